@@ -0,0 +1,174 @@
+package action
+
+import (
+	"errors"
+	"io/ioutil"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+// Bench executes the select query repeatedly, and writes the costs of the phases of the executions.
+// Tables are loaded in every iteration because the loaded tables are released after each execution.
+// The result of the query is encoded in the output format, and then discarded.
+func Bench(proc *query.Procedure, input string, iterations int) error {
+	if iterations < 1 {
+		return errors.New("iterations must be a positive integer")
+	}
+
+	statements, err := parser.Parse(input, "")
+	if err != nil {
+		return query.NewSyntaxError(err.(*parser.SyntaxError))
+	}
+	if len(statements) != 1 {
+		return errors.New("only one select query can be benchmarked")
+	}
+	selectQuery, ok := statements[0].(parser.SelectQuery)
+	if !ok {
+		return errors.New("only one select query can be benchmarked")
+	}
+
+	flags := cmd.GetFlags()
+	fileInfo := &query.FileInfo{
+		Format:             flags.Format,
+		Delimiter:          flags.WriteDelimiter,
+		DelimiterPositions: flags.WriteDelimiterPositions,
+		Encoding:           flags.WriteEncoding,
+		LineBreak:          flags.LineBreak,
+		NoHeader:           flags.WithoutHeader,
+		EncloseAll:         flags.EncloseAll,
+		PrettyPrint:        flags.PrettyPrint,
+	}
+
+	results := make([]*query.BenchmarkResult, 0, iterations)
+	for i := 0; i < iterations; i++ {
+		result, err := query.BenchmarkQuery(selectQuery, proc.Filter, fileInfo, ioutil.Discard)
+		if e := query.ReleaseResourcesWithErrors(); e != nil && err == nil {
+			err = e
+		}
+		if err != nil {
+			return err
+		}
+		results = append(results, result)
+	}
+
+	query.Log("\n"+formatBenchmarkResults(results), false)
+	return nil
+}
+
+type benchmarkSummary struct {
+	average time.Duration
+	minimum time.Duration
+	maximum time.Duration
+	memory  uint64
+	allocs  uint64
+}
+
+func summarizeBenchmark(list []*query.OperationStats) benchmarkSummary {
+	summary := benchmarkSummary{
+		minimum: list[0].Elapsed,
+		maximum: list[0].Elapsed,
+	}
+
+	var elapsed time.Duration
+	var memory uint64
+	var allocs uint64
+	for _, stats := range list {
+		elapsed = elapsed + stats.Elapsed
+		memory = memory + stats.Memory
+		allocs = allocs + stats.Allocs
+		if stats.Elapsed < summary.minimum {
+			summary.minimum = stats.Elapsed
+		}
+		if summary.maximum < stats.Elapsed {
+			summary.maximum = stats.Elapsed
+		}
+	}
+
+	n := len(list)
+	summary.average = elapsed / time.Duration(n)
+	summary.memory = memory / uint64(n)
+	summary.allocs = allocs / uint64(n)
+	return summary
+}
+
+func formatBenchmarkResults(results []*query.BenchmarkResult) string {
+	labels := append(append([]string{}, query.BenchmarkPhases...), "Total")
+	headers := []string{"Phase", "Average", "Minimum", "Maximum", "Memory", "Allocs"}
+
+	rows := make([][]string, 0, len(labels))
+	for _, label := range labels {
+		list := make([]*query.OperationStats, 0, len(results))
+		for _, result := range results {
+			if label == "Total" {
+				list = append(list, result.Total())
+			} else {
+				list = append(list, result.Phases[label])
+			}
+		}
+
+		summary := summarizeBenchmark(list)
+		rows = append(rows, []string{
+			label,
+			formatSeconds(summary.average),
+			formatSeconds(summary.minimum),
+			formatSeconds(summary.maximum),
+			cmd.FormatNumber(float64(summary.memory), 0, ".", ",", ""),
+			cmd.FormatNumber(float64(summary.allocs), 0, ".", ",", ""),
+		})
+	}
+
+	widths := make([]int, len(headers))
+	for i := range headers {
+		widths[i] = len(headers[i])
+		for _, row := range rows {
+			if widths[i] < len(row[i]) {
+				widths[i] = len(row[i])
+			}
+		}
+	}
+
+	w := query.NewObjectWriter()
+	w.WriteColor(" Iterations: ", cmd.LableEffect)
+	w.WriteWithoutLineBreak(cmd.FormatNumber(float64(len(results)), 0, ".", ",", ""))
+	w.NewLine()
+	w.WriteColor("    Records: ", cmd.LableEffect)
+	w.WriteWithoutLineBreak(cmd.FormatNumber(float64(results[0].Rows), 0, ".", ",", ""))
+	w.NewLine()
+	w.NewLine()
+
+	for i, header := range headers {
+		if i == 0 {
+			w.WriteColor(" "+header, cmd.LableEffect)
+			w.WriteSpaces(widths[i] - len(header))
+		} else {
+			w.WriteSpaces(widths[i] - len(header) + 2)
+			w.WriteColor(header, cmd.LableEffect)
+		}
+	}
+	w.NewLine()
+	for _, row := range rows {
+		for i, s := range row {
+			if i == 0 {
+				w.WriteColor(" "+s, cmd.LableEffect)
+				w.WriteSpaces(widths[i] - len(s))
+			} else {
+				w.WriteSpaces(widths[i] - len(s) + 2)
+				w.WriteWithoutLineBreak(s)
+			}
+		}
+		w.NewLine()
+	}
+	w.NewLine()
+	w.Write("Times are in seconds. Memory and Allocs are the average bytes and number of allocations.")
+	w.NewLine()
+
+	w.Title1 = "Benchmark Results"
+	return w.String()
+}
+
+func formatSeconds(d time.Duration) string {
+	return cmd.FormatNumber(d.Seconds(), 6, ".", ",", "")
+}
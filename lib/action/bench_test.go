@@ -0,0 +1,88 @@
+package action
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+var benchTests = []struct {
+	Name       string
+	Input      string
+	Iterations int
+	Error      string
+}{
+	{
+		Name:       "Bench",
+		Input:      "select column1 from table1 where column1 > 1 order by column2",
+		Iterations: 3,
+	},
+	{
+		Name:       "Bench Invalid Iterations",
+		Input:      "select 1",
+		Iterations: 0,
+		Error:      "iterations must be a positive integer",
+	},
+	{
+		Name:       "Bench Multiple Statements",
+		Input:      "select 1; select 2",
+		Iterations: 1,
+		Error:      "only one select query can be benchmarked",
+	},
+	{
+		Name:       "Bench Not Select Query",
+		Input:      "print 1",
+		Iterations: 1,
+		Error:      "only one select query can be benchmarked",
+	},
+	{
+		Name:       "Bench Query Error",
+		Input:      "select notexist from table1",
+		Iterations: 1,
+		Error:      "[L:1 C:8] field notexist does not exist",
+	},
+}
+
+func TestBench(t *testing.T) {
+	defer initFlags()
+
+	for _, v := range benchTests {
+		initFlags()
+		tf := cmd.GetFlags()
+		tf.Repository = TestDataDir
+		tf.SetNoHeader(false)
+
+		oldStdout := query.Stdout
+		r, w, _ := os.Pipe()
+		query.Stdout = w
+
+		err := Bench(query.NewProcedure(), v.Input, v.Iterations)
+
+		w.Close()
+		query.Stdout = oldStdout
+		log, _ := ioutil.ReadAll(r)
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		for _, s := range append([]string{"Benchmark Results", "Iterations: 3", "Records: 2"}, query.BenchmarkPhases...) {
+			if !strings.Contains(string(log), s) {
+				t.Errorf("%s: output does not contain %q: %s", v.Name, s, log)
+			}
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package action
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+// Check parses and validates the statements without executing them.
+// The errors found are written to the standard error.
+func Check(proc *query.Procedure, input string, sourceFile string) error {
+	statements, err := parser.Parse(input, sourceFile)
+	if err != nil {
+		return query.NewSyntaxError(err.(*parser.SyntaxError))
+	}
+
+	errs := query.CheckStatements(statements, proc.Filter)
+	if 0 < len(errs) {
+		for _, e := range errs {
+			query.LogError(e.Error())
+		}
+		return errors.New(fmt.Sprintf(cmd.Message("%s found"), query.FormatCount(len(errs), "error")))
+	}
+
+	query.LogNotice(cmd.Message("No errors found."), cmd.GetFlags().Quiet)
+	return nil
+}
@@ -0,0 +1,73 @@
+package action
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+var checkTests = []struct {
+	Name   string
+	Input  string
+	Output string
+	Stderr string
+	Error  string
+}{
+	{
+		Name:   "Check",
+		Input:  "var @a := 1; print @a;",
+		Output: "No errors found.\n",
+	},
+	{
+		Name:   "Check Errors",
+		Input:  "print @a;\nprint @b;",
+		Stderr: "[L:1 C:7] variable @a is undeclared\n[L:2 C:7] variable @b is undeclared\n",
+		Error:  "2 errors found",
+	},
+	{
+		Name:  "Check Syntax Error",
+		Input: "select from",
+		Error: "[L:1 C:8] syntax error: unexpected token \"from\"",
+	},
+}
+
+func TestCheck(t *testing.T) {
+	for _, v := range checkTests {
+		initFlags()
+
+		oldStdout := query.Stdout
+		oldStderr := query.Stderr
+		r, w, _ := os.Pipe()
+		er, ew, _ := os.Pipe()
+		query.Stdout = w
+		query.Stderr = ew
+
+		err := Check(query.NewProcedure(), v.Input, "")
+
+		w.Close()
+		ew.Close()
+		query.Stdout = oldStdout
+		query.Stderr = oldStderr
+		stdout, _ := ioutil.ReadAll(r)
+		stderr, _ := ioutil.ReadAll(er)
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+		} else if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+
+		if string(stdout) != v.Output {
+			t.Errorf("%s: output = %q, want %q", v.Name, string(stdout), v.Output)
+		}
+		if string(stderr) != v.Stderr {
+			t.Errorf("%s: stderr = %q, want %q", v.Name, string(stderr), v.Stderr)
+		}
+	}
+}
@@ -37,7 +37,13 @@ func Run(proc *query.Procedure, input string, sourceFile string, outfile string)
 		return query.NewSyntaxError(err.(*parser.SyntaxError))
 	}
 
-	if 0 < len(outfile) {
+	if query.Debug != nil {
+		query.Debug.SetSource(sourceFile, input)
+	}
+
+	if cmd.IsHttpUrl(outfile) {
+		query.OutFile = query.NewHttpSink(outfile)
+	} else if 0 < len(outfile) {
 		if abs, err := filepath.Abs(outfile); err == nil {
 			outfile = abs
 		}
@@ -62,7 +68,7 @@ func Run(proc *query.Procedure, input string, sourceFile string, outfile string)
 
 	if err == nil && flow == query.Terminate {
 		if e := query.Commit(nil, proc.Filter); e != nil {
-			query.LogError(e.Error())
+			return e
 		}
 	}
 
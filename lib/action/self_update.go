@@ -0,0 +1,200 @@
+package action
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+// executablePath returns the path of the executable to be replaced.
+var executablePath = os.Executable
+
+// SelfUpdate replaces the running executable with the latest release in the channel.
+//
+// The checksums of the release archives are verified with the signature and the public key
+// embedded at build time, and then the downloaded archive is verified with the checksums.
+func SelfUpdate(channel string) error {
+	channel, err := query.ParseReleaseChannel(channel)
+	if err != nil {
+		return err
+	}
+
+	release, err := query.LatestRelease(channel)
+	if err != nil {
+		return err
+	}
+
+	quiet := cmd.GetFlags().Quiet
+	if query.CompareVersions(release.Version, query.Version) <= 0 {
+		query.Log(fmt.Sprintf(cmd.Message("csvq %s is already the latest version"), query.Version), quiet)
+		return nil
+	}
+
+	archiveName := release.ArchiveName()
+	archive, err := downloadReleaseAsset(release, archiveName)
+	if err != nil {
+		return err
+	}
+	checksums, err := downloadReleaseAsset(release, query.ChecksumsAssetName)
+	if err != nil {
+		return err
+	}
+	signature, err := downloadReleaseAsset(release, query.SignatureAssetName)
+	if err != nil {
+		return err
+	}
+
+	if err := VerifySignature(checksums, signature, query.ReleasePublicKey); err != nil {
+		return err
+	}
+	if err := VerifyChecksum(checksums, archiveName, archive); err != nil {
+		return err
+	}
+
+	bin, err := extractExecutable(archive)
+	if err != nil {
+		return err
+	}
+
+	path, err := executablePath()
+	if err != nil {
+		return err
+	}
+	if path, err = filepath.EvalSymlinks(path); err != nil {
+		return err
+	}
+	if err := replaceExecutable(path, bin); err != nil {
+		return err
+	}
+
+	query.Log(fmt.Sprintf(cmd.Message("csvq is updated from %s to %s"), query.Version, release.Version), quiet)
+	return nil
+}
+
+func downloadReleaseAsset(release *query.Release, name string) ([]byte, error) {
+	url, ok := release.Assets[name]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("release %s does not have the asset %s", release.Version, name))
+	}
+	return query.DownloadReleaseAsset(url)
+}
+
+// VerifySignature verifies the ed25519 signature of the data with the base64-encoded public key.
+func VerifySignature(data []byte, signature []byte, publicKey string) error {
+	if len(publicKey) < 1 {
+		return errors.New("this build does not have a public key to verify releases")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return errors.New("public key to verify releases is invalid")
+	}
+	if !ed25519.Verify(ed25519.PublicKey(key), data, signature) {
+		return errors.New("signature of the release checksums is invalid")
+	}
+	return nil
+}
+
+// VerifyChecksum verifies the SHA-256 checksum of the data with the checksum of the name
+// listed in the checksums in the format of sha256sum.
+func VerifyChecksum(checksums []byte, name string, data []byte) error {
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || strings.TrimPrefix(fields[1], "*") != name {
+			continue
+		}
+
+		sum := sha256.Sum256(data)
+		if !strings.EqualFold(fields[0], hex.EncodeToString(sum[:])) {
+			return errors.New(fmt.Sprintf("checksum of %s does not match", name))
+		}
+		return nil
+	}
+	return errors.New(fmt.Sprintf("checksum of %s is not found", name))
+}
+
+func extractExecutable(archive []byte) ([]byte, error) {
+	name := "csvq"
+	if runtime.GOOS == "windows" {
+		name = "csvq.exe"
+	}
+
+	gr, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if h.Typeflag == tar.TypeReg && filepath.Base(h.Name) == name {
+			return ioutil.ReadAll(tr)
+		}
+	}
+	return nil, errors.New(fmt.Sprintf("%s is not found in the release archive", name))
+}
+
+// replaceExecutable writes the new executable next to the current one and swaps them,
+// so that the current executable is kept if the replacement fails.
+func replaceExecutable(path string, bin []byte) error {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	fp, err := ioutil.TempFile(filepath.Dir(path), ".csvq-update-")
+	if err != nil {
+		return err
+	}
+	newPath := fp.Name()
+	if _, err = fp.Write(bin); err == nil {
+		err = fp.Chmod(fi.Mode())
+	}
+	if e := fp.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		_ = os.Remove(newPath)
+		return err
+	}
+
+	oldPath := path + ".old"
+	_ = os.Remove(oldPath)
+	if err := os.Rename(path, oldPath); err != nil {
+		_ = os.Remove(newPath)
+		return err
+	}
+	if err := os.Rename(newPath, path); err != nil {
+		_ = os.Rename(oldPath, path)
+		_ = os.Remove(newPath)
+		return err
+	}
+
+	// The old executable cannot be removed while it is running on Windows.
+	_ = os.Remove(oldPath)
+	return nil
+}
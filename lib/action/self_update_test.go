@@ -0,0 +1,194 @@
+package action
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+func testReleaseArchive(dir string, name string, contents string) []byte {
+	buf := new(bytes.Buffer)
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+	_ = tw.WriteHeader(&tar.Header{Name: dir + "/", Typeflag: tar.TypeDir, Mode: 0755})
+	_ = tw.WriteHeader(&tar.Header{Name: dir + "/" + name, Typeflag: tar.TypeReg, Mode: 0755, Size: int64(len(contents))})
+	_, _ = tw.Write([]byte(contents))
+	_ = tw.Close()
+	_ = gw.Close()
+	return buf.Bytes()
+}
+
+func testChecksum(name string, data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]) + "  " + name + "\n"
+}
+
+var verifyChecksumTests = []struct {
+	Name      string
+	Checksums string
+	File      string
+	Error     string
+}{
+	{
+		Name:      "Verify Checksum",
+		Checksums: testChecksum("other.tar.gz", []byte("other")) + testChecksum("csvq.tar.gz", []byte("data")),
+		File:      "csvq.tar.gz",
+	},
+	{
+		Name:      "Verify Checksum in Binary Mode",
+		Checksums: testChecksum("*csvq.tar.gz", []byte("data")),
+		File:      "csvq.tar.gz",
+	},
+	{
+		Name:      "Verify Checksum Not Match",
+		Checksums: testChecksum("csvq.tar.gz", []byte("modified")),
+		File:      "csvq.tar.gz",
+		Error:     "checksum of csvq.tar.gz does not match",
+	},
+	{
+		Name:      "Verify Checksum Not Found",
+		Checksums: testChecksum("other.tar.gz", []byte("data")),
+		File:      "csvq.tar.gz",
+		Error:     "checksum of csvq.tar.gz is not found",
+	},
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	for _, v := range verifyChecksumTests {
+		err := VerifyChecksum([]byte(v.Checksums), v.File, []byte("data"))
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(rand.Reader)
+	publicKey := base64.StdEncoding.EncodeToString(pub)
+	data := []byte("checksums")
+	signature := ed25519.Sign(priv, data)
+
+	if err := VerifySignature(data, signature, publicKey); err != nil {
+		t.Errorf("unexpected error %q", err)
+	}
+
+	expectErr := "signature of the release checksums is invalid"
+	if err := VerifySignature([]byte("modified"), signature, publicKey); err == nil || err.Error() != expectErr {
+		t.Errorf("error %v, want error %q", err, expectErr)
+	}
+
+	expectErr = "public key to verify releases is invalid"
+	if err := VerifySignature(data, signature, "invalid"); err == nil || err.Error() != expectErr {
+		t.Errorf("error %v, want error %q", err, expectErr)
+	}
+
+	expectErr = "this build does not have a public key to verify releases"
+	if err := VerifySignature(data, signature, ""); err == nil || err.Error() != expectErr {
+		t.Errorf("error %v, want error %q", err, expectErr)
+	}
+}
+
+func TestSelfUpdate(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(rand.Reader)
+
+	execName := "csvq"
+	if runtime.GOOS == "windows" {
+		execName = "csvq.exe"
+	}
+
+	release := &query.Release{Version: "v1.1.0"}
+	archiveName := release.ArchiveName()
+	archive := testReleaseArchive("csvq-v1.1.0-"+runtime.GOOS+"-"+runtime.GOARCH, execName, "new executable")
+	checksums := []byte(testChecksum(archiveName, archive))
+	signature := ed25519.Sign(priv, checksums)
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/releases":
+			_, _ = fmt.Fprintf(w, `[{"tag_name": "v1.1.0", "draft": false, "prerelease": false, "assets": [
+				{"name": %q, "browser_download_url": "%s/archive"},
+				{"name": "checksums.txt", "browser_download_url": "%s/checksums"},
+				{"name": "checksums.txt.sig", "browser_download_url": "%s/signature"}
+			]}]`, archiveName, server.URL, server.URL, server.URL)
+		case "/archive":
+			_, _ = w.Write(archive)
+		case "/checksums":
+			_, _ = w.Write(checksums)
+		case "/signature":
+			_, _ = w.Write(signature)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	execPath := GetTestFilePath(execName)
+	_ = ioutil.WriteFile(execPath, []byte("old executable"), 0755)
+
+	oldURL := query.ReleaseURL
+	oldKey := query.ReleasePublicKey
+	oldVersion := query.Version
+	oldExecutablePath := executablePath
+	query.ReleaseURL = server.URL + "/releases"
+	query.ReleasePublicKey = base64.StdEncoding.EncodeToString(pub)
+	executablePath = func() (string, error) { return execPath, nil }
+	defer func() {
+		query.ReleaseURL = oldURL
+		query.ReleasePublicKey = oldKey
+		query.Version = oldVersion
+		executablePath = oldExecutablePath
+	}()
+
+	query.Version = "v1.1.0"
+	if err := SelfUpdate("stable"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if b, _ := ioutil.ReadFile(execPath); string(b) != "old executable" {
+		t.Errorf("executable = %q, want %q for the latest version", string(b), "old executable")
+	}
+
+	query.Version = "v1.0.0"
+	query.ReleasePublicKey = base64.StdEncoding.EncodeToString(make([]byte, ed25519.PublicKeySize))
+	expectErr := "signature of the release checksums is invalid"
+	if err := SelfUpdate("stable"); err == nil || err.Error() != expectErr {
+		t.Errorf("error %v, want error %q for the wrong key", err, expectErr)
+	}
+	if b, _ := ioutil.ReadFile(execPath); string(b) != "old executable" {
+		t.Errorf("executable = %q, want %q for the wrong key", string(b), "old executable")
+	}
+
+	query.ReleasePublicKey = base64.StdEncoding.EncodeToString(pub)
+	if err := SelfUpdate("stable"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if b, _ := ioutil.ReadFile(execPath); string(b) != "new executable" {
+		t.Errorf("executable = %q, want %q", string(b), "new executable")
+	}
+
+	expectErr = "channel must be one of stable|prerelease"
+	if err := SelfUpdate("nightly"); err == nil || err.Error() != expectErr {
+		t.Errorf("error %v, want error %q", err, expectErr)
+	}
+}
@@ -0,0 +1,171 @@
+package action
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+// TestFileSuffix is the suffix of the names of the files executed by Test.
+const TestFileSuffix = "_test.sql"
+
+type testOutput struct {
+	bytes.Buffer
+}
+
+func (out *testOutput) Close() error {
+	return nil
+}
+
+// FindTestFiles returns the paths of the test files specified by the paths.
+// Directories are searched recursively for files whose names end with TestFileSuffix, and duplicated paths are removed.
+func FindTestFiles(paths []string) ([]string, error) {
+	files := make([]string, 0, 10)
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("file %q does not exist", path))
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		err = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() && strings.HasSuffix(info.Name(), TestFileSuffix) {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to read directory: %s", err.Error()))
+		}
+	}
+	sort.Strings(files)
+
+	unique := files[:0]
+	for i := range files {
+		if i == 0 || files[i] != files[i-1] {
+			unique = append(unique, files[i])
+		}
+	}
+	return unique, nil
+}
+
+// Test executes the test files specified by the paths, and reports whether each file passes.
+// A test file fails if an error occurs in the statements, such as a failure of an ASSERT or EXPECT statement.
+func Test(proc *query.Procedure, paths []string) error {
+	if len(paths) < 1 {
+		paths = []string{"."}
+	}
+
+	files, err := FindTestFiles(paths)
+	if err != nil {
+		return err
+	}
+	if len(files) < 1 {
+		query.LogNotice(cmd.Message("No test files found."), cmd.GetFlags().Quiet)
+		return nil
+	}
+
+	failed := 0
+	for _, path := range files {
+		start := time.Now()
+		output, err := RunTestFile(proc, path)
+		elapsed := time.Since(start).Seconds()
+
+		if err == nil {
+			query.Log(fmt.Sprintf("PASS  %s (%.3fs)", path, elapsed), cmd.GetFlags().Quiet)
+			continue
+		}
+
+		failed++
+		query.Log(fmt.Sprintf("FAIL  %s (%.3fs)", path, elapsed), false)
+		query.Log(indentTestOutput(err.Error()), false)
+		if 0 < len(output) {
+			query.Log(indentTestOutput(output), false)
+		}
+	}
+
+	if 0 < failed {
+		return errors.New(fmt.Sprintf(cmd.Message("%d of %s failed"), failed, query.FormatCount(len(files), "test file")))
+	}
+	query.LogNotice(fmt.Sprintf(cmd.Message("%s passed."), query.FormatCount(len(files), "test file")), cmd.GetFlags().Quiet)
+	return nil
+}
+
+// RunTestFile executes a test file, and returns the output written by the statements.
+//
+// Statements are executed in a new child scope of the procedure with the repository set to the directory
+// of the test file, so that fixture files placed with the test file are loaded.
+// Changes to the files are rolled back after the execution unless committed by the statements.
+// An EXIT statement with the exit code 0 terminates the test file without a failure.
+func RunTestFile(proc *query.Procedure, path string) (string, error) {
+	flags := cmd.GetFlags()
+	repository := flags.Repository
+	oldStdout := query.Stdout
+	out := &testOutput{}
+
+	query.PreparedStatements = query.NewPreparedStatementMap()
+	query.TableTriggers = query.NewTableTriggerMap()
+	query.Stdout = out
+	child := proc.NewChildProcedure()
+
+	defer func() {
+		if e := query.Rollback(nil, child.Filter); e != nil {
+			query.LogError(e.Error())
+		}
+		if err := query.ReleaseResourcesWithErrors(); err != nil {
+			query.LogError(err.Error())
+		}
+		query.Stdout = oldStdout
+		flags.Repository = repository
+	}()
+
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("failed to read file: %s", err.Error()))
+	}
+	if err = flags.SetRepository(filepath.Dir(path)); err != nil {
+		return "", err
+	}
+
+	statements, err := parser.Parse(string(buf), path)
+	if err != nil {
+		return "", query.NewSyntaxError(err.(*parser.SyntaxError))
+	}
+
+	_, err = child.Execute(statements)
+	if ex, ok := err.(*query.ForcedExit); ok {
+		if ex.GetCode() == 0 {
+			err = nil
+		} else {
+			err = errors.New(fmt.Sprintf(cmd.Message("exited with code %d"), ex.GetCode()))
+		}
+	}
+	return out.String(), err
+}
+
+func indentTestOutput(s string) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	for i := range lines {
+		lines[i] = "      " + lines[i]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,148 @@
+package action
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+func setupTestFiles() string {
+	dir := GetTestFilePath("test_files")
+	_ = os.MkdirAll(filepath.Join(dir, "sub"), 0755)
+
+	_ = ioutil.WriteFile(filepath.Join(dir, "sales.csv"), []byte("id,amount\n1,10\n2,-5\n"), 0644)
+	_ = ioutil.WriteFile(filepath.Join(dir, "expected.csv"), []byte("id,amount\n1,10\n2,0\n"), 0644)
+	_ = ioutil.WriteFile(filepath.Join(dir, "pass_test.sql"), []byte(""+
+		"UPDATE sales SET amount = 0 WHERE amount < 0;\n"+
+		"EXPECT SELECT * FROM sales TO EQUAL SELECT * FROM expected;\n"), 0644)
+	_ = ioutil.WriteFile(filepath.Join(dir, "sub", "exit_test.sql"), []byte("EXIT;\n"), 0644)
+	_ = ioutil.WriteFile(filepath.Join(dir, "sub", "fail_test.sql"), []byte(""+
+		"PRINT 'checking';\n"+
+		"ASSERT (SELECT COUNT(*) FROM `../sales.csv` WHERE amount < 0) = 0 MESSAGE 'negative amounts found';\n"), 0644)
+	_ = ioutil.WriteFile(filepath.Join(dir, "sub", "other.sql"), []byte("EXIT 1;\n"), 0644)
+	return dir
+}
+
+func TestFindTestFiles(t *testing.T) {
+	dir := setupTestFiles()
+
+	files, err := FindTestFiles([]string{dir, filepath.Join(dir, "sub"), filepath.Join(dir, "sub", "other.sql")})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := []string{
+		filepath.Join(dir, "pass_test.sql"),
+		filepath.Join(dir, "sub", "exit_test.sql"),
+		filepath.Join(dir, "sub", "fail_test.sql"),
+		filepath.Join(dir, "sub", "other.sql"),
+	}
+	if !reflect.DeepEqual(files, expect) {
+		t.Errorf("files = %q, want %q", files, expect)
+	}
+
+	_, err = FindTestFiles([]string{filepath.Join(dir, "notexist")})
+	if err == nil {
+		t.Errorf("no error, want error for a path that does not exist")
+	}
+}
+
+var runTestFileTests = []struct {
+	Name   string
+	File   string
+	Output string
+	Error  string
+}{
+	{
+		Name: "RunTestFile",
+		File: "pass_test.sql",
+	},
+	{
+		Name: "RunTestFile Exit",
+		File: filepath.Join("sub", "exit_test.sql"),
+	},
+	{
+		Name:   "RunTestFile Failure",
+		File:   filepath.Join("sub", "fail_test.sql"),
+		Output: "\"checking\"\n",
+		Error:  GetTestFilePath(filepath.Join("test_files", "sub", "fail_test.sql")) + " [L:2 C:1] negative amounts found",
+	},
+	{
+		Name:  "RunTestFile Exit with Error Code",
+		File:  filepath.Join("sub", "other.sql"),
+		Error: "exited with code 1",
+	},
+}
+
+func TestRunTestFile(t *testing.T) {
+	defer func() {
+		initFlags()
+		cmd.GetFlags().SetQuiet(false)
+	}()
+
+	dir := setupTestFiles()
+	proc := query.NewProcedure()
+
+	for _, v := range runTestFileTests {
+		initFlags()
+		tf := cmd.GetFlags()
+		tf.Format = cmd.CSV
+		tf.Repository = TestDir
+		tf.SetNoHeader(false)
+		tf.SetQuiet(true)
+
+		output, err := RunTestFile(proc, filepath.Join(dir, v.File))
+
+		if tf.Repository != TestDir {
+			t.Errorf("%s: repository = %q, want %q", v.Name, tf.Repository, TestDir)
+		}
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+		} else if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+
+		if output != v.Output {
+			t.Errorf("%s: output = %q, want %q", v.Name, output, v.Output)
+		}
+	}
+
+	buf, _ := ioutil.ReadFile(filepath.Join(dir, "sales.csv"))
+	if string(buf) != "id,amount\n1,10\n2,-5\n" {
+		t.Errorf("fixture file is modified: %q", string(buf))
+	}
+}
+
+func TestTest(t *testing.T) {
+	defer initFlags()
+	initFlags()
+	cmd.GetFlags().SetNoHeader(false)
+
+	dir := setupTestFiles()
+	proc := query.NewProcedure()
+
+	oldStdout := query.Stdout
+	_, w, _ := os.Pipe()
+	query.Stdout = w
+
+	err := Test(proc, []string{dir})
+
+	w.Close()
+	query.Stdout = oldStdout
+
+	expect := "1 of 3 test files failed"
+	if err == nil {
+		t.Errorf("no error, want error %q", expect)
+	} else if err.Error() != expect {
+		t.Errorf("error %q, want error %q", err.Error(), expect)
+	}
+}
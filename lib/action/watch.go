@@ -0,0 +1,148 @@
+package action
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+// WatchPollInterval is the interval to check for modifications of the files watched by Watch.
+var WatchPollInterval = 500 * time.Millisecond
+
+type watchedFileState struct {
+	exists  bool
+	modTime time.Time
+	size    int64
+}
+
+func statWatchedFile(path string) watchedFileState {
+	info, err := os.Stat(path)
+	if err != nil {
+		return watchedFileState{}
+	}
+	return watchedFileState{
+		exists:  true,
+		modTime: info.ModTime(),
+		size:    info.Size(),
+	}
+}
+
+// WatchedFiles holds the states of files to detect their modifications.
+type WatchedFiles map[string]watchedFileState
+
+func NewWatchedFiles(paths []string) WatchedFiles {
+	files := make(WatchedFiles, len(paths))
+	for _, path := range paths {
+		files[path] = statWatchedFile(path)
+	}
+	return files
+}
+
+// Modified reports whether any of the files is created, removed, or modified since the states were taken.
+func (files WatchedFiles) Modified() bool {
+	for path, state := range files {
+		if statWatchedFile(path) != state {
+			return true
+		}
+	}
+	return false
+}
+
+// Watch executes the statements loaded from the source file, and executes them again
+// each time the source file or the files loaded by the statements are modified.
+//
+// Statements are executed in a new child scope of the procedure every time, so that
+// variables and temporary tables declared by the previous execution do not remain.
+// Errors in the statements are written to the standard error, and watching continues.
+func Watch(proc *query.Procedure, input string, sourceFile string, outfile string) error {
+	for {
+		paths, err := WatchRun(proc, input, sourceFile, outfile)
+		if err != nil {
+			if ex, ok := err.(*query.ForcedExit); ok {
+				return ex
+			}
+			query.LogError(err.Error())
+		}
+
+		files := NewWatchedFiles(append(paths, sourceFile))
+		query.LogNotice(fmt.Sprintf(cmd.Message("Watching %s for modifications."), query.FormatCount(len(files), "file")), cmd.GetFlags().Quiet)
+		for !files.Modified() {
+			time.Sleep(WatchPollInterval)
+		}
+
+		buf, err := ioutil.ReadFile(sourceFile)
+		if err != nil {
+			return errors.New(fmt.Sprintf("failed to read file: %s", err.Error()))
+		}
+		input = string(buf)
+	}
+}
+
+// WatchRun executes the statements once for Watch, and returns the paths of the files loaded by the statements.
+// Files released by COMMIT or ROLLBACK statements before the end of the statements are not included.
+// The output file is overwritten if it exists.
+func WatchRun(proc *query.Procedure, input string, sourceFile string, outfile string) (paths []string, err error) {
+	start := time.Now()
+
+	query.PreparedStatements = query.NewPreparedStatementMap()
+	query.TableTriggers = query.NewTableTriggerMap()
+	child := proc.NewChildProcedure()
+
+	defer func() {
+		if e := query.Rollback(nil, child.Filter); e != nil {
+			query.LogError(e.Error())
+		}
+		if err := query.ReleaseResourcesWithErrors(); err != nil {
+			query.LogError(err.Error())
+		}
+		showStats(start)
+	}()
+	statements, err := parser.Parse(input, sourceFile)
+	if err != nil {
+		return nil, query.NewSyntaxError(err.(*parser.SyntaxError))
+	}
+
+	if cmd.IsHttpUrl(outfile) {
+		query.OutFile = query.NewHttpSink(outfile)
+	} else if 0 < len(outfile) {
+		if abs, err := filepath.Abs(outfile); err == nil {
+			outfile = abs
+		}
+
+		fp, err := os.Create(outfile)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to create file: %s", err.Error()))
+		}
+		defer fp.Close()
+		query.OutFile = fp
+	}
+
+	flow, err := child.Execute(statements)
+	paths = loadedFilePaths()
+
+	if err == nil && flow == query.Terminate {
+		err = query.Commit(nil, child.Filter)
+	}
+
+	return paths, err
+}
+
+// loadedFilePaths returns the paths of the files loaded as tables, excluding temporary tables.
+func loadedFilePaths() []string {
+	paths := make([]string, 0, len(query.ViewCache))
+	for _, view := range query.ViewCache {
+		if view.FileInfo != nil && !view.FileInfo.IsTemporary && 0 < len(view.FileInfo.Path) {
+			paths = append(paths, view.FileInfo.Path)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
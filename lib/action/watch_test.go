@@ -0,0 +1,120 @@
+package action
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+func TestWatchedFiles_Modified(t *testing.T) {
+	path := GetTestFilePath("watched_file.csv")
+	_ = ioutil.WriteFile(path, []byte("c1\n1\n"), 0644)
+	notExist := GetTestFilePath("watched_file_not_exist.csv")
+
+	files := NewWatchedFiles([]string{path, notExist})
+	if files.Modified() {
+		t.Errorf("modified = %t, want %t", true, false)
+	}
+
+	_ = ioutil.WriteFile(path, []byte("c1\n1\n2\n"), 0644)
+	if !files.Modified() {
+		t.Errorf("modified = %t, want %t for the updated file", false, true)
+	}
+
+	files = NewWatchedFiles([]string{path, notExist})
+	_ = ioutil.WriteFile(notExist, []byte("c1\n"), 0644)
+	if !files.Modified() {
+		t.Errorf("modified = %t, want %t for the created file", false, true)
+	}
+}
+
+var watchRunTests = []struct {
+	Name    string
+	Input   string
+	Table   string
+	Paths   []string
+	Content string
+	Error   string
+}{
+	{
+		Name:    "WatchRun",
+		Input:   "select sum(c1) as s from watch_table",
+		Table:   "c1\n1\n2\n",
+		Paths:   []string{GetTestFilePath("watch_table.csv")},
+		Content: "s\n3\n",
+	},
+	{
+		Name:    "WatchRun Overwrite Output File",
+		Input:   "select sum(c1) as s from watch_table",
+		Table:   "c1\n1\n2\n3\n",
+		Paths:   []string{GetTestFilePath("watch_table.csv")},
+		Content: "s\n6\n",
+	},
+	{
+		Name:    "WatchRun Redeclaration",
+		Input:   "var @a := 1; declare v view (c1); print @a;",
+		Content: "",
+	},
+	{
+		Name:  "WatchRun Execution Error",
+		Input: "select c2 from watch_table",
+		Table: "c1\n1\n",
+		Paths: []string{GetTestFilePath("watch_table.csv")},
+		Error: "[L:1 C:8] field c2 does not exist",
+	},
+}
+
+func TestWatchRun(t *testing.T) {
+	defer func() {
+		initFlags()
+		cmd.GetFlags().Quiet = false
+	}()
+	outfile := GetTestFilePath("watch_output.csv")
+	proc := query.NewProcedure()
+
+	for _, v := range watchRunTests {
+		initFlags()
+		tf := cmd.GetFlags()
+		tf.Format = cmd.CSV
+		tf.Quiet = true
+
+		if 0 < len(v.Table) {
+			_ = ioutil.WriteFile(GetTestFilePath("watch_table.csv"), []byte(v.Table), 0644)
+		}
+
+		oldStdout := query.Stdout
+		_, w, _ := os.Pipe()
+		query.Stdout = w
+
+		paths, err := WatchRun(proc, v.Input, "", outfile)
+
+		w.Close()
+		query.Stdout = oldStdout
+		query.OutFile = nil
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+		} else if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+
+		if !reflect.DeepEqual(paths, v.Paths) && !(len(paths) < 1 && len(v.Paths) < 1) {
+			t.Errorf("%s: paths = %q, want %q", v.Name, paths, v.Paths)
+		}
+
+		if len(v.Error) < 1 {
+			buf, _ := ioutil.ReadFile(outfile)
+			if string(buf) != v.Content {
+				t.Errorf("%s: content = %q, want %q", v.Name, string(buf), v.Content)
+			}
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/file"
+
+	"github.com/mithrandie/go-text"
+)
+
+// Catalog binds logical table names to files.
+//
+// A catalog file is a JSON document like the following:
+//
+//	{
+//	  "tables": {
+//	    "sales": {"path": "data/sales.csv", "format": "csv"},
+//	    "users": {"path": "data/users.txt", "delimiter": "|", "no_header": true, "columns": ["id", "name"]}
+//	  }
+//	}
+//
+// Relative paths are resolved from the directory of the catalog file.
+// The options of a table are used in place of the command options
+// when the table is referred by its name.
+type Catalog struct {
+	Path   string
+	Tables map[string]*CatalogTable
+}
+
+type CatalogTable struct {
+	Path        string   `json:"path"`
+	Format      string   `json:"format"`
+	Delimiter   string   `json:"delimiter"`
+	JsonQuery   string   `json:"json_query"`
+	Encoding    string   `json:"encoding"`
+	NoHeader    *bool    `json:"no_header"`
+	WithoutNull *bool    `json:"without_null"`
+	Columns     []string `json:"columns"`
+
+	DecimalSeparator   *string `json:"decimal_separator"`
+	ThousandsSeparator *string `json:"thousands_separator"`
+
+	importFormat       Format
+	delimiter          rune
+	delimiterPositions []int
+	encoding           text.Encoding
+}
+
+type catalogFile struct {
+	Tables map[string]*CatalogTable `json:"tables"`
+}
+
+func (t *CatalogTable) ImportFormat() Format {
+	return t.importFormat
+}
+
+func (t *CatalogTable) ParsedDelimiter() (rune, []int) {
+	return t.delimiter, t.delimiterPositions
+}
+
+func (t *CatalogTable) ParsedEncoding() text.Encoding {
+	return t.encoding
+}
+
+func (t *CatalogTable) parseOptions() error {
+	var err error
+
+	if t.importFormat, err = ParseImportFormat(t.Format); err != nil {
+		return err
+	}
+
+	if 0 < len(t.Delimiter) {
+		var auto bool
+		if t.delimiter, t.delimiterPositions, auto, err = ParseDelimiter(t.Delimiter, ',', nil, false); err != nil {
+			return err
+		}
+		if t.importFormat == AutoSelect {
+			if auto || t.delimiterPositions != nil {
+				t.importFormat = FIXED
+			} else if t.delimiter == '\t' {
+				t.importFormat = TSV
+			} else {
+				t.importFormat = CSV
+			}
+		}
+	}
+
+	if 0 < len(t.Encoding) {
+		if t.encoding, err = ParseEncoding(t.Encoding); err != nil {
+			return err
+		}
+	}
+
+	if t.DecimalSeparator != nil {
+		if _, err = ParseDecimalSeparator(*t.DecimalSeparator); err != nil {
+			return err
+		}
+	}
+	if t.ThousandsSeparator != nil {
+		if _, err = ParseThousandsSeparator(*t.ThousandsSeparator); err != nil {
+			return err
+		}
+	}
+
+	t.JsonQuery = strings.TrimSpace(t.JsonQuery)
+	return nil
+}
+
+func LoadCatalog(fpath string) (*Catalog, error) {
+	if !file.Exists(fpath) {
+		return nil, errors.New(fmt.Sprintf("catalog file %q does not exist", fpath))
+	}
+
+	h, err := file.NewHandlerForRead(fpath)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to load %q: %s", fpath, err.Error()))
+	}
+	defer h.Close()
+
+	buf, err := ioutil.ReadAll(h.FileForRead())
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to load %q: %s", fpath, err.Error()))
+	}
+	buf = bytes.TrimSuffix(buf, []byte{0x00})
+
+	cf := &catalogFile{}
+	if err = json.Unmarshal(buf, cf); err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to load %q: %s", fpath, err.Error()))
+	}
+
+	dir := filepath.Dir(fpath)
+	catalog := &Catalog{
+		Path:   fpath,
+		Tables: make(map[string]*CatalogTable, len(cf.Tables)),
+	}
+	for name, t := range cf.Tables {
+		if t == nil || len(t.Path) < 1 {
+			return nil, errors.New(fmt.Sprintf("failed to load %q: path for table %q is not specified", fpath, name))
+		}
+
+		if err = t.parseOptions(); err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to load %q: %s for table %q", fpath, err.Error(), name))
+		}
+
+		if !filepath.IsAbs(t.Path) {
+			t.Path = filepath.Join(dir, t.Path)
+		}
+
+		key := strings.ToUpper(name)
+		if _, ok := catalog.Tables[key]; ok {
+			return nil, errors.New(fmt.Sprintf("failed to load %q: table %q is duplicated", fpath, name))
+		}
+		catalog.Tables[key] = t
+	}
+	return catalog, nil
+}
+
+func (c *Catalog) Get(name string) (*CatalogTable, bool) {
+	if c == nil {
+		return nil, false
+	}
+	t, ok := c.Tables[strings.ToUpper(name)]
+	return t, ok
+}
+
+func ParseImportFormat(s string) (Format, error) {
+	switch strings.ToUpper(s) {
+	case "":
+		return AutoSelect, nil
+	case "CSV":
+		return CSV, nil
+	case "TSV":
+		return TSV, nil
+	case "FIXED":
+		return FIXED, nil
+	case "JSON":
+		return JSON, nil
+	case "LTSV":
+		return LTSV, nil
+	}
+	return AutoSelect, errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV")
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/go-text"
+)
+
+var loadCatalogTests = []struct {
+	Name   string
+	File   string
+	Tables map[string]CatalogTable
+	Error  string
+}{
+	{
+		Name: "LoadCatalog",
+		File: "catalog.json",
+		Tables: map[string]CatalogTable{
+			"SALES":  {Path: "table1.csv", importFormat: AutoSelect},
+			"USERS":  {Path: "table3.tsv", importFormat: AutoSelect},
+			"ITEMS":  {Path: "table1.csv", Format: "tsv", importFormat: TSV},
+			"TABBED": {Path: "table1.csv", Delimiter: "\\t", importFormat: TSV, delimiter: '\t'},
+			"SJIS":   {Path: "table_sjis.csv", Encoding: "SJIS", importFormat: AutoSelect, encoding: text.SJIS},
+			"NUMBERS": {
+				Path:         "table5.csv",
+				NoHeader:     &trueValue,
+				WithoutNull:  &trueValue,
+				Columns:      []string{"id", "name"},
+				importFormat: AutoSelect,
+			},
+			"MISMATCH": {Path: "table1.csv", Columns: []string{"id"}, importFormat: AutoSelect},
+			"EUROPEAN": {
+				Path:               "table_eu.csv",
+				Delimiter:          ";",
+				DecimalSeparator:   &commaValue,
+				ThousandsSeparator: &periodValue,
+				importFormat:       CSV,
+				delimiter:          ';',
+			},
+		},
+	},
+	{
+		Name:  "LoadCatalog File Does Not Exist Error",
+		File:  "notexist.json",
+		Error: "catalog file %q does not exist",
+	},
+	{
+		Name:  "LoadCatalog Invalid Format Error",
+		File:  "catalog_invalid_format.json",
+		Error: "failed to load %q: import format must be one of CSV|TSV|FIXED|JSON|LTSV for table \"sales\"",
+	},
+	{
+		Name:  "LoadCatalog Invalid Encoding Error",
+		File:  "catalog_invalid_encoding.json",
+		Error: "failed to load %q: encoding must be one of UTF8|SJIS for table \"sales\"",
+	},
+}
+
+var trueValue = true
+var commaValue = ","
+var periodValue = "."
+
+func TestLoadCatalog(t *testing.T) {
+	for _, v := range loadCatalogTests {
+		fpath := filepath.Join(TestDataDir, v.File)
+
+		catalog, err := LoadCatalog(fpath)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if expect := fmt.Sprintf(v.Error, fpath); err.Error() != expect {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), expect)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if len(catalog.Tables) != len(v.Tables) {
+			t.Errorf("%s: %d tables, want %d tables", v.Name, len(catalog.Tables), len(v.Tables))
+		}
+		for name, expect := range v.Tables {
+			table, ok := catalog.Tables[name]
+			if !ok {
+				t.Errorf("%s: table %s is not loaded", v.Name, name)
+				continue
+			}
+			expect.Path = filepath.Join(TestDataDir, expect.Path)
+			if !reflect.DeepEqual(*table, expect) {
+				t.Errorf("%s: table %s = %v, want %v", v.Name, name, *table, expect)
+			}
+		}
+	}
+}
+
+func TestCatalog_Get(t *testing.T) {
+	catalog, _ := LoadCatalog(filepath.Join(TestDataDir, "catalog.json"))
+
+	if table, ok := catalog.Get("Sales"); !ok {
+		t.Errorf("table %q is not found", "Sales")
+	} else if table.Path != filepath.Join(TestDataDir, "table1.csv") {
+		t.Errorf("path = %q, want %q", table.Path, filepath.Join(TestDataDir, "table1.csv"))
+	}
+
+	if _, ok := catalog.Get("notexist"); ok {
+		t.Errorf("table %q is found, want not found", "notexist")
+	}
+
+	catalog = nil
+	if _, ok := catalog.Get("sales"); ok {
+		t.Errorf("table %q is found in nil catalog, want not found", "sales")
+	}
+}
@@ -3,12 +3,12 @@ package cmd
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/mithrandie/go-text"
@@ -28,56 +28,98 @@ const DelimiteAutomatically = "SPACES"
 
 const (
 	RepositoryFlag           = "REPOSITORY"
+	CatalogFlag              = "CATALOG"
+	CacheDirFlag             = "CACHE_DIR"
+	SourcePathFlag           = "SOURCE_PATH"
+	SourceRelativeFlag       = "SOURCE_RELATIVE"
 	TimezoneFlag             = "TIMEZONE"
 	DatetimeFormatFlag       = "DATETIME_FORMAT"
+	DecimalSeparatorFlag     = "DECIMAL_SEPARATOR"
+	ThousandsSeparatorFlag   = "THOUSANDS_SEPARATOR"
+	CollationFlag            = "COLLATION"
+	LanguageFlag             = "LANGUAGE"
 	WaitTimeoutFlag          = "WAIT_TIMEOUT"
+	MergeToolFlag            = "MERGE_TOOL"
+	ConflictDirFlag          = "CONFLICT_DIR"
+	GitCheckFlag             = "GIT_CHECK"
 	DelimiterFlag            = "DELIMITER"
 	JsonQueryFlag            = "JSON_QUERY"
 	EncodingFlag             = "ENCODING"
+	EncodingErrorsFlag       = "ENCODING_ERRORS"
 	NoHeaderFlag             = "NO_HEADER"
 	WithoutNullFlag          = "WITHOUT_NULL"
+	MaxFieldSizeFlag         = "MAX_FIELD_SIZE"
+	MaxRowSizeFlag           = "MAX_ROW_SIZE"
+	RecoverQuotesFlag        = "RECOVER_QUOTES"
 	FormatFlag               = "FORMAT"
 	WriteEncodingFlag        = "WRITE_ENCODING"
 	WriteDelimiterFlag       = "WRITE_DELIMITER"
 	WithoutHeaderFlag        = "WITHOUT_HEADER"
 	LineBreakFlag            = "LINE_BREAK"
+	NormalizeLineBreakFlag   = "NORMALIZE_LINE_BREAK"
 	EncloseAll               = "ENCLOSE_ALL"
+	QuoteStyleFlag           = "QUOTE_STYLE"
 	JsonEscape               = "JSON_ESCAPE"
 	PrettyPrintFlag          = "PRETTY_PRINT"
 	EastAsianEncodingFlag    = "EAST_ASIAN_ENCODING"
 	CountDiacriticalSignFlag = "COUNT_DIACRITICAL_SIGN"
 	CountFormatCodeFlag      = "COUNT_FORMAT_CODE"
 	ColorFlag                = "COLOR"
+	PlainFlag                = "PLAIN"
 	QuietFlag                = "QUIET"
 	CPUFlag                  = "CPU"
+	SortMemoryFlag           = "SORT_MEMORY"
 	StatsFlag                = "STATS"
+	StreamFlag               = "STREAM"
+	FilterOnLoadFlag         = "FILTER_ON_LOAD"
 )
 
 var FlagList = []string{
 	RepositoryFlag,
+	CatalogFlag,
+	CacheDirFlag,
+	SourcePathFlag,
+	SourceRelativeFlag,
 	TimezoneFlag,
 	DatetimeFormatFlag,
+	DecimalSeparatorFlag,
+	ThousandsSeparatorFlag,
+	CollationFlag,
+	LanguageFlag,
 	WaitTimeoutFlag,
+	MergeToolFlag,
+	ConflictDirFlag,
+	GitCheckFlag,
 	DelimiterFlag,
 	JsonQueryFlag,
 	EncodingFlag,
+	EncodingErrorsFlag,
 	NoHeaderFlag,
 	WithoutNullFlag,
+	MaxFieldSizeFlag,
+	MaxRowSizeFlag,
+	RecoverQuotesFlag,
 	FormatFlag,
 	WriteEncodingFlag,
 	WriteDelimiterFlag,
 	WithoutHeaderFlag,
 	LineBreakFlag,
+	NormalizeLineBreakFlag,
 	EncloseAll,
+	QuoteStyleFlag,
 	JsonEscape,
 	PrettyPrintFlag,
 	EastAsianEncodingFlag,
 	CountDiacriticalSignFlag,
 	CountFormatCodeFlag,
 	ColorFlag,
+	PlainFlag,
 	QuietFlag,
 	CPUFlag,
+	SortMemoryFlag,
 	StatsFlag,
+	StreamFlag,
+	FilterOnLoadFlag,
 }
 
 type Format int
@@ -119,6 +161,62 @@ func JsonEscapeTypeToString(escapeType txjson.EscapeType) string {
 	return JsonEscapeTypeLiteral[escapeType]
 }
 
+type GitCheckType int
+
+const (
+	GitCheckNone GitCheckType = iota
+	GitCheckWarn
+	GitCheckRefuse
+)
+
+var GitCheckTypeLiteral = map[GitCheckType]string{
+	GitCheckNone:   "NONE",
+	GitCheckWarn:   "WARN",
+	GitCheckRefuse: "REFUSE",
+}
+
+func (t GitCheckType) String() string {
+	return GitCheckTypeLiteral[t]
+}
+
+type EncodingErrorsType int
+
+const (
+	EncodingErrorsNotSet EncodingErrorsType = iota
+	EncodingErrorsStrict
+	EncodingErrorsReplace
+	EncodingErrorsIgnore
+)
+
+var EncodingErrorsTypeLiteral = map[EncodingErrorsType]string{
+	EncodingErrorsNotSet:  "",
+	EncodingErrorsStrict:  "STRICT",
+	EncodingErrorsReplace: "REPLACE",
+	EncodingErrorsIgnore:  "IGNORE",
+}
+
+func (t EncodingErrorsType) String() string {
+	return EncodingErrorsTypeLiteral[t]
+}
+
+type QuoteStyle int
+
+const (
+	QuoteStylePreserve QuoteStyle = iota
+	QuoteStyleMinimal
+	QuoteStyleAll
+)
+
+var QuoteStyleLiteral = map[QuoteStyle]string{
+	QuoteStylePreserve: "PRESERVE",
+	QuoteStyleMinimal:  "MINIMAL",
+	QuoteStyleAll:      "ALL",
+}
+
+func (s QuoteStyle) String() string {
+	return QuoteStyleLiteral[s]
+}
+
 const (
 	CsvExt      = ".csv"
 	TsvExt      = ".tsv"
@@ -133,27 +231,46 @@ const (
 
 type Flags struct {
 	// Common Settings
-	Repository     string
-	Location       string
-	DatetimeFormat []string
-	WaitTimeout    float64
+	Repository         string
+	Catalog            string
+	CacheDir           string
+	SourcePath         []string
+	SourceRelative     bool
+	Location           string
+	DatetimeFormat     []string
+	DecimalSeparator   string
+	ThousandsSeparator string
+	Collation          string
+	Language           string
+	WaitTimeout        float64
+	MergeTool          string
+	ConflictDir        string
+	GitCheck           GitCheckType
 
 	// For Import
-	Delimiter   rune
-	JsonQuery   string
-	Encoding    text.Encoding
-	NoHeader    bool
-	WithoutNull bool
+	Delimiter     rune
+	JsonQuery     string
+	Encoding      text.Encoding
+	NoHeader      bool
+	WithoutNull   bool
+	MaxFieldSize  int
+	MaxRowSize    int
+	RecoverQuotes bool
+
+	// For Import and Export
+	EncodingErrors EncodingErrorsType
 
 	// For Export
-	Format         Format
-	WriteEncoding  text.Encoding
-	WriteDelimiter rune
-	WithoutHeader  bool
-	LineBreak      text.LineBreak
-	EncloseAll     bool
-	JsonEscape     txjson.EscapeType
-	PrettyPrint    bool
+	Format             Format
+	WriteEncoding      text.Encoding
+	WriteDelimiter     rune
+	WithoutHeader      bool
+	LineBreak          text.LineBreak
+	NormalizeLineBreak bool
+	EncloseAll         bool
+	QuoteStyle         QuoteStyle
+	JsonEscape         txjson.EscapeType
+	PrettyPrint        bool
 
 	// For Calculation of String Width
 	EastAsianEncoding    bool
@@ -162,11 +279,16 @@ type Flags struct {
 
 	// ANSI Color Sequence
 	Color bool
+	// Plain Output without Decorations
+	Plain bool
 
 	// System Use
-	Quiet bool
-	CPU   int
-	Stats bool
+	Quiet        bool
+	CPU          int
+	SortMemory   int
+	Stats        bool
+	Stream       bool
+	FilterOnLoad bool
 
 	// For CSV
 	// For Fixed-Length Format
@@ -179,11 +301,15 @@ type Flags struct {
 
 	// Use in tests
 	Now string
+
+	catalog  *Catalog
+	location *time.Location
 }
 
 var (
-	flags    *Flags
-	getFlags sync.Once
+	flags       atomic.Value
+	getFlags    sync.Once
+	updateFlags sync.Mutex
 )
 
 func GetDefaultNumberOfCPU() int {
@@ -194,6 +320,8 @@ func GetDefaultNumberOfCPU() int {
 	return n
 }
 
+// GetFlags returns the current flags.
+// The returned flags must not be modified while statements are executed. Use UpdateFlags instead.
 func GetFlags() *Flags {
 	getFlags.Do(func() {
 		env, _ := GetEnvironment()
@@ -203,39 +331,102 @@ func GetFlags() *Flags {
 			datetimeFormat = AppendStrIfNotExist(datetimeFormat, v)
 		}
 
-		flags = &Flags{
+		flags.Store(&Flags{
 			Repository:              "",
+			Catalog:                 "",
+			CacheDir:                "",
+			SourcePath:              nil,
+			SourceRelative:          false,
 			Location:                "Local",
 			DatetimeFormat:          datetimeFormat,
+			DecimalSeparator:        ".",
+			ThousandsSeparator:      "",
+			Collation:               "NOCASE",
+			Language:                EnglishLanguage,
 			WaitTimeout:             10,
+			MergeTool:               "",
+			ConflictDir:             "",
+			GitCheck:                GitCheckNone,
 			Delimiter:               ',',
 			JsonQuery:               "",
 			Encoding:                text.UTF8,
 			NoHeader:                false,
 			WithoutNull:             false,
+			MaxFieldSize:            0,
+			MaxRowSize:              0,
+			RecoverQuotes:           false,
+			EncodingErrors:          EncodingErrorsNotSet,
 			Format:                  TEXT,
 			WriteEncoding:           text.UTF8,
 			WriteDelimiter:          ',',
 			WithoutHeader:           false,
 			LineBreak:               text.LF,
+			NormalizeLineBreak:      false,
 			EncloseAll:              false,
+			QuoteStyle:              QuoteStylePreserve,
 			JsonEscape:              txjson.Backslash,
 			PrettyPrint:             false,
 			EastAsianEncoding:       false,
 			CountDiacriticalSign:    false,
 			CountFormatCode:         false,
 			Color:                   false,
+			Plain:                   false,
 			Quiet:                   false,
 			CPU:                     GetDefaultNumberOfCPU(),
+			SortMemory:              0,
 			Stats:                   false,
+			Stream:                  false,
+			FilterOnLoad:            false,
 			DelimitAutomatically:    false,
 			DelimiterPositions:      nil,
 			WriteDelimiterPositions: nil,
 			RetryInterval:           10 * time.Millisecond,
 			Now:                     "",
-		}
+		})
 	})
-	return flags
+	return flags.Load().(*Flags)
+}
+
+// UpdateFlags applies fn to a copy of the current flags, and replaces the current
+// flags with the copy if fn succeeds.
+//
+// The flags returned by GetFlags are never modified by UpdateFlags, so goroutines
+// that are evaluating records can read the flags without locks while other flags
+// are being set.
+func UpdateFlags(fn func(*Flags) error) error {
+	updateFlags.Lock()
+	defer updateFlags.Unlock()
+
+	f := GetFlags().Copy()
+	if err := fn(f); err != nil {
+		return err
+	}
+	flags.Store(f)
+	return nil
+}
+
+// Copy returns a copy of the flags that does not share the slices with the original.
+func (f *Flags) Copy() *Flags {
+	c := *f
+	c.SourcePath = copyStrings(f.SourcePath)
+	c.DatetimeFormat = copyStrings(f.DatetimeFormat)
+	c.DelimiterPositions = copyInts(f.DelimiterPositions)
+	c.WriteDelimiterPositions = copyInts(f.WriteDelimiterPositions)
+	return &c
+}
+
+func copyStrings(list []string) []string {
+	if list == nil {
+		return nil
+	}
+	return append(make([]string, 0, len(list)), list...)
+}
+
+func copyInts(list []int) []int {
+	if list == nil {
+		return nil
+	}
+	return append(make([]int, 0, len(list)), list...)
 }
 
 func (f *Flags) SelectImportFormat() Format {
@@ -274,6 +465,79 @@ func (f *Flags) SetRepository(s string) error {
 	return nil
 }
 
+func (f *Flags) SetCatalog(s string) error {
+	if len(s) < 1 {
+		f.Catalog = ""
+		f.catalog = nil
+		return nil
+	}
+
+	path, err := filepath.Abs(s)
+	if err != nil {
+		path = s
+	}
+
+	catalog, err := LoadCatalog(path)
+	if err != nil {
+		return err
+	}
+
+	f.Catalog = path
+	f.catalog = catalog
+	return nil
+}
+
+func (f *Flags) SetCacheDir(s string) error {
+	if len(s) < 1 {
+		f.CacheDir = ""
+		return nil
+	}
+
+	path, err := filepath.Abs(s)
+	if err != nil {
+		path = s
+	}
+
+	stat, err := os.Stat(path)
+	if err != nil {
+		return errors.New("cache directory does not exist")
+	}
+	if !stat.IsDir() {
+		return errors.New("cache directory must be a directory path")
+	}
+
+	f.CacheDir = path
+	return nil
+}
+
+// SetSourcePath replaces the directories searched for modules with a list separated by os.PathListSeparator.
+func (f *Flags) SetSourcePath(s string) {
+	f.SourcePath = nil
+	f.AddSourcePath(s)
+}
+
+// AddSourcePath appends directories separated by os.PathListSeparator to the list searched for modules.
+func (f *Flags) AddSourcePath(s string) {
+	for _, dir := range filepath.SplitList(s) {
+		if len(dir) < 1 {
+			continue
+		}
+		if path, err := filepath.Abs(dir); err == nil {
+			dir = path
+		}
+		f.SourcePath = AppendStrIfNotExist(f.SourcePath, dir)
+	}
+}
+
+// SetSourceRelative sets whether relative paths in SOURCE statements are resolved from the directory of the including file.
+func (f *Flags) SetSourceRelative(b bool) {
+	f.SourceRelative = b
+}
+
+func (f *Flags) TableCatalog() *Catalog {
+	return f.catalog
+}
+
 func (f *Flags) SetLocation(s string) error {
 	if len(s) < 1 || strings.EqualFold(s, "Local") {
 		s = "Local"
@@ -281,13 +545,13 @@ func (f *Flags) SetLocation(s string) error {
 		s = "UTC"
 	}
 
-	location, err := time.LoadLocation(s)
+	location, err := LoadLocation(s)
 	if err != nil {
-		return errors.New(fmt.Sprintf("timezone %q does not exist", s))
+		return err
 	}
 
 	f.Location = s
-	time.Local = location
+	f.location = location
 	return nil
 }
 
@@ -302,8 +566,48 @@ func (f *Flags) SetDatetimeFormat(s string) {
 			f.DatetimeFormat = AppendStrIfNotExist(f.DatetimeFormat, v)
 		}
 	} else {
-		f.DatetimeFormat = append(f.DatetimeFormat, s)
+		f.DatetimeFormat = AppendStrIfNotExist(f.DatetimeFormat, s)
+	}
+}
+
+func (f *Flags) SetDecimalSeparator(s string) error {
+	sep, err := ParseDecimalSeparator(s)
+	if err != nil {
+		return err
 	}
+
+	f.DecimalSeparator = sep
+	return nil
+}
+
+func (f *Flags) SetThousandsSeparator(s string) error {
+	sep, err := ParseThousandsSeparator(s)
+	if err != nil {
+		return err
+	}
+
+	f.ThousandsSeparator = sep
+	return nil
+}
+
+func (f *Flags) SetCollation(s string) error {
+	c, err := ParseCollation(s)
+	if err != nil {
+		return err
+	}
+
+	f.Collation = c
+	return nil
+}
+
+func (f *Flags) SetLanguage(s string) error {
+	l, err := ParseLanguage(s)
+	if err != nil {
+		return err
+	}
+
+	f.Language = l
+	return nil
 }
 
 func (f *Flags) SetWaitTimeout(t float64) {
@@ -311,11 +615,58 @@ func (f *Flags) SetWaitTimeout(t float64) {
 		t = 0
 	}
 
-	flags.WaitTimeout = t
-	file.UpdateWaitTimeout(flags.WaitTimeout, flags.RetryInterval)
+	f.WaitTimeout = t
+	file.UpdateWaitTimeout(f.WaitTimeout, f.RetryInterval)
 	return
 }
 
+func (f *Flags) SetMergeTool(s string) {
+	f.MergeTool = strings.TrimSpace(s)
+}
+
+func (f *Flags) SetConflictDir(s string) error {
+	if len(s) < 1 {
+		f.ConflictDir = ""
+		return nil
+	}
+
+	path, err := filepath.Abs(s)
+	if err != nil {
+		path = s
+	}
+
+	stat, err := os.Stat(path)
+	if err != nil {
+		return errors.New("conflict directory does not exist")
+	}
+	if !stat.IsDir() {
+		return errors.New("conflict directory must be a directory path")
+	}
+
+	f.ConflictDir = path
+	return nil
+}
+
+func (f *Flags) SetGitCheck(s string) error {
+	t, err := ParseGitCheckType(s)
+	if err != nil {
+		return err
+	}
+
+	f.GitCheck = t
+	return nil
+}
+
+func (f *Flags) SetEncodingErrors(s string) error {
+	t, err := ParseEncodingErrorsType(s)
+	if err != nil {
+		return err
+	}
+
+	f.EncodingErrors = t
+	return nil
+}
+
 func (f *Flags) SetDelimiter(s string) error {
 	if len(s) < 1 {
 		return nil
@@ -358,6 +709,24 @@ func (f *Flags) SetWithoutNull(b bool) {
 	f.WithoutNull = b
 }
 
+func (f *Flags) SetMaxFieldSize(i int) {
+	if i < 0 {
+		i = 0
+	}
+	f.MaxFieldSize = i
+}
+
+func (f *Flags) SetMaxRowSize(i int) {
+	if i < 0 {
+		i = 0
+	}
+	f.MaxRowSize = i
+}
+
+func (f *Flags) SetRecoverQuotes(b bool) {
+	f.RecoverQuotes = b
+}
+
 func (f *Flags) SetFormat(s string, outfile string) error {
 	var fm Format
 	var escape txjson.EscapeType
@@ -365,7 +734,12 @@ func (f *Flags) SetFormat(s string, outfile string) error {
 
 	switch s {
 	case "":
-		switch strings.ToLower(filepath.Ext(outfile)) {
+		ext := strings.ToLower(filepath.Ext(outfile))
+		if IsHttpUrl(outfile) {
+			ext = JsonExt
+		}
+
+		switch ext {
 		case CsvExt:
 			fm = CSV
 		case TsvExt:
@@ -441,6 +815,10 @@ func (f *Flags) SetLineBreak(s string) error {
 	return nil
 }
 
+func (f *Flags) SetNormalizeLineBreak(b bool) {
+	f.NormalizeLineBreak = b
+}
+
 func (f *Flags) SetJsonEscape(s string) error {
 	var escape txjson.EscapeType
 	var err error
@@ -461,9 +839,24 @@ func (f *Flags) SetEncloseAll(b bool) {
 	f.EncloseAll = b
 }
 
+func (f *Flags) SetQuoteStyle(s string) error {
+	style, err := ParseQuoteStyle(s)
+	if err != nil {
+		return err
+	}
+
+	f.QuoteStyle = style
+	return nil
+}
+
 func (f *Flags) SetColor(b bool) {
 	f.Color = b
-	color.UseEffect = b
+	color.UseEffect = b && !f.Plain
+}
+
+func (f *Flags) SetPlain(b bool) {
+	f.Plain = b
+	color.UseEffect = f.Color && !b
 }
 
 func (f *Flags) SetEastAsianEncoding(b bool) {
@@ -494,6 +887,25 @@ func (f *Flags) SetCPU(i int) {
 	f.CPU = i
 }
 
+// SetSortMemory sets the size in bytes of memory used to hold sort keys of records sorted by ORDER BY clauses.
+// If the size is exceeded, the sort keys are written to temporary files. 0 means unlimited.
+func (f *Flags) SetSortMemory(i int) {
+	if i < 0 {
+		i = 0
+	}
+	f.SortMemory = i
+}
+
 func (f *Flags) SetStats(b bool) {
 	f.Stats = b
 }
+
+// SetStream sets whether simple select queries are executed without loading whole tables.
+func (f *Flags) SetStream(b bool) {
+	f.Stream = b
+}
+
+// SetFilterOnLoad sets whether the conditions in where clauses are evaluated while tables are read from files.
+func (f *Flags) SetFilterOnLoad(b bool) {
+	f.FilterOnLoad = b
+}
@@ -1,12 +1,16 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/color"
 	"github.com/mithrandie/go-text/json"
 
 	"github.com/mithrandie/csvq/lib/file"
@@ -77,6 +81,141 @@ func TestFlags_SetRepository(t *testing.T) {
 	}
 }
 
+func TestFlags_SetCatalog(t *testing.T) {
+	flags := GetFlags()
+
+	fpath := filepath.Join(TestDataDir, "catalog.json")
+	flags.SetCatalog(fpath)
+	if flags.Catalog != fpath {
+		t.Errorf("catalog = %s, expect to set %s for %s", flags.Catalog, fpath, fpath)
+	}
+	if _, ok := flags.TableCatalog().Get("sales"); !ok {
+		t.Errorf("catalog is not loaded for %s", fpath)
+	}
+
+	flags.SetCatalog("")
+	if flags.Catalog != "" {
+		t.Errorf("catalog = %s, expect to set %q for %q", flags.Catalog, "", "")
+	}
+	if flags.TableCatalog() != nil {
+		t.Errorf("catalog is not cleared for %q", "")
+	}
+
+	notexist, _ := filepath.Abs("notexist.json")
+	expectErr := fmt.Sprintf("catalog file %q does not exist", notexist)
+	err := flags.SetCatalog("notexist.json")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "notexist.json")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "notexist.json")
+	}
+}
+
+func TestFlags_SetCacheDir(t *testing.T) {
+	flags := GetFlags()
+
+	dir := filepath.Join("..", "..", "lib", "cmd")
+	absdir, _ := filepath.Abs(dir)
+	flags.SetCacheDir(dir)
+	if flags.CacheDir != absdir {
+		t.Errorf("cache directory = %s, expect to set %s for %s", flags.CacheDir, absdir, dir)
+	}
+
+	flags.SetCacheDir("")
+	if flags.CacheDir != "" {
+		t.Errorf("cache directory = %s, expect to set %q for %q", flags.CacheDir, "", "")
+	}
+
+	expectErr := "cache directory does not exist"
+	err := flags.SetCacheDir("notexists")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "notexists")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "notexists")
+	}
+
+	expectErr = "cache directory must be a directory path"
+	err = flags.SetCacheDir("flags_test.go")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "flags_test.go")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "flags_test.go")
+	}
+}
+
+func TestFlags_SetMergeTool(t *testing.T) {
+	flags := GetFlags()
+
+	s := " vimdiff ${MERGED} ${THEIRS} "
+	flags.SetMergeTool(s)
+	if flags.MergeTool != "vimdiff ${MERGED} ${THEIRS}" {
+		t.Errorf("merge tool = %q, expect to set %q for %q", flags.MergeTool, "vimdiff ${MERGED} ${THEIRS}", s)
+	}
+
+	flags.SetMergeTool("")
+	if flags.MergeTool != "" {
+		t.Errorf("merge tool = %q, expect to set %q for %q", flags.MergeTool, "", "")
+	}
+}
+
+func TestFlags_SetConflictDir(t *testing.T) {
+	flags := GetFlags()
+
+	dir := filepath.Join("..", "..", "lib", "cmd")
+	absdir, _ := filepath.Abs(dir)
+	flags.SetConflictDir(dir)
+	if flags.ConflictDir != absdir {
+		t.Errorf("conflict directory = %s, expect to set %s for %s", flags.ConflictDir, absdir, dir)
+	}
+
+	flags.SetConflictDir("")
+	if flags.ConflictDir != "" {
+		t.Errorf("conflict directory = %s, expect to set %q for %q", flags.ConflictDir, "", "")
+	}
+
+	expectErr := "conflict directory does not exist"
+	err := flags.SetConflictDir("notexists")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "notexists")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "notexists")
+	}
+
+	expectErr = "conflict directory must be a directory path"
+	err = flags.SetConflictDir("flags_test.go")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "flags_test.go")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "flags_test.go")
+	}
+}
+
+func TestFlags_SetSourcePath(t *testing.T) {
+	flags := GetFlags()
+
+	dir1, _ := filepath.Abs("lib")
+	dir2, _ := filepath.Abs(filepath.Join("..", "share"))
+	s := strings.Join([]string{"lib", "", filepath.Join("..", "share"), "lib"}, string(os.PathListSeparator))
+
+	flags.SetSourcePath(s)
+	expect := []string{dir1, dir2}
+	if !reflect.DeepEqual(flags.SourcePath, expect) {
+		t.Errorf("source path = %q, expect to set %q for %q", flags.SourcePath, expect, s)
+	}
+
+	dir3, _ := filepath.Abs("modules")
+	flags.AddSourcePath("modules")
+	expect = []string{dir1, dir2, dir3}
+	if !reflect.DeepEqual(flags.SourcePath, expect) {
+		t.Errorf("source path = %q, expect to set %q for %q", flags.SourcePath, expect, "modules")
+	}
+
+	flags.SetSourcePath("")
+	if flags.SourcePath != nil {
+		t.Errorf("source path = %q, expect to set %v for %q", flags.SourcePath, nil, "")
+	}
+}
+
 func TestFlags_SetLocation(t *testing.T) {
 	flags := GetFlags()
 
@@ -138,6 +277,18 @@ func TestFlags_SetDatetimeFormat(t *testing.T) {
 	if !reflect.DeepEqual(flags.DatetimeFormat, expect) {
 		t.Errorf("datetime format = %s, expect to set %s", flags.DatetimeFormat, expect)
 	}
+
+	format = "02/01/2006 15:04"
+	flags.SetDatetimeFormat(format)
+	flags.SetDatetimeFormat(format)
+	expect = []string{
+		"%Y-%m-%d",
+		"%Y-%m-%d %H:%i:%s",
+		"02/01/2006 15:04",
+	}
+	if !reflect.DeepEqual(flags.DatetimeFormat, expect) {
+		t.Errorf("datetime format = %s, expect to set %s", flags.DatetimeFormat, expect)
+	}
 }
 
 func TestFlags_SetWaitTimeout(t *testing.T) {
@@ -160,6 +311,150 @@ func TestFlags_SetWaitTimeout(t *testing.T) {
 	}
 }
 
+func TestFlags_SetGitCheck(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetGitCheck("warn")
+	if flags.GitCheck != GitCheckWarn {
+		t.Errorf("git check = %s, expect to set %s for %s", flags.GitCheck, GitCheckWarn, "warn")
+	}
+
+	flags.SetGitCheck("REFUSE")
+	if flags.GitCheck != GitCheckRefuse {
+		t.Errorf("git check = %s, expect to set %s for %s", flags.GitCheck, GitCheckRefuse, "REFUSE")
+	}
+
+	flags.SetGitCheck("none")
+	if flags.GitCheck != GitCheckNone {
+		t.Errorf("git check = %s, expect to set %s for %s", flags.GitCheck, GitCheckNone, "none")
+	}
+
+	expectErr := "git-check must be one of NONE|WARN|REFUSE"
+	err := flags.SetGitCheck("error")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "error")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
+	}
+}
+
+func TestFlags_SetDecimalSeparator(t *testing.T) {
+	flags := GetFlags()
+	defer func() { flags.DecimalSeparator = "." }()
+
+	flags.SetDecimalSeparator(",")
+	if flags.DecimalSeparator != "," {
+		t.Errorf("decimal separator = %q, expect to set %q for %q", flags.DecimalSeparator, ",", ",")
+	}
+
+	expectErr := "decimal separator must be one of .|,"
+	err := flags.SetDecimalSeparator(";")
+	if err == nil {
+		t.Errorf("no error, want error %q for %q", expectErr, ";")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %q", err.Error(), expectErr, ";")
+	}
+}
+
+func TestFlags_SetThousandsSeparator(t *testing.T) {
+	flags := GetFlags()
+	defer func() { flags.ThousandsSeparator = "" }()
+
+	flags.SetThousandsSeparator("'")
+	if flags.ThousandsSeparator != "'" {
+		t.Errorf("thousands separator = %q, expect to set %q for %q", flags.ThousandsSeparator, "'", "'")
+	}
+
+	flags.SetThousandsSeparator("")
+	if flags.ThousandsSeparator != "" {
+		t.Errorf("thousands separator = %q, expect to set %q for %q", flags.ThousandsSeparator, "", "")
+	}
+
+	expectErr := "thousands separator must be one of ,|.|'|_|space or an empty string"
+	err := flags.SetThousandsSeparator(";")
+	if err == nil {
+		t.Errorf("no error, want error %q for %q", expectErr, ";")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %q", err.Error(), expectErr, ";")
+	}
+}
+
+func TestFlags_SetCollation(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetCollation("binary")
+	if flags.Collation != "BINARY" {
+		t.Errorf("collation = %s, expect to set %s for %s", flags.Collation, "BINARY", "binary")
+	}
+
+	flags.SetCollation("NOCASE")
+	if flags.Collation != "NOCASE" {
+		t.Errorf("collation = %s, expect to set %s for %s", flags.Collation, "NOCASE", "NOCASE")
+	}
+
+	expectErr := "collation must be one of BINARY|NOCASE|UNICODE|UNICODE_CI|NATURAL"
+	err := flags.SetCollation("error")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "error")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
+	}
+}
+
+func TestFlags_SetLanguage(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetLanguage("JA")
+	if flags.Language != "ja" {
+		t.Errorf("language = %s, expect to set %s for %s", flags.Language, "ja", "JA")
+	}
+
+	flags.SetLanguage("en")
+	if flags.Language != "en" {
+		t.Errorf("language = %s, expect to set %s for %s", flags.Language, "en", "en")
+	}
+
+	expectErr := "language must be one of en|ja"
+	err := flags.SetLanguage("error")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "error")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
+	}
+}
+
+func TestFlags_SetEncodingErrors(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetEncodingErrors("replace")
+	if flags.EncodingErrors != EncodingErrorsReplace {
+		t.Errorf("encoding errors = %s, expect to set %s for %s", flags.EncodingErrors, EncodingErrorsReplace, "replace")
+	}
+
+	flags.SetEncodingErrors("IGNORE")
+	if flags.EncodingErrors != EncodingErrorsIgnore {
+		t.Errorf("encoding errors = %s, expect to set %s for %s", flags.EncodingErrors, EncodingErrorsIgnore, "IGNORE")
+	}
+
+	flags.SetEncodingErrors("strict")
+	if flags.EncodingErrors != EncodingErrorsStrict {
+		t.Errorf("encoding errors = %s, expect to set %s for %s", flags.EncodingErrors, EncodingErrorsStrict, "strict")
+	}
+
+	flags.SetEncodingErrors("")
+	if flags.EncodingErrors != EncodingErrorsNotSet {
+		t.Errorf("encoding errors = %s, expect to set %s for %q", flags.EncodingErrors, EncodingErrorsNotSet, "")
+	}
+
+	expectErr := "encoding-errors must be one of STRICT|REPLACE|IGNORE or an empty string"
+	err := flags.SetEncodingErrors("error")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "error")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
+	}
+}
+
 func TestFlags_SetDelimiter(t *testing.T) {
 	flags := GetFlags()
 
@@ -250,6 +545,43 @@ func TestFlags_SetWithoutNull(t *testing.T) {
 	}
 }
 
+func TestFlags_SetMaxFieldSize(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetMaxFieldSize(1024)
+	if flags.MaxFieldSize != 1024 {
+		t.Errorf("max-field-size = %d, expect to set %d", flags.MaxFieldSize, 1024)
+	}
+
+	flags.SetMaxFieldSize(-1)
+	if flags.MaxFieldSize != 0 {
+		t.Errorf("max-field-size = %d, expect to set %d", flags.MaxFieldSize, 0)
+	}
+}
+
+func TestFlags_SetMaxRowSize(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetMaxRowSize(1024)
+	if flags.MaxRowSize != 1024 {
+		t.Errorf("max-row-size = %d, expect to set %d", flags.MaxRowSize, 1024)
+	}
+
+	flags.SetMaxRowSize(-1)
+	if flags.MaxRowSize != 0 {
+		t.Errorf("max-row-size = %d, expect to set %d", flags.MaxRowSize, 0)
+	}
+}
+
+func TestFlags_SetRecoverQuotes(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetRecoverQuotes(true)
+	if !flags.RecoverQuotes {
+		t.Errorf("recover-quotes = %t, expect to set %t", flags.RecoverQuotes, true)
+	}
+}
+
 func TestFlags_SetFormat(t *testing.T) {
 	flags := GetFlags()
 
@@ -293,6 +625,11 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, ORG, "foo.org")
 	}
 
+	flags.SetFormat("", "http://localhost:8080/ingest")
+	if flags.Format != JSON {
+		t.Errorf("format = %s, expect to set %s for empty string with url %q", flags.Format, JSON, "http://localhost:8080/ingest")
+	}
+
 	flags.SetFormat("csv", "")
 	if flags.Format != CSV {
 		t.Errorf("format = %s, expect to set %s for %s", flags.Format, CSV, "csv")
@@ -452,6 +789,33 @@ func TestFlags_SetEncloseAll(t *testing.T) {
 	}
 }
 
+func TestFlags_SetQuoteStyle(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetQuoteStyle("minimal")
+	if flags.QuoteStyle != QuoteStyleMinimal {
+		t.Errorf("quote style = %s, expect to set %s for %s", flags.QuoteStyle, QuoteStyleMinimal, "minimal")
+	}
+
+	flags.SetQuoteStyle("ALL")
+	if flags.QuoteStyle != QuoteStyleAll {
+		t.Errorf("quote style = %s, expect to set %s for %s", flags.QuoteStyle, QuoteStyleAll, "ALL")
+	}
+
+	flags.SetQuoteStyle("preserve")
+	if flags.QuoteStyle != QuoteStylePreserve {
+		t.Errorf("quote style = %s, expect to set %s for %s", flags.QuoteStyle, QuoteStylePreserve, "preserve")
+	}
+
+	expectErr := "quote-style must be one of PRESERVE|MINIMAL|ALL"
+	err := flags.SetQuoteStyle("none")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "none")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "none")
+	}
+}
+
 func TestFlags_SetJsonEscape(t *testing.T) {
 	flags := GetFlags()
 
@@ -483,6 +847,16 @@ func TestFlags_SetJsonEscape(t *testing.T) {
 	}
 }
 
+func TestFlags_SetNormalizeLineBreak(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetNormalizeLineBreak(true)
+	if !flags.NormalizeLineBreak {
+		t.Errorf("normalize-line-break = %t, expect to set %t", flags.NormalizeLineBreak, true)
+	}
+	flags.SetNormalizeLineBreak(false)
+}
+
 func TestFlags_SetPrettyPrint(t *testing.T) {
 	flags := GetFlags()
 
@@ -529,6 +903,25 @@ func TestFlags_SetColor(t *testing.T) {
 	flags.SetColor(false)
 }
 
+func TestFlags_SetPlain(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetColor(true)
+	flags.SetPlain(true)
+	if !flags.Plain {
+		t.Errorf("plain = %t, expect to set %t", flags.Plain, true)
+	}
+	if color.UseEffect {
+		t.Errorf("use effect = %t, expect to set %t", color.UseEffect, false)
+	}
+
+	flags.SetPlain(false)
+	if !color.UseEffect {
+		t.Errorf("use effect = %t, expect to set %t", color.UseEffect, true)
+	}
+	flags.SetColor(false)
+}
+
 func TestFlags_SetQuiet(t *testing.T) {
 	flags := GetFlags()
 
@@ -561,3 +954,41 @@ func TestFlags_SetStats(t *testing.T) {
 		t.Errorf("stats = %t, expect to set %t", flags.Stats, true)
 	}
 }
+
+func TestUpdateFlags(t *testing.T) {
+	flags := GetFlags()
+	flags.DatetimeFormat = []string{"%Y"}
+	flags.NoHeader = false
+
+	err := UpdateFlags(func(f *Flags) error {
+		f.DatetimeFormat = append(f.DatetimeFormat, "%m")
+		f.NoHeader = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	if !reflect.DeepEqual(flags.DatetimeFormat, []string{"%Y"}) || flags.NoHeader {
+		t.Errorf("flags being used are modified")
+	}
+
+	updated := GetFlags()
+	if !reflect.DeepEqual(updated.DatetimeFormat, []string{"%Y", "%m"}) || !updated.NoHeader {
+		t.Errorf("flags = %v, want updated flags", updated)
+	}
+
+	err = UpdateFlags(func(f *Flags) error {
+		f.NoHeader = false
+		return fmt.Errorf("error")
+	})
+	if err == nil {
+		t.Fatalf("no error, want error")
+	}
+	if GetFlags() != updated {
+		t.Errorf("flags are replaced when an error occurred")
+	}
+
+	updated.DatetimeFormat = []string{}
+	updated.NoHeader = false
+}
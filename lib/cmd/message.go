@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"strings"
+)
+
+const (
+	EnglishLanguage  = "en"
+	JapaneseLanguage = "ja"
+)
+
+// messageCatalogs holds the translations of messages for each language.
+// Messages are looked up by their English text, and messages that are not
+// in a catalog are shown in English.
+var messageCatalogs = map[string]map[string]string{
+	JapaneseLanguage: japaneseMessages,
+}
+
+func ParseLanguage(s string) (string, error) {
+	l := strings.ToLower(s)
+	switch l {
+	case EnglishLanguage, JapaneseLanguage:
+	default:
+		return l, errors.New("language must be one of en|ja")
+	}
+	return l, nil
+}
+
+// DetectLanguage returns the language of the locale specified by the environment variables
+// LC_ALL, LC_MESSAGES and LANG. If the language is not supported, then returns English.
+func DetectLanguage() string {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		locale := os.Getenv(name)
+		if len(locale) < 1 {
+			continue
+		}
+
+		if i := strings.IndexAny(locale, "_.@"); -1 < i {
+			locale = locale[:i]
+		}
+		if l, err := ParseLanguage(locale); err == nil {
+			return l
+		}
+		break
+	}
+	return EnglishLanguage
+}
+
+// Message returns the translation of the message in the language specified by the flag.
+func Message(s string) string {
+	if catalog, ok := messageCatalogs[GetFlags().Language]; ok {
+		if t, ok := catalog[s]; ok {
+			return t
+		}
+	}
+	return s
+}
@@ -0,0 +1,186 @@
+package cmd
+
+var japaneseMessages = map[string]string{
+	// Errors
+	"%s: cannot evaluate as a value":                   "%s: 値として評価できません",
+	"%s cannot be converted to a number":               "%s は数値に変換できません",
+	"failed to read from file: %s":                     "ファイルの読み込みに失敗しました: %s",
+	"failed to write to file: %s":                      "ファイルへの書き込みに失敗しました: %s",
+	"failed to send the result to %s: %s":              "%s への結果の送信に失敗しました: %s",
+	"failed to commit: %s":                             "コミットに失敗しました: %s",
+	"failed to rollback: %s":                           "ロールバックに失敗しました: %s",
+	"file %s has been modified by another application": "ファイル %s は他のアプリケーションによって変更されています",
+	"file %s has been modified by another application, and files to resolve the conflict are saved in %s": "ファイル %s は他のアプリケーションによって変更されています。競合を解決するためのファイルは %s に保存されました",
+	"file %s has uncommitted modifications in git":                                                        "ファイル %s には git にコミットされていない変更があります",
+	"field %s is ambiguous":                                                      "フィールド %s は曖昧です",
+	"field %s does not exist":                                                    "フィールド %s は存在しません",
+	"field %s is not a group key":                                                "フィールド %s はグループキーではありません",
+	"field name %s is a duplicate":                                               "フィールド名 %s が重複しています",
+	"function %s cannot aggregate not grouping records":                          "関数 %s はグループ化されていないレコードを集計できません",
+	"variable %s is undeclared":                                                  "変数 %s は宣言されていません",
+	"variable %s is redeclared":                                                  "変数 %s は再宣言されています",
+	"function %s does not exist":                                                 "関数 %s は存在しません",
+	"function %s takes %s":                                                       "関数 %s の引数は %s です",
+	"%s for function %s":                                                         "関数 %[2]s に対して %[1]s",
+	"function %s cannot be used as a statement":                                  "関数 %s は文として使用できません",
+	"aggregate functions are nested at %s":                                       "%s で集約関数が入れ子になっています",
+	"function %s does not return a table":                                        "関数 %s はテーブルを返しません",
+	"function %s is redeclared":                                                  "関数 %s は再宣言されています",
+	"function %s is a built-in function":                                         "関数 %s は組み込み関数です",
+	"parameter %s is a duplicate":                                                "パラメータ %s が重複しています",
+	"subquery returns too many records, should return only one record":           "サブクエリが返すレコードが多すぎます。レコードは 1 件のみ返す必要があります",
+	"subquery returns too many fields, should return only one field":             "サブクエリが返すフィールドが多すぎます。フィールドは 1 つのみ返す必要があります",
+	"json query returns too many records, should return only one record":         "JSON クエリが返すレコードが多すぎます。レコードは 1 件のみ返す必要があります",
+	"json query error: %s":                                                       "JSON クエリのエラー: %s",
+	"json query is empty":                                                        "JSON クエリが空です",
+	"json table is empty":                                                        "JSON テーブルが空です",
+	"revision of table %s is empty":                                              "テーブル %s のリビジョンが空です",
+	"failed to read %s at revision %s: %s":                                       "リビジョン %[2]s の %[1]s の読み込みに失敗しました: %[3]s",
+	"%s: value is not an array":                                                  "%s: 値が配列ではありません",
+	"%s: %s is not a number or a datetime":                                       "%s: %s は数値でも日時でもありません",
+	"%s: %s is not a valid step":                                                 "%s: %s は有効な増分ではありません",
+	"%s: series exceeds the limit of %d values":                                  "%s: 数列が上限の %d 個を超えています",
+	"%s: %s format is not supported, only CSV and TSV files can be followed":     "%s: %s 形式はサポートされていません。追跡できるのは CSV と TSV ファイルのみです",
+	"%s can only be used as the only table in the from clause of a select query": "%s は SELECT クエリの FROM 句の唯一のテーブルとしてのみ使用できます",
+	"table object %s can only be used as the only table in the from clause of a select query": "テーブルオブジェクト %s は SELECT クエリの FROM 句の唯一のテーブルとしてのみ使用できます",
+	"%s cannot be used in an incremental query":                                               "%s はインクリメンタルクエリでは使用できません",
+	"failed to use state file %s: %s":                                                         "状態ファイル %s を使用できませんでした: %s",
+	"failed to sort records using temporary files: %s":                                        "一時ファイルを使用したレコードのソートに失敗しました: %s",
+	"%s: catalog defines %s, but the table has %s":                                            "%s: カタログには %s が定義されていますが、テーブルには %s があります",
+	"%s cannot be joined with %s OUTER JOIN":                                                  "%s は %s OUTER JOIN で結合できません",
+	"collations %s and %s are in conflict":                                                    "照合順序 %s と %s が競合しています",
+	"invalid table object: %s":                                                                "無効なテーブルオブジェクトです: %s",
+	"invalid delimiter: %s":                                                                   "無効な区切り文字です: %s",
+	"invalid delimiter positions: %s":                                                         "無効な区切り位置です: %s",
+	"invalid json query: %s":                                                                  "無効な JSON クエリです: %s",
+	"table object %s takes at most %d arguments":                                              "テーブルオブジェクト %s の引数は最大 %d 個です",
+	"table object %s takes exactly %d arguments":                                              "テーブルオブジェクト %s の引数はちょうど %d 個です",
+	"invalid argument for %s: %s":                                                             "%s の引数が無効です: %s",
+	"cursor %s is redeclared":                                                                 "カーソル %s は再宣言されています",
+	"cursor %s is undeclared":                                                                 "カーソル %s は宣言されていません",
+	"statement %s is redeclared":                                                              "ステートメント %s は再宣言されています",
+	"statement %s is undeclared":                                                              "ステートメント %s は宣言されていません",
+	"statement %s takes %s, but %s specified":                                                 "ステートメント %s は %s を取りますが、%s が指定されました",
+	"named value %s is allowed only for a prepared statement":                                 "名前付きの値 %s はプリペアドステートメントでのみ使用できます",
+	"value for placeholder %s is not specified":                                               "プレースホルダ %s の値が指定されていません",
+	"%s is not a valid identifier for placeholder %s":                                         "%s はプレースホルダ %s の識別子として有効ではありません",
+	"statement takes %s, but %s specified":                                                    "ステートメントは %s を取りますが、%s が指定されました",
+	"trigger %s is redeclared":                                                                "トリガー %s は再宣言されています",
+	"trigger %s is undeclared":                                                                "トリガー %s は宣言されていません",
+	"table %s cannot be modified in trigger %s":                                               "テーブル %s はトリガー %s の中で変更できません",
+	"cursor %s is closed":                                                                     "カーソル %s は閉じられています",
+	"cursor %s is already open":                                                               "カーソル %s はすでに開かれています",
+	"cursor %s is a pseudo cursor":                                                            "カーソル %s は疑似カーソルです",
+	"fetching from cursor %s returns %s":                                                      "カーソル %s からのフェッチは %s を返します",
+	"fetching position %s is not an integer value":                                            "フェッチ位置 %s は整数値ではありません",
+	"fetching bulk size %s is not a positive integer value":                                   "フェッチのバルクサイズ %s は正の整数値ではありません",
+	"inline table %s is redefined":                                                            "インラインテーブル %s は再定義されています",
+	"inline table %s is undefined":                                                            "インラインテーブル %s は定義されていません",
+	"select query should return exactly %s for inline table %s":                               "インラインテーブル %[2]s に対して SELECT クエリはちょうど %[1]s を返す必要があります",
+	"file %s does not exist":                                                                  "ファイル %s は存在しません",
+	"%s is a invalid module name":                                                             "%s は無効なモジュール名です",
+	"module %s is not found in the source path":                                               "モジュール %s はソースパスに見つかりません",
+	"file %s already exists":                                                                  "ファイル %s はすでに存在します",
+	"file %s is unable to be read":                                                            "ファイル %s を読み込めません",
+	"file %s: lock wait timeout period exceeded":                                              "ファイル %s: ロック待ちがタイムアウトしました",
+	"filename %s is ambiguous":                                                                "ファイル名 %s は曖昧です",
+	"data parse error in file %s: %s":                                                         "ファイル %s のデータ解析エラー: %s",
+	"select query should return exactly %s for table %s":                                      "テーブル %[2]s に対して SELECT クエリはちょうど %[1]s を返す必要があります",
+	"view %s is redeclared":                                                                   "ビュー %s は再宣言されています",
+	"view %s is undeclared":                                                                   "ビュー %s は宣言されていません",
+	"select query should return exactly %s for view %s":                                       "ビュー %[2]s に対して SELECT クエリはちょうど %[1]s を返す必要があります",
+	"table name %s is a duplicate":                                                            "テーブル名 %s が重複しています",
+	"%s of table %s is violated by values (%s)":                                               "テーブル %[2]s の %[1]s が値 (%[3]s) によって違反されています",
+	"%s of field %s in table %s is violated by values (%s)":                                   "テーブル %[3]s のフィールド %[2]s の %[1]s が値 (%[4]s) によって違反されています",
+	"%s of table %s is violated by duplicate values (%s)":                                     "テーブル %[2]s の %[1]s が重複した値 (%[3]s) によって違反されています",
+	"%s is an unknown column type":                                                            "%s は不明な列の型です",
+	"field %s cannot be converted to %s at %s":                                                "%[3]s でフィールド %[1]s を %[2]s に変換できません",
+	"table %s is not loaded":                                                                  "テーブル %s は読み込まれていません",
+	"stdin is empty":                                                                          "標準入力が空です",
+	"row value should contain exactly %s":                                                     "行値はちょうど %s を含む必要があります",
+	"select query should return exactly %s":                                                   "SELECT クエリはちょうど %s を返す必要があります",
+	"limit percentage %s is not a float value":                                                "LIMIT のパーセンテージ %s は浮動小数点数ではありません",
+	"limit number of records %s is not an integer value":                                      "LIMIT のレコード数 %s は整数値ではありません",
+	"offset number %s is not an integer value":                                                "OFFSET の数 %s は整数値ではありません",
+	"result set to be combined should contain exactly %s":                                     "結合される結果セットはちょうど %s を含む必要があります",
+	"field %s does not exist in the tables to update":                                         "フィールド %s は更新するテーブルに存在しません",
+	"value %s to set in the field %s is ambiguous":                                            "フィールド %[2]s に設定する値 %[1]s は曖昧です",
+	"tables to delete records are not specified":                                              "レコードを削除するテーブルが指定されていません",
+	"object type %s is invalid":                                                               "オブジェクトの種類 %s は無効です",
+	"%s is a invalid file path":                                                               "%s は無効なファイルパスです",
+	"%s is an unknown flag":                                                                   "%s は不明なフラグです",
+	"%s for %s is not allowed":                                                                "%[2]s に %[1]s は指定できません",
+	"add flag element syntax does not support %s":                                             "フラグ要素の追加構文は %s をサポートしていません",
+	"remove flag element syntax does not support %s":                                          "フラグ要素の削除構文は %s をサポートしていません",
+	"%s is an invalid value for %s to specify the element":                                    "%s は %s の要素を指定する値として無効です",
+	"%s is an unknown runtime information":                                                    "%s は不明なランタイム情報です",
+	"failed to fetch the latest version: %s":                                                  "最新バージョンを取得できませんでした: %s",
+	"timezone %q does not exist":                                                              "タイムゾーン %q は存在しません",
+	"view has no attributes":                                                                  "ビューには属性がありません",
+	"table attribute %s does not exist":                                                       "テーブル属性 %s は存在しません",
+	"%s is an unknown event":                                                                  "%s は不明なイベントです",
+	"assertion %s failed":                                                                     "アサーション %s が失敗しました",
+	"fields %s do not equal the expected fields %s":                                           "フィールド %s が期待されるフィールド %s と一致しません",
+	"query returns %s, expected %s":                                                           "クエリが %s を返しました。期待される結果は %s です",
+	"record %d is %s, expected %s":                                                            "レコード %d は %s です。期待される値は %s です",
+	"label %s is undeclared":                                                                  "ラベル %s は宣言されていません",
+	"internal record id does not exist":                                                       "内部レコード ID が存在しません",
+	"internal record id is empty":                                                             "内部レコード ID が空です",
+	"field length does not match":                                                             "フィールド数が一致しません",
+	"row value length does not match at index %d":                                             "インデックス %d で行値の長さが一致しません",
+	"number of replace values does not match":                                                 "置換する値の数が一致しません",
+	"%q is an unknown placeholder":                                                            "%q は不明なプレースホルダです",
+	"unexpected termination of format string":                                                 "フォーマット文字列が予期せず終了しました",
+	"external command: %s":                                                                    "外部コマンド: %s",
+	"%s is an unknown reload type":                                                            "%s は不明なリロードの種類です",
+	"configuration loading error: %s":                                                         "設定の読み込みエラー: %s",
+
+	// Logs
+	"%s inserted on %q.":                       "%[2]q に %[1]s を挿入しました。",
+	"%s updated on %q.":                        "%[2]q の %[1]s を更新しました。",
+	"%s deleted on %q.":                        "%[2]q の %[1]s を削除しました。",
+	"%s exported to %q.":                       "%[2]q に %[1]s をエクスポートしました。",
+	"file %q is created.":                      "ファイル %q を作成しました。",
+	"%s added on %q.":                          "%[2]q に %[1]s を追加しました。",
+	"%s dropped on %q.":                        "%[2]q の %[1]s を削除しました。",
+	"%s renamed on %q.":                        "%[2]q の %[1]s の名前を変更しました。",
+	"%s altered on %q.":                        "%[2]q の %[1]s を変更しました。",
+	"Table attributes of %s remain unchanged.": "%s のテーブル属性は変更されていません。",
+	"Commit: file %q is created.":              "コミット: ファイル %q を作成しました。",
+	"Commit: file %q is updated.":              "コミット: ファイル %q を更新しました。",
+	"Commit: %s.":                              "コミット: %s。",
+	"Commit: conflict in file %q is resolved by the merge tool.": "コミット: ファイル %q の競合をマージツールで解決しました。",
+	"Commit: restore point of view %q is created.":               "コミット: ビュー %q の復元ポイントを作成しました。",
+	"Rollback: file %q is deleted.":                              "ロールバック: ファイル %q を削除しました。",
+	"Rollback: file %q is restored.":                             "ロールバック: ファイル %q を復元しました。",
+	"Rollback: view %q is restored.":                             "ロールバック: ビュー %q を復元しました。",
+	"%s not encodable in %s":                                     "%[2]s でエンコードできない %[1]s",
+	"%s replaced":                                                "%s を置換しました",
+	"%s ignored":                                                 "%s を無視しました",
+	"cannot detect filepath: %q":                                 "ファイルパスを特定できません: %q",
+	"csvq %s is already the latest version":                      "csvq %s は最新バージョンです",
+	"csvq is updated from %s to %s":                              "csvq を %s から %s に更新しました",
+	"%s found":                                                   "%s が見つかりました",
+	"No errors found.":                                           "エラーは見つかりませんでした。",
+	"Watching %s for modifications.":                             "%s の変更を監視しています。",
+	"No test files found.":                                       "テストファイルが見つかりませんでした。",
+	"%d of %s failed":                                            "%[2]s のうち %[1]d 個が失敗しました",
+	"%s passed.":                                                 "%s が成功しました。",
+	"exited with code %d":                                        "終了コード %d で終了しました",
+
+	// Counts
+	"no %s":     "0 %s",
+	"%d %ss":    "%d %s",
+	"argument":  "個の引数",
+	"byte":      "バイト",
+	"character": "文字",
+	"error":     "件のエラー",
+	"column":    "列",
+	"field":     "個のフィールド",
+	"file":      "個のファイル",
+	"record":    "件のレコード",
+	"test file": "個のテストファイル",
+	"value":     "個の値",
+	"Table":     "テーブル",
+	"View":      "ビュー",
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+var detectLanguageTests = []struct {
+	Env    map[string]string
+	Expect string
+}{
+	{
+		Env:    map[string]string{},
+		Expect: "en",
+	},
+	{
+		Env:    map[string]string{"LANG": "ja_JP.UTF-8"},
+		Expect: "ja",
+	},
+	{
+		Env:    map[string]string{"LC_MESSAGES": "ja", "LANG": "en_US.UTF-8"},
+		Expect: "ja",
+	},
+	{
+		Env:    map[string]string{"LC_ALL": "C", "LANG": "ja_JP.UTF-8"},
+		Expect: "en",
+	},
+}
+
+func TestDetectLanguage(t *testing.T) {
+	names := []string{"LC_ALL", "LC_MESSAGES", "LANG"}
+	for _, name := range names {
+		if v, ok := os.LookupEnv(name); ok {
+			defer func(name string, v string) { _ = os.Setenv(name, v) }(name, v)
+		} else {
+			defer func(name string) { _ = os.Unsetenv(name) }(name)
+		}
+	}
+
+	for _, v := range detectLanguageTests {
+		for _, name := range names {
+			if s, ok := v.Env[name]; ok {
+				_ = os.Setenv(name, s)
+			} else {
+				_ = os.Unsetenv(name)
+			}
+		}
+
+		result := DetectLanguage()
+		if result != v.Expect {
+			t.Errorf("result = %q, want %q for %v", result, v.Expect, v.Env)
+		}
+	}
+}
+
+func TestMessage(t *testing.T) {
+	flags := GetFlags()
+	defer func() { flags.Language = EnglishLanguage }()
+
+	flags.Language = EnglishLanguage
+	if s := Message("file %s does not exist"); s != "file %s does not exist" {
+		t.Errorf("message = %q, want %q for %q", s, "file %s does not exist", EnglishLanguage)
+	}
+
+	flags.Language = JapaneseLanguage
+	if s := Message("file %s does not exist"); s != "ファイル %s は存在しません" {
+		t.Errorf("message = %q, want %q for %q", s, "ファイル %s は存在しません", JapaneseLanguage)
+	}
+	if s := Message("message not in catalog"); s != "message not in catalog" {
+		t.Errorf("message = %q, want %q for %q", s, "message not in catalog", JapaneseLanguage)
+	}
+}
+
+var formatVerbExp = regexp.MustCompile(`%(\[(\d+)])?([a-z])`)
+
+func formatVerbs(s string) []string {
+	verbs := make([]string, 0, 4)
+	n := 0
+	for _, m := range formatVerbExp.FindAllStringSubmatch(s, -1) {
+		if 0 < len(m[2]) {
+			n, _ = strconv.Atoi(m[2])
+		} else {
+			n++
+		}
+		verbs = append(verbs, strconv.Itoa(n)+m[3])
+	}
+	sort.Strings(verbs)
+	return verbs
+}
+
+func TestMessageCatalogs(t *testing.T) {
+	for lang, catalog := range messageCatalogs {
+		for k, v := range catalog {
+			if !reflect.DeepEqual(formatVerbs(k), formatVerbs(v)) {
+				t.Errorf("verbs in translation %q for %q does not match the message %q", v, lang, k)
+			}
+		}
+	}
+}
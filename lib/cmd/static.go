@@ -1,7 +1,12 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
@@ -11,14 +16,48 @@ var (
 	getRand sync.Once
 )
 
+// lockedSource is a source of random numbers that can be used by multiple goroutines.
+type lockedSource struct {
+	mtx *sync.Mutex
+	src rand.Source64
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.mtx.Lock()
+	n := s.src.Int63()
+	s.mtx.Unlock()
+	return n
+}
+
+func (s *lockedSource) Uint64() uint64 {
+	s.mtx.Lock()
+	n := s.src.Uint64()
+	s.mtx.Unlock()
+	return n
+}
+
+func (s *lockedSource) Seed(seed int64) {
+	s.mtx.Lock()
+	s.src.Seed(seed)
+	s.mtx.Unlock()
+}
+
+// GetRand returns a random number generator that is safe for concurrent use.
 func GetRand() *rand.Rand {
 	getRand.Do(func() {
-		random = rand.New(rand.NewSource(time.Now().UnixNano()))
+		random = rand.New(&lockedSource{
+			mtx: &sync.Mutex{},
+			src: rand.NewSource(time.Now().UnixNano()).(rand.Source64),
+		})
 	})
 	return random
 }
 
+// GetLocation returns the location specified by the timezone flag.
 func GetLocation() *time.Location {
+	if l := GetFlags().location; l != nil {
+		return l
+	}
 	return time.Local
 }
 
@@ -27,5 +66,37 @@ func Now() time.Time {
 		t, _ := time.ParseInLocation("2006-01-02 15:04:05.999999999", GetFlags().Now, GetLocation())
 		return t
 	}
-	return time.Now()
+	return time.Now().In(GetLocation())
 }
+
+// LoadLocation returns the location with the name.
+// The name is "Local", "UTC", a name in the IANA Time Zone database or an offset
+// from UTC in the form of "+hh:mm" or "-hhmm".
+func LoadLocation(s string) (*time.Location, error) {
+	switch {
+	case len(s) < 1 || strings.EqualFold(s, "Local"):
+		return time.Local, nil
+	case strings.EqualFold(s, "UTC"):
+		return time.UTC, nil
+	}
+
+	if m := offsetExp.FindStringSubmatch(s); m != nil {
+		h, _ := strconv.Atoi(m[2])
+		mi, _ := strconv.Atoi(m[3])
+		if h < 24 && mi < 60 {
+			offset := h*3600 + mi*60
+			if m[1] == "-" {
+				offset = -offset
+			}
+			return time.FixedZone(s, offset), nil
+		}
+	}
+
+	location, err := time.LoadLocation(s)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("timezone %q does not exist", s))
+	}
+	return location, nil
+}
+
+var offsetExp = regexp.MustCompile(`^([+-])(\d{2}):?(\d{2})$`)
@@ -39,4 +39,73 @@ func TestNow(t *testing.T) {
 		t.Log(Now())
 	}
 
+	_ = flag.SetLocation("Asia/Tokyo")
+	if l := Now().Location(); l.String() != "Asia/Tokyo" {
+		t.Errorf("location of Now() = %s, want %s", l, "Asia/Tokyo")
+	}
+	_ = flag.SetLocation("Local")
+}
+
+var loadLocationTests = []struct {
+	Name   string
+	Local  bool
+	Offset int
+	Error  string
+}{
+	{
+		Name:  "",
+		Local: true,
+	},
+	{
+		Name:   "utc",
+		Offset: 0,
+	},
+	{
+		Name:   "Asia/Tokyo",
+		Offset: 9 * 60 * 60,
+	},
+	{
+		Name:   "+09:00",
+		Offset: 9 * 60 * 60,
+	},
+	{
+		Name:   "-0330",
+		Offset: -(3*60 + 30) * 60,
+	},
+	{
+		Name:  "+25:00",
+		Error: "timezone \"+25:00\" does not exist",
+	},
+	{
+		Name:  "Asia/NotExist",
+		Error: "timezone \"Asia/NotExist\" does not exist",
+	},
+}
+
+func TestLoadLocation(t *testing.T) {
+	for _, v := range loadLocationTests {
+		result, err := LoadLocation(v.Name)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.Name)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.Name)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Name)
+			continue
+		}
+
+		if v.Local {
+			if result != time.Local {
+				t.Errorf("location = %s, want %s for %q", result, time.Local, v.Name)
+			}
+			continue
+		}
+		if _, offset := time.Date(2012, 2, 1, 0, 0, 0, 0, result).Zone(); offset != v.Offset {
+			t.Errorf("offset = %d, want %d for %q", offset, v.Offset, v.Name)
+		}
+	}
 }
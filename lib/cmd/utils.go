@@ -271,6 +271,12 @@ func IsReadableFromPipeOrRedirection() bool {
 	return false
 }
 
+// IsHttpUrl reports whether the string is a URL with the http or https scheme.
+func IsHttpUrl(s string) bool {
+	s = strings.ToLower(s)
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
 func ParseEncoding(s string) (text.Encoding, error) {
 	var encoding text.Encoding
 	switch strings.ToUpper(s) {
@@ -374,6 +380,97 @@ func ParseJsonEscapeType(s string) (txjson.EscapeType, error) {
 	return escape, nil
 }
 
+func ParseGitCheckType(s string) (GitCheckType, error) {
+	var t GitCheckType
+	switch strings.ToUpper(s) {
+	case "NONE":
+		t = GitCheckNone
+	case "WARN":
+		t = GitCheckWarn
+	case "REFUSE":
+		t = GitCheckRefuse
+	default:
+		return t, errors.New("git-check must be one of NONE|WARN|REFUSE")
+	}
+	return t, nil
+}
+
+func ParseCollation(s string) (string, error) {
+	c := strings.ToUpper(s)
+	switch c {
+	case "BINARY", "NOCASE", "UNICODE", "UNICODE_CI", "NATURAL":
+	default:
+		return c, errors.New("collation must be one of BINARY|NOCASE|UNICODE|UNICODE_CI|NATURAL")
+	}
+	return c, nil
+}
+
+func ParseDecimalSeparator(s string) (string, error) {
+	switch s {
+	case ".", ",":
+	default:
+		return s, errors.New("decimal separator must be one of .|,")
+	}
+	return s, nil
+}
+
+func ParseThousandsSeparator(s string) (string, error) {
+	switch s {
+	case "", ",", ".", " ", "'", "_":
+	default:
+		return s, errors.New("thousands separator must be one of ,|.|'|_|space or an empty string")
+	}
+	return s, nil
+}
+
+func ParseEncodingErrorsType(s string) (EncodingErrorsType, error) {
+	var t EncodingErrorsType
+	switch strings.ToUpper(s) {
+	case "":
+		t = EncodingErrorsNotSet
+	case "STRICT":
+		t = EncodingErrorsStrict
+	case "REPLACE":
+		t = EncodingErrorsReplace
+	case "IGNORE":
+		t = EncodingErrorsIgnore
+	default:
+		return t, errors.New("encoding-errors must be one of STRICT|REPLACE|IGNORE or an empty string")
+	}
+	return t, nil
+}
+
+func ParseQuoteStyle(s string) (QuoteStyle, error) {
+	var style QuoteStyle
+	switch strings.ToUpper(s) {
+	case "PRESERVE":
+		style = QuoteStylePreserve
+	case "MINIMAL":
+		style = QuoteStyleMinimal
+	case "ALL":
+		style = QuoteStyleAll
+	default:
+		return style, errors.New("quote-style must be one of PRESERVE|MINIMAL|ALL")
+	}
+	return style, nil
+}
+
+// ParseParam parses a parameter in the form of "NAME=VALUE".
+func ParseParam(s string) (string, string, error) {
+	i := strings.IndexByte(s, '=')
+	if i < 1 {
+		return "", "", errors.New(fmt.Sprintf("param %q must be in the form of NAME=VALUE", s))
+	}
+
+	name := s[:i]
+	for _, r := range name {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return "", "", errors.New(fmt.Sprintf("param name %q must consist of letters, digits and underscores", name))
+		}
+	}
+	return name, s[i+1:], nil
+}
+
 func AppendStrIfNotExist(list []string, elem string) []string {
 	if len(elem) < 1 {
 		return list
@@ -223,6 +223,19 @@ func TestIsReadableFromPipeOrRedirection(t *testing.T) {
 	}
 }
 
+func TestIsHttpUrl(t *testing.T) {
+	for s, expect := range map[string]bool{
+		"http://localhost:8080/ingest": true,
+		"HTTPS://example.com/ingest":   true,
+		"foo.json":                     false,
+		"/tmp/http://foo":              false,
+	} {
+		if result := IsHttpUrl(s); result != expect {
+			t.Errorf("is http url = %t, want %t for %q", result, expect, s)
+		}
+	}
+}
+
 func TestParseEncoding(t *testing.T) {
 	e, err := ParseEncoding("utf8")
 	if err != nil {
@@ -249,6 +262,40 @@ func TestParseEncoding(t *testing.T) {
 	}
 }
 
+func TestParseParam(t *testing.T) {
+	name, val, err := ParseParam("id=a=1")
+	if err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if name != "id" || val != "a=1" {
+		t.Errorf("param = %q, %q, want %q, %q for %s", name, val, "id", "a=1", "id=a=1")
+	}
+
+	name, val, err = ParseParam("id=")
+	if err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if name != "id" || val != "" {
+		t.Errorf("param = %q, %q, want %q, %q for %s", name, val, "id", "", "id=")
+	}
+
+	expectErr := "param \"=1\" must be in the form of NAME=VALUE"
+	_, _, err = ParseParam("=1")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "=1")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "=1")
+	}
+
+	expectErr = "param name \"a-b\" must consist of letters, digits and underscores"
+	_, _, err = ParseParam("a-b=1")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "a-b=1")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "a-b=1")
+	}
+}
+
 func TestParseDelimiter(t *testing.T) {
 	var s string
 	var delimiter rune
@@ -1,10 +1,18 @@
 package file
 
-import "time"
+import (
+	"os"
+	"strconv"
+	"time"
+)
 
 var WaitTimeout = 30.0
 var RetryInterval = 50 * time.Millisecond
 
+// SessionId is used to namespace the temporary files created by a session.
+// Applications that run several sessions in a process should assign a distinct id to each one.
+var SessionId = strconv.Itoa(os.Getpid())
+
 const (
 	LockFileSuffix = ".lock"
 	TempFileSuffix = ".temp"
@@ -4,11 +4,16 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 )
 
 var container = make(map[string]*Handler)
+var containerMutex = &sync.Mutex{}
 
 func addToContainer(path string, handler *Handler) error {
+	containerMutex.Lock()
+	defer containerMutex.Unlock()
+
 	key := strings.ToUpper(path)
 	if _, ok := container[key]; ok {
 		return errors.New(fmt.Sprintf("file %s already opened", path))
@@ -18,29 +23,43 @@ func addToContainer(path string, handler *Handler) error {
 }
 
 func removeFromContainer(path string) {
+	containerMutex.Lock()
+	defer containerMutex.Unlock()
+
 	key := strings.ToUpper(path)
 	if _, ok := container[key]; ok {
 		delete(container, key)
 	}
 }
 
+func handlers() []*Handler {
+	containerMutex.Lock()
+	defer containerMutex.Unlock()
+
+	list := make([]*Handler, 0, len(container))
+	for _, h := range container {
+		list = append(list, h)
+	}
+	return list
+}
+
 func UnlockAll() error {
-	for k := range container {
-		if err := container[k].Close(); err != nil {
+	for _, h := range handlers() {
+		if err := h.Close(); err != nil {
 			return err
 		}
-		delete(container, k)
+		removeFromContainer(h.path)
 	}
 	return nil
 }
 
 func UnlockAllWithErrors() error {
 	var errs []error
-	for k := range container {
-		if err := container[k].CloseWithErrors(); err != nil {
+	for _, h := range handlers() {
+		if err := h.CloseWithErrors(); err != nil {
 			errs = append(errs, err.(*ForcedUnlockError).Errors...)
 		}
-		delete(container, k)
+		removeFromContainer(h.path)
 	}
 
 	if errs != nil {
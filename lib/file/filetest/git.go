@@ -0,0 +1,42 @@
+package filetest
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// GitRepository creates a git repository in dir that has a commit of data.csv for each of the contents.
+func GitRepository(t *testing.T, command string, dir string, contents ...string) string {
+	t.Helper()
+
+	if _, err := exec.LookPath(command); err != nil {
+		t.Skip("git is not available")
+	}
+
+	os.RemoveAll(dir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	git := func(args ...string) {
+		c := exec.Command(command, append([]string{"-c", "user.name=csvq", "-c", "user.email=csvq@example.com"}, args...)...)
+		c.Dir = dir
+		if out, err := c.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %s", args, out)
+		}
+	}
+
+	git("init", "-q")
+	for i, s := range contents {
+		if err := ioutil.WriteFile(filepath.Join(dir, "data.csv"), []byte(s), 0644); err != nil {
+			t.Fatal(err)
+		}
+		git("add", "data.csv")
+		git("commit", "-q", "-m", "revision "+strconv.Itoa(i+1))
+	}
+	return dir
+}
@@ -24,6 +24,9 @@ func LockFilePath(path string) string {
 func TempFilePath(path string) string {
 	dir := filepath.Dir(path)
 	basename := filepath.Base(path)
+	if 0 < len(SessionId) {
+		basename = basename + "." + SessionId
+	}
 	return filepath.Join(dir, "."+basename+TempFileSuffix)
 }
 
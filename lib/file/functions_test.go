@@ -14,7 +14,16 @@ func TestLockFilePath(t *testing.T) {
 func TestTempFilePath(t *testing.T) {
 	path := GetTestFilePath("testfile.txt")
 	result := TempFilePath(path)
-	expect := GetTestFilePath(".testfile.txt" + TempFileSuffix)
+	expect := GetTestFilePath(".testfile.txt." + SessionId + TempFileSuffix)
+	if result != expect {
+		t.Errorf("result = %q, want %q", result, expect)
+	}
+
+	sessionId := SessionId
+	SessionId = "session1"
+	result = TempFilePath(path)
+	SessionId = sessionId
+	expect = GetTestFilePath(".testfile.txt.session1" + TempFileSuffix)
 	if result != expect {
 		t.Errorf("result = %q, want %q", result, expect)
 	}
@@ -0,0 +1,49 @@
+package file
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+var GitCommand = "git"
+
+// ReadRevision returns the contents of the file at the revision in the git repository
+// that the file belongs to.
+func ReadRevision(path string, revision string) ([]byte, error) {
+	if len(revision) < 1 || strings.HasPrefix(revision, "-") {
+		return nil, errors.New("invalid revision: " + revision)
+	}
+
+	return runGit(filepath.Dir(path), "show", revision+":./"+filepath.Base(path))
+}
+
+// IsModifiedInGit reports whether the file has modifications that are not committed
+// in the git repository that the file belongs to.
+// If git is not available or the file is not in a git work tree, then returns false.
+func IsModifiedInGit(path string) bool {
+	out, err := runGit(filepath.Dir(path), "status", "--porcelain", "--untracked-files=no", "--", filepath.Base(path))
+	if err != nil {
+		return false
+	}
+	return 0 < len(bytes.TrimSpace(out))
+}
+
+func runGit(dir string, args ...string) ([]byte, error) {
+	c := exec.Command(GitCommand, args...)
+	c.Dir = dir
+
+	stderr := new(bytes.Buffer)
+	c.Stderr = stderr
+
+	out, err := c.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); 0 < len(msg) {
+			return nil, errors.New(msg)
+		}
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,54 @@
+package file
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/file/filetest"
+)
+
+func setupGitRepository(t *testing.T) string {
+	return filetest.GitRepository(t, GitCommand, filepath.Join(TestDir, "git"), "c1\n1\n", "c1\n2\n")
+}
+
+func TestReadRevision(t *testing.T) {
+	dir := setupGitRepository(t)
+	fpath := filepath.Join(dir, "data.csv")
+
+	b, err := ReadRevision(fpath, "HEAD~1")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if string(b) != "c1\n1\n" {
+		t.Errorf("contents = %q, want %q", string(b), "c1\n1\n")
+	}
+
+	if _, err = ReadRevision(fpath, "notexist"); err == nil {
+		t.Error("no error, want error for a revision that does not exist")
+	}
+
+	if _, err = ReadRevision(fpath, "--output=x"); err == nil || err.Error() != "invalid revision: --output=x" {
+		t.Errorf("error = %v, want error %q", err, "invalid revision: --output=x")
+	}
+}
+
+func TestIsModifiedInGit(t *testing.T) {
+	dir := setupGitRepository(t)
+	fpath := filepath.Join(dir, "data.csv")
+
+	if IsModifiedInGit(fpath) {
+		t.Error("IsModifiedInGit = true, want false for a committed file")
+	}
+
+	if err := ioutil.WriteFile(fpath, []byte("c1\n3\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if !IsModifiedInGit(fpath) {
+		t.Error("IsModifiedInGit = false, want true for a modified file")
+	}
+
+	if IsModifiedInGit(GetTestFilePath("open.txt")) {
+		t.Error("IsModifiedInGit = true, want false for a file not in a git work tree")
+	}
+}
@@ -2,6 +2,8 @@ package file
 
 import (
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"time"
 
@@ -28,6 +30,9 @@ type Handler struct {
 	tempFilePath string
 	tempFp       *os.File
 
+	stat os.FileInfo
+	base []byte
+
 	closed bool
 }
 
@@ -97,6 +102,11 @@ func NewHandlerForUpdate(path string) (*Handler, error) {
 	}
 	h.fp = fp
 
+	if h.stat, err = fp.Stat(); err != nil {
+		h.Close()
+		return h, NewIOError(err.Error())
+	}
+
 	if err := h.TryCreateTempFile(); err != nil {
 		return h, err
 	}
@@ -122,6 +132,57 @@ func (h *Handler) FileForUpdate() *os.File {
 	return h.fp
 }
 
+// IsModified reports whether the file opened for update has been modified or
+// replaced by other processes that do not respect the lock files.
+func (h *Handler) IsModified() bool {
+	if h.openType != ForUpdate || h.stat == nil {
+		return false
+	}
+
+	stat, err := os.Stat(h.path)
+	if err != nil {
+		return true
+	}
+	return !os.SameFile(h.stat, stat) || !h.stat.ModTime().Equal(stat.ModTime()) || h.stat.Size() != stat.Size()
+}
+
+// KeepBase keeps the contents of the file opened for update as they are at this time.
+// It must be called before the file is read.
+func (h *Handler) KeepBase() error {
+	base, err := h.readAll()
+	if err != nil {
+		return err
+	}
+	h.base = base
+	return nil
+}
+
+// Base returns the contents of the file opened for update.
+// If the contents are not kept, then the contents are read from the opened file.
+func (h *Handler) Base() ([]byte, error) {
+	if h.base != nil {
+		return h.base, nil
+	}
+	return h.readAll()
+}
+
+func (h *Handler) readAll() ([]byte, error) {
+	if h.fp == nil {
+		return nil, NewIOError(fmt.Sprintf("file %s is not opened", h.path))
+	}
+	if _, err := h.fp.Seek(0, io.SeekStart); err != nil {
+		return nil, NewIOError(err.Error())
+	}
+	buf, err := ioutil.ReadAll(h.fp)
+	if err != nil {
+		return nil, NewIOError(err.Error())
+	}
+	if _, err := h.fp.Seek(0, io.SeekStart); err != nil {
+		return nil, NewIOError(err.Error())
+	}
+	return buf, nil
+}
+
 func (h *Handler) Close() error {
 	if h.closed {
 		return nil
@@ -1,6 +1,7 @@
 package file
 
 import (
+	"io/ioutil"
 	"testing"
 )
 
@@ -133,3 +134,47 @@ func TestHandler(t *testing.T) {
 	}
 	rh.Close()
 }
+
+func TestHandler_IsModified(t *testing.T) {
+	fpath := GetTestFilePath("modified.txt")
+	if err := ioutil.WriteFile(fpath, []byte("base"), 0600); err != nil {
+		t.Fatalf("error = %#v, expect no error", err)
+	}
+
+	h, err := NewHandlerForUpdate(fpath)
+	if err != nil {
+		t.Fatalf("error = %#v, expect no error", err)
+	}
+	defer h.Close()
+
+	if err = h.KeepBase(); err != nil {
+		t.Fatalf("error = %#v, expect no error", err)
+	}
+	if h.IsModified() {
+		t.Errorf("modified = %t, expect %t", true, false)
+	}
+
+	if err = ioutil.WriteFile(fpath, []byte("theirs"), 0600); err != nil {
+		t.Fatalf("error = %#v, expect no error", err)
+	}
+	if !h.IsModified() {
+		t.Errorf("modified = %t, expect %t", false, true)
+	}
+
+	base, err := h.Base()
+	if err != nil {
+		t.Fatalf("error = %#v, expect no error", err)
+	}
+	if string(base) != "base" {
+		t.Errorf("base = %q, expect %q", string(base), "base")
+	}
+
+	rh, err := NewHandlerForRead(GetTestFilePath("open.txt"))
+	if err != nil {
+		t.Fatalf("error = %#v, expect no error", err)
+	}
+	defer rh.Close()
+	if rh.IsModified() {
+		t.Errorf("modified = %t, expect %t for a file opened for read", true, false)
+	}
+}
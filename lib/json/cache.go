@@ -1,36 +1,75 @@
 package json
 
-import "strings"
+import (
+	"strings"
+	"sync"
+)
 
-var Path = PathMap{}
-var Query = QueryMap{}
+var Path = NewPathMap()
+var Query = NewQueryMap()
 
-type PathMap map[string]PathExpression
+// PathMap is a cache of parsed path expressions that can be used by multiple goroutines.
+type PathMap struct {
+	mtx   *sync.RWMutex
+	items map[string]PathExpression
+}
+
+func NewPathMap() *PathMap {
+	return &PathMap{
+		mtx:   &sync.RWMutex{},
+		items: make(map[string]PathExpression),
+	}
+}
 
-func (m PathMap) Parse(s string) (PathExpression, error) {
-	if e, ok := m[s]; ok {
+func (m *PathMap) Parse(s string) (PathExpression, error) {
+	m.mtx.RLock()
+	e, ok := m.items[s]
+	m.mtx.RUnlock()
+	if ok {
 		return e, nil
 	}
+
 	e, err := ParsePath(s)
 	if err != nil {
 		return nil, err
 	}
-	m[s] = e
+
+	m.mtx.Lock()
+	m.items[s] = e
+	m.mtx.Unlock()
 	return e, nil
 }
 
-type QueryMap map[string]QueryExpression
+// QueryMap is a cache of parsed query expressions that can be used by multiple goroutines.
+type QueryMap struct {
+	mtx   *sync.RWMutex
+	items map[string]QueryExpression
+}
+
+func NewQueryMap() *QueryMap {
+	return &QueryMap{
+		mtx:   &sync.RWMutex{},
+		items: make(map[string]QueryExpression),
+	}
+}
 
-func (m QueryMap) Parse(s string) (QueryExpression, error) {
+func (m *QueryMap) Parse(s string) (QueryExpression, error) {
 	s = strings.TrimSpace(s)
 
-	if e, ok := m[s]; ok {
+	m.mtx.RLock()
+	e, ok := m.items[s]
+	m.mtx.RUnlock()
+	if ok {
 		return e, nil
 	}
+
 	e, err := ParseQuery(s)
 	if err != nil {
 		return nil, err
 	}
-	m[s] = e
+
+	m.mtx.Lock()
+	m.items[s] = e
+	m.mtx.Unlock()
 	return e, nil
 }
@@ -3,12 +3,10 @@ package json
 import (
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/mithrandie/go-text/json"
 
 	"github.com/mithrandie/csvq/lib/value"
-	"github.com/mithrandie/ternary"
 )
 
 func ConvertToValue(structure json.Structure) value.Primary {
@@ -30,6 +28,30 @@ func ConvertToValue(structure json.Structure) value.Primary {
 	return p
 }
 
+// ConvertToNestedValue converts a structure to a value.
+// Unlike ConvertToValue, objects and arrays are kept as maps and arrays.
+func ConvertToNestedValue(structure json.Structure) value.Primary {
+	switch structure.(type) {
+	case json.Object:
+		obj := structure.(json.Object)
+		keys := make([]string, 0, obj.Len())
+		values := make([]value.Primary, 0, obj.Len())
+		for _, m := range obj.Members {
+			keys = append(keys, m.Key)
+			values = append(values, ConvertToNestedValue(m.Value))
+		}
+		return value.NewMap(keys, values)
+	case json.Array:
+		array := structure.(json.Array)
+		values := make([]value.Primary, 0, len(array))
+		for _, v := range array {
+			values = append(values, ConvertToNestedValue(v))
+		}
+		return value.NewArray(values)
+	}
+	return ConvertToValue(structure)
+}
+
 func ConvertToArray(array json.Array) []value.Primary {
 	row := make([]value.Primary, 0, len(array))
 	for _, v := range array {
@@ -73,7 +95,7 @@ func ConvertToTableValue(array json.Array) ([]string, [][]value.Primary, error)
 		obj, _ := elem.(json.Object)
 		for _, column := range header {
 			if obj.Exists(column) {
-				row = append(row, ConvertToValue(obj.Value(column)))
+				row = append(row, ConvertToNestedValue(obj.Value(column)))
 			} else {
 				row = append(row, ConvertToValue(json.Null{}))
 			}
@@ -157,29 +179,5 @@ func addPathValueToRowStructure(parent json.Structure, path ObjectPath, val valu
 }
 
 func ParseValueToStructure(val value.Primary) json.Structure {
-	var s json.Structure
-
-	switch val.(type) {
-	case value.String:
-		s = json.String(val.(value.String).Raw())
-	case value.Integer:
-		s = json.Number(val.(value.Integer).Raw())
-	case value.Float:
-		s = json.Number(val.(value.Float).Raw())
-	case value.Boolean:
-		s = json.Boolean(val.(value.Boolean).Raw())
-	case value.Ternary:
-		t := val.(value.Ternary)
-		if t.Ternary() == ternary.UNKNOWN {
-			s = json.Null{}
-		} else {
-			s = json.Boolean(t.Ternary().ParseBool())
-		}
-	case value.Datetime:
-		s = json.String(val.(value.Datetime).Format(time.RFC3339Nano))
-	case value.Null:
-		s = json.Null{}
-	}
-
-	return s
+	return value.ToJSONStructure(val)
 }
@@ -91,6 +91,106 @@ func TestConvertToArray(t *testing.T) {
 	}
 }
 
+var convertToNestedValueTests = []struct {
+	Input  json.Structure
+	Expect value.Primary
+}{
+	{
+		Input:  json.String("abc"),
+		Expect: value.NewString("abc"),
+	},
+	{
+		Input: json.Array{
+			json.String("abc"),
+			json.Number(1),
+		},
+		Expect: value.NewArray([]value.Primary{
+			value.NewString("abc"),
+			value.NewInteger(1),
+		}),
+	},
+	{
+		Input: json.Object{
+			Members: []json.ObjectMember{
+				{
+					Key:   "key1",
+					Value: json.String("value1"),
+				},
+				{
+					Key: "key2",
+					Value: json.Object{
+						Members: []json.ObjectMember{
+							{
+								Key:   "key3",
+								Value: json.Array{json.Boolean(true)},
+							},
+						},
+					},
+				},
+			},
+		},
+		Expect: value.NewMap(
+			[]string{"key1", "key2"},
+			[]value.Primary{
+				value.NewString("value1"),
+				value.NewMap(
+					[]string{"key3"},
+					[]value.Primary{value.NewArray([]value.Primary{value.NewBoolean(true)})},
+				),
+			},
+		),
+	},
+}
+
+func TestConvertToNestedValue(t *testing.T) {
+	for _, v := range convertToNestedValueTests {
+		result := ConvertToNestedValue(v.Input)
+		if !reflect.DeepEqual(result, v.Expect) {
+			t.Errorf("result = %#v, want %#v for %#v", result, v.Expect, v.Input)
+		}
+	}
+}
+
+var parseValueToStructureTests = []struct {
+	Input  value.Primary
+	Expect json.Structure
+}{
+	{
+		Input:  value.NewString("abc"),
+		Expect: json.String("abc"),
+	},
+	{
+		Input: value.NewMap(
+			[]string{"key1", "key2"},
+			[]value.Primary{
+				value.NewInteger(1),
+				value.NewArray([]value.Primary{value.NewNull()}),
+			},
+		),
+		Expect: json.Object{
+			Members: []json.ObjectMember{
+				{
+					Key:   "key1",
+					Value: json.Number(1),
+				},
+				{
+					Key:   "key2",
+					Value: json.Array{json.Null{}},
+				},
+			},
+		},
+	},
+}
+
+func TestParseValueToStructure(t *testing.T) {
+	for _, v := range parseValueToStructureTests {
+		result := ParseValueToStructure(v.Input)
+		if !reflect.DeepEqual(result, v.Expect) {
+			t.Errorf("result = %#v, want %#v for %#v", result, v.Expect, v.Input)
+		}
+	}
+}
+
 var convertToTableValueTests = []struct {
 	Input        json.Array
 	ExpectHeader []string
@@ -47,6 +47,46 @@ func LoadTable(queryString string, jsontext string) ([]string, [][]value.Primary
 	return h, rows, et, err
 }
 
+// LoadColumns extracts the elements selected by the query as rows, and extracts
+// the values of the columns from each element.
+// If the query selects an object, then the object is treated as a single row.
+func LoadColumns(query QueryExpression, jsontext string, columns []QueryExpression) ([][]value.Primary, error) {
+	d := json.NewDecoder()
+	data, _, err := d.Decode(jsontext)
+	if err != nil {
+		return nil, err
+	}
+
+	structure, err := Extract(query, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var elems json.Array
+	switch structure.(type) {
+	case json.Array:
+		elems = structure.(json.Array)
+	case json.Null:
+		return nil, nil
+	default:
+		elems = json.Array{structure}
+	}
+
+	rows := make([][]value.Primary, 0, len(elems))
+	for _, elem := range elems {
+		row := make([]value.Primary, 0, len(columns))
+		for _, column := range columns {
+			st, err := Extract(column, elem)
+			if err != nil {
+				return nil, err
+			}
+			row = append(row, ConvertToNestedValue(st))
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
 func load(queryString string, jsontext string) (json.Structure, json.EscapeType, error) {
 	query, err := Query.Parse(queryString)
 	if err != nil {
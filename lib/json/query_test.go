@@ -163,6 +163,94 @@ func TestLoadTable(t *testing.T) {
 	}
 }
 
+var loadColumnsTests = []struct {
+	Query   QueryExpression
+	Json    string
+	Columns []QueryExpression
+	Expect  [][]value.Primary
+	Error   string
+}{
+	{
+		Query: Element{Label: "items"},
+		Json:  "{\"items\":[{\"id\":1, \"address\":{\"city\":\"a\"}}, {\"id\":2, \"tags\":[\"x\"]}]}",
+		Columns: []QueryExpression{
+			Element{Label: "id"},
+			Element{Label: "address", Child: Element{Label: "city"}},
+			Element{Label: "tags"},
+		},
+		Expect: [][]value.Primary{
+			{value.NewInteger(1), value.NewString("a"), value.NewNull()},
+			{value.NewInteger(2), value.NewNull(), value.NewArray([]value.Primary{value.NewString("x")})},
+		},
+	},
+	{
+		Query: Element{Label: "item"},
+		Json:  "{\"item\":{\"id\":1}}",
+		Columns: []QueryExpression{
+			Element{Label: "id"},
+		},
+		Expect: [][]value.Primary{
+			{value.NewInteger(1)},
+		},
+	},
+	{
+		Query: Element{Label: "notexist"},
+		Json:  "{\"item\":{\"id\":1}}",
+		Columns: []QueryExpression{
+			Element{Label: "id"},
+		},
+		Expect: nil,
+	},
+	{
+		Query: nil,
+		Json:  "[1, 2]",
+		Columns: []QueryExpression{
+			nil,
+		},
+		Expect: [][]value.Primary{
+			{value.NewInteger(1)},
+			{value.NewInteger(2)},
+		},
+	},
+	{
+		Query: Element{Label: "items"},
+		Json:  "{\"items\":[{\"id\":1}",
+		Columns: []QueryExpression{
+			Element{Label: "id"},
+		},
+		Error: "line 1, column 18: unexpected termination",
+	},
+	{
+		Query: Element{Label: "items"},
+		Json:  "{\"items\":[{\"id\":1}]}",
+		Columns: []QueryExpression{
+			RowValueExpr{},
+		},
+		Error: "json value must be an array",
+	},
+}
+
+func TestLoadColumns(t *testing.T) {
+	for _, v := range loadColumnsTests {
+		result, err := LoadColumns(v.Query, v.Json, v.Columns)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %#v, %q", err.Error(), v.Query, v.Json)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %#v, %q", err, v.Error, v.Query, v.Json)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %#v, %q", v.Error, v.Query, v.Json)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Expect) {
+			t.Errorf("result = %#v, want %#v for %#v, %q", result, v.Expect, v.Query, v.Json)
+		}
+	}
+}
+
 var extractTests = []struct {
 	Query  QueryExpression
 	Data   json.Structure
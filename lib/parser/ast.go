@@ -177,6 +177,11 @@ func (i Identifier) String() string {
 	return i.Literal
 }
 
+// NewQualifiedIdentifier returns an identifier that represents the name qualified by the namespace.
+func NewQualifiedIdentifier(namespace Identifier, name Identifier) Identifier {
+	return Identifier{BaseExpr: namespace.BaseExpr, Literal: namespace.Literal + string(NamespaceSeparator) + name.Literal}
+}
+
 type FieldReference struct {
 	*BaseExpr
 	View   Identifier
@@ -228,6 +233,57 @@ func (e ValueList) String() string {
 	return putParentheses(listQueryExpressions(e.Values))
 }
 
+type ArrayValue struct {
+	*BaseExpr
+	Values []QueryExpression
+}
+
+func (e ArrayValue) String() string {
+	return "[" + listQueryExpressions(e.Values) + "]"
+}
+
+type ArrayElement struct {
+	*BaseExpr
+	Array QueryExpression
+	Index QueryExpression
+}
+
+func (e ArrayElement) String() string {
+	return e.Array.String() + "[" + e.Index.String() + "]"
+}
+
+type MemberReference struct {
+	*BaseExpr
+	Value  QueryExpression
+	Member Identifier
+}
+
+func (e MemberReference) String() string {
+	return e.Value.String() + "." + e.Member.String()
+}
+
+type Collate struct {
+	*BaseExpr
+	Value     QueryExpression
+	Collate   string
+	Collation Identifier
+}
+
+func (e Collate) String() string {
+	return joinWithSpace([]string{e.Value.String(), e.Collate, e.Collation.String()})
+}
+
+type AtTimeZone struct {
+	*BaseExpr
+	Value      QueryExpression
+	AtTimeZone string
+	TimeZone   QueryExpression
+}
+
+func (e AtTimeZone) String() string {
+	return joinWithSpace([]string{e.Value.String(), e.AtTimeZone, e.TimeZone.String()})
+}
+
 type RowValueList struct {
 	*BaseExpr
 	RowValues []QueryExpression
@@ -264,6 +320,23 @@ func (e SelectQuery) String() string {
 	return joinWithSpace(s)
 }
 
+// NewSelectAllQuery returns a query that selects all the columns from the table object.
+func NewSelectAllQuery(table QueryExpression) SelectQuery {
+	return SelectQuery{
+		SelectEntity: SelectEntity{
+			SelectClause: SelectClause{
+				BaseExpr: table.GetBaseExpr(),
+				Select:   "SELECT",
+				Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: table.GetBaseExpr()}}},
+			},
+			FromClause: FromClause{
+				From:   "FROM",
+				Tables: []QueryExpression{Table{Object: table}},
+			},
+		},
+	}
+}
+
 type SelectSet struct {
 	*BaseExpr
 	LHS      QueryExpression
@@ -310,6 +383,7 @@ func (e SelectEntity) String() string {
 type SelectClause struct {
 	*BaseExpr
 	Select   string
+	Hints    []Hint
 	Distinct Token
 	Fields   []QueryExpression
 }
@@ -320,6 +394,9 @@ func (sc SelectClause) IsDistinct() bool {
 
 func (sc SelectClause) String() string {
 	s := []string{sc.Select}
+	if 0 < len(sc.Hints) {
+		s = append(s, FormatHints(sc.Hints))
+	}
 	if sc.IsDistinct() {
 		s = append(s, sc.Distinct.Literal)
 	}
@@ -486,6 +563,7 @@ type TableObject struct {
 	FormatElement QueryExpression
 	Path          Identifier
 	Args          []QueryExpression
+	Options       []QueryExpression
 }
 
 func (e TableObject) String() string {
@@ -493,11 +571,30 @@ func (e TableObject) String() string {
 	if e.FormatElement != nil {
 		allArgs = append(allArgs, e.FormatElement)
 	}
-	allArgs = append(allArgs, e.Path)
+	if 0 < len(e.Path.Literal) {
+		allArgs = append(allArgs, e.Path)
+	}
 	if e.Args != nil {
 		allArgs = append(allArgs, e.Args...)
 	}
-	return e.Type.String() + putParentheses(listQueryExpressions(allArgs))
+	s := listQueryExpressions(allArgs)
+	if 0 < len(e.Options) {
+		s = s + " WITH " + putParentheses(listQueryExpressions(e.Options))
+	}
+	return e.Type.String() + putParentheses(s)
+}
+
+type TableObjectOption struct {
+	*BaseExpr
+	Name  Identifier
+	Value QueryExpression
+}
+
+func (e TableObjectOption) String() string {
+	if e.Value == nil {
+		return e.Name.String()
+	}
+	return e.Name.String() + " " + e.Value.String()
 }
 
 type JsonQuery struct {
@@ -511,6 +608,88 @@ func (e JsonQuery) String() string {
 	return e.JsonQuery + putParentheses(e.Query.String()+", "+e.JsonText.String())
 }
 
+type Unnest struct {
+	*BaseExpr
+	Unnest string
+	Value  QueryExpression
+}
+
+func (e Unnest) String() string {
+	return e.Unnest + putParentheses(e.Value.String())
+}
+
+type GenerateSeries struct {
+	*BaseExpr
+	GenerateSeries string
+	Start          QueryExpression
+	Stop           QueryExpression
+	Step           QueryExpression
+}
+
+func (e GenerateSeries) String() string {
+	s := e.Start.String() + ", " + e.Stop.String()
+	if e.Step != nil {
+		s += ", " + e.Step.String()
+	}
+	return e.GenerateSeries + putParentheses(s)
+}
+
+type JsonTable struct {
+	*BaseExpr
+	JsonTable string
+	JsonText  QueryExpression
+	Query     QueryExpression
+	Columns   []QueryExpression
+}
+
+func (e JsonTable) String() string {
+	return e.JsonTable + putParentheses(e.JsonText.String()+", "+e.Query.String()+" COLUMNS "+putParentheses(listQueryExpressions(e.Columns)))
+}
+
+type JsonTableColumn struct {
+	*BaseExpr
+	Name Identifier
+	Path QueryExpression
+}
+
+func (e JsonTableColumn) String() string {
+	if e.Path == nil {
+		return e.Name.String()
+	}
+	return joinWithSpace([]string{e.Name.String(), "PATH", e.Path.String()})
+}
+
+type RevisionTable struct {
+	*BaseExpr
+	Table    Identifier
+	At       string
+	Revision QueryExpression
+}
+
+func (e RevisionTable) String() string {
+	return joinWithSpace([]string{e.Table.String(), e.At, e.Revision.String()})
+}
+
+type TailTable struct {
+	*BaseExpr
+	Tail string
+	Path Identifier
+}
+
+func (e TailTable) String() string {
+	return joinWithSpace([]string{e.Tail, e.Path.String()})
+}
+
+type TableFunction struct {
+	*BaseExpr
+	Table    string
+	Function Function
+}
+
+func (e TableFunction) String() string {
+	return e.Table + "(" + e.Function.String() + ")"
+}
+
 type Comparison struct {
 	*BaseExpr
 	LHS      QueryExpression
@@ -719,25 +898,66 @@ func (e Function) String() string {
 
 type AggregateFunction struct {
 	*BaseExpr
-	Name     string
-	Distinct Token
-	Args     []QueryExpression
+	Name        string
+	Distinct    Token
+	Args        []QueryExpression
+	WithinGroup string
+	OrderBy     QueryExpression
+	Filter      QueryExpression
 }
 
 func (e AggregateFunction) String() string {
-	s := make([]string, 0)
+	option := make([]string, 0)
 	if !e.Distinct.IsEmpty() {
-		s = append(s, e.Distinct.Literal)
+		option = append(option, e.Distinct.Literal)
 	}
-	s = append(s, listQueryExpressions(e.Args))
+	option = append(option, listQueryExpressions(e.Args))
 
-	return e.Name + "(" + joinWithSpace(s) + ")"
+	s := []string{e.Name + "(" + joinWithSpace(option) + ")"}
+	if 0 < len(e.WithinGroup) {
+		s = append(s, e.WithinGroup)
+		if e.OrderBy != nil {
+			s = append(s, "("+e.OrderBy.String()+")")
+		} else {
+			s = append(s, "()")
+		}
+	}
+	if e.Filter != nil {
+		s = append(s, e.Filter.String())
+	}
+	return joinWithSpace(s)
 }
 
 func (e AggregateFunction) IsDistinct() bool {
 	return !e.Distinct.IsEmpty()
 }
 
+type FilterClause struct {
+	*BaseExpr
+	Filter string
+	Where  QueryExpression
+}
+
+func (e FilterClause) String() string {
+	return e.Filter + " (" + e.Where.String() + ")"
+}
+
+// SetAggregateFilter returns the aggregate function with the filter clause.
+// A function is dealt with as a user defined aggregate function.
+func SetAggregateFilter(expr QueryExpression, filter FilterClause) QueryExpression {
+	switch e := expr.(type) {
+	case Function:
+		return AggregateFunction{BaseExpr: e.BaseExpr, Name: e.Name, Args: e.Args, Filter: filter}
+	case AggregateFunction:
+		e.Filter = filter
+		return e
+	case ListFunction:
+		e.Filter = filter
+		return e
+	}
+	return expr
+}
+
 type Table struct {
 	*BaseExpr
 	Object QueryExpression
@@ -768,6 +988,48 @@ func (t Table) Name() Identifier {
 		}
 	}
 
+	if revisionTable, ok := t.Object.(RevisionTable); ok {
+		return Identifier{
+			BaseExpr: revisionTable.Table.BaseExpr,
+			Literal:  FormatTableName(revisionTable.Table.Literal),
+		}
+	}
+
+	if tailTable, ok := t.Object.(TailTable); ok {
+		return Identifier{
+			BaseExpr: tailTable.Path.BaseExpr,
+			Literal:  FormatTableName(tailTable.Path.Literal),
+		}
+	}
+
+	if tableFunction, ok := t.Object.(TableFunction); ok {
+		return Identifier{
+			BaseExpr: tableFunction.Function.BaseExpr,
+			Literal:  tableFunction.Function.Name,
+		}
+	}
+
+	if unnest, ok := t.Object.(Unnest); ok {
+		return Identifier{
+			BaseExpr: unnest.BaseExpr,
+			Literal:  unnest.Unnest,
+		}
+	}
+
+	if generateSeries, ok := t.Object.(GenerateSeries); ok {
+		return Identifier{
+			BaseExpr: generateSeries.BaseExpr,
+			Literal:  generateSeries.GenerateSeries,
+		}
+	}
+
+	if jsonTable, ok := t.Object.(JsonTable); ok {
+		return Identifier{
+			BaseExpr: jsonTable.BaseExpr,
+			Literal:  jsonTable.JsonTable,
+		}
+	}
+
 	return Identifier{
 		BaseExpr: t.Object.GetBaseExpr(),
 		Literal:  t.Object.String(),
@@ -952,6 +1214,7 @@ type ListFunction struct {
 	Args        []QueryExpression
 	WithinGroup string
 	OrderBy     QueryExpression
+	Filter      QueryExpression
 }
 
 func (e ListFunction) String() string {
@@ -970,6 +1233,9 @@ func (e ListFunction) String() string {
 			s = append(s, "()")
 		}
 	}
+	if e.Filter != nil {
+		s = append(s, e.Filter.String())
+	}
 	return joinWithSpace(s)
 }
 
@@ -1141,6 +1407,17 @@ func (e RuntimeInformation) String() string {
 	return string(VariableSign) + string(RuntimeInformationSign) + e.Name
 }
 
+type Placeholder struct {
+	*BaseExpr
+	Literal string
+	Ordinal int
+	Name    string
+}
+
+func (e Placeholder) String() string {
+	return e.Literal
+}
+
 type SetEnvVar struct {
 	*BaseExpr
 	EnvVar EnvironmentVariable
@@ -1152,6 +1429,12 @@ type UnsetEnvVar struct {
 	EnvVar EnvironmentVariable
 }
 
+type Export struct {
+	*BaseExpr
+	EnvVars  []EnvironmentVariable
+	FilePath QueryExpression
+}
+
 type InsertQuery struct {
 	*BaseExpr
 	WithClause QueryExpression
@@ -1186,9 +1469,75 @@ type DeleteQuery struct {
 
 type CreateTable struct {
 	*BaseExpr
-	Table  Identifier
-	Fields []QueryExpression
-	Query  QueryExpression
+	Table       Identifier
+	Attributes  []TableAttribute
+	Fields      []QueryExpression
+	Constraints []QueryExpression
+	Query       QueryExpression
+}
+
+type TableAttribute struct {
+	*BaseExpr
+	Attribute Identifier
+	Value     QueryExpression
+}
+
+type Copy struct {
+	*BaseExpr
+	Query      QueryExpression
+	File       QueryExpression
+	Attributes []TableAttribute
+}
+
+type CheckConstraint struct {
+	*BaseExpr
+	Name      Identifier
+	Condition QueryExpression
+}
+
+func (e CheckConstraint) String() string {
+	s := []string{"CHECK", putParentheses(e.Condition.String())}
+	if 0 < len(e.Name.Literal) {
+		s = append([]string{"CONSTRAINT", e.Name.String()}, s...)
+	}
+	return joinWithSpace(s)
+}
+
+type NotNullConstraint struct {
+	*BaseExpr
+	Name   Identifier
+	Column Identifier
+}
+
+func (e NotNullConstraint) String() string {
+	s := []string{"NOT", "NULL"}
+	if 0 < len(e.Name.Literal) {
+		s = append([]string{"CONSTRAINT", e.Name.String()}, s...)
+	}
+	return joinWithSpace(s)
+}
+
+type UniqueConstraint struct {
+	*BaseExpr
+	Name    Identifier
+	Columns []QueryExpression
+}
+
+func (e UniqueConstraint) String() string {
+	s := []string{"UNIQUE", putParentheses(listQueryExpressions(e.Columns))}
+	if 0 < len(e.Name.Literal) {
+		s = append([]string{"CONSTRAINT", e.Name.String()}, s...)
+	}
+	return joinWithSpace(s)
+}
+
+type AutoIncrement struct {
+	*BaseExpr
+	Column Identifier
+}
+
+func (e AutoIncrement) String() string {
+	return "AUTO_INCREMENT"
 }
 
 type AddColumns struct {
@@ -1201,6 +1550,7 @@ type AddColumns struct {
 type ColumnDefault struct {
 	*BaseExpr
 	Column Identifier
+	Type   Identifier
 	Value  QueryExpression
 }
 
@@ -1223,6 +1573,13 @@ type RenameColumn struct {
 	New   Identifier
 }
 
+type SetColumnType struct {
+	*BaseExpr
+	Table  QueryExpression
+	Column QueryExpression
+	Type   Identifier
+}
+
 type SetTableAttribute struct {
 	*BaseExpr
 	Table     QueryExpression
@@ -1234,6 +1591,7 @@ type FunctionDeclaration struct {
 	*BaseExpr
 	Name       Identifier
 	Parameters []VariableAssignment
+	Variadic   bool
 	Statements []Statement
 }
 
@@ -1250,11 +1608,48 @@ type DisposeFunction struct {
 	Name Identifier
 }
 
+type TableTriggerDeclaration struct {
+	*BaseExpr
+	Name       Identifier
+	Timing     Token
+	Event      Token
+	Table      QueryExpression
+	Statements []Statement
+}
+
+type DisposeTableTrigger struct {
+	*BaseExpr
+	Name Identifier
+}
+
+type CreateIndex struct {
+	*BaseExpr
+	Name    Identifier
+	Table   QueryExpression
+	Columns []QueryExpression
+	Method  Identifier
+}
+
+type DropIndex struct {
+	*BaseExpr
+	Name Identifier
+}
+
 type Return struct {
 	*BaseExpr
 	Value QueryExpression
 }
 
+type ReturnTable struct {
+	*BaseExpr
+	Query SelectQuery
+}
+
+type ReturnCursor struct {
+	*BaseExpr
+	Cursor Identifier
+}
+
 type Echo struct {
 	*BaseExpr
 	Value QueryExpression
@@ -1276,6 +1671,11 @@ type Source struct {
 	FilePath QueryExpression
 }
 
+type Import struct {
+	*BaseExpr
+	Module QueryExpression
+}
+
 type Chdir struct {
 	*BaseExpr
 	DirPath QueryExpression
@@ -1292,10 +1692,32 @@ type Reload struct {
 
 type Execute struct {
 	*BaseExpr
+	Immediate  bool
 	Statements QueryExpression
 	Values     []QueryExpression
 }
 
+type Prepare struct {
+	*BaseExpr
+	Name      Identifier
+	Statement QueryExpression
+}
+
+type DisposePrepared struct {
+	*BaseExpr
+	Name Identifier
+}
+
+type ReplaceValue struct {
+	*BaseExpr
+	Value QueryExpression
+	Name  Identifier
+}
+
+func (e ReplaceValue) String() string {
+	return e.Value.String() + " AS " + e.Name.String()
+}
+
 type Syntax struct {
 	*BaseExpr
 	Keywords []QueryExpression
@@ -1335,6 +1757,21 @@ type ShowFields struct {
 	Table Identifier
 }
 
+type Explain struct {
+	*BaseExpr
+	Analyze Token
+	Query   QueryExpression
+}
+
+func (e Explain) IsAnalyze() bool {
+	return !e.Analyze.IsEmpty()
+}
+
+type Estimate struct {
+	*BaseExpr
+	Query QueryExpression
+}
+
 type If struct {
 	*BaseExpr
 	Condition  QueryExpression
@@ -1374,18 +1811,39 @@ type CaseElse struct {
 
 type While struct {
 	*BaseExpr
+	Label      Identifier
 	Condition  QueryExpression
 	Statements []Statement
 }
 
 type WhileInCursor struct {
 	*BaseExpr
+	Label           Identifier
 	WithDeclaration bool
 	Variables       []Variable
 	Cursor          Identifier
 	Statements      []Statement
 }
 
+// SetLoopLabel sets the label to the loop statement.
+func SetLoopLabel(stmt Statement, label Identifier) Statement {
+	switch loop := stmt.(type) {
+	case While:
+		loop.Label = label
+		return loop
+	case WhileInCursor:
+		loop.Label = label
+		return loop
+	}
+	return stmt
+}
+
+type Try struct {
+	*BaseExpr
+	Statements      []Statement
+	CatchStatements []Statement
+}
+
 type CursorDeclaration struct {
 	*BaseExpr
 	Cursor Identifier
@@ -1411,6 +1869,7 @@ type FetchCursor struct {
 	*BaseExpr
 	Position  FetchPosition
 	Cursor    Identifier
+	Bulk      QueryExpression
 	Variables []Variable
 }
 
@@ -1453,9 +1912,10 @@ func (e CursorAttrebute) String() string {
 
 type ViewDeclaration struct {
 	*BaseExpr
-	View   Identifier
-	Fields []QueryExpression
-	Query  QueryExpression
+	View        Identifier
+	Fields      []QueryExpression
+	Constraints []QueryExpression
+	Query       QueryExpression
 }
 
 type DisposeView struct {
@@ -1471,6 +1931,7 @@ type TransactionControl struct {
 type FlowControl struct {
 	*BaseExpr
 	Token int
+	Label Identifier
 }
 
 type Trigger struct {
@@ -1478,6 +1939,19 @@ type Trigger struct {
 	Event   Identifier
 	Message QueryExpression
 	Code    value.Primary
+	Data    QueryExpression
+}
+
+type Assert struct {
+	*BaseExpr
+	Condition QueryExpression
+	Message   QueryExpression
+}
+
+type Expect struct {
+	*BaseExpr
+	Query    QueryExpression
+	Expected QueryExpression
 }
 
 type Exit struct {
@@ -1513,3 +1987,48 @@ func quoteString(s string) string {
 func quoteIdentifier(s string) string {
 	return "`" + s + "`"
 }
+
+// setConstraintColumn sets the column to the constraints declared after the column name.
+func setConstraintColumn(constraints []QueryExpression, column Identifier) []QueryExpression {
+	for i, c := range constraints {
+		switch c.(type) {
+		case NotNullConstraint:
+			constraint := c.(NotNullConstraint)
+			constraint.Column = column
+			constraints[i] = constraint
+		case UniqueConstraint:
+			constraint := c.(UniqueConstraint)
+			constraint.Columns = []QueryExpression{column}
+			constraints[i] = constraint
+		case AutoIncrement:
+			constraint := c.(AutoIncrement)
+			constraint.Column = column
+			constraints[i] = constraint
+		}
+	}
+	return constraints
+}
+
+func booleanTableAttributes(names []QueryExpression) []TableAttribute {
+	attributes := make([]TableAttribute, 0, len(names))
+	for _, name := range names {
+		ident := name.(Identifier)
+		attributes = append(attributes, TableAttribute{BaseExpr: ident.BaseExpr, Attribute: ident})
+	}
+	return attributes
+}
+
+// splitTableElements separates the column names and the constraints declared
+// in the parentheses of CREATE TABLE or DECLARE VIEW.
+func splitTableElements(elements []QueryExpression) ([]QueryExpression, []QueryExpression) {
+	fields := make([]QueryExpression, 0, len(elements))
+	var constraints []QueryExpression
+	for _, e := range elements {
+		if _, ok := e.(Identifier); ok {
+			fields = append(fields, e)
+		} else {
+			constraints = append(constraints, e)
+		}
+	}
+	return fields, constraints
+}
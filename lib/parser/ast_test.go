@@ -358,6 +358,23 @@ func TestSelectClause_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = SelectClause{
+		Select: "select",
+		Hints: []Hint{
+			{Name: "HASH_JOIN", Args: []string{"t1", "t2"}},
+			{Name: "NO_PARALLEL"},
+		},
+		Fields: []QueryExpression{
+			Field{
+				Object: Identifier{Literal: "column1"},
+			},
+		},
+	}
+	expect = "select /*+ HASH_JOIN(t1 t2) NO_PARALLEL */ column1"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestFromClause_String(t *testing.T) {
@@ -653,6 +670,114 @@ func TestTableObject_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = TableObject{
+		Type:          Identifier{Literal: "csv"},
+		FormatElement: NewStringValue("table.txt"),
+		Options: []QueryExpression{
+			TableObjectOption{Name: Identifier{Literal: "delimiter"}, Value: NewStringValue(";")},
+			TableObjectOption{Name: Identifier{Literal: "noheader"}},
+		},
+	}
+	expect = "csv('table.txt' WITH (delimiter ';', noheader))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestTableObjectOption_String(t *testing.T) {
+	e := TableObjectOption{
+		Name:  Identifier{Literal: "encoding"},
+		Value: NewStringValue("sjis"),
+	}
+	expect := "encoding 'sjis'"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestCheckConstraint_String(t *testing.T) {
+	e := CheckConstraint{
+		Condition: Comparison{
+			LHS:      FieldReference{Column: Identifier{Literal: "column1"}},
+			Operator: ">",
+			RHS:      NewIntegerValueFromString("0"),
+		},
+	}
+	expect := "CHECK (column1 > 0)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e.Name = Identifier{Literal: "positive"}
+	expect = "CONSTRAINT positive CHECK (column1 > 0)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestNotNullConstraint_String(t *testing.T) {
+	e := NotNullConstraint{
+		Column: Identifier{Literal: "column1"},
+	}
+	expect := "NOT NULL"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e.Name = Identifier{Literal: "nn"}
+	expect = "CONSTRAINT nn NOT NULL"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestUniqueConstraint_String(t *testing.T) {
+	e := UniqueConstraint{
+		Columns: []QueryExpression{
+			Identifier{Literal: "column1"},
+			Identifier{Literal: "column2"},
+		},
+	}
+	expect := "UNIQUE (column1, column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e.Name = Identifier{Literal: "uq"}
+	expect = "CONSTRAINT uq UNIQUE (column1, column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestAutoIncrement_String(t *testing.T) {
+	e := AutoIncrement{
+		Column: Identifier{Literal: "id"},
+	}
+	expect := "AUTO_INCREMENT"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestPlaceholder_String(t *testing.T) {
+	e := Placeholder{Literal: ":id", Name: "id"}
+	expect := ":id"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestReplaceValue_String(t *testing.T) {
+	e := ReplaceValue{
+		Value: NewIntegerValueFromString("1"),
+		Name:  Identifier{Literal: "id"},
+	}
+	expect := "1 AS id"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestJsonQuery_String(t *testing.T) {
@@ -667,6 +792,147 @@ func TestJsonQuery_String(t *testing.T) {
 	}
 }
 
+func TestUnnest_String(t *testing.T) {
+	e := Unnest{
+		Unnest: "unnest",
+		Value:  Identifier{Literal: "column"},
+	}
+	expect := "unnest(column)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestGenerateSeries_String(t *testing.T) {
+	e := GenerateSeries{
+		GenerateSeries: "generate_series",
+		Start:          NewIntegerValue(1),
+		Stop:           NewIntegerValue(10),
+	}
+	expect := "generate_series(1, 10)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e.Step = NewIntegerValue(2)
+	expect = "generate_series(1, 10, 2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestRevisionTable_String(t *testing.T) {
+	e := RevisionTable{
+		Table:    Identifier{Literal: "data"},
+		At:       "at",
+		Revision: NewStringValue("HEAD~3"),
+	}
+	expect := "data at 'HEAD~3'"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestTailTable_String(t *testing.T) {
+	e := TailTable{
+		Tail: "tail",
+		Path: Identifier{Literal: "events.csv", Quoted: true},
+	}
+	expect := "tail `events.csv`"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestTableFunction_String(t *testing.T) {
+	e := TableFunction{
+		Table: "table",
+		Function: Function{
+			Name: "func1",
+			Args: []QueryExpression{NewIntegerValueFromString("1"), NewStringValue("a")},
+		},
+	}
+	expect := "table(func1(1, 'a'))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestJsonTable_String(t *testing.T) {
+	e := JsonTable{
+		JsonTable: "json_table",
+		JsonText:  Identifier{Literal: "column"},
+		Query:     NewStringValue("items"),
+		Columns: []QueryExpression{
+			JsonTableColumn{Name: Identifier{Literal: "id"}},
+			JsonTableColumn{Name: Identifier{Literal: "city"}, Path: NewStringValue("address.city")},
+		},
+	}
+	expect := "json_table(column, 'items' COLUMNS (id, city PATH 'address.city'))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestArrayValue_String(t *testing.T) {
+	e := ArrayValue{
+		Values: []QueryExpression{
+			NewIntegerValueFromString("1"),
+			NewStringValue("a"),
+		},
+	}
+	expect := "[1, 'a']"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestArrayElement_String(t *testing.T) {
+	e := ArrayElement{
+		Array: Identifier{Literal: "column"},
+		Index: NewIntegerValueFromString("1"),
+	}
+	expect := "column[1]"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestMemberReference_String(t *testing.T) {
+	e := MemberReference{
+		Value:  FieldReference{View: Identifier{Literal: "table1"}, Column: Identifier{Literal: "column1"}},
+		Member: Identifier{Literal: "key"},
+	}
+	expect := "table1.column1.key"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestCollate_String(t *testing.T) {
+	e := Collate{
+		Value:     FieldReference{Column: Identifier{Literal: "column1"}},
+		Collate:   "collate",
+		Collation: Identifier{Literal: "unicode"},
+	}
+	expect := "column1 collate unicode"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestAtTimeZone_String(t *testing.T) {
+	e := AtTimeZone{
+		Value:      FieldReference{Column: Identifier{Literal: "column1"}},
+		AtTimeZone: "at time zone",
+		TimeZone:   NewStringValue("Asia/Tokyo"),
+	}
+	expect := "column1 at time zone 'Asia/Tokyo'"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestComparison_String(t *testing.T) {
 	e := Comparison{
 		LHS:      Identifier{Literal: "column"},
@@ -983,6 +1249,80 @@ func TestAggregateFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = AggregateFunction{
+		Name: "percentile_disc",
+		Args: []QueryExpression{
+			NewFloatValueFromString("0.5"),
+		},
+		WithinGroup: "within group",
+		OrderBy: OrderByClause{
+			OrderBy: "order by",
+			Items:   []QueryExpression{Identifier{Literal: "column"}},
+		},
+	}
+	expect = "percentile_disc(0.5) within group (order by column)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = AggregateFunction{
+		Name: "count",
+		Args: []QueryExpression{
+			AllColumns{},
+		},
+		Filter: FilterClause{
+			Filter: "filter",
+			Where: WhereClause{
+				Where:  "where",
+				Filter: Identifier{Literal: "column"},
+			},
+		},
+	}
+	expect = "count(*) filter (where column)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+var setAggregateFilterTests = []struct {
+	Expr   QueryExpression
+	Result QueryExpression
+}{
+	{
+		Expr: Function{Name: "userfunc", Args: []QueryExpression{Identifier{Literal: "column"}}},
+		Result: AggregateFunction{
+			Name:   "userfunc",
+			Args:   []QueryExpression{Identifier{Literal: "column"}},
+			Filter: FilterClause{Filter: "filter", Where: WhereClause{Where: "where", Filter: NewTernaryValueFromString("true")}},
+		},
+	},
+	{
+		Expr: AggregateFunction{Name: "sum", Args: []QueryExpression{Identifier{Literal: "column"}}},
+		Result: AggregateFunction{
+			Name:   "sum",
+			Args:   []QueryExpression{Identifier{Literal: "column"}},
+			Filter: FilterClause{Filter: "filter", Where: WhereClause{Where: "where", Filter: NewTernaryValueFromString("true")}},
+		},
+	},
+	{
+		Expr: ListFunction{Name: "listagg", Args: []QueryExpression{Identifier{Literal: "column"}}},
+		Result: ListFunction{
+			Name:   "listagg",
+			Args:   []QueryExpression{Identifier{Literal: "column"}},
+			Filter: FilterClause{Filter: "filter", Where: WhereClause{Where: "where", Filter: NewTernaryValueFromString("true")}},
+		},
+	},
+}
+
+func TestSetAggregateFilter(t *testing.T) {
+	filter := FilterClause{Filter: "filter", Where: WhereClause{Where: "where", Filter: NewTernaryValueFromString("true")}}
+	for _, v := range setAggregateFilterTests {
+		result := SetAggregateFilter(v.Expr, filter)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %#v, want %#v for %#v", result, v.Result, v.Expr)
+		}
+	}
 }
 
 func TestAggregateFunction_IsDistinct(t *testing.T) {
@@ -1036,6 +1376,29 @@ func TestTable_Name(t *testing.T) {
 		t.Errorf("name = %q, want %q for %#v", e.Name(), expect, e)
 	}
 
+	e = Table{
+		Object: RevisionTable{
+			Table:    Identifier{Literal: "/path/to/table.csv"},
+			At:       "at",
+			Revision: NewStringValue("HEAD"),
+		},
+	}
+	expect = Identifier{Literal: "table"}
+	if !reflect.DeepEqual(e.Name(), expect) {
+		t.Errorf("name = %q, want %q for %#v", e.Name(), expect, e)
+	}
+
+	e = Table{
+		Object: TableFunction{
+			Table:    "table",
+			Function: Function{Name: "func1"},
+		},
+	}
+	expect = Identifier{Literal: "func1"}
+	if !reflect.DeepEqual(e.Name(), expect) {
+		t.Errorf("name = %q, want %q for %#v", e.Name(), expect, e)
+	}
+
 	e = Table{
 		Object: Subquery{
 			Query: SelectQuery{
@@ -0,0 +1,101 @@
+package parser
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Hint is an optimizer hint written in a comment beginning with "/*+" just after
+// the SELECT keyword, such as
+//
+//	SELECT /*+ HASH_JOIN(t1 t2) NO_PARALLEL */ * FROM t1 JOIN t2 USING (id)
+type Hint struct {
+	Name string
+	Args []string
+}
+
+func (h Hint) String() string {
+	if len(h.Args) < 1 {
+		return h.Name
+	}
+	return h.Name + "(" + strings.Join(h.Args, " ") + ")"
+}
+
+func FormatHints(hints []Hint) string {
+	s := make([]string, len(hints))
+	for i, v := range hints {
+		s[i] = v.String()
+	}
+	return "/*+ " + strings.Join(s, " ") + " */"
+}
+
+// ParseHints parses the text in a hint comment.
+//
+// The text is a list of hint names separated by spaces, each of which can be
+// followed by arguments enclosed in parentheses and separated by spaces or commas.
+// Since hints are comments, the text that does not follow the format is ignored
+// from the position.
+func ParseHints(s string) []Hint {
+	var hints []Hint
+
+	src := []rune(s)
+	pos := 0
+
+	skipSpaces := func() {
+		for pos < len(src) && unicode.IsSpace(src[pos]) {
+			pos++
+		}
+	}
+	readWord := func() string {
+		start := pos
+		for pos < len(src) && (unicode.IsLetter(src[pos]) || unicode.IsDigit(src[pos]) || src[pos] == '_' || src[pos] == '.') {
+			pos++
+		}
+		return string(src[start:pos])
+	}
+
+	for {
+		skipSpaces()
+		if len(src) <= pos {
+			break
+		}
+
+		name := readWord()
+		if len(name) < 1 {
+			break
+		}
+		hint := Hint{Name: strings.ToUpper(name)}
+
+		skipSpaces()
+		if pos < len(src) && src[pos] == '(' {
+			pos++
+			args := make([]string, 0, 2)
+			closed := false
+			for pos < len(src) {
+				for pos < len(src) && (unicode.IsSpace(src[pos]) || src[pos] == ',') {
+					pos++
+				}
+				if pos < len(src) && src[pos] == ')' {
+					pos++
+					closed = true
+					break
+				}
+				arg := readWord()
+				if len(arg) < 1 {
+					break
+				}
+				args = append(args, arg)
+			}
+			if !closed {
+				break
+			}
+			if 0 < len(args) {
+				hint.Args = args
+			}
+		}
+
+		hints = append(hints, hint)
+	}
+
+	return hints
+}
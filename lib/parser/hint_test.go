@@ -0,0 +1,77 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+var parseHintsTests = []struct {
+	Input  string
+	Output []Hint
+}{
+	{
+		Input:  "",
+		Output: nil,
+	},
+	{
+		Input: " hash_join(t1 t2) NO_PARALLEL ",
+		Output: []Hint{
+			{Name: "HASH_JOIN", Args: []string{"t1", "t2"}},
+			{Name: "NO_PARALLEL"},
+		},
+	},
+	{
+		Input: "parallel( 4 ) nested_loop_join(t1,t2, t3)",
+		Output: []Hint{
+			{Name: "PARALLEL", Args: []string{"4"}},
+			{Name: "NESTED_LOOP_JOIN", Args: []string{"t1", "t2", "t3"}},
+		},
+	},
+	{
+		Input: "no_parallel() hash_join",
+		Output: []Hint{
+			{Name: "NO_PARALLEL"},
+			{Name: "HASH_JOIN"},
+		},
+	},
+	{
+		Input: "hash_join(t1 t2) this is not a hint: parallel",
+		Output: []Hint{
+			{Name: "HASH_JOIN", Args: []string{"t1", "t2"}},
+			{Name: "THIS"},
+			{Name: "IS"},
+			{Name: "NOT"},
+			{Name: "A"},
+			{Name: "HINT"},
+		},
+	},
+	{
+		Input: "no_parallel hash_join(t1 t2",
+		Output: []Hint{
+			{Name: "NO_PARALLEL"},
+		},
+	},
+}
+
+func TestParseHints(t *testing.T) {
+	for _, v := range parseHintsTests {
+		result := ParseHints(v.Input)
+		if !reflect.DeepEqual(result, v.Output) {
+			t.Errorf("result = %#v, want %#v for %q", result, v.Output, v.Input)
+		}
+	}
+}
+
+func TestFormatHints(t *testing.T) {
+	hints := []Hint{
+		{Name: "HASH_JOIN", Args: []string{"t1", "t2"}},
+		{Name: "PARALLEL", Args: []string{"4"}},
+		{Name: "NO_PARALLEL"},
+	}
+	expect := "/*+ HASH_JOIN(t1 t2) PARALLEL(4) NO_PARALLEL */"
+
+	result := FormatHints(hints)
+	if result != expect {
+		t.Errorf("result = %q, want %q", result, expect)
+	}
+}
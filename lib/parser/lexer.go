@@ -1,18 +1,73 @@
 package parser
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 )
 
+// IdentifierResolver returns the identifier that replaces the identifier placeholder.
+type IdentifierResolver func(placeholder Placeholder) (string, error)
+
 type Lexer struct {
 	Scanner
 	program []Statement
 	token   Token
 	err     error
+
+	placeholderOrdinal int
+	ordinals           []int
+	resolveIdentifier  IdentifierResolver
+}
+
+func (l *Lexer) newPlaceholder(token Token) Placeholder {
+	if token.Literal == PositionalPlaceholder {
+		ordinal := l.ordinals[0]
+		l.ordinals = l.ordinals[1:]
+		return Placeholder{BaseExpr: NewBaseExpr(token), Literal: token.Literal, Ordinal: ordinal}
+	}
+	return Placeholder{BaseExpr: NewBaseExpr(token), Literal: token.Literal, Name: token.Literal[1:]}
+}
+
+// scanPlaceholder numbers the positional placeholders in order of appearance,
+// and replaces the identifier placeholders with the quoted identifiers.
+func (l *Lexer) scanPlaceholder(token Token) (Token, error) {
+	placeholder := Placeholder{BaseExpr: NewBaseExpr(token), Literal: token.Literal}
+
+	switch {
+	case token.Literal == PositionalPlaceholder:
+		l.placeholderOrdinal++
+		l.ordinals = append(l.ordinals, l.placeholderOrdinal)
+		return token, nil
+	case token.Literal == PositionalIdentifierPlaceholder:
+		l.placeholderOrdinal++
+		placeholder.Ordinal = l.placeholderOrdinal
+	case strings.HasPrefix(token.Literal, string(NamedPlaceholderSign)+string(NamedPlaceholderSign)):
+		placeholder.Name = token.Literal[2:]
+	default:
+		return token, nil
+	}
+
+	if l.resolveIdentifier == nil {
+		return token, errors.New(fmt.Sprintf("identifier placeholder %s is allowed only in EXECUTE IMMEDIATE", token.Literal))
+	}
+	ident, err := l.resolveIdentifier(placeholder)
+	if err != nil {
+		return token, err
+	}
+	token.Token = IDENTIFIER
+	token.Literal = ident
+	token.Quoted = true
+	return token, nil
 }
 
 func (l *Lexer) Lex(lval *yySymType) int {
 	tok, err := l.Scan()
+	if err == nil && tok.Token == PLACEHOLDER {
+		if tok, err = l.scanPlaceholder(tok); err != nil {
+			l.Scanner.err = err
+		}
+	}
 	if err != nil {
 		l.Error(err.Error())
 	}
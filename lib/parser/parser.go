@@ -29,10 +29,13 @@ type yySymType struct {
 	varassign   VariableAssignment
 	varassigns  []VariableAssignment
 	envvar      EnvironmentVariable
+	envvars     []EnvironmentVariable
 	updateset   UpdateSet
 	updatesets  []UpdateSet
 	columndef   ColumnDefault
 	columndefs  []ColumnDefault
+	tableattr   TableAttribute
+	tableattrs  []TableAttribute
 	elseif      []ElseIf
 	elseexpr    Else
 	casewhen    []CaseWhen
@@ -53,138 +56,172 @@ const FLAG = 57354
 const ENVIRONMENT_VARIABLE = 57355
 const RUNTIME_INFORMATION = 57356
 const EXTERNAL_COMMAND = 57357
-const SELECT = 57358
-const FROM = 57359
-const UPDATE = 57360
-const SET = 57361
-const UNSET = 57362
-const DELETE = 57363
-const WHERE = 57364
-const INSERT = 57365
-const INTO = 57366
-const VALUES = 57367
-const AS = 57368
-const DUAL = 57369
-const STDIN = 57370
-const RECURSIVE = 57371
-const CREATE = 57372
-const ADD = 57373
-const DROP = 57374
-const ALTER = 57375
-const TABLE = 57376
-const FIRST = 57377
-const LAST = 57378
-const AFTER = 57379
-const BEFORE = 57380
-const DEFAULT = 57381
-const RENAME = 57382
-const TO = 57383
-const VIEW = 57384
-const ORDER = 57385
-const GROUP = 57386
-const HAVING = 57387
-const BY = 57388
-const ASC = 57389
-const DESC = 57390
-const LIMIT = 57391
-const OFFSET = 57392
-const PERCENT = 57393
-const JOIN = 57394
-const INNER = 57395
-const OUTER = 57396
-const LEFT = 57397
-const RIGHT = 57398
-const FULL = 57399
-const CROSS = 57400
-const ON = 57401
-const USING = 57402
-const NATURAL = 57403
-const UNION = 57404
-const INTERSECT = 57405
-const EXCEPT = 57406
-const ALL = 57407
-const ANY = 57408
-const EXISTS = 57409
-const IN = 57410
-const AND = 57411
-const OR = 57412
-const NOT = 57413
-const BETWEEN = 57414
-const LIKE = 57415
-const IS = 57416
-const NULL = 57417
-const DISTINCT = 57418
-const WITH = 57419
-const RANGE = 57420
-const UNBOUNDED = 57421
-const PRECEDING = 57422
-const FOLLOWING = 57423
-const CURRENT = 57424
-const ROW = 57425
-const CASE = 57426
-const IF = 57427
-const ELSEIF = 57428
-const WHILE = 57429
-const WHEN = 57430
-const THEN = 57431
-const ELSE = 57432
-const DO = 57433
-const END = 57434
-const DECLARE = 57435
-const CURSOR = 57436
-const FOR = 57437
-const FETCH = 57438
-const OPEN = 57439
-const CLOSE = 57440
-const DISPOSE = 57441
-const NEXT = 57442
-const PRIOR = 57443
-const ABSOLUTE = 57444
-const RELATIVE = 57445
-const SEPARATOR = 57446
-const PARTITION = 57447
-const OVER = 57448
-const COMMIT = 57449
-const ROLLBACK = 57450
-const CONTINUE = 57451
-const BREAK = 57452
-const EXIT = 57453
-const ECHO = 57454
-const PRINT = 57455
-const PRINTF = 57456
-const SOURCE = 57457
-const EXECUTE = 57458
-const CHDIR = 57459
-const PWD = 57460
-const RELOAD = 57461
-const REMOVE = 57462
-const SYNTAX = 57463
-const TRIGGER = 57464
-const FUNCTION = 57465
-const AGGREGATE = 57466
-const BEGIN = 57467
-const RETURN = 57468
-const IGNORE = 57469
-const WITHIN = 57470
-const VAR = 57471
-const SHOW = 57472
-const TIES = 57473
-const NULLS = 57474
-const ROWS = 57475
-const JSON_ROW = 57476
-const JSON_TABLE = 57477
-const COUNT = 57478
-const JSON_OBJECT = 57479
-const AGGREGATE_FUNCTION = 57480
-const LIST_FUNCTION = 57481
-const ANALYTIC_FUNCTION = 57482
-const FUNCTION_NTH = 57483
-const FUNCTION_WITH_INS = 57484
-const COMPARISON_OP = 57485
-const STRING_OP = 57486
-const SUBSTITUTION_OP = 57487
-const UMINUS = 57488
-const UPLUS = 57489
+const PLACEHOLDER = 57358
+const HINT = 57359
+const SELECT = 57360
+const FROM = 57361
+const UPDATE = 57362
+const SET = 57363
+const UNSET = 57364
+const EXPORT = 57365
+const DELETE = 57366
+const WHERE = 57367
+const INSERT = 57368
+const INTO = 57369
+const VALUES = 57370
+const AS = 57371
+const DUAL = 57372
+const STDIN = 57373
+const COPY = 57374
+const RECURSIVE = 57375
+const CREATE = 57376
+const ADD = 57377
+const DROP = 57378
+const ALTER = 57379
+const TABLE = 57380
+const FIRST = 57381
+const LAST = 57382
+const AFTER = 57383
+const BEFORE = 57384
+const DEFAULT = 57385
+const RENAME = 57386
+const TO = 57387
+const VIEW = 57388
+const CHECK = 57389
+const CONSTRAINT = 57390
+const UNIQUE = 57391
+const AUTO_INCREMENT = 57392
+const ORDER = 57393
+const GROUP = 57394
+const HAVING = 57395
+const BY = 57396
+const ASC = 57397
+const DESC = 57398
+const LIMIT = 57399
+const OFFSET = 57400
+const PERCENT = 57401
+const JOIN = 57402
+const INNER = 57403
+const OUTER = 57404
+const LEFT = 57405
+const RIGHT = 57406
+const FULL = 57407
+const CROSS = 57408
+const ON = 57409
+const USING = 57410
+const NATURAL = 57411
+const UNION = 57412
+const INTERSECT = 57413
+const EXCEPT = 57414
+const ALL = 57415
+const ANY = 57416
+const EXISTS = 57417
+const IN = 57418
+const AND = 57419
+const OR = 57420
+const NOT = 57421
+const BETWEEN = 57422
+const LIKE = 57423
+const IS = 57424
+const NULL = 57425
+const DISTINCT = 57426
+const WITH = 57427
+const COLLATE = 57428
+const RANGE = 57429
+const UNBOUNDED = 57430
+const PRECEDING = 57431
+const FOLLOWING = 57432
+const CURRENT = 57433
+const ROW = 57434
+const CASE = 57435
+const IF = 57436
+const ELSEIF = 57437
+const WHILE = 57438
+const WHEN = 57439
+const THEN = 57440
+const ELSE = 57441
+const DO = 57442
+const END = 57443
+const TRY = 57444
+const CATCH = 57445
+const DECLARE = 57446
+const CURSOR = 57447
+const FOR = 57448
+const FETCH = 57449
+const OPEN = 57450
+const CLOSE = 57451
+const DISPOSE = 57452
+const NEXT = 57453
+const PRIOR = 57454
+const ABSOLUTE = 57455
+const RELATIVE = 57456
+const BULK = 57457
+const SEPARATOR = 57458
+const PARTITION = 57459
+const OVER = 57460
+const COMMIT = 57461
+const ROLLBACK = 57462
+const CONTINUE = 57463
+const BREAK = 57464
+const EXIT = 57465
+const ECHO = 57466
+const PRINT = 57467
+const PRINTF = 57468
+const SOURCE = 57469
+const IMPORT = 57470
+const EXECUTE = 57471
+const IMMEDIATE = 57472
+const PREPARE = 57473
+const CHDIR = 57474
+const PWD = 57475
+const RELOAD = 57476
+const REMOVE = 57477
+const SYNTAX = 57478
+const TRIGGER = 57479
+const ASSERT = 57480
+const EXPECT = 57481
+const FUNCTION = 57482
+const AGGREGATE = 57483
+const BEGIN = 57484
+const RETURN = 57485
+const VARIADIC = 57486
+const IGNORE = 57487
+const WITHIN = 57488
+const FILTER = 57489
+const VAR = 57490
+const SHOW = 57491
+const EXPLAIN = 57492
+const TIES = 57493
+const NULLS = 57494
+const ROWS = 57495
+const COLUMNS = 57496
+const PATH = 57497
+const AT = 57498
+const TYPE = 57499
+const ANALYZE = 57500
+const ESTIMATE = 57501
+const TIME = 57502
+const ZONE = 57503
+const MESSAGE = 57504
+const EQUAL = 57505
+const INDEX = 57506
+const JSON_ROW = 57507
+const JSON_TABLE = 57508
+const UNNEST = 57509
+const GENERATE_SERIES = 57510
+const TAIL = 57511
+const COUNT = 57512
+const JSON_OBJECT = 57513
+const AGGREGATE_FUNCTION = 57514
+const LIST_FUNCTION = 57515
+const ANALYTIC_FUNCTION = 57516
+const FUNCTION_NTH = 57517
+const FUNCTION_WITH_INS = 57518
+const COMPARISON_OP = 57519
+const STRING_OP = 57520
+const SUBSTITUTION_OP = 57521
+const UMINUS = 57522
+const UPLUS = 57523
 
 var yyToknames = [...]string{
 	"$end",
@@ -202,11 +239,14 @@ var yyToknames = [...]string{
 	"ENVIRONMENT_VARIABLE",
 	"RUNTIME_INFORMATION",
 	"EXTERNAL_COMMAND",
+	"PLACEHOLDER",
+	"HINT",
 	"SELECT",
 	"FROM",
 	"UPDATE",
 	"SET",
 	"UNSET",
+	"EXPORT",
 	"DELETE",
 	"WHERE",
 	"INSERT",
@@ -215,6 +255,7 @@ var yyToknames = [...]string{
 	"AS",
 	"DUAL",
 	"STDIN",
+	"COPY",
 	"RECURSIVE",
 	"CREATE",
 	"ADD",
@@ -229,6 +270,10 @@ var yyToknames = [...]string{
 	"RENAME",
 	"TO",
 	"VIEW",
+	"CHECK",
+	"CONSTRAINT",
+	"UNIQUE",
+	"AUTO_INCREMENT",
 	"ORDER",
 	"GROUP",
 	"HAVING",
@@ -264,6 +309,7 @@ var yyToknames = [...]string{
 	"NULL",
 	"DISTINCT",
 	"WITH",
+	"COLLATE",
 	"RANGE",
 	"UNBOUNDED",
 	"PRECEDING",
@@ -279,6 +325,8 @@ var yyToknames = [...]string{
 	"ELSE",
 	"DO",
 	"END",
+	"TRY",
+	"CATCH",
 	"DECLARE",
 	"CURSOR",
 	"FOR",
@@ -290,6 +338,7 @@ var yyToknames = [...]string{
 	"PRIOR",
 	"ABSOLUTE",
 	"RELATIVE",
+	"BULK",
 	"SEPARATOR",
 	"PARTITION",
 	"OVER",
@@ -302,26 +351,48 @@ var yyToknames = [...]string{
 	"PRINT",
 	"PRINTF",
 	"SOURCE",
+	"IMPORT",
 	"EXECUTE",
+	"IMMEDIATE",
+	"PREPARE",
 	"CHDIR",
 	"PWD",
 	"RELOAD",
 	"REMOVE",
 	"SYNTAX",
 	"TRIGGER",
+	"ASSERT",
+	"EXPECT",
 	"FUNCTION",
 	"AGGREGATE",
 	"BEGIN",
 	"RETURN",
+	"VARIADIC",
 	"IGNORE",
 	"WITHIN",
+	"FILTER",
 	"VAR",
 	"SHOW",
+	"EXPLAIN",
 	"TIES",
 	"NULLS",
 	"ROWS",
+	"COLUMNS",
+	"PATH",
+	"AT",
+	"TYPE",
+	"ANALYZE",
+	"ESTIMATE",
+	"TIME",
+	"ZONE",
+	"MESSAGE",
+	"EQUAL",
+	"INDEX",
 	"JSON_ROW",
 	"JSON_TABLE",
+	"UNNEST",
+	"GENERATE_SERIES",
+	"TAIL",
 	"COUNT",
 	"JSON_OBJECT",
 	"AGGREGATE_FUNCTION",
@@ -339,13 +410,16 @@ var yyToknames = [...]string{
 	"'='",
 	"'-'",
 	"'+'",
+	"'/'",
+	"'%'",
 	"'!'",
 	"'('",
 	"')'",
-	"'/'",
-	"'%'",
-	"','",
+	"'['",
+	"']'",
 	"'.'",
+	"':'",
+	"','",
 }
 var yyStatenames = [...]string{}
 
@@ -353,7 +427,7 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line parser.y:2313
+//line parser.y:3022
 
 func SetDebugLevel(level int, verbose bool) {
 	yyDebug = level
@@ -367,1095 +441,1584 @@ func Parse(s string, sourceFile string) ([]Statement, error) {
 	return l.program, l.err
 }
 
+// ParsePreparedStatement parses the statements that may contain placeholders,
+// and returns the number of the positional placeholders in the statements.
+func ParsePreparedStatement(s string, sourceFile string) ([]Statement, int, error) {
+	l := new(Lexer)
+	l.Init(s, sourceFile)
+	yyParse(l)
+	return l.program, l.placeholderOrdinal, l.err
+}
+
+// ParseImmediateStatement parses the statements that may contain placeholders and identifier placeholders,
+// and returns the number of the positional placeholders in the statements.
+// Identifier placeholders are replaced with the identifiers returned by resolveIdentifier.
+func ParseImmediateStatement(s string, sourceFile string, resolveIdentifier IdentifierResolver) ([]Statement, int, error) {
+	l := new(Lexer)
+	l.Init(s, sourceFile)
+	l.resolveIdentifier = resolveIdentifier
+	yyParse(l)
+	return l.program, l.placeholderOrdinal, l.err
+}
+
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 0,
 	1, 1,
-	-2, 186,
+	-2, 281,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 29,
-	1, 73,
-	86, 73,
-	88, 73,
-	90, 73,
-	92, 73,
-	148, 73,
-	-2, 216,
-	-1, 99,
-	16, 186,
-	18, 186,
-	21, 186,
-	23, 186,
+	-1, 30,
+	103, 4,
+	-2, 281,
+	-1, 36,
+	1, 92,
+	95, 92,
+	97, 92,
+	99, 92,
+	101, 92,
+	182, 92,
+	-2, 313,
+	-1, 129,
+	18, 281,
+	20, 281,
+	24, 281,
+	26, 281,
 	-2, 1,
-	-1, 117,
-	155, 273,
-	-2, 186,
-	-1, 123,
-	62, 166,
-	63, 166,
-	64, 166,
-	-2, 177,
-	-1, 162,
-	1, 146,
-	86, 146,
-	88, 146,
-	90, 146,
-	92, 146,
-	148, 146,
-	-2, 200,
-	-1, 167,
-	1, 154,
-	86, 154,
-	88, 154,
-	90, 154,
-	92, 154,
-	148, 154,
-	-2, 200,
-	-1, 208,
-	68, 0,
-	72, 0,
-	73, 0,
-	74, 0,
-	143, 0,
-	150, 0,
-	-2, 243,
+	-1, 151,
+	191, 379,
+	-2, 281,
+	-1, 163,
+	70, 260,
+	71, 260,
+	72, 260,
+	-2, 272,
 	-1, 209,
-	68, 0,
-	72, 0,
-	73, 0,
-	74, 0,
-	143, 0,
-	150, 0,
-	-2, 245,
-	-1, 218,
-	68, 0,
-	72, 0,
-	73, 0,
-	74, 0,
-	143, 0,
-	150, 0,
-	-2, 255,
-	-1, 228,
-	86, 1,
-	90, 1,
-	92, 1,
-	-2, 186,
-	-1, 283,
-	92, 4,
-	-2, 186,
-	-1, 330,
-	68, 0,
-	72, 0,
-	73, 0,
-	74, 0,
-	143, 0,
-	150, 0,
-	-2, 256,
-	-1, 337,
-	92, 1,
-	-2, 186,
-	-1, 349,
-	52, 425,
-	-2, 357,
-	-1, 382,
-	1, 76,
-	86, 76,
-	88, 76,
-	90, 76,
-	92, 76,
-	148, 76,
-	-2, 200,
-	-1, 384,
-	1, 78,
-	86, 78,
-	88, 78,
-	90, 78,
-	92, 78,
-	148, 78,
-	-2, 200,
-	-1, 385,
-	1, 134,
-	86, 134,
-	88, 134,
-	90, 134,
-	92, 134,
-	148, 134,
-	-2, 200,
-	-1, 387,
-	1, 136,
-	86, 136,
-	88, 136,
-	90, 136,
-	92, 136,
-	148, 136,
-	-2, 200,
-	-1, 447,
-	92, 1,
-	-2, 186,
-	-1, 454,
-	88, 1,
-	90, 1,
-	92, 1,
-	-2, 186,
-	-1, 519,
-	86, 4,
-	88, 4,
-	90, 4,
-	92, 4,
-	-2, 186,
-	-1, 522,
-	92, 4,
-	-2, 186,
-	-1, 523,
-	92, 4,
-	-2, 186,
-	-1, 591,
-	16, 435,
-	77, 435,
-	154, 435,
-	-2, 82,
-	-1, 614,
-	86, 4,
-	90, 4,
-	92, 4,
-	-2, 186,
-	-1, 619,
-	92, 4,
-	-2, 186,
-	-1, 620,
-	92, 4,
-	-2, 186,
-	-1, 641,
-	86, 1,
-	90, 1,
-	92, 1,
-	-2, 186,
-	-1, 676,
-	1, 90,
-	86, 90,
-	88, 90,
-	90, 90,
-	92, 90,
-	148, 90,
-	-2, 200,
+	1, 224,
+	95, 224,
+	97, 224,
+	99, 224,
+	101, 224,
+	182, 224,
+	-2, 295,
+	-1, 221,
+	1, 240,
+	95, 240,
+	97, 240,
+	99, 240,
+	101, 240,
+	182, 240,
+	-2, 295,
+	-1, 271,
+	76, 0,
+	80, 0,
+	81, 0,
+	82, 0,
+	177, 0,
+	184, 0,
+	-2, 349,
+	-1, 272,
+	76, 0,
+	80, 0,
+	81, 0,
+	82, 0,
+	177, 0,
+	184, 0,
+	-2, 351,
+	-1, 281,
+	76, 0,
+	80, 0,
+	81, 0,
+	82, 0,
+	177, 0,
+	184, 0,
+	-2, 361,
+	-1, 291,
+	95, 1,
+	99, 1,
+	101, 1,
+	-2, 281,
+	-1, 305,
+	101, 1,
+	-2, 281,
+	-1, 306,
+	103, 4,
+	-2, 281,
+	-1, 372,
+	101, 6,
+	-2, 281,
+	-1, 417,
+	76, 0,
+	80, 0,
+	81, 0,
+	82, 0,
+	177, 0,
+	184, 0,
+	-2, 362,
+	-1, 424,
+	101, 1,
+	-2, 281,
+	-1, 442,
+	60, 576,
+	-2, 480,
+	-1, 486,
+	1, 95,
+	95, 95,
+	97, 95,
+	99, 95,
+	101, 95,
+	182, 95,
+	-2, 295,
+	-1, 488,
+	1, 97,
+	95, 97,
+	97, 97,
+	99, 97,
+	101, 97,
+	182, 97,
+	-2, 295,
+	-1, 489,
+	1, 212,
+	95, 212,
+	97, 212,
+	99, 212,
+	101, 212,
+	182, 212,
+	-2, 295,
+	-1, 491,
+	1, 214,
+	95, 214,
+	97, 214,
+	99, 214,
+	101, 214,
+	182, 214,
+	-2, 295,
+	-1, 525,
+	103, 6,
+	-2, 281,
+	-1, 565,
+	101, 1,
+	-2, 281,
+	-1, 572,
+	97, 1,
+	99, 1,
+	101, 1,
+	-2, 281,
 	-1, 679,
-	92, 6,
-	-2, 186,
-	-1, 690,
-	92, 4,
-	-2, 186,
-	-1, 746,
-	92, 6,
-	-2, 186,
-	-1, 747,
-	92, 6,
-	-2, 186,
-	-1, 751,
-	92, 4,
-	-2, 186,
-	-1, 755,
-	88, 4,
-	90, 4,
-	92, 4,
-	-2, 186,
-	-1, 775,
-	88, 1,
-	90, 1,
-	92, 1,
-	-2, 186,
-	-1, 788,
-	86, 6,
-	88, 6,
-	90, 6,
-	92, 6,
-	-2, 186,
+	18, 281,
+	20, 281,
+	24, 281,
+	26, 281,
+	-2, 6,
+	-1, 686,
+	101, 6,
+	-2, 281,
+	-1, 687,
+	101, 6,
+	-2, 281,
+	-1, 764,
+	18, 586,
+	85, 586,
+	190, 586,
+	-2, 103,
+	-1, 766,
+	18, 586,
+	85, 586,
+	190, 586,
+	-2, 104,
+	-1, 821,
+	95, 6,
+	99, 6,
+	101, 6,
+	-2, 281,
+	-1, 825,
+	101, 6,
+	-2, 281,
 	-1, 828,
-	86, 6,
-	90, 6,
-	92, 6,
-	-2, 186,
-	-1, 831,
-	92, 8,
-	-2, 186,
-	-1, 836,
-	92, 6,
-	-2, 186,
-	-1, 839,
-	86, 4,
-	90, 4,
-	92, 4,
-	-2, 186,
-	-1, 862,
-	92, 6,
-	-2, 186,
-	-1, 890,
-	92, 6,
-	-2, 186,
-	-1, 894,
-	88, 6,
-	90, 6,
-	92, 6,
-	-2, 186,
-	-1, 896,
-	86, 8,
-	88, 8,
-	90, 8,
-	92, 8,
-	-2, 186,
-	-1, 899,
-	92, 8,
-	-2, 186,
-	-1, 900,
-	92, 8,
-	-2, 186,
-	-1, 903,
-	88, 4,
-	90, 4,
-	92, 4,
-	-2, 186,
-	-1, 915,
-	86, 8,
-	90, 8,
-	92, 8,
-	-2, 186,
-	-1, 924,
-	86, 6,
-	90, 6,
-	92, 6,
-	-2, 186,
-	-1, 929,
-	92, 8,
-	-2, 186,
-	-1, 943,
-	92, 8,
-	-2, 186,
-	-1, 947,
-	88, 8,
-	90, 8,
-	92, 8,
-	-2, 186,
-	-1, 959,
-	88, 6,
-	90, 6,
-	92, 6,
-	-2, 186,
-	-1, 973,
-	86, 8,
-	90, 8,
-	92, 8,
-	-2, 186,
-	-1, 984,
-	88, 8,
-	90, 8,
-	92, 8,
-	-2, 186,
+	101, 6,
+	-2, 281,
+	-1, 829,
+	101, 6,
+	-2, 281,
+	-1, 852,
+	95, 1,
+	99, 1,
+	101, 1,
+	-2, 281,
+	-1, 914,
+	1, 117,
+	95, 117,
+	97, 117,
+	99, 117,
+	101, 117,
+	182, 117,
+	-2, 295,
+	-1, 920,
+	101, 8,
+	-2, 281,
+	-1, 936,
+	101, 6,
+	-2, 281,
+	-1, 1015,
+	103, 8,
+	-2, 281,
+	-1, 1018,
+	101, 8,
+	-2, 281,
+	-1, 1019,
+	101, 8,
+	-2, 281,
+	-1, 1021,
+	101, 8,
+	-2, 281,
+	-1, 1027,
+	101, 6,
+	-2, 281,
+	-1, 1031,
+	97, 6,
+	99, 6,
+	101, 6,
+	-2, 281,
+	-1, 1053,
+	97, 1,
+	99, 1,
+	101, 1,
+	-2, 281,
+	-1, 1076,
+	18, 586,
+	85, 586,
+	190, 586,
+	-2, 108,
+	-1, 1084,
+	101, 8,
+	-2, 281,
+	-1, 1086,
+	18, 281,
+	20, 281,
+	24, 281,
+	26, 281,
+	-2, 8,
+	-1, 1153,
+	95, 8,
+	99, 8,
+	101, 8,
+	-2, 281,
+	-1, 1157,
+	101, 8,
+	-2, 281,
+	-1, 1158,
+	101, 10,
+	-2, 281,
+	-1, 1165,
+	101, 8,
+	-2, 281,
+	-1, 1167,
+	101, 8,
+	-2, 281,
+	-1, 1173,
+	95, 6,
+	99, 6,
+	101, 6,
+	-2, 281,
+	-1, 1210,
+	101, 8,
+	-2, 281,
+	-1, 1223,
+	103, 10,
+	-2, 281,
+	-1, 1249,
+	101, 8,
+	-2, 281,
+	-1, 1253,
+	97, 8,
+	99, 8,
+	101, 8,
+	-2, 281,
+	-1, 1256,
+	18, 281,
+	20, 281,
+	24, 281,
+	26, 281,
+	-2, 10,
+	-1, 1261,
+	101, 10,
+	-2, 281,
+	-1, 1262,
+	101, 10,
+	-2, 281,
+	-1, 1266,
+	97, 6,
+	99, 6,
+	101, 6,
+	-2, 281,
+	-1, 1285,
+	95, 10,
+	99, 10,
+	101, 10,
+	-2, 281,
+	-1, 1289,
+	101, 10,
+	-2, 281,
+	-1, 1297,
+	95, 8,
+	99, 8,
+	101, 8,
+	-2, 281,
+	-1, 1302,
+	101, 10,
+	-2, 281,
+	-1, 1318,
+	101, 10,
+	-2, 281,
+	-1, 1322,
+	97, 10,
+	99, 10,
+	101, 10,
+	-2, 281,
+	-1, 1335,
+	97, 8,
+	99, 8,
+	101, 8,
+	-2, 281,
+	-1, 1350,
+	95, 10,
+	99, 10,
+	101, 10,
+	-2, 281,
+	-1, 1361,
+	97, 10,
+	99, 10,
+	101, 10,
+	-2, 281,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 3689
+const yyLast = 6415
 
 var yyAct = [...]int{
 
-	18, 942, 952, 941, 916, 304, 743, 889, 888, 121,
-	750, 829, 615, 458, 809, 178, 543, 295, 808, 118,
-	29, 116, 122, 496, 405, 23, 912, 844, 404, 22,
-	24, 349, 230, 749, 446, 598, 721, 234, 803, 155,
-	156, 593, 159, 160, 161, 163, 164, 166, 168, 83,
-	568, 510, 513, 807, 576, 742, 368, 468, 558, 1,
-	512, 359, 560, 233, 165, 965, 172, 176, 302, 476,
-	475, 245, 299, 348, 445, 599, 197, 345, 190, 191,
-	183, 362, 350, 173, 187, 128, 201, 202, 76, 406,
-	188, 239, 493, 134, 434, 187, 175, 400, 3, 74,
-	832, 207, 208, 209, 785, 211, 413, 786, 218, 61,
-	221, 222, 223, 224, 225, 226, 227, 123, 172, 29,
-	423, 122, 137, 189, 23, 187, 188, 782, 22, 610,
-	232, 187, 611, 672, 651, 229, 634, 136, 136, 480,
-	139, 481, 482, 477, 474, 111, 51, 478, 175, 284,
-	608, 236, 112, 113, 268, 269, 215, 607, 206, 188,
-	662, 100, 175, 663, 187, 592, 111, 572, 110, 109,
-	563, 277, 279, 112, 113, 87, 177, 171, 285, 480,
-	210, 481, 482, 477, 474, 421, 347, 478, 289, 166,
-	285, 254, 906, 303, 905, 288, 285, 3, 885, 884,
-	129, 68, 125, 240, 240, 126, 324, 124, 463, 883,
-	882, 253, 881, 328, 859, 330, 858, 166, 244, 857,
-	111, 293, 110, 109, 91, 855, 171, 112, 113, 853,
-	852, 91, 166, 843, 173, 842, 340, 784, 748, 285,
-	703, 129, 98, 702, 479, 315, 316, 175, 29, 701,
-	700, 303, 699, 23, 696, 70, 375, 22, 98, 674,
-	68, 91, 216, 329, 381, 383, 386, 388, 123, 331,
-	332, 671, 650, 633, 166, 166, 166, 166, 216, 397,
-	631, 583, 630, 629, 353, 242, 623, 333, 622, 287,
-	606, 393, 394, 395, 396, 166, 604, 591, 548, 326,
-	541, 410, 540, 29, 539, 528, 250, 420, 418, 91,
-	325, 334, 281, 282, 166, 166, 416, 856, 266, 509,
-	361, 344, 398, 366, 166, 437, 3, 419, 443, 854,
-	364, 365, 353, 242, 68, 378, 449, 464, 131, 815,
-	453, 369, 814, 457, 461, 435, 430, 431, 374, 462,
-	813, 92, 93, 94, 812, 811, 441, 29, 92, 93,
-	94, 491, 23, 778, 136, 773, 22, 433, 770, 768,
-	415, 767, 761, 760, 500, 175, 545, 526, 487, 131,
-	486, 503, 429, 432, 428, 175, 485, 427, 92, 93,
-	94, 426, 356, 425, 424, 411, 451, 380, 440, 175,
-	379, 507, 231, 520, 122, 517, 205, 175, 204, 175,
-	473, 354, 131, 521, 438, 439, 194, 193, 192, 199,
-	264, 573, 303, 896, 166, 240, 788, 265, 166, 166,
-	166, 519, 488, 99, 255, 3, 92, 93, 94, 472,
-	356, 527, 171, 549, 417, 550, 499, 921, 492, 554,
-	494, 495, 321, 91, 771, 557, 531, 559, 91, 354,
-	536, 537, 538, 377, 769, 649, 175, 29, 647, 367,
-	91, 243, 23, 637, 29, 766, 22, 70, 836, 23,
-	567, 707, 242, 22, 747, 544, 91, 584, 586, 515,
-	746, 195, 484, 470, 529, 294, 679, 637, 196, 411,
-	313, 314, 708, 705, 821, 819, 553, 765, 764, 91,
-	242, 323, 763, 544, 322, 91, 762, 297, 502, 504,
-	704, 552, 698, 810, 706, 376, 972, 960, 91, 263,
-	292, 467, 578, 166, 166, 166, 166, 257, 945, 29,
-	571, 547, 29, 29, 91, 3, 635, 91, 580, 579,
-	587, 87, 3, 581, 601, 157, 642, 175, 613, 91,
-	932, 617, 618, 931, 461, 624, 625, 626, 628, 462,
-	546, 87, 923, 907, 654, 901, 895, 648, 892, 838,
-	92, 93, 94, 835, 834, 92, 93, 94, 798, 256,
-	665, 166, 632, 787, 759, 643, 758, 92, 93, 94,
-	753, 673, 141, 627, 677, 693, 655, 656, 666, 692,
-	685, 569, 640, 92, 93, 94, 668, 691, 258, 259,
-	551, 518, 644, 452, 646, 450, 900, 151, 152, 899,
-	653, 949, 620, 652, 29, 660, 92, 93, 94, 29,
-	29, 619, 92, 93, 94, 944, 714, 523, 667, 943,
-	569, 709, 522, 688, 140, 92, 93, 94, 694, 695,
-	681, 29, 729, 687, 166, 943, 23, 929, 682, 683,
-	22, 92, 93, 94, 92, 93, 94, 890, 862, 751,
-	643, 175, 975, 142, 544, 690, 92, 93, 94, 724,
-	725, 726, 149, 150, 153, 154, 730, 891, 175, 29,
-	713, 890, 720, 752, 447, 339, 736, 751, 734, 175,
-	29, 772, 448, 733, 5, 337, 447, 515, 684, 470,
-	926, 515, 917, 777, 841, 830, 645, 616, 335, 754,
-	532, 533, 534, 535, 235, 776, 948, 913, 805, 3,
-	804, 789, 122, 669, 670, 791, 794, 757, 774, 756,
-	612, 790, 944, 801, 891, 752, 557, 448, 979, 781,
-	793, 779, 971, 938, 922, 876, 29, 29, 544, 837,
-	712, 29, 639, 795, 796, 29, 953, 738, 964, 911,
-	174, 825, 817, 802, 799, 817, 816, 166, 556, 820,
-	800, 175, 108, 970, 957, 29, 968, 969, 569, 982,
-	23, 823, 824, 967, 22, 956, 955, 636, 29, 718,
-	68, 562, 251, 199, 966, 827, 840, 95, 936, 826,
-	213, 833, 818, 953, 212, 214, 542, 414, 286, 318,
-	817, 863, 174, 317, 851, 248, 320, 319, 871, 220,
-	219, 363, 878, 577, 738, 738, 174, 166, 29, 977,
-	727, 29, 954, 659, 792, 860, 29, 658, 657, 29,
-	342, 68, 575, 875, 880, 847, 848, 849, 850, 897,
-	122, 817, 574, 3, 456, 887, 198, 879, 877, 898,
-	461, 846, 29, 96, 934, 462, 738, 870, 902, 893,
-	590, 935, 910, 904, 937, 557, 951, 864, 908, 954,
-	247, 248, 249, 871, 565, 566, 871, 871, 886, 480,
-	29, 481, 482, 343, 29, 589, 29, 909, 930, 29,
-	29, 872, 871, 29, 925, 711, 738, 940, 490, 866,
-	237, 174, 845, 603, 738, 29, 871, 594, 595, 596,
-	597, 602, 609, 600, 29, 963, 961, 958, 557, 29,
-	871, 939, 870, 133, 871, 870, 870, 716, 717, 132,
-	738, 186, 914, 29, 797, 918, 919, 29, 62, 978,
-	974, 870, 697, 69, 686, 680, 981, 678, 369, 29,
-	871, 927, 983, 605, 422, 870, 872, 389, 738, 872,
-	872, 871, 738, 29, 866, 946, 238, 866, 866, 870,
-	143, 145, 138, 870, 29, 872, 360, 146, 147, 962,
-	246, 346, 358, 866, 158, 272, 144, 88, 162, 872,
-	88, 167, 738, 169, 170, 391, 390, 866, 87, 870,
-	182, 185, 63, 872, 135, 928, 861, 872, 689, 980,
-	870, 866, 336, 8, 480, 866, 481, 482, 477, 474,
-	780, 55, 478, 469, 373, 7, 6, 738, 338, 465,
-	58, 300, 301, 872, 203, 352, 370, 371, 351, 174,
-	976, 866, 950, 933, 872, 372, 130, 920, 82, 57,
-	56, 60, 866, 498, 53, 59, 54, 715, 564, 460,
-	459, 506, 52, 508, 184, 455, 341, 588, 489, 241,
-	241, 127, 17, 16, 64, 148, 252, 241, 14, 514,
-	511, 13, 12, 9, 260, 261, 262, 15, 11, 10,
-	867, 739, 267, 865, 737, 401, 106, 115, 114, 105,
-	104, 107, 103, 399, 4, 480, 200, 481, 482, 477,
-	474, 722, 723, 478, 179, 2, 0, 0, 0, 0,
-	174, 0, 0, 0, 0, 0, 0, 0, 217, 290,
-	0, 291, 0, 296, 0, 0, 306, 0, 0, 0,
-	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
-	106, 115, 114, 105, 104, 107, 103, 0, 0, 0,
+	153, 28, 1317, 1286, 1328, 1248, 1316, 1154, 1194, 1247,
+	1070, 1026, 78, 822, 388, 1117, 68, 1116, 1025, 1281,
+	580, 1110, 626, 67, 304, 156, 1178, 523, 29, 661,
+	628, 28, 663, 691, 236, 972, 179, 564, 631, 791,
+	297, 786, 192, 193, 770, 707, 652, 658, 736, 660,
+	205, 187, 189, 191, 209, 659, 466, 214, 29, 728,
+	296, 221, 386, 223, 224, 502, 456, 599, 180, 745,
+	590, 316, 598, 115, 563, 441, 1115, 383, 293, 722,
+	1, 437, 215, 792, 310, 321, 253, 190, 241, 655,
+	175, 459, 108, 551, 168, 443, 2, 161, 106, 132,
+	442, 1159, 532, 622, 1242, 1075, 1141, 232, 160, 879,
+	521, 27, 159, 1023, 880, 603, 373, 604, 605, 600,
+	597, 160, 1062, 601, 259, 159, 178, 162, 85, 908,
+	28, 1170, 266, 267, 160, 163, 769, 1169, 159, 1259,
+	160, 27, 1168, 810, 159, 1089, 768, 766, 811, 261,
+	864, 769, 767, 1014, 764, 102, 524, 29, 132, 765,
+	300, 845, 832, 808, 806, 802, 312, 312, 740, 133,
+	731, 1012, 374, 324, 325, 312, 292, 77, 669, 538,
+	439, 295, 378, 335, 337, 337, 339, 340, 160, 615,
+	307, 134, 159, 682, 329, 347, 145, 132, 144, 143,
+	146, 147, 350, 132, 230, 130, 346, 131, 327, 264,
+	742, 1073, 177, 177, 278, 181, 1074, 331, 440, 230,
+	1341, 374, 374, 616, 273, 160, 330, 440, 133, 159,
+	603, 245, 604, 605, 600, 597, 374, 160, 601, 302,
+	27, 159, 158, 130, 379, 131, 380, 311, 311, 390,
+	602, 315, 336, 338, 407, 145, 326, 144, 143, 146,
+	147, 540, 235, 119, 130, 159, 131, 133, 1312, 299,
+	585, 94, 377, 133, 1294, 86, 87, 88, 89, 90,
+	91, 92, 93, 331, 95, 96, 97, 98, 99, 1202,
+	1008, 3, 28, 1275, 145, 1069, 1273, 1270, 146, 147,
+	1269, 101, 467, 130, 232, 131, 28, 28, 169, 130,
+	312, 131, 1268, 1246, 642, 454, 1244, 1241, 454, 29,
+	1238, 3, 390, 163, 1237, 399, 400, 101, 1236, 1235,
+	1234, 1206, 480, 29, 29, 397, 398, 1199, 1193, 1192,
+	1191, 1189, 486, 488, 489, 491, 1187, 169, 408, 165,
+	416, 1186, 1177, 166, 499, 164, 418, 419, 1176, 191,
+	752, 1150, 1148, 1140, 1138, 1133, 413, 1076, 1067, 1054,
+	1022, 420, 412, 522, 528, 1020, 531, 998, 997, 500,
+	501, 128, 430, 951, 507, 431, 950, 949, 128, 948,
+	947, 943, 911, 458, 515, 1201, 662, 907, 863, 463,
+	844, 1068, 27, 162, 432, 436, 279, 535, 464, 529,
+	841, 461, 462, 279, 482, 376, 27, 27, 474, 840,
+	3, 839, 833, 831, 805, 28, 804, 801, 723, 712,
+	705, 704, 586, 554, 494, 703, 390, 575, 588, 593,
+	312, 595, 537, 657, 512, 606, 467, 429, 454, 421,
+	584, 370, 29, 371, 613, 1245, 454, 1190, 552, 1188,
+	1144, 549, 592, 892, 1139, 390, 629, 534, 1136, 312,
+	640, 593, 593, 593, 645, 550, 547, 548, 1123, 1122,
+	171, 1121, 654, 1120, 1119, 666, 1078, 558, 1058, 1051,
+	1049, 1047, 557, 1045, 641, 643, 644, 555, 556, 1044,
+	1038, 1037, 1024, 1003, 569, 177, 996, 573, 596, 995,
+	984, 965, 897, 878, 857, 799, 785, 784, 782, 171,
+	709, 311, 690, 612, 594, 611, 522, 684, 685, 610,
+	667, 617, 609, 688, 689, 27, 546, 692, 545, 390,
+	694, 544, 625, 543, 542, 541, 681, 683, 608, 484,
+	637, 483, 530, 638, 621, 630, 623, 624, 428, 367,
+	366, 294, 263, 262, 171, 250, 28, 249, 536, 85,
+	248, 671, 227, 28, 255, 481, 741, 1256, 1086, 679,
+	306, 129, 3, 328, 230, 344, 201, 593, 172, 405,
+	738, 342, 511, 29, 411, 269, 3, 3, 913, 1243,
+	29, 1293, 101, 454, 1048, 1046, 862, 465, 751, 860,
+	737, 229, 228, 337, 848, 842, 693, 758, 725, 695,
+	1043, 1040, 1039, 700, 701, 702, 708, 697, 698, 699,
+	593, 735, 946, 574, 119, 783, 1167, 955, 953, 716,
+	640, 794, 776, 593, 1129, 717, 774, 1165, 848, 842,
+	725, 1084, 1021, 773, 332, 1019, 1127, 1018, 920, 708,
+	574, 665, 739, 517, 956, 954, 737, 1042, 1118, 749,
+	747, 814, 251, 530, 748, 218, 27, 406, 750, 252,
+	522, 756, 1041, 27, 760, 197, 198, 522, 522, 952,
+	476, 795, 1289, 495, 142, 1157, 825, 305, 1342, 1282,
+	1111, 820, 711, 726, 1349, 1336, 1323, 1320, 826, 827,
+	1306, 1305, 94, 1296, 173, 3, 86, 87, 88, 89,
+	90, 91, 92, 93, 343, 95, 96, 97, 98, 99,
+	341, 1276, 390, 1264, 710, 813, 1263, 1255, 119, 1254,
+	1251, 593, 1207, 868, 454, 454, 584, 1262, 333, 334,
+	1172, 1166, 861, 1164, 1163, 639, 1105, 195, 196, 199,
+	200, 837, 854, 1085, 592, 1036, 886, 662, 890, 1035,
+	894, 1032, 1029, 183, 940, 834, 835, 836, 838, 898,
+	843, 496, 939, 855, 851, 692, 593, 715, 884, 859,
+	593, 593, 678, 824, 576, 570, 568, 912, 1319, 914,
+	337, 312, 1318, 654, 888, 1261, 865, 775, 895, 737,
+	254, 866, 829, 905, 906, 828, 517, 896, 903, 874,
+	1250, 885, 522, 889, 1249, 893, 522, 1028, 687, 522,
+	522, 1027, 182, 692, 904, 686, 924, 566, 926, 923,
+	807, 565, 1318, 934, 869, 870, 1302, 938, 1249, 919,
+	941, 942, 1210, 28, 1027, 887, 3, 891, 186, 930,
+	936, 931, 925, 3, 185, 593, 945, 184, 916, 565,
+	426, 424, 454, 454, 454, 1352, 979, 1299, 1287, 1175,
+	29, 1155, 917, 985, 964, 856, 823, 593, 737, 422,
+	298, 958, 1325, 654, 854, 593, 1324, 1016, 1283, 776,
+	1113, 1112, 1034, 774, 1033, 84, 819, 776, 1319, 640,
+	773, 774, 1250, 971, 1002, 1028, 566, 1356, 773, 708,
+	1348, 1013, 1313, 1295, 1310, 1229, 1171, 961, 850, 1340,
+	1280, 1109, 962, 720, 1347, 1333, 1329, 522, 1359, 988,
+	1006, 1329, 1345, 1346, 1344, 1332, 1331, 847, 1000, 999,
+	969, 101, 647, 730, 323, 125, 1079, 982, 1030, 983,
+	322, 899, 255, 27, 777, 778, 780, 781, 1343, 706,
+	517, 276, 975, 976, 977, 275, 277, 517, 517, 454,
+	991, 1160, 993, 402, 665, 1072, 927, 401, 533, 665,
+	932, 375, 1055, 404, 403, 319, 779, 692, 1308, 101,
+	1052, 283, 282, 800, 460, 1082, 1309, 101, 468, 1311,
+	746, 1059, 992, 978, 873, 1056, 1013, 872, 1354, 1013,
+	1013, 1330, 1013, 1327, 871, 1104, 1330, 323, 522, 126,
+	1081, 603, 522, 604, 605, 1090, 744, 1088, 1097, 1098,
+	743, 1100, 578, 708, 434, 1106, 1232, 1102, 1180, 1107,
+	762, 1093, 763, 924, 28, 1103, 923, 733, 734, 692,
+	1125, 435, 1124, 1125, 775, 1128, 318, 319, 320, 960,
+	957, 858, 775, 724, 619, 308, 1130, 1179, 1132, 1061,
+	473, 29, 1083, 1149, 901, 1013, 902, 1013, 787, 788,
+	789, 790, 1134, 798, 469, 470, 472, 1162, 796, 675,
+	364, 345, 244, 471, 1151, 593, 1152, 809, 910, 479,
+	478, 174, 517, 967, 968, 467, 517, 776, 1101, 517,
+	517, 774, 1126, 1099, 1174, 1004, 944, 929, 773, 922,
+	921, 918, 803, 1145, 539, 309, 457, 1196, 514, 1125,
+	1072, 1185, 1072, 3, 513, 1072, 797, 438, 317, 455,
+	1198, 358, 1200, 1143, 1013, 1203, 353, 120, 1013, 1220,
+	1224, 1225, 188, 120, 27, 498, 1013, 497, 1013, 1228,
+	119, 240, 243, 1208, 522, 503, 80, 1212, 79, 176,
+	650, 152, 36, 1301, 651, 1226, 649, 1227, 1209, 935,
+	423, 8, 591, 7, 1092, 1230, 1181, 1182, 1183, 1184,
+	6, 665, 425, 74, 384, 1233, 1125, 888, 1240, 385,
+	445, 1013, 36, 603, 1071, 604, 605, 600, 597, 973,
+	974, 601, 23, 1195, 1220, 444, 1353, 517, 1326, 593,
+	1252, 1307, 603, 390, 604, 605, 600, 597, 1060, 1292,
+	601, 776, 1265, 1196, 1258, 774, 1072, 584, 150, 157,
+	1013, 1271, 773, 1267, 1013, 114, 1274, 1220, 1277, 73,
+	72, 1156, 1220, 1220, 76, 1239, 69, 522, 75, 1278,
+	202, 203, 70, 206, 207, 208, 210, 211, 212, 966,
+	216, 732, 775, 222, 582, 581, 1220, 225, 83, 1298,
+	1220, 242, 577, 433, 761, 618, 167, 22, 1013, 21,
+	20, 19, 18, 1220, 81, 231, 194, 234, 648, 477,
+	16, 36, 1221, 15, 14, 1222, 664, 1314, 517, 1220,
+	1334, 13, 517, 1220, 1337, 12, 772, 632, 627, 653,
+	1219, 771, 246, 247, 9, 17, 1013, 11, 10, 1216,
+	257, 258, 1009, 1214, 3, 1351, 1007, 216, 1355, 518,
+	516, 1220, 4, 265, 237, 0, 0, 270, 271, 272,
+	1360, 274, 1220, 0, 281, 0, 284, 285, 286, 287,
+	288, 289, 290, 0, 231, 0, 0, 1221, 157, 1213,
+	1222, 0, 0, 216, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1219, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 775, 0, 0, 0,
+	1221, 0, 0, 1222, 0, 1221, 1221, 0, 1222, 1222,
+	0, 0, 348, 349, 0, 0, 0, 0, 1219, 0,
+	0, 1288, 0, 1219, 1219, 0, 357, 0, 0, 1221,
+	0, 0, 1222, 1221, 1260, 0, 1222, 361, 0, 1215,
+	0, 0, 0, 368, 0, 0, 1221, 1219, 0, 1222,
+	0, 1219, 0, 0, 517, 0, 0, 0, 0, 0,
+	0, 387, 1221, 36, 1219, 1222, 1221, 1284, 0, 1222,
+	0, 0, 1290, 1291, 0, 0, 409, 36, 36, 0,
+	1219, 0, 0, 0, 1219, 0, 0, 0, 415, 0,
+	417, 0, 216, 0, 1221, 0, 1300, 1222, 0, 0,
+	1304, 0, 0, 0, 1215, 1221, 85, 216, 1222, 0,
+	0, 427, 1219, 1321, 0, 0, 216, 0, 0, 0,
+	0, 31, 0, 1219, 0, 0, 0, 0, 0, 1338,
+	0, 0, 0, 0, 387, 0, 0, 1215, 0, 0,
+	0, 475, 1215, 1215, 36, 0, 0, 517, 0, 633,
+	634, 635, 0, 0, 485, 487, 490, 492, 493, 0,
+	0, 1357, 0, 0, 0, 0, 1215, 216, 216, 504,
+	1215, 506, 216, 0, 0, 509, 510, 0, 0, 0,
+	219, 219, 0, 1215, 0, 0, 0, 219, 0, 0,
+	0, 0, 0, 0, 0, 0, 36, 0, 0, 1215,
+	0, 0, 0, 1215, 219, 0, 0, 0, 0, 0,
+	216, 216, 5, 0, 0, 0, 0, 0, 0, 0,
+	0, 216, 0, 0, 560, 0, 0, 561, 0, 0,
+	0, 1215, 0, 0, 0, 567, 0, 0, 0, 571,
+	0, 216, 1215, 0, 0, 0, 0, 579, 583, 94,
+	0, 0, 0, 86, 87, 88, 89, 90, 91, 92,
+	93, 0, 95, 96, 97, 98, 99, 0, 0, 0,
+	620, 217, 220, 219, 0, 0, 0, 387, 226, 0,
+	0, 0, 0, 0, 0, 0, 140, 149, 148, 139,
+	138, 141, 137, 219, 0, 233, 132, 36, 140, 149,
+	148, 139, 138, 141, 137, 0, 0, 0, 132, 668,
+	1158, 0, 0, 0, 0, 0, 0, 0, 504, 85,
+	0, 672, 0, 0, 0, 0, 676, 677, 0, 0,
+	0, 0, 680, 157, 314, 0, 0, 36, 0, 0,
+	219, 0, 0, 0, 36, 0, 313, 85, 0, 219,
+	0, 387, 0, 216, 0, 0, 0, 216, 216, 216,
+	0, 0, 0, 0, 233, 0, 133, 0, 0, 0,
+	0, 0, 713, 0, 102, 714, 0, 0, 133, 718,
+	0, 0, 0, 0, 233, 721, 0, 135, 134, 0,
+	0, 727, 0, 145, 136, 144, 143, 146, 147, 135,
+	134, 219, 130, 0, 131, 145, 136, 144, 143, 146,
+	147, 0, 0, 1065, 130, 0, 131, 0, 1066, 0,
+	0, 0, 753, 754, 755, 0, 0, 0, 757, 759,
+	0, 360, 0, 0, 0, 0, 0, 0, 0, 0,
+	365, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 36, 0, 0, 0, 0, 0, 0, 36, 36,
+	0, 71, 94, 0, 0, 0, 86, 87, 88, 89,
+	90, 91, 92, 93, 0, 95, 96, 97, 98, 99,
+	0, 0, 504, 0, 0, 0, 815, 0, 816, 0,
+	94, 0, 233, 170, 86, 87, 88, 89, 90, 91,
+	92, 93, 0, 95, 96, 97, 98, 99, 0, 216,
+	216, 216, 216, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 846, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 853, 0, 0, 0, 140, 149, 148, 139,
+	138, 141, 137, 0, 583, 881, 132, 0, 0, 0,
+	0, 0, 0, 0, 867, 0, 0, 0, 219, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 219,
+	0, 0, 0, 0, 0, 883, 216, 0, 256, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 219, 0,
+	0, 0, 0, 36, 0, 900, 0, 36, 219, 0,
+	36, 36, 280, 85, 219, 301, 909, 0, 0, 0,
+	0, 915, 0, 0, 0, 0, 133, 0, 0, 0,
+	0, 0, 928, 0, 36, 140, 149, 148, 139, 138,
+	141, 137, 0, 219, 0, 132, 937, 135, 134, 0,
+	0, 0, 0, 145, 136, 144, 143, 146, 147, 587,
+	0, 0, 130, 0, 131, 0, 882, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 0, 0, 0, 963,
+	0, 0, 0, 0, 219, 0, 0, 0, 0, 636,
+	0, 170, 0, 0, 0, 0, 0, 0, 980, 646,
+	981, 216, 36, 216, 0, 656, 0, 0, 0, 0,
+	0, 0, 0, 1064, 0, 133, 0, 0, 36, 0,
+	990, 0, 0, 280, 280, 0, 0, 0, 0, 0,
+	0, 0, 0, 1001, 674, 0, 135, 134, 0, 0,
+	0, 0, 145, 136, 144, 143, 146, 147, 280, 0,
+	1063, 130, 0, 131, 280, 280, 94, 0, 0, 0,
+	86, 87, 88, 89, 90, 91, 92, 93, 0, 95,
+	96, 97, 98, 99, 0, 233, 0, 0, 0, 0,
+	0, 0, 1050, 0, 0, 0, 448, 0, 0, 448,
+	0, 0, 0, 0, 0, 0, 1057, 36, 0, 0,
+	36, 36, 0, 36, 0, 0, 0, 0, 0, 36,
+	0, 0, 0, 36, 0, 0, 0, 0, 1080, 0,
+	0, 0, 219, 0, 0, 0, 216, 0, 0, 0,
+	0, 0, 0, 1087, 157, 36, 0, 0, 0, 1091,
+	1094, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1108, 0, 0, 721, 0, 0, 140, 149, 148,
+	139, 138, 141, 137, 0, 0, 36, 132, 36, 0,
+	0, 0, 0, 280, 553, 553, 553, 0, 0, 0,
+	0, 0, 0, 1135, 0, 0, 0, 0, 0, 1137,
+	0, 0, 0, 0, 0, 1142, 0, 216, 0, 0,
+	140, 1146, 0, 139, 138, 141, 137, 0, 0, 0,
+	132, 0, 0, 830, 0, 0, 0, 0, 0, 448,
+	0, 0, 0, 0, 0, 0, 0, 448, 0, 0,
+	0, 170, 0, 170, 170, 36, 0, 133, 0, 36,
+	36, 0, 0, 0, 0, 0, 0, 36, 0, 36,
+	0, 0, 0, 0, 0, 36, 0, 0, 135, 134,
+	0, 0, 0, 0, 145, 136, 144, 143, 146, 147,
+	0, 0, 369, 130, 0, 131, 0, 359, 1211, 0,
+	133, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 36, 0, 0, 219, 0, 0, 1231, 0,
+	0, 135, 134, 216, 0, 36, 0, 145, 136, 144,
+	143, 146, 147, 0, 0, 0, 130, 219, 131, 0,
+	0, 219, 0, 0, 280, 219, 0, 0, 0, 0,
+	0, 36, 0, 0, 0, 36, 0, 0, 36, 0,
+	1257, 157, 0, 36, 36, 0, 0, 0, 36, 0,
+	219, 0, 0, 0, 0, 583, 0, 280, 0, 0,
+	0, 0, 0, 0, 0, 0, 1272, 36, 0, 0,
+	0, 36, 0, 1279, 448, 0, 721, 0, 0, 36,
+	355, 0, 0, 0, 36, 0, 970, 0, 140, 149,
+	148, 139, 138, 141, 137, 0, 0, 0, 132, 0,
+	36, 0, 0, 0, 36, 0, 0, 0, 986, 0,
+	1303, 0, 987, 0, 0, 0, 989, 36, 0, 0,
+	0, 0, 1315, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 36, 85, 0, 0, 0, 0, 0, 0,
+	0, 1005, 1339, 36, 0, 721, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 446,
+	313, 0, 0, 0, 0, 0, 0, 452, 133, 219,
+	0, 0, 0, 0, 0, 1358, 0, 0, 280, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 135,
+	134, 0, 0, 0, 0, 145, 136, 144, 143, 146,
+	147, 0, 0, 0, 130, 0, 131, 0, 354, 0,
+	0, 0, 0, 0, 101, 448, 448, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 219, 0, 0,
+	0, 85, 103, 104, 105, 0, 125, 107, 119, 0,
+	120, 121, 24, 122, 0, 0, 0, 0, 38, 39,
+	40, 0, 0, 0, 0, 0, 0, 0, 102, 66,
+	1114, 32, 47, 44, 33, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 94, 219, 0, 0,
+	86, 87, 88, 89, 90, 91, 92, 93, 0, 95,
+	96, 97, 98, 99, 0, 449, 450, 451, 453, 0,
+	0, 0, 116, 0, 0, 0, 117, 0, 0, 0,
+	126, 0, 101, 85, 0, 0, 0, 280, 1161, 447,
+	1218, 1217, 0, 1016, 0, 0, 0, 0, 0, 1223,
+	0, 35, 123, 0, 43, 41, 42, 37, 0, 0,
+	313, 0, 0, 448, 448, 448, 45, 46, 526, 527,
+	0, 50, 51, 52, 53, 54, 55, 0, 56, 60,
+	61, 62, 48, 57, 63, 64, 65, 0, 1204, 0,
+	1017, 0, 0, 0, 94, 34, 49, 58, 86, 87,
+	88, 89, 90, 91, 92, 93, 59, 95, 96, 97,
+	98, 99, 128, 0, 0, 0, 0, 113, 111, 112,
+	127, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 109, 110, 0, 0, 118, 82, 0, 124,
+	0, 85, 103, 104, 105, 0, 125, 107, 119, 0,
+	120, 121, 24, 122, 0, 0, 0, 0, 38, 39,
+	40, 280, 0, 0, 0, 0, 0, 0, 102, 66,
+	448, 32, 47, 44, 33, 0, 94, 0, 0, 0,
+	86, 87, 88, 89, 90, 91, 92, 93, 0, 95,
+	96, 97, 98, 99, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 100, 0, 0, 0, 0, 111, 102, 110,
-	109, 0, 241, 280, 112, 113, 276, 357, 0, 0,
-	357, 0, 0, 0, 306, 106, 115, 130, 105, 104,
-	107, 103, 0, 0, 0, 0, 0, 382, 384, 385,
-	387, 621, 0, 0, 0, 0, 392, 217, 217, 0,
-	0, 0, 0, 0, 0, 101, 100, 0, 409, 0,
-	412, 111, 102, 110, 109, 217, 0, 0, 112, 113,
-	273, 217, 217, 0, 106, 115, 114, 105, 104, 107,
-	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 355, 0, 0, 355, 0,
-	101, 100, 0, 0, 0, 0, 111, 102, 110, 109,
-	0, 0, 0, 112, 113, 0, 0, 306, 0, 466,
-	471, 241, 0, 0, 0, 483, 0, 0, 357, 0,
-	0, 0, 357, 0, 0, 0, 0, 0, 0, 0,
-	0, 497, 0, 0, 501, 471, 471, 505, 0, 101,
-	100, 497, 0, 0, 516, 111, 102, 110, 109, 0,
-	0, 0, 112, 113, 710, 719, 0, 0, 0, 217,
-	436, 436, 436, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 732, 0, 0, 0, 0, 524, 525, 0,
-	0, 497, 0, 735, 0, 306, 530, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 355, 0, 0, 0,
-	355, 0, 0, 0, 130, 0, 130, 130, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 0, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 0, 471, 0,
-	0, 570, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 357, 0, 0, 0, 0, 582, 0,
-	0, 585, 561, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 501, 806, 0, 471, 0, 106,
-	115, 114, 105, 104, 107, 103, 0, 217, 562, 0,
-	0, 0, 0, 101, 100, 0, 0, 0, 0, 111,
-	102, 110, 109, 101, 100, 0, 112, 113, 664, 111,
-	102, 110, 109, 0, 0, 217, 112, 113, 661, 0,
-	91, 71, 72, 73, 0, 95, 75, 87, 0, 88,
-	89, 355, 0, 0, 0, 0, 0, 306, 0, 0,
-	0, 0, 0, 0, 70, 0, 471, 0, 357, 357,
-	0, 0, 0, 0, 101, 100, 0, 0, 0, 0,
-	111, 102, 110, 109, 0, 0, 497, 112, 113, 0,
-	471, 471, 0, 0, 0, 0, 675, 676, 0, 0,
-	0, 0, 0, 84, 0, 0, 0, 85, 0, 0,
-	0, 96, 0, 0, 217, 0, 0, 0, 0, 0,
-	120, 119, 0, 0, 0, 0, 0, 0, 0, 0,
-	90, 0, 0, 0, 106, 115, 114, 105, 104, 107,
-	103, 0, 0, 0, 0, 471, 355, 355, 0, 0,
-	0, 357, 357, 357, 0, 728, 0, 0, 731, 0,
-	0, 0, 0, 0, 0, 0, 501, 92, 93, 94,
-	98, 0, 308, 79, 307, 309, 310, 311, 312, 0,
-	0, 0, 0, 0, 0, 305, 0, 77, 78, 86,
-	65, 298, 0, 0, 0, 0, 0, 0, 106, 115,
-	114, 105, 104, 107, 103, 0, 217, 0, 0, 101,
-	100, 0, 0, 0, 0, 111, 102, 110, 109, 0,
-	0, 357, 112, 113, 442, 0, 0, 0, 0, 355,
-	355, 355, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 91, 71, 72, 73, 0, 95, 75, 87,
-	0, 88, 89, 19, 0, 0, 0, 31, 32, 0,
-	0, 0, 0, 0, 0, 0, 70, 0, 25, 38,
-	0, 26, 497, 101, 100, 0, 0, 0, 0, 111,
-	102, 110, 109, 0, 0, 0, 112, 113, 276, 0,
-	217, 0, 0, 0, 0, 0, 0, 0, 0, 355,
-	0, 0, 0, 0, 0, 84, 0, 0, 0, 85,
-	0, 0, 0, 96, 0, 68, 0, 0, 0, 0,
-	0, 0, 869, 868, 0, 744, 873, 874, 0, 0,
-	0, 28, 90, 0, 35, 33, 34, 30, 0, 0,
-	0, 0, 0, 0, 0, 36, 37, 407, 408, 0,
-	41, 42, 43, 44, 45, 47, 48, 49, 39, 46,
-	50, 0, 0, 0, 745, 0, 0, 27, 40, 92,
-	93, 94, 98, 306, 81, 79, 80, 97, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 77,
-	78, 86, 65, 91, 71, 72, 73, 0, 95, 75,
-	87, 0, 88, 89, 19, 0, 0, 0, 31, 32,
-	0, 0, 0, 0, 0, 0, 0, 70, 0, 25,
-	38, 0, 26, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 116, 0, 0, 0, 117, 0, 0, 0,
+	126, 0, 101, 0, 0, 0, 0, 0, 85, 0,
+	520, 519, 0, 84, 0, 0, 0, 0, 0, 525,
+	0, 35, 123, 0, 43, 41, 42, 37, 0, 0,
+	0, 0, 0, 0, 0, 0, 45, 46, 526, 527,
+	100, 50, 51, 52, 53, 54, 55, 793, 56, 60,
+	61, 62, 48, 57, 63, 64, 65, 0, 0, 0,
+	0, 0, 0, 0, 94, 34, 49, 58, 86, 87,
+	88, 89, 90, 91, 92, 93, 59, 95, 96, 97,
+	98, 99, 128, 0, 0, 0, 0, 113, 111, 112,
+	127, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 109, 110, 0, 0, 118, 82, 0, 124,
+	85, 103, 104, 105, 0, 125, 107, 119, 0, 120,
+	121, 24, 122, 0, 0, 0, 0, 38, 39, 40,
+	0, 0, 0, 0, 0, 0, 0, 102, 66, 0,
+	32, 47, 44, 33, 0, 0, 0, 0, 0, 0,
+	0, 94, 0, 0, 0, 86, 87, 88, 89, 90,
+	91, 92, 93, 0, 95, 96, 97, 98, 99, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 116, 0, 0, 0, 117, 0, 0, 0, 126,
+	0, 101, 0, 85, 0, 0, 0, 0, 0, 1011,
+	1010, 0, 1016, 0, 0, 0, 0, 0, 1015, 0,
+	35, 123, 0, 43, 41, 42, 37, 0, 0, 446,
+	313, 0, 0, 0, 0, 45, 46, 452, 0, 0,
+	50, 51, 52, 53, 54, 55, 0, 56, 60, 61,
+	62, 48, 57, 63, 64, 65, 0, 0, 0, 1017,
+	0, 0, 0, 94, 34, 49, 58, 86, 87, 88,
+	89, 90, 91, 92, 93, 59, 95, 96, 97, 98,
+	99, 128, 0, 0, 0, 0, 113, 111, 112, 127,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 0, 0, 0,
-	85, 0, 0, 0, 96, 0, 68, 0, 0, 0,
-	0, 0, 0, 403, 402, 0, 66, 0, 0, 0,
-	0, 0, 28, 90, 0, 35, 33, 34, 30, 0,
-	0, 0, 0, 0, 0, 0, 36, 37, 407, 408,
-	67, 41, 42, 43, 44, 45, 47, 48, 49, 39,
-	46, 50, 0, 0, 0, 0, 0, 0, 27, 40,
-	92, 93, 94, 98, 0, 81, 79, 80, 97, 0,
+	0, 109, 110, 0, 0, 118, 82, 0, 124, 85,
+	103, 104, 105, 0, 125, 107, 119, 0, 120, 121,
+	24, 122, 0, 0, 0, 0, 38, 39, 40, 0,
+	0, 0, 0, 0, 0, 0, 102, 66, 0, 32,
+	47, 44, 33, 0, 0, 0, 94, 0, 0, 0,
+	86, 87, 88, 89, 90, 91, 92, 93, 0, 95,
+	96, 97, 98, 99, 0, 449, 450, 451, 453, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	77, 78, 86, 65, 91, 71, 72, 73, 0, 95,
-	75, 87, 0, 88, 89, 19, 0, 0, 0, 31,
-	32, 0, 0, 0, 0, 0, 0, 0, 70, 0,
-	25, 38, 0, 26, 0, 0, 0, 0, 0, 0,
+	116, 85, 614, 0, 117, 0, 0, 0, 126, 447,
+	101, 0, 0, 0, 0, 0, 0, 0, 26, 25,
+	85, 84, 381, 0, 0, 0, 0, 30, 0, 35,
+	123, 0, 43, 41, 42, 37, 0, 0, 0, 0,
+	0, 0, 0, 0, 45, 46, 0, 0, 100, 50,
+	51, 52, 53, 54, 55, 0, 56, 60, 61, 62,
+	48, 57, 63, 64, 65, 0, 0, 0, 0, 0,
+	0, 0, 94, 34, 49, 58, 86, 87, 88, 89,
+	90, 91, 92, 93, 59, 95, 96, 97, 98, 99,
+	128, 0, 0, 0, 0, 113, 111, 112, 127, 0,
+	0, 0, 140, 149, 148, 139, 138, 141, 137, 0,
+	109, 110, 132, 0, 118, 82, 0, 124, 85, 103,
+	104, 105, 0, 125, 107, 119, 0, 120, 121, 0,
+	122, 0, 0, 0, 0, 0, 140, 149, 148, 139,
+	138, 141, 137, 0, 94, 102, 132, 0, 86, 87,
+	88, 89, 90, 91, 92, 93, 0, 95, 96, 97,
+	98, 99, 0, 94, 0, 0, 0, 86, 87, 88,
+	89, 90, 91, 92, 93, 0, 95, 96, 97, 98,
+	99, 0, 133, 0, 0, 0, 0, 0, 0, 116,
+	0, 0, 0, 117, 0, 0, 0, 126, 0, 0,
+	0, 0, 0, 135, 134, 0, 0, 155, 154, 145,
+	136, 144, 143, 146, 147, 0, 133, 0, 130, 123,
+	131, 0, 959, 85, 103, 104, 105, 0, 125, 107,
+	119, 0, 120, 121, 0, 122, 0, 135, 134, 0,
+	0, 0, 0, 145, 136, 144, 143, 146, 147, 0,
+	102, 0, 130, 0, 131, 0, 877, 0, 0, 0,
+	0, 94, 0, 0, 0, 86, 87, 88, 89, 90,
+	91, 92, 93, 0, 95, 96, 97, 98, 99, 128,
+	0, 0, 0, 0, 392, 111, 391, 393, 394, 395,
+	396, 0, 0, 0, 116, 0, 0, 389, 117, 109,
+	110, 0, 126, 118, 82, 382, 124, 0, 0, 0,
+	0, 0, 155, 154, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 123, 0, 0, 0, 85, 103,
+	104, 105, 0, 125, 107, 119, 0, 120, 121, 0,
+	122, 0, 0, 0, 140, 149, 148, 139, 138, 141,
+	137, 0, 0, 0, 132, 102, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 94, 0, 0, 0,
+	86, 87, 88, 89, 90, 91, 92, 93, 0, 95,
+	96, 97, 98, 99, 128, 0, 0, 0, 0, 392,
+	111, 391, 393, 394, 395, 396, 0, 0, 0, 116,
+	0, 0, 389, 117, 109, 110, 0, 126, 118, 82,
+	0, 124, 0, 0, 0, 0, 0, 155, 154, 0,
+	0, 0, 0, 0, 133, 0, 0, 0, 0, 123,
+	0, 0, 85, 103, 104, 105, 0, 125, 107, 119,
+	0, 120, 121, 0, 122, 135, 134, 0, 0, 0,
+	0, 145, 136, 144, 143, 146, 147, 0, 0, 102,
+	130, 0, 131, 0, 875, 0, 0, 0, 0, 0,
+	0, 94, 0, 0, 0, 86, 87, 88, 89, 90,
+	91, 92, 93, 0, 95, 96, 97, 98, 99, 128,
+	0, 0, 0, 0, 392, 111, 391, 393, 394, 395,
+	396, 0, 0, 116, 0, 0, 0, 117, 0, 109,
+	110, 126, 0, 118, 82, 0, 124, 0, 0, 0,
+	0, 155, 154, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 123, 0, 0, 85, 103, 104, 105,
+	0, 125, 107, 119, 0, 120, 121, 0, 122, 0,
+	0, 0, 140, 149, 148, 139, 138, 141, 137, 0,
+	0, 0, 132, 102, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 94, 0, 0, 0, 86,
+	87, 88, 89, 90, 91, 92, 93, 0, 95, 96,
+	97, 98, 99, 128, 0, 0, 0, 0, 113, 111,
+	112, 127, 0, 0, 0, 0, 0, 116, 0, 0,
+	0, 117, 0, 109, 110, 126, 85, 118, 82, 0,
+	124, 260, 0, 0, 0, 155, 154, 0, 0, 0,
+	0, 0, 133, 0, 0, 0, 239, 123, 0, 0,
+	85, 103, 104, 105, 0, 125, 107, 119, 0, 120,
+	121, 0, 122, 135, 134, 0, 0, 0, 0, 145,
+	136, 144, 143, 146, 147, 0, 0, 102, 130, 0,
+	131, 0, 559, 0, 1095, 0, 0, 0, 0, 94,
+	238, 268, 0, 86, 87, 88, 89, 90, 91, 92,
+	93, 0, 95, 96, 97, 98, 99, 128, 0, 0,
+	0, 0, 113, 111, 112, 127, 0, 0, 0, 0,
+	0, 116, 0, 0, 0, 117, 0, 109, 110, 126,
+	0, 118, 82, 0, 124, 0, 0, 0, 0, 155,
+	154, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1096, 0, 0, 85, 103, 104, 105, 0, 125,
+	107, 119, 0, 120, 121, 0, 122, 0, 0, 94,
+	0, 0, 0, 86, 87, 88, 89, 90, 91, 92,
+	93, 102, 95, 96, 97, 98, 99, 0, 0, 0,
+	0, 0, 0, 94, 0, 0, 0, 86, 87, 88,
+	89, 90, 91, 92, 93, 0, 95, 96, 97, 98,
+	99, 128, 0, 0, 0, 0, 113, 111, 112, 127,
+	0, 0, 0, 0, 0, 116, 0, 0, 0, 117,
+	0, 109, 110, 126, 0, 118, 82, 0, 124, 0,
+	0, 0, 0, 155, 154, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 123, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 85, 103, 104, 105,
+	0, 125, 107, 119, 0, 120, 121, 0, 122, 0,
+	0, 0, 0, 0, 0, 0, 140, 149, 148, 139,
+	138, 141, 137, 102, 0, 0, 132, 94, 0, 0,
+	0, 86, 87, 88, 89, 90, 91, 92, 93, 0,
+	95, 96, 97, 98, 99, 128, 0, 0, 0, 0,
+	113, 111, 112, 127, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 389, 0, 109, 110, 116, 0, 118,
+	82, 117, 124, 0, 0, 126, 696, 0, 0, 0,
+	0, 0, 0, 0, 0, 155, 154, 0, 140, 149,
+	148, 139, 138, 141, 137, 0, 133, 123, 132, 0,
+	0, 0, 0, 0, 85, 103, 104, 105, 0, 125,
+	107, 119, 0, 120, 121, 0, 122, 135, 134, 0,
+	0, 0, 0, 145, 136, 144, 143, 146, 147, 0,
+	0, 102, 130, 0, 131, 0, 359, 0, 0, 94,
+	0, 0, 0, 86, 87, 88, 89, 90, 91, 92,
+	93, 0, 95, 96, 97, 98, 99, 128, 0, 0,
+	0, 0, 113, 111, 112, 127, 0, 0, 133, 0,
+	0, 0, 0, 0, 0, 116, 0, 109, 110, 117,
+	0, 118, 82, 126, 124, 101, 0, 0, 0, 135,
+	134, 0, 0, 155, 154, 145, 136, 144, 143, 146,
+	147, 0, 0, 1205, 130, 123, 131, 729, 85, 103,
+	104, 105, 0, 125, 107, 119, 0, 120, 121, 0,
+	122, 0, 0, 0, 140, 149, 148, 139, 138, 141,
+	137, 0, 0, 730, 132, 102, 140, 149, 148, 139,
+	138, 141, 137, 0, 0, 933, 132, 94, 0, 0,
+	0, 86, 87, 88, 89, 90, 91, 92, 93, 0,
+	95, 96, 97, 98, 99, 128, 0, 0, 0, 0,
+	113, 111, 112, 127, 0, 0, 0, 0, 0, 116,
+	0, 0, 0, 117, 0, 109, 110, 126, 303, 118,
+	82, 0, 124, 0, 0, 0, 0, 155, 154, 0,
+	0, 0, 0, 0, 133, 0, 0, 0, 0, 123,
+	0, 0, 85, 103, 104, 105, 133, 125, 107, 119,
+	0, 120, 121, 0, 122, 135, 134, 0, 0, 0,
+	0, 145, 136, 144, 143, 146, 147, 135, 134, 102,
+	130, 0, 131, 145, 136, 144, 143, 146, 147, 0,
+	0, 94, 130, 0, 131, 86, 87, 88, 89, 90,
+	91, 92, 93, 0, 95, 96, 97, 98, 99, 128,
+	0, 0, 0, 0, 113, 111, 112, 127, 0, 0,
+	0, 0, 0, 116, 0, 0, 0, 117, 0, 109,
+	110, 126, 0, 118, 82, 0, 124, 0, 0, 0,
+	0, 155, 154, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 123, 0, 0, 85, 103, 104, 105,
+	0, 125, 107, 119, 0, 120, 121, 0, 122, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 213, 0,
+	0, 0, 0, 102, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 94, 0, 0, 0, 86,
+	87, 88, 89, 90, 91, 92, 93, 0, 95, 96,
+	97, 98, 99, 128, 0, 0, 0, 0, 113, 111,
+	112, 127, 0, 0, 0, 0, 0, 116, 0, 0,
+	0, 117, 0, 109, 110, 126, 0, 118, 82, 0,
+	124, 0, 0, 0, 0, 155, 154, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 123, 0, 0,
+	85, 103, 104, 105, 0, 125, 107, 119, 0, 120,
+	121, 0, 122, 0, 0, 0, 140, 149, 148, 139,
+	138, 141, 137, 0, 0, 0, 132, 102, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1361, 94,
+	0, 0, 0, 86, 87, 88, 89, 90, 91, 92,
+	93, 0, 95, 96, 97, 98, 99, 128, 0, 0,
+	0, 0, 113, 111, 112, 127, 0, 0, 0, 0,
+	0, 116, 0, 0, 0, 117, 0, 109, 110, 126,
+	0, 118, 82, 0, 124, 0, 0, 0, 0, 155,
+	154, 0, 0, 0, 0, 0, 133, 0, 0, 0,
+	0, 123, 0, 0, 85, 103, 362, 105, 0, 125,
+	107, 119, 0, 120, 121, 0, 122, 135, 134, 0,
+	0, 0, 0, 145, 136, 144, 143, 146, 147, 0,
+	0, 102, 130, 0, 131, 0, 0, 0, 0, 0,
+	0, 0, 0, 94, 0, 0, 0, 86, 87, 88,
+	89, 90, 91, 92, 93, 0, 95, 96, 97, 98,
+	99, 128, 0, 0, 0, 0, 113, 111, 112, 127,
+	0, 0, 0, 0, 0, 116, 0, 0, 0, 117,
+	0, 109, 110, 126, 0, 118, 151, 0, 124, 0,
+	0, 0, 0, 155, 154, 0, 140, 149, 148, 139,
+	138, 141, 137, 0, 0, 123, 132, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1350, 140,
+	149, 148, 139, 138, 141, 137, 0, 0, 0, 132,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 0, 0,
-	0, 85, 0, 0, 0, 96, 0, 68, 0, 0,
-	0, 0, 0, 0, 741, 740, 0, 744, 0, 0,
-	0, 0, 0, 28, 90, 0, 35, 33, 34, 30,
-	0, 0, 0, 0, 0, 0, 0, 36, 37, 0,
-	0, 0, 41, 42, 43, 44, 45, 47, 48, 49,
-	39, 46, 50, 0, 0, 0, 745, 0, 0, 27,
-	40, 92, 93, 94, 98, 0, 81, 79, 80, 97,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 77, 78, 86, 65, 91, 71, 72, 73, 0,
-	95, 75, 87, 0, 88, 89, 19, 0, 0, 0,
-	31, 32, 0, 0, 0, 0, 0, 0, 0, 70,
-	0, 25, 38, 0, 26, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 0,
-	0, 0, 85, 0, 0, 0, 96, 0, 68, 0,
-	0, 0, 0, 0, 0, 21, 20, 0, 66, 0,
-	0, 0, 0, 0, 28, 90, 0, 35, 33, 34,
-	30, 0, 0, 0, 0, 0, 0, 0, 36, 37,
-	0, 0, 67, 41, 42, 43, 44, 45, 47, 48,
-	49, 39, 46, 50, 0, 0, 0, 0, 0, 0,
-	27, 40, 92, 93, 94, 98, 0, 81, 79, 80,
-	97, 91, 71, 72, 73, 0, 95, 75, 87, 0,
-	88, 89, 77, 78, 86, 65, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 70, 91, 71, 72, 73,
-	0, 95, 75, 87, 0, 88, 89, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	70, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 84, 0, 0, 0, 85, 0,
-	0, 0, 96, 0, 0, 0, 0, 0, 0, 0,
-	0, 120, 119, 0, 0, 0, 0, 0, 0, 84,
-	0, 90, 0, 85, 0, 0, 0, 96, 0, 0,
-	0, 0, 0, 0, 0, 0, 120, 119, 0, 0,
-	0, 0, 0, 0, 0, 0, 90, 91, 71, 72,
-	73, 0, 95, 75, 87, 0, 88, 89, 92, 93,
-	94, 98, 0, 308, 79, 307, 309, 310, 311, 312,
-	0, 70, 0, 0, 0, 0, 305, 0, 77, 78,
-	86, 65, 0, 92, 93, 94, 98, 0, 308, 79,
-	307, 309, 310, 311, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 77, 78, 86, 65, 0, 0, 0,
-	84, 0, 0, 0, 85, 0, 0, 0, 96, 0,
-	0, 0, 0, 0, 0, 0, 0, 120, 119, 0,
-	0, 0, 0, 0, 0, 0, 181, 90, 91, 71,
-	72, 73, 0, 95, 75, 87, 0, 88, 89, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 70, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 180, 0, 92, 93, 94, 98, 0, 81,
-	79, 80, 97, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 77, 78, 86, 65, 0, 0,
-	0, 84, 0, 0, 0, 85, 0, 0, 0, 96,
-	0, 0, 0, 0, 0, 0, 0, 0, 120, 119,
-	0, 0, 0, 0, 0, 0, 0, 0, 90, 91,
-	71, 72, 73, 0, 95, 75, 87, 0, 88, 89,
-	91, 71, 72, 73, 0, 95, 75, 87, 0, 88,
-	89, 0, 0, 70, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 70, 92, 93, 94, 98, 0,
-	81, 79, 80, 97, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 305, 0, 77, 78, 86, 65, 0,
-	0, 0, 84, 0, 0, 0, 85, 0, 0, 0,
-	96, 251, 0, 84, 0, 0, 0, 85, 0, 120,
-	119, 96, 0, 68, 0, 0, 0, 0, 0, 90,
-	120, 119, 0, 0, 0, 0, 0, 0, 0, 0,
-	90, 91, 71, 72, 73, 0, 95, 75, 87, 0,
-	88, 89, 91, 71, 72, 73, 0, 95, 75, 87,
-	0, 88, 89, 0, 0, 70, 92, 93, 94, 98,
-	0, 81, 79, 80, 97, 0, 70, 92, 93, 94,
-	98, 0, 81, 79, 80, 97, 77, 78, 86, 65,
-	0, 0, 0, 0, 0, 0, 0, 77, 78, 86,
-	65, 0, 0, 0, 84, 0, 0, 0, 85, 0,
-	0, 0, 96, 0, 0, 84, 0, 0, 0, 85,
-	0, 120, 119, 96, 0, 0, 0, 0, 0, 0,
-	0, 90, 120, 119, 0, 0, 0, 0, 0, 0,
-	0, 0, 90, 91, 71, 278, 73, 0, 95, 75,
-	87, 0, 88, 89, 0, 0, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 70, 92, 93,
-	94, 98, 0, 81, 79, 80, 97, 984, 0, 92,
-	93, 94, 98, 0, 81, 79, 80, 97, 77, 78,
-	86, 65, 0, 0, 0, 0, 0, 0, 0, 77,
-	78, 86, 117, 0, 0, 0, 84, 0, 0, 0,
-	85, 0, 0, 0, 96, 0, 0, 0, 0, 0,
-	0, 0, 0, 120, 119, 0, 0, 0, 0, 0,
-	0, 101, 100, 90, 0, 0, 0, 111, 102, 110,
-	109, 0, 0, 0, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 973, 0, 0,
-	92, 93, 94, 98, 0, 81, 79, 80, 97, 106,
-	115, 114, 105, 104, 107, 103, 0, 0, 0, 0,
-	77, 78, 86, 65, 0, 0, 0, 0, 0, 0,
-	959, 0, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 0, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 101, 100, 947, 0, 0, 0, 111, 102, 110,
-	109, 0, 0, 924, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 100, 0, 915, 0, 0,
-	111, 102, 110, 109, 0, 0, 0, 112, 113, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 100, 0,
-	0, 0, 0, 111, 102, 110, 109, 101, 100, 0,
-	112, 113, 0, 111, 102, 110, 109, 0, 0, 0,
-	112, 113, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 101, 100, 0, 0, 0, 0, 111, 102, 110,
-	109, 0, 0, 903, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 894, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 839, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 0, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 101, 100, 0,
-	0, 831, 0, 111, 102, 110, 109, 0, 0, 828,
-	112, 113, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 101, 100, 0, 0, 0, 0, 111, 102, 110,
-	109, 101, 100, 0, 112, 113, 0, 111, 102, 110,
-	109, 0, 0, 0, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 101, 100, 0, 0, 0, 0, 111,
-	102, 110, 109, 101, 100, 0, 112, 113, 0, 111,
-	102, 110, 109, 0, 0, 0, 112, 113, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 101, 100, 0,
-	0, 0, 0, 111, 102, 110, 109, 0, 335, 822,
-	112, 113, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 0, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 101, 100, 775, 0, 0, 0, 111, 102, 110,
-	109, 0, 0, 783, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 101, 100, 0, 0, 755, 0, 111,
-	102, 110, 109, 0, 0, 0, 112, 113, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 101, 100, 0,
-	0, 0, 0, 111, 102, 110, 109, 101, 100, 641,
-	112, 113, 0, 111, 102, 110, 109, 0, 0, 638,
-	112, 113, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 101, 100, 0, 0, 0, 0, 111, 102, 110,
-	109, 0, 0, 614, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 271, 101, 100, 0, 0, 555, 0, 111,
-	102, 110, 109, 0, 0, 0, 112, 113, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 0, 0, 106,
-	115, 114, 105, 104, 107, 103, 0, 101, 100, 454,
-	0, 0, 0, 111, 102, 110, 109, 0, 0, 0,
-	112, 113, 283, 106, 115, 114, 105, 104, 107, 103,
-	0, 101, 100, 0, 0, 0, 0, 111, 102, 110,
-	109, 275, 0, 0, 112, 113, 0, 0, 0, 106,
-	115, 114, 105, 104, 107, 103, 0, 0, 0, 0,
-	0, 0, 270, 101, 100, 0, 0, 0, 0, 111,
-	102, 110, 109, 0, 101, 100, 112, 113, 0, 0,
-	111, 102, 110, 109, 0, 0, 0, 112, 113, 106,
-	115, 114, 105, 104, 107, 103, 0, 0, 101, 100,
-	0, 0, 0, 0, 111, 102, 110, 109, 0, 0,
-	0, 112, 113, 106, 115, 114, 105, 104, 107, 103,
-	0, 0, 0, 106, 101, 100, 105, 104, 107, 103,
-	111, 102, 110, 109, 228, 0, 0, 112, 113, 106,
-	115, 114, 105, 104, 107, 103, 0, 0, 0, 106,
-	444, 114, 105, 104, 107, 103, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 100, 0, 0, 0, 0,
-	111, 102, 110, 109, 0, 0, 0, 112, 113, 106,
-	327, 114, 105, 104, 107, 103, 0, 0, 101, 100,
-	0, 0, 0, 0, 111, 102, 110, 109, 101, 100,
-	0, 112, 113, 0, 111, 102, 110, 109, 0, 0,
-	0, 112, 113, 0, 101, 100, 0, 0, 0, 0,
-	111, 102, 110, 109, 101, 100, 0, 112, 113, 0,
-	111, 102, 110, 109, 0, 0, 0, 112, 113, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 100, 0, 0, 0, 0,
-	111, 102, 110, 109, 0, 0, 0, 112, 113,
+	0, 1335, 0, 0, 0, 0, 0, 94, 0, 0,
+	0, 86, 87, 88, 89, 90, 91, 92, 93, 0,
+	95, 96, 97, 98, 99, 128, 0, 0, 0, 0,
+	113, 111, 112, 127, 0, 0, 133, 0, 140, 149,
+	148, 139, 138, 141, 137, 109, 110, 0, 132, 118,
+	82, 0, 124, 0, 0, 0, 0, 135, 134, 133,
+	1322, 0, 0, 145, 136, 144, 143, 146, 147, 0,
+	0, 0, 130, 0, 131, 0, 0, 0, 0, 0,
+	135, 134, 0, 0, 0, 0, 145, 136, 144, 143,
+	146, 147, 0, 0, 0, 130, 0, 131, 140, 149,
+	148, 139, 138, 141, 137, 0, 0, 0, 132, 0,
+	0, 140, 149, 148, 139, 138, 141, 137, 133, 0,
+	1297, 132, 0, 0, 140, 149, 148, 139, 138, 141,
+	137, 0, 0, 1285, 132, 0, 0, 0, 0, 135,
+	134, 0, 0, 0, 0, 145, 136, 144, 143, 146,
+	147, 0, 0, 0, 130, 0, 131, 140, 149, 148,
+	139, 138, 141, 137, 0, 0, 0, 132, 0, 0,
+	140, 149, 148, 139, 138, 141, 137, 0, 133, 1266,
+	132, 0, 0, 85, 0, 0, 0, 0, 0, 0,
+	119, 133, 1253, 0, 0, 0, 0, 0, 0, 135,
+	134, 0, 0, 0, 133, 145, 136, 144, 143, 146,
+	147, 0, 135, 134, 130, 0, 131, 0, 145, 136,
+	144, 143, 146, 147, 0, 135, 134, 130, 0, 131,
+	0, 145, 136, 144, 143, 146, 147, 133, 0, 1197,
+	130, 0, 131, 140, 149, 148, 139, 138, 141, 137,
+	133, 0, 0, 132, 0, 0, 0, 0, 135, 134,
+	0, 0, 0, 0, 145, 136, 144, 143, 146, 147,
+	0, 135, 134, 130, 0, 131, 0, 145, 136, 144,
+	143, 146, 147, 0, 0, 0, 130, 0, 131, 140,
+	149, 148, 139, 138, 141, 137, 0, 0, 0, 132,
+	0, 0, 140, 149, 148, 139, 138, 141, 137, 0,
+	0, 1173, 132, 0, 0, 140, 149, 148, 139, 138,
+	141, 137, 0, 133, 1153, 132, 94, 0, 0, 0,
+	86, 87, 88, 89, 90, 91, 92, 93, 0, 95,
+	96, 97, 98, 99, 135, 134, 0, 0, 0, 0,
+	145, 136, 144, 143, 146, 147, 0, 0, 1147, 130,
+	0, 131, 140, 149, 148, 139, 138, 141, 137, 133,
+	0, 0, 132, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 133, 0, 0, 0, 0, 0, 0, 0,
+	135, 134, 0, 0, 0, 133, 145, 136, 144, 143,
+	146, 147, 0, 135, 134, 130, 0, 131, 0, 145,
+	136, 144, 143, 146, 147, 0, 135, 134, 130, 0,
+	131, 0, 145, 136, 144, 143, 146, 147, 0, 0,
+	1131, 130, 0, 131, 140, 149, 148, 139, 138, 141,
+	137, 0, 133, 0, 132, 0, 0, 140, 149, 148,
+	139, 138, 141, 137, 0, 0, 1053, 132, 0, 0,
+	0, 0, 0, 135, 134, 0, 0, 0, 0, 145,
+	136, 144, 143, 146, 147, 0, 0, 1077, 130, 0,
+	131, 140, 149, 148, 139, 138, 141, 137, 0, 0,
+	0, 132, 0, 0, 140, 149, 148, 139, 138, 141,
+	137, 0, 0, 1031, 132, 0, 140, 149, 148, 139,
+	138, 141, 137, 0, 133, 422, 132, 0, 140, 149,
+	148, 139, 138, 141, 137, 0, 0, 133, 132, 0,
+	0, 0, 0, 0, 0, 135, 134, 0, 0, 0,
+	852, 145, 136, 144, 143, 146, 147, 0, 135, 134,
+	130, 0, 131, 0, 145, 136, 144, 143, 146, 147,
+	0, 133, 994, 130, 0, 131, 140, 149, 148, 139,
+	138, 141, 137, 0, 133, 85, 132, 0, 0, 0,
+	0, 0, 135, 134, 0, 0, 133, 0, 145, 136,
+	144, 143, 146, 147, 0, 135, 134, 130, 133, 131,
+	607, 145, 136, 144, 143, 146, 147, 135, 134, 0,
+	130, 0, 131, 145, 136, 144, 143, 146, 147, 135,
+	134, 876, 130, 0, 131, 145, 136, 144, 143, 146,
+	147, 0, 0, 0, 130, 0, 131, 140, 149, 148,
+	139, 138, 141, 137, 0, 0, 133, 132, 0, 0,
+	140, 149, 148, 139, 138, 141, 137, 812, 0, 821,
+	132, 0, 0, 0, 0, 0, 0, 135, 134, 0,
+	0, 0, 0, 145, 136, 144, 143, 146, 147, 0,
+	0, 849, 130, 0, 131, 0, 0, 140, 149, 148,
+	139, 138, 141, 137, 0, 0, 0, 132, 0, 0,
+	0, 0, 0, 0, 0, 0, 140, 149, 148, 139,
+	138, 141, 137, 0, 0, 0, 132, 133, 94, 0,
+	0, 0, 86, 87, 88, 89, 90, 91, 92, 93,
+	133, 95, 96, 97, 98, 99, 0, 0, 135, 134,
+	0, 0, 0, 0, 145, 136, 144, 143, 146, 147,
+	0, 135, 134, 130, 0, 131, 0, 145, 136, 144,
+	143, 146, 147, 0, 0, 818, 130, 133, 131, 140,
+	149, 148, 139, 138, 141, 137, 0, 0, 0, 132,
+	0, 0, 0, 0, 0, 0, 133, 0, 135, 134,
+	670, 719, 0, 0, 145, 136, 144, 143, 146, 147,
+	0, 0, 817, 130, 0, 131, 0, 135, 134, 0,
+	0, 0, 0, 145, 136, 144, 143, 146, 147, 0,
+	0, 0, 130, 0, 131, 140, 149, 148, 139, 138,
+	141, 137, 0, 0, 673, 132, 0, 140, 149, 148,
+	139, 138, 141, 137, 0, 0, 0, 132, 0, 133,
+	140, 149, 148, 139, 138, 141, 137, 0, 0, 0,
+	132, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	135, 134, 572, 0, 0, 0, 145, 136, 144, 143,
+	146, 147, 0, 0, 0, 130, 0, 131, 0, 140,
+	149, 148, 139, 138, 141, 137, 0, 0, 508, 132,
+	505, 0, 0, 0, 0, 133, 0, 0, 140, 149,
+	148, 139, 138, 141, 137, 0, 0, 133, 132, 0,
+	0, 0, 0, 0, 0, 0, 135, 134, 0, 0,
+	133, 0, 145, 136, 144, 143, 146, 147, 135, 134,
+	0, 130, 0, 131, 145, 136, 144, 143, 146, 147,
+	0, 135, 134, 130, 0, 131, 0, 145, 136, 144,
+	143, 146, 147, 0, 0, 0, 130, 0, 131, 133,
+	140, 149, 148, 139, 138, 141, 137, 0, 0, 0,
+	132, 0, 0, 0, 0, 0, 0, 0, 133, 0,
+	135, 134, 0, 0, 0, 0, 145, 136, 144, 143,
+	146, 147, 0, 0, 0, 130, 0, 131, 0, 135,
+	134, 0, 0, 0, 0, 145, 136, 144, 143, 146,
+	147, 0, 0, 0, 130, 0, 131, 140, 149, 148,
+	139, 138, 141, 137, 0, 0, 0, 132, 0, 140,
+	149, 148, 139, 138, 141, 137, 0, 0, 0, 132,
+	133, 372, 0, 352, 0, 0, 356, 0, 0, 351,
+	0, 0, 0, 0, 140, 149, 148, 139, 138, 141,
+	137, 135, 134, 0, 132, 0, 0, 145, 136, 144,
+	143, 146, 147, 0, 0, 0, 130, 410, 131, 0,
+	140, 149, 148, 139, 138, 141, 137, 0, 0, 0,
+	132, 0, 0, 0, 0, 0, 0, 133, 0, 0,
+	140, 149, 148, 139, 138, 141, 137, 0, 0, 133,
+	132, 0, 0, 0, 0, 363, 0, 0, 135, 134,
+	0, 0, 0, 0, 145, 136, 144, 143, 146, 147,
+	135, 134, 0, 130, 133, 131, 145, 136, 144, 143,
+	146, 147, 0, 0, 0, 130, 0, 131, 140, 149,
+	148, 139, 138, 141, 137, 135, 134, 0, 132, 0,
+	133, 145, 136, 144, 143, 146, 147, 0, 0, 0,
+	130, 0, 131, 140, 149, 148, 139, 138, 141, 137,
+	133, 135, 134, 132, 0, 0, 0, 145, 136, 144,
+	143, 146, 147, 0, 0, 291, 130, 0, 131, 0,
+	0, 135, 134, 0, 0, 0, 0, 145, 136, 144,
+	143, 146, 147, 0, 0, 0, 130, 0, 131, 140,
+	562, 148, 139, 138, 141, 137, 0, 0, 133, 132,
+	0, 140, 414, 148, 139, 138, 141, 137, 0, 0,
+	0, 132, 0, 0, 0, 0, 0, 0, 0, 135,
+	134, 0, 0, 133, 0, 145, 136, 144, 143, 146,
+	147, 0, 0, 0, 130, 0, 131, 0, 0, 85,
+	0, 0, 0, 0, 135, 134, 0, 0, 0, 0,
+	145, 136, 144, 143, 146, 147, 0, 85, 0, 130,
+	0, 131, 140, 149, 589, 139, 138, 141, 137, 133,
+	0, 0, 132, 0, 0, 0, 0, 85, 103, 104,
+	105, 133, 125, 107, 119, 0, 120, 0, 0, 0,
+	135, 134, 0, 0, 0, 0, 145, 136, 144, 143,
+	146, 147, 135, 134, 102, 130, 85, 131, 145, 136,
+	144, 143, 146, 147, 204, 0, 0, 130, 0, 131,
+	777, 778, 780, 781, 85, 103, 104, 105, 0, 125,
+	107, 119, 0, 120, 0, 0, 0, 0, 0, 0,
+	0, 0, 133, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 779, 0, 0, 0, 126, 0, 0, 0,
+	0, 0, 0, 135, 134, 0, 0, 0, 0, 145,
+	136, 144, 143, 146, 147, 0, 0, 0, 130, 0,
+	131, 0, 94, 0, 0, 0, 86, 87, 88, 89,
+	90, 91, 92, 93, 0, 95, 96, 97, 98, 99,
+	94, 0, 0, 126, 86, 87, 88, 89, 90, 91,
+	92, 93, 0, 95, 96, 97, 98, 99, 0, 0,
+	94, 0, 0, 0, 86, 87, 88, 89, 90, 91,
+	92, 93, 0, 95, 96, 97, 98, 99, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 94,
+	0, 0, 0, 86, 87, 88, 89, 90, 91, 92,
+	93, 0, 95, 96, 97, 98, 99, 94, 0, 0,
+	0, 86, 87, 88, 89, 90, 91, 92, 93, 0,
+	95, 96, 97, 98, 99,
 }
 var yyPact = [...]int{
 
-	2171, -1000, 285, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 3491, -1000,
-	2718, 2707, -1000, -1000, 184, 925, 919, 1017, 540, -1000,
-	560, 1004, 1007, 555, 555, 592, -1000, -1000, 2707, 2707,
-	543, 2707, 2707, 2707, 2707, 2707, 2707, 2707, -1000, 555,
-	555, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 297, -1000, -1000, -1000, 2616, 2423, 1024, 932, -64,
-	-36, -1000, -1000, -1000, -1000, -1000, -1000, 2707, 2707, 264,
-	263, 262, -1000, 348, 258, 2707, 2707, -1000, -1000, -1000,
-	555, -1000, -1000, -1000, -1000, -1000, -1000, 254, 252, 2171,
-	2707, 2707, 2707, 742, 2707, 752, 108, 2707, 774, 2707,
-	2707, 2707, 2707, 2707, 2707, 2707, 3465, 2616, -1000, 248,
-	2707, 646, 3491, 887, 972, 482, 454, 993, 838, 736,
-	-1000, 733, 555, 482, -1000, 33, 289, -1000, 495, -1000,
-	555, 555, 555, 379, 277, -1000, -1000, -1000, 555, -1000,
-	-1000, -1000, -1000, 2707, 2707, 3441, 3375, -1000, 998, 3491,
-	3491, 1112, -64, 3491, 3401, -1000, 1610, -64, 3491, -1000,
-	2809, 2707, 1058, 157, 158, 225, 3351, 81, 760, 1017,
-	-1000, -1000, -1000, -1000, 30, 555, -1000, 524, 2605, 511,
-	-1000, -1000, 1516, 736, 736, 108, 108, 761, 771, -1000,
-	-1000, 3475, -1000, 378, 736, 2707, -1000, 71, 17, 17,
-	808, 3531, 2707, 108, 2707, -1000, 2616, -1000, 17, 108,
-	108, -4, -4, -1000, -1000, -1000, 1157, 3475, 2171, 157,
-	156, 2707, 640, 625, 615, 2707, 811, 867, 482, 992,
-	28, -1000, -1000, 305, 995, 984, 305, 776, 776, 776,
-	2307, -1000, 315, 1035, 1017, 2707, 430, 309, 246, 243,
-	-1000, -1000, -1000, 2707, 2707, 2707, 2707, 963, 3491, 3491,
-	1014, 1013, 555, 2707, 2707, 2707, 2707, 3491, 2707, 3491,
-	-1000, -1000, -1000, 1869, 555, 1017, 555, 38, 759, 932,
-	290, -1000, -1000, 153, 2707, -1000, -1000, -1000, -1000, 152,
-	27, 958, -1000, 3491, -1000, -1000, -34, 240, 239, 237,
-	233, 230, 228, 2707, 2514, -1000, -1000, 108, 191, 191,
-	191, 742, -1000, 2707, 1546, -1000, -1000, 2707, 3501, -1000,
-	17, -1000, -1000, 626, -1000, 2707, 533, 2171, 531, 2707,
-	3340, 824, 2707, 2332, 183, 505, 449, 482, 984, 86,
-	-1000, 466, -1000, -1000, 257, -1000, 226, 224, 305, 884,
-	2707, -1000, 225, -1000, 225, 225, -1000, 555, 733, -1000,
-	220, 227, 449, 555, -1000, 3491, 733, 555, 733, 164,
-	555, 3491, -64, 3491, -64, -64, 3491, -64, 3491, 1017,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 3491, 529, 283,
-	-1000, -1000, 2718, 2707, -1000, -1000, -1000, -1000, -1000, 561,
-	-1000, 20, 556, 555, 555, -1000, 223, 555, -1000, 150,
-	-1000, 2307, 555, 2605, 736, 736, 736, 2707, 2707, 2707,
-	149, 147, 145, 757, -1000, 124, -1000, 222, -1000, -1000,
-	473, 143, 2707, 3475, 2707, 528, 614, 2171, 2707, 3308,
-	704, -1000, -1000, 3491, 2171, -1000, 2707, 1411, -1000, 12,
-	857, 3491, -1000, 108, 449, -1000, -1000, 555, 993, 9,
-	271, -75, -1000, -1000, 820, 810, 789, 789, 856, 305,
-	-1000, -1000, -1000, -1000, 555, 126, 2707, 2707, 984, 870,
-	844, 3491, 772, -1000, -1000, 772, 142, 7, -1000, 902,
-	555, 904, -1000, 449, 900, 892, -1000, 141, -1000, 957,
-	135, -1, -1000, -1000, -8, 903, -26, -1000, 663, 1869,
-	3284, 639, 1869, 1869, 550, 541, 733, 133, -1000, -1000,
-	-1000, 131, 2707, 2707, 2514, 2707, 128, 127, 125, -1000,
-	-1000, -1000, 108, 118, -22, 2707, -1000, 729, 345, 3194,
-	3475, 687, 520, -1000, 3250, 2707, -1000, 3160, 638, 3491,
-	-1000, 734, 337, 2332, 333, -1000, -1000, -1000, 117, -24,
-	-1000, 984, 449, 2707, 305, 305, 806, -1000, 805, 801,
-	789, -1000, -1000, -1000, 1360, 5, 1350, -1000, -1000, 2707,
-	2707, 952, 555, -1000, -1000, -1000, 449, 449, 116, -25,
-	2707, 104, 555, 2707, 951, 371, 949, 1017, 1017, 2707,
-	948, 1017, -1000, -1000, 1869, 595, 2707, 517, 513, 1869,
-	1869, 99, 946, 416, 97, 95, 94, 88, 85, 414,
-	397, 375, -1000, -1000, 108, 1206, -1000, 881, -1000, -1000,
-	685, 2171, 3160, -1000, -1000, 2707, -1000, -1000, -1000, 922,
-	784, 449, -1000, -1000, 3491, 856, 1082, 305, 305, 305,
-	798, 2707, -1000, 2707, 555, 3491, -1000, 733, -1000, -1000,
-	-1000, 902, 555, 3491, -1000, -1000, -64, 3491, 733, 2020,
-	365, -1000, -1000, -1000, 903, 3491, 359, 83, 617, 508,
-	1869, 3218, 662, 660, 504, 502, -1000, 219, 218, 410,
-	406, 402, 401, 369, 217, 215, 332, 214, 322, -1000,
-	2707, 211, -1000, 671, 3184, -1000, -1000, -1000, 108, -1000,
-	-1000, -1000, 2707, 209, 1082, 991, 856, 305, -28, 3128,
-	82, -51, -1000, -1000, -1000, -1000, 501, 278, -1000, -1000,
-	2718, 2707, -1000, -1000, 2707, 2707, 2020, 2020, 938, 496,
-	589, 1869, 2707, 699, -1000, 1869, -1000, -1000, 653, 651,
-	733, 418, 201, 200, 196, 188, 185, 418, 418, 399,
-	418, 398, 3094, 887, -1000, 2171, -1000, 3491, 555, -1000,
-	2707, 856, -1000, -1000, -1000, -1000, 2707, -1000, 2020, 3070,
-	637, 3060, 32, 753, 3491, 492, 491, 353, 684, 487,
-	-1000, 3038, -1000, 636, -1000, -1000, 80, 78, -1000, 889,
-	835, 418, 418, 418, 418, 418, 75, 887, 74, 175,
-	70, 163, -1000, 64, 61, 3491, 59, -1000, 2020, 588,
-	2707, 1718, 555, 555, -1000, -1000, 2020, -1000, 680, 1869,
-	-1000, 2707, -1000, -1000, -1000, 831, 2707, 57, 55, 54,
-	44, 43, -1000, -1000, 418, -1000, 418, -1000, -1000, -1000,
-	611, 486, 2020, 3028, 484, 275, -1000, -1000, 2718, 2707,
-	-1000, -1000, -1000, 538, 535, 483, -1000, 669, 3004, 2332,
-	-1000, -1000, -1000, -1000, -1000, -1000, 39, 37, 481, 587,
-	2020, 2707, 695, -1000, 2020, 650, 1718, 2938, 634, 1718,
-	1718, -1000, -1000, 1869, 314, -1000, -1000, 679, 480, -1000,
-	2914, -1000, 632, -1000, -1000, 1718, 577, 2707, 471, 468,
-	-1000, 812, -1000, 678, 2020, -1000, 2707, 559, 446, 1718,
-	2904, 649, 544, -1000, 817, 726, 725, 711, -1000, 668,
-	2881, 435, 575, 1718, 2707, 694, -1000, 1718, -1000, -1000,
-	745, 723, -1000, 716, 710, -1000, -1000, -1000, -1000, 2020,
-	677, 434, -1000, 2848, -1000, 594, 770, -1000, -1000, -1000,
-	-1000, -1000, 673, 1718, -1000, 2707, -1000, 718, -1000, -1000,
-	666, 2758, -1000, -1000, 1718,
+	3195, -1000, 399, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 5972, -1000, 4646, 4542, -1000, 47, -1000,
+	3195, 329, 550, 1073, 1159, 5069, -1000, 727, 1150, 1144,
+	1144, 6183, 6183, 646, 422, -1000, -1000, 4542, 4542, 6232,
+	4542, 4542, 4542, 4542, 4542, 4438, 6183, 4542, 517, 866,
+	4542, -1000, 6183, 6183, 4542, 866, 382, -1000, -1000, -1000,
+	-1000, -1000, 465, 464, -1000, -1000, -1000, 405, -1000, -1000,
+	-1000, -1000, 4230, -1000, 3802, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1165, 1069, 37, -1000, -1000, -1000, -1000, -1000, -1000, 4542,
+	4542, 380, 377, 375, -1000, 495, 374, 4542, 4542, -1000,
+	-1000, -1000, -1000, 6183, 3698, -1000, -1000, 373, 372, 3195,
+	4542, 6183, 3882, 435, 4542, 4542, 4542, 883, 4542, 895,
+	223, 4542, 928, 4542, 4542, 4542, 4542, 4542, 4542, 4542,
+	5997, 4230, -1000, 35, 371, 4542, 793, 5972, 809, 2009,
+	4334, 594, 398, 1024, 1108, 2709, 1725, 1129, 996, 943,
+	-1000, 866, 6183, 6183, 2709, -1000, 12, 404, -1000, 89,
+	608, -1000, 6183, 6183, 6183, 6183, 6183, 546, 540, -1000,
+	1056, 10, -1000, -1000, 6183, -1000, -1000, -1000, -1000, 4542,
+	4542, 6183, 5904, 5924, -1000, 1137, 5972, 5972, 2412, 35,
+	5972, 5972, 5878, 4542, 1132, -1000, 4070, -1000, 866, 290,
+	-1000, 35, 5972, -1000, 4750, 5853, 1055, 866, 370, 369,
+	4542, 2181, 260, 262, 5841, 40, 915, 1159, -1000, -1000,
+	-1000, -1000, -14, 6183, -1000, 3286, 117, 117, 3384, 870,
+	870, 223, 223, 907, 920, -1000, -1000, 2224, 117, 507,
+	-1000, 61, 870, 4542, -1000, 5784, -1000, -1000, -1000, 433,
+	72, 13, 13, 946, 6055, 4542, 223, 4542, -1000, 4230,
+	-1000, 13, 223, 223, 111, 111, 117, 117, 117, 6116,
+	2224, 3195, 260, 258, 4542, 792, 772, 771, 4542, -1000,
+	368, -1000, 256, 4542, -1000, 3195, 3195, 987, 1007, 2709,
+	1126, -16, 24, -1000, 3089, 1130, 1111, 3089, 931, 931,
+	931, 3489, 870, -1000, 417, 941, 1059, 1159, 4542, 584,
+	1068, 6183, 385, 361, 359, -1000, -1000, 23, -1000, -1000,
+	-1000, 4542, 4542, 4542, 4542, 4542, 1144, 666, 5972, 5972,
+	-1000, 1155, 1153, 6183, 4542, 4542, 4542, 5722, 4542, 4542,
+	-1000, 5703, 4542, 4542, 429, 253, 1119, 1113, 5972, -1000,
+	-1000, -1000, 2817, 6183, 1159, 6183, 26, 912, 1069, 378,
+	-1000, -1000, -1000, 251, -17, 1105, -1000, 5972, -1000, -1000,
+	71, 355, 354, 353, 351, 348, 346, 4542, 4010, -1000,
+	-1000, 223, 268, 268, 268, 883, -1000, -1000, 4542, 3746,
+	-1000, 4542, -1000, -1000, 4542, 6043, -1000, 13, -1000, -1000,
+	742, -1000, 4542, 695, 3195, 694, 4542, 5664, 4542, 487,
+	246, 693, -1000, 984, 4542, 3594, 242, 6165, 1753, 2709,
+	6183, 1111, 54, -1000, 5461, -1000, -1000, 2529, -1000, 342,
+	339, 335, 333, 3267, 33, 3089, 1022, 4542, -1000, 290,
+	-1000, 290, 290, -1000, 3489, 1512, 866, -1000, 2709, 565,
+	124, 1753, 1753, 6183, -1000, 5972, 914, 1160, -1000, -1000,
+	-1000, 1512, 866, 252, 6183, 5972, 35, 5972, 35, 35,
+	5972, 35, 5972, 5972, -1000, 1159, 4542, -1000, -1000, -1000,
+	-1000, -1000, -1000, -18, 5651, 4542, 5972, -1000, 4542, 5639,
+	5972, 866, 1054, 4542, 4542, 691, 397, -1000, -1000, 4646,
+	4542, -1000, -2, -1000, -1000, 2817, 6183, 6183, 735, -1000,
+	-24, 728, 6183, 6183, -1000, 332, 6183, -1000, 3489, 6183,
+	4122, 870, 870, 870, 4542, 4542, 4542, 244, 240, 239,
+	892, -1000, 216, -1000, 330, -1000, -1000, 626, 238, 4542,
+	51, 2224, 4542, 686, 770, 3195, 4542, 5583, 840, -1000,
+	-1000, 5972, 3195, 237, 1021, 472, 601, -1000, 4542, 4278,
+	-1000, -26, 1002, 5972, -1000, 223, 1753, -1000, -1000, 6183,
+	1129, -28, 392, 16, -1000, -1000, -1000, 980, 976, 948,
+	948, 970, 3089, -1000, -1000, -1000, -1000, 6183, 169, 4542,
+	4542, 4542, 6183, -1000, -1000, 4542, 4542, 1111, 997, 998,
+	5972, 924, -1000, -1000, 924, -1000, -37, -44, -45, 6203,
+	-1000, -1000, -1000, 328, 6183, 327, -1000, 326, 1049, 6183,
+	2904, -1000, 1753, 1053, 1125, 1048, -1000, 325, 936, -1000,
+	-1000, -1000, 236, -31, 917, -1000, -1000, 1103, 235, 233,
+	-32, -1000, 1159, -1000, -33, 1064, -48, -1000, 5520, 4542,
+	6183, -1000, 5972, 4542, -1000, 4542, 5501, 5464, 810, 2817,
+	5451, 789, 809, 593, -1000, -1000, 2817, 2817, 715, 712,
+	866, 232, -34, -1000, -1000, 231, 4542, 4542, 4010, 4542,
+	230, 228, 219, 469, -1000, -1000, 223, 209, -35, 4542,
+	-1000, 860, 468, 5380, 2224, 834, 683, -1000, 5332, 4542,
+	-1000, 5308, 788, -1000, 324, 1019, -1000, 5972, -1000, 868,
+	458, 3594, 454, -1000, -1000, -1000, 207, -46, -1000, 1111,
+	1753, 4542, 2009, 3089, 3089, 964, -1000, 957, 954, 948,
+	-1000, -1000, -1000, 3538, 5320, 3330, 323, 5972, -82, 1870,
+	-1000, -1000, 4542, 4542, 1086, 1512, 1086, 1512, 273, 6183,
+	-1000, -1000, 917, -1000, -1000, -1000, -1000, 322, 6183, 878,
+	-1000, -1000, 4542, 1037, 6183, 1753, -1000, -1000, -1000, 1753,
+	1753, 206, -67, 4542, 1065, 201, 6183, 441, 4542, 6183,
+	2709, 1102, 1512, 516, 1101, 1100, 623, -1000, 1159, 4542,
+	1098, 1159, 1159, -1000, -1000, 5972, 4290, -1000, -1000, -1000,
+	-1000, 2817, 761, 4542, -1000, 2817, 681, 673, 2817, 2817,
+	200, 1097, 6183, 514, 199, 198, 196, 195, 192, 571,
+	520, 519, 1018, -1000, -1000, 223, 3296, -1000, 1017, -1000,
+	-1000, 833, 3195, 5308, -1000, -1000, 4542, 1024, 321, -1000,
+	-1000, -1000, 1074, 922, 1753, -1000, -1000, 5972, -1000, 970,
+	1152, 3089, 3089, 3089, 953, 4542, -1000, 4542, 4542, -1000,
+	4542, 320, 6183, 5972, -1000, 866, 6203, -1000, -1000, 866,
+	917, -1000, 1512, 866, 6250, -1000, -1000, 4542, 933, -1000,
+	5261, 319, 316, 187, 186, -1000, -1000, 1049, 6183, 5972,
+	4542, -1000, -1000, 6183, 35, 5972, 313, 1096, 866, -1000,
+	3006, 515, 513, -1000, -1000, 184, -1000, 1064, 5972, 510,
+	179, -83, -1000, 312, 732, 671, 2817, 5295, 670, 808,
+	806, 668, 664, -1000, 311, -1000, 310, 504, 503, 564,
+	549, 502, 309, 303, 453, 301, 452, 300, -1000, 4542,
+	299, -1000, 821, 5248, 178, 1024, -1000, -1000, -1000, 223,
+	-1000, -1000, -1000, 4542, 298, 1152, 1171, 970, 3089, -69,
+	1959, 1632, 177, 210, 6183, 20, -1000, -1000, 176, -1000,
+	5176, 296, 873, -1000, -1000, 4542, 6183, -1000, 937, -1000,
+	-1000, 5972, -1000, 4542, 509, -1000, 662, 396, -1000, -1000,
+	4646, 4542, -1000, -50, -1000, 3006, 4542, 3906, 3006, 3006,
+	1094, 3006, 1089, 1159, 6183, 655, 755, 2817, 4542, 838,
+	-1000, 2817, 598, -1000, -1000, 805, 804, 866, 551, 294,
+	293, 291, 289, 288, 551, 551, 538, 551, 526, 1024,
+	5129, 1024, -1000, 3195, -1000, 174, -1000, 5972, 6183, -1000,
+	4542, 970, -1000, -1000, 278, -1000, 4542, 173, -1000, 274,
+	172, -90, 4542, -1000, 4542, 270, 1086, -1000, 4542, -1000,
+	5057, 171, 6183, 170, 3006, -1000, 3006, 5116, 784, 801,
+	592, 1620, 25, 905, 5972, 866, 6183, 653, 652, 505,
+	650, 494, -54, -60, 6250, 832, 649, -1000, 5103, -1000,
+	782, -1000, -1000, -1000, 167, 161, -1000, 1026, 994, 551,
+	551, 551, 551, 551, 160, 1024, 155, 269, 150, 267,
+	149, -1000, 148, -1000, 147, 5972, 6183, 4938, -1000, 6183,
+	146, 6183, 5972, 204, 6183, 866, 4142, -1000, -1000, -1000,
+	140, 641, -1000, 3006, 753, 4542, -1000, 3006, 2627, 6183,
+	6183, -1000, 507, -1000, -1000, 3006, -1000, 3006, 6183, -1000,
+	-1000, -1000, 831, 2817, -1000, 4542, -1000, -1000, -1000, 992,
+	4542, 139, 138, 137, 133, 129, -1000, -1000, 551, -1000,
+	551, -1000, -1000, -1000, 126, -92, 444, -1000, 125, -1000,
+	-1000, -1000, 265, 122, -1000, -1000, -1000, -1000, 725, 639,
+	3006, 4984, 638, 636, 395, -1000, -1000, 4646, 4542, -1000,
+	-56, -1000, -1000, 2627, 705, 647, 635, 632, 6250, -1000,
+	820, 4971, 3594, -1000, -1000, -1000, -1000, -1000, -1000, 121,
+	109, 106, 6183, 4542, 105, 6183, 102, 630, 749, 3006,
+	4542, 837, -1000, 3006, 597, 802, 2627, 4925, 781, 801,
+	589, 2627, 2627, -1000, -1000, -1000, 2817, 448, -1000, -1000,
+	-1000, -1000, 5972, -1000, 83, -1000, 829, 612, -1000, 4912,
+	-1000, 780, -1000, -1000, -1000, 2627, 747, 4542, -1000, 2627,
+	610, 609, -1000, 918, 77, -1000, 828, 3006, -1000, 4542,
+	703, 606, 2627, 4852, 605, 800, 796, -1000, 935, 857,
+	856, 843, -1000, -1000, 817, 4793, 604, 743, 2627, 4542,
+	836, -1000, 2627, 596, -1000, -1000, 891, 855, -1000, 853,
+	842, -1000, -1000, -1000, -1000, 3006, 826, 603, -1000, 4770,
+	-1000, 778, -1000, 930, -1000, -1000, -1000, -1000, -1000, 823,
+	2627, -1000, 4542, -1000, 848, -1000, -1000, 813, 4590, -1000,
+	-1000, 2627,
 }
 var yyPgo = [...]int{
 
-	0, 58, 38, 26, 65, 97, 89, 1145, 28, 1144,
-	24, 1134, 1133, 1125, 1124, 55, 6, 1123, 1121, 1120,
-	1119, 1118, 1117, 1113, 75, 35, 41, 1112, 1111, 52,
-	1110, 1109, 60, 51, 1108, 1105, 1104, 1103, 1102, 714,
-	92, 85, 1101, 71, 61, 1098, 1097, 27, 1096, 62,
-	1095, 30, 1094, 80, 1092, 99, 88, 146, 0, 68,
-	49, 16, 13, 1090, 1089, 1088, 1087, 1051, 1086, 94,
-	1085, 1084, 1081, 32, 1080, 1079, 1078, 5, 18, 53,
-	14, 1077, 1073, 2, 1072, 1070, 77, 82, 91, 1068,
-	31, 1065, 36, 1062, 1061, 1060, 9, 37, 1058, 50,
-	17, 73, 23, 72, 1056, 1055, 1053, 57, 1043, 34,
-	74, 10, 33, 7, 8, 1, 3, 63, 1042, 12,
-	1038, 11, 1036, 4, 1035, 973, 109, 15, 19, 1034,
-	93, 968, 1032, 306, 76, 70, 54, 69, 81, 1031,
-	56, 792,
+	0, 79, 97, 21, 19, 220, 290, 156, 96, 110,
+	1354, 27, 1352, 1350, 1349, 1346, 171, 153, 1343, 1342,
+	1339, 1338, 1337, 1335, 1334, 83, 39, 41, 38, 1331,
+	30, 46, 1329, 22, 1328, 89, 1327, 1326, 44, 1325,
+	1321, 32, 49, 1316, 55, 29, 47, 1314, 1313, 1310,
+	1309, 1308, 1306, 1304, 1302, 1301, 1300, 1299, 1297, 1622,
+	103, 94, 1296, 71, 66, 1295, 1294, 26, 1293, 59,
+	1292, 1531, 1291, 88, 16, 98, 92, 23, 1222, 62,
+	73, 1288, 45, 20, 1285, 1284, 1281, 1279, 1871, 1272,
+	93, 1268, 1266, 1264, 78, 1260, 1259, 1255, 14, 17,
+	76, 15, 1239, 1231, 4, 1228, 1226, 81, 95, 84,
+	1225, 1223, 8, 1214, 10, 100, 1210, 35, 1209, 1204,
+	1203, 25, 40, 1202, 48, 24, 75, 33, 77, 1200,
+	1193, 1192, 70, 1191, 37, 74, 11, 18, 5, 9,
+	2, 6, 60, 1190, 13, 1189, 7, 1188, 3, 1183,
+	0, 68, 177, 34, 1181, 1179, 90, 12, 87, 1178,
+	1176, 1175, 65, 85, 86, 72, 69, 67, 91, 1172,
+	56, 694,
 }
 var yyR1 = [...]int{
 
 	0, 1, 1, 1, 2, 2, 3, 3, 4, 4,
-	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
-	5, 5, 5, 5, 5, 6, 6, 7, 7, 8,
-	8, 8, 8, 8, 9, 9, 10, 10, 12, 12,
-	11, 11, 11, 11, 11, 13, 13, 13, 13, 13,
-	13, 14, 14, 15, 15, 15, 16, 16, 17, 17,
-	18, 18, 18, 18, 18, 19, 19, 19, 19, 19,
-	19, 20, 20, 20, 20, 21, 21, 21, 21, 21,
-	22, 22, 23, 23, 23, 23, 23, 23, 23, 23,
-	23, 23, 24, 24, 25, 25, 26, 26, 26, 26,
-	26, 27, 27, 27, 27, 27, 28, 28, 28, 28,
-	29, 30, 30, 31, 32, 32, 33, 33, 33, 34,
-	34, 34, 34, 34, 35, 35, 35, 35, 35, 35,
-	35, 36, 36, 36, 37, 37, 37, 37, 37, 37,
-	37, 37, 37, 37, 37, 37, 37, 37, 37, 37,
-	37, 37, 37, 37, 37, 37, 37, 37, 38, 38,
-	38, 39, 40, 40, 40, 40, 41, 41, 42, 43,
-	43, 44, 44, 45, 45, 46, 46, 47, 47, 48,
-	48, 48, 49, 49, 50, 50, 51, 51, 52, 52,
-	53, 53, 54, 54, 54, 54, 54, 54, 55, 56,
-	57, 57, 57, 57, 57, 58, 58, 58, 58, 58,
-	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
-	58, 59, 60, 60, 60, 61, 61, 62, 62, 63,
-	63, 64, 64, 65, 65, 65, 66, 66, 67, 68,
-	69, 69, 69, 70, 70, 70, 70, 70, 70, 70,
-	70, 70, 70, 70, 70, 70, 70, 70, 70, 70,
-	70, 70, 71, 71, 71, 71, 71, 71, 71, 72,
-	72, 72, 72, 73, 73, 74, 74, 74, 74, 75,
-	75, 75, 75, 75, 76, 76, 77, 77, 77, 77,
-	77, 77, 77, 77, 77, 77, 77, 78, 79, 79,
-	80, 80, 81, 81, 82, 82, 82, 83, 83, 83,
-	84, 84, 85, 85, 86, 86, 87, 87, 87, 89,
-	89, 89, 89, 89, 89, 89, 90, 90, 90, 90,
-	90, 90, 90, 91, 91, 91, 91, 91, 91, 92,
-	92, 93, 93, 94, 94, 94, 95, 96, 96, 97,
-	97, 98, 98, 99, 99, 100, 100, 101, 101, 88,
-	88, 102, 102, 103, 103, 104, 104, 104, 104, 105,
-	106, 107, 107, 108, 108, 109, 109, 110, 110, 111,
-	111, 112, 112, 113, 113, 114, 114, 115, 115, 116,
-	116, 117, 117, 118, 118, 119, 119, 120, 120, 121,
-	121, 122, 122, 123, 123, 124, 124, 125, 125, 125,
-	125, 126, 127, 127, 128, 129, 129, 130, 130, 131,
-	132, 133, 133, 134, 134, 135, 135, 136, 136, 137,
-	137, 138, 138, 139, 139, 140, 140, 141, 141,
+	5, 5, 6, 6, 6, 6, 6, 6, 6, 6,
+	6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
+	6, 6, 7, 7, 7, 7, 8, 8, 9, 9,
+	9, 9, 9, 10, 10, 11, 11, 13, 13, 12,
+	12, 12, 12, 12, 12, 12, 14, 14, 14, 14,
+	14, 14, 14, 14, 15, 15, 16, 16, 16, 17,
+	17, 17, 17, 18, 18, 19, 19, 19, 19, 19,
+	19, 19, 20, 20, 20, 20, 20, 20, 20, 20,
+	21, 21, 21, 21, 22, 22, 22, 22, 22, 22,
+	22, 23, 23, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 25,
+	25, 25, 25, 26, 26, 36, 36, 36, 36, 37,
+	37, 37, 37, 37, 37, 37, 38, 38, 32, 32,
+	31, 31, 33, 33, 35, 35, 34, 34, 34, 34,
+	28, 29, 29, 29, 29, 30, 30, 30, 30, 27,
+	27, 27, 27, 27, 39, 39, 39, 39, 39, 39,
+	39, 40, 40, 40, 40, 41, 42, 42, 43, 45,
+	45, 46, 46, 46, 44, 47, 47, 47, 47, 47,
+	47, 47, 48, 48, 49, 49, 49, 50, 50, 51,
+	51, 51, 52, 52, 52, 52, 52, 52, 52, 53,
+	53, 53, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 55, 55, 55, 55, 55, 56,
+	56, 57, 58, 58, 58, 59, 60, 60, 60, 60,
+	61, 61, 62, 62, 63, 63, 64, 64, 65, 65,
+	66, 66, 67, 67, 68, 68, 68, 69, 69, 70,
+	70, 71, 71, 72, 72, 73, 73, 74, 74, 74,
+	74, 74, 74, 75, 76, 77, 77, 77, 77, 77,
+	78, 78, 78, 78, 78, 78, 78, 78, 78, 78,
+	78, 78, 78, 78, 78, 78, 78, 78, 78, 78,
+	78, 78, 78, 78, 78, 81, 81, 79, 80, 80,
+	80, 82, 82, 83, 83, 84, 84, 85, 85, 86,
+	86, 86, 87, 87, 88, 89, 90, 90, 90, 91,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	91, 91, 91, 91, 91, 91, 91, 91, 92, 92,
+	92, 92, 92, 92, 92, 93, 93, 93, 93, 94,
+	94, 95, 95, 95, 95, 95, 95, 96, 96, 96,
+	96, 96, 96, 96, 97, 97, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 99, 100, 100,
+	101, 101, 102, 102, 103, 103, 103, 104, 104, 104,
+	105, 105, 106, 106, 107, 107, 107, 108, 108, 108,
+	110, 110, 110, 110, 110, 110, 110, 110, 110, 110,
+	110, 110, 110, 110, 110, 110, 110, 110, 110, 115,
+	115, 115, 115, 115, 115, 115, 116, 116, 116, 116,
+	116, 116, 117, 117, 118, 118, 119, 119, 119, 120,
+	121, 121, 122, 122, 123, 123, 124, 124, 125, 125,
+	126, 126, 109, 109, 111, 111, 112, 112, 113, 113,
+	114, 114, 127, 127, 128, 128, 129, 129, 129, 129,
+	130, 131, 132, 132, 133, 133, 134, 134, 135, 135,
+	136, 136, 137, 137, 138, 138, 139, 139, 140, 140,
+	141, 141, 142, 142, 143, 143, 144, 144, 145, 145,
+	146, 146, 147, 147, 148, 148, 149, 149, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 160, 161, 161, 162, 162, 151, 151,
+	152, 153, 153, 154, 155, 155, 156, 156, 157, 158,
+	158, 159, 163, 163, 164, 164, 165, 165, 166, 166,
+	167, 167, 168, 168, 169, 169, 170, 170, 171, 171,
 }
 var yyR2 = [...]int{
 
 	0, 0, 1, 3, 0, 3, 0, 3, 0, 3,
+	0, 3, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 6,
-	8, 8, 9, 9, 1, 1, 1, 2, 1, 1,
-	7, 8, 6, 1, 1, 7, 8, 6, 1, 1,
-	1, 1, 1, 6, 8, 8, 1, 2, 1, 1,
-	7, 8, 6, 1, 1, 7, 8, 6, 1, 1,
-	1, 2, 2, 1, 2, 4, 4, 4, 4, 2,
-	1, 1, 6, 8, 5, 6, 8, 5, 7, 7,
-	7, 7, 1, 3, 1, 3, 0, 1, 1, 2,
-	2, 5, 2, 2, 3, 5, 6, 8, 5, 3,
-	1, 1, 3, 3, 1, 3, 1, 1, 3, 9,
-	10, 10, 12, 3, 0, 1, 1, 1, 1, 2,
-	2, 5, 6, 3, 4, 4, 4, 4, 4, 4,
-	2, 2, 2, 2, 4, 4, 2, 2, 2, 4,
-	1, 2, 2, 4, 2, 2, 1, 2, 2, 3,
-	4, 5, 5, 4, 4, 4, 1, 1, 3, 0,
-	2, 0, 2, 0, 3, 0, 2, 0, 3, 0,
-	3, 4, 0, 2, 0, 2, 0, 2, 6, 9,
-	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 3, 3, 3, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 1, 3, 1, 6, 1, 3, 1, 3, 2,
-	4, 1, 1, 0, 1, 1, 1, 1, 3, 3,
-	3, 1, 6, 3, 3, 3, 3, 4, 4, 5,
-	6, 6, 3, 4, 4, 3, 4, 4, 4, 4,
-	4, 2, 3, 3, 3, 3, 3, 2, 2, 3,
-	3, 2, 2, 0, 1, 4, 3, 4, 4, 5,
-	5, 5, 5, 1, 5, 10, 8, 9, 9, 9,
+	1, 1, 1, 2, 1, 2, 1, 1, 6, 8,
+	8, 9, 9, 1, 1, 1, 2, 1, 1, 7,
+	8, 6, 1, 3, 1, 6, 7, 8, 6, 1,
+	3, 1, 1, 6, 1, 1, 6, 8, 8, 1,
+	2, 3, 3, 1, 1, 7, 8, 6, 1, 3,
+	1, 6, 7, 8, 6, 1, 3, 1, 1, 6,
+	2, 2, 1, 2, 4, 4, 4, 4, 2, 2,
+	4, 1, 1, 6, 6, 8, 8, 5, 9, 11,
+	8, 6, 8, 5, 7, 7, 8, 7, 7, 1,
+	3, 2, 4, 1, 3, 4, 6, 4, 6, 4,
+	6, 2, 4, 1, 3, 1, 1, 2, 1, 1,
+	1, 3, 1, 3, 2, 1, 1, 3, 3, 3,
+	2, 1, 1, 1, 1, 1, 3, 3, 3, 0,
+	1, 1, 2, 2, 5, 11, 2, 2, 3, 5,
+	7, 6, 8, 5, 3, 1, 1, 3, 3, 1,
+	3, 1, 1, 3, 2, 9, 10, 10, 12, 10,
+	12, 3, 11, 3, 8, 10, 3, 1, 1, 1,
+	1, 1, 0, 1, 1, 1, 1, 2, 2, 5,
+	6, 3, 4, 4, 4, 4, 4, 4, 2, 2,
+	2, 2, 4, 4, 2, 2, 2, 2, 4, 3,
+	5, 4, 3, 1, 2, 2, 4, 2, 3, 2,
+	2, 2, 1, 2, 2, 3, 4, 5, 6, 2,
+	4, 5, 6, 10, 10, 5, 5, 4, 4, 4,
+	1, 1, 3, 4, 0, 2, 0, 2, 0, 3,
+	0, 2, 0, 3, 0, 3, 4, 0, 2, 0,
+	2, 0, 2, 6, 9, 1, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 3, 3, 3,
+	1, 1, 1, 1, 1, 1, 1, 6, 6, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
+	4, 3, 3, 3, 5, 2, 3, 1, 3, 1,
+	6, 1, 3, 1, 3, 2, 4, 1, 1, 0,
+	1, 1, 1, 1, 3, 3, 3, 1, 6, 3,
+	3, 3, 3, 4, 4, 5, 6, 6, 3, 4,
+	4, 3, 4, 4, 4, 4, 4, 2, 3, 3,
+	3, 3, 3, 2, 2, 3, 3, 2, 2, 0,
+	1, 4, 6, 9, 3, 4, 4, 5, 10, 5,
+	10, 5, 5, 1, 5, 10, 8, 9, 9, 9,
 	9, 9, 8, 8, 10, 8, 10, 2, 1, 5,
 	0, 3, 2, 5, 2, 2, 2, 2, 2, 2,
-	2, 1, 2, 1, 1, 1, 1, 2, 3, 1,
-	6, 6, 4, 6, 6, 8, 1, 1, 2, 3,
-	1, 1, 3, 4, 5, 6, 7, 5, 6, 2,
-	4, 1, 1, 1, 3, 1, 5, 0, 1, 4,
-	5, 0, 2, 1, 3, 1, 3, 1, 3, 1,
-	3, 1, 3, 1, 3, 6, 9, 5, 8, 7,
-	3, 1, 3, 5, 6, 4, 5, 0, 2, 4,
-	5, 0, 2, 4, 5, 0, 2, 4, 5, 0,
-	2, 4, 5, 0, 2, 4, 5, 0, 2, 4,
-	5, 0, 2, 4, 5, 0, 2, 1, 1, 1,
-	1, 1, 1, 3, 3, 1, 3, 1, 3, 1,
-	1, 0, 1, 0, 1, 0, 1, 0, 1, 1,
-	1, 0, 1, 0, 1, 0, 1, 1, 1,
+	2, 1, 2, 1, 1, 3, 1, 1, 2, 3,
+	1, 6, 6, 4, 6, 8, 10, 7, 2, 2,
+	3, 4, 6, 10, 8, 6, 8, 10, 12, 1,
+	1, 2, 3, 1, 1, 3, 4, 5, 6, 7,
+	5, 6, 2, 4, 1, 1, 1, 3, 1, 5,
+	0, 1, 4, 5, 0, 2, 1, 3, 1, 3,
+	1, 3, 1, 3, 1, 3, 1, 3, 1, 2,
+	1, 3, 1, 3, 1, 3, 6, 9, 5, 8,
+	7, 3, 1, 3, 5, 6, 4, 5, 0, 2,
+	4, 5, 0, 2, 4, 5, 0, 2, 4, 5,
+	0, 2, 4, 5, 0, 2, 4, 5, 0, 2,
+	4, 5, 0, 2, 4, 5, 0, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 1, 3, 1, 3,
+	1, 1, 3, 3, 1, 3, 1, 3, 1, 1,
+	3, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+	1, 1, 0, 1, 0, 1, 0, 1, 1, 1,
 }
 var yyChk = [...]int{
 
-	-1000, -1, -7, -5, -11, -39, -104, -105, -108, -23,
-	-20, -21, -27, -28, -34, -22, -37, -38, -58, 15,
-	85, 84, -8, -10, -51, 30, 33, 129, 93, -128,
-	99, 19, 20, 97, 98, 96, 107, 108, 31, 120,
-	130, 112, 113, 114, 115, 116, 121, 117, 118, 119,
-	122, -57, -54, -71, -68, -67, -74, -75, -95, -70,
-	-72, -126, -131, -132, -36, 154, 87, 111, 77, -125,
-	28, 5, 6, 7, -55, 10, -56, 151, 152, 137,
-	138, 136, -76, -60, 67, 71, 153, 11, 13, 14,
-	94, 4, 131, 132, 133, 9, 75, 139, 134, 148,
-	144, 143, 150, 74, 72, 71, 68, 73, -141, 152,
-	151, 149, 156, 157, 70, 69, -58, 154, -128, 85,
-	84, -96, -58, -40, 23, 18, 21, -42, -41, 16,
-	-67, 154, 34, 34, -130, -129, -126, -130, -125, -126,
-	94, 42, 123, -131, 12, -131, -125, -125, -35, 100,
-	101, 35, 36, 102, 103, -58, -58, 12, -125, -58,
-	-58, -58, -125, -58, -58, -100, -58, -125, -58, -125,
-	-125, 145, -58, -100, -39, -51, -58, -126, -127, -9,
-	129, 93, 6, -53, -52, -139, 29, 159, 154, 159,
-	-58, -58, 154, 154, 154, 143, 150, -134, -141, 71,
-	-67, -58, -58, -125, 154, 154, -1, -58, -58, -58,
-	-134, -58, 72, 68, 73, -60, 154, -67, -58, 66,
-	65, -58, -58, -58, -58, -58, -58, -58, 89, -100,
-	-73, 154, -96, -117, -97, 88, -47, 43, 24, -88,
-	-86, -125, 28, 17, -88, -43, 17, 62, 63, 64,
-	-133, 76, -125, -86, 158, 145, 94, 42, 123, 124,
-	-125, -125, -125, 150, 41, 150, 41, -125, -58, -58,
-	41, 17, 17, 158, 60, 60, 158, -58, 6, -58,
-	155, 155, 155, 91, 68, 158, 68, -126, -127, 158,
-	-125, -125, 6, -73, -133, -100, -125, 6, 155, -103,
-	-94, -93, -59, -58, -77, 149, -125, 138, 136, 139,
-	140, 141, 142, -133, -133, -60, -60, 72, 68, 66,
-	65, 74, 136, -133, -58, -55, -56, 69, -58, -60,
-	-58, -60, -60, -1, 155, 88, -118, 90, -98, 90,
-	-58, -48, 49, 46, -87, -86, 19, 158, -101, -90,
-	-87, -89, -91, 27, 154, -67, 135, -125, 17, -44,
-	22, -101, -138, 65, -138, -138, -103, 154, -140, 26,
-	31, 32, 40, 19, -130, -58, 95, 154, 26, 154,
-	154, -58, -125, -58, -125, -125, -58, -125, -58, 24,
-	12, 12, -125, -100, -100, -100, -100, -58, -2, -12,
-	-5, -13, 85, 84, -8, -10, -6, 109, 110, -125,
-	-127, -126, -125, 68, 68, -53, 26, 154, 155, -73,
-	155, 158, 26, 154, 154, 154, 154, 154, 154, 154,
-	-73, -73, -59, -60, -69, 154, -67, 134, -69, -69,
-	-134, -73, 158, -58, 69, -110, -109, 90, 86, -58,
-	92, -1, 92, -58, 89, -50, 50, -58, -62, -63,
-	-64, -58, -77, 25, 154, -39, -125, 26, -107, -106,
-	-57, -125, -88, -44, 58, -135, -137, 57, 61, 158,
-	53, 55, 56, -125, 26, -90, 154, 154, -101, -45,
-	44, -58, -41, -40, -41, -41, -102, -125, -39, -24,
-	154, -125, -57, 154, -57, -125, -39, -102, -39, 155,
-	-33, -30, -32, -29, -31, -126, -125, -127, 92, 148,
-	-58, -96, 91, 91, -125, -125, 154, -102, 155, -103,
-	-125, -73, -133, -133, -133, -133, -73, -73, -73, 155,
-	155, 155, 69, -61, -60, 154, 97, 68, 155, -58,
-	-58, 92, -110, -1, -58, 89, 84, -58, -1, -58,
-	-49, 51, 77, 158, -65, 47, 48, -61, -99, -57,
-	-125, -43, 158, 150, 52, 52, -136, 54, -136, -135,
-	-137, -101, -125, 155, -58, -125, -58, -44, -46, 45,
-	46, 155, 158, -26, 35, 36, 37, 38, -25, -24,
-	39, -99, 41, 41, 155, 26, 155, 158, 158, 39,
-	155, 158, 87, -2, 89, -119, 88, -2, -2, 91,
-	91, -39, 155, 155, -73, -73, -73, -59, -73, 155,
-	155, 155, -60, 155, 158, -58, 78, 128, 155, 85,
-	92, 89, -58, -97, -117, 88, -49, 131, -62, 132,
-	155, 158, -44, -107, -58, -90, -90, 52, 52, 52,
-	-136, 158, 155, 158, 158, -58, -100, -140, -102, -57,
-	-57, 155, 158, -58, 155, -125, -125, -58, 26, 125,
-	26, -29, -32, -32, -126, -58, 26, -33, -2, -120,
-	90, -58, 92, 92, -2, -2, 155, 26, 106, 155,
-	155, 155, 155, 155, 106, 106, 127, 106, 127, -61,
-	158, 44, 85, -1, -58, -66, 35, 36, 25, -39,
-	-99, -92, 59, 60, -90, -90, -90, 52, -125, -58,
-	-73, -125, -39, -26, -25, -39, -3, -14, -5, -18,
-	85, 84, -15, -16, 87, 126, 125, 125, 155, -112,
-	-111, 90, 86, 92, -2, 89, 87, 87, 92, 92,
-	154, 154, 106, 106, 106, 106, 106, 154, 154, 132,
-	154, 132, -58, 154, -109, 89, -61, -58, 154, -92,
-	59, -90, 155, 155, 155, 155, 158, 92, 148, -58,
-	-96, -58, -126, -127, -58, -3, -3, 26, 92, -112,
-	-2, -58, 84, -2, 87, 87, -39, -79, -78, -80,
-	105, 154, 154, 154, 154, 154, -78, -80, -79, 106,
-	-78, 106, 155, -47, -102, -58, -73, -3, 89, -121,
-	88, 91, 68, 68, 92, 92, 125, 85, 92, 89,
-	-119, 88, 155, 155, -47, 43, 46, -79, -79, -79,
-	-79, -78, 155, 155, 154, 155, 154, 155, 155, 155,
-	-3, -122, 90, -58, -4, -17, -5, -19, 85, 84,
-	-15, -16, -6, -125, -125, -3, 85, -2, -58, 46,
-	-100, 155, 155, 155, 155, 155, -79, -78, -114, -113,
-	90, 86, 92, -3, 89, 92, 148, -58, -96, 91,
-	91, 92, -111, 89, -62, 155, 155, 92, -114, -3,
-	-58, 84, -3, 87, -4, 89, -123, 88, -4, -4,
-	-81, 133, 85, 92, 89, -121, 88, -4, -124, 90,
-	-58, 92, 92, -82, 72, 79, 6, 82, 85, -3,
-	-58, -116, -115, 90, 86, 92, -4, 89, 87, 87,
-	-84, 79, -83, 6, 82, 80, 80, 83, -113, 89,
-	92, -116, -4, -58, 84, -4, 69, 80, 80, 81,
-	83, 85, 92, 89, -123, 88, -85, 79, -83, 85,
-	-4, -58, 81, -115, 89,
+	-1000, -1, -8, -6, -12, -59, -129, -130, -133, -24,
+	-21, -22, -39, -40, -47, -48, -49, -23, -54, -55,
+	-56, -57, -58, -78, 15, 94, 93, -9, -150, -11,
+	102, -71, 34, 37, 148, 104, -154, 110, 21, 22,
+	23, 108, 109, 107, 36, 119, 120, 35, 135, 149,
+	124, 125, 126, 127, 128, 129, 131, 136, 150, 159,
+	132, 133, 134, 137, 138, 139, 32, -77, -74, -92,
+	-89, -88, -95, -96, -120, -91, -93, -152, -157, -159,
+	-160, -53, 190, -81, 96, 4, 151, 152, 153, 154,
+	155, 156, 157, 158, 147, 160, 161, 162, 163, 164,
+	123, 85, 31, 5, 6, 7, -75, 10, -76, 185,
+	186, 171, 172, 170, -97, -80, 75, 79, 189, 11,
+	13, 14, 16, 105, 192, 9, 83, 173, 165, 182,
+	192, 194, 86, 156, 178, 177, 184, 82, 80, 79,
+	76, 81, -171, 186, 185, 183, 187, 188, 78, 77,
+	-78, 190, -154, -150, 94, 93, -121, -78, 195, 194,
+	190, -2, -8, -60, 26, 20, 24, -62, -61, 18,
+	-88, 190, 38, 164, 38, -156, -155, -152, -156, -150,
+	-151, -152, 105, 46, 140, 137, 131, -157, 12, -157,
+	-158, -157, -150, -150, -52, 111, 112, 39, 40, 113,
+	114, 164, -78, -78, 12, -150, -78, -78, -78, -150,
+	-78, -78, -78, 130, -150, -125, -78, -59, 158, -71,
+	-59, -150, -78, -150, -150, -78, -59, 190, 147, 147,
+	179, -78, -125, -59, -78, -152, -153, -10, 148, 104,
+	6, -73, -72, -169, 33, 194, -78, -78, 190, 190,
+	190, 177, 184, -164, -171, 79, -88, -78, -78, -150,
+	193, -125, 190, 190, -1, -78, -150, -150, 69, 160,
+	-78, -78, -78, -164, -78, 80, 76, 81, -80, 190,
+	-88, -78, 74, 73, -78, -78, -78, -78, -78, -78,
+	-78, 98, -125, -94, 190, -121, -142, -122, 97, -9,
+	-150, 6, -94, 84, -125, 103, 182, -67, 51, 27,
+	-109, -107, -150, 31, 19, -109, -63, 19, 70, 71,
+	72, -163, 17, 84, -150, -150, -107, 196, 179, 105,
+	137, 194, 46, 140, 141, -150, -151, -150, -151, -150,
+	-150, 184, 45, 184, 45, 45, 196, -150, -78, -78,
+	-150, 45, 19, 19, 196, 68, 68, -78, 19, 196,
+	-59, -78, 6, 162, 45, -59, 190, 190, -78, 191,
+	191, 191, 100, 76, 196, 76, -152, -153, 196, -150,
+	-150, 6, 191, -128, -119, -118, -79, -78, -98, 183,
+	-150, 172, 170, 173, 174, 175, 176, -163, -163, -80,
+	-80, 80, 76, 74, 73, 82, 170, 193, -163, -78,
+	193, 161, -75, -76, 77, -78, -80, -78, -80, -80,
+	-1, 191, 97, -143, 99, -123, 99, -78, 190, 191,
+	-94, -1, -2, -68, 57, 54, -108, -107, 21, 196,
+	194, -126, -115, -108, -110, -116, 30, 190, -88, 166,
+	167, 168, 38, 169, -150, 19, -64, 25, -126, -168,
+	73, -168, -168, -128, -163, 190, -170, 29, 67, 35,
+	36, 44, 37, 21, -156, -78, 106, -50, 42, 41,
+	-150, 190, 29, 190, 190, -78, -150, -78, -150, -150,
+	-78, -150, -78, -78, -158, 27, 115, 12, 12, -150,
+	-125, -125, -162, -161, -78, 68, -78, -125, 85, -78,
+	-78, 163, 191, 25, 25, -3, -13, -6, -14, 94,
+	93, -9, -150, -11, -7, 102, 121, 122, -150, -153,
+	-152, -150, 76, 76, -73, 29, 190, 191, 196, 29,
+	190, 190, 190, 190, 190, 190, 190, -94, -94, -79,
+	-80, -90, 190, -88, 165, -90, -90, -164, -94, 196,
+	-78, -78, 77, -135, -134, 99, 95, -78, 101, -1,
+	101, -78, 98, -94, 146, 191, 101, -70, 58, -78,
+	-83, -84, -85, -78, -98, 28, 190, -59, -150, 29,
+	-132, -131, -77, -150, -109, -150, -64, 66, -165, -167,
+	65, 69, 196, 61, 63, 64, -150, 29, -115, 190,
+	190, 190, 190, -150, 5, 156, 190, -126, -65, 52,
+	-78, -61, -60, -61, -61, -128, -33, -34, -30, -150,
+	-35, -28, -36, 47, 48, 49, -59, -107, -25, 190,
+	-150, -77, 190, -77, -77, -150, -59, 38, -51, 26,
+	20, 24, -31, -32, -150, -35, -59, 191, -46, -44,
+	-42, -45, 144, -41, -43, -152, -150, -153, -78, 196,
+	29, -162, -78, 85, -59, 45, -78, -78, 101, 182,
+	-78, -121, 195, -3, -150, -150, 100, 100, -150, -150,
+	190, -127, -150, -128, -150, -94, 84, -163, -163, -163,
+	-94, -94, -94, 191, 191, 191, 77, -82, -80, 190,
+	108, 76, 191, -78, -78, 101, -135, -1, -78, 98,
+	93, -78, -1, 191, 52, 146, 102, -78, -69, 59,
+	85, 196, -86, 55, 56, -82, -124, -77, -150, -63,
+	196, 184, 194, 60, 60, -166, 62, -166, -165, -167,
+	-126, -150, 191, -78, -78, -78, -151, -78, -150, -78,
+	-64, -66, 53, 54, 191, 196, 191, 196, 191, 196,
+	-38, -29, -37, -77, -74, -152, -157, 47, 48, 79,
+	49, 50, 190, -150, 190, 190, -27, 39, 40, 41,
+	42, -26, -25, 43, -150, -124, 45, 21, 45, 190,
+	67, 191, 196, 29, 191, 191, 196, -152, 196, 43,
+	191, 196, 27, -162, -150, -78, -78, 191, 191, 96,
+	-3, 98, -144, 97, -9, 103, -3, -3, 100, 100,
+	-59, 191, 196, 191, -94, -94, -94, -79, -94, 191,
+	191, 191, 146, -80, 191, 196, -78, 87, 146, 191,
+	94, 101, 98, -78, -122, -142, 97, 190, 52, -69,
+	151, -83, 152, 191, 196, -64, -132, -78, -150, -115,
+	-115, 60, 60, 60, -166, 196, 191, 196, 190, 191,
+	196, 85, 196, -78, -125, -170, -150, -35, -28, -170,
+	-150, -35, 190, -170, -150, -28, -38, 190, -150, 83,
+	-78, 47, 49, -127, -124, -77, -77, 191, 196, -78,
+	43, 191, -150, 157, -150, -78, -151, -107, 29, -31,
+	142, 29, 29, -41, -45, -44, -45, -152, -78, 29,
+	-46, -42, -152, 85, -3, -145, 99, -78, -3, 101,
+	101, -3, -3, 191, 29, -127, 118, 191, 191, 191,
+	191, 191, 118, 118, 145, 118, 145, 52, -82, 196,
+	52, 94, -1, -78, -67, 190, -87, 39, 40, 28,
+	-59, -124, -117, 67, 68, -115, -115, -115, 60, -150,
+	-78, -78, -94, -94, 190, -150, -59, -59, -31, -59,
+	-78, 47, 79, 49, 191, 190, 190, 191, 191, -27,
+	-26, -78, -150, 190, 29, -59, -4, -15, -6, -19,
+	94, 93, -16, -150, -17, 102, 96, 143, 142, 142,
+	191, 142, 191, 196, 190, -137, -136, 99, 95, 101,
+	-3, 98, 101, 96, 96, 101, 101, 190, 190, 118,
+	118, 118, 118, 118, 190, 190, 152, 190, 152, 190,
+	-78, 190, -134, 98, 191, -67, -82, -78, 190, -117,
+	67, -115, 191, 191, 154, 191, 196, 191, 191, 85,
+	-114, -113, -150, 191, 196, 85, 191, 191, 190, 83,
+	-78, -127, 68, -94, 142, 101, 182, -78, -121, 195,
+	-4, -78, -152, -153, -78, 38, 105, -4, -4, 29,
+	-4, 29, -33, -30, -150, 101, -137, -3, -78, 93,
+	-3, 102, 96, 96, -59, -100, -99, -101, 117, 190,
+	190, 190, 190, 190, -99, -101, -100, 118, -99, 118,
+	-67, 191, -67, 191, -127, -78, 190, -78, 191, 190,
+	191, 196, -78, -94, 190, -170, -78, 191, 191, -150,
+	191, -4, -4, 98, -146, 97, -16, 103, 100, 76,
+	76, -59, -150, 101, 101, 142, 101, 142, 196, 191,
+	191, 94, 101, 98, -144, 97, 191, 191, -67, 51,
+	54, -100, -100, -100, -100, -99, 191, 191, 190, 191,
+	190, 191, 191, 191, -112, -111, -150, 191, -114, 191,
+	-114, 191, 85, -114, -59, 191, 191, 101, -4, -147,
+	99, -78, -4, -5, -18, -6, -20, 94, 93, -16,
+	-150, -17, -7, 102, -150, -150, -4, -4, -150, 94,
+	-3, -78, 54, -125, 191, 191, 191, 191, 191, -100,
+	-99, 191, 196, 155, 191, 190, 191, -139, -138, 99,
+	95, 101, -4, 98, 101, 101, 182, -78, -121, 195,
+	-5, 100, 100, 101, 101, -136, 98, -83, 191, 191,
+	191, -112, -78, 191, -114, 191, 101, -139, -4, -78,
+	93, -4, 102, 96, -5, 98, -148, 97, -16, 103,
+	-5, -5, -102, 153, 191, 94, 101, 98, -146, 97,
+	-5, -149, 99, -78, -5, 101, 101, -103, 80, 88,
+	6, 91, 191, 94, -4, -78, -141, -140, 99, 95,
+	101, -5, 98, 101, 96, 96, -105, 88, -104, 6,
+	91, 89, 89, 92, -138, 98, 101, -141, -5, -78,
+	93, -5, 102, 77, 89, 89, 90, 92, 94, 101,
+	98, -148, 97, -106, 88, -104, 94, -5, -78, 90,
+	-140, 98,
 }
 var yyDef = [...]int{
 
-	-2, -2, 2, 27, 28, 10, 11, 12, 13, 14,
-	15, 16, 17, 18, 19, 20, 21, 22, 23, 24,
-	0, 347, 43, 44, 0, 0, 0, 0, 0, -2,
-	0, 0, 0, 0, 0, 124, 80, 81, 0, 0,
-	0, 0, 0, 0, 0, 0, 150, 0, 156, 0,
-	0, 205, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 217, 218, 219, 186, 0, 36, 433, 200,
-	0, 192, 193, 194, 195, 196, 197, 0, 0, 0,
-	0, 0, 283, 423, 0, 0, 0, 411, 419, 420,
-	0, 407, 408, 409, 410, 198, 199, 0, 0, -2,
-	0, 437, 438, 423, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, -2, 216, 0,
-	347, 0, 348, -2, 0, 0, 0, 169, 0, 421,
-	167, 186, 0, 0, 71, 417, 415, 72, 0, 74,
-	0, 0, 0, 0, 0, 79, 102, 103, 0, 125,
-	126, 127, 128, 0, 0, 0, 0, 140, 152, 141,
-	142, 143, -2, 147, 148, 151, 355, -2, 155, 157,
-	158, 0, 0, 0, 0, 0, 0, 215, 0, 0,
-	34, 35, 37, 187, 190, 0, 434, 0, 273, 0,
-	267, 268, 0, 421, 421, 437, 438, 0, 0, 424,
-	261, 271, 272, 0, 421, 0, 3, 239, -2, -2,
-	0, 0, 0, 0, 0, 252, 186, 223, -2, 0,
-	0, 262, 263, 264, 265, 266, 269, 270, -2, 0,
-	0, 273, 0, 393, 351, 0, 179, 0, 0, 0,
-	359, 314, 315, 0, 0, 171, 0, 431, 431, 431,
-	0, 422, 435, 0, 0, 0, 0, 0, 0, 0,
-	104, 109, 123, 0, 0, 0, 0, 0, 129, 130,
-	0, 0, 0, 0, 0, 0, 0, 159, 193, 414,
-	220, 222, 238, -2, 0, 0, 0, 0, 0, 433,
-	0, 201, 203, 0, 273, 274, 202, 204, 276, 0,
-	363, 343, 345, 341, 342, 221, 200, 0, 0, 0,
-	0, 0, 0, 273, 273, 244, 246, 0, 0, 0,
-	0, 423, 133, 273, 0, 247, 248, 0, 0, 253,
-	-2, 257, 259, 377, 278, 0, 0, -2, 0, 0,
-	0, 184, 0, 0, 186, 316, 0, 0, 171, -2,
-	326, 327, 330, 331, 186, 319, 0, 314, 0, 173,
-	0, 170, 0, 432, 0, 0, 168, 0, 186, 436,
-	0, 0, 0, 0, 418, 416, 186, 0, 186, 0,
-	0, 75, -2, 77, -2, -2, 135, -2, 137, 0,
-	138, 139, 153, 144, 145, 149, 356, 160, 0, 0,
-	38, 39, 0, 347, 48, 49, 50, 25, 26, 0,
-	413, 412, 0, 0, 0, 191, 0, 0, 275, 0,
-	277, 0, 0, 273, 421, 421, 421, 273, 273, 273,
-	0, 0, 0, 0, 254, 186, 241, 0, 258, 260,
-	0, 0, 0, 249, 0, 0, 377, -2, 0, 0,
-	0, 394, 346, 352, -2, 161, 0, 182, 178, 227,
-	233, 231, 232, 0, 0, 367, 317, 0, 169, 371,
-	0, 200, 360, 373, 0, 0, 427, 427, 425, 0,
-	426, 429, 430, 328, 0, 425, 0, 0, 171, 175,
-	0, 172, 163, 166, 164, 165, 0, 361, 84, 96,
-	0, 92, 87, 0, 0, 0, 101, 0, 108, 0,
-	0, 116, 117, 111, 114, 110, 0, 105, 0, -2,
-	0, 0, -2, -2, 0, 0, 186, 0, 279, 364,
-	344, 0, 273, 273, 273, 273, 0, 0, 0, 280,
-	281, 282, 0, 0, 225, 0, 131, 0, 284, 0,
-	250, 0, 0, 378, 0, 0, 42, 23, 391, 185,
-	180, 182, 0, 0, 229, 234, 235, 365, 0, 353,
-	318, 171, 0, 0, 0, 0, 0, 428, 0, 0,
-	427, 358, 329, 332, 0, 200, 0, 374, 162, 0,
-	0, -2, 0, 85, 97, 98, 0, 0, 0, 94,
-	0, 0, 0, 0, 106, 0, 0, 0, 0, 0,
-	0, 0, 29, 5, -2, 397, 0, 0, 0, -2,
-	-2, 0, 0, 275, 0, 0, 0, 0, 0, 0,
-	0, 0, 251, 240, 0, 0, 132, 0, 224, 40,
-	0, -2, 349, 350, 392, 0, 181, 183, 228, 0,
-	186, 0, 369, 372, 370, 333, 425, 0, 0, 0,
-	0, 0, 322, 273, 0, 176, 174, 186, 362, 99,
-	100, 96, 0, 93, 88, 89, -2, 91, 186, -2,
-	0, 112, 118, 115, 0, 113, 0, 0, 381, 0,
-	-2, 0, 0, 0, 0, 0, 188, 0, 0, 279,
-	280, 281, 282, 284, 0, 0, 0, 0, 0, 226,
-	0, 0, 41, 375, 0, 230, 236, 237, 0, 368,
-	354, 334, 0, 0, 425, 425, 337, 0, 200, 0,
-	0, 0, 83, 86, 95, 107, 0, 0, 51, 52,
-	0, 347, 63, 64, 0, 56, -2, -2, 0, 0,
-	381, -2, 0, 0, 398, -2, 30, 31, 0, 0,
-	186, 300, 0, 0, 0, 0, 0, 300, 300, 0,
-	300, 0, 0, 177, 376, -2, 366, 339, 0, 335,
-	0, 338, 320, 321, 323, 324, 273, 119, -2, 0,
-	0, 0, 215, 0, 57, 0, 0, 0, 0, 0,
-	382, 0, 47, 395, 32, 33, 0, 0, 298, 177,
-	0, 300, 300, 300, 300, 300, 0, 177, 0, 0,
-	0, 0, 242, 0, 0, 336, 0, 7, -2, 401,
-	0, -2, 0, 0, 120, 121, -2, 45, 0, -2,
-	396, 0, 189, 286, 297, 0, 0, 0, 0, 0,
-	0, 0, 292, 293, 300, 295, 300, 285, 340, 325,
-	385, 0, -2, 0, 0, 0, 58, 59, 0, 347,
-	68, 69, 70, 0, 0, 0, 46, 379, 0, 0,
-	301, 287, 288, 289, 290, 291, 0, 0, 0, 385,
-	-2, 0, 0, 402, -2, 0, -2, 0, 0, -2,
-	-2, 122, 380, -2, 178, 294, 296, 0, 0, 386,
-	0, 62, 399, 53, 9, -2, 405, 0, 0, 0,
-	299, 0, 60, 0, -2, 400, 0, 389, 0, -2,
-	0, 0, 0, 302, 0, 0, 0, 0, 61, 383,
-	0, 0, 389, -2, 0, 0, 406, -2, 54, 55,
-	0, 0, 311, 0, 0, 304, 305, 306, 384, -2,
-	0, 0, 390, 0, 67, 403, 0, 310, 307, 308,
-	309, 65, 0, -2, 404, 0, 303, 0, 313, 66,
-	387, 0, 312, 388, -2,
+	-2, -2, 2, 36, 37, 12, 13, 14, 15, 16,
+	17, 18, 19, 20, 21, 22, 23, 24, 25, 26,
+	27, 28, 29, 30, 31, 0, 470, 52, 295, 54,
+	-2, 0, 0, 0, 0, 0, -2, 0, 0, 0,
+	0, 0, 0, 202, 0, 101, 102, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 233, 281, 281,
+	0, 242, 0, 0, 0, 281, 0, 300, 301, 302,
+	303, 304, 305, 306, 309, 310, 311, 312, 314, 315,
+	316, 317, 281, 319, 0, 538, 539, 540, 541, 542,
+	543, 544, 545, 546, 547, 548, 549, 550, 551, 552,
+	45, 584, 0, 287, 288, 289, 290, 291, 292, 0,
+	0, 0, 0, 0, 393, 574, 0, 0, 0, 560,
+	568, 571, 553, 0, 0, 293, 294, 0, 0, -2,
+	0, 0, 0, 0, 0, 588, 589, 574, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, -2, 313, 295, 0, 470, 0, 471, 0, 0,
+	379, 0, 0, -2, 0, 0, 0, 264, 0, 572,
+	261, 281, 0, 0, 0, 90, 566, 564, 91, 558,
+	0, 93, 0, 0, 0, 0, 0, 0, 0, 98,
+	99, 569, 166, 167, 0, 203, 204, 205, 206, 0,
+	0, 0, 0, 0, 218, 235, 219, 220, 221, -2,
+	225, 226, 227, 0, 0, 234, 478, 237, 281, 0,
+	239, -2, 241, 243, 244, 249, 0, 281, 0, 0,
+	0, 0, 0, 0, 0, 312, 0, 0, 43, 44,
+	46, 282, 285, 0, 585, 0, 373, 374, 0, 572,
+	572, 588, 589, 0, 0, 575, 367, 377, 378, 0,
+	325, 0, 572, 0, 3, 0, 321, 322, 323, 0,
+	345, -2, -2, 0, 0, 0, 0, 0, 358, 281,
+	329, -2, 0, 0, 368, 369, 370, 371, 372, 375,
+	376, -2, 0, 0, 379, 0, 524, 474, 0, 53,
+	296, 298, 0, 379, 380, -2, -2, 274, 0, 0,
+	0, 482, 424, 426, 0, 0, 266, 0, 582, 582,
+	582, 0, 572, 573, 586, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 168, 174, 558, 191, 193,
+	232, 0, 0, 0, 0, 0, 0, 0, 207, 208,
+	196, 0, 0, 0, 0, 0, 0, 229, 0, 0,
+	238, 245, 288, 0, 0, 0, 0, 0, 563, 318,
+	328, 344, -2, 0, 0, 0, 0, 0, 584, 0,
+	297, 299, 384, 0, 494, 466, 468, 464, 465, 327,
+	295, 0, 0, 0, 0, 0, 0, 379, 379, 350,
+	352, 0, 0, 0, 0, 574, 211, 326, 379, 0,
+	320, 0, 353, 354, 0, 0, 359, -2, 363, 365,
+	508, 386, 0, 0, -2, 0, 0, 0, 379, 381,
+	0, 0, 5, 279, 0, 0, 281, 427, 0, 0,
+	0, 266, -2, 449, 450, 453, 454, 281, 430, 0,
+	0, 0, 0, 0, 424, 0, 268, 0, 265, 0,
+	583, 0, 0, 262, 0, 0, 281, 587, 0, 0,
+	0, 0, 0, 0, 567, 565, 281, 0, 197, 198,
+	559, 0, 281, 0, 0, 94, -2, 96, -2, -2,
+	213, -2, 215, 100, 570, 0, 0, 216, 217, 236,
+	222, 223, 228, 556, 554, 0, 231, 479, 0, 246,
+	250, 281, 0, 0, 0, 0, 0, 47, 48, 0,
+	470, 59, 295, 61, 62, -2, 32, 34, 0, 562,
+	561, 0, 0, 0, 286, 0, 0, 385, 0, 0,
+	379, 572, 572, 572, 379, 379, 379, 0, 0, 0,
+	0, 360, 281, 347, 0, 364, 366, 0, 0, 0,
+	324, 355, 0, 0, 508, -2, 0, 0, 0, 525,
+	469, 475, -2, 0, 0, 387, 0, 255, 0, 277,
+	273, 333, 339, 337, 338, 0, 0, 498, 428, 0,
+	264, 502, 0, 295, 483, 425, 504, 0, 0, 578,
+	578, 576, 0, 577, 580, 581, 451, 0, 576, 0,
+	0, 0, 0, 438, 439, 0, 0, 266, 270, 0,
+	267, 257, 260, 258, 259, 263, 0, 0, 0, 142,
+	146, 155, 145, 0, 0, 0, 107, 0, 159, 0,
+	119, 113, 0, 0, 0, 0, 164, 0, 0, 199,
+	200, 201, 0, 140, 138, 139, 173, 0, 0, 0,
+	181, 182, 0, 176, 179, 175, 0, 169, 0, 0,
+	0, 230, 247, 0, 251, 0, 0, 0, 0, -2,
+	0, 0, 0, 0, 33, 35, -2, -2, 0, 0,
+	281, 0, 492, 495, 467, 0, 379, 379, 379, 379,
+	0, 0, 0, 389, 391, 392, 0, 0, 331, 0,
+	209, 0, 394, 0, 356, 0, 0, 509, 0, 0,
+	51, 30, 522, 382, 0, 0, 55, 280, 275, 277,
+	0, 0, 335, 340, 341, 496, 0, 476, 429, 266,
+	0, 0, 0, 0, 0, 0, 579, 0, 0, 578,
+	481, 452, 455, 0, 0, 0, 0, 440, 295, 0,
+	505, 256, 0, 0, -2, 0, -2, 0, 586, 0,
+	144, 150, 136, 151, 152, 153, 154, 0, 0, 0,
+	133, 135, 0, 0, 0, 0, 111, 160, 161, 0,
+	0, 0, 123, 0, 121, 0, 0, 0, 0, 0,
+	0, 171, 0, 0, 0, 0, 0, 184, 0, 0,
+	0, 0, 0, 557, 555, 248, 252, 307, 308, 38,
+	7, -2, 528, 0, 60, -2, 0, 0, -2, -2,
+	0, 0, 0, 381, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 357, 346, 0, 0, 210, 0, 330,
+	49, 0, -2, 472, 473, 523, 0, 272, 0, 276,
+	278, 334, 0, 281, 0, 500, 503, 501, 296, 456,
+	576, 0, 0, 0, 0, 0, 433, 0, 379, 441,
+	379, 0, 0, 271, 269, 281, 143, 147, 156, 281,
+	148, 149, 0, 281, 157, 158, 137, 0, 0, 131,
+	0, 0, 0, 0, 0, 162, 163, 159, 0, 120,
+	0, 114, 115, 0, -2, 118, 0, 0, 281, 141,
+	-2, 0, 0, 177, 183, 0, 180, 0, 178, 0,
+	0, 181, 170, 0, 512, 0, -2, 0, 0, 0,
+	0, 0, 0, 283, 0, 493, 0, 387, 389, 391,
+	392, 394, 0, 0, 0, 0, 0, 0, 332, 0,
+	0, 50, 506, 0, 0, 272, 336, 342, 343, 0,
+	499, 477, 457, 0, 0, 576, 576, 460, 0, 295,
+	0, 0, 0, 0, 0, 0, 105, 106, 0, 110,
+	0, 0, 0, 134, 125, 0, 0, 127, 194, 112,
+	124, 122, 116, 379, 0, 172, 0, 0, 64, 65,
+	0, 470, 78, 295, 80, -2, 0, 69, -2, -2,
+	0, -2, 0, 0, 0, 0, 512, -2, 0, 0,
+	529, -2, 0, 39, 40, 0, 0, 281, 410, 0,
+	0, 0, 0, 0, 410, 410, 0, 410, 0, 272,
+	0, 272, 507, -2, 383, 0, 497, 462, 0, 458,
+	0, 461, 431, 432, 0, 434, 0, 0, 442, 0,
+	0, 490, 488, 445, 379, 0, -2, 129, 0, 132,
+	0, 0, 0, 0, -2, 185, -2, 0, 0, 0,
+	0, 0, 312, 0, 70, 281, 0, 0, 0, 0,
+	0, 0, 0, 0, 142, 0, 0, 513, 0, 58,
+	526, 63, 41, 42, 0, 0, 408, 272, 0, 410,
+	410, 410, 410, 410, 0, 272, 0, 0, 0, 0,
+	0, 348, 0, 388, 0, 459, 0, 0, 437, 0,
+	0, 0, 489, 0, 0, 281, 0, 126, 128, 195,
+	0, 0, 9, -2, 532, 0, 79, -2, -2, 0,
+	0, 71, 72, 186, 187, -2, 189, -2, 0, 253,
+	254, 56, 0, -2, 527, 0, 284, 396, 407, 0,
+	0, 0, 0, 0, 0, 0, 402, 403, 410, 405,
+	410, 390, 395, 463, 0, 486, 484, 435, 0, 444,
+	491, 446, 0, 0, 109, 130, 165, 192, 516, 0,
+	-2, 0, 0, 0, 0, 73, 74, 0, 470, 85,
+	295, 87, 88, -2, 0, 0, 0, 0, 143, 57,
+	510, 0, 0, 411, 397, 398, 399, 400, 401, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 516, -2,
+	0, 0, 533, -2, 0, 0, -2, 0, 0, 0,
+	0, -2, -2, 188, 190, 511, -2, 273, 404, 406,
+	436, 487, 485, 443, 0, 447, 0, 0, 517, 0,
+	77, 530, 81, 66, 11, -2, 536, 0, 86, -2,
+	0, 0, 409, 0, 0, 75, 0, -2, 531, 0,
+	520, 0, -2, 0, 0, 0, 0, 412, 0, 0,
+	0, 0, 448, 76, 514, 0, 0, 520, -2, 0,
+	0, 537, -2, 0, 67, 68, 0, 0, 421, 0,
+	0, 414, 415, 416, 515, -2, 0, 0, 521, 0,
+	84, 534, 89, 0, 420, 417, 418, 419, 82, 0,
+	-2, 535, 0, 413, 0, 423, 83, 518, 0, 422,
+	519, -2,
 }
 var yyTok1 = [...]int{
 
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 153, 3, 3, 3, 157, 3, 3,
-	154, 155, 149, 152, 158, 151, 159, 156, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 148,
-	3, 150,
+	3, 3, 3, 189, 3, 3, 3, 188, 3, 3,
+	190, 191, 183, 186, 196, 185, 194, 187, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 195, 182,
+	3, 184, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 192, 3, 193,
 }
 var yyTok2 = [...]int{
 
@@ -1473,7 +2036,10 @@ var yyTok2 = [...]int{
 	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
-	142, 143, 144, 145, 146, 147,
+	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
 }
 var yyTok3 = [...]int{
 	0,
@@ -1818,970 +2384,1541 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:226
+		//line parser.y:263
 		{
 			yyVAL.program = nil
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:231
+		//line parser.y:268
 		{
 			yyVAL.program = []Statement{yyDollar[1].statement}
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:236
+		//line parser.y:273
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 4:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:243
+		//line parser.y:280
 		{
 			yyVAL.program = nil
 		}
 	case 5:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:247
+		//line parser.y:284
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 6:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:253
+		//line parser.y:290
 		{
 			yyVAL.program = nil
 		}
 	case 7:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:257
+		//line parser.y:294
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 8:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:263
+		//line parser.y:300
 		{
 			yyVAL.program = nil
 		}
 	case 9:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:267
+		//line parser.y:304
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 10:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:273
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line parser.y:310
 		{
-			yyVAL.statement = yyDollar[1].queryexpr
+			yyVAL.program = nil
 		}
 	case 11:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:277
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:314
 		{
-			yyVAL.statement = yyDollar[1].expression
+			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:281
+		//line parser.y:320
 		{
-			yyVAL.statement = yyDollar[1].expression
+			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:285
+		//line parser.y:324
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:289
+		//line parser.y:328
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = yyDollar[1].expression
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:293
+		//line parser.y:332
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = yyDollar[1].expression
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:297
+		//line parser.y:336
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:301
+		//line parser.y:340
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:305
+		//line parser.y:344
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:309
+		//line parser.y:348
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:313
+		//line parser.y:352
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:317
+		//line parser.y:356
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:321
+		//line parser.y:360
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:325
+		//line parser.y:364
 		{
-			yyVAL.statement = yyDollar[1].queryexpr
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:329
+		//line parser.y:368
 		{
-			yyVAL.statement = ExternalCommand{BaseExpr: NewBaseExpr(yyDollar[1].token), Command: yyDollar[1].token.Literal}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:335
+		//line parser.y:372
 		{
-			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:339
+		//line parser.y:376
 		{
-			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:345
+		//line parser.y:380
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:349
+		//line parser.y:384
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 29:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:388
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 30:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:392
+		{
+			yyVAL.statement = yyDollar[1].queryexpr
+		}
+	case 31:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:396
+		{
+			yyVAL.statement = ExternalCommand{BaseExpr: NewBaseExpr(yyDollar[1].token), Command: yyDollar[1].token.Literal}
+		}
+	case 32:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:402
+		{
+			yyVAL.statement = FlowControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
+		}
+	case 33:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:406
+		{
+			yyVAL.statement = FlowControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token, Label: yyDollar[2].identifier}
+		}
+	case 34:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:410
+		{
+			yyVAL.statement = FlowControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
+		}
+	case 35:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:414
+		{
+			yyVAL.statement = FlowControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token, Label: yyDollar[2].identifier}
+		}
+	case 36:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:420
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 37:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:424
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 38:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:355
+		//line parser.y:430
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
-	case 30:
+	case 39:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:359
+		//line parser.y:434
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 31:
+	case 40:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:363
+		//line parser.y:438
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 32:
+	case 41:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:367
+		//line parser.y:442
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: []Variable{yyDollar[3].variable}, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 33:
+	case 42:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:371
+		//line parser.y:446
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: yyDollar[3].variables, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 34:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:377
+		//line parser.y:452
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 35:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:381
+		//line parser.y:456
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 36:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:387
+		//line parser.y:462
 		{
-			yyVAL.statement = Exit{}
+			yyVAL.statement = Exit{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 37:
+	case 46:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:391
+		//line parser.y:466
 		{
-			yyVAL.statement = Exit{Code: value.NewIntegerFromString(yyDollar[2].token.Literal)}
+			yyVAL.statement = Exit{BaseExpr: NewBaseExpr(yyDollar[1].token), Code: value.NewIntegerFromString(yyDollar[2].token.Literal)}
 		}
-	case 38:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:397
+		//line parser.y:472
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 39:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:401
+		//line parser.y:476
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 40:
+	case 49:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:407
+		//line parser.y:482
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 41:
+	case 50:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:411
+		//line parser.y:486
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 42:
+	case 51:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:415
+		//line parser.y:490
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 43:
+	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:419
+		//line parser.y:494
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 44:
+	case 53:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:498
+		{
+			yyVAL.statement = SetLoopLabel(yyDollar[3].statement, yyDollar[1].identifier)
+		}
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:423
+		//line parser.y:502
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 45:
+	case 55:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:506
+		{
+			yyVAL.statement = Try{Statements: yyDollar[2].program, CatchStatements: yyDollar[4].program}
+		}
+	case 56:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:429
+		//line parser.y:512
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 46:
+	case 57:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:433
+		//line parser.y:516
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 47:
+	case 58:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:437
+		//line parser.y:520
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 48:
+	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:441
+		//line parser.y:524
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 49:
+	case 60:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:528
+		{
+			yyVAL.statement = SetLoopLabel(yyDollar[3].statement, yyDollar[1].identifier)
+		}
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:445
+		//line parser.y:532
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 50:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:449
+		//line parser.y:536
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 51:
+	case 63:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:540
+		{
+			yyVAL.statement = Try{Statements: yyDollar[2].program, CatchStatements: yyDollar[4].program}
+		}
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:455
+		//line parser.y:546
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 52:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:459
+		//line parser.y:550
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 53:
+	case 66:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:465
+		//line parser.y:556
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
-	case 54:
+	case 67:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:469
+		//line parser.y:560
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 55:
+	case 68:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:473
+		//line parser.y:564
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 56:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:479
+		//line parser.y:570
 		{
-			yyVAL.statement = Return{Value: NewNullValue()}
+			yyVAL.statement = Return{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: NewNullValue()}
 		}
-	case 57:
+	case 70:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:483
+		//line parser.y:574
 		{
-			yyVAL.statement = Return{Value: yyDollar[2].queryexpr}
+			yyVAL.statement = Return{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: yyDollar[2].queryexpr}
 		}
-	case 58:
+	case 71:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:578
+		{
+			yyVAL.statement = ReturnTable{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[3].queryexpr.(SelectQuery)}
+		}
+	case 72:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:582
+		{
+			yyVAL.statement = ReturnCursor{BaseExpr: NewBaseExpr(yyDollar[1].token), Cursor: yyDollar[3].identifier}
+		}
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:489
+		//line parser.y:588
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 59:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:493
+		//line parser.y:592
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 60:
+	case 75:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:499
+		//line parser.y:598
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 61:
+	case 76:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:503
+		//line parser.y:602
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 62:
+	case 77:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:507
+		//line parser.y:606
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 63:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:511
+		//line parser.y:610
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 64:
+	case 79:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:614
+		{
+			yyVAL.statement = SetLoopLabel(yyDollar[3].statement, yyDollar[1].identifier)
+		}
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:515
+		//line parser.y:618
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 65:
+	case 81:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:622
+		{
+			yyVAL.statement = Try{Statements: yyDollar[2].program, CatchStatements: yyDollar[4].program}
+		}
+	case 82:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:521
+		//line parser.y:628
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 66:
+	case 83:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:525
+		//line parser.y:632
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 67:
+	case 84:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:529
+		//line parser.y:636
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 68:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:533
+		//line parser.y:640
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 69:
+	case 86:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:644
+		{
+			yyVAL.statement = SetLoopLabel(yyDollar[3].statement, yyDollar[1].identifier)
+		}
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:537
+		//line parser.y:648
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 70:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:541
+		//line parser.y:652
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 71:
+	case 89:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:656
+		{
+			yyVAL.statement = Try{Statements: yyDollar[2].program, CatchStatements: yyDollar[4].program}
+		}
+	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:547
+		//line parser.y:662
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
-	case 72:
+	case 91:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:551
+		//line parser.y:666
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
-	case 73:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:555
+		//line parser.y:670
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
-	case 74:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:559
+		//line parser.y:674
 		{
 			yyVAL.statement = DisposeVariable{Variable: yyDollar[2].variable}
 		}
-	case 75:
+	case 94:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:565
+		//line parser.y:680
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
-	case 76:
+	case 95:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:569
+		//line parser.y:684
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
-	case 77:
+	case 96:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:573
+		//line parser.y:688
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
-	case 78:
+	case 97:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:577
+		//line parser.y:692
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
-	case 79:
+	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:581
+		//line parser.y:696
 		{
 			yyVAL.statement = UnsetEnvVar{EnvVar: yyDollar[2].envvar}
 		}
-	case 80:
+	case 99:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:700
+		{
+			yyVAL.statement = Export{BaseExpr: NewBaseExpr(yyDollar[1].token), EnvVars: yyDollar[2].envvars}
+		}
+	case 100:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:704
+		{
+			yyVAL.statement = Export{BaseExpr: NewBaseExpr(yyDollar[1].token), EnvVars: yyDollar[2].envvars, FilePath: yyDollar[4].queryexpr}
+		}
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:587
+		//line parser.y:710
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
-	case 81:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:591
+		//line parser.y:714
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
-	case 82:
+	case 103:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:597
+		//line parser.y:720
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs}
 		}
-	case 83:
+	case 104:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:724
+		{
+			fields, constraints := splitTableElements(yyDollar[5].queryexprs)
+			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: fields, Constraints: constraints}
+		}
+	case 105:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:601
+		//line parser.y:729
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
 		}
-	case 84:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:605
-		{
+	case 106:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		//line parser.y:733
+		{
+			fields, constraints := splitTableElements(yyDollar[5].queryexprs)
+			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: fields, Constraints: constraints, Query: yyDollar[8].queryexpr}
+		}
+	case 107:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:738
+		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Query: yyDollar[5].queryexpr}
 		}
-	case 85:
+	case 108:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		//line parser.y:742
+		{
+			fields, constraints := splitTableElements(yyDollar[8].queryexprs)
+			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Attributes: yyDollar[5].tableattrs, Fields: fields, Constraints: constraints}
+		}
+	case 109:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		//line parser.y:747
+		{
+			fields, constraints := splitTableElements(yyDollar[8].queryexprs)
+			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Attributes: yyDollar[5].tableattrs, Fields: fields, Constraints: constraints, Query: yyDollar[11].queryexpr}
+		}
+	case 110:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		//line parser.y:752
+		{
+			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Attributes: yyDollar[5].tableattrs, Query: yyDollar[8].queryexpr}
+		}
+	case 111:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:609
+		//line parser.y:756
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: []ColumnDefault{yyDollar[5].columndef}, Position: yyDollar[6].expression}
 		}
-	case 86:
+	case 112:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:613
+		//line parser.y:760
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].columndefs, Position: yyDollar[8].expression}
 		}
-	case 87:
+	case 113:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:617
+		//line parser.y:764
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: []QueryExpression{yyDollar[5].queryexpr}}
 		}
-	case 88:
+	case 114:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:621
+		//line parser.y:768
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].queryexprs}
 		}
-	case 89:
+	case 115:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:625
+		//line parser.y:772
 		{
 			yyVAL.statement = RenameColumn{Table: yyDollar[3].queryexpr, Old: yyDollar[5].queryexpr, New: yyDollar[7].identifier}
 		}
-	case 90:
+	case 116:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		//line parser.y:776
+		{
+			yyVAL.statement = SetColumnType{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Column: yyDollar[5].queryexpr, Type: yyDollar[8].identifier}
+		}
+	case 117:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:629
+		//line parser.y:780
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].identifier}
 		}
-	case 91:
+	case 118:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:633
+		//line parser.y:784
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].queryexpr}
 		}
-	case 92:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:639
+		//line parser.y:790
 		{
 			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier}
 		}
-	case 93:
+	case 120:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:643
+		//line parser.y:794
 		{
 			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, Value: yyDollar[3].queryexpr}
 		}
-	case 94:
+	case 121:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:798
+		{
+			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, Type: yyDollar[2].identifier}
+		}
+	case 122:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:802
+		{
+			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, Type: yyDollar[2].identifier, Value: yyDollar[4].queryexpr}
+		}
+	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:649
+		//line parser.y:808
 		{
 			yyVAL.columndefs = []ColumnDefault{yyDollar[1].columndef}
 		}
-	case 95:
+	case 124:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:653
+		//line parser.y:812
 		{
 			yyVAL.columndefs = append([]ColumnDefault{yyDollar[1].columndef}, yyDollar[3].columndefs...)
 		}
-	case 96:
+	case 125:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:818
+		{
+			yyVAL.queryexpr = CheckConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[3].queryexpr}
+		}
+	case 126:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:822
+		{
+			yyVAL.queryexpr = CheckConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier, Condition: yyDollar[5].queryexpr}
+		}
+	case 127:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:826
+		{
+			yyVAL.queryexpr = UniqueConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Columns: yyDollar[3].queryexprs}
+		}
+	case 128:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:830
+		{
+			yyVAL.queryexpr = UniqueConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier, Columns: yyDollar[5].queryexprs}
+		}
+	case 129:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:836
+		{
+			yyVAL.queryexpr = CheckConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[3].queryexpr}
+		}
+	case 130:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:840
+		{
+			yyVAL.queryexpr = CheckConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier, Condition: yyDollar[5].queryexpr}
+		}
+	case 131:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:844
+		{
+			yyVAL.queryexpr = NotNullConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token)}
+		}
+	case 132:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:848
+		{
+			yyVAL.queryexpr = NotNullConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier}
+		}
+	case 133:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:852
+		{
+			yyVAL.queryexpr = UniqueConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token)}
+		}
+	case 134:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:856
+		{
+			yyVAL.queryexpr = UniqueConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier}
+		}
+	case 135:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:860
+		{
+			yyVAL.queryexpr = AutoIncrement{BaseExpr: NewBaseExpr(yyDollar[1].token)}
+		}
+	case 136:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:866
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 137:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:870
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[2].queryexprs...)
+		}
+	case 138:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:876
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].identifier}
+		}
+	case 139:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:880
+		{
+			yyVAL.queryexprs = yyDollar[1].queryexprs
+		}
+	case 140:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:886
+		{
+			yyVAL.queryexprs = yyDollar[1].queryexprs
+		}
+	case 141:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:890
+		{
+			yyVAL.queryexprs = append(yyDollar[1].queryexprs, yyDollar[3].queryexprs...)
+		}
+	case 142:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:896
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].identifier}
+		}
+	case 143:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:900
+		{
+			yyVAL.queryexprs = append(yyDollar[1].queryexprs, yyDollar[3].identifier)
+		}
+	case 144:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:906
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].identifier}, setConstraintColumn(yyDollar[2].queryexprs, yyDollar[1].identifier)...)
+		}
+	case 145:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:910
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 146:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:916
+		{
+			yyVAL.queryexprs = yyDollar[1].queryexprs
+		}
+	case 147:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:920
+		{
+			yyVAL.queryexprs = append(yyDollar[1].queryexprs, yyDollar[3].queryexprs...)
+		}
+	case 148:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:924
+		{
+			yyVAL.queryexprs = append(yyDollar[1].queryexprs, yyDollar[3].identifier)
+		}
+	case 149:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:928
+		{
+			yyVAL.queryexprs = append(yyDollar[1].queryexprs, yyDollar[3].queryexprs...)
+		}
+	case 150:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:934
+		{
+			yyVAL.tableattr = TableAttribute{BaseExpr: yyDollar[1].identifier.BaseExpr, Attribute: yyDollar[1].identifier, Value: yyDollar[2].queryexpr}
+		}
+	case 151:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:940
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 152:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:944
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 153:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:948
+		{
+			yyVAL.queryexpr = yyDollar[1].variable
+		}
+	case 154:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:952
+		{
+			yyVAL.queryexpr = yyDollar[1].envvar
+		}
+	case 155:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:958
+		{
+			yyVAL.tableattrs = []TableAttribute{yyDollar[1].tableattr}
+		}
+	case 156:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:962
+		{
+			yyVAL.tableattrs = append(booleanTableAttributes(yyDollar[1].queryexprs), yyDollar[3].tableattr)
+		}
+	case 157:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:966
+		{
+			yyVAL.tableattrs = append(yyDollar[1].tableattrs, TableAttribute{BaseExpr: yyDollar[3].identifier.BaseExpr, Attribute: yyDollar[3].identifier})
+		}
+	case 158:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:970
+		{
+			yyVAL.tableattrs = append(yyDollar[1].tableattrs, yyDollar[3].tableattr)
+		}
+	case 159:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:659
+		//line parser.y:976
 		{
 			yyVAL.expression = nil
 		}
-	case 97:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:663
+		//line parser.y:980
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
 		}
-	case 98:
+	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:667
+		//line parser.y:984
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
 		}
-	case 99:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:671
+		//line parser.y:988
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
 		}
-	case 100:
+	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:675
+		//line parser.y:992
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
 		}
-	case 101:
+	case 164:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:681
+		//line parser.y:998
 		{
 			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 102:
+	case 165:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		//line parser.y:1002
+		{
+			fn := TableFunction{BaseExpr: NewBaseExpr(yyDollar[5].token), Table: yyDollar[5].token.Literal, Function: Function{BaseExpr: yyDollar[7].identifier.BaseExpr, Name: yyDollar[7].identifier.Literal, Args: yyDollar[9].queryexprs}}
+			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Query: NewSelectAllQuery(fn)}
+		}
+	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:685
+		//line parser.y:1007
 		{
 			yyVAL.statement = OpenCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 103:
+	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:689
+		//line parser.y:1011
 		{
 			yyVAL.statement = CloseCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 104:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:693
+		//line parser.y:1015
 		{
 			yyVAL.statement = DisposeCursor{Cursor: yyDollar[3].identifier}
 		}
-	case 105:
+	case 169:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:697
+		//line parser.y:1019
 		{
 			yyVAL.statement = FetchCursor{Position: yyDollar[2].fetchpos, Cursor: yyDollar[3].identifier, Variables: yyDollar[5].variables}
 		}
-	case 106:
+	case 170:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		//line parser.y:1023
+		{
+			yyVAL.statement = FetchCursor{Position: yyDollar[2].fetchpos, Cursor: yyDollar[3].identifier, Bulk: yyDollar[5].queryexpr, Variables: []Variable{yyDollar[7].variable}}
+		}
+	case 171:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:703
+		//line parser.y:1029
 		{
-			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs}
+			fields, constraints := splitTableElements(yyDollar[5].queryexprs)
+			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: fields, Constraints: constraints}
 		}
-	case 107:
+	case 172:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:707
+		//line parser.y:1034
 		{
-			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
+			fields, constraints := splitTableElements(yyDollar[5].queryexprs)
+			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: fields, Constraints: constraints, Query: yyDollar[8].queryexpr}
 		}
-	case 108:
+	case 173:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:711
+		//line parser.y:1039
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Query: yyDollar[5].queryexpr}
 		}
-	case 109:
+	case 174:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:715
+		//line parser.y:1043
 		{
 			yyVAL.statement = DisposeView{View: yyDollar[3].identifier}
 		}
-	case 110:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:721
+		//line parser.y:1049
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 111:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:727
+		//line parser.y:1055
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 112:
+	case 177:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:731
+		//line parser.y:1059
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassign)
 		}
-	case 113:
+	case 178:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:737
+		//line parser.y:1065
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 114:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:743
+		//line parser.y:1071
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 115:
+	case 180:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:747
+		//line parser.y:1075
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 116:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:753
+		//line parser.y:1081
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 117:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:757
+		//line parser.y:1085
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 118:
+	case 183:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:761
+		//line parser.y:1089
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassigns...)
 		}
-	case 119:
+	case 184:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:1095
+		{
+			yyVAL.varassign = VariableAssignment{Variable: yyDollar[2].variable}
+		}
+	case 185:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:767
+		//line parser.y:1101
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Statements: yyDollar[8].program}
 		}
-	case 120:
+	case 186:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:771
+		//line parser.y:1105
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Parameters: yyDollar[5].varassigns, Statements: yyDollar[9].program}
 		}
-	case 121:
+	case 187:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:1109
+		{
+			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Parameters: []VariableAssignment{yyDollar[5].varassign}, Variadic: true, Statements: yyDollar[9].program}
+		}
+	case 188:
+		yyDollar = yyS[yypt-12 : yypt+1]
+		//line parser.y:1113
+		{
+			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Parameters: append(yyDollar[5].varassigns, yyDollar[7].varassign), Variadic: true, Statements: yyDollar[11].program}
+		}
+	case 189:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:775
+		//line parser.y:1117
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Statements: yyDollar[9].program}
 		}
-	case 122:
+	case 190:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:779
+		//line parser.y:1121
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Parameters: yyDollar[7].varassigns, Statements: yyDollar[11].program}
 		}
-	case 123:
+	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:783
+		//line parser.y:1125
 		{
 			yyVAL.statement = DisposeFunction{Name: yyDollar[3].identifier}
 		}
-	case 124:
+	case 192:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		//line parser.y:1131
+		{
+			yyVAL.statement = TableTriggerDeclaration{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier, Timing: yyDollar[4].token, Event: yyDollar[5].token, Table: yyDollar[7].queryexpr, Statements: yyDollar[10].program}
+		}
+	case 193:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:1135
+		{
+			yyVAL.statement = DisposeTableTrigger{Name: yyDollar[3].identifier}
+		}
+	case 194:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		//line parser.y:1141
+		{
+			yyVAL.statement = CreateIndex{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[3].identifier, Table: yyDollar[5].queryexpr, Columns: yyDollar[7].queryexprs}
+		}
+	case 195:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:1145
+		{
+			yyVAL.statement = CreateIndex{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[3].identifier, Table: yyDollar[5].queryexpr, Columns: yyDollar[7].queryexprs, Method: yyDollar[10].identifier}
+		}
+	case 196:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:1149
+		{
+			yyVAL.statement = DropIndex{Name: yyDollar[3].identifier}
+		}
+	case 197:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:1155
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 198:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:1159
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 199:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:1165
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 200:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:1169
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 201:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:1173
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 202:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:789
+		//line parser.y:1179
 		{
 			yyVAL.fetchpos = FetchPosition{}
 		}
-	case 125:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:793
+		//line parser.y:1183
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 126:
+	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:797
+		//line parser.y:1187
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 127:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:801
+		//line parser.y:1191
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 128:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:805
+		//line parser.y:1195
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 129:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:809
+		//line parser.y:1199
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 130:
+	case 208:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:813
+		//line parser.y:1203
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 131:
+	case 209:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:819
+		//line parser.y:1209
 		{
 			yyVAL.queryexpr = CursorStatus{CursorLit: yyDollar[1].token.Literal, Cursor: yyDollar[2].identifier, Is: yyDollar[3].token.Literal, Negation: yyDollar[4].token, Type: yyDollar[5].token.Token, TypeLit: yyDollar[5].token.Literal}
 		}
-	case 132:
+	case 210:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:823
+		//line parser.y:1213
 		{
 			yyVAL.queryexpr = CursorStatus{CursorLit: yyDollar[1].token.Literal, Cursor: yyDollar[2].identifier, Is: yyDollar[3].token.Literal, Negation: yyDollar[4].token, Type: yyDollar[6].token.Token, TypeLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal}
 		}
-	case 133:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:827
+		//line parser.y:1217
 		{
 			yyVAL.queryexpr = CursorAttrebute{CursorLit: yyDollar[1].token.Literal, Cursor: yyDollar[2].identifier, Attrebute: yyDollar[3].token}
 		}
-	case 134:
+	case 212:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:833
+		//line parser.y:1223
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal, Value: yyDollar[4].identifier}
 		}
-	case 135:
+	case 213:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:837
+		//line parser.y:1227
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal, Value: yyDollar[4].queryexpr}
 		}
-	case 136:
+	case 214:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:841
+		//line parser.y:1231
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal, Value: yyDollar[4].identifier}
 		}
-	case 137:
+	case 215:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:845
+		//line parser.y:1235
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal, Value: yyDollar[4].queryexpr}
 		}
-	case 138:
+	case 216:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:849
+		//line parser.y:1239
 		{
 			yyVAL.statement = AddFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[4].token.Literal, Value: yyDollar[2].queryexpr}
 		}
-	case 139:
+	case 217:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:853
+		//line parser.y:1243
 		{
 			yyVAL.statement = RemoveFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[4].token.Literal, Value: yyDollar[2].queryexpr}
 		}
-	case 140:
+	case 218:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:857
+		//line parser.y:1247
 		{
 			yyVAL.statement = ShowFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal}
 		}
-	case 141:
+	case 219:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:861
+		//line parser.y:1251
 		{
-			yyVAL.statement = Echo{Value: yyDollar[2].queryexpr}
+			yyVAL.statement = Echo{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: yyDollar[2].queryexpr}
 		}
-	case 142:
+	case 220:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:865
+		//line parser.y:1255
 		{
-			yyVAL.statement = Print{Value: yyDollar[2].queryexpr}
+			yyVAL.statement = Print{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: yyDollar[2].queryexpr}
 		}
-	case 143:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:869
+		//line parser.y:1259
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr}
 		}
-	case 144:
+	case 222:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:873
+		//line parser.y:1263
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 145:
+	case 223:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:877
+		//line parser.y:1267
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 146:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:881
+		//line parser.y:1271
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].identifier}
 		}
-	case 147:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:885
+		//line parser.y:1275
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].queryexpr}
 		}
-	case 148:
+	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:889
+		//line parser.y:1279
+		{
+			yyVAL.statement = Import{BaseExpr: NewBaseExpr(yyDollar[1].token), Module: yyDollar[2].queryexpr}
+		}
+	case 227:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:1283
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr}
 		}
-	case 149:
+	case 228:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:893
+		//line parser.y:1287
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 150:
+	case 229:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:1291
+		{
+			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Immediate: true, Statements: yyDollar[3].queryexpr}
+		}
+	case 230:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:1295
+		{
+			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Immediate: true, Statements: yyDollar[3].queryexpr, Values: yyDollar[5].queryexprs}
+		}
+	case 231:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:1299
+		{
+			yyVAL.statement = Prepare{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier, Statement: yyDollar[4].queryexpr}
+		}
+	case 232:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:1303
+		{
+			yyVAL.statement = DisposePrepared{Name: yyDollar[3].identifier}
+		}
+	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:897
+		//line parser.y:1307
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 151:
+	case 234:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:901
+		//line parser.y:1311
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token), Keywords: yyDollar[2].queryexprs}
 		}
-	case 152:
+	case 235:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:905
+		//line parser.y:1315
 		{
 			yyVAL.statement = ShowObjects{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
 		}
-	case 153:
+	case 236:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:909
+		//line parser.y:1319
 		{
 			yyVAL.statement = ShowFields{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier, Table: yyDollar[4].identifier}
 		}
-	case 154:
+	case 237:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:1323
+		{
+			yyVAL.statement = Explain{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr}
+		}
+	case 238:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:1327
+		{
+			yyVAL.statement = Explain{BaseExpr: NewBaseExpr(yyDollar[1].token), Analyze: yyDollar[2].token, Query: yyDollar[3].queryexpr}
+		}
+	case 239:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:1331
+		{
+			yyVAL.statement = Estimate{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr}
+		}
+	case 240:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:1335
+		{
+			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].identifier}
+		}
+	case 241:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:1339
+		{
+			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].queryexpr}
+		}
+	case 242:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:1343
+		{
+			yyVAL.statement = Pwd{BaseExpr: NewBaseExpr(yyDollar[1].token)}
+		}
+	case 243:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:1347
+		{
+			yyVAL.statement = Reload{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
+		}
+	case 244:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:913
+		//line parser.y:1353
+		{
+			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier}
+		}
+	case 245:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:1357
+		{
+			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[3].queryexpr}
+		}
+	case 246:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:1361
+		{
+			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[4].queryexpr, Code: value.NewIntegerFromString(yyDollar[3].token.Literal)}
+		}
+	case 247:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:1365
+		{
+			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[3].queryexpr, Data: yyDollar[5].queryexpr}
+		}
+	case 248:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:1369
 		{
-			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].identifier}
+			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[4].queryexpr, Code: value.NewIntegerFromString(yyDollar[3].token.Literal), Data: yyDollar[6].queryexpr}
 		}
-	case 155:
+	case 249:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:917
+		//line parser.y:1375
 		{
-			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].queryexpr}
+			yyVAL.statement = Assert{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[2].queryexpr}
 		}
-	case 156:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:921
+	case 250:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:1379
 		{
-			yyVAL.statement = Pwd{BaseExpr: NewBaseExpr(yyDollar[1].token)}
+			yyVAL.statement = Assert{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[2].queryexpr, Message: yyDollar[4].queryexpr}
 		}
-	case 157:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:925
+	case 251:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:1385
 		{
-			yyVAL.statement = Reload{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
+			yyVAL.statement = Expect{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr, Expected: yyDollar[5].queryexpr}
 		}
-	case 158:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:931
+	case 252:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:1391
 		{
-			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier}
+			yyVAL.statement = Copy{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[3].queryexpr, File: yyDollar[6].queryexpr}
 		}
-	case 159:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:935
+	case 253:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:1395
 		{
-			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[3].queryexpr}
+			yyVAL.statement = Copy{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[3].queryexpr, File: yyDollar[6].queryexpr, Attributes: booleanTableAttributes(yyDollar[9].queryexprs)}
 		}
-	case 160:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:939
+	case 254:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:1399
 		{
-			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[4].queryexpr, Code: value.NewIntegerFromString(yyDollar[3].token.Literal)}
+			yyVAL.statement = Copy{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[3].queryexpr, File: yyDollar[6].queryexpr, Attributes: yyDollar[9].tableattrs}
 		}
-	case 161:
+	case 255:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:945
+		//line parser.y:1405
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause:    yyDollar[1].queryexpr,
@@ -2791,9 +3928,9 @@ yydefault:
 				OffsetClause:  yyDollar[5].queryexpr,
 			}
 		}
-	case 162:
+	case 256:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:957
+		//line parser.y:1417
 		{
 			yyVAL.queryexpr = SelectEntity{
 				SelectClause:  yyDollar[1].queryexpr,
@@ -2803,9 +3940,9 @@ yydefault:
 				HavingClause:  yyDollar[5].queryexpr,
 			}
 		}
-	case 163:
+	case 257:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:967
+		//line parser.y:1427
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -2814,9 +3951,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 164:
+	case 258:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:976
+		//line parser.y:1436
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -2825,9 +3962,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 165:
+	case 259:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:985
+		//line parser.y:1445
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -2836,447 +3973,519 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 166:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:996
+		//line parser.y:1456
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 167:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1000
+		//line parser.y:1460
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 168:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1006
+		//line parser.y:1466
 		{
 			yyVAL.queryexpr = SelectClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Select: yyDollar[1].token.Literal, Distinct: yyDollar[2].token, Fields: yyDollar[3].queryexprs}
 		}
-	case 169:
+	case 263:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:1470
+		{
+			yyVAL.queryexpr = SelectClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Select: yyDollar[1].token.Literal, Hints: ParseHints(yyDollar[2].token.Literal), Distinct: yyDollar[3].token, Fields: yyDollar[4].queryexprs}
+		}
+	case 264:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1012
+		//line parser.y:1476
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 170:
+	case 265:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1016
+		//line parser.y:1480
 		{
 			yyVAL.queryexpr = FromClause{From: yyDollar[1].token.Literal, Tables: yyDollar[2].queryexprs}
 		}
-	case 171:
+	case 266:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1022
+		//line parser.y:1486
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 172:
+	case 267:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1026
+		//line parser.y:1490
 		{
 			yyVAL.queryexpr = WhereClause{Where: yyDollar[1].token.Literal, Filter: yyDollar[2].queryexpr}
 		}
-	case 173:
+	case 268:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1032
+		//line parser.y:1496
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 174:
+	case 269:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1036
+		//line parser.y:1500
 		{
 			yyVAL.queryexpr = GroupByClause{GroupBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Items: yyDollar[3].queryexprs}
 		}
-	case 175:
+	case 270:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1042
+		//line parser.y:1506
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 176:
+	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1046
+		//line parser.y:1510
 		{
 			yyVAL.queryexpr = HavingClause{Having: yyDollar[1].token.Literal, Filter: yyDollar[2].queryexpr}
 		}
-	case 177:
+	case 272:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1052
+		//line parser.y:1516
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 178:
+	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1056
+		//line parser.y:1520
 		{
 			yyVAL.queryexpr = OrderByClause{OrderBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Items: yyDollar[3].queryexprs}
 		}
-	case 179:
+	case 274:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1062
+		//line parser.y:1526
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 180:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1066
+		//line parser.y:1530
 		{
 			yyVAL.queryexpr = LimitClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Limit: yyDollar[1].token.Literal, Value: yyDollar[2].queryexpr, With: yyDollar[3].queryexpr}
 		}
-	case 181:
+	case 276:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1070
+		//line parser.y:1534
 		{
 			yyVAL.queryexpr = LimitClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Limit: yyDollar[1].token.Literal, Value: yyDollar[2].queryexpr, Percent: yyDollar[3].token.Literal, With: yyDollar[4].queryexpr}
 		}
-	case 182:
+	case 277:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1076
+		//line parser.y:1540
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 183:
+	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1080
+		//line parser.y:1544
 		{
 			yyVAL.queryexpr = LimitWith{With: yyDollar[1].token.Literal, Type: yyDollar[2].token}
 		}
-	case 184:
+	case 279:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1086
+		//line parser.y:1550
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 185:
+	case 280:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1090
+		//line parser.y:1554
 		{
 			yyVAL.queryexpr = OffsetClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Offset: yyDollar[1].token.Literal, Value: yyDollar[2].queryexpr}
 		}
-	case 186:
+	case 281:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1096
+		//line parser.y:1560
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 187:
+	case 282:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1100
+		//line parser.y:1564
 		{
 			yyVAL.queryexpr = WithClause{With: yyDollar[1].token.Literal, InlineTables: yyDollar[2].queryexprs}
 		}
-	case 188:
+	case 283:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1106
+		//line parser.y:1570
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, As: yyDollar[3].token.Literal, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 189:
+	case 284:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1110
+		//line parser.y:1574
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, Fields: yyDollar[4].queryexprs, As: yyDollar[6].token.Literal, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 190:
+	case 285:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1116
+		//line parser.y:1580
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 191:
+	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1120
+		//line parser.y:1584
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 192:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1126
+		//line parser.y:1590
 		{
 			yyVAL.queryexpr = NewStringValue(yyDollar[1].token.Literal)
 		}
-	case 193:
+	case 288:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1130
+		//line parser.y:1594
 		{
 			yyVAL.queryexpr = NewIntegerValueFromString(yyDollar[1].token.Literal)
 		}
-	case 194:
+	case 289:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1134
+		//line parser.y:1598
 		{
 			yyVAL.queryexpr = NewFloatValueFromString(yyDollar[1].token.Literal)
 		}
-	case 195:
+	case 290:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1138
+		//line parser.y:1602
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 196:
+	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1142
+		//line parser.y:1606
 		{
 			yyVAL.queryexpr = NewDatetimeValueFromString(yyDollar[1].token.Literal)
 		}
-	case 197:
+	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1146
+		//line parser.y:1610
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 198:
+	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1152
+		//line parser.y:1616
 		{
 			yyVAL.queryexpr = NewTernaryValueFromString(yyDollar[1].token.Literal)
 		}
-	case 199:
+	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1158
+		//line parser.y:1622
 		{
 			yyVAL.queryexpr = NewNullValueFromString(yyDollar[1].token.Literal)
 		}
-	case 200:
+	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1164
+		//line parser.y:1628
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier}
 		}
-	case 201:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1168
+		//line parser.y:1632
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Column: yyDollar[3].identifier}
 		}
-	case 202:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1172
+		//line parser.y:1636
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Column: yyDollar[3].identifier}
 		}
-	case 203:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1176
+		//line parser.y:1640
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 204:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1180
+		//line parser.y:1644
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 205:
+	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1186
+		//line parser.y:1650
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 206:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1190
+		//line parser.y:1654
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 207:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1194
+		//line parser.y:1658
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 208:
+	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1198
+		//line parser.y:1662
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 209:
+	case 304:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1202
+		//line parser.y:1666
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 210:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1206
+		//line parser.y:1670
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 211:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1210
+		//line parser.y:1674
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 212:
+	case 307:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:1678
+		{
+			yyVAL.queryexpr = SetAggregateFilter(yyDollar[1].queryexpr, FilterClause{BaseExpr: NewBaseExpr(yyDollar[2].token), Filter: yyDollar[2].token.Literal, Where: WhereClause{Where: yyDollar[4].token.Literal, Filter: yyDollar[5].queryexpr}})
+		}
+	case 308:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:1682
+		{
+			yyVAL.queryexpr = SetAggregateFilter(yyDollar[1].queryexpr, FilterClause{BaseExpr: NewBaseExpr(yyDollar[2].token), Filter: yyDollar[2].token.Literal, Where: WhereClause{Where: yyDollar[4].token.Literal, Filter: yyDollar[5].queryexpr}})
+		}
+	case 309:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1214
+		//line parser.y:1686
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 213:
+	case 310:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1218
+		//line parser.y:1690
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 214:
+	case 311:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1222
+		//line parser.y:1694
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 215:
+	case 312:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1226
+		//line parser.y:1698
 		{
 			yyVAL.queryexpr = yyDollar[1].variable
 		}
-	case 216:
+	case 313:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1230
+		//line parser.y:1702
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 217:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1234
+		//line parser.y:1706
 		{
 			yyVAL.queryexpr = yyDollar[1].envvar
 		}
-	case 218:
+	case 315:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1238
+		//line parser.y:1710
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 219:
+	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1242
+		//line parser.y:1714
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 220:
+	case 317:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:1718
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 318:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1246
+		//line parser.y:1722
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 221:
+	case 319:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:1726
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 320:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:1730
+		{
+			yyVAL.queryexpr = ArrayElement{BaseExpr: NewBaseExpr(yyDollar[2].token), Array: yyDollar[1].queryexpr, Index: yyDollar[3].queryexpr}
+		}
+	case 321:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:1734
+		{
+			yyVAL.queryexpr = MemberReference{BaseExpr: yyDollar[3].identifier.BaseExpr, Value: yyDollar[1].queryexpr, Member: yyDollar[3].identifier}
+		}
+	case 322:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:1738
+		{
+			yyVAL.queryexpr = Collate{BaseExpr: NewBaseExpr(yyDollar[2].token), Value: yyDollar[1].queryexpr, Collate: yyDollar[2].token.Literal, Collation: yyDollar[3].identifier}
+		}
+	case 323:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:1742
+		{
+			yyVAL.queryexpr = Collate{BaseExpr: NewBaseExpr(yyDollar[2].token), Value: yyDollar[1].queryexpr, Collate: yyDollar[2].token.Literal, Collation: Identifier{BaseExpr: NewBaseExpr(yyDollar[3].token), Literal: yyDollar[3].token.Literal}}
+		}
+	case 324:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:1746
+		{
+			yyVAL.queryexpr = AtTimeZone{BaseExpr: NewBaseExpr(yyDollar[2].token), Value: yyDollar[1].queryexpr, AtTimeZone: yyDollar[2].token.Literal + " " + yyDollar[3].token.Literal + " " + yyDollar[4].token.Literal, TimeZone: yyDollar[5].queryexpr}
+		}
+	case 325:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:1752
+		{
+			yyVAL.queryexpr = ArrayValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Values: nil}
+		}
+	case 326:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:1756
+		{
+			yyVAL.queryexpr = ArrayValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Values: yyDollar[2].queryexprs}
+		}
+	case 327:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1252
+		//line parser.y:1762
 		{
 			yyVAL.queryexpr = AllColumns{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 222:
+	case 328:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1258
+		//line parser.y:1768
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: ValueList{Values: yyDollar[2].queryexprs}}
 		}
-	case 223:
+	case 329:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1262
+		//line parser.y:1772
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: yyDollar[1].queryexpr.GetBaseExpr(), Value: yyDollar[1].queryexpr}
 		}
-	case 224:
+	case 330:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1266
+		//line parser.y:1776
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: JsonQuery{JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}}
 		}
-	case 225:
+	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1272
+		//line parser.y:1782
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 226:
+	case 332:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1276
+		//line parser.y:1786
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 227:
+	case 333:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1282
+		//line parser.y:1792
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 228:
+	case 334:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1286
+		//line parser.y:1796
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 229:
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1292
+		//line parser.y:1802
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token}
 		}
-	case 230:
+	case 336:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1296
+		//line parser.y:1806
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token, Nulls: yyDollar[3].token.Literal, Position: yyDollar[4].token}
 		}
-	case 231:
+	case 337:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1302
+		//line parser.y:1812
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 232:
+	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1306
+		//line parser.y:1816
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 233:
+	case 339:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1312
+		//line parser.y:1822
 		{
 			yyVAL.token = Token{}
 		}
-	case 234:
+	case 340:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1316
+		//line parser.y:1826
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 235:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1320
+		//line parser.y:1830
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 236:
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1326
+		//line parser.y:1836
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 237:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1330
+		//line parser.y:1840
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 238:
+	case 344:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1336
+		//line parser.y:1846
 		{
 			yyVAL.queryexpr = Subquery{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr.(SelectQuery)}
 		}
-	case 239:
+	case 345:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1342
+		//line parser.y:1852
 		{
 			var item1 []QueryExpression
 			var item2 []QueryExpression
@@ -3297,1203 +4506,1474 @@ yydefault:
 
 			yyVAL.queryexpr = Concat{Items: append(item1, item2...)}
 		}
-	case 240:
+	case 346:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1365
+		//line parser.y:1875
 		{
 			yyVAL.queryexpr = RowValueList{RowValues: yyDollar[2].queryexprs}
 		}
-	case 241:
+	case 347:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1369
+		//line parser.y:1879
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 242:
+	case 348:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1373
+		//line parser.y:1883
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
 		}
-	case 243:
+	case 349:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1379
+		//line parser.y:1889
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, RHS: yyDollar[3].queryexpr}
 		}
-	case 244:
+	case 350:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1383
+		//line parser.y:1893
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, RHS: yyDollar[3].queryexpr}
 		}
-	case 245:
+	case 351:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1387
+		//line parser.y:1897
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: "=", RHS: yyDollar[3].queryexpr}
 		}
-	case 246:
+	case 352:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1391
+		//line parser.y:1901
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: "=", RHS: yyDollar[3].queryexpr}
 		}
-	case 247:
+	case 353:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1395
+		//line parser.y:1905
 		{
 			yyVAL.queryexpr = Is{Is: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 248:
+	case 354:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1399
+		//line parser.y:1909
 		{
 			yyVAL.queryexpr = Is{Is: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 249:
+	case 355:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1403
+		//line parser.y:1913
 		{
 			yyVAL.queryexpr = Between{Between: yyDollar[2].token.Literal, And: yyDollar[4].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[3].queryexpr, High: yyDollar[5].queryexpr}
 		}
-	case 250:
+	case 356:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1407
+		//line parser.y:1917
 		{
 			yyVAL.queryexpr = Between{Between: yyDollar[3].token.Literal, And: yyDollar[5].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 251:
+	case 357:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1411
+		//line parser.y:1921
 		{
 			yyVAL.queryexpr = Between{Between: yyDollar[3].token.Literal, And: yyDollar[5].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 252:
+	case 358:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1415
+		//line parser.y:1925
 		{
 			yyVAL.queryexpr = In{In: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[3].queryexpr}
 		}
-	case 253:
+	case 359:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1419
+		//line parser.y:1929
 		{
 			yyVAL.queryexpr = In{In: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 254:
+	case 360:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1423
+		//line parser.y:1933
 		{
 			yyVAL.queryexpr = In{In: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 255:
+	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1427
+		//line parser.y:1937
 		{
 			yyVAL.queryexpr = Like{Like: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
 		}
-	case 256:
+	case 362:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1431
+		//line parser.y:1941
 		{
 			yyVAL.queryexpr = Like{Like: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 257:
+	case 363:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1435
+		//line parser.y:1945
 		{
 			yyVAL.queryexpr = Any{Any: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 258:
+	case 364:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1439
+		//line parser.y:1949
 		{
 			yyVAL.queryexpr = Any{Any: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 259:
+	case 365:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1443
+		//line parser.y:1953
 		{
 			yyVAL.queryexpr = All{All: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 260:
+	case 366:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1447
+		//line parser.y:1957
 		{
 			yyVAL.queryexpr = All{All: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 261:
+	case 367:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1451
+		//line parser.y:1961
 		{
 			yyVAL.queryexpr = Exists{Exists: yyDollar[1].token.Literal, Query: yyDollar[2].queryexpr.(Subquery)}
 		}
-	case 262:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1457
+		//line parser.y:1967
 		{
-			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('+'), RHS: yyDollar[3].queryexpr}
+			yyVAL.queryexpr = Arithmetic{BaseExpr: NewBaseExpr(yyDollar[2].token), LHS: yyDollar[1].queryexpr, Operator: int('+'), RHS: yyDollar[3].queryexpr}
 		}
-	case 263:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1461
+		//line parser.y:1971
 		{
-			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('-'), RHS: yyDollar[3].queryexpr}
+			yyVAL.queryexpr = Arithmetic{BaseExpr: NewBaseExpr(yyDollar[2].token), LHS: yyDollar[1].queryexpr, Operator: int('-'), RHS: yyDollar[3].queryexpr}
 		}
-	case 264:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1465
+		//line parser.y:1975
 		{
-			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('*'), RHS: yyDollar[3].queryexpr}
+			yyVAL.queryexpr = Arithmetic{BaseExpr: NewBaseExpr(yyDollar[2].token), LHS: yyDollar[1].queryexpr, Operator: int('*'), RHS: yyDollar[3].queryexpr}
 		}
-	case 265:
+	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1469
+		//line parser.y:1979
 		{
-			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('/'), RHS: yyDollar[3].queryexpr}
+			yyVAL.queryexpr = Arithmetic{BaseExpr: NewBaseExpr(yyDollar[2].token), LHS: yyDollar[1].queryexpr, Operator: int('/'), RHS: yyDollar[3].queryexpr}
 		}
-	case 266:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1473
+		//line parser.y:1983
 		{
-			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('%'), RHS: yyDollar[3].queryexpr}
+			yyVAL.queryexpr = Arithmetic{BaseExpr: NewBaseExpr(yyDollar[2].token), LHS: yyDollar[1].queryexpr, Operator: int('%'), RHS: yyDollar[3].queryexpr}
 		}
-	case 267:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1477
+		//line parser.y:1987
 		{
-			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
+			yyVAL.queryexpr = UnaryArithmetic{BaseExpr: NewBaseExpr(yyDollar[1].token), Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 268:
+	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1481
+		//line parser.y:1991
 		{
-			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
+			yyVAL.queryexpr = UnaryArithmetic{BaseExpr: NewBaseExpr(yyDollar[1].token), Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 269:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1487
+		//line parser.y:1997
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 270:
+	case 376:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1491
+		//line parser.y:2001
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 271:
+	case 377:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1495
+		//line parser.y:2005
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 272:
+	case 378:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1499
+		//line parser.y:2009
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 273:
+	case 379:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1505
+		//line parser.y:2015
 		{
 			yyVAL.queryexprs = nil
 		}
-	case 274:
+	case 380:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1509
+		//line parser.y:2019
 		{
 			yyVAL.queryexprs = yyDollar[1].queryexprs
 		}
-	case 275:
+	case 381:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1515
+		//line parser.y:2025
 		{
 			yyVAL.queryexpr = Function{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 276:
+	case 382:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:2029
+		{
+			name := NewQualifiedIdentifier(yyDollar[1].identifier, yyDollar[3].identifier)
+			yyVAL.queryexpr = Function{BaseExpr: name.BaseExpr, Name: name.Literal, Args: yyDollar[5].queryexprs}
+		}
+	case 383:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		//line parser.y:2034
+		{
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, WithinGroup: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal, OrderBy: yyDollar[8].queryexpr}
+		}
+	case 384:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1519
+		//line parser.y:2038
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 277:
+	case 385:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1523
+		//line parser.y:2042
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 278:
+	case 386:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1527
+		//line parser.y:2046
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 279:
+	case 387:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1534
+		//line parser.y:2053
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 280:
+	case 388:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:2057
+		{
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, WithinGroup: yyDollar[6].token.Literal + " " + yyDollar[7].token.Literal, OrderBy: yyDollar[9].queryexpr}
+		}
+	case 389:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1538
+		//line parser.y:2061
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 281:
+	case 390:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:2065
+		{
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, WithinGroup: yyDollar[6].token.Literal + " " + yyDollar[7].token.Literal, OrderBy: yyDollar[9].queryexpr}
+		}
+	case 391:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1542
+		//line parser.y:2069
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 282:
+	case 392:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1546
+		//line parser.y:2073
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}}
 		}
-	case 283:
+	case 393:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1550
+		//line parser.y:2077
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 284:
+	case 394:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1556
+		//line parser.y:2083
 		{
 			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 285:
+	case 395:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1560
+		//line parser.y:2087
 		{
 			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, WithinGroup: yyDollar[6].token.Literal + " " + yyDollar[7].token.Literal, OrderBy: yyDollar[9].queryexpr}
 		}
-	case 286:
+	case 396:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1566
+		//line parser.y:2093
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 287:
+	case 397:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1570
+		//line parser.y:2097
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 288:
+	case 398:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1574
+		//line parser.y:2101
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 289:
+	case 399:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1578
+		//line parser.y:2105
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 290:
+	case 400:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1582
+		//line parser.y:2109
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 291:
+	case 401:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1586
+		//line parser.y:2113
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 292:
+	case 402:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1590
+		//line parser.y:2117
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 293:
+	case 403:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1594
+		//line parser.y:2121
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 294:
+	case 404:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1598
+		//line parser.y:2125
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreNulls: true, IgnoreNullsLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
 		}
-	case 295:
+	case 405:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1602
+		//line parser.y:2129
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 296:
+	case 406:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1606
+		//line parser.y:2133
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreNulls: true, IgnoreNullsLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
 		}
-	case 297:
+	case 407:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1612
+		//line parser.y:2139
 		{
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: yyDollar[2].queryexpr}
 		}
-	case 298:
+	case 408:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1618
+		//line parser.y:2145
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 299:
+	case 409:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1622
+		//line parser.y:2149
 		{
 			orderByClause := OrderByClause{OrderBy: yyDollar[2].token.Literal + " " + yyDollar[3].token.Literal, Items: yyDollar[4].queryexprs}
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: orderByClause, WindowingClause: yyDollar[5].queryexpr}
 		}
-	case 300:
+	case 410:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1629
+		//line parser.y:2156
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 301:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1633
+		//line parser.y:2160
 		{
 			yyVAL.queryexpr = PartitionClause{PartitionBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Values: yyDollar[3].queryexprs}
 		}
-	case 302:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1639
+		//line parser.y:2166
 		{
 			yyVAL.queryexpr = WindowingClause{Rows: yyDollar[1].token.Literal, FrameLow: yyDollar[2].queryexpr}
 		}
-	case 303:
+	case 413:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1643
+		//line parser.y:2170
 		{
 			yyVAL.queryexpr = WindowingClause{Rows: yyDollar[1].token.Literal, FrameLow: yyDollar[3].queryexpr, FrameHigh: yyDollar[5].queryexpr, Between: yyDollar[2].token.Literal, And: yyDollar[4].token.Literal}
 		}
-	case 304:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1649
+		//line parser.y:2176
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Unbounded: true, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 305:
+	case 415:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1653
+		//line parser.y:2180
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Offset: i, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 306:
+	case 416:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1658
+		//line parser.y:2185
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 307:
+	case 417:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1664
+		//line parser.y:2191
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Offset: i, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 308:
+	case 418:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1669
+		//line parser.y:2196
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Offset: i, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 309:
+	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1674
+		//line parser.y:2201
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 310:
+	case 420:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1680
+		//line parser.y:2207
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Unbounded: true, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 311:
+	case 421:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1684
+		//line parser.y:2211
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 312:
+	case 422:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1690
+		//line parser.y:2217
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Unbounded: true, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 313:
+	case 423:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1694
+		//line parser.y:2221
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 314:
+	case 424:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1700
+		//line parser.y:2227
 		{
 			yyVAL.queryexpr = yyDollar[1].identifier
 		}
-	case 315:
+	case 425:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2231
+		{
+			yyVAL.queryexpr = NewQualifiedIdentifier(yyDollar[1].identifier, yyDollar[3].identifier)
+		}
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1704
+		//line parser.y:2235
 		{
 			yyVAL.queryexpr = Stdin{BaseExpr: NewBaseExpr(yyDollar[1].token), Stdin: yyDollar[1].token.Literal}
 		}
-	case 316:
+	case 427:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1710
+		//line parser.y:2241
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 317:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1714
+		//line parser.y:2245
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 318:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1718
+		//line parser.y:2249
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
 		}
-	case 319:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1724
+	case 430:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2255
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 431:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:2259
+		{
+			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].identifier}
+		}
+	case 432:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:2263
+		{
+			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
+		}
+	case 433:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:2267
+		{
+			yyVAL.queryexpr = Unnest{BaseExpr: NewBaseExpr(yyDollar[1].token), Unnest: yyDollar[1].token.Literal, Value: yyDollar[3].queryexpr}
+		}
+	case 434:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:2271
+		{
+			yyVAL.queryexpr = GenerateSeries{BaseExpr: NewBaseExpr(yyDollar[1].token), GenerateSeries: yyDollar[1].token.Literal, Start: yyDollar[3].queryexpr, Stop: yyDollar[5].queryexpr}
+		}
+	case 435:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		//line parser.y:2275
+		{
+			yyVAL.queryexpr = GenerateSeries{BaseExpr: NewBaseExpr(yyDollar[1].token), GenerateSeries: yyDollar[1].token.Literal, Start: yyDollar[3].queryexpr, Stop: yyDollar[5].queryexpr, Step: yyDollar[7].queryexpr}
+		}
+	case 436:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:2279
+		{
+			yyVAL.queryexpr = JsonTable{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonTable: yyDollar[1].token.Literal, JsonText: yyDollar[3].queryexpr, Query: yyDollar[5].queryexpr, Columns: yyDollar[8].queryexprs}
+		}
+	case 437:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		//line parser.y:2283
+		{
+			yyVAL.queryexpr = TableFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[1].token.Literal, Function: Function{BaseExpr: yyDollar[3].identifier.BaseExpr, Name: yyDollar[3].identifier.Literal, Args: yyDollar[5].queryexprs}}
+		}
+	case 438:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:2287
 		{
-			yyVAL.queryexpr = yyDollar[1].queryexpr
+			yyVAL.queryexpr = TailTable{BaseExpr: NewBaseExpr(yyDollar[1].token), Tail: yyDollar[1].token.Literal, Path: yyDollar[2].identifier}
 		}
-	case 320:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1728
+	case 439:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:2291
 		{
-			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].identifier}
+			yyVAL.queryexpr = TailTable{BaseExpr: NewBaseExpr(yyDollar[1].token), Tail: yyDollar[1].token.Literal, Path: Identifier{BaseExpr: NewBaseExpr(yyDollar[2].token), Literal: yyDollar[2].token.Literal, Quoted: true}}
 		}
-	case 321:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1732
+	case 440:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2295
 		{
-			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
+			yyVAL.queryexpr = RevisionTable{BaseExpr: yyDollar[1].identifier.BaseExpr, Table: yyDollar[1].identifier, At: yyDollar[2].token.Literal, Revision: yyDollar[3].queryexpr}
 		}
-	case 322:
+	case 441:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1736
+		//line parser.y:2299
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, Path: yyDollar[3].identifier, Args: nil}
 		}
-	case 323:
+	case 442:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1740
+		//line parser.y:2303
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, Path: yyDollar[3].identifier, Args: yyDollar[5].queryexprs}
 		}
-	case 324:
+	case 443:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:2307
+		{
+			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, Path: yyDollar[3].identifier, Args: yyDollar[5].queryexprs, Options: yyDollar[8].queryexprs}
+		}
+	case 444:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		//line parser.y:2311
+		{
+			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, FormatElement: yyDollar[3].queryexpr, Options: yyDollar[6].queryexprs}
+		}
+	case 445:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1744
+		//line parser.y:2315
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].identifier, Args: nil}
 		}
-	case 325:
+	case 446:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1748
+		//line parser.y:2319
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].identifier, Args: yyDollar[7].queryexprs}
 		}
-	case 326:
+	case 447:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:2323
+		{
+			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].identifier, Options: yyDollar[8].queryexprs}
+		}
+	case 448:
+		yyDollar = yyS[yypt-12 : yypt+1]
+		//line parser.y:2327
+		{
+			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].identifier, Args: yyDollar[7].queryexprs, Options: yyDollar[10].queryexprs}
+		}
+	case 449:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1754
+		//line parser.y:2333
 		{
 			yyVAL.queryexpr = yyDollar[1].table
 		}
-	case 327:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1758
+		//line parser.y:2337
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 328:
+	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1762
+		//line parser.y:2341
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 329:
+	case 452:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1766
+		//line parser.y:2345
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
 		}
-	case 330:
+	case 453:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1770
+		//line parser.y:2349
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 331:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1774
+		//line parser.y:2353
 		{
 			yyVAL.queryexpr = Table{Object: Dual{Dual: yyDollar[1].token.Literal}}
 		}
-	case 332:
+	case 455:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1778
+		//line parser.y:2357
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 333:
+	case 456:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1784
+		//line parser.y:2363
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[3].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: nil}
 		}
-	case 334:
+	case 457:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1788
+		//line parser.y:2367
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[3].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: yyDollar[5].queryexpr}
 		}
-	case 335:
+	case 458:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1792
+		//line parser.y:2371
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: yyDollar[6].queryexpr}
 		}
-	case 336:
+	case 459:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:1796
+		//line parser.y:2375
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: JoinCondition{Literal: yyDollar[6].token.Literal, On: yyDollar[7].queryexpr}}
 		}
-	case 337:
+	case 460:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1800
+		//line parser.y:2379
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 338:
+	case 461:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1804
+		//line parser.y:2383
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[5].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].queryexpr, JoinType: yyDollar[4].token, Direction: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 339:
+	case 462:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1810
+		//line parser.y:2389
 		{
 			yyVAL.queryexpr = JoinCondition{Literal: yyDollar[1].token.Literal, On: yyDollar[2].queryexpr}
 		}
-	case 340:
+	case 463:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1814
+		//line parser.y:2393
 		{
 			yyVAL.queryexpr = JoinCondition{Literal: yyDollar[1].token.Literal, Using: yyDollar[3].queryexprs}
 		}
-	case 341:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1820
+		//line parser.y:2399
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 342:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1824
+		//line parser.y:2403
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 343:
+	case 466:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1830
+		//line parser.y:2409
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 344:
+	case 467:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1834
+		//line parser.y:2413
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
 		}
-	case 345:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1838
+		//line parser.y:2417
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 346:
+	case 469:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1844
+		//line parser.y:2423
 		{
 			yyVAL.queryexpr = CaseExpr{Case: yyDollar[1].token.Literal, End: yyDollar[5].token.Literal, Value: yyDollar[2].queryexpr, When: yyDollar[3].queryexprs, Else: yyDollar[4].queryexpr}
 		}
-	case 347:
+	case 470:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1850
+		//line parser.y:2429
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 348:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1854
+		//line parser.y:2433
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 349:
+	case 472:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1860
+		//line parser.y:2439
 		{
 			yyVAL.queryexprs = []QueryExpression{CaseExprWhen{When: yyDollar[1].token.Literal, Then: yyDollar[3].token.Literal, Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}
 		}
-	case 350:
+	case 473:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1864
+		//line parser.y:2443
 		{
 			yyVAL.queryexprs = append([]QueryExpression{CaseExprWhen{When: yyDollar[1].token.Literal, Then: yyDollar[3].token.Literal, Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}, yyDollar[5].queryexprs...)
 		}
-	case 351:
+	case 474:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1870
+		//line parser.y:2449
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 352:
+	case 475:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1874
+		//line parser.y:2453
 		{
 			yyVAL.queryexpr = CaseExprElse{Else: yyDollar[1].token.Literal, Result: yyDollar[2].queryexpr}
 		}
-	case 353:
+	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1880
+		//line parser.y:2459
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 354:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1884
+		//line parser.y:2463
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 355:
+	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1890
+		//line parser.y:2469
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 356:
+	case 479:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1894
+		//line parser.y:2473
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 357:
+	case 480:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1900
+		//line parser.y:2479
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 358:
+	case 481:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1904
+		//line parser.y:2483
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 359:
+	case 482:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1910
+		//line parser.y:2489
 		{
 			yyVAL.queryexprs = []QueryExpression{Table{Object: yyDollar[1].queryexpr}}
 		}
-	case 360:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1914
+		//line parser.y:2493
 		{
 			yyVAL.queryexprs = append([]QueryExpression{Table{Object: yyDollar[1].queryexpr}}, yyDollar[3].queryexprs...)
 		}
-	case 361:
+	case 484:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2499
+		{
+			yyVAL.queryexpr = JsonTableColumn{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier}
+		}
+	case 485:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2503
+		{
+			yyVAL.queryexpr = JsonTableColumn{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier, Path: yyDollar[3].queryexpr}
+		}
+	case 486:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2509
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 487:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2513
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 488:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2519
+		{
+			yyVAL.queryexpr = TableObjectOption{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier}
+		}
+	case 489:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:2523
+		{
+			yyVAL.queryexpr = TableObjectOption{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier, Value: yyDollar[2].queryexpr}
+		}
+	case 490:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2529
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 491:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2533
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 492:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1920
+		//line parser.y:2539
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].identifier}
 		}
-	case 362:
+	case 493:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1924
+		//line parser.y:2543
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].identifier}, yyDollar[3].queryexprs...)
 		}
-	case 363:
+	case 494:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1930
+		//line parser.y:2549
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 364:
+	case 495:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1934
+		//line parser.y:2553
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 365:
+	case 496:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1940
+		//line parser.y:2559
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, ValuesList: yyDollar[6].queryexprs}
 		}
-	case 366:
+	case 497:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1944
+		//line parser.y:2563
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, Fields: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
 		}
-	case 367:
+	case 498:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1948
+		//line parser.y:2567
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 368:
+	case 499:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1952
+		//line parser.y:2571
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, Fields: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 369:
+	case 500:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:1958
+		//line parser.y:2577
 		{
 			yyVAL.expression = UpdateQuery{WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, SetList: yyDollar[5].updatesets, FromClause: yyDollar[6].queryexpr, WhereClause: yyDollar[7].queryexpr}
 		}
-	case 370:
+	case 501:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1964
+		//line parser.y:2583
 		{
 			yyVAL.updateset = UpdateSet{Field: yyDollar[1].queryexpr, Value: yyDollar[3].queryexpr}
 		}
-	case 371:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1970
+		//line parser.y:2589
 		{
 			yyVAL.updatesets = []UpdateSet{yyDollar[1].updateset}
 		}
-	case 372:
+	case 503:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1974
+		//line parser.y:2593
 		{
 			yyVAL.updatesets = append([]UpdateSet{yyDollar[1].updateset}, yyDollar[3].updatesets...)
 		}
-	case 373:
+	case 504:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1980
+		//line parser.y:2599
 		{
 			from := FromClause{From: yyDollar[3].token.Literal, Tables: yyDollar[4].queryexprs}
 			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, FromClause: from, WhereClause: yyDollar[5].queryexpr}
 		}
-	case 374:
+	case 505:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1985
+		//line parser.y:2604
 		{
 			from := FromClause{From: yyDollar[4].token.Literal, Tables: yyDollar[5].queryexprs}
 			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, FromClause: from, WhereClause: yyDollar[6].queryexpr}
 		}
-	case 375:
+	case 506:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1992
+		//line parser.y:2611
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 376:
+	case 507:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1996
+		//line parser.y:2615
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 377:
+	case 508:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2002
+		//line parser.y:2621
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 378:
+	case 509:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2006
+		//line parser.y:2625
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 379:
+	case 510:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2012
+		//line parser.y:2631
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 380:
+	case 511:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2016
+		//line parser.y:2635
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 381:
+	case 512:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2022
+		//line parser.y:2641
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 382:
+	case 513:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2026
+		//line parser.y:2645
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 383:
+	case 514:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2032
+		//line parser.y:2651
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 384:
+	case 515:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2036
+		//line parser.y:2655
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 385:
+	case 516:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2042
+		//line parser.y:2661
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 386:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2046
+		//line parser.y:2665
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 387:
+	case 518:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2052
+		//line parser.y:2671
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 388:
+	case 519:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2056
+		//line parser.y:2675
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 389:
+	case 520:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2062
+		//line parser.y:2681
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 390:
+	case 521:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2066
+		//line parser.y:2685
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 391:
+	case 522:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2072
+		//line parser.y:2691
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 392:
+	case 523:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2076
+		//line parser.y:2695
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 393:
+	case 524:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2082
+		//line parser.y:2701
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 394:
+	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2086
+		//line parser.y:2705
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 395:
+	case 526:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2092
+		//line parser.y:2711
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 396:
+	case 527:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2096
+		//line parser.y:2715
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 397:
+	case 528:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2102
+		//line parser.y:2721
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 398:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2106
+		//line parser.y:2725
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 399:
+	case 530:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2112
+		//line parser.y:2731
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 400:
+	case 531:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2116
+		//line parser.y:2735
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 401:
+	case 532:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2122
+		//line parser.y:2741
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 402:
+	case 533:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2126
+		//line parser.y:2745
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 403:
+	case 534:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2132
+		//line parser.y:2751
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 404:
+	case 535:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2136
+		//line parser.y:2755
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 405:
+	case 536:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2142
+		//line parser.y:2761
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 406:
+	case 537:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2146
+		//line parser.y:2765
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 407:
+	case 538:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2152
+		//line parser.y:2771
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 408:
+	case 539:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2156
+		//line parser.y:2775
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 409:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2160
+		//line parser.y:2779
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 410:
+	case 541:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2164
+		//line parser.y:2783
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 411:
+	case 542:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2170
+		//line parser.y:2787
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 543:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2791
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 544:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2795
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 545:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2799
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 546:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2803
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 547:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2807
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 548:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2811
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 549:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2815
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 550:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2819
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 551:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2823
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 552:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2827
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 553:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2833
+		{
+			yyVAL.queryexpr = yylex.(*Lexer).newPlaceholder(yyDollar[1].token)
+		}
+	case 554:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2839
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 555:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2843
+		{
+			yyVAL.queryexpr = ReplaceValue{Value: yyDollar[1].queryexpr, Name: yyDollar[3].identifier}
+		}
+	case 556:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2849
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 557:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2853
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 558:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2859
+		{
+			yyVAL.identifier = yyDollar[1].identifier
+		}
+	case 559:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2863
+		{
+			yyVAL.identifier = NewQualifiedIdentifier(yyDollar[1].identifier, yyDollar[3].identifier)
+		}
+	case 560:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2869
 		{
 			yyVAL.variable = Variable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 412:
+	case 561:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2176
+		//line parser.y:2875
 		{
 			yyVAL.variables = []Variable{yyDollar[1].variable}
 		}
-	case 413:
+	case 562:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2180
+		//line parser.y:2879
 		{
 			yyVAL.variables = append([]Variable{yyDollar[1].variable}, yyDollar[3].variables...)
 		}
-	case 414:
+	case 563:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2186
+		//line parser.y:2885
 		{
 			yyVAL.queryexpr = VariableSubstitution{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 415:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2192
+		//line parser.y:2891
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 416:
+	case 565:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2196
+		//line parser.y:2895
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 417:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2202
+		//line parser.y:2901
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 418:
+	case 567:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2206
+		//line parser.y:2905
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 419:
+	case 568:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2212
+		//line parser.y:2911
 		{
 			yyVAL.envvar = EnvironmentVariable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 420:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2218
+		//line parser.y:2917
+		{
+			yyVAL.envvars = []EnvironmentVariable{yyDollar[1].envvar}
+		}
+	case 570:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2921
+		{
+			yyVAL.envvars = append([]EnvironmentVariable{yyDollar[1].envvar}, yyDollar[3].envvars...)
+		}
+	case 571:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2927
 		{
 			yyVAL.queryexpr = RuntimeInformation{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 421:
+	case 572:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2224
+		//line parser.y:2933
 		{
 			yyVAL.token = Token{}
 		}
-	case 422:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2228
+		//line parser.y:2937
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 423:
+	case 574:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2234
+		//line parser.y:2943
 		{
 			yyVAL.token = Token{}
 		}
-	case 424:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2238
+		//line parser.y:2947
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 425:
+	case 576:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2244
+		//line parser.y:2953
 		{
 			yyVAL.token = Token{}
 		}
-	case 426:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2248
+		//line parser.y:2957
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 427:
+	case 578:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2254
+		//line parser.y:2963
 		{
 			yyVAL.token = Token{}
 		}
-	case 428:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2258
+		//line parser.y:2967
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 429:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2264
+		//line parser.y:2973
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 430:
+	case 581:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2268
+		//line parser.y:2977
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 431:
+	case 582:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2274
+		//line parser.y:2983
 		{
 			yyVAL.token = Token{}
 		}
-	case 432:
+	case 583:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2278
+		//line parser.y:2987
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 433:
+	case 584:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2284
+		//line parser.y:2993
 		{
 			yyVAL.token = Token{}
 		}
-	case 434:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2288
+		//line parser.y:2997
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 435:
+	case 586:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2294
+		//line parser.y:3003
 		{
 			yyVAL.token = Token{}
 		}
-	case 436:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2298
+		//line parser.y:3007
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 437:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2304
+		//line parser.y:3013
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 438:
+	case 589:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2308
+		//line parser.y:3017
 		{
 			yyDollar[1].token.Token = COMPARISON_OP
 			yyVAL.token = yyDollar[1].token
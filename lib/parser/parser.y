@@ -22,10 +22,13 @@ import (
     varassign   VariableAssignment
     varassigns  []VariableAssignment
     envvar      EnvironmentVariable
+    envvars     []EnvironmentVariable
     updateset   UpdateSet
     updatesets  []UpdateSet
     columndef   ColumnDefault
     columndefs  []ColumnDefault
+    tableattr   TableAttribute
+    tableattrs  []TableAttribute
     elseif      []ElseIf
     elseexpr    Else
     casewhen    []CaseWhen
@@ -35,6 +38,7 @@ import (
 }
 
 %type<program>     program
+%type<program>     try_program
 %type<program>     loop_program
 %type<program>     function_program
 %type<program>     function_loop_program
@@ -60,18 +64,37 @@ import (
 %type<columndef>   column_default
 %type<columndefs>  column_defaults
 %type<expression>  column_position
+%type<tableattr>   table_attribute
+%type<queryexpr>   table_attribute_value
+%type<tableattrs>  table_attributes
+%type<queryexprs>  table_elements
+%type<queryexprs>  table_element
+%type<queryexprs>  table_element_names
+%type<queryexprs>  constrained_table_elements
+%type<queryexprs>  constrained_table_element
+%type<queryexpr>   table_constraint
+%type<queryexpr>   column_constraint
+%type<queryexprs>  column_constraints
 %type<statement>   cursor_statement
 %type<statement>   temporary_table_statement
 %type<varassign>   parameter
 %type<varassigns>  parameters
 %type<varassign>   optional_parameter
+%type<varassign>   variadic_parameter
 %type<varassigns>  optional_parameters
 %type<varassigns>  function_parameters
 %type<statement>   user_defined_function_statement
+%type<statement>   table_trigger_statement
+%type<statement>   table_index_statement
+%type<token>       trigger_timing
+%type<token>       trigger_event
 %type<fetchpos>    fetch_position
 %type<queryexpr>   cursor_status
 %type<statement>   command_statement
 %type<statement>   trigger_statement
+%type<statement>   assert_statement
+%type<statement>   expect_statement
+%type<statement>   copy_statement
 %type<queryexpr>   select_query
 %type<queryexpr>   select_entity
 %type<queryexpr>   select_set_entity
@@ -94,6 +117,7 @@ import (
 %type<queryexpr>   value
 %type<queryexpr>   wildcard
 %type<queryexpr>   row_value
+%type<queryexpr>   array_value
 %type<queryexprs>  row_values
 %type<queryexprs>  order_items
 %type<queryexpr>   order_item
@@ -123,6 +147,10 @@ import (
 %type<table>       identified_table
 %type<queryexprs>  operate_tables
 %type<queryexpr>   virtual_table_object
+%type<queryexpr>   json_table_column
+%type<queryexprs>  json_table_columns
+%type<queryexpr>   table_object_option
+%type<queryexprs>  table_object_options
 %type<queryexpr>   table
 %type<queryexpr>   join
 %type<queryexpr>   join_condition
@@ -159,13 +187,18 @@ import (
 %type<casewhen>    in_function_in_loop_case_when
 %type<caseelse>    in_function_in_loop_case_else
 %type<identifier>  identifier
+%type<identifier>  qualified_identifier
 %type<variable>    variable
 %type<variables>   variables
 %type<queryexpr>   variable_substitution
 %type<varassign>   variable_assignment
 %type<varassigns>  variable_assignments
 %type<envvar>      environment_variable
+%type<envvars>     environment_variables
 %type<queryexpr>   runtime_information
+%type<queryexpr>   placeholder
+%type<queryexpr>   replace_value
+%type<queryexprs>  replace_values
 %type<token>       distinct
 %type<token>       negation
 %type<token>       join_type_inner
@@ -177,34 +210,36 @@ import (
 %type<token>       comparison_operator
 
 %token<token> IDENTIFIER STRING INTEGER FLOAT BOOLEAN TERNARY DATETIME
-%token<token> VARIABLE FLAG ENVIRONMENT_VARIABLE RUNTIME_INFORMATION EXTERNAL_COMMAND
-%token<token> SELECT FROM UPDATE SET UNSET DELETE WHERE INSERT INTO VALUES AS DUAL STDIN
+%token<token> VARIABLE FLAG ENVIRONMENT_VARIABLE RUNTIME_INFORMATION EXTERNAL_COMMAND PLACEHOLDER HINT
+%token<token> SELECT FROM UPDATE SET UNSET EXPORT DELETE WHERE INSERT INTO VALUES AS DUAL STDIN COPY
 %token<token> RECURSIVE
 %token<token> CREATE ADD DROP ALTER TABLE FIRST LAST AFTER BEFORE DEFAULT RENAME TO VIEW
+%token<token> CHECK CONSTRAINT UNIQUE AUTO_INCREMENT
 %token<token> ORDER GROUP HAVING BY ASC DESC LIMIT OFFSET PERCENT
 %token<token> JOIN INNER OUTER LEFT RIGHT FULL CROSS ON USING NATURAL
 %token<token> UNION INTERSECT EXCEPT
 %token<token> ALL ANY EXISTS IN
 %token<token> AND OR NOT BETWEEN LIKE IS NULL
-%token<token> DISTINCT WITH
+%token<token> DISTINCT WITH COLLATE
 %token<token> RANGE UNBOUNDED PRECEDING FOLLOWING CURRENT ROW
 %token<token> CASE IF ELSEIF WHILE WHEN THEN ELSE DO END
+%token<token> TRY CATCH
 %token<token> DECLARE CURSOR FOR FETCH OPEN CLOSE DISPOSE
-%token<token> NEXT PRIOR ABSOLUTE RELATIVE
+%token<token> NEXT PRIOR ABSOLUTE RELATIVE BULK
 %token<token> SEPARATOR PARTITION OVER
 %token<token> COMMIT ROLLBACK
 %token<token> CONTINUE BREAK EXIT
-%token<token> ECHO PRINT PRINTF SOURCE EXECUTE CHDIR PWD RELOAD REMOVE SYNTAX TRIGGER
-%token<token> FUNCTION AGGREGATE BEGIN RETURN
-%token<token> IGNORE WITHIN
-%token<token> VAR SHOW
-%token<token> TIES NULLS ROWS
-%token<token> JSON_ROW JSON_TABLE
+%token<token> ECHO PRINT PRINTF SOURCE IMPORT EXECUTE IMMEDIATE PREPARE CHDIR PWD RELOAD REMOVE SYNTAX TRIGGER ASSERT EXPECT
+%token<token> FUNCTION AGGREGATE BEGIN RETURN VARIADIC
+%token<token> IGNORE WITHIN FILTER
+%token<token> VAR SHOW EXPLAIN
+%token<token> TIES NULLS ROWS COLUMNS PATH AT TYPE ANALYZE ESTIMATE TIME ZONE MESSAGE EQUAL INDEX
+%token<token> JSON_ROW JSON_TABLE UNNEST GENERATE_SERIES TAIL
 %token<token> COUNT JSON_OBJECT
 %token<token> AGGREGATE_FUNCTION LIST_FUNCTION ANALYTIC_FUNCTION FUNCTION_NTH FUNCTION_WITH_INS
 %token<token> COMPARISON_OP STRING_OP SUBSTITUTION_OP
 %token<token> UMINUS UPLUS
-%token<token> ';' '*' '=' '-' '+' '!' '(' ')'
+%token<token> ';' '*' '=' '-' '+' '/' '%' '!' '(' ')' '[' ']'
 
 %right SUBSTITUTION_OP
 %left UNION EXCEPT
@@ -218,6 +253,8 @@ import (
 %left '+' '-'
 %left '*' '/' '%'
 %right UMINUS UPLUS '!'
+%left COLLATE AT ZONE
+%left '[' '.'
 
 %%
 
@@ -238,6 +275,16 @@ program
         yylex.(*Lexer).program = $$
     }
 
+try_program
+    :
+    {
+        $$ = nil
+    }
+    | procedure_statement ';' try_program
+    {
+        $$ = append([]Statement{$1}, $3...)
+    }
+
 loop_program
     :
     {
@@ -309,6 +356,14 @@ common_statement
     {
         $$ = $1
     }
+    | table_trigger_statement
+    {
+        $$ = $1
+    }
+    | table_index_statement
+    {
+        $$ = $1
+    }
     | transaction_statement
     {
         $$ = $1
@@ -321,6 +376,18 @@ common_statement
     {
         $$ = $1
     }
+    | assert_statement
+    {
+        $$ = $1
+    }
+    | expect_statement
+    {
+        $$ = $1
+    }
+    | copy_statement
+    {
+        $$ = $1
+    }
     | value
     {
         $$ = $1
@@ -333,11 +400,19 @@ common_statement
 common_loop_flow_control_statement
     : CONTINUE
     {
-        $$ = FlowControl{Token: $1.Token}
+        $$ = FlowControl{BaseExpr: NewBaseExpr($1), Token: $1.Token}
+    }
+    | CONTINUE identifier
+    {
+        $$ = FlowControl{BaseExpr: NewBaseExpr($1), Token: $1.Token, Label: $2}
     }
     | BREAK
     {
-        $$ = FlowControl{Token: $1.Token}
+        $$ = FlowControl{BaseExpr: NewBaseExpr($1), Token: $1.Token}
+    }
+    | BREAK identifier
+    {
+        $$ = FlowControl{BaseExpr: NewBaseExpr($1), Token: $1.Token, Label: $2}
     }
 
 procedure_statement
@@ -385,11 +460,11 @@ while_variable_declaration
 exit_statement
     : EXIT
     {
-        $$ = Exit{}
+        $$ = Exit{BaseExpr: NewBaseExpr($1)}
     }
     | EXIT INTEGER
     {
-        $$ = Exit{Code: value.NewIntegerFromString($2.Literal)}
+        $$ = Exit{BaseExpr: NewBaseExpr($1), Code: value.NewIntegerFromString($2.Literal)}
     }
 
 loop_statement
@@ -419,10 +494,18 @@ flow_control_statement
     {
         $$ = $1
     }
+    | identifier ':' while_statement
+    {
+        $$ = SetLoopLabel($3, $1)
+    }
     | exit_statement
     {
         $$ = $1
     }
+    | TRY try_program CATCH program END TRY
+    {
+        $$ = Try{Statements: $2, CatchStatements: $4}
+    }
 
 loop_flow_control_statement
     : IF value THEN loop_program in_loop_else END IF
@@ -441,6 +524,10 @@ loop_flow_control_statement
     {
         $$ = $1
     }
+    | identifier ':' while_statement
+    {
+        $$ = SetLoopLabel($3, $1)
+    }
     | exit_statement
     {
         $$ = $1
@@ -449,6 +536,10 @@ loop_flow_control_statement
     {
         $$ = $1
     }
+    | TRY loop_program CATCH loop_program END TRY
+    {
+        $$ = Try{Statements: $2, CatchStatements: $4}
+    }
 
 function_statement
     : common_statement
@@ -477,11 +568,19 @@ function_while_statement
 function_exit_statement
     : RETURN
     {
-        $$ = Return{Value: NewNullValue()}
+        $$ = Return{BaseExpr: NewBaseExpr($1), Value: NewNullValue()}
     }
     | RETURN value
     {
-        $$ = Return{Value: $2}
+        $$ = Return{BaseExpr: NewBaseExpr($1), Value: $2}
+    }
+    | RETURN TABLE select_query
+    {
+        $$ = ReturnTable{BaseExpr: NewBaseExpr($1), Query: $3.(SelectQuery)}
+    }
+    | RETURN CURSOR identifier
+    {
+        $$ = ReturnCursor{BaseExpr: NewBaseExpr($1), Cursor: $3}
     }
 
 function_loop_statement
@@ -511,10 +610,18 @@ function_flow_control_statement
     {
         $$ = $1
     }
+    | identifier ':' function_while_statement
+    {
+        $$ = SetLoopLabel($3, $1)
+    }
     | function_exit_statement
     {
         $$ = $1
     }
+    | TRY function_program CATCH function_program END TRY
+    {
+        $$ = Try{Statements: $2, CatchStatements: $4}
+    }
 
 function_loop_flow_control_statement
     : IF value THEN function_loop_program in_function_in_loop_else END IF
@@ -533,6 +640,10 @@ function_loop_flow_control_statement
     {
         $$ = $1
     }
+    | identifier ':' function_while_statement
+    {
+        $$ = SetLoopLabel($3, $1)
+    }
     | function_exit_statement
     {
         $$ = $1
@@ -541,6 +652,10 @@ function_loop_flow_control_statement
     {
         $$ = $1
     }
+    | TRY function_loop_program CATCH function_loop_program END TRY
+    {
+        $$ = Try{Statements: $2, CatchStatements: $4}
+    }
 
 variable_statement
     : VAR variable_assignments
@@ -581,6 +696,14 @@ environment_variable_statement
     {
         $$ = UnsetEnvVar{EnvVar:$2}
     }
+    | EXPORT environment_variables
+    {
+        $$ = Export{BaseExpr: NewBaseExpr($1), EnvVars: $2}
+    }
+    | EXPORT environment_variables TO value
+    {
+        $$ = Export{BaseExpr: NewBaseExpr($1), EnvVars: $2, FilePath: $4}
+    }
 
 transaction_statement
     : COMMIT
@@ -593,18 +716,42 @@ transaction_statement
     }
 
 table_operation_statement
-    : CREATE TABLE identifier '(' identifiers ')'
+    : CREATE TABLE identifier '(' table_element_names ')'
     {
         $$ = CreateTable{Table: $3, Fields: $5}
     }
-    | CREATE TABLE identifier '(' identifiers ')' as select_query
+    | CREATE TABLE identifier '(' constrained_table_elements ')'
+    {
+        fields, constraints := splitTableElements($5)
+        $$ = CreateTable{Table: $3, Fields: fields, Constraints: constraints}
+    }
+    | CREATE TABLE identifier '(' table_element_names ')' as select_query
     {
         $$ = CreateTable{Table: $3, Fields: $5, Query: $8}
     }
+    | CREATE TABLE identifier '(' constrained_table_elements ')' as select_query
+    {
+        fields, constraints := splitTableElements($5)
+        $$ = CreateTable{Table: $3, Fields: fields, Constraints: constraints, Query: $8}
+    }
     | CREATE TABLE identifier as select_query
     {
         $$ = CreateTable{Table: $3, Query: $5}
     }
+    | CREATE TABLE identifier '(' table_attributes ')' '(' table_elements ')'
+    {
+        fields, constraints := splitTableElements($8)
+        $$ = CreateTable{Table: $3, Attributes: $5, Fields: fields, Constraints: constraints}
+    }
+    | CREATE TABLE identifier '(' table_attributes ')' '(' table_elements ')' as select_query
+    {
+        fields, constraints := splitTableElements($8)
+        $$ = CreateTable{Table: $3, Attributes: $5, Fields: fields, Constraints: constraints, Query: $11}
+    }
+    | CREATE TABLE identifier '(' table_attributes ')' as select_query
+    {
+        $$ = CreateTable{Table: $3, Attributes: $5, Query: $8}
+    }
     | ALTER TABLE table_identifier ADD column_default column_position
     {
         $$ = AddColumns{Table: $3, Columns: []ColumnDefault{$5}, Position: $6}
@@ -625,6 +772,10 @@ table_operation_statement
     {
         $$ = RenameColumn{Table: $3, Old: $5, New: $7}
     }
+    | ALTER TABLE table_identifier ALTER field_reference SET TYPE identifier
+    {
+        $$ = SetColumnType{BaseExpr: NewBaseExpr($1), Table: $3, Column: $5, Type: $8}
+    }
     | ALTER TABLE table_identifier SET identifier TO identifier
     {
         $$ = SetTableAttribute{BaseExpr: NewBaseExpr($1), Table: $3, Attribute: $5, Value: $7}
@@ -643,6 +794,14 @@ column_default
     {
         $$ = ColumnDefault{Column: $1, Value: $3}
     }
+    | identifier identifier
+    {
+        $$ = ColumnDefault{Column: $1, Type: $2}
+    }
+    | identifier identifier DEFAULT value
+    {
+        $$ = ColumnDefault{Column: $1, Type: $2, Value: $4}
+    }
 
 column_defaults
     : column_default
@@ -654,6 +813,164 @@ column_defaults
         $$ = append([]ColumnDefault{$1}, $3...)
     }
 
+table_constraint
+    : CHECK '(' value ')'
+    {
+        $$ = CheckConstraint{BaseExpr: NewBaseExpr($1), Condition: $3}
+    }
+    | CONSTRAINT identifier CHECK '(' value ')'
+    {
+        $$ = CheckConstraint{BaseExpr: NewBaseExpr($1), Name: $2, Condition: $5}
+    }
+    | UNIQUE '(' identifiers ')'
+    {
+        $$ = UniqueConstraint{BaseExpr: NewBaseExpr($1), Columns: $3}
+    }
+    | CONSTRAINT identifier UNIQUE '(' identifiers ')'
+    {
+        $$ = UniqueConstraint{BaseExpr: NewBaseExpr($1), Name: $2, Columns: $5}
+    }
+
+column_constraint
+    : CHECK '(' value ')'
+    {
+        $$ = CheckConstraint{BaseExpr: NewBaseExpr($1), Condition: $3}
+    }
+    | CONSTRAINT identifier CHECK '(' value ')'
+    {
+        $$ = CheckConstraint{BaseExpr: NewBaseExpr($1), Name: $2, Condition: $5}
+    }
+    | NOT NULL
+    {
+        $$ = NotNullConstraint{BaseExpr: NewBaseExpr($1)}
+    }
+    | CONSTRAINT identifier NOT NULL
+    {
+        $$ = NotNullConstraint{BaseExpr: NewBaseExpr($1), Name: $2}
+    }
+    | UNIQUE
+    {
+        $$ = UniqueConstraint{BaseExpr: NewBaseExpr($1)}
+    }
+    | CONSTRAINT identifier UNIQUE
+    {
+        $$ = UniqueConstraint{BaseExpr: NewBaseExpr($1), Name: $2}
+    }
+    | AUTO_INCREMENT
+    {
+        $$ = AutoIncrement{BaseExpr: NewBaseExpr($1)}
+    }
+
+column_constraints
+    : column_constraint
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | column_constraint column_constraints
+    {
+        $$ = append([]QueryExpression{$1}, $2...)
+    }
+
+table_element
+    : identifier
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | constrained_table_element
+    {
+        $$ = $1
+    }
+
+table_elements
+    : table_element
+    {
+        $$ = $1
+    }
+    | table_element ',' table_elements
+    {
+        $$ = append($1, $3...)
+    }
+
+table_element_names
+    : identifier
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | table_element_names ',' identifier
+    {
+        $$ = append($1, $3)
+    }
+
+constrained_table_element
+    : identifier column_constraints
+    {
+        $$ = append([]QueryExpression{$1}, setConstraintColumn($2, $1)...)
+    }
+    | table_constraint
+    {
+        $$ = []QueryExpression{$1}
+    }
+
+constrained_table_elements
+    : constrained_table_element
+    {
+        $$ = $1
+    }
+    | table_element_names ',' constrained_table_element
+    {
+        $$ = append($1, $3...)
+    }
+    | constrained_table_elements ',' identifier
+    {
+        $$ = append($1, $3)
+    }
+    | constrained_table_elements ',' constrained_table_element
+    {
+        $$ = append($1, $3...)
+    }
+
+table_attribute
+    : identifier table_attribute_value
+    {
+        $$ = TableAttribute{BaseExpr: $1.BaseExpr, Attribute: $1, Value: $2}
+    }
+
+table_attribute_value
+    : field_reference
+    {
+        $$ = $1
+    }
+    | primitive_type
+    {
+        $$ = $1
+    }
+    | variable
+    {
+        $$ = $1
+    }
+    | environment_variable
+    {
+        $$ = $1
+    }
+
+table_attributes
+    : table_attribute
+    {
+        $$ = []TableAttribute{$1}
+    }
+    | table_element_names ',' table_attribute
+    {
+        $$ = append(booleanTableAttributes($1), $3)
+    }
+    | table_attributes ',' identifier
+    {
+        $$ = append($1, TableAttribute{BaseExpr: $3.BaseExpr, Attribute: $3})
+    }
+    | table_attributes ',' table_attribute
+    {
+        $$ = append($1, $3)
+    }
+
 column_position
     :
     {
@@ -681,6 +998,11 @@ cursor_statement
     {
         $$ = CursorDeclaration{Cursor:$2, Query: $5.(SelectQuery)}
     }
+    | DECLARE identifier CURSOR FOR TABLE '(' qualified_identifier '(' arguments ')' ')'
+    {
+        fn := TableFunction{BaseExpr: NewBaseExpr($5), Table: $5.Literal, Function: Function{BaseExpr: $7.BaseExpr, Name: $7.Literal, Args: $9}}
+        $$ = CursorDeclaration{Cursor:$2, Query: NewSelectAllQuery(fn)}
+    }
     | OPEN identifier
     {
         $$ = OpenCursor{Cursor: $2}
@@ -697,21 +1019,27 @@ cursor_statement
     {
         $$ = FetchCursor{Position: $2, Cursor: $3, Variables: $5}
     }
+    | FETCH fetch_position identifier BULK value INTO variable
+    {
+        $$ = FetchCursor{Position: $2, Cursor: $3, Bulk: $5, Variables: []Variable{$7}}
+    }
 
 temporary_table_statement
-    : DECLARE identifier VIEW '(' identifiers ')'
+    : DECLARE qualified_identifier VIEW '(' table_elements ')'
     {
-        $$ = ViewDeclaration{View: $2, Fields: $5}
+        fields, constraints := splitTableElements($5)
+        $$ = ViewDeclaration{View: $2, Fields: fields, Constraints: constraints}
     }
-    | DECLARE identifier VIEW '(' identifiers ')' AS select_query
+    | DECLARE qualified_identifier VIEW '(' table_elements ')' AS select_query
     {
-        $$ = ViewDeclaration{View: $2, Fields: $5, Query: $8}
+        fields, constraints := splitTableElements($5)
+        $$ = ViewDeclaration{View: $2, Fields: fields, Constraints: constraints, Query: $8}
     }
-    | DECLARE identifier VIEW AS select_query
+    | DECLARE qualified_identifier VIEW AS select_query
     {
         $$ = ViewDeclaration{View: $2, Query: $5}
     }
-    | DISPOSE VIEW identifier
+    | DISPOSE VIEW qualified_identifier
     {
         $$ = DisposeView{View: $3}
     }
@@ -762,28 +1090,90 @@ function_parameters
         $$ = append($1, $3...)
     }
 
+variadic_parameter
+    : VARIADIC variable
+    {
+        $$ = VariableAssignment{Variable:$2}
+    }
+
 user_defined_function_statement
-    : DECLARE identifier FUNCTION '(' ')' AS BEGIN function_program END
+    : DECLARE qualified_identifier FUNCTION '(' ')' AS BEGIN function_program END
     {
         $$ = FunctionDeclaration{Name: $2, Statements: $8}
     }
-    | DECLARE identifier FUNCTION '(' function_parameters ')' AS BEGIN function_program END
+    | DECLARE qualified_identifier FUNCTION '(' function_parameters ')' AS BEGIN function_program END
     {
         $$ = FunctionDeclaration{Name: $2, Parameters: $5, Statements: $9}
     }
-    | DECLARE identifier AGGREGATE '(' identifier ')' AS BEGIN function_program END
+    | DECLARE qualified_identifier FUNCTION '(' variadic_parameter ')' AS BEGIN function_program END
+    {
+        $$ = FunctionDeclaration{Name: $2, Parameters: []VariableAssignment{$5}, Variadic: true, Statements: $9}
+    }
+    | DECLARE qualified_identifier FUNCTION '(' parameters ',' variadic_parameter ')' AS BEGIN function_program END
+    {
+        $$ = FunctionDeclaration{Name: $2, Parameters: append($5, $7), Variadic: true, Statements: $11}
+    }
+    | DECLARE qualified_identifier AGGREGATE '(' identifier ')' AS BEGIN function_program END
     {
         $$ = AggregateDeclaration{Name: $2, Cursor: $5, Statements: $9}
     }
-    | DECLARE identifier AGGREGATE '(' identifier ',' function_parameters ')' AS BEGIN function_program END
+    | DECLARE qualified_identifier AGGREGATE '(' identifier ',' function_parameters ')' AS BEGIN function_program END
     {
         $$ = AggregateDeclaration{Name: $2, Cursor: $5, Parameters: $7, Statements: $11}
     }
-    | DISPOSE FUNCTION identifier
+    | DISPOSE FUNCTION qualified_identifier
     {
         $$ = DisposeFunction{Name: $3}
     }
 
+table_trigger_statement
+    : DECLARE identifier TRIGGER trigger_timing trigger_event ON table_identifier AS BEGIN function_program END
+    {
+        $$ = TableTriggerDeclaration{BaseExpr: NewBaseExpr($1), Name: $2, Timing: $4, Event: $5, Table: $7, Statements: $10}
+    }
+    | DISPOSE TRIGGER identifier
+    {
+        $$ = DisposeTableTrigger{Name: $3}
+    }
+
+table_index_statement
+    : CREATE INDEX identifier ON table_identifier '(' field_references ')'
+    {
+        $$ = CreateIndex{BaseExpr: NewBaseExpr($1), Name: $3, Table: $5, Columns: $7}
+    }
+    | CREATE INDEX identifier ON table_identifier '(' field_references ')' USING identifier
+    {
+        $$ = CreateIndex{BaseExpr: NewBaseExpr($1), Name: $3, Table: $5, Columns: $7, Method: $10}
+    }
+    | DROP INDEX identifier
+    {
+        $$ = DropIndex{Name: $3}
+    }
+
+trigger_timing
+    : BEFORE
+    {
+        $$ = $1
+    }
+    | AFTER
+    {
+        $$ = $1
+    }
+
+trigger_event
+    : INSERT
+    {
+        $$ = $1
+    }
+    | UPDATE
+    {
+        $$ = $1
+    }
+    | DELETE
+    {
+        $$ = $1
+    }
+
 fetch_position
     :
     {
@@ -859,11 +1249,11 @@ command_statement
     }
     | ECHO value
     {
-        $$ = Echo{Value: $2}
+        $$ = Echo{BaseExpr: NewBaseExpr($1), Value: $2}
     }
     | PRINT value
     {
-        $$ = Print{Value: $2}
+        $$ = Print{BaseExpr: NewBaseExpr($1), Value: $2}
     }
     | PRINTF value
     {
@@ -885,14 +1275,34 @@ command_statement
     {
         $$ = Source{BaseExpr: NewBaseExpr($1), FilePath: $2}
     }
+    | IMPORT value
+    {
+        $$ = Import{BaseExpr: NewBaseExpr($1), Module: $2}
+    }
     | EXECUTE value
     {
         $$ = Execute{BaseExpr: NewBaseExpr($1), Statements: $2}
     }
-    | EXECUTE value USING values
+    | EXECUTE value USING replace_values
     {
         $$ = Execute{BaseExpr: NewBaseExpr($1), Statements: $2, Values: $4}
     }
+    | EXECUTE IMMEDIATE value
+    {
+        $$ = Execute{BaseExpr: NewBaseExpr($1), Immediate: true, Statements: $3}
+    }
+    | EXECUTE IMMEDIATE value USING replace_values
+    {
+        $$ = Execute{BaseExpr: NewBaseExpr($1), Immediate: true, Statements: $3, Values: $5}
+    }
+    | PREPARE identifier FROM value
+    {
+        $$ = Prepare{BaseExpr: NewBaseExpr($1), Name: $2, Statement: $4}
+    }
+    | DISPOSE PREPARE identifier
+    {
+        $$ = DisposePrepared{Name: $3}
+    }
     | SYNTAX
     {
         $$ = Syntax{BaseExpr: NewBaseExpr($1)}
@@ -909,6 +1319,18 @@ command_statement
     {
         $$ = ShowFields{BaseExpr: NewBaseExpr($1), Type: $2, Table: $4}
     }
+    | EXPLAIN select_query
+    {
+        $$ = Explain{BaseExpr: NewBaseExpr($1), Query: $2}
+    }
+    | EXPLAIN ANALYZE select_query
+    {
+        $$ = Explain{BaseExpr: NewBaseExpr($1), Analyze: $2, Query: $3}
+    }
+    | ESTIMATE select_query
+    {
+        $$ = Estimate{BaseExpr: NewBaseExpr($1), Query: $2}
+    }
     | CHDIR identifier
     {
         $$ = Chdir{BaseExpr: NewBaseExpr($1), DirPath: $2}
@@ -939,6 +1361,44 @@ trigger_statement
     {
         $$ = Trigger{BaseExpr: NewBaseExpr($1), Event: $2, Message: $4, Code: value.NewIntegerFromString($3.Literal)}
     }
+    | TRIGGER identifier value WITH value
+    {
+        $$ = Trigger{BaseExpr: NewBaseExpr($1), Event: $2, Message: $3, Data: $5}
+    }
+    | TRIGGER identifier INTEGER value WITH value
+    {
+        $$ = Trigger{BaseExpr: NewBaseExpr($1), Event: $2, Message: $4, Code: value.NewIntegerFromString($3.Literal), Data: $6}
+    }
+
+assert_statement
+    : ASSERT value
+    {
+        $$ = Assert{BaseExpr: NewBaseExpr($1), Condition: $2}
+    }
+    | ASSERT value MESSAGE value
+    {
+        $$ = Assert{BaseExpr: NewBaseExpr($1), Condition: $2, Message: $4}
+    }
+
+expect_statement
+    : EXPECT select_query TO EQUAL select_query
+    {
+        $$ = Expect{BaseExpr: NewBaseExpr($1), Query: $2, Expected: $5}
+    }
+
+copy_statement
+    : COPY '(' select_query ')' TO value
+    {
+        $$ = Copy{BaseExpr: NewBaseExpr($1), Query: $3, File: $6}
+    }
+    | COPY '(' select_query ')' TO value WITH '(' table_element_names ')'
+    {
+        $$ = Copy{BaseExpr: NewBaseExpr($1), Query: $3, File: $6, Attributes: booleanTableAttributes($9)}
+    }
+    | COPY '(' select_query ')' TO value WITH '(' table_attributes ')'
+    {
+        $$ = Copy{BaseExpr: NewBaseExpr($1), Query: $3, File: $6, Attributes: $9}
+    }
 
 select_query
     : with_clause select_entity order_by_clause limit_clause offset_clause
@@ -1006,6 +1466,10 @@ select_clause
     {
         $$ = SelectClause{BaseExpr: NewBaseExpr($1), Select: $1.Literal, Distinct: $2, Fields: $3}
     }
+    | SELECT HINT distinct fields
+    {
+        $$ = SelectClause{BaseExpr: NewBaseExpr($1), Select: $1.Literal, Hints: ParseHints($2.Literal), Distinct: $3, Fields: $4}
+    }
 
 from_clause
     :
@@ -1210,6 +1674,14 @@ value
     {
         $$ = $1
     }
+    | function FILTER '(' WHERE value ')'
+    {
+        $$ = SetAggregateFilter($1, FilterClause{BaseExpr: NewBaseExpr($2), Filter: $2.Literal, Where: WhereClause{Where: $4.Literal, Filter: $5}})
+    }
+    | aggregate_function FILTER '(' WHERE value ')'
+    {
+        $$ = SetAggregateFilter($1, FilterClause{BaseExpr: NewBaseExpr($2), Filter: $2.Literal, Where: WhereClause{Where: $4.Literal, Filter: $5}})
+    }
     | case_expr
     {
         $$ = $1
@@ -1238,6 +1710,10 @@ value
     {
         $$ = $1
     }
+    | placeholder
+    {
+        $$ = $1
+    }
     | cursor_status
     {
         $$ = $1
@@ -1246,6 +1722,40 @@ value
     {
         $$ = Parentheses{Expr: $2}
     }
+    | array_value
+    {
+        $$ = $1
+    }
+    | value '[' value ']'
+    {
+        $$ = ArrayElement{BaseExpr: NewBaseExpr($2), Array: $1, Index: $3}
+    }
+    | value '.' identifier
+    {
+        $$ = MemberReference{BaseExpr: $3.BaseExpr, Value: $1, Member: $3}
+    }
+    | value COLLATE identifier
+    {
+        $$ = Collate{BaseExpr: NewBaseExpr($2), Value: $1, Collate: $2.Literal, Collation: $3}
+    }
+    | value COLLATE NATURAL
+    {
+        $$ = Collate{BaseExpr: NewBaseExpr($2), Value: $1, Collate: $2.Literal, Collation: Identifier{BaseExpr: NewBaseExpr($3), Literal: $3.Literal}}
+    }
+    | value AT TIME ZONE value
+    {
+        $$ = AtTimeZone{BaseExpr: NewBaseExpr($2), Value: $1, AtTimeZone: $2.Literal + " " + $3.Literal + " " + $4.Literal, TimeZone: $5}
+    }
+
+array_value
+    : '[' ']'
+    {
+        $$ = ArrayValue{BaseExpr: NewBaseExpr($1), Values: nil}
+    }
+    | '[' values ']'
+    {
+        $$ = ArrayValue{BaseExpr: NewBaseExpr($1), Values: $2}
+    }
 
 wildcard
     : '*'
@@ -1455,31 +1965,31 @@ comparison
 arithmetic
     : value '+' value
     {
-        $$ = Arithmetic{LHS: $1, Operator: int('+'), RHS: $3}
+        $$ = Arithmetic{BaseExpr: NewBaseExpr($2), LHS: $1, Operator: int('+'), RHS: $3}
     }
     | value '-' value
     {
-        $$ = Arithmetic{LHS: $1, Operator: int('-'), RHS: $3}
+        $$ = Arithmetic{BaseExpr: NewBaseExpr($2), LHS: $1, Operator: int('-'), RHS: $3}
     }
     | value '*' value
     {
-        $$ = Arithmetic{LHS: $1, Operator: int('*'), RHS: $3}
+        $$ = Arithmetic{BaseExpr: NewBaseExpr($2), LHS: $1, Operator: int('*'), RHS: $3}
     }
     | value '/' value
     {
-        $$ = Arithmetic{LHS: $1, Operator: int('/'), RHS: $3}
+        $$ = Arithmetic{BaseExpr: NewBaseExpr($2), LHS: $1, Operator: int('/'), RHS: $3}
     }
     | value '%' value
     {
-        $$ = Arithmetic{LHS: $1, Operator: int('%'), RHS: $3}
+        $$ = Arithmetic{BaseExpr: NewBaseExpr($2), LHS: $1, Operator: int('%'), RHS: $3}
     }
     | '-' value %prec UMINUS
     {
-        $$ = UnaryArithmetic{Operand: $2, Operator: $1}
+        $$ = UnaryArithmetic{BaseExpr: NewBaseExpr($1), Operand: $2, Operator: $1}
     }
     | '+' value %prec UPLUS
     {
-        $$ = UnaryArithmetic{Operand: $2, Operator: $1}
+        $$ = UnaryArithmetic{BaseExpr: NewBaseExpr($1), Operand: $2, Operator: $1}
     }
 
 logic
@@ -1515,6 +2025,15 @@ function
     {
         $$ = Function{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3}
     }
+    | identifier '.' identifier '(' arguments ')'
+    {
+        name := NewQualifiedIdentifier($1, $3)
+        $$ = Function{BaseExpr: name.BaseExpr, Name: name.Literal, Args: $5}
+    }
+    | identifier '(' arguments ')' WITHIN GROUP '(' order_by_clause ')'
+    {
+        $$ = AggregateFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, WithinGroup: $5.Literal + " " + $6.Literal, OrderBy: $8}
+    }
     | JSON_OBJECT '(' ')'
     {
         $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal}
@@ -1530,14 +2049,22 @@ function
 
 
 aggregate_function
-    : identifier '(' distinct arguments ')'
+    : identifier '(' DISTINCT arguments ')'
     {
         $$ = AggregateFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4}
     }
+    | identifier '(' DISTINCT arguments ')' WITHIN GROUP '(' order_by_clause ')'
+    {
+        $$ = AggregateFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4, WithinGroup: $6.Literal + " " + $7.Literal, OrderBy: $9}
+    }
     | AGGREGATE_FUNCTION '(' distinct arguments ')'
     {
         $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4}
     }
+    | AGGREGATE_FUNCTION '(' distinct arguments ')' WITHIN GROUP '(' order_by_clause ')'
+    {
+        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, WithinGroup: $6.Literal + " " + $7.Literal, OrderBy: $9}
+    }
     | COUNT '(' distinct arguments ')'
     {
         $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4}
@@ -1566,7 +2093,7 @@ analytic_function
     {
         $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $7.(AnalyticClause)}
     }
-    | identifier '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | identifier '(' DISTINCT arguments ')' OVER '(' analytic_clause_with_windowing ')'
     {
         $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
     }
@@ -1700,6 +2227,10 @@ table_identifier
     {
         $$ = $1
     }
+    | identifier '.' identifier
+    {
+        $$ = NewQualifiedIdentifier($1, $3)
+    }
     | STDIN
     {
         $$ = Stdin{BaseExpr: NewBaseExpr($1), Stdin: $1.Literal}
@@ -1732,6 +2263,38 @@ virtual_table_object
     {
         $$ = JsonQuery{BaseExpr: NewBaseExpr($1), JsonQuery: $1.Literal, Query: $3, JsonText: $5}
     }
+    | UNNEST '(' value ')'
+    {
+        $$ = Unnest{BaseExpr: NewBaseExpr($1), Unnest: $1.Literal, Value: $3}
+    }
+    | GENERATE_SERIES '(' value ',' value ')'
+    {
+        $$ = GenerateSeries{BaseExpr: NewBaseExpr($1), GenerateSeries: $1.Literal, Start: $3, Stop: $5}
+    }
+    | GENERATE_SERIES '(' value ',' value ',' value ')'
+    {
+        $$ = GenerateSeries{BaseExpr: NewBaseExpr($1), GenerateSeries: $1.Literal, Start: $3, Stop: $5, Step: $7}
+    }
+    | JSON_TABLE '(' value ',' value COLUMNS '(' json_table_columns ')' ')'
+    {
+        $$ = JsonTable{BaseExpr: NewBaseExpr($1), JsonTable: $1.Literal, JsonText: $3, Query: $5, Columns: $8}
+    }
+    | TABLE '(' qualified_identifier '(' arguments ')' ')'
+    {
+        $$ = TableFunction{BaseExpr: NewBaseExpr($1), Table: $1.Literal, Function: Function{BaseExpr: $3.BaseExpr, Name: $3.Literal, Args: $5}}
+    }
+    | TAIL identifier
+    {
+        $$ = TailTable{BaseExpr: NewBaseExpr($1), Tail: $1.Literal, Path: $2}
+    }
+    | TAIL STRING
+    {
+        $$ = TailTable{BaseExpr: NewBaseExpr($1), Tail: $1.Literal, Path: Identifier{BaseExpr: NewBaseExpr($2), Literal: $2.Literal, Quoted: true}}
+    }
+    | identifier AT value %prec SUBSTITUTION_OP
+    {
+        $$ = RevisionTable{BaseExpr: $1.BaseExpr, Table: $1, At: $2.Literal, Revision: $3}
+    }
     | identifier '(' identifier ')'
     {
         $$ = TableObject{BaseExpr: $1.BaseExpr, Type: $1, Path: $3, Args: nil}
@@ -1740,6 +2303,14 @@ virtual_table_object
     {
         $$ = TableObject{BaseExpr: $1.BaseExpr, Type: $1, Path: $3, Args: $5}
     }
+    | identifier '(' identifier ',' arguments WITH '(' table_object_options ')' ')'
+    {
+        $$ = TableObject{BaseExpr: $1.BaseExpr, Type: $1, Path: $3, Args: $5, Options: $8}
+    }
+    | identifier '(' value WITH '(' table_object_options ')' ')'
+    {
+        $$ = TableObject{BaseExpr: $1.BaseExpr, Type: $1, FormatElement: $3, Options: $6}
+    }
     | identifier '(' value ',' identifier ')'
     {
         $$ = TableObject{BaseExpr: $1.BaseExpr, Type: $1, FormatElement: $3, Path: $5, Args: nil}
@@ -1748,6 +2319,14 @@ virtual_table_object
     {
         $$ = TableObject{BaseExpr: $1.BaseExpr, Type: $1, FormatElement: $3, Path: $5, Args: $7}
     }
+    | identifier '(' value ',' identifier WITH '(' table_object_options ')' ')'
+    {
+        $$ = TableObject{BaseExpr: $1.BaseExpr, Type: $1, FormatElement: $3, Path: $5, Options: $8}
+    }
+    | identifier '(' value ',' identifier ',' arguments WITH '(' table_object_options ')' ')'
+    {
+        $$ = TableObject{BaseExpr: $1.BaseExpr, Type: $1, FormatElement: $3, Path: $5, Args: $7, Options: $10}
+    }
 
 table
     : identified_table
@@ -1915,6 +2494,46 @@ operate_tables
         $$ = append([]QueryExpression{Table{Object: $1}}, $3...)
     }
 
+json_table_column
+    : identifier
+    {
+        $$ = JsonTableColumn{BaseExpr: $1.BaseExpr, Name: $1}
+    }
+    | identifier PATH value
+    {
+        $$ = JsonTableColumn{BaseExpr: $1.BaseExpr, Name: $1, Path: $3}
+    }
+
+json_table_columns
+    : json_table_column
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | json_table_column ',' json_table_columns
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
+table_object_option
+    : identifier
+    {
+        $$ = TableObjectOption{BaseExpr: $1.BaseExpr, Name: $1}
+    }
+    | identifier value
+    {
+        $$ = TableObjectOption{BaseExpr: $1.BaseExpr, Name: $1, Value: $2}
+    }
+
+table_object_options
+    : table_object_option
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | table_object_option ',' table_object_options
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
 identifiers
     : identifier
     {
@@ -2164,6 +2783,86 @@ identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | COLUMNS
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | PATH
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | AT
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | TYPE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | ANALYZE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | FILTER
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | TIME
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | ZONE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | MESSAGE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | EQUAL
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | INDEX
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+
+placeholder
+    : PLACEHOLDER
+    {
+        $$ = yylex.(*Lexer).newPlaceholder($1)
+    }
+
+replace_value
+    : value
+    {
+        $$ = $1
+    }
+    | value AS identifier
+    {
+        $$ = ReplaceValue{Value: $1, Name: $3}
+    }
+
+replace_values
+    : replace_value
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | replace_value ',' replace_values
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
+qualified_identifier
+    : identifier
+    {
+        $$ = $1
+    }
+    | identifier '.' identifier
+    {
+        $$ = NewQualifiedIdentifier($1, $3)
+    }
 
 variable
     : VARIABLE
@@ -2213,6 +2912,16 @@ environment_variable
         $$ = EnvironmentVariable{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Quoted: $1.Quoted}
     }
 
+environment_variables
+    : environment_variable
+    {
+        $$ = []EnvironmentVariable{$1}
+    }
+    | environment_variable ',' environment_variables
+    {
+        $$ = append([]EnvironmentVariable{$1}, $3...)
+    }
+
 runtime_information
     : RUNTIME_INFORMATION
     {
@@ -2322,4 +3031,24 @@ func Parse(s string, sourceFile string) ([]Statement, error) {
     l.Init(s, sourceFile)
     yyParse(l)
     return l.program, l.err
+}
+
+// ParsePreparedStatement parses the statements that may contain placeholders,
+// and returns the number of the positional placeholders in the statements.
+func ParsePreparedStatement(s string, sourceFile string) ([]Statement, int, error) {
+    l := new(Lexer)
+    l.Init(s, sourceFile)
+    yyParse(l)
+    return l.program, l.placeholderOrdinal, l.err
+}
+
+// ParseImmediateStatement parses the statements that may contain placeholders and identifier placeholders,
+// and returns the number of the positional placeholders in the statements.
+// Identifier placeholders are replaced with the identifiers returned by resolveIdentifier.
+func ParseImmediateStatement(s string, sourceFile string, resolveIdentifier IdentifierResolver) ([]Statement, int, error) {
+    l := new(Lexer)
+    l.Init(s, sourceFile)
+    l.resolveIdentifier = resolveIdentifier
+    yyParse(l)
+    return l.program, l.placeholderOrdinal, l.err
 }
\ No newline at end of file
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -234,6 +235,117 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select c1 from csv('table.txt' with (delimiter ';', noheader))",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: TableObject{
+								BaseExpr:      &BaseExpr{line: 1, char: 16},
+								Type:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "csv"},
+								FormatElement: NewStringValue("table.txt"),
+								Options: []QueryExpression{
+									TableObjectOption{
+										BaseExpr: &BaseExpr{line: 1, char: 38},
+										Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "delimiter"},
+										Value:    NewStringValue(";"),
+									},
+									TableObjectOption{
+										BaseExpr: &BaseExpr{line: 1, char: 53},
+										Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "noheader"},
+									},
+								},
+							},
+						},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select c1 from csv(',', `table.csv`, 'sjis' with (no_header false))",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: TableObject{
+								BaseExpr:      &BaseExpr{line: 1, char: 16},
+								Type:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "csv"},
+								FormatElement: NewStringValue(","),
+								Path:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "table.csv", Quoted: true},
+								Args:          []QueryExpression{NewStringValue("sjis")},
+								Options: []QueryExpression{
+									TableObjectOption{
+										BaseExpr: &BaseExpr{line: 1, char: 51},
+										Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "no_header"},
+										Value:    NewTernaryValueFromString("false"),
+									},
+								},
+							},
+						},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select c1 from ltsv(`table.ltsv` with (encoding 'sjis'))",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: TableObject{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "ltsv"},
+								FormatElement: FieldReference{
+									BaseExpr: &BaseExpr{line: 1, char: 21},
+									Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "table.ltsv", Quoted: true},
+								},
+								Options: []QueryExpression{
+									TableObjectOption{
+										BaseExpr: &BaseExpr{line: 1, char: 40},
+										Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "encoding"},
+										Value:    NewStringValue("sjis"),
+									},
+								},
+							},
+						},
+					}},
+				},
+			},
+		},
+	},
 	{
 		Input: "select c1 from json_table('key', `table.json`)",
 		Output: []Statement{
@@ -322,410 +434,376 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select 1 from table1, (select 2 from dual)",
+		Input: "select t.c1.k1, c1['k1'].k2 from t",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
-					FromClause: FromClause{
-						From: "from",
-						Tables: []QueryExpression{
-							Table{
-								Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"},
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{
+								Object: MemberReference{
+									BaseExpr: &BaseExpr{line: 1, char: 13},
+									Value: FieldReference{
+										BaseExpr: &BaseExpr{line: 1, char: 8},
+										View:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "t"},
+										Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 10}, Literal: "c1"},
+									},
+									Member: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "k1"},
+								},
 							},
-							Table{
-								Object: Subquery{
-									BaseExpr: &BaseExpr{line: 1, char: 23},
-									Query: SelectQuery{
-										SelectEntity: SelectEntity{
-											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 24}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("2")}}},
-											FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
-										},
+							Field{
+								Object: MemberReference{
+									BaseExpr: &BaseExpr{line: 1, char: 26},
+									Value: ArrayElement{
+										BaseExpr: &BaseExpr{line: 1, char: 19},
+										Array:    FieldReference{BaseExpr: &BaseExpr{line: 1, char: 17}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "c1"}},
+										Index:    NewStringValue("k1"),
 									},
+									Member: Identifier{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "k2"},
 								},
 							},
 						},
 					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "t"}},
+					}},
 				},
 			},
 		},
 	},
 	{
-		Input: "select 1 from table1 alias, (select 2 from dual) alias2",
+		Input: "select c1 collate binary from t order by c1 || c2 collate unicode desc",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
-					FromClause: FromClause{
-						From: "from",
-						Tables: []QueryExpression{
-							Table{
-								Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"},
-								Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "alias"},
-							},
-							Table{
-								Object: Subquery{
-									BaseExpr: &BaseExpr{line: 1, char: 29},
-									Query: SelectQuery{
-										SelectEntity: SelectEntity{
-											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 30}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("2")}}},
-											FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
-										},
-									},
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{
+								Object: Collate{
+									BaseExpr:  &BaseExpr{line: 1, char: 11},
+									Value:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+									Collate:   "collate",
+									Collation: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "binary"},
 								},
-								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 50}, Literal: "alias2"},
 							},
 						},
 					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "t"}},
+					}},
+				},
+				OrderByClause: OrderByClause{
+					OrderBy: "order by",
+					Items: []QueryExpression{
+						OrderItem{
+							Value: Concat{Items: []QueryExpression{
+								FieldReference{BaseExpr: &BaseExpr{line: 1, char: 42}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "c1"}},
+								Collate{
+									BaseExpr:  &BaseExpr{line: 1, char: 51},
+									Value:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 48}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 48}, Literal: "c2"}},
+									Collate:   "collate",
+									Collation: Identifier{BaseExpr: &BaseExpr{line: 1, char: 59}, Literal: "unicode"},
+								},
+							}},
+							Direction: Token{Token: DESC, Literal: "desc", Line: 1, Char: 67},
+						},
+					},
 				},
 			},
 		},
 	},
 	{
-		Input: "select 1 from table1 as alias, (select 2 from dual) as alias2",
+		Input: "select time at time zone 'UTC' || zone from t",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
-					FromClause: FromClause{
-						From: "from",
-						Tables: []QueryExpression{
-							Table{
-								Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"},
-								As:     "as",
-								Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "alias"},
-							},
-							Table{
-								Object: Subquery{
-									BaseExpr: &BaseExpr{line: 1, char: 32},
-									Query: SelectQuery{
-										SelectEntity: SelectEntity{
-											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 33}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("2")}}},
-											FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
-										},
-									},
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Concat{Items: []QueryExpression{
+								AtTimeZone{
+									BaseExpr:   &BaseExpr{line: 1, char: 13},
+									Value:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "time"}},
+									AtTimeZone: "at time zone",
+									TimeZone:   NewStringValue("UTC"),
 								},
-								As:    "as",
-								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 56}, Literal: "alias2"},
-							},
+								FieldReference{BaseExpr: &BaseExpr{line: 1, char: 35}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "zone"}},
+							}}},
 						},
 					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 45}, Literal: "t"}},
+					}},
 				},
 			},
 		},
 	},
 	{
-		Input: "select 1 \r\n" +
-			" from dual \n" +
-			" where 1 = 1 \n" +
-			" group by column1, column2 \n" +
-			" having 1 > 1 \n" +
-			" order by column4, \n" +
-			"          column5 desc, \n" +
-			"          column6 asc, \n" +
-			"          column7 nulls first, \n" +
-			"          column8 desc nulls last, \n" +
-			"          rank() over () \n" +
-			" limit 10 \n" +
-			" offset 10 \n",
+		Input: "select c1 from t order by c1 collate natural",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
-					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
-					WhereClause: WhereClause{
-						Where: "where",
-						Filter: Comparison{
-							LHS:      NewIntegerValueFromString("1"),
-							Operator: "=",
-							RHS:      NewIntegerValueFromString("1"),
-						},
-					},
-					GroupByClause: GroupByClause{
-						GroupBy: "group by",
-						Items: []QueryExpression{
-							FieldReference{BaseExpr: &BaseExpr{line: 4, char: 11}, Column: Identifier{BaseExpr: &BaseExpr{line: 4, char: 11}, Literal: "column1"}},
-							FieldReference{BaseExpr: &BaseExpr{line: 4, char: 20}, Column: Identifier{BaseExpr: &BaseExpr{line: 4, char: 20}, Literal: "column2"}},
-						},
-					},
-					HavingClause: HavingClause{
-						Having: "having",
-						Filter: Comparison{
-							LHS:      NewIntegerValueFromString("1"),
-							Operator: ">",
-							RHS:      NewIntegerValueFromString("1"),
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}}},
 						},
 					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "t"}},
+					}},
 				},
 				OrderByClause: OrderByClause{
 					OrderBy: "order by",
 					Items: []QueryExpression{
-						OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 6, char: 11}, Column: Identifier{BaseExpr: &BaseExpr{line: 6, char: 11}, Literal: "column4"}}},
-						OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 7, char: 11}, Column: Identifier{BaseExpr: &BaseExpr{line: 7, char: 11}, Literal: "column5"}}, Direction: Token{Token: DESC, Literal: "desc", Line: 7, Char: 19}},
-						OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 8, char: 11}, Column: Identifier{BaseExpr: &BaseExpr{line: 8, char: 11}, Literal: "column6"}}, Direction: Token{Token: ASC, Literal: "asc", Line: 8, Char: 19}},
-						OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 9, char: 11}, Column: Identifier{BaseExpr: &BaseExpr{line: 9, char: 11}, Literal: "column7"}}, Nulls: "nulls", Position: Token{Token: FIRST, Literal: "first", Line: 9, Char: 25}},
-						OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 10, char: 11}, Column: Identifier{BaseExpr: &BaseExpr{line: 10, char: 11}, Literal: "column8"}}, Direction: Token{Token: DESC, Literal: "desc", Line: 10, Char: 19}, Nulls: "nulls", Position: Token{Token: LAST, Literal: "last", Line: 10, Char: 30}},
-						OrderItem{Value: AnalyticFunction{
-							BaseExpr: &BaseExpr{line: 11, char: 11},
-							Name:     "rank",
-							Over:     "over",
-							AnalyticClause: AnalyticClause{
-								PartitionClause: nil,
-								OrderByClause:   nil,
+						OrderItem{
+							Value: Collate{
+								BaseExpr:  &BaseExpr{line: 1, char: 30},
+								Value:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 27}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "c1"}},
+								Collate:   "collate",
+								Collation: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "natural"},
 							},
-						}},
+						},
 					},
 				},
-				LimitClause: LimitClause{
-					BaseExpr: &BaseExpr{line: 12, char: 2},
-					Limit:    "limit",
-					Value:    NewIntegerValueFromString("10"),
-				},
-				OffsetClause: OffsetClause{
-					BaseExpr: &BaseExpr{line: 13, char: 2},
-					Offset:   "offset",
-					Value:    NewIntegerValueFromString("10"),
-				},
 			},
 		},
 	},
 	{
-		Input: "select 1 \n" +
-			" from dual \n" +
-			" limit 10 percent",
+		Input: "select [1, 'a'][0], [] from unnest(c1) as u",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
-					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
-				},
-				LimitClause: LimitClause{
-					BaseExpr: &BaseExpr{line: 3, char: 2},
-					Limit:    "limit",
-					Value:    NewIntegerValueFromString("10"),
-					Percent:  "percent",
-				},
-			},
-		},
-	},
-	{
-		Input: "select 1 \n" +
-			" from dual \n" +
-			" limit 10 with ties",
-		Output: []Statement{
-			SelectQuery{
-				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
-					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
-				},
-				LimitClause: LimitClause{
-					BaseExpr: &BaseExpr{line: 3, char: 2},
-					Limit:    "limit",
-					Value:    NewIntegerValueFromString("10"),
-					With:     LimitWith{With: "with", Type: Token{Token: TIES, Literal: "ties", Line: 3, Char: 16}},
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{
+								Object: ArrayElement{
+									BaseExpr: &BaseExpr{line: 1, char: 16},
+									Array: ArrayValue{
+										BaseExpr: &BaseExpr{line: 1, char: 8},
+										Values: []QueryExpression{
+											NewIntegerValueFromString("1"),
+											NewStringValue("a"),
+										},
+									},
+									Index: NewIntegerValueFromString("0"),
+								},
+							},
+							Field{
+								Object: ArrayValue{BaseExpr: &BaseExpr{line: 1, char: 21}},
+							},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: Unnest{
+								BaseExpr: &BaseExpr{line: 1, char: 29},
+								Unnest:   "unnest",
+								Value:    FieldReference{BaseExpr: &BaseExpr{line: 1, char: 36}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "c1"}},
+							},
+							As:    "as",
+							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "u"},
+						},
+					}},
 				},
 			},
 		},
 	},
 	{
-		Input: "select distinct * from dual",
+		Input: "select * from generate_series(1, 10) as n",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
 					SelectClause: SelectClause{
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
-						Distinct: Token{Token: DISTINCT, Literal: "distinct", Line: 1, Char: 8},
-						Fields: []QueryExpression{
-							Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 17}}},
-						},
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 8}}}},
 					},
-					FromClause: FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: GenerateSeries{
+								BaseExpr:       &BaseExpr{line: 1, char: 15},
+								GenerateSeries: "generate_series",
+								Start:          NewIntegerValueFromString("1"),
+								Stop:           NewIntegerValueFromString("10"),
+							},
+							As:    "as",
+							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "n"},
+						},
+					}},
 				},
 			},
 		},
 	},
 	{
-		Input: "with ct as (select 1) select * from ct",
+		Input: "select * from generate_series(10, 1, '-2')",
 		Output: []Statement{
 			SelectQuery{
-				WithClause: WithClause{
-					With: "with",
-					InlineTables: []QueryExpression{
-						InlineTable{
-							Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "ct"},
-							As:   "as",
-							Query: SelectQuery{
-								SelectEntity: SelectEntity{
-									SelectClause: SelectClause{
-										BaseExpr: &BaseExpr{line: 1, char: 13},
-										Select:   "select",
-										Fields: []QueryExpression{
-											Field{Object: NewIntegerValueFromString("1")},
-										},
-									},
-								},
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 8}}}},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: GenerateSeries{
+								BaseExpr:       &BaseExpr{line: 1, char: 15},
+								GenerateSeries: "generate_series",
+								Start:          NewIntegerValueFromString("10"),
+								Stop:           NewIntegerValueFromString("1"),
+								Step:           NewStringValue("-2"),
 							},
 						},
-					},
+					}},
 				},
+			},
+		},
+	},
+	{
+		Input: "select * from tail 'events.csv' as e",
+		Output: []Statement{
+			SelectQuery{
 				SelectEntity: SelectEntity{
 					SelectClause: SelectClause{
-						BaseExpr: &BaseExpr{line: 1, char: 23},
+						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
-						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 30}}}},
-					},
-					FromClause: FromClause{
-						From:   "from",
-						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "ct"}}},
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 8}}}},
 					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: TailTable{
+								BaseExpr: &BaseExpr{line: 1, char: 15},
+								Tail:     "tail",
+								Path:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "events.csv", Quoted: true},
+							},
+							As:    "as",
+							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "e"},
+						},
+					}},
 				},
 			},
 		},
 	},
 	{
-		Input: "with ct (column1) as (select 1) select * from ct",
+		Input: "select * from table(func1(1)) as t",
 		Output: []Statement{
 			SelectQuery{
-				WithClause: WithClause{
-					With: "with",
-					InlineTables: []QueryExpression{
-						InlineTable{
-							Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "ct"},
-							Fields: []QueryExpression{
-								Identifier{BaseExpr: &BaseExpr{line: 1, char: 10}, Literal: "column1"},
-							},
-							As: "as",
-							Query: SelectQuery{
-								SelectEntity: SelectEntity{
-									SelectClause: SelectClause{
-										BaseExpr: &BaseExpr{line: 1, char: 23},
-										Select:   "select",
-										Fields: []QueryExpression{
-											Field{Object: NewIntegerValueFromString("1")},
-										},
-									},
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 8}}}},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: TableFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 15},
+								Table:    "table",
+								Function: Function{
+									BaseExpr: &BaseExpr{line: 1, char: 21},
+									Name:     "func1",
+									Args:     []QueryExpression{NewIntegerValueFromString("1")},
 								},
 							},
+							As:    "as",
+							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "t"},
 						},
-					},
+					}},
 				},
+			},
+		},
+	},
+	{
+		Input: "select * from util.tbl as t",
+		Output: []Statement{
+			SelectQuery{
 				SelectEntity: SelectEntity{
 					SelectClause: SelectClause{
-						BaseExpr: &BaseExpr{line: 1, char: 33},
+						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
-						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 40}}}},
-					},
-					FromClause: FromClause{
-						From:   "from",
-						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "ct"}}},
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 8}}}},
 					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "util.tbl"},
+							As:     "as",
+							Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "t"},
+						},
+					}},
 				},
 			},
 		},
 	},
 	{
-		Input: "with recursive ct as (select 1), ct2 as (select 2) select * from ct",
+		Input: "select * from estimate",
 		Output: []Statement{
 			SelectQuery{
-				WithClause: WithClause{
-					With: "with",
-					InlineTables: []QueryExpression{
-						InlineTable{
-							Name:      Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "ct"},
-							Recursive: Token{Token: RECURSIVE, Literal: "recursive", Line: 1, Char: 6},
-							As:        "as",
-							Query: SelectQuery{
-								SelectEntity: SelectEntity{
-									SelectClause: SelectClause{
-										BaseExpr: &BaseExpr{line: 1, char: 23},
-										Select:   "select",
-										Fields: []QueryExpression{
-											Field{Object: NewIntegerValueFromString("1")},
-										},
-									},
-								},
-							},
-						},
-						InlineTable{
-							Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "ct2"},
-							As:   "as",
-							Query: SelectQuery{
-								SelectEntity: SelectEntity{
-									SelectClause: SelectClause{
-										BaseExpr: &BaseExpr{line: 1, char: 42},
-										Select:   "select",
-										Fields: []QueryExpression{
-											Field{Object: NewIntegerValueFromString("2")},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
 				SelectEntity: SelectEntity{
 					SelectClause: SelectClause{
-						BaseExpr: &BaseExpr{line: 1, char: 52},
+						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
-						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 59}}}},
-					},
-					FromClause: FromClause{
-						From:   "from",
-						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 66}, Literal: "ct"}}},
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 8}}}},
 					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "estimate"},
+						},
+					}},
 				},
 			},
 		},
 	},
 	{
-		Input: "select ident, tbl.3, 'foo', 1, 1.234, true, '2010-01-01 12:00:00', null, ('bar') from dual",
+		Input: "select * from kw unique",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
 					SelectClause: SelectClause{
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
-						Fields: []QueryExpression{
-							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "ident"}}},
-							Field{Object: ColumnNumber{BaseExpr: &BaseExpr{line: 1, char: 15}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "tbl"}, Number: value.NewInteger(3)}},
-							Field{Object: NewStringValue("foo")},
-							Field{Object: NewIntegerValueFromString("1")},
-							Field{Object: NewFloatValueFromString("1.234")},
-							Field{Object: NewTernaryValueFromString("true")},
-							Field{Object: NewDatetimeValueFromString("2010-01-01 12:00:00")},
-							Field{Object: NewNullValueFromString("null")},
-							Field{Object: Parentheses{Expr: NewStringValue("bar")}},
-						},
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 8}}}},
 					},
-					FromClause: FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "kw"},
+							Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "unique"},
+						},
+					}},
 				},
 			},
 		},
 	},
 	{
-		Input: "select foo, \n" +
-			" bar.foo, \n" +
-			" stdin.foo, \n" +
-			" bar.3, \n" +
-			" stdin.3",
+		Input: "select * from kw auto_increment",
 		Output: []Statement{
-			SelectQuery{SelectEntity: SelectEntity{
-				SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1},
-					Select: "select",
-					Fields: []QueryExpression{
-						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "foo"}}},
-						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 2, char: 2}, View: Identifier{BaseExpr: &BaseExpr{line: 2, char: 2}, Literal: "bar"}, Column: Identifier{BaseExpr: &BaseExpr{line: 2, char: 6}, Literal: "foo"}}},
-						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 3, char: 2}, View: Identifier{BaseExpr: &BaseExpr{line: 3, char: 2}, Literal: "stdin"}, Column: Identifier{BaseExpr: &BaseExpr{line: 3, char: 8}, Literal: "foo"}}},
-						Field{Object: ColumnNumber{BaseExpr: &BaseExpr{line: 4, char: 2}, View: Identifier{BaseExpr: &BaseExpr{line: 4, char: 2}, Literal: "bar"}, Number: value.NewInteger(3)}},
-						Field{Object: ColumnNumber{BaseExpr: &BaseExpr{line: 5, char: 2}, View: Identifier{BaseExpr: &BaseExpr{line: 5, char: 2}, Literal: "stdin"}, Number: value.NewInteger(3)}},
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 8}}}},
 					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "kw"},
+							Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "auto_increment"},
+						},
+					}},
 				},
-			}},
+			},
 		},
 	},
 	{
-		Input: "select ident || 'foo' || 'bar'",
+		Input: "select at.v from kw at",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -733,87 +811,109 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Concat{Items: []QueryExpression{
-								FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "ident"}},
-								NewStringValue("foo"),
-								NewStringValue("bar"),
-							}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "at"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 11}, Literal: "v"}}},
 						},
 					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "kw"},
+							Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "at"},
+						},
+					}},
 				},
 			},
 		},
 	},
 	{
-		Input: "select column1 = 1",
+		Input: "select * from data at 'HEAD~3' as d",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
 					SelectClause: SelectClause{
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
-						Fields: []QueryExpression{
-							Field{Object: Comparison{
-								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-								Operator: "=",
-								RHS:      NewIntegerValueFromString("1"),
-							}},
-						},
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 8}}}},
 					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: RevisionTable{
+								BaseExpr: &BaseExpr{line: 1, char: 15},
+								Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "data"},
+								At:       "at",
+								Revision: NewStringValue("HEAD~3"),
+							},
+							As:    "as",
+							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "d"},
+						},
+					}},
 				},
 			},
 		},
 	},
 	{
-		Input: "select (column1, column2) = (1, 2)",
+		Input: "select * from json_table(c1, 'items' columns (id, city path 'address.city', path)) as j",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
 					SelectClause: SelectClause{
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
-						Fields: []QueryExpression{
-							Field{Object: Comparison{
-								LHS: RowValue{
-									BaseExpr: &BaseExpr{line: 1, char: 8},
-									Value: ValueList{
-										Values: []QueryExpression{
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "column1"}},
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column2"}},
-										},
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 8}}}},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: JsonTable{
+								BaseExpr:  &BaseExpr{line: 1, char: 15},
+								JsonTable: "json_table",
+								JsonText:  FieldReference{BaseExpr: &BaseExpr{line: 1, char: 26}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "c1"}},
+								Query:     NewStringValue("items"),
+								Columns: []QueryExpression{
+									JsonTableColumn{
+										BaseExpr: &BaseExpr{line: 1, char: 47},
+										Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "id"},
 									},
-								},
-								Operator: "=",
-								RHS: RowValue{
-									BaseExpr: &BaseExpr{line: 1, char: 29},
-									Value: ValueList{
-										Values: []QueryExpression{
-											NewIntegerValueFromString("1"),
-											NewIntegerValueFromString("2"),
-										},
+									JsonTableColumn{
+										BaseExpr: &BaseExpr{line: 1, char: 51},
+										Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "city"},
+										Path:     NewStringValue("address.city"),
+									},
+									JsonTableColumn{
+										BaseExpr: &BaseExpr{line: 1, char: 77},
+										Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 77}, Literal: "path"},
 									},
 								},
-							}},
+							},
+							As:    "as",
+							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 87}, Literal: "j"},
 						},
-					},
+					}},
 				},
 			},
 		},
 	},
 	{
-		Input: "select column1 < 1",
+		Input: "select 1 from table1, (select 2 from dual)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{
-						BaseExpr: &BaseExpr{line: 1, char: 1},
-						Select:   "select",
-						Fields: []QueryExpression{
-							Field{Object: Comparison{
-								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-								Operator: "<",
-								RHS:      NewIntegerValueFromString("1"),
-							}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"},
+							},
+							Table{
+								Object: Subquery{
+									BaseExpr: &BaseExpr{line: 1, char: 23},
+									Query: SelectQuery{
+										SelectEntity: SelectEntity{
+											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 24}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("2")}}},
+											FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
+										},
+									},
+								},
+							},
 						},
 					},
 				},
@@ -821,44 +921,63 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select (column1, column2) < (select 1, 2)",
+		Input: "select 1 from table1 alias, (select 2 from dual) alias2",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{
-						BaseExpr: &BaseExpr{line: 1, char: 1},
-						Select:   "select",
-						Fields: []QueryExpression{
-							Field{Object: Comparison{
-								LHS: RowValue{
-									BaseExpr: &BaseExpr{line: 1, char: 8},
-									Value: ValueList{
-										Values: []QueryExpression{
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "column1"}},
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column2"}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"},
+								Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "alias"},
+							},
+							Table{
+								Object: Subquery{
+									BaseExpr: &BaseExpr{line: 1, char: 29},
+									Query: SelectQuery{
+										SelectEntity: SelectEntity{
+											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 30}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("2")}}},
+											FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 										},
 									},
 								},
-								Operator: "<",
-								RHS: RowValue{
-									BaseExpr: &BaseExpr{line: 1, char: 29},
-									Value: Subquery{
-										BaseExpr: &BaseExpr{line: 1, char: 29},
-										Query: SelectQuery{
-											SelectEntity: SelectEntity{
-												SelectClause: SelectClause{
-													BaseExpr: &BaseExpr{line: 1, char: 30},
-													Select:   "select",
-													Fields: []QueryExpression{
-														Field{Object: NewIntegerValueFromString("1")},
-														Field{Object: NewIntegerValueFromString("2")},
-													},
-												},
-											},
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 50}, Literal: "alias2"},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from table1 as alias, (select 2 from dual) as alias2",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"},
+								As:     "as",
+								Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "alias"},
+							},
+							Table{
+								Object: Subquery{
+									BaseExpr: &BaseExpr{line: 1, char: 32},
+									Query: SelectQuery{
+										SelectEntity: SelectEntity{
+											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 33}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("2")}}},
+											FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 										},
 									},
 								},
-							}},
+								As:    "as",
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 56}, Literal: "alias2"},
+							},
 						},
 					},
 				},
@@ -866,215 +985,293 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select column1 is not null",
+		Input: "select 1 \r\n" +
+			" from dual \n" +
+			" where 1 = 1 \n" +
+			" group by column1, column2 \n" +
+			" having 1 > 1 \n" +
+			" order by column4, \n" +
+			"          column5 desc, \n" +
+			"          column6 asc, \n" +
+			"          column7 nulls first, \n" +
+			"          column8 desc nulls last, \n" +
+			"          rank() over () \n" +
+			" limit 10 \n" +
+			" offset 10 \n",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{
-						BaseExpr: &BaseExpr{line: 1, char: 1},
-						Select:   "select",
-						Fields: []QueryExpression{
-							Field{Object: Is{
-								Is:       "is",
-								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-								RHS:      NewNullValueFromString("null"),
-								Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 19},
-							}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
+					WhereClause: WhereClause{
+						Where: "where",
+						Filter: Comparison{
+							LHS:      NewIntegerValueFromString("1"),
+							Operator: "=",
+							RHS:      NewIntegerValueFromString("1"),
+						},
+					},
+					GroupByClause: GroupByClause{
+						GroupBy: "group by",
+						Items: []QueryExpression{
+							FieldReference{BaseExpr: &BaseExpr{line: 4, char: 11}, Column: Identifier{BaseExpr: &BaseExpr{line: 4, char: 11}, Literal: "column1"}},
+							FieldReference{BaseExpr: &BaseExpr{line: 4, char: 20}, Column: Identifier{BaseExpr: &BaseExpr{line: 4, char: 20}, Literal: "column2"}},
+						},
+					},
+					HavingClause: HavingClause{
+						Having: "having",
+						Filter: Comparison{
+							LHS:      NewIntegerValueFromString("1"),
+							Operator: ">",
+							RHS:      NewIntegerValueFromString("1"),
 						},
 					},
 				},
+				OrderByClause: OrderByClause{
+					OrderBy: "order by",
+					Items: []QueryExpression{
+						OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 6, char: 11}, Column: Identifier{BaseExpr: &BaseExpr{line: 6, char: 11}, Literal: "column4"}}},
+						OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 7, char: 11}, Column: Identifier{BaseExpr: &BaseExpr{line: 7, char: 11}, Literal: "column5"}}, Direction: Token{Token: DESC, Literal: "desc", Line: 7, Char: 19}},
+						OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 8, char: 11}, Column: Identifier{BaseExpr: &BaseExpr{line: 8, char: 11}, Literal: "column6"}}, Direction: Token{Token: ASC, Literal: "asc", Line: 8, Char: 19}},
+						OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 9, char: 11}, Column: Identifier{BaseExpr: &BaseExpr{line: 9, char: 11}, Literal: "column7"}}, Nulls: "nulls", Position: Token{Token: FIRST, Literal: "first", Line: 9, Char: 25}},
+						OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 10, char: 11}, Column: Identifier{BaseExpr: &BaseExpr{line: 10, char: 11}, Literal: "column8"}}, Direction: Token{Token: DESC, Literal: "desc", Line: 10, Char: 19}, Nulls: "nulls", Position: Token{Token: LAST, Literal: "last", Line: 10, Char: 30}},
+						OrderItem{Value: AnalyticFunction{
+							BaseExpr: &BaseExpr{line: 11, char: 11},
+							Name:     "rank",
+							Over:     "over",
+							AnalyticClause: AnalyticClause{
+								PartitionClause: nil,
+								OrderByClause:   nil,
+							},
+						}},
+					},
+				},
+				LimitClause: LimitClause{
+					BaseExpr: &BaseExpr{line: 12, char: 2},
+					Limit:    "limit",
+					Value:    NewIntegerValueFromString("10"),
+				},
+				OffsetClause: OffsetClause{
+					BaseExpr: &BaseExpr{line: 13, char: 2},
+					Offset:   "offset",
+					Value:    NewIntegerValueFromString("10"),
+				},
 			},
 		},
 	},
 	{
-		Input: "select column1 is true",
+		Input: "select 1 \n" +
+			" from dual \n" +
+			" limit 10 percent",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
+				},
+				LimitClause: LimitClause{
+					BaseExpr: &BaseExpr{line: 3, char: 2},
+					Limit:    "limit",
+					Value:    NewIntegerValueFromString("10"),
+					Percent:  "percent",
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 \n" +
+			" from dual \n" +
+			" limit 10 with ties",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
+				},
+				LimitClause: LimitClause{
+					BaseExpr: &BaseExpr{line: 3, char: 2},
+					Limit:    "limit",
+					Value:    NewIntegerValueFromString("10"),
+					With:     LimitWith{With: "with", Type: Token{Token: TIES, Literal: "ties", Line: 3, Char: 16}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select distinct * from dual",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
 					SelectClause: SelectClause{
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
+						Distinct: Token{Token: DISTINCT, Literal: "distinct", Line: 1, Char: 8},
 						Fields: []QueryExpression{
-							Field{Object: Is{
-								Is:  "is",
-								LHS: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-								RHS: NewTernaryValueFromString("true"),
-							}},
+							Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 17}}},
 						},
 					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 				},
 			},
 		},
 	},
 	{
-		Input: "select column1 not between -10 and +10 or column2 between 20 and 30",
+		Input: "select /*+ hash_join(t1, t2) no_parallel */ distinct * from dual",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
 					SelectClause: SelectClause{
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
+						Hints: []Hint{
+							{Name: "HASH_JOIN", Args: []string{"t1", "t2"}},
+							{Name: "NO_PARALLEL"},
+						},
+						Distinct: Token{Token: DISTINCT, Literal: "distinct", Line: 1, Char: 45},
 						Fields: []QueryExpression{
-							Field{Object: Logic{
-								LHS: Between{
-									Between: "between",
-									And:     "and",
-									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-									Low: UnaryArithmetic{
-										Operand:  NewIntegerValueFromString("10"),
-										Operator: Token{Token: '-', Literal: "-", Line: 1, Char: 28},
-									},
-									High: UnaryArithmetic{
-										Operand:  NewIntegerValueFromString("10"),
-										Operator: Token{Token: '+', Literal: "+", Line: 1, Char: 36},
-									},
-									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 16},
-								},
-								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 40},
-								RHS: Between{
-									Between: "between",
-									And:     "and",
-									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 43}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "column2"}},
-									Low:     NewIntegerValueFromString("20"),
-									High:    NewIntegerValueFromString("30"),
-								},
-							}},
+							Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 54}}},
 						},
 					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 				},
 			},
 		},
 	},
 	{
-		Input: "select (column1, column2) not between (1, 2) and (3, 4) and (column3, column4) between (5, 6) and (7, 8)",
+		Input: "with ct as (select 1) select * from ct",
 		Output: []Statement{
 			SelectQuery{
-				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{
-						BaseExpr: &BaseExpr{line: 1, char: 1},
-						Select:   "select",
-						Fields: []QueryExpression{
-							Field{Object: Logic{
-								LHS: Between{
-									Between: "between",
-									And:     "and",
-									LHS: RowValue{
-										BaseExpr: &BaseExpr{line: 1, char: 8},
-										Value: ValueList{
-											Values: []QueryExpression{
-												FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "column1"}},
-												FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column2"}},
-											},
-										},
-									},
-									Low: RowValue{
-										BaseExpr: &BaseExpr{line: 1, char: 39},
-										Value: ValueList{
-											Values: []QueryExpression{
-												NewIntegerValueFromString("1"),
-												NewIntegerValueFromString("2"),
-											},
-										},
-									},
-									High: RowValue{
-										BaseExpr: &BaseExpr{line: 1, char: 50},
-										Value: ValueList{
-											Values: []QueryExpression{
-												NewIntegerValueFromString("3"),
-												NewIntegerValueFromString("4"),
-											},
-										},
-									},
-									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 27},
-								},
-								RHS: Between{
-									Between: "between",
-									And:     "and",
-									LHS: RowValue{
-										BaseExpr: &BaseExpr{line: 1, char: 61},
-										Value: ValueList{
-											Values: []QueryExpression{
-												FieldReference{BaseExpr: &BaseExpr{line: 1, char: 62}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 62}, Literal: "column3"}},
-												FieldReference{BaseExpr: &BaseExpr{line: 1, char: 71}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 71}, Literal: "column4"}},
-											},
-										},
-									},
-									Low: RowValue{
-										BaseExpr: &BaseExpr{line: 1, char: 88},
-										Value: ValueList{
-											Values: []QueryExpression{
-												NewIntegerValueFromString("5"),
-												NewIntegerValueFromString("6"),
-											},
-										},
-									},
-									High: RowValue{
-										BaseExpr: &BaseExpr{line: 1, char: 99},
-										Value: ValueList{
-											Values: []QueryExpression{
-												NewIntegerValueFromString("7"),
-												NewIntegerValueFromString("8"),
-											},
+				WithClause: WithClause{
+					With: "with",
+					InlineTables: []QueryExpression{
+						InlineTable{
+							Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "ct"},
+							As:   "as",
+							Query: SelectQuery{
+								SelectEntity: SelectEntity{
+									SelectClause: SelectClause{
+										BaseExpr: &BaseExpr{line: 1, char: 13},
+										Select:   "select",
+										Fields: []QueryExpression{
+											Field{Object: NewIntegerValueFromString("1")},
 										},
 									},
 								},
-								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 57},
-							}},
+							},
 						},
 					},
 				},
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 23},
+						Select:   "select",
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 30}}}},
+					},
+					FromClause: FromClause{
+						From:   "from",
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "ct"}}},
+					},
+				},
 			},
 		},
 	},
 	{
-		Input: "select column1 not in (1, 2, 3) and column2 in (4, 5, 6)",
+		Input: "with ct (column1) as (select 1) select * from ct",
 		Output: []Statement{
 			SelectQuery{
+				WithClause: WithClause{
+					With: "with",
+					InlineTables: []QueryExpression{
+						InlineTable{
+							Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "ct"},
+							Fields: []QueryExpression{
+								Identifier{BaseExpr: &BaseExpr{line: 1, char: 10}, Literal: "column1"},
+							},
+							As: "as",
+							Query: SelectQuery{
+								SelectEntity: SelectEntity{
+									SelectClause: SelectClause{
+										BaseExpr: &BaseExpr{line: 1, char: 23},
+										Select:   "select",
+										Fields: []QueryExpression{
+											Field{Object: NewIntegerValueFromString("1")},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
 				SelectEntity: SelectEntity{
 					SelectClause: SelectClause{
-						BaseExpr: &BaseExpr{line: 1, char: 1},
+						BaseExpr: &BaseExpr{line: 1, char: 33},
 						Select:   "select",
-						Fields: []QueryExpression{
-							Field{Object: Logic{
-								LHS: In{
-									In:  "in",
-									LHS: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-									Values: RowValue{
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 40}}}},
+					},
+					FromClause: FromClause{
+						From:   "from",
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "ct"}}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "with recursive ct as (select 1), ct2 as (select 2) select * from ct",
+		Output: []Statement{
+			SelectQuery{
+				WithClause: WithClause{
+					With: "with",
+					InlineTables: []QueryExpression{
+						InlineTable{
+							Name:      Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "ct"},
+							Recursive: Token{Token: RECURSIVE, Literal: "recursive", Line: 1, Char: 6},
+							As:        "as",
+							Query: SelectQuery{
+								SelectEntity: SelectEntity{
+									SelectClause: SelectClause{
 										BaseExpr: &BaseExpr{line: 1, char: 23},
-										Value: ValueList{
-											Values: []QueryExpression{
-												NewIntegerValueFromString("1"),
-												NewIntegerValueFromString("2"),
-												NewIntegerValueFromString("3"),
-											},
+										Select:   "select",
+										Fields: []QueryExpression{
+											Field{Object: NewIntegerValueFromString("1")},
 										},
 									},
-									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 16},
 								},
-								RHS: In{
-									In:  "in",
-									LHS: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 37}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "column2"}},
-									Values: RowValue{
-										BaseExpr: &BaseExpr{line: 1, char: 48},
-										Value: ValueList{
-											Values: []QueryExpression{
-												NewIntegerValueFromString("4"),
-												NewIntegerValueFromString("5"),
-												NewIntegerValueFromString("6"),
-											},
+							},
+						},
+						InlineTable{
+							Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "ct2"},
+							As:   "as",
+							Query: SelectQuery{
+								SelectEntity: SelectEntity{
+									SelectClause: SelectClause{
+										BaseExpr: &BaseExpr{line: 1, char: 42},
+										Select:   "select",
+										Fields: []QueryExpression{
+											Field{Object: NewIntegerValueFromString("2")},
 										},
 									},
 								},
-								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 33},
-							}},
+							},
 						},
 					},
 				},
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 52},
+						Select:   "select",
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 59}}}},
+					},
+					FromClause: FromClause{
+						From:   "from",
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 66}, Literal: "ct"}}},
+					},
+				},
 			},
 		},
 	},
 	{
-		Input: "select column1 in json_row('key', '{\"key\":1}')",
+		Input: "select ident, tbl.3, 'foo', 1, 1.234, true, '2010-01-01 12:00:00', null, ('bar') from dual",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1082,68 +1279,57 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: In{
-								In:  "in",
-								LHS: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-								Values: RowValue{
-									BaseExpr: &BaseExpr{line: 1, char: 19},
-									Value: JsonQuery{
-										JsonQuery: "json_row",
-										Query:     NewStringValue("key"),
-										JsonText:  NewStringValue("{\"key\":1}"),
-									},
-								},
-							}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "ident"}}},
+							Field{Object: ColumnNumber{BaseExpr: &BaseExpr{line: 1, char: 15}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "tbl"}, Number: value.NewInteger(3)}},
+							Field{Object: NewStringValue("foo")},
+							Field{Object: NewIntegerValueFromString("1")},
+							Field{Object: NewFloatValueFromString("1.234")},
+							Field{Object: NewTernaryValueFromString("true")},
+							Field{Object: NewDatetimeValueFromString("2010-01-01 12:00:00")},
+							Field{Object: NewNullValueFromString("null")},
+							Field{Object: Parentheses{Expr: NewStringValue("bar")}},
 						},
 					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 				},
 			},
 		},
 	},
 	{
-		Input: "select (column1, column2) not in ((1, 2), (3, 4))",
+		Input: "select foo, \n" +
+			" bar.foo, \n" +
+			" stdin.foo, \n" +
+			" bar.3, \n" +
+			" stdin.3",
 		Output: []Statement{
-			SelectQuery{
+			SelectQuery{SelectEntity: SelectEntity{
+				SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1},
+					Select: "select",
+					Fields: []QueryExpression{
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "foo"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 2, char: 2}, View: Identifier{BaseExpr: &BaseExpr{line: 2, char: 2}, Literal: "bar"}, Column: Identifier{BaseExpr: &BaseExpr{line: 2, char: 6}, Literal: "foo"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 3, char: 2}, View: Identifier{BaseExpr: &BaseExpr{line: 3, char: 2}, Literal: "stdin"}, Column: Identifier{BaseExpr: &BaseExpr{line: 3, char: 8}, Literal: "foo"}}},
+						Field{Object: ColumnNumber{BaseExpr: &BaseExpr{line: 4, char: 2}, View: Identifier{BaseExpr: &BaseExpr{line: 4, char: 2}, Literal: "bar"}, Number: value.NewInteger(3)}},
+						Field{Object: ColumnNumber{BaseExpr: &BaseExpr{line: 5, char: 2}, View: Identifier{BaseExpr: &BaseExpr{line: 5, char: 2}, Literal: "stdin"}, Number: value.NewInteger(3)}},
+					},
+				},
+			}},
+		},
+	},
+	{
+		Input: "select ident || 'foo' || 'bar'",
+		Output: []Statement{
+			SelectQuery{
 				SelectEntity: SelectEntity{
 					SelectClause: SelectClause{
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: In{
-								In: "in",
-								LHS: RowValue{
-									BaseExpr: &BaseExpr{line: 1, char: 8},
-									Value: ValueList{
-										Values: []QueryExpression{
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "column1"}},
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column2"}},
-										},
-									},
-								},
-								Values: RowValueList{
-									RowValues: []QueryExpression{
-										RowValue{
-											BaseExpr: &BaseExpr{line: 1, char: 35},
-											Value: ValueList{
-												Values: []QueryExpression{
-													NewIntegerValueFromString("1"),
-													NewIntegerValueFromString("2"),
-												},
-											},
-										},
-										RowValue{
-											BaseExpr: &BaseExpr{line: 1, char: 43},
-											Value: ValueList{
-												Values: []QueryExpression{
-													NewIntegerValueFromString("3"),
-													NewIntegerValueFromString("4"),
-												},
-											},
-										},
-									},
-								},
-								Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 27},
-							}},
+							Field{Object: Concat{Items: []QueryExpression{
+								FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "ident"}},
+								NewStringValue("foo"),
+								NewStringValue("bar"),
+							}}},
 						},
 					},
 				},
@@ -1151,7 +1337,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select (column1, column2) in (select 1)",
+		Input: "select column1 = 1",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1159,25 +1345,10 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: In{
-								In: "in",
-								LHS: RowValue{
-									BaseExpr: &BaseExpr{line: 1, char: 8},
-									Value: ValueList{
-										Values: []QueryExpression{
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "column1"}},
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column2"}},
-										},
-									},
-								},
-								Values: Subquery{
-									BaseExpr: &BaseExpr{line: 1, char: 30},
-									Query: SelectQuery{
-										SelectEntity: SelectEntity{
-											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 31}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
-										},
-									},
-								},
+							Field{Object: Comparison{
+								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+								Operator: "=",
+								RHS:      NewIntegerValueFromString("1"),
 							}},
 						},
 					},
@@ -1186,7 +1357,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select (column1, column2) in json_row('key', '{\"key\":1}')",
+		Input: "select (column1, column2) = (1, 2)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1194,8 +1365,7 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: In{
-								In: "in",
+							Field{Object: Comparison{
 								LHS: RowValue{
 									BaseExpr: &BaseExpr{line: 1, char: 8},
 									Value: ValueList{
@@ -1205,11 +1375,15 @@ var parseTests = []struct {
 										},
 									},
 								},
-								Values: JsonQuery{
-									BaseExpr:  &BaseExpr{line: 1, char: 30},
-									JsonQuery: "json_row",
-									Query:     NewStringValue("key"),
-									JsonText:  NewStringValue("{\"key\":1}"),
+								Operator: "=",
+								RHS: RowValue{
+									BaseExpr: &BaseExpr{line: 1, char: 29},
+									Value: ValueList{
+										Values: []QueryExpression{
+											NewIntegerValueFromString("1"),
+											NewIntegerValueFromString("2"),
+										},
+									},
 								},
 							}},
 						},
@@ -1219,7 +1393,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select column1 not like 'pattern1' and column2 like 'pattern2'",
+		Input: "select column1 < 1",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1227,19 +1401,10 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Logic{
-								LHS: Like{
-									Like:     "like",
-									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-									Pattern:  NewStringValue("pattern1"),
-									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 16},
-								},
-								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 36},
-								RHS: Like{
-									Like:    "like",
-									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 40}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "column2"}},
-									Pattern: NewStringValue("pattern2"),
-								},
+							Field{Object: Comparison{
+								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+								Operator: "<",
+								RHS:      NewIntegerValueFromString("1"),
 							}},
 						},
 					},
@@ -1248,7 +1413,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select column1 like 'pattern1' or column2 not like 'pattern2'",
+		Input: "select (column1, column2) < (select 1, 2)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1256,46 +1421,31 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Logic{
-								LHS: Like{
-									Like:    "like",
-									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-									Pattern: NewStringValue("pattern1"),
-								},
-								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 32},
-								RHS: Like{
-									Like:     "like",
-									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 35}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "column2"}},
-									Pattern:  NewStringValue("pattern2"),
-									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 43},
+							Field{Object: Comparison{
+								LHS: RowValue{
+									BaseExpr: &BaseExpr{line: 1, char: 8},
+									Value: ValueList{
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "column1"}},
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column2"}},
+										},
+									},
 								},
-							}},
-						},
-					},
-				},
-			},
-		},
-	},
-	{
-		Input: "select column1 = any (select 1)",
-		Output: []Statement{
-			SelectQuery{
-				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{
-						BaseExpr: &BaseExpr{line: 1, char: 1},
-						Select:   "select",
-						Fields: []QueryExpression{
-							Field{Object: Any{
-								Any:      "any",
-								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-								Operator: "=",
-								Values: RowValue{
-									BaseExpr: &BaseExpr{line: 1, char: 22},
+								Operator: "<",
+								RHS: RowValue{
+									BaseExpr: &BaseExpr{line: 1, char: 29},
 									Value: Subquery{
-										BaseExpr: &BaseExpr{line: 1, char: 22},
+										BaseExpr: &BaseExpr{line: 1, char: 29},
 										Query: SelectQuery{
 											SelectEntity: SelectEntity{
-												SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 23}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+												SelectClause: SelectClause{
+													BaseExpr: &BaseExpr{line: 1, char: 30},
+													Select:   "select",
+													Fields: []QueryExpression{
+														Field{Object: NewIntegerValueFromString("1")},
+														Field{Object: NewIntegerValueFromString("2")},
+													},
+												},
 											},
 										},
 									},
@@ -1308,7 +1458,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select (column1, column2) = any ((1, 2), (3, 4))",
+		Input: "select column1 is not null",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1316,40 +1466,11 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Any{
-								Any: "any",
-								LHS: RowValue{
-									BaseExpr: &BaseExpr{line: 1, char: 8},
-									Value: ValueList{
-										Values: []QueryExpression{
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "column1"}},
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column2"}},
-										},
-									},
-								},
-								Operator: "=",
-								Values: RowValueList{
-									RowValues: []QueryExpression{
-										RowValue{
-											BaseExpr: &BaseExpr{line: 1, char: 34},
-											Value: ValueList{
-												Values: []QueryExpression{
-													NewIntegerValueFromString("1"),
-													NewIntegerValueFromString("2"),
-												},
-											},
-										},
-										RowValue{
-											BaseExpr: &BaseExpr{line: 1, char: 42},
-											Value: ValueList{
-												Values: []QueryExpression{
-													NewIntegerValueFromString("3"),
-													NewIntegerValueFromString("4"),
-												},
-											},
-										},
-									},
-								},
+							Field{Object: Is{
+								Is:       "is",
+								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+								RHS:      NewNullValueFromString("null"),
+								Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 19},
 							}},
 						},
 					},
@@ -1358,7 +1479,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select (column1, column2) = any (select 1)",
+		Input: "select column1 is true",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1366,26 +1487,10 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Any{
-								Any: "any",
-								LHS: RowValue{
-									BaseExpr: &BaseExpr{line: 1, char: 8},
-									Value: ValueList{
-										Values: []QueryExpression{
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "column1"}},
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column2"}},
-										},
-									},
-								},
-								Operator: "=",
-								Values: Subquery{
-									BaseExpr: &BaseExpr{line: 1, char: 33},
-									Query: SelectQuery{
-										SelectEntity: SelectEntity{
-											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 34}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
-										},
-									},
-								},
+							Field{Object: Is{
+								Is:  "is",
+								LHS: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+								RHS: NewTernaryValueFromString("true"),
 							}},
 						},
 					},
@@ -1394,7 +1499,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select column1 = all (select 1)",
+		Input: "select column1 not between -10 and +10 or column2 between 20 and 30",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1402,21 +1507,112 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: All{
-								All:      "all",
-								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-								Operator: "=",
-								Values: RowValue{
-									BaseExpr: &BaseExpr{line: 1, char: 22},
-									Value: Subquery{
-										BaseExpr: &BaseExpr{line: 1, char: 22},
-										Query: SelectQuery{
-											SelectEntity: SelectEntity{
-												SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 23}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+							Field{Object: Logic{
+								LHS: Between{
+									Between: "between",
+									And:     "and",
+									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Low: UnaryArithmetic{
+										BaseExpr: &BaseExpr{line: 1, char: 28},
+										Operand:  NewIntegerValueFromString("10"),
+										Operator: Token{Token: '-', Literal: "-", Line: 1, Char: 28},
+									},
+									High: UnaryArithmetic{
+										BaseExpr: &BaseExpr{line: 1, char: 36},
+										Operand:  NewIntegerValueFromString("10"),
+										Operator: Token{Token: '+', Literal: "+", Line: 1, Char: 36},
+									},
+									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 16},
+								},
+								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 40},
+								RHS: Between{
+									Between: "between",
+									And:     "and",
+									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 43}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "column2"}},
+									Low:     NewIntegerValueFromString("20"),
+									High:    NewIntegerValueFromString("30"),
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select (column1, column2) not between (1, 2) and (3, 4) and (column3, column4) between (5, 6) and (7, 8)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Logic{
+								LHS: Between{
+									Between: "between",
+									And:     "and",
+									LHS: RowValue{
+										BaseExpr: &BaseExpr{line: 1, char: 8},
+										Value: ValueList{
+											Values: []QueryExpression{
+												FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "column1"}},
+												FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column2"}},
+											},
+										},
+									},
+									Low: RowValue{
+										BaseExpr: &BaseExpr{line: 1, char: 39},
+										Value: ValueList{
+											Values: []QueryExpression{
+												NewIntegerValueFromString("1"),
+												NewIntegerValueFromString("2"),
+											},
+										},
+									},
+									High: RowValue{
+										BaseExpr: &BaseExpr{line: 1, char: 50},
+										Value: ValueList{
+											Values: []QueryExpression{
+												NewIntegerValueFromString("3"),
+												NewIntegerValueFromString("4"),
+											},
+										},
+									},
+									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 27},
+								},
+								RHS: Between{
+									Between: "between",
+									And:     "and",
+									LHS: RowValue{
+										BaseExpr: &BaseExpr{line: 1, char: 61},
+										Value: ValueList{
+											Values: []QueryExpression{
+												FieldReference{BaseExpr: &BaseExpr{line: 1, char: 62}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 62}, Literal: "column3"}},
+												FieldReference{BaseExpr: &BaseExpr{line: 1, char: 71}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 71}, Literal: "column4"}},
+											},
+										},
+									},
+									Low: RowValue{
+										BaseExpr: &BaseExpr{line: 1, char: 88},
+										Value: ValueList{
+											Values: []QueryExpression{
+												NewIntegerValueFromString("5"),
+												NewIntegerValueFromString("6"),
+											},
+										},
+									},
+									High: RowValue{
+										BaseExpr: &BaseExpr{line: 1, char: 99},
+										Value: ValueList{
+											Values: []QueryExpression{
+												NewIntegerValueFromString("7"),
+												NewIntegerValueFromString("8"),
 											},
 										},
 									},
 								},
+								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 57},
 							}},
 						},
 					},
@@ -1425,7 +1621,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select (column1, column2) = all ((1, 2), (3, 4))",
+		Input: "select column1 not in (1, 2, 3) and column2 in (4, 5, 6)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1433,8 +1629,82 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: All{
-								All: "all",
+							Field{Object: Logic{
+								LHS: In{
+									In:  "in",
+									LHS: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Values: RowValue{
+										BaseExpr: &BaseExpr{line: 1, char: 23},
+										Value: ValueList{
+											Values: []QueryExpression{
+												NewIntegerValueFromString("1"),
+												NewIntegerValueFromString("2"),
+												NewIntegerValueFromString("3"),
+											},
+										},
+									},
+									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 16},
+								},
+								RHS: In{
+									In:  "in",
+									LHS: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 37}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "column2"}},
+									Values: RowValue{
+										BaseExpr: &BaseExpr{line: 1, char: 48},
+										Value: ValueList{
+											Values: []QueryExpression{
+												NewIntegerValueFromString("4"),
+												NewIntegerValueFromString("5"),
+												NewIntegerValueFromString("6"),
+											},
+										},
+									},
+								},
+								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 33},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select column1 in json_row('key', '{\"key\":1}')",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: In{
+								In:  "in",
+								LHS: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+								Values: RowValue{
+									BaseExpr: &BaseExpr{line: 1, char: 19},
+									Value: JsonQuery{
+										JsonQuery: "json_row",
+										Query:     NewStringValue("key"),
+										JsonText:  NewStringValue("{\"key\":1}"),
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select (column1, column2) not in ((1, 2), (3, 4))",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: In{
+								In: "in",
 								LHS: RowValue{
 									BaseExpr: &BaseExpr{line: 1, char: 8},
 									Value: ValueList{
@@ -1444,11 +1714,10 @@ var parseTests = []struct {
 										},
 									},
 								},
-								Operator: "=",
 								Values: RowValueList{
 									RowValues: []QueryExpression{
 										RowValue{
-											BaseExpr: &BaseExpr{line: 1, char: 34},
+											BaseExpr: &BaseExpr{line: 1, char: 35},
 											Value: ValueList{
 												Values: []QueryExpression{
 													NewIntegerValueFromString("1"),
@@ -1457,7 +1726,7 @@ var parseTests = []struct {
 											},
 										},
 										RowValue{
-											BaseExpr: &BaseExpr{line: 1, char: 42},
+											BaseExpr: &BaseExpr{line: 1, char: 43},
 											Value: ValueList{
 												Values: []QueryExpression{
 													NewIntegerValueFromString("3"),
@@ -1467,6 +1736,7 @@ var parseTests = []struct {
 										},
 									},
 								},
+								Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 27},
 							}},
 						},
 					},
@@ -1475,7 +1745,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select (column1, column2) = all (select 1)",
+		Input: "select (column1, column2) in (select 1)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1483,8 +1753,8 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: All{
-								All: "all",
+							Field{Object: In{
+								In: "in",
 								LHS: RowValue{
 									BaseExpr: &BaseExpr{line: 1, char: 8},
 									Value: ValueList{
@@ -1494,12 +1764,11 @@ var parseTests = []struct {
 										},
 									},
 								},
-								Operator: "=",
 								Values: Subquery{
-									BaseExpr: &BaseExpr{line: 1, char: 33},
+									BaseExpr: &BaseExpr{line: 1, char: 30},
 									Query: SelectQuery{
 										SelectEntity: SelectEntity{
-											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 34}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 31}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
 										},
 									},
 								},
@@ -1511,7 +1780,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select exists (select 1)",
+		Input: "select (column1, column2) in json_row('key', '{\"key\":1}')",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1519,16 +1788,23 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Exists{
-								Exists: "exists",
-								Query: Subquery{
-									BaseExpr: &BaseExpr{line: 1, char: 15},
-									Query: SelectQuery{
-										SelectEntity: SelectEntity{
-											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 16}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+							Field{Object: In{
+								In: "in",
+								LHS: RowValue{
+									BaseExpr: &BaseExpr{line: 1, char: 8},
+									Value: ValueList{
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "column1"}},
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column2"}},
 										},
 									},
 								},
+								Values: JsonQuery{
+									BaseExpr:  &BaseExpr{line: 1, char: 30},
+									JsonQuery: "json_row",
+									Query:     NewStringValue("key"),
+									JsonText:  NewStringValue("{\"key\":1}"),
+								},
 							}},
 						},
 					},
@@ -1537,7 +1813,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select column1 + 1",
+		Input: "select column1 not like 'pattern1' and column2 like 'pattern2'",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1545,10 +1821,19 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Arithmetic{
-								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-								Operator: int('+'),
-								RHS:      NewIntegerValueFromString("1"),
+							Field{Object: Logic{
+								LHS: Like{
+									Like:     "like",
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Pattern:  NewStringValue("pattern1"),
+									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 16},
+								},
+								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 36},
+								RHS: Like{
+									Like:    "like",
+									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 40}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "column2"}},
+									Pattern: NewStringValue("pattern2"),
+								},
 							}},
 						},
 					},
@@ -1557,7 +1842,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select column1 - 1",
+		Input: "select column1 like 'pattern1' or column2 not like 'pattern2'",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1565,19 +1850,28 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Arithmetic{
-								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-								Operator: int('-'),
-								RHS:      NewIntegerValueFromString("1"),
-							}},
-						},
-					},
-				},
-			},
-		},
-	},
+							Field{Object: Logic{
+								LHS: Like{
+									Like:    "like",
+									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Pattern: NewStringValue("pattern1"),
+								},
+								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 32},
+								RHS: Like{
+									Like:     "like",
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 35}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "column2"}},
+									Pattern:  NewStringValue("pattern2"),
+									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 43},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
-		Input: "select column1 * 1",
+		Input: "select column1 = any (select 1)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1585,10 +1879,21 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Arithmetic{
+							Field{Object: Any{
+								Any:      "any",
 								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-								Operator: int('*'),
-								RHS:      NewIntegerValueFromString("1"),
+								Operator: "=",
+								Values: RowValue{
+									BaseExpr: &BaseExpr{line: 1, char: 22},
+									Value: Subquery{
+										BaseExpr: &BaseExpr{line: 1, char: 22},
+										Query: SelectQuery{
+											SelectEntity: SelectEntity{
+												SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 23}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+											},
+										},
+									},
+								},
 							}},
 						},
 					},
@@ -1597,7 +1902,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select column1 / 1",
+		Input: "select (column1, column2) = any ((1, 2), (3, 4))",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1605,10 +1910,40 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Arithmetic{
-								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-								Operator: int('/'),
-								RHS:      NewIntegerValueFromString("1"),
+							Field{Object: Any{
+								Any: "any",
+								LHS: RowValue{
+									BaseExpr: &BaseExpr{line: 1, char: 8},
+									Value: ValueList{
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "column1"}},
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column2"}},
+										},
+									},
+								},
+								Operator: "=",
+								Values: RowValueList{
+									RowValues: []QueryExpression{
+										RowValue{
+											BaseExpr: &BaseExpr{line: 1, char: 34},
+											Value: ValueList{
+												Values: []QueryExpression{
+													NewIntegerValueFromString("1"),
+													NewIntegerValueFromString("2"),
+												},
+											},
+										},
+										RowValue{
+											BaseExpr: &BaseExpr{line: 1, char: 42},
+											Value: ValueList{
+												Values: []QueryExpression{
+													NewIntegerValueFromString("3"),
+													NewIntegerValueFromString("4"),
+												},
+											},
+										},
+									},
+								},
 							}},
 						},
 					},
@@ -1617,7 +1952,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select column1 % 1",
+		Input: "select (column1, column2) = any (select 1)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1625,10 +1960,26 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Arithmetic{
-								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-								Operator: int('%'),
-								RHS:      NewIntegerValueFromString("1"),
+							Field{Object: Any{
+								Any: "any",
+								LHS: RowValue{
+									BaseExpr: &BaseExpr{line: 1, char: 8},
+									Value: ValueList{
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "column1"}},
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column2"}},
+										},
+									},
+								},
+								Operator: "=",
+								Values: Subquery{
+									BaseExpr: &BaseExpr{line: 1, char: 33},
+									Query: SelectQuery{
+										SelectEntity: SelectEntity{
+											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 34}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+										},
+									},
+								},
 							}},
 						},
 					},
@@ -1637,7 +1988,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select true and false",
+		Input: "select column1 = all (select 1)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1645,10 +1996,21 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Logic{
-								LHS:      NewTernaryValueFromString("true"),
-								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 13},
-								RHS:      NewTernaryValueFromString("false"),
+							Field{Object: All{
+								All:      "all",
+								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+								Operator: "=",
+								Values: RowValue{
+									BaseExpr: &BaseExpr{line: 1, char: 22},
+									Value: Subquery{
+										BaseExpr: &BaseExpr{line: 1, char: 22},
+										Query: SelectQuery{
+											SelectEntity: SelectEntity{
+												SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 23}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+											},
+										},
+									},
+								},
 							}},
 						},
 					},
@@ -1657,7 +2019,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select true or false",
+		Input: "select (column1, column2) = all ((1, 2), (3, 4))",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1665,10 +2027,40 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Logic{
-								LHS:      NewTernaryValueFromString("true"),
-								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 13},
-								RHS:      NewTernaryValueFromString("false"),
+							Field{Object: All{
+								All: "all",
+								LHS: RowValue{
+									BaseExpr: &BaseExpr{line: 1, char: 8},
+									Value: ValueList{
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "column1"}},
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column2"}},
+										},
+									},
+								},
+								Operator: "=",
+								Values: RowValueList{
+									RowValues: []QueryExpression{
+										RowValue{
+											BaseExpr: &BaseExpr{line: 1, char: 34},
+											Value: ValueList{
+												Values: []QueryExpression{
+													NewIntegerValueFromString("1"),
+													NewIntegerValueFromString("2"),
+												},
+											},
+										},
+										RowValue{
+											BaseExpr: &BaseExpr{line: 1, char: 42},
+											Value: ValueList{
+												Values: []QueryExpression{
+													NewIntegerValueFromString("3"),
+													NewIntegerValueFromString("4"),
+												},
+											},
+										},
+									},
+								},
 							}},
 						},
 					},
@@ -1677,7 +2069,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select not false",
+		Input: "select (column1, column2) = all (select 1)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1685,9 +2077,26 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: UnaryLogic{
-								Operator: Token{Token: NOT, Literal: "not", Line: 1, Char: 8},
-								Operand:  NewTernaryValueFromString("false"),
+							Field{Object: All{
+								All: "all",
+								LHS: RowValue{
+									BaseExpr: &BaseExpr{line: 1, char: 8},
+									Value: ValueList{
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "column1"}},
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column2"}},
+										},
+									},
+								},
+								Operator: "=",
+								Values: Subquery{
+									BaseExpr: &BaseExpr{line: 1, char: 33},
+									Query: SelectQuery{
+										SelectEntity: SelectEntity{
+											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 34}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+										},
+									},
+								},
 							}},
 						},
 					},
@@ -1696,7 +2105,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select true or (false and false)",
+		Input: "select exists (select 1)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1704,14 +2113,14 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Logic{
-								LHS:      NewTernaryValueFromString("true"),
-								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 13},
-								RHS: Parentheses{
-									Expr: Logic{
-										LHS:      NewTernaryValueFromString("false"),
-										Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 23},
-										RHS:      NewTernaryValueFromString("false"),
+							Field{Object: Exists{
+								Exists: "exists",
+								Query: Subquery{
+									BaseExpr: &BaseExpr{line: 1, char: 15},
+									Query: SelectQuery{
+										SelectEntity: SelectEntity{
+											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 16}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+										},
 									},
 								},
 							}},
@@ -1722,7 +2131,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select true and true or !false and not false",
+		Input: "select column1 + 1",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1730,24 +2139,11 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Logic{
-								LHS: Logic{
-									LHS:      NewTernaryValueFromString("true"),
-									Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 13},
-									RHS:      NewTernaryValueFromString("true"),
-								},
-								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 22},
-								RHS: Logic{
-									LHS: UnaryLogic{
-										Operator: Token{Token: '!', Literal: "!", Line: 1, Char: 25},
-										Operand:  NewTernaryValueFromString("false"),
-									},
-									Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 32},
-									RHS: UnaryLogic{
-										Operator: Token{Token: NOT, Literal: "not", Line: 1, Char: 36},
-										Operand:  NewTernaryValueFromString("false"),
-									},
-								},
+							Field{Object: Arithmetic{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+								Operator: int('+'),
+								RHS:      NewIntegerValueFromString("1"),
 							}},
 						},
 					},
@@ -1756,7 +2152,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select @var",
+		Input: "select column1 - 1",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1764,7 +2160,12 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: Variable{BaseExpr: &BaseExpr{line: 1, char: 8}, Name: "var"}},
+							Field{Object: Arithmetic{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+								Operator: int('-'),
+								RHS:      NewIntegerValueFromString("1"),
+							}},
 						},
 					},
 				},
@@ -1772,7 +2173,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select @var := 1",
+		Input: "select column1 * 1",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1780,9 +2181,11 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: VariableSubstitution{
-								Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 8}, Name: "var"},
-								Value:    NewIntegerValueFromString("1"),
+							Field{Object: Arithmetic{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+								Operator: int('*'),
+								RHS:      NewIntegerValueFromString("1"),
 							}},
 						},
 					},
@@ -1791,7 +2194,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select case when true then 'A' when false then 'B' end",
+		Input: "select column1 / 1",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1799,7 +2202,203 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: CaseExpr{
+							Field{Object: Arithmetic{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+								Operator: int('/'),
+								RHS:      NewIntegerValueFromString("1"),
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select column1 % 1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Arithmetic{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+								Operator: int('%'),
+								RHS:      NewIntegerValueFromString("1"),
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select true and false",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Logic{
+								LHS:      NewTernaryValueFromString("true"),
+								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 13},
+								RHS:      NewTernaryValueFromString("false"),
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select true or false",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Logic{
+								LHS:      NewTernaryValueFromString("true"),
+								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 13},
+								RHS:      NewTernaryValueFromString("false"),
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select not false",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: UnaryLogic{
+								Operator: Token{Token: NOT, Literal: "not", Line: 1, Char: 8},
+								Operand:  NewTernaryValueFromString("false"),
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select true or (false and false)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Logic{
+								LHS:      NewTernaryValueFromString("true"),
+								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 13},
+								RHS: Parentheses{
+									Expr: Logic{
+										LHS:      NewTernaryValueFromString("false"),
+										Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 23},
+										RHS:      NewTernaryValueFromString("false"),
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select true and true or !false and not false",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Logic{
+								LHS: Logic{
+									LHS:      NewTernaryValueFromString("true"),
+									Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 13},
+									RHS:      NewTernaryValueFromString("true"),
+								},
+								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 22},
+								RHS: Logic{
+									LHS: UnaryLogic{
+										Operator: Token{Token: '!', Literal: "!", Line: 1, Char: 25},
+										Operand:  NewTernaryValueFromString("false"),
+									},
+									Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 32},
+									RHS: UnaryLogic{
+										Operator: Token{Token: NOT, Literal: "not", Line: 1, Char: 36},
+										Operand:  NewTernaryValueFromString("false"),
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select @var",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Variable{BaseExpr: &BaseExpr{line: 1, char: 8}, Name: "var"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select @var := 1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: VariableSubstitution{
+								Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 8}, Name: "var"},
+								Value:    NewIntegerValueFromString("1"),
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select case when true then 'A' when false then 'B' end",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: CaseExpr{
 								Case: "case",
 								End:  "end",
 								When: []QueryExpression{
@@ -1902,6 +2501,28 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select util.trim(column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Function{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "util.trim",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column1"}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select trim(column1, column2)",
 		Output: []Statement{
@@ -2037,7 +2658,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select count(distinct *)",
+		Input: "select count(*) filter (where column1)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2048,9 +2669,16 @@ var parseTests = []struct {
 							Field{Object: AggregateFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
 								Name:     "count",
-								Distinct: Token{Token: DISTINCT, Literal: "distinct", Line: 1, Char: 14},
 								Args: []QueryExpression{
-									AllColumns{BaseExpr: &BaseExpr{line: 1, char: 23}},
+									AllColumns{BaseExpr: &BaseExpr{line: 1, char: 14}},
+								},
+								Filter: FilterClause{
+									BaseExpr: &BaseExpr{line: 1, char: 17},
+									Filter:   "filter",
+									Where: WhereClause{
+										Where:  "where",
+										Filter: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 31}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "column1"}},
+									},
 								},
 							}},
 						},
@@ -2060,7 +2688,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select count(column1)",
+		Input: "select userfunc(filter) filter (where column1)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2070,9 +2698,17 @@ var parseTests = []struct {
 						Fields: []QueryExpression{
 							Field{Object: AggregateFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
-								Name:     "count",
+								Name:     "userfunc",
 								Args: []QueryExpression{
-									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 14}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "column1"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 17}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "filter"}},
+								},
+								Filter: FilterClause{
+									BaseExpr: &BaseExpr{line: 1, char: 25},
+									Filter:   "filter",
+									Where: WhereClause{
+										Where:  "where",
+										Filter: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 39}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "column1"}},
+									},
 								},
 							}},
 						},
@@ -2082,7 +2718,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select count(distinct column1)",
+		Input: "select listagg(column1) within group (order by column1) filter (where column2)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2090,12 +2726,26 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: AggregateFunction{
+							Field{Object: ListFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
-								Name:     "count",
-								Distinct: Token{Token: DISTINCT, Literal: "distinct", Line: 1, Char: 14},
+								Name:     "listagg",
 								Args: []QueryExpression{
-									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 23}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "column1"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "column1"}},
+								},
+								WithinGroup: "within group",
+								OrderBy: OrderByClause{
+									OrderBy: "order by",
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 48}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 48}, Literal: "column1"}}},
+									},
+								},
+								Filter: FilterClause{
+									BaseExpr: &BaseExpr{line: 1, char: 57},
+									Filter:   "filter",
+									Where: WhereClause{
+										Where:  "where",
+										Filter: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 71}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 71}, Literal: "column2"}},
+									},
 								},
 							}},
 						},
@@ -2105,7 +2755,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select listagg(column1)",
+		Input: "select count(distinct *)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2113,11 +2763,12 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: ListFunction{
+							Field{Object: AggregateFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
-								Name:     "listagg",
+								Name:     "count",
+								Distinct: Token{Token: DISTINCT, Literal: "distinct", Line: 1, Char: 14},
 								Args: []QueryExpression{
-									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "column1"}},
+									AllColumns{BaseExpr: &BaseExpr{line: 1, char: 23}},
 								},
 							}},
 						},
@@ -2127,7 +2778,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select listagg(distinct column1, ',')",
+		Input: "select count(column1)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2135,7 +2786,105 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Select:   "select",
 						Fields: []QueryExpression{
-							Field{Object: ListFunction{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "count",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 14}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "column1"}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select count(distinct column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "count",
+								Distinct: Token{Token: DISTINCT, Literal: "distinct", Line: 1, Char: 14},
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 23}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "column1"}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select listagg(column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: ListFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "listagg",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "column1"}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select group_concat(distinct column1, ',') within group (order by column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: ListFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "group_concat",
+								Distinct: Token{Token: DISTINCT, Literal: "distinct", Line: 1, Char: 21},
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 30}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "column1"}},
+									NewStringValue(","),
+								},
+								WithinGroup: "within group",
+								OrderBy: OrderByClause{
+									OrderBy: "order by",
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 67}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 67}, Literal: "column1"}}},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select listagg(distinct column1, ',')",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: ListFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
 								Name:     "listagg",
 								Distinct: Token{Token: DISTINCT, Literal: "distinct", Line: 1, Char: 16},
@@ -2210,6 +2959,67 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select percentile_disc(0.5) within group (order by column1 desc)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "percentile_disc",
+								Args: []QueryExpression{
+									NewFloatValueFromString("0.5"),
+								},
+								WithinGroup: "within group",
+								OrderBy: OrderByClause{
+									OrderBy: "order by",
+									Items: []QueryExpression{
+										OrderItem{
+											Value:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 52}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "column1"}},
+											Direction: Token{Token: DESC, Literal: "desc", Line: 1, Char: 60},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select userfunc(column1) within group (order by column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "userfunc",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 17}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "column1"}},
+								},
+								WithinGroup: "within group",
+								OrderBy: OrderByClause{
+									OrderBy: "order by",
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 49}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "column2"}}},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select cursor cur is not open",
 		Output: []Statement{
@@ -3484,6 +4294,19 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "export @%var1, @%var2 to 'vars.env'",
+		Output: []Statement{
+			Export{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				EnvVars: []EnvironmentVariable{
+					{BaseExpr: &BaseExpr{line: 1, char: 8}, Name: "var1"},
+					{BaseExpr: &BaseExpr{line: 1, char: 16}, Name: "var2"},
+				},
+				FilePath: NewStringValue("vars.env"),
+			},
+		},
+	},
 	{
 		Input: "func('arg1', 'arg2')",
 		Output: []Statement{
@@ -3814,6 +4637,97 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "create table newtable (column1 check (column1 > 0), column2, constraint chk check (column1 < column2))",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "column2"},
+				},
+				Constraints: []QueryExpression{
+					CheckConstraint{
+						BaseExpr: &BaseExpr{line: 1, char: 32},
+						Condition: Comparison{
+							LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 39}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "column1"}},
+							Operator: ">",
+							RHS:      NewIntegerValueFromString("0"),
+						},
+					},
+					CheckConstraint{
+						BaseExpr: &BaseExpr{line: 1, char: 62},
+						Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 73}, Literal: "chk"},
+						Condition: Comparison{
+							LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 84}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 84}, Literal: "column1"}},
+							Operator: "<",
+							RHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 94}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 94}, Literal: "column2"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "create table newtable (column1 not null unique, column2 constraint nn not null, unique (column1, column2))",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "column2"},
+				},
+				Constraints: []QueryExpression{
+					NotNullConstraint{
+						BaseExpr: &BaseExpr{line: 1, char: 32},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+					},
+					UniqueConstraint{
+						BaseExpr: &BaseExpr{line: 1, char: 41},
+						Columns: []QueryExpression{
+							Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+						},
+					},
+					NotNullConstraint{
+						BaseExpr: &BaseExpr{line: 1, char: 57},
+						Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 68}, Literal: "nn"},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "column2"},
+					},
+					UniqueConstraint{
+						BaseExpr: &BaseExpr{line: 1, char: 81},
+						Columns: []QueryExpression{
+							Identifier{BaseExpr: &BaseExpr{line: 1, char: 89}, Literal: "column1"},
+							Identifier{BaseExpr: &BaseExpr{line: 1, char: 98}, Literal: "column2"},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "create table newtable (id auto_increment unique, column1)",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "id"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 50}, Literal: "column1"},
+				},
+				Constraints: []QueryExpression{
+					AutoIncrement{
+						BaseExpr: &BaseExpr{line: 1, char: 27},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "id"},
+					},
+					UniqueConstraint{
+						BaseExpr: &BaseExpr{line: 1, char: 42},
+						Columns: []QueryExpression{
+							Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "id"},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "create table newtable as select 1, 2",
 		Output: []Statement{
@@ -3839,50 +4753,195 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "alter table table1 add column1",
+		Input: "create table newtable (format json, pretty_print true) as select 1",
 		Output: []Statement{
-			AddColumns{
-				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
-				Columns: []ColumnDefault{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Attributes: []TableAttribute{
 					{
-						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+						BaseExpr:  &BaseExpr{line: 1, char: 24},
+						Attribute: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "format"},
+						Value:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 31}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "json"}},
+					},
+					{
+						BaseExpr:  &BaseExpr{line: 1, char: 37},
+						Attribute: Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "pretty_print"},
+						Value:     NewTernaryValueFromString("true"),
+					},
+				},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 59},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{
+									Object: NewIntegerValueFromString("1"),
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 	},
 	{
-		Input: "alter table table1 add (column1, column2 default 1) first",
+		Input: "create table newtable (pretty_print, format json, header) as select 1",
 		Output: []Statement{
-			AddColumns{
-				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
-				Columns: []ColumnDefault{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Attributes: []TableAttribute{
 					{
-						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "column1"},
+						BaseExpr:  &BaseExpr{line: 1, char: 24},
+						Attribute: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "pretty_print"},
 					},
 					{
-						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"},
-						Value:  NewIntegerValueFromString("1"),
+						BaseExpr:  &BaseExpr{line: 1, char: 38},
+						Attribute: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "format"},
+						Value:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 45}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 45}, Literal: "json"}},
+					},
+					{
+						BaseExpr:  &BaseExpr{line: 1, char: 51},
+						Attribute: Identifier{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "header"},
 					},
 				},
-				Position: ColumnPosition{
-					Position: Token{Token: FIRST, Literal: "first", Line: 1, Char: 53},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 62},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{
+									Object: NewIntegerValueFromString("1"),
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 	},
 	{
-		Input: "alter table table1 add column1 last",
+		Input: "create table newtable (column1, column2 not null, column3) as select 1, 2, 3",
 		Output: []Statement{
-			AddColumns{
-				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
-				Columns: []ColumnDefault{
-					{
-						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
-					},
-				},
-				Position: ColumnPosition{
-					Position: Token{Token: LAST, Literal: "last", Line: 1, Char: 32},
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "column2"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "column3"},
+				},
+				Constraints: []QueryExpression{
+					NotNullConstraint{BaseExpr: &BaseExpr{line: 1, char: 41}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "column2"}},
+				},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 63},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{
+									Object: NewIntegerValueFromString("1"),
+								},
+								Field{
+									Object: NewIntegerValueFromString("2"),
+								},
+								Field{
+									Object: NewIntegerValueFromString("3"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "create table newtable (delimiter ';') (column1, column2)",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Attributes: []TableAttribute{
+					{
+						BaseExpr:  &BaseExpr{line: 1, char: 24},
+						Attribute: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "delimiter"},
+						Value:     NewStringValue(";"),
+					},
+				},
+				Fields: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "column1"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "column2"},
+				},
+			},
+		},
+	},
+	{
+		Input: "alter table table1 add column1",
+		Output: []Statement{
+			AddColumns{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				Columns: []ColumnDefault{
+					{
+						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "alter table table1 add (column1, column2 default 1) first",
+		Output: []Statement{
+			AddColumns{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				Columns: []ColumnDefault{
+					{
+						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "column1"},
+					},
+					{
+						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"},
+						Value:  NewIntegerValueFromString("1"),
+					},
+				},
+				Position: ColumnPosition{
+					Position: Token{Token: FIRST, Literal: "first", Line: 1, Char: 53},
+				},
+			},
+		},
+	},
+	{
+		Input: "alter table table1 add (column1 integer, column2 string default 'abc') first",
+		Output: []Statement{
+			AddColumns{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				Columns: []ColumnDefault{
+					{
+						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "column1"},
+						Type:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "integer"},
+					},
+					{
+						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "column2"},
+						Type:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 50}, Literal: "string"},
+						Value:  NewStringValue("abc"),
+					},
+				},
+				Position: ColumnPosition{
+					Position: Token{Token: FIRST, Literal: "first", Line: 1, Char: 72},
+				},
+			},
+		},
+	},
+	{
+		Input: "alter table table1 add column1 last",
+		Output: []Statement{
+			AddColumns{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				Columns: []ColumnDefault{
+					{
+						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+					},
+				},
+				Position: ColumnPosition{
+					Position: Token{Token: LAST, Literal: "last", Line: 1, Char: 32},
 				},
 			},
 		},
@@ -3963,6 +5022,17 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "alter table table1 alter column1 set type integer",
+		Output: []Statement{
+			SetColumnType{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				Column:   FieldReference{BaseExpr: &BaseExpr{line: 1, char: 26}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "column1"}},
+				Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "integer"},
+			},
+		},
+	},
 	{
 		Input: "alter table table1 set format to 'json'",
 		Output: []Statement{
@@ -4007,7 +5077,8 @@ var parseTests = []struct {
 		Input: "echo 'foo'",
 		Output: []Statement{
 			Echo{
-				Value: NewStringValue("foo"),
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Value:    NewStringValue("foo"),
 			},
 		},
 	},
@@ -4015,7 +5086,8 @@ var parseTests = []struct {
 		Input: "print 'foo'",
 		Output: []Statement{
 			Print{
-				Value: NewStringValue("foo"),
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Value:    NewStringValue("foo"),
 			},
 		},
 	},
@@ -4070,6 +5142,80 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "import strutil",
+		Output: []Statement{
+			Import{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Module:   FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "strutil"}},
+			},
+		},
+	},
+	{
+		Input: "import 'lib/strutil.sql'",
+		Output: []Statement{
+			Import{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Module:   NewStringValue("lib/strutil.sql"),
+			},
+		},
+	},
+	{
+		Input: "copy (select 1) to `out.csv`",
+		Output: []Statement{
+			Copy{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 7},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{
+									Object: NewIntegerValueFromString("1"),
+								},
+							},
+						},
+					},
+				},
+				File: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 20}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "out.csv", Quoted: true}},
+			},
+		},
+	},
+	{
+		Input: "copy (select 1) to 'out.txt' with (format tsv, header false)",
+		Output: []Statement{
+			Copy{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 7},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{
+									Object: NewIntegerValueFromString("1"),
+								},
+							},
+						},
+					},
+				},
+				File: NewStringValue("out.txt"),
+				Attributes: []TableAttribute{
+					{
+						BaseExpr:  &BaseExpr{line: 1, char: 36},
+						Attribute: Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "format"},
+						Value:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 43}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "tsv"}},
+					},
+					{
+						BaseExpr:  &BaseExpr{line: 1, char: 48},
+						Attribute: Identifier{BaseExpr: &BaseExpr{line: 1, char: 48}, Literal: "header"},
+						Value:     NewTernaryValueFromString("false"),
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "execute 'select 1'",
 		Output: []Statement{
@@ -4091,6 +5237,53 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "execute immediate 'select ??' using 'a'",
+		Output: []Statement{
+			Execute{
+				BaseExpr:   &BaseExpr{line: 1, char: 1},
+				Immediate:  true,
+				Statements: NewStringValue("select ??"),
+				Values: []QueryExpression{
+					NewStringValue("a"),
+				},
+			},
+		},
+	},
+	{
+		Input: "execute stmt using 1, @a as id",
+		Output: []Statement{
+			Execute{
+				BaseExpr:   &BaseExpr{line: 1, char: 1},
+				Statements: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "stmt"}},
+				Values: []QueryExpression{
+					NewIntegerValueFromString("1"),
+					ReplaceValue{
+						Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 23}, Name: "a"},
+						Name:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "id"},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "prepare stmt from 'select ?'",
+		Output: []Statement{
+			Prepare{
+				BaseExpr:  &BaseExpr{line: 1, char: 1},
+				Name:      Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "stmt"},
+				Statement: NewStringValue("select ?"),
+			},
+		},
+	},
+	{
+		Input: "dispose prepare stmt",
+		Output: []Statement{
+			DisposePrepared{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "stmt"},
+			},
+		},
+	},
 	{
 		Input: "syntax",
 		Output: []Statement{
@@ -4234,72 +5427,241 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "trigger error",
+		Input: "explain select c1 from stdin",
 		Output: []Statement{
-			Trigger{
+			Explain{
 				BaseExpr: &BaseExpr{line: 1, char: 1},
-				Event:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "error"},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 9},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{
+									Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "c1"}},
+								},
+							},
+						},
+						FromClause: FromClause{From: "from", Tables: []QueryExpression{
+							Table{Object: Stdin{BaseExpr: &BaseExpr{line: 1, char: 24}, Stdin: "stdin"}},
+						}},
+					},
+				},
 			},
 		},
 	},
 	{
-		Input: "trigger error 'user error'",
+		Input: "explain analyze select 1",
 		Output: []Statement{
-			Trigger{
+			Explain{
 				BaseExpr: &BaseExpr{line: 1, char: 1},
-				Event:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "error"},
-				Message:  NewStringValue("user error"),
+				Analyze:  Token{Token: ANALYZE, Literal: "analyze", Line: 1, Char: 9},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 17},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{Object: NewIntegerValueFromString("1")},
+							},
+						},
+					},
+				},
 			},
 		},
 	},
 	{
-		Input: "trigger error 300 'user error'",
+		Input: "estimate select estimate from t",
 		Output: []Statement{
-			Trigger{
+			Estimate{
 				BaseExpr: &BaseExpr{line: 1, char: 1},
-				Event:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "error"},
-				Message:  NewStringValue("user error"),
-				Code:     value.NewInteger(300),
-			},
-		},
-	},
-	{
-		Input: "declare cur cursor for select 1",
-		Output: []Statement{
-			CursorDeclaration{
-				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "cur"},
 				Query: SelectQuery{
 					SelectEntity: SelectEntity{
 						SelectClause: SelectClause{
-							BaseExpr: &BaseExpr{line: 1, char: 24},
+							BaseExpr: &BaseExpr{line: 1, char: 10},
 							Select:   "select",
 							Fields: []QueryExpression{
-								Field{Object: NewIntegerValueFromString("1")},
+								Field{
+									Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 17}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "estimate"}},
+								},
 							},
 						},
+						FromClause: FromClause{From: "from", Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "t"}},
+						}},
 					},
 				},
 			},
 		},
 	},
 	{
-		Input: "open cur",
+		Input: "trigger error",
 		Output: []Statement{
-			OpenCursor{
-				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "cur"},
+			Trigger{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Event:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "error"},
 			},
 		},
 	},
 	{
-		Input: "close cur",
+		Input: "trigger error 'user error'",
 		Output: []Statement{
-			CloseCursor{
-				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 7}, Literal: "cur"},
+			Trigger{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Event:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "error"},
+				Message:  NewStringValue("user error"),
 			},
 		},
 	},
 	{
-		Input: "dispose cursor cur",
+		Input: "trigger error 300 'user error'",
+		Output: []Statement{
+			Trigger{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Event:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "error"},
+				Message:  NewStringValue("user error"),
+				Code:     value.NewInteger(300),
+			},
+		},
+	},
+	{
+		Input: "trigger error 300 'user error' with @data",
+		Output: []Statement{
+			Trigger{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Event:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "error"},
+				Message:  NewStringValue("user error"),
+				Code:     value.NewInteger(300),
+				Data:     Variable{BaseExpr: &BaseExpr{line: 1, char: 37}, Name: "data"},
+			},
+		},
+	},
+	{
+		Input: "trigger error 'user error' with @data",
+		Output: []Statement{
+			Trigger{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Event:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "error"},
+				Message:  NewStringValue("user error"),
+				Data:     Variable{BaseExpr: &BaseExpr{line: 1, char: 33}, Name: "data"},
+			},
+		},
+	},
+	{
+		Input: "assert @a = 1",
+		Output: []Statement{
+			Assert{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Condition: Comparison{
+					LHS:      Variable{BaseExpr: &BaseExpr{line: 1, char: 8}, Name: "a"},
+					RHS:      NewIntegerValueFromString("1"),
+					Operator: "=",
+				},
+			},
+		},
+	},
+	{
+		Input: "assert @a = 1 message 'assertion error'",
+		Output: []Statement{
+			Assert{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Condition: Comparison{
+					LHS:      Variable{BaseExpr: &BaseExpr{line: 1, char: 8}, Name: "a"},
+					RHS:      NewIntegerValueFromString("1"),
+					Operator: "=",
+				},
+				Message: NewStringValue("assertion error"),
+			},
+		},
+	},
+	{
+		Input: "expect select 1 to equal select 2",
+		Output: []Statement{
+			Expect{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Query: SelectQuery{SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 8}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+				}},
+				Expected: SelectQuery{SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 26}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("2")}}},
+				}},
+			},
+		},
+	},
+	{
+		Input: "declare cur cursor for select 1",
+		Output: []Statement{
+			CursorDeclaration{
+				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "cur"},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 24},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{Object: NewIntegerValueFromString("1")},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare cur cursor for table(func1(1))",
+		Output: []Statement{
+			CursorDeclaration{
+				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "cur"},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 24},
+							Select:   "SELECT",
+							Fields: []QueryExpression{
+								Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 24}}},
+							},
+						},
+						FromClause: FromClause{
+							From: "FROM",
+							Tables: []QueryExpression{
+								Table{
+									Object: TableFunction{
+										BaseExpr: &BaseExpr{line: 1, char: 24},
+										Table:    "table",
+										Function: Function{
+											BaseExpr: &BaseExpr{line: 1, char: 30},
+											Name:     "func1",
+											Args: []QueryExpression{
+												NewIntegerValueFromString("1"),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "open cur",
+		Output: []Statement{
+			OpenCursor{
+				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "cur"},
+			},
+		},
+	},
+	{
+		Input: "close cur",
+		Output: []Statement{
+			CloseCursor{
+				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 7}, Literal: "cur"},
+			},
+		},
+	},
+	{
+		Input: "dispose cursor cur",
 		Output: []Statement{
 			DisposeCursor{
 				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "cur"},
@@ -4318,6 +5680,16 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "fetch cur bulk 100 into @rows",
+		Output: []Statement{
+			FetchCursor{
+				Cursor:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 7}, Literal: "cur"},
+				Bulk:      NewIntegerValueFromString("100"),
+				Variables: []Variable{{BaseExpr: &BaseExpr{line: 1, char: 25}, Name: "rows"}},
+			},
+		},
+	},
 	{
 		Input: "fetch next cur into @var1",
 		Output: []Statement{
@@ -4446,6 +5818,49 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "declare tbl view (column1, column2, check (column1 <> column2))",
+		Output: []Statement{
+			ViewDeclaration{
+				View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "tbl"},
+				Fields: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "column1"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "column2"},
+				},
+				Constraints: []QueryExpression{
+					CheckConstraint{
+						BaseExpr: &BaseExpr{line: 1, char: 37},
+						Condition: Comparison{
+							LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 44}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 44}, Literal: "column1"}},
+							Operator: "<>",
+							RHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 55}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 55}, Literal: "column2"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare tbl view (column1 constraint u unique, column2)",
+		Output: []Statement{
+			ViewDeclaration{
+				View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "tbl"},
+				Fields: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "column1"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 48}, Literal: "column2"},
+				},
+				Constraints: []QueryExpression{
+					UniqueConstraint{
+						BaseExpr: &BaseExpr{line: 1, char: 27},
+						Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "u"},
+						Columns: []QueryExpression{
+							Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "column1"},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "declare tbl view as select 1, 2",
 		Output: []Statement{
@@ -4478,6 +5893,14 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "dispose view util.tbl",
+		Output: []Statement{
+			DisposeView{
+				View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "util.tbl"},
+			},
+		},
+	},
 	{
 		Input: "if @var1 = 1 then print 1; end if",
 		Output: []Statement{
@@ -4488,7 +5911,7 @@ var parseTests = []struct {
 					Operator: "=",
 				},
 				Statements: []Statement{
-					Print{Value: NewIntegerValueFromString("1")},
+					Print{BaseExpr: &BaseExpr{line: 1, char: 19}, Value: NewIntegerValueFromString("1")},
 				},
 			},
 		},
@@ -4503,7 +5926,7 @@ var parseTests = []struct {
 					Operator: "=",
 				},
 				Statements: []Statement{
-					Print{Value: NewIntegerValueFromString("1")},
+					Print{BaseExpr: &BaseExpr{line: 1, char: 19}, Value: NewIntegerValueFromString("1")},
 				},
 				ElseIf: []ElseIf{
 					{
@@ -4513,7 +5936,7 @@ var parseTests = []struct {
 							Operator: "=",
 						},
 						Statements: []Statement{
-							Print{Value: NewIntegerValueFromString("2")},
+							Print{BaseExpr: &BaseExpr{line: 1, char: 50}, Value: NewIntegerValueFromString("2")},
 						},
 					},
 					{
@@ -4523,13 +5946,13 @@ var parseTests = []struct {
 							Operator: "=",
 						},
 						Statements: []Statement{
-							Print{Value: NewIntegerValueFromString("3")},
+							Print{BaseExpr: &BaseExpr{line: 1, char: 81}, Value: NewIntegerValueFromString("3")},
 						},
 					},
 				},
 				Else: Else{
 					Statements: []Statement{
-						Print{Value: NewIntegerValueFromString("4")},
+						Print{BaseExpr: &BaseExpr{line: 1, char: 95}, Value: NewIntegerValueFromString("4")},
 					},
 				},
 			},
@@ -4541,7 +5964,7 @@ var parseTests = []struct {
 			While{
 				Condition: Variable{BaseExpr: &BaseExpr{line: 1, char: 7}, Name: "var1"},
 				Statements: []Statement{
-					Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 22}, Name: "var1"}},
+					Print{BaseExpr: &BaseExpr{line: 1, char: 16}, Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 22}, Name: "var1"}},
 				},
 			},
 		},
@@ -4555,7 +5978,7 @@ var parseTests = []struct {
 				},
 				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "cur"},
 				Statements: []Statement{
-					Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 29}, Name: "var1"}},
+					Print{BaseExpr: &BaseExpr{line: 1, char: 23}, Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 29}, Name: "var1"}},
 				},
 			},
 		},
@@ -4570,7 +5993,7 @@ var parseTests = []struct {
 				},
 				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "cur"},
 				Statements: []Statement{
-					Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 36}, Name: "var1"}},
+					Print{BaseExpr: &BaseExpr{line: 1, char: 30}, Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 36}, Name: "var1"}},
 				},
 			},
 		},
@@ -4585,7 +6008,7 @@ var parseTests = []struct {
 				},
 				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "cur"},
 				Statements: []Statement{
-					Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 33}, Name: "var1"}},
+					Print{BaseExpr: &BaseExpr{line: 1, char: 27}, Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 33}, Name: "var1"}},
 				},
 			},
 		},
@@ -4601,7 +6024,7 @@ var parseTests = []struct {
 				},
 				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "cur"},
 				Statements: []Statement{
-					Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 44}, Name: "var1"}},
+					Print{BaseExpr: &BaseExpr{line: 1, char: 38}, Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 44}, Name: "var1"}},
 				},
 			},
 		},
@@ -4614,13 +6037,13 @@ var parseTests = []struct {
 					{
 						Condition: NewTernaryValueFromString("true"),
 						Statements: []Statement{
-							Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 27}, Name: "var1"}},
+							Print{BaseExpr: &BaseExpr{line: 1, char: 21}, Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 27}, Name: "var1"}},
 						},
 					},
 					{
 						Condition: NewTernaryValueFromString("false"),
 						Statements: []Statement{
-							Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 56}, Name: "var2"}},
+							Print{BaseExpr: &BaseExpr{line: 1, char: 50}, Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 56}, Name: "var2"}},
 						},
 					},
 				},
@@ -4635,19 +6058,19 @@ var parseTests = []struct {
 					{
 						Condition: NewTernaryValueFromString("true"),
 						Statements: []Statement{
-							Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 27}, Name: "var1"}},
+							Print{BaseExpr: &BaseExpr{line: 1, char: 21}, Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 27}, Name: "var1"}},
 						},
 					},
 					{
 						Condition: NewTernaryValueFromString("false"),
 						Statements: []Statement{
-							Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 56}, Name: "var2"}},
+							Print{BaseExpr: &BaseExpr{line: 1, char: 50}, Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 56}, Name: "var2"}},
 						},
 					},
 				},
 				Else: CaseElse{
 					Statements: []Statement{
-						Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 74}, Name: "var3"}},
+						Print{BaseExpr: &BaseExpr{line: 1, char: 68}, Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 74}, Name: "var3"}},
 					},
 				},
 			},
@@ -4656,13 +6079,13 @@ var parseTests = []struct {
 	{
 		Input: "exit",
 		Output: []Statement{
-			Exit{},
+			Exit{BaseExpr: &BaseExpr{line: 1, char: 1}},
 		},
 	},
 	{
 		Input: "exit 1",
 		Output: []Statement{
-			Exit{Code: value.NewIntegerFromString("1")},
+			Exit{BaseExpr: &BaseExpr{line: 1, char: 1}, Code: value.NewIntegerFromString("1")},
 		},
 	},
 	{
@@ -4671,8 +6094,70 @@ var parseTests = []struct {
 			While{
 				Condition: NewTernaryValueFromString("true"),
 				Statements: []Statement{
-					Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 21}, Name: "var1"}},
-					FlowControl{Token: CONTINUE},
+					Print{BaseExpr: &BaseExpr{line: 1, char: 15}, Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 21}, Name: "var1"}},
+					FlowControl{BaseExpr: &BaseExpr{line: 1, char: 28}, Token: CONTINUE},
+				},
+			},
+		},
+	},
+	{
+		Input: "try print 1; catch print 2; end try",
+		Output: []Statement{
+			Try{
+				Statements: []Statement{
+					Print{BaseExpr: &BaseExpr{line: 1, char: 5}, Value: NewIntegerValueFromString("1")},
+				},
+				CatchStatements: []Statement{
+					Print{BaseExpr: &BaseExpr{line: 1, char: 20}, Value: NewIntegerValueFromString("2")},
+				},
+			},
+		},
+	},
+	{
+		Input: "while true do try continue; catch break; end try; end while",
+		Output: []Statement{
+			While{
+				Condition: NewTernaryValueFromString("true"),
+				Statements: []Statement{
+					Try{
+						Statements: []Statement{
+							FlowControl{BaseExpr: &BaseExpr{line: 1, char: 19}, Token: CONTINUE},
+						},
+						CatchStatements: []Statement{
+							FlowControl{BaseExpr: &BaseExpr{line: 1, char: 35}, Token: BREAK},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "loop1: while true do while true do continue loop1; end while; break loop1; end while",
+		Output: []Statement{
+			While{
+				Label:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 1}, Literal: "loop1"},
+				Condition: NewTernaryValueFromString("true"),
+				Statements: []Statement{
+					While{
+						Condition: NewTernaryValueFromString("true"),
+						Statements: []Statement{
+							FlowControl{BaseExpr: &BaseExpr{line: 1, char: 36}, Token: CONTINUE, Label: Identifier{BaseExpr: &BaseExpr{line: 1, char: 45}, Literal: "loop1"}},
+						},
+					},
+					FlowControl{BaseExpr: &BaseExpr{line: 1, char: 63}, Token: BREAK, Label: Identifier{BaseExpr: &BaseExpr{line: 1, char: 69}, Literal: "loop1"}},
+				},
+			},
+		},
+	},
+	{
+		Input: "loop1: while @var1 in cur do break loop1; end while",
+		Output: []Statement{
+			WhileInCursor{
+				Label:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 1}, Literal: "loop1"},
+				Variables: []Variable{{BaseExpr: &BaseExpr{line: 1, char: 14}, Name: "var1"}},
+				Cursor:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "cur"},
+				Statements: []Statement{
+					FlowControl{BaseExpr: &BaseExpr{line: 1, char: 30}, Token: BREAK, Label: Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "loop1"}},
 				},
 			},
 		},
@@ -4683,7 +6168,7 @@ var parseTests = []struct {
 			While{
 				Condition: NewTernaryValueFromString("true"),
 				Statements: []Statement{
-					FlowControl{Token: BREAK},
+					FlowControl{BaseExpr: &BaseExpr{line: 1, char: 15}, Token: BREAK},
 				},
 			},
 		},
@@ -4694,7 +6179,7 @@ var parseTests = []struct {
 			While{
 				Condition: NewTernaryValueFromString("true"),
 				Statements: []Statement{
-					Exit{},
+					Exit{BaseExpr: &BaseExpr{line: 1, char: 15}},
 				},
 			},
 		},
@@ -4712,7 +6197,7 @@ var parseTests = []struct {
 							Operator: "=",
 						},
 						Statements: []Statement{
-							FlowControl{Token: CONTINUE},
+							FlowControl{BaseExpr: &BaseExpr{line: 1, char: 33}, Token: CONTINUE},
 						},
 					},
 				},
@@ -4732,7 +6217,7 @@ var parseTests = []struct {
 							Operator: "=",
 						},
 						Statements: []Statement{
-							FlowControl{Token: CONTINUE},
+							FlowControl{BaseExpr: &BaseExpr{line: 1, char: 33}, Token: CONTINUE},
 						},
 						ElseIf: []ElseIf{
 							{
@@ -4742,7 +6227,7 @@ var parseTests = []struct {
 									Operator: "=",
 								},
 								Statements: []Statement{
-									FlowControl{Token: BREAK},
+									FlowControl{BaseExpr: &BaseExpr{line: 1, char: 65}, Token: BREAK},
 								},
 							},
 							{
@@ -4752,13 +6237,13 @@ var parseTests = []struct {
 									Operator: "=",
 								},
 								Statements: []Statement{
-									Exit{},
+									Exit{BaseExpr: &BaseExpr{line: 1, char: 94}},
 								},
 							},
 						},
 						Else: Else{
 							Statements: []Statement{
-								FlowControl{Token: CONTINUE},
+								FlowControl{BaseExpr: &BaseExpr{line: 1, char: 105}, Token: CONTINUE},
 							},
 						},
 					},
@@ -4777,13 +6262,13 @@ var parseTests = []struct {
 							{
 								Condition: NewTernaryValueFromString("true"),
 								Statements: []Statement{
-									Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 41}, Name: "var1"}},
+									Print{BaseExpr: &BaseExpr{line: 1, char: 35}, Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 41}, Name: "var1"}},
 								},
 							},
 							{
 								Condition: NewTernaryValueFromString("false"),
 								Statements: []Statement{
-									FlowControl{Token: CONTINUE},
+									FlowControl{BaseExpr: &BaseExpr{line: 1, char: 64}, Token: CONTINUE},
 								},
 							},
 						},
@@ -4803,19 +6288,19 @@ var parseTests = []struct {
 							{
 								Condition: NewTernaryValueFromString("true"),
 								Statements: []Statement{
-									Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 41}, Name: "var1"}},
+									Print{BaseExpr: &BaseExpr{line: 1, char: 35}, Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 41}, Name: "var1"}},
 								},
 							},
 							{
 								Condition: NewTernaryValueFromString("false"),
 								Statements: []Statement{
-									Exit{},
+									Exit{BaseExpr: &BaseExpr{line: 1, char: 64}},
 								},
 							},
 						},
 						Else: CaseElse{
 							Statements: []Statement{
-								FlowControl{Token: CONTINUE},
+								FlowControl{BaseExpr: &BaseExpr{line: 1, char: 75}, Token: CONTINUE},
 							},
 						},
 					},
@@ -4832,59 +6317,163 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "declare func1 function (@arg1 default 0, @arg2 default 1) as begin end",
+		Input: "declare util.func1 function () as begin end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "util.func1"},
+			},
+		},
+	},
+	{
+		Input: "declare func1 function () as begin return table select 1; end",
 		Output: []Statement{
 			FunctionDeclaration{
 				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
-				Parameters: []VariableAssignment{
-					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 25}, Name: "arg1"}, Value: NewIntegerValueFromString("0")},
-					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 42}, Name: "arg2"}, Value: NewIntegerValueFromString("1")},
+				Statements: []Statement{
+					ReturnTable{
+						BaseExpr: &BaseExpr{line: 1, char: 36},
+						Query: SelectQuery{
+							SelectEntity: SelectEntity{
+								SelectClause: SelectClause{
+									BaseExpr: &BaseExpr{line: 1, char: 49},
+									Select:   "select",
+									Fields:   []QueryExpression{Field{Object: NewIntegerValueFromString("1")}},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 	},
 	{
-		Input: "declare func1 function (@arg1, @arg2 default 0) as begin \n" +
-			"if @var1 = 1 then print 1; end if; \n" +
-			"if @var1 = 1 then print 1; elseif @var1 = 2 then print 2; elseif @var1 = 3 then print 3; else print 4; end if; \n" +
-			"while true do break; end while; \n" +
-			"while true do if @var1 = 1 then continue; end if; end while; \n" +
-			"while true do if @var1 = 1 then continue; elseif @var1 = 2 then break; elseif @var1 = 3 then return; else continue; end if; end while; \n" +
-			"while @var1 in cur do print @var1; end while; \n" +
-			"while @var1, @var2 in cur do print @var1; end while; \n" +
-			"case when true then print @var1; when false then print @var2; end case; \n" +
-			"case when true then print @var1; when false then return; else return; end case; \n" +
-			"while true do case when true then print @var1; when false then continue; end case; end while; \n" +
-			"while true do case when true then print @var1; when false then return; else continue; end case; end while; \n" +
-			"return; \n" +
-			"return @var1; \n" +
-			"end",
+		Input: "declare func1 function () as begin try return 1; catch return 2; end try; end",
 		Output: []Statement{
 			FunctionDeclaration{
 				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
-				Parameters: []VariableAssignment{
-					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 25}, Name: "arg1"}},
-					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 32}, Name: "arg2"}, Value: NewIntegerValueFromString("0")},
-				},
 				Statements: []Statement{
-					If{
-						Condition: Comparison{
-							LHS:      Variable{BaseExpr: &BaseExpr{line: 2, char: 4}, Name: "var1"},
-							RHS:      NewIntegerValueFromString("1"),
-							Operator: "=",
+					Try{
+						Statements: []Statement{
+							Return{BaseExpr: &BaseExpr{line: 1, char: 40}, Value: NewIntegerValueFromString("1")},
+						},
+						CatchStatements: []Statement{
+							Return{BaseExpr: &BaseExpr{line: 1, char: 56}, Value: NewIntegerValueFromString("2")},
 						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare func1 function () as begin lp: while true do break lp; end while; end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
+				Statements: []Statement{
+					While{
+						Label:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "lp"},
+						Condition: NewTernaryValueFromString("true"),
 						Statements: []Statement{
-							Print{Value: NewIntegerValueFromString("1")},
+							FlowControl{BaseExpr: &BaseExpr{line: 1, char: 54}, Token: BREAK, Label: Identifier{BaseExpr: &BaseExpr{line: 1, char: 60}, Literal: "lp"}},
 						},
 					},
-					If{
-						Condition: Comparison{
-							LHS:      Variable{BaseExpr: &BaseExpr{line: 3, char: 4}, Name: "var1"},
-							RHS:      NewIntegerValueFromString("1"),
+				},
+			},
+		},
+	},
+	{
+		Input: "declare func1 function (@arg1 default 0, @arg2 default 1) as begin end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
+				Parameters: []VariableAssignment{
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 25}, Name: "arg1"}, Value: NewIntegerValueFromString("0")},
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 42}, Name: "arg2"}, Value: NewIntegerValueFromString("1")},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare func1 function () as begin return cursor cur; end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
+				Statements: []Statement{
+					ReturnCursor{
+						BaseExpr: &BaseExpr{line: 1, char: 36},
+						Cursor:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 50}, Literal: "cur"},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare func1 function (variadic @args) as begin end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
+				Parameters: []VariableAssignment{
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 34}, Name: "args"}},
+				},
+				Variadic: true,
+			},
+		},
+	},
+	{
+		Input: "declare func1 function (@arg1, variadic @args) as begin end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
+				Parameters: []VariableAssignment{
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 25}, Name: "arg1"}},
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 41}, Name: "args"}},
+				},
+				Variadic: true,
+			},
+		},
+	},
+	{
+		Input: "declare func1 function (@arg1, @arg2 default 0) as begin \n" +
+			"if @var1 = 1 then print 1; end if; \n" +
+			"if @var1 = 1 then print 1; elseif @var1 = 2 then print 2; elseif @var1 = 3 then print 3; else print 4; end if; \n" +
+			"while true do break; end while; \n" +
+			"while true do if @var1 = 1 then continue; end if; end while; \n" +
+			"while true do if @var1 = 1 then continue; elseif @var1 = 2 then break; elseif @var1 = 3 then return; else continue; end if; end while; \n" +
+			"while @var1 in cur do print @var1; end while; \n" +
+			"while @var1, @var2 in cur do print @var1; end while; \n" +
+			"case when true then print @var1; when false then print @var2; end case; \n" +
+			"case when true then print @var1; when false then return; else return; end case; \n" +
+			"while true do case when true then print @var1; when false then continue; end case; end while; \n" +
+			"while true do case when true then print @var1; when false then return; else continue; end case; end while; \n" +
+			"return; \n" +
+			"return @var1; \n" +
+			"end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
+				Parameters: []VariableAssignment{
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 25}, Name: "arg1"}},
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 32}, Name: "arg2"}, Value: NewIntegerValueFromString("0")},
+				},
+				Statements: []Statement{
+					If{
+						Condition: Comparison{
+							LHS:      Variable{BaseExpr: &BaseExpr{line: 2, char: 4}, Name: "var1"},
+							RHS:      NewIntegerValueFromString("1"),
+							Operator: "=",
+						},
+						Statements: []Statement{
+							Print{BaseExpr: &BaseExpr{line: 2, char: 19}, Value: NewIntegerValueFromString("1")},
+						},
+					},
+					If{
+						Condition: Comparison{
+							LHS:      Variable{BaseExpr: &BaseExpr{line: 3, char: 4}, Name: "var1"},
+							RHS:      NewIntegerValueFromString("1"),
 							Operator: "=",
 						},
 						Statements: []Statement{
-							Print{Value: NewIntegerValueFromString("1")},
+							Print{BaseExpr: &BaseExpr{line: 3, char: 19}, Value: NewIntegerValueFromString("1")},
 						},
 						ElseIf: []ElseIf{
 							{
@@ -4894,7 +6483,7 @@ var parseTests = []struct {
 									Operator: "=",
 								},
 								Statements: []Statement{
-									Print{Value: NewIntegerValueFromString("2")},
+									Print{BaseExpr: &BaseExpr{line: 3, char: 50}, Value: NewIntegerValueFromString("2")},
 								},
 							},
 							{
@@ -4904,20 +6493,20 @@ var parseTests = []struct {
 									Operator: "=",
 								},
 								Statements: []Statement{
-									Print{Value: NewIntegerValueFromString("3")},
+									Print{BaseExpr: &BaseExpr{line: 3, char: 81}, Value: NewIntegerValueFromString("3")},
 								},
 							},
 						},
 						Else: Else{
 							Statements: []Statement{
-								Print{Value: NewIntegerValueFromString("4")},
+								Print{BaseExpr: &BaseExpr{line: 3, char: 95}, Value: NewIntegerValueFromString("4")},
 							},
 						},
 					},
 					While{
 						Condition: NewTernaryValueFromString("true"),
 						Statements: []Statement{
-							FlowControl{Token: BREAK},
+							FlowControl{BaseExpr: &BaseExpr{line: 4, char: 15}, Token: BREAK},
 						},
 					},
 					While{
@@ -4930,7 +6519,7 @@ var parseTests = []struct {
 									Operator: "=",
 								},
 								Statements: []Statement{
-									FlowControl{Token: CONTINUE},
+									FlowControl{BaseExpr: &BaseExpr{line: 5, char: 33}, Token: CONTINUE},
 								},
 							},
 						},
@@ -4945,7 +6534,7 @@ var parseTests = []struct {
 									Operator: "=",
 								},
 								Statements: []Statement{
-									FlowControl{Token: CONTINUE},
+									FlowControl{BaseExpr: &BaseExpr{line: 6, char: 33}, Token: CONTINUE},
 								},
 								ElseIf: []ElseIf{
 									{
@@ -4955,7 +6544,7 @@ var parseTests = []struct {
 											Operator: "=",
 										},
 										Statements: []Statement{
-											FlowControl{Token: BREAK},
+											FlowControl{BaseExpr: &BaseExpr{line: 6, char: 65}, Token: BREAK},
 										},
 									},
 									{
@@ -4965,13 +6554,13 @@ var parseTests = []struct {
 											Operator: "=",
 										},
 										Statements: []Statement{
-											Return{Value: NewNullValue()},
+											Return{BaseExpr: &BaseExpr{line: 6, char: 94}, Value: NewNullValue()},
 										},
 									},
 								},
 								Else: Else{
 									Statements: []Statement{
-										FlowControl{Token: CONTINUE},
+										FlowControl{BaseExpr: &BaseExpr{line: 6, char: 107}, Token: CONTINUE},
 									},
 								},
 							},
@@ -4983,143 +6572,725 @@ var parseTests = []struct {
 						},
 						Cursor: Identifier{BaseExpr: &BaseExpr{line: 7, char: 16}, Literal: "cur"},
 						Statements: []Statement{
-							Print{Value: Variable{BaseExpr: &BaseExpr{line: 7, char: 29}, Name: "var1"}},
+							Print{BaseExpr: &BaseExpr{line: 7, char: 23}, Value: Variable{BaseExpr: &BaseExpr{line: 7, char: 29}, Name: "var1"}},
+						},
+					},
+					WhileInCursor{
+						Variables: []Variable{
+							{BaseExpr: &BaseExpr{line: 8, char: 7}, Name: "var1"},
+							{BaseExpr: &BaseExpr{line: 8, char: 14}, Name: "var2"},
+						},
+						Cursor: Identifier{BaseExpr: &BaseExpr{line: 8, char: 23}, Literal: "cur"},
+						Statements: []Statement{
+							Print{BaseExpr: &BaseExpr{line: 8, char: 30}, Value: Variable{BaseExpr: &BaseExpr{line: 8, char: 36}, Name: "var1"}},
+						},
+					},
+					Case{
+						When: []CaseWhen{
+							{
+								Condition: NewTernaryValueFromString("true"),
+								Statements: []Statement{
+									Print{BaseExpr: &BaseExpr{line: 9, char: 21}, Value: Variable{BaseExpr: &BaseExpr{line: 9, char: 27}, Name: "var1"}},
+								},
+							},
+							{
+								Condition: NewTernaryValueFromString("false"),
+								Statements: []Statement{
+									Print{BaseExpr: &BaseExpr{line: 9, char: 50}, Value: Variable{BaseExpr: &BaseExpr{line: 9, char: 56}, Name: "var2"}},
+								},
+							},
+						},
+					},
+					Case{
+						When: []CaseWhen{
+							{
+								Condition: NewTernaryValueFromString("true"),
+								Statements: []Statement{
+									Print{BaseExpr: &BaseExpr{line: 10, char: 21}, Value: Variable{BaseExpr: &BaseExpr{line: 10, char: 27}, Name: "var1"}},
+								},
+							},
+							{
+								Condition: NewTernaryValueFromString("false"),
+								Statements: []Statement{
+									Return{BaseExpr: &BaseExpr{line: 10, char: 50}, Value: NewNullValue()},
+								},
+							},
+						},
+						Else: CaseElse{
+							Statements: []Statement{
+								Return{BaseExpr: &BaseExpr{line: 10, char: 63}, Value: NewNullValue()},
+							},
+						},
+					},
+					While{
+						Condition: NewTernaryValueFromString("true"),
+						Statements: []Statement{
+							Case{
+								When: []CaseWhen{
+									{
+										Condition: NewTernaryValueFromString("true"),
+										Statements: []Statement{
+											Print{BaseExpr: &BaseExpr{line: 11, char: 35}, Value: Variable{BaseExpr: &BaseExpr{line: 11, char: 41}, Name: "var1"}},
+										},
+									},
+									{
+										Condition: NewTernaryValueFromString("false"),
+										Statements: []Statement{
+											FlowControl{BaseExpr: &BaseExpr{line: 11, char: 64}, Token: CONTINUE},
+										},
+									},
+								},
+							},
+						},
+					},
+					While{
+						Condition: NewTernaryValueFromString("true"),
+						Statements: []Statement{
+							Case{
+								When: []CaseWhen{
+									{
+										Condition: NewTernaryValueFromString("true"),
+										Statements: []Statement{
+											Print{BaseExpr: &BaseExpr{line: 12, char: 35}, Value: Variable{BaseExpr: &BaseExpr{line: 12, char: 41}, Name: "var1"}},
+										},
+									},
+									{
+										Condition: NewTernaryValueFromString("false"),
+										Statements: []Statement{
+											Return{BaseExpr: &BaseExpr{line: 12, char: 64}, Value: NewNullValue()},
+										},
+									},
+								},
+								Else: CaseElse{
+									Statements: []Statement{
+										FlowControl{BaseExpr: &BaseExpr{line: 12, char: 77}, Token: CONTINUE},
+									},
+								},
+							},
+						},
+					},
+					Return{
+						BaseExpr: &BaseExpr{line: 13, char: 1},
+						Value:    NewNullValue(),
+					},
+					Return{
+						BaseExpr: &BaseExpr{line: 14, char: 1},
+						Value:    Variable{BaseExpr: &BaseExpr{line: 14, char: 8}, Name: "var1"},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare aggfunc aggregate (cur) as begin end",
+		Output: []Statement{
+			AggregateDeclaration{
+				Name:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "aggfunc"},
+				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "cur"},
+			},
+		},
+	},
+	{
+		Input: "declare aggfunc aggregate (cur, @var1) as begin end",
+		Output: []Statement{
+			AggregateDeclaration{
+				Name:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "aggfunc"},
+				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "cur"},
+				Parameters: []VariableAssignment{
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 33}, Name: "var1"}},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare aggfunc aggregate (cur, @var1, @var2) as begin end",
+		Output: []Statement{
+			AggregateDeclaration{
+				Name:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "aggfunc"},
+				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "cur"},
+				Parameters: []VariableAssignment{
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 33}, Name: "var1"}},
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 40}, Name: "var2"}},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare trg trigger before insert on table1 as begin @new.c1 := 1; end",
+		Output: []Statement{
+			TableTriggerDeclaration{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "trg"},
+				Timing:   Token{Token: BEFORE, Literal: "before", Line: 1, Char: 21},
+				Event:    Token{Token: INSERT, Literal: "insert", Line: 1, Char: 28},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "table1"},
+				Statements: []Statement{
+					VariableSubstitution{
+						Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 54}, Name: "new.c1"},
+						Value:    NewIntegerValueFromString("1"),
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare trg trigger after delete on table1 as begin return; end",
+		Output: []Statement{
+			TableTriggerDeclaration{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "trg"},
+				Timing:   Token{Token: AFTER, Literal: "after", Line: 1, Char: 21},
+				Event:    Token{Token: DELETE, Literal: "delete", Line: 1, Char: 27},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "table1"},
+				Statements: []Statement{
+					Return{BaseExpr: &BaseExpr{line: 1, char: 53}, Value: NewNullValue()},
+				},
+			},
+		},
+	},
+	{
+		Input: "dispose trigger trg",
+		Output: []Statement{
+			DisposeTableTrigger{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "trg"},
+			},
+		},
+	},
+	{
+		Input: "create index idx on table1 (column1, t.column2)",
+		Output: []Statement{
+			CreateIndex{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "idx"},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "table1"},
+				Columns: []QueryExpression{
+					FieldReference{BaseExpr: &BaseExpr{line: 1, char: 29}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "column1"}},
+					FieldReference{BaseExpr: &BaseExpr{line: 1, char: 38}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "t"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "column2"}},
+				},
+			},
+		},
+	},
+	{
+		Input: "create index idx on table1 (column1) using btree",
+		Output: []Statement{
+			CreateIndex{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "idx"},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "table1"},
+				Columns: []QueryExpression{
+					FieldReference{BaseExpr: &BaseExpr{line: 1, char: 29}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "column1"}},
+				},
+				Method: Identifier{BaseExpr: &BaseExpr{line: 1, char: 44}, Literal: "btree"},
+			},
+		},
+	},
+	{
+		Input: "drop index idx",
+		Output: []Statement{
+			DropIndex{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "idx"},
+			},
+		},
+	},
+	{
+		Input: "select index from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "index"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select array_agg, unnest from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "array_agg"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 19}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "unnest"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select group_concat from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "group_concat"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select stddev, stddev_pop, stddev_samp, variance, var_pop, var_samp from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "stddev"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "stddev_pop"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 28}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "stddev_samp"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 41}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "variance"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 51}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "var_pop"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 60}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 60}, Literal: "var_samp"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 74}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select bit_and, bit_or, bit_xor from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "bit_and"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 17}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "bit_or"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 25}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "bit_xor"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select generate_series from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "generate_series"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select tail from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "tail"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select prepare, immediate from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "prepare"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 17}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "immediate"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select check, constraint from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "check"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 15}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "constraint"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select unique from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "unique"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select auto_increment from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "auto_increment"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select copy from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "copy"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select explain from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "explain"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select collate from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "collate"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select corr, covar_pop, covar_samp, regr_slope, regr_intercept, regr_r2 from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "corr"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 14}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "covar_pop"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 25}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "covar_samp"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 37}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "regr_slope"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 49}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "regr_intercept"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 65}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 65}, Literal: "regr_r2"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 78}, Literal: "table1"}},
 						},
 					},
-					WhileInCursor{
-						Variables: []Variable{
-							{BaseExpr: &BaseExpr{line: 8, char: 7}, Name: "var1"},
-							{BaseExpr: &BaseExpr{line: 8, char: 14}, Name: "var2"},
-						},
-						Cursor: Identifier{BaseExpr: &BaseExpr{line: 8, char: 23}, Literal: "cur"},
-						Statements: []Statement{
-							Print{Value: Variable{BaseExpr: &BaseExpr{line: 8, char: 36}, Name: "var1"}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select mode, percentile_cont, percentile_disc from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "mode"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 14}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "percentile_cont"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 31}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "percentile_disc"}}},
 						},
 					},
-					Case{
-						When: []CaseWhen{
-							{
-								Condition: NewTernaryValueFromString("true"),
-								Statements: []Statement{
-									Print{Value: Variable{BaseExpr: &BaseExpr{line: 9, char: 27}, Name: "var1"}},
-								},
-							},
-							{
-								Condition: NewTernaryValueFromString("false"),
-								Statements: []Statement{
-									Print{Value: Variable{BaseExpr: &BaseExpr{line: 9, char: 56}, Name: "var2"}},
-								},
-							},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "table1"}},
 						},
 					},
-					Case{
-						When: []CaseWhen{
-							{
-								Condition: NewTernaryValueFromString("true"),
-								Statements: []Statement{
-									Print{Value: Variable{BaseExpr: &BaseExpr{line: 10, char: 27}, Name: "var1"}},
-								},
-							},
-							{
-								Condition: NewTernaryValueFromString("false"),
-								Statements: []Statement{
-									Return{Value: NewNullValue()},
-								},
-							},
+				},
+			},
+		},
+	},
+	{
+		Input: "select try, catch from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "try"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 13}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "catch"}}},
 						},
-						Else: CaseElse{
-							Statements: []Statement{
-								Return{Value: NewNullValue()},
-							},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "table1"}},
 						},
 					},
-					While{
-						Condition: NewTernaryValueFromString("true"),
-						Statements: []Statement{
-							Case{
-								When: []CaseWhen{
-									{
-										Condition: NewTernaryValueFromString("true"),
-										Statements: []Statement{
-											Print{Value: Variable{BaseExpr: &BaseExpr{line: 11, char: 41}, Name: "var1"}},
-										},
-									},
-									{
-										Condition: NewTernaryValueFromString("false"),
-										Statements: []Statement{
-											FlowControl{Token: CONTINUE},
-										},
-									},
-								},
-							},
+				},
+			},
+		},
+	},
+	{
+		Input: "select import from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "import"}}},
 						},
 					},
-					While{
-						Condition: NewTernaryValueFromString("true"),
-						Statements: []Statement{
-							Case{
-								When: []CaseWhen{
-									{
-										Condition: NewTernaryValueFromString("true"),
-										Statements: []Statement{
-											Print{Value: Variable{BaseExpr: &BaseExpr{line: 12, char: 41}, Name: "var1"}},
-										},
-									},
-									{
-										Condition: NewTernaryValueFromString("false"),
-										Statements: []Statement{
-											Return{Value: NewNullValue()},
-										},
-									},
-								},
-								Else: CaseElse{
-									Statements: []Statement{
-										FlowControl{Token: CONTINUE},
-									},
-								},
-							},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "table1"}},
 						},
 					},
-					Return{
-						Value: NewNullValue(),
+				},
+			},
+		},
+	},
+	{
+		Input: "select variadic from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "variadic"}}},
+						},
 					},
-					Return{
-						Value: Variable{BaseExpr: &BaseExpr{line: 14, char: 8}, Name: "var1"},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "table1"}},
+						},
 					},
 				},
 			},
 		},
 	},
 	{
-		Input: "declare aggfunc aggregate (cur) as begin end",
+		Input: "select bulk from table1",
 		Output: []Statement{
-			AggregateDeclaration{
-				Name:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "aggfunc"},
-				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "cur"},
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "bulk"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "table1"}},
+						},
+					},
+				},
 			},
 		},
 	},
 	{
-		Input: "declare aggfunc aggregate (cur, @var1) as begin end",
+		Input: "select export from table1",
 		Output: []Statement{
-			AggregateDeclaration{
-				Name:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "aggfunc"},
-				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "cur"},
-				Parameters: []VariableAssignment{
-					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 33}, Name: "var1"}},
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "export"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "table1"}},
+						},
+					},
 				},
 			},
 		},
 	},
 	{
-		Input: "declare aggfunc aggregate (cur, @var1, @var2) as begin end",
+		Input: "select assert, expect from table1",
 		Output: []Statement{
-			AggregateDeclaration{
-				Name:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "aggfunc"},
-				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "cur"},
-				Parameters: []VariableAssignment{
-					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 33}, Name: "var1"}},
-					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 40}, Name: "var2"}},
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "assert"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "expect"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "table1"}},
+						},
+					},
 				},
 			},
 		},
@@ -5132,6 +7303,20 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "dispose function util.userfunc",
+		Output: []Statement{
+			DisposeFunction{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "util.userfunc"},
+			},
+		},
+	},
+	{
+		Input: "print @util.var1",
+		Output: []Statement{
+			Print{BaseExpr: &BaseExpr{line: 1, char: 1}, Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 7}, Name: "util.var1"}},
+		},
+	},
 	{
 		Input: "select @var1 := @var2 + @var3",
 		Output: []Statement{
@@ -5144,6 +7329,7 @@ var parseTests = []struct {
 							Object: VariableSubstitution{
 								Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 8}, Name: "var1"},
 								Value: Arithmetic{
+									BaseExpr: &BaseExpr{line: 1, char: 23},
 									LHS:      Variable{BaseExpr: &BaseExpr{line: 1, char: 17}, Name: "var2"},
 									Operator: int('+'),
 									RHS:      Variable{BaseExpr: &BaseExpr{line: 1, char: 25}, Name: "var3"},
@@ -5203,6 +7389,28 @@ var parseTests = []struct {
 			}},
 		},
 	},
+	{
+		Input: "select ?, :id, ?",
+		Output: []Statement{
+			SelectQuery{SelectEntity: SelectEntity{
+				SelectClause: SelectClause{
+					BaseExpr: &BaseExpr{line: 1, char: 1},
+					Select:   "select",
+					Fields: []QueryExpression{
+						Field{
+							Object: Placeholder{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "?", Ordinal: 1},
+						},
+						Field{
+							Object: Placeholder{BaseExpr: &BaseExpr{line: 1, char: 11}, Literal: ":id", Name: "id"},
+						},
+						Field{
+							Object: Placeholder{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "?", Ordinal: 2},
+						},
+					},
+				},
+			}},
+		},
+	},
 	{
 		Input: "select ties",
 		Output: []Statement{
@@ -5416,6 +7624,14 @@ var parseTests = []struct {
 		ErrorChar:  13,
 		ErrorFile:  GetTestFilePath("dummy.sql"),
 	},
+	{
+		Input:      "try print 1 catch print 2; end try",
+		SourceFile: GetTestFilePath("dummy.sql"),
+		Error:      "syntax error: unexpected token \"catch\"",
+		ErrorLine:  1,
+		ErrorChar:  13,
+		ErrorFile:  GetTestFilePath("dummy.sql"),
+	},
 	{
 		Input:      "print !=",
 		SourceFile: GetTestFilePath("dummy.sql"),
@@ -5526,3 +7742,61 @@ func TestParse(t *testing.T) {
 		}
 	}
 }
+
+func TestParseImmediateStatement(t *testing.T) {
+	identifiers := map[string]string{
+		"??":     "c1",
+		"::tbl":  "table1",
+		"::none": "",
+	}
+	resolve := func(placeholder Placeholder) (string, error) {
+		if placeholder.Literal == "::none" {
+			return "", errors.New("identifier is not specified")
+		}
+		return identifiers[placeholder.Literal], nil
+	}
+
+	s := "select ?, ?? from ::tbl where c2 = ?"
+	expect := []Statement{
+		SelectQuery{
+			SelectEntity: SelectEntity{
+				SelectClause: SelectClause{
+					BaseExpr: &BaseExpr{line: 1, char: 1},
+					Select:   "select",
+					Fields: []QueryExpression{
+						Field{Object: Placeholder{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "?", Ordinal: 1}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 11}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 11}, Literal: "c1", Quoted: true}}},
+					},
+				},
+				FromClause: FromClause{
+					From:   "from",
+					Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "table1", Quoted: true}}},
+				},
+				WhereClause: WhereClause{
+					Where: "where",
+					Filter: Comparison{
+						LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 31}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "c2"}},
+						RHS:      Placeholder{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "?", Ordinal: 3},
+						Operator: "=",
+					},
+				},
+			},
+		},
+	}
+
+	program, holderNumber, err := ParseImmediateStatement(s, "", resolve)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if holderNumber != 3 {
+		t.Errorf("holder number = %d, want %d", holderNumber, 3)
+	}
+	if !reflect.DeepEqual(program, expect) {
+		t.Errorf("output = %#v, want %#v", program, expect)
+	}
+
+	_, _, err = ParseImmediateStatement("select ::none", "", resolve)
+	if err == nil || err.Error() != "identifier is not specified" {
+		t.Errorf("error = %v, want %q", err, "identifier is not specified")
+	}
+}
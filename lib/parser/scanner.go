@@ -30,6 +30,12 @@ const (
 	EnvironmentVariableSign = '%'
 	ExternalCommandSign     = '$'
 	RuntimeInformationSign  = '#'
+	NamedPlaceholderSign    = ':'
+	HintSign                = '+'
+	NamespaceSeparator      = '.'
+
+	PositionalPlaceholder           = "?"
+	PositionalIdentifierPlaceholder = "??"
 
 	SubstitutionOperator = ":="
 
@@ -57,11 +63,31 @@ var aggregateFunctions = []string{
 	"SUM",
 	"AVG",
 	"MEDIAN",
+	"STDDEV",
+	"STDDEV_POP",
+	"STDDEV_SAMP",
+	"VARIANCE",
+	"VAR_POP",
+	"VAR_SAMP",
+	"BIT_AND",
+	"BIT_OR",
+	"BIT_XOR",
+	"CORR",
+	"COVAR_POP",
+	"COVAR_SAMP",
+	"REGR_SLOPE",
+	"REGR_INTERCEPT",
+	"REGR_R2",
+	"MODE",
+	"PERCENTILE_DISC",
+	"PERCENTILE_CONT",
 }
 
 var listFunctions = []string{
 	"LISTAGG",
+	"GROUP_CONCAT",
 	"JSON_AGG",
+	"ARRAY_AGG",
 }
 
 var analyticFunctions = []string{
@@ -100,6 +126,12 @@ type Scanner struct {
 	line       int
 	char       int
 	sourceFile string
+
+	prevToken     int
+	statementHead int
+	parenDepth    int
+	queryStarted  bool
+	tableElements bool
 }
 
 func (s *Scanner) Init(src string, sourceFile string) *Scanner {
@@ -110,6 +142,11 @@ func (s *Scanner) Init(src string, sourceFile string) *Scanner {
 	s.line = 1
 	s.char = 0
 	s.sourceFile = sourceFile
+	s.prevToken = EOF
+	s.statementHead = EOF
+	s.parenDepth = 0
+	s.queryStarted = false
+	s.tableElements = false
 	return s
 }
 
@@ -173,6 +210,11 @@ func (s *Scanner) Scan() (Token, error) {
 			token = TERNARY
 		} else if t, e := s.searchKeyword(literal); e == nil {
 			token = rune(t)
+			if !s.isKeywordInPlace(t) {
+				token = IDENTIFIER
+			}
+		} else if !s.isFollowedByParenthesis() {
+			token = IDENTIFIER
 		} else if s.isAggregateFunctions(literal) {
 			token = AGGREGATE_FUNCTION
 		} else if s.isListaggFunctions(literal) {
@@ -186,6 +228,15 @@ func (s *Scanner) Scan() (Token, error) {
 		} else {
 			token = IDENTIFIER
 		}
+	case ch == NamedPlaceholderSign && s.isIdentRune(s.peek()):
+		s.scanIdentifier(s.next())
+		literal = string(NamedPlaceholderSign) + s.literal.String()
+		token = PLACEHOLDER
+	case ch == NamedPlaceholderSign && s.peek() == NamedPlaceholderSign && s.srcPos+1 < len(s.src) && s.isIdentRune(s.src[s.srcPos+1]):
+		s.next()
+		s.scanIdentifier(s.next())
+		literal = string(NamedPlaceholderSign) + string(NamedPlaceholderSign) + s.literal.String()
+		token = PLACEHOLDER
 	case s.isOperatorRune(ch):
 		s.scanOperator(ch)
 
@@ -221,6 +272,9 @@ func (s *Scanner) Scan() (Token, error) {
 		} else {
 			if s.isIdentRune(s.peek()) {
 				s.scanIdentifier(s.next())
+				if token == VARIABLE {
+					s.scanQualifiedName()
+				}
 				literal = s.literal.String()
 			} else {
 				literal = ""
@@ -235,6 +289,13 @@ func (s *Scanner) Scan() (Token, error) {
 		literal = s.literal.String()
 		token = EXTERNAL_COMMAND
 	case s.isCommentRune(ch):
+		if s.prevToken == SELECT && s.peek() == HintSign {
+			s.next()
+			s.scanHint()
+			literal = s.literal.String()
+			token = HINT
+			break
+		}
 		s.scanComment()
 		return s.Scan()
 	case s.isLineCommentRune(ch):
@@ -244,6 +305,12 @@ func (s *Scanner) Scan() (Token, error) {
 		switch ch {
 		case EOF:
 			break
+		case '?':
+			if s.peek() == '?' {
+				s.next()
+				literal = PositionalIdentifierPlaceholder
+			}
+			token = PLACEHOLDER
 		case '"', '\'':
 			s.scanString(ch)
 			literal = cmd.UnescapeString(s.literal.String())
@@ -260,6 +327,12 @@ func (s *Scanner) Scan() (Token, error) {
 		}
 	}
 
+	if s.isStatementHead() {
+		s.statementHead = int(token)
+		s.queryStarted = false
+	}
+	s.trackTableElements(int(token))
+	s.prevToken = int(token)
 	return Token{Token: int(token), Literal: literal, Quoted: quoted, Line: line, Char: char, SourceFile: s.sourceFile}, s.err
 }
 
@@ -297,6 +370,15 @@ func (s *Scanner) scanIdentifier(head rune) {
 	}
 }
 
+func (s *Scanner) scanQualifiedName() {
+	for s.peek() == NamespaceSeparator && s.srcPos+1 < len(s.src) && s.isIdentRune(s.src[s.srcPos+1]) {
+		s.literal.WriteRune(s.next())
+		for s.isIdentRune(s.peek()) {
+			s.literal.WriteRune(s.next())
+		}
+	}
+}
+
 func (s *Scanner) isIdentRune(ch rune) bool {
 	return ch == '_' || unicode.IsLetter(ch) || unicode.IsDigit(ch)
 }
@@ -350,6 +432,169 @@ func (s *Scanner) searchKeyword(str string) (int, error) {
 	return IDENTIFIER, errors.New(fmt.Sprintf("%q is not a keyword", str))
 }
 
+// isKeywordInPlace reports whether the keyword is placed where it is used.
+// Some keywords are scanned as identifiers elsewhere so that they can be used as names.
+func (s *Scanner) isKeywordInPlace(token int) bool {
+	switch token {
+	case UNNEST, GENERATE_SERIES, CHECK:
+		return s.isFollowedByParenthesis()
+	case CONSTRAINT:
+		return s.isFollowedByName()
+	case UNIQUE:
+		return s.tableElements && (s.isFollowedByParenthesis() || s.isColumnConstraintHead())
+	case AUTO_INCREMENT:
+		return s.tableElements && s.isColumnConstraintHead()
+	case TAIL:
+		return (s.prevToken == FROM || s.prevToken == JOIN || s.prevToken == ',') && s.isFollowedByName()
+	case PREPARE:
+		return s.prevToken == DISPOSE || s.isStatementHead()
+	case COPY, EXPLAIN, TRY, CATCH, IMPORT, EXPORT, ASSERT, EXPECT:
+		return s.isStatementHead()
+	case ESTIMATE:
+		return s.isStatementHead() && (s.isFollowedByKeyword(SELECT) || s.isFollowedByKeyword(WITH) || s.isFollowedByParenthesis())
+	case IMMEDIATE:
+		return s.prevToken == EXECUTE
+	case BULK:
+		return s.statementHead == FETCH && s.prevToken == IDENTIFIER
+	case VARIADIC:
+		return s.isFollowedBy(VariableSign)
+	case COLLATE:
+		return s.isFollowedByName() || s.isFollowedByKeyword(NATURAL)
+	case AT:
+		return s.isFollowedByValue()
+	}
+	return true
+}
+
+// trackTableElements records whether the scanner is in the column definitions of CREATE TABLE or DECLARE VIEW.
+func (s *Scanner) trackTableElements(token int) {
+	switch token {
+	case '(':
+		s.parenDepth++
+		if s.parenDepth == 1 {
+			s.tableElements = !s.queryStarted && (s.statementHead == CREATE || s.statementHead == DECLARE)
+		}
+	case ')':
+		if 0 < s.parenDepth {
+			s.parenDepth--
+		}
+		if s.parenDepth < 1 {
+			s.tableElements = false
+		}
+	case SELECT:
+		s.queryStarted = true
+	case ';':
+		s.parenDepth = 0
+		s.tableElements = false
+	}
+}
+
+// isColumnConstraintHead reports whether a column constraint can start after the previous token.
+func (s *Scanner) isColumnConstraintHead() bool {
+	if 1 < s.parenDepth {
+		return false
+	}
+	switch s.prevToken {
+	case IDENTIFIER, ')', NULL, UNIQUE, AUTO_INCREMENT:
+		return true
+	}
+	return false
+}
+
+// isStatementHead reports whether a statement can start after the previous token.
+func (s *Scanner) isStatementHead() bool {
+	switch s.prevToken {
+	case EOF, ';', BEGIN, DO, THEN, ELSE, END, TRY, CATCH:
+		return true
+	}
+	return false
+}
+
+// isFollowedByParenthesis reports whether the next rune except spaces is '('.
+// Names of functions are scanned as identifiers unless they are called.
+func (s *Scanner) isFollowedByParenthesis() bool {
+	return s.isFollowedBy('(')
+}
+
+func (s *Scanner) isFollowedBy(ch rune) bool {
+	for i := s.srcPos; i < len(s.src); i++ {
+		if !unicode.IsSpace(s.src[i]) {
+			return s.src[i] == ch
+		}
+	}
+	return false
+}
+
+// isFollowedByName reports whether the next token is a string or an identifier that is not a keyword.
+func (s *Scanner) isFollowedByName() bool {
+	i := s.srcPos
+	for i < len(s.src) && unicode.IsSpace(s.src[i]) {
+		i++
+	}
+	if len(s.src) <= i {
+		return false
+	}
+
+	switch ch := s.src[i]; {
+	case ch == '`' || ch == '\'' || ch == '"':
+		return true
+	case s.isIdentRune(ch) && !s.isDecimal(ch):
+		_, err := s.searchKeyword(s.nextWord(i))
+		return err != nil
+	}
+	return false
+}
+
+// isFollowedByKeyword reports whether the next token is the keyword.
+// isFollowedByValue reports whether a value or TIME ZONE can follow.
+// AT is scanned as an identifier when it is used as a table alias.
+func (s *Scanner) isFollowedByValue() bool {
+	i := s.srcPos
+	for i < len(s.src) && unicode.IsSpace(s.src[i]) {
+		i++
+	}
+	if len(s.src) <= i {
+		return false
+	}
+
+	switch ch := s.src[i]; {
+	case ch == '.' || ch == ',' || ch == ')' || ch == ';':
+		return false
+	case s.isIdentRune(ch) && !s.isDecimal(ch):
+		t, err := s.searchKeyword(s.nextWord(i))
+		if err != nil {
+			return true
+		}
+		switch t {
+		case TIME, NULL, CASE, NOT, EXISTS, IF, CURSOR, COUNT, JSON_OBJECT, JSON_ROW:
+			return true
+		}
+		return false
+	}
+	return true
+}
+
+func (s *Scanner) isFollowedByKeyword(token int) bool {
+	i := s.srcPos
+	for i < len(s.src) && unicode.IsSpace(s.src[i]) {
+		i++
+	}
+	if len(s.src) <= i || s.isDecimal(s.src[i]) {
+		return false
+	}
+
+	t, err := s.searchKeyword(s.nextWord(i))
+	return err == nil && t == token
+}
+
+func (s *Scanner) nextWord(i int) string {
+	j := i
+	for j < len(s.src) && s.isIdentRune(s.src[j]) {
+		j++
+	}
+	return string(s.src[i:j])
+}
+
 func (s *Scanner) isAggregateFunctions(str string) bool {
 	for _, v := range aggregateFunctions {
 		if strings.EqualFold(v, str) {
@@ -435,6 +680,21 @@ func (s *Scanner) scanComment() {
 	}
 }
 
+func (s *Scanner) scanHint() {
+	s.literal.Reset()
+
+	for {
+		ch := s.next()
+		if ch == EOF {
+			break
+		} else if ch == '*' && s.peek() == '/' {
+			s.next()
+			break
+		}
+		s.literal.WriteRune(ch)
+	}
+}
+
 func (s *Scanner) isLineCommentRune(ch rune) bool {
 	if ch == '-' && s.peek() == '-' {
 		s.next()
@@ -139,6 +139,30 @@ var scanTests = []struct {
 			},
 		},
 	},
+	{
+		Name:  "Qualified Variable",
+		Input: "@util.var",
+		Output: []scanResult{
+			{
+				Token:   VARIABLE,
+				Literal: "util.var",
+			},
+		},
+	},
+	{
+		Name:  "Variable Followed by Period",
+		Input: "@var.",
+		Output: []scanResult{
+			{
+				Token:   VARIABLE,
+				Literal: "var",
+			},
+			{
+				Token:   '.',
+				Literal: ".",
+			},
+		},
+	},
 	{
 		Name:  "Environment Variable",
 		Input: "@%var",
@@ -170,6 +194,34 @@ var scanTests = []struct {
 			},
 		},
 	},
+	{
+		Name:  "Placeholders",
+		Input: "? :name",
+		Output: []scanResult{
+			{
+				Token:   PLACEHOLDER,
+				Literal: "?",
+			},
+			{
+				Token:   PLACEHOLDER,
+				Literal: ":name",
+			},
+		},
+	},
+	{
+		Name:  "Identifier Placeholders",
+		Input: "?? ::name",
+		Output: []scanResult{
+			{
+				Token:   PLACEHOLDER,
+				Literal: "??",
+			},
+			{
+				Token:   PLACEHOLDER,
+				Literal: "::name",
+			},
+		},
+	},
 	{
 		Name:  "EqualSign",
 		Input: "=",
@@ -232,42 +284,115 @@ var scanTests = []struct {
 	},
 	{
 		Name:  "AggregateFunction",
-		Input: "sum",
+		Input: "sum (",
 		Output: []scanResult{
 			{
 				Token:   AGGREGATE_FUNCTION,
 				Literal: "sum",
 			},
+			{
+				Token:   '(',
+				Literal: "(",
+			},
 		},
 	},
 	{
 		Name:  "AnalyticFunction",
-		Input: "rank",
+		Input: "rank (",
 		Output: []scanResult{
 			{
 				Token:   ANALYTIC_FUNCTION,
 				Literal: "rank",
 			},
+			{
+				Token:   '(',
+				Literal: "(",
+			},
 		},
 	},
 	{
 		Name:  "FunctionNTH",
-		Input: "nth_value",
+		Input: "nth_value (",
 		Output: []scanResult{
 			{
 				Token:   FUNCTION_NTH,
 				Literal: "nth_value",
 			},
+			{
+				Token:   '(',
+				Literal: "(",
+			},
 		},
 	},
 	{
 		Name:  "FunctionWithINS",
-		Input: "lag",
+		Input: "lag (",
 		Output: []scanResult{
 			{
 				Token:   FUNCTION_WITH_INS,
 				Literal: "lag",
 			},
+			{
+				Token:   '(',
+				Literal: "(",
+			},
+		},
+	},
+	{
+		Name:  "Function Name Not Called",
+		Input: "sum",
+		Output: []scanResult{
+			{
+				Token:   IDENTIFIER,
+				Literal: "sum",
+			},
+		},
+	},
+	{
+		Name:  "Keyword Not In Place",
+		Input: "unnest",
+		Output: []scanResult{
+			{
+				Token:   IDENTIFIER,
+				Literal: "unnest",
+			},
+		},
+	},
+	{
+		Name:  "Keyword In Place",
+		Input: "from tail `events.csv`",
+		Output: []scanResult{
+			{
+				Token:   FROM,
+				Literal: "from",
+			},
+			{
+				Token:   TAIL,
+				Literal: "tail",
+			},
+			{
+				Token:   IDENTIFIER,
+				Literal: "events.csv",
+				Quoted:  true,
+			},
+		},
+	},
+	{
+		Name:  "Keyword Followed By Keyword",
+		Input: "from tail where",
+		Output: []scanResult{
+			{
+				Token:   FROM,
+				Literal: "from",
+			},
+			{
+				Token:   IDENTIFIER,
+				Literal: "tail",
+			},
+			{
+				Token:   WHERE,
+				Literal: "where",
+			},
 		},
 	},
 	{
@@ -338,6 +463,34 @@ var scanTests = []struct {
 			},
 		},
 	},
+	{
+		Name:  "Hint",
+		Input: "select /*+ hash_join(t1 t2) */ 1 /*+ comment */",
+		Output: []scanResult{
+			{
+				Token:   SELECT,
+				Literal: "select",
+			},
+			{
+				Token:   HINT,
+				Literal: " hash_join(t1 t2) ",
+			},
+			{
+				Token:   INTEGER,
+				Literal: "1",
+			},
+		},
+	},
+	{
+		Name:  "Hint Not Following Select",
+		Input: "identifier /*+ hash_join(t1 t2) */",
+		Output: []scanResult{
+			{
+				Token:   IDENTIFIER,
+				Literal: "identifier",
+			},
+		},
+	},
 	{
 		Name:  "External Command",
 		Input: "$abc",
@@ -1,10 +1,13 @@
 package query
 
 import (
+	"bytes"
+	"math"
 	"sort"
 	"strings"
 
 	"github.com/mithrandie/csvq/lib/json"
+	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 	txjson "github.com/mithrandie/go-text/json"
 
@@ -14,12 +17,62 @@ import (
 type AggregateFunction func([]value.Primary) value.Primary
 
 var AggregateFunctions = map[string]AggregateFunction{
-	"COUNT":  Count,
-	"MAX":    Max,
-	"MIN":    Min,
-	"SUM":    Sum,
-	"AVG":    Avg,
-	"MEDIAN": Median,
+	"COUNT":          Count,
+	"MAX":            Max,
+	"MIN":            Min,
+	"SUM":            Sum,
+	"AVG":            Avg,
+	"MEDIAN":         Median,
+	"STDDEV":         StdDev,
+	"STDDEV_POP":     StdDevPop,
+	"STDDEV_SAMP":    StdDevSamp,
+	"VARIANCE":       Variance,
+	"VAR_POP":        VarPop,
+	"VAR_SAMP":       VarSamp,
+	"BIT_AND":        BitAnd,
+	"BIT_OR":         BitOr,
+	"BIT_XOR":        BitXor,
+	"CORR":           Corr,
+	"COVAR_POP":      CovarPop,
+	"COVAR_SAMP":     CovarSamp,
+	"REGR_SLOPE":     RegrSlope,
+	"REGR_INTERCEPT": RegrIntercept,
+	"REGR_R2":        RegrR2,
+}
+
+// BivariateAggregateFunctions are aggregate functions that take two arguments.
+// The arguments are aggregated as arrays of the pairs of values.
+var BivariateAggregateFunctions = map[string]bool{
+	"CORR":           true,
+	"COVAR_POP":      true,
+	"COVAR_SAMP":     true,
+	"REGR_SLOPE":     true,
+	"REGR_INTERCEPT": true,
+	"REGR_R2":        true,
+}
+
+// OrderedSetAggregateFunctions are aggregate functions that calculate the values of the sort key
+// specified in the WITHIN GROUP clause in the sorted order.
+var OrderedSetAggregateFunctions = map[string]bool{
+	"MODE":            true,
+	"PERCENTILE_DISC": true,
+	"PERCENTILE_CONT": true,
+}
+
+// AggregateListExpression checks the number of the arguments of a built-in aggregate function,
+// and returns the expression to be evaluated for each record.
+func AggregateListExpression(expr parser.QueryExpression, name string, args []parser.QueryExpression) (parser.QueryExpression, error) {
+	if BivariateAggregateFunctions[strings.ToUpper(name)] {
+		if len(args) != 2 {
+			return nil, NewFunctionArgumentLengthError(expr, name, []int{2})
+		}
+		return parser.ArrayValue{BaseExpr: expr.GetBaseExpr(), Values: args}, nil
+	}
+
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(expr, name, []int{1})
+	}
+	return args[0], nil
 }
 
 func Count(list []value.Primary) value.Primary {
@@ -150,6 +203,291 @@ func Median(list []value.Primary) value.Primary {
 	return value.ParseFloat64(median)
 }
 
+// StdDev is an alias of StdDevSamp.
+func StdDev(list []value.Primary) value.Primary {
+	return StdDevSamp(list)
+}
+
+func StdDevPop(list []value.Primary) value.Primary {
+	v, ok := variance(list, false)
+	if !ok {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(math.Sqrt(v))
+}
+
+func StdDevSamp(list []value.Primary) value.Primary {
+	v, ok := variance(list, true)
+	if !ok {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(math.Sqrt(v))
+}
+
+// Variance is an alias of VarSamp.
+func Variance(list []value.Primary) value.Primary {
+	return VarSamp(list)
+}
+
+func VarPop(list []value.Primary) value.Primary {
+	v, ok := variance(list, false)
+	if !ok {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(v)
+}
+
+func VarSamp(list []value.Primary) value.Primary {
+	v, ok := variance(list, true)
+	if !ok {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(v)
+}
+
+// variance calculates the population or the sample variance of float values in the list.
+// If the list does not have enough values, then returns false.
+func variance(list []value.Primary, sample bool) (float64, bool) {
+	values := make([]float64, 0, len(list))
+	var sum float64
+
+	for _, v := range list {
+		f := value.ToFloat(v)
+		if value.IsNull(f) {
+			continue
+		}
+
+		values = append(values, f.(value.Float).Raw())
+		sum += f.(value.Float).Raw()
+	}
+
+	n := len(values)
+	if sample {
+		n--
+	}
+	if n < 1 {
+		return 0, false
+	}
+
+	mean := sum / float64(len(values))
+	var sq float64
+	for _, x := range values {
+		sq += (x - mean) * (x - mean)
+	}
+	return sq / float64(n), true
+}
+
+// Mode returns the most frequent value in the list.
+// If there are multiple most frequent values, then returns the one that appears first in the list.
+func Mode(list []value.Primary) value.Primary {
+	var mode value.Primary = value.NewNull()
+	counts := make(map[string]int)
+	firsts := make(map[string]value.Primary)
+	max := 0
+
+	keyBuf := new(bytes.Buffer)
+	for _, v := range list {
+		if value.IsNull(v) {
+			continue
+		}
+
+		keyBuf.Reset()
+		SerializeComparisonKeys(keyBuf, []value.Primary{v})
+		key := keyBuf.String()
+		if _, ok := firsts[key]; !ok {
+			firsts[key] = v
+		}
+		counts[key]++
+		if max < counts[key] {
+			max = counts[key]
+			mode = firsts[key]
+		}
+	}
+	return mode
+}
+
+// PercentileDisc returns the first value in the list whose position is greater than or equal to
+// the fraction of the number of values.
+func PercentileDisc(list []value.Primary, fraction float64) value.Primary {
+	values := make([]value.Primary, 0, len(list))
+	for _, v := range list {
+		if !value.IsNull(v) {
+			values = append(values, v)
+		}
+	}
+
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	idx := int(math.Ceil(fraction*float64(len(values)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return values[idx]
+}
+
+// PercentileCont returns the value at the position of the fraction in the list
+// by interpolating between the adjacent float values.
+func PercentileCont(list []value.Primary, fraction float64) value.Primary {
+	values := make([]float64, 0, len(list))
+	for _, v := range list {
+		if f := value.ToFloat(v); !value.IsNull(f) {
+			values = append(values, f.(value.Float).Raw())
+		}
+	}
+
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	pos := fraction * float64(len(values)-1)
+	lower := int(math.Floor(pos))
+	if lower == len(values)-1 {
+		return value.ParseFloat64(values[lower])
+	}
+	return value.ParseFloat64(values[lower] + (pos-float64(lower))*(values[lower+1]-values[lower]))
+}
+
+func CovarPop(list []value.Primary) value.Primary {
+	s, ok := bivariateStats(list)
+	if !ok || s.n < 1 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(s.sxy / s.n)
+}
+
+func CovarSamp(list []value.Primary) value.Primary {
+	s, ok := bivariateStats(list)
+	if !ok || s.n < 2 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(s.sxy / (s.n - 1))
+}
+
+func Corr(list []value.Primary) value.Primary {
+	s, ok := bivariateStats(list)
+	if !ok || s.sxx == 0 || s.syy == 0 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(s.sxy / math.Sqrt(s.sxx*s.syy))
+}
+
+func RegrSlope(list []value.Primary) value.Primary {
+	s, ok := bivariateStats(list)
+	if !ok || s.sxx == 0 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(s.sxy / s.sxx)
+}
+
+func RegrIntercept(list []value.Primary) value.Primary {
+	s, ok := bivariateStats(list)
+	if !ok || s.sxx == 0 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(s.meanY - s.sxy/s.sxx*s.meanX)
+}
+
+func RegrR2(list []value.Primary) value.Primary {
+	s, ok := bivariateStats(list)
+	if !ok || s.sxx == 0 {
+		return value.NewNull()
+	}
+	if s.syy == 0 {
+		return value.NewInteger(1)
+	}
+	return value.ParseFloat64(s.sxy * s.sxy / (s.sxx * s.syy))
+}
+
+type bivariate struct {
+	n     float64
+	meanY float64
+	meanX float64
+	syy   float64
+	sxx   float64
+	sxy   float64
+}
+
+// bivariateStats calculates the means and the sums of squared deviations of pairs of
+// dependent and independent float values in the list.
+// Pairs that have a null are ignored. If the list does not have any pairs, then returns false.
+func bivariateStats(list []value.Primary) (bivariate, bool) {
+	ys := make([]float64, 0, len(list))
+	xs := make([]float64, 0, len(list))
+
+	for _, v := range list {
+		arr, ok := v.(value.Array)
+		if !ok || arr.Len() != 2 {
+			continue
+		}
+		y := value.ToFloat(arr.Elem(0))
+		x := value.ToFloat(arr.Elem(1))
+		if value.IsNull(y) || value.IsNull(x) {
+			continue
+		}
+		ys = append(ys, y.(value.Float).Raw())
+		xs = append(xs, x.(value.Float).Raw())
+	}
+
+	s := bivariate{n: float64(len(ys))}
+	if len(ys) < 1 {
+		return s, false
+	}
+
+	for i := range ys {
+		s.meanY += ys[i]
+		s.meanX += xs[i]
+	}
+	s.meanY /= s.n
+	s.meanX /= s.n
+
+	for i := range ys {
+		dy := ys[i] - s.meanY
+		dx := xs[i] - s.meanX
+		s.syy += dy * dy
+		s.sxx += dx * dx
+		s.sxy += dx * dy
+	}
+	return s, true
+}
+
+func BitAnd(list []value.Primary) value.Primary {
+	return bitAggregate(list, func(a int64, b int64) int64 { return a & b })
+}
+
+func BitOr(list []value.Primary) value.Primary {
+	return bitAggregate(list, func(a int64, b int64) int64 { return a | b })
+}
+
+func BitXor(list []value.Primary) value.Primary {
+	return bitAggregate(list, func(a int64, b int64) int64 { return a ^ b })
+}
+
+func bitAggregate(list []value.Primary, fn func(int64, int64) int64) value.Primary {
+	var result int64
+	var count int
+
+	for _, v := range list {
+		i := value.ToInteger(v)
+		if value.IsNull(i) {
+			continue
+		}
+
+		if count < 1 {
+			result = i.(value.Integer).Raw()
+		} else {
+			result = fn(result, i.(value.Integer).Raw())
+		}
+		count++
+	}
+
+	if count < 1 {
+		return value.NewNull()
+	}
+	return value.NewInteger(result)
+}
+
 func ListAgg(list []value.Primary, separator string) value.Primary {
 	strlist := make([]string, 0)
 	for _, v := range list {
@@ -176,3 +514,9 @@ func JsonAgg(list []value.Primary) value.Primary {
 
 	return value.NewString(array.Encode())
 }
+
+func ArrayAgg(list []value.Primary) value.Primary {
+	values := make([]value.Primary, len(list))
+	copy(values, list)
+	return value.NewArray(values)
+}
@@ -208,6 +208,279 @@ func TestMedian(t *testing.T) {
 	}
 }
 
+var varianceTests = []struct {
+	List       []value.Primary
+	VarPop     value.Primary
+	VarSamp    value.Primary
+	StdDevPop  value.Primary
+	StdDevSamp value.Primary
+}{
+	{
+		List: []value.Primary{
+			value.NewInteger(2),
+			value.NewInteger(4),
+			value.NewInteger(4),
+			value.NewNull(),
+			value.NewInteger(4),
+			value.NewInteger(5),
+			value.NewString("5"),
+			value.NewInteger(7),
+			value.NewFloat(9),
+		},
+		VarPop:     value.NewInteger(4),
+		VarSamp:    value.NewFloat(4.571428571428571),
+		StdDevPop:  value.NewInteger(2),
+		StdDevSamp: value.NewFloat(2.138089935299395),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(3),
+			value.NewNull(),
+		},
+		VarPop:     value.NewInteger(0),
+		VarSamp:    value.NewNull(),
+		StdDevPop:  value.NewInteger(0),
+		StdDevSamp: value.NewNull(),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		VarPop:     value.NewNull(),
+		VarSamp:    value.NewNull(),
+		StdDevPop:  value.NewNull(),
+		StdDevSamp: value.NewNull(),
+	},
+}
+
+func TestVariance(t *testing.T) {
+	for _, v := range varianceTests {
+		if r := VarPop(v.List); !reflect.DeepEqual(r, v.VarPop) {
+			t.Errorf("var_pop list = %s: result = %s, want %s", v.List, r, v.VarPop)
+		}
+		if r := VarSamp(v.List); !reflect.DeepEqual(r, v.VarSamp) {
+			t.Errorf("var_samp list = %s: result = %s, want %s", v.List, r, v.VarSamp)
+		}
+		if r := Variance(v.List); !reflect.DeepEqual(r, v.VarSamp) {
+			t.Errorf("variance list = %s: result = %s, want %s", v.List, r, v.VarSamp)
+		}
+		if r := StdDevPop(v.List); !reflect.DeepEqual(r, v.StdDevPop) {
+			t.Errorf("stddev_pop list = %s: result = %s, want %s", v.List, r, v.StdDevPop)
+		}
+		if r := StdDevSamp(v.List); !reflect.DeepEqual(r, v.StdDevSamp) {
+			t.Errorf("stddev_samp list = %s: result = %s, want %s", v.List, r, v.StdDevSamp)
+		}
+		if r := StdDev(v.List); !reflect.DeepEqual(r, v.StdDevSamp) {
+			t.Errorf("stddev list = %s: result = %s, want %s", v.List, r, v.StdDevSamp)
+		}
+	}
+}
+
+var bitAggregateTests = []struct {
+	List []value.Primary
+	And  value.Primary
+	Or   value.Primary
+	Xor  value.Primary
+}{
+	{
+		List: []value.Primary{
+			value.NewInteger(12),
+			value.NewNull(),
+			value.NewString("10"),
+			value.NewFloat(14),
+			value.NewString("abc"),
+			value.NewFloat(1.5),
+		},
+		And: value.NewInteger(8),
+		Or:  value.NewInteger(14),
+		Xor: value.NewInteger(8),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		And: value.NewNull(),
+		Or:  value.NewNull(),
+		Xor: value.NewNull(),
+	},
+}
+
+func TestBitAggregate(t *testing.T) {
+	for _, v := range bitAggregateTests {
+		if r := BitAnd(v.List); !reflect.DeepEqual(r, v.And) {
+			t.Errorf("bit_and list = %s: result = %s, want %s", v.List, r, v.And)
+		}
+		if r := BitOr(v.List); !reflect.DeepEqual(r, v.Or) {
+			t.Errorf("bit_or list = %s: result = %s, want %s", v.List, r, v.Or)
+		}
+		if r := BitXor(v.List); !reflect.DeepEqual(r, v.Xor) {
+			t.Errorf("bit_xor list = %s: result = %s, want %s", v.List, r, v.Xor)
+		}
+	}
+}
+
+var orderedSetAggregateTests = []struct {
+	List     []value.Primary
+	Fraction float64
+	Mode     value.Primary
+	Disc     value.Primary
+	Cont     value.Primary
+}{
+	{
+		List: []value.Primary{
+			value.NewNull(),
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewString("2"),
+			value.NewInteger(3),
+			value.NewInteger(3),
+			value.NewInteger(4),
+		},
+		Fraction: 0.5,
+		Mode:     value.NewInteger(2),
+		Disc:     value.NewString("2"),
+		Cont:     value.NewFloat(2.5),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(4),
+			value.NewInteger(3),
+			value.NewInteger(1),
+		},
+		Fraction: 0,
+		Mode:     value.NewInteger(4),
+		Disc:     value.NewInteger(4),
+		Cont:     value.NewInteger(4),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(4),
+			value.NewInteger(3),
+			value.NewInteger(1),
+		},
+		Fraction: 1,
+		Mode:     value.NewInteger(4),
+		Disc:     value.NewInteger(1),
+		Cont:     value.NewInteger(1),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Fraction: 0.5,
+		Mode:     value.NewNull(),
+		Disc:     value.NewNull(),
+		Cont:     value.NewNull(),
+	},
+}
+
+func TestOrderedSetAggregate(t *testing.T) {
+	for _, v := range orderedSetAggregateTests {
+		if r := Mode(v.List); !reflect.DeepEqual(r, v.Mode) {
+			t.Errorf("mode list = %s: result = %s, want %s", v.List, r, v.Mode)
+		}
+		if r := PercentileDisc(v.List, v.Fraction); !reflect.DeepEqual(r, v.Disc) {
+			t.Errorf("percentile_disc list = %s, fraction = %f: result = %s, want %s", v.List, v.Fraction, r, v.Disc)
+		}
+		if r := PercentileCont(v.List, v.Fraction); !reflect.DeepEqual(r, v.Cont) {
+			t.Errorf("percentile_cont list = %s, fraction = %f: result = %s, want %s", v.List, v.Fraction, r, v.Cont)
+		}
+	}
+}
+
+func pairList(pairs ...[2]value.Primary) []value.Primary {
+	list := make([]value.Primary, len(pairs))
+	for i, p := range pairs {
+		list[i] = value.NewArray([]value.Primary{p[0], p[1]})
+	}
+	return list
+}
+
+var bivariateAggregateTests = []struct {
+	List          []value.Primary
+	CovarPop      value.Primary
+	CovarSamp     value.Primary
+	Corr          value.Primary
+	RegrSlope     value.Primary
+	RegrIntercept value.Primary
+	RegrR2        value.Primary
+}{
+	{
+		List: pairList(
+			[2]value.Primary{value.NewInteger(2), value.NewInteger(1)},
+			[2]value.Primary{value.NewInteger(4), value.NewInteger(2)},
+			[2]value.Primary{value.NewNull(), value.NewInteger(6)},
+			[2]value.Primary{value.NewString("5"), value.NewFloat(3)},
+			[2]value.Primary{value.NewInteger(7), value.NewNull()},
+			[2]value.Primary{value.NewInteger(4), value.NewInteger(4)},
+			[2]value.Primary{value.NewInteger(5), value.NewInteger(5)},
+		),
+		CovarPop:      value.NewFloat(1.2),
+		CovarSamp:     value.NewFloat(1.5),
+		Corr:          value.NewFloat(0.7745966692414834),
+		RegrSlope:     value.NewFloat(0.6),
+		RegrIntercept: value.NewFloat(2.2),
+		RegrR2:        value.NewFloat(0.6),
+	},
+	{
+		List: pairList(
+			[2]value.Primary{value.NewInteger(3), value.NewInteger(1)},
+			[2]value.Primary{value.NewInteger(3), value.NewInteger(2)},
+		),
+		CovarPop:      value.NewInteger(0),
+		CovarSamp:     value.NewInteger(0),
+		Corr:          value.NewNull(),
+		RegrSlope:     value.NewInteger(0),
+		RegrIntercept: value.NewInteger(3),
+		RegrR2:        value.NewInteger(1),
+	},
+	{
+		List: pairList(
+			[2]value.Primary{value.NewInteger(3), value.NewInteger(1)},
+		),
+		CovarPop:      value.NewInteger(0),
+		CovarSamp:     value.NewNull(),
+		Corr:          value.NewNull(),
+		RegrSlope:     value.NewNull(),
+		RegrIntercept: value.NewNull(),
+		RegrR2:        value.NewNull(),
+	},
+	{
+		List: pairList(
+			[2]value.Primary{value.NewNull(), value.NewInteger(1)},
+		),
+		CovarPop:      value.NewNull(),
+		CovarSamp:     value.NewNull(),
+		Corr:          value.NewNull(),
+		RegrSlope:     value.NewNull(),
+		RegrIntercept: value.NewNull(),
+		RegrR2:        value.NewNull(),
+	},
+}
+
+func TestBivariateAggregate(t *testing.T) {
+	for _, v := range bivariateAggregateTests {
+		if r := CovarPop(v.List); !reflect.DeepEqual(r, v.CovarPop) {
+			t.Errorf("covar_pop list = %s: result = %s, want %s", v.List, r, v.CovarPop)
+		}
+		if r := CovarSamp(v.List); !reflect.DeepEqual(r, v.CovarSamp) {
+			t.Errorf("covar_samp list = %s: result = %s, want %s", v.List, r, v.CovarSamp)
+		}
+		if r := Corr(v.List); !reflect.DeepEqual(r, v.Corr) {
+			t.Errorf("corr list = %s: result = %s, want %s", v.List, r, v.Corr)
+		}
+		if r := RegrSlope(v.List); !reflect.DeepEqual(r, v.RegrSlope) {
+			t.Errorf("regr_slope list = %s: result = %s, want %s", v.List, r, v.RegrSlope)
+		}
+		if r := RegrIntercept(v.List); !reflect.DeepEqual(r, v.RegrIntercept) {
+			t.Errorf("regr_intercept list = %s: result = %s, want %s", v.List, r, v.RegrIntercept)
+		}
+		if r := RegrR2(v.List); !reflect.DeepEqual(r, v.RegrR2) {
+			t.Errorf("regr_r2 list = %s: result = %s, want %s", v.List, r, v.RegrR2)
+		}
+	}
+}
+
 var listAggTests = []struct {
 	List      []value.Primary
 	Separator string
@@ -269,3 +542,34 @@ func TestJsonAgg(t *testing.T) {
 		}
 	}
 }
+
+var arrayAggTests = []struct {
+	List   []value.Primary
+	Result value.Primary
+}{
+	{
+		List:   []value.Primary{},
+		Result: value.NewArray([]value.Primary{}),
+	},
+	{
+		List: []value.Primary{
+			value.NewString("str3"),
+			value.NewNull(),
+			value.NewString("str2"),
+		},
+		Result: value.NewArray([]value.Primary{
+			value.NewString("str3"),
+			value.NewNull(),
+			value.NewString("str2"),
+		}),
+	},
+}
+
+func TestArrayAgg(t *testing.T) {
+	for _, v := range arrayAggTests {
+		r := ArrayAgg(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("ArrayAgg list = %s, result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
@@ -22,7 +22,9 @@ var AnalyticFunctions = map[string]AnalyticFunction{
 	"LAG":          Lag{},
 	"LEAD":         Lead{},
 	"LISTAGG":      AnalyticListAgg{},
+	"GROUP_CONCAT": AnalyticListAgg{},
 	"JSON_AGG":     AnalyticJsonAgg{},
+	"ARRAY_AGG":    AnalyticArrayAgg{},
 }
 
 type AnalyticFunction interface {
@@ -72,9 +74,11 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 			return err
 		}
 	case Aggregate:
-		if len(fn.Args) != 1 {
-			return NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+		listExpr, err := AggregateListExpression(fn, fn.Name, fn.Args)
+		if err != nil {
+			return err
 		}
+		fn.Args = []parser.QueryExpression{listExpr}
 	case UserDefined:
 		if err := udfn.CheckArgsLen(fn, fn.Name, len(fn.Args)-1); err != nil {
 			return err
@@ -86,7 +90,7 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 	}
 
 	partitionKeys := make([]string, view.RecordLen())
-	NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+	NewGoroutineTaskManager(view.RecordLen(), -1, view.Filter.cpu()).Run(func(index int) {
 		keyBuf := new(bytes.Buffer)
 
 		if view.sortValuesInEachCell[index] == nil {
@@ -121,7 +125,7 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 		}
 	}
 
-	gm := NewGoroutineTaskManager(len(partitionMapKeys), -1)
+	gm := NewGoroutineTaskManager(len(partitionMapKeys), -1, view.Filter.cpu())
 	for i := 0; i < gm.Number; i++ {
 		gm.Add()
 		go func(thIdx int) {
@@ -449,7 +453,7 @@ func (fn PercentRank) Execute(partition Partition, expr parser.AnalyticFunction,
 	denom := float64(len(partition) - 1)
 	cumulative := float64(0)
 	for _, group := range groups {
-		var dist float64 = 1
+		var dist float64 = 0
 		if 0 < denom {
 			dist = cumulative / denom
 		}
@@ -464,15 +468,21 @@ func (fn PercentRank) Execute(partition Partition, expr parser.AnalyticFunction,
 	return list, nil
 }
 
+// perseCumulativeGroups splits the partition into groups of peer records that have equivalent sort values.
+// If the records are not sorted, then all records in the partition are peers.
 func perseCumulativeGroups(partition Partition, view *View) [][]int {
+	if view.sortValuesInEachRecord == nil {
+		group := make([]int, len(partition))
+		copy(group, partition)
+		return [][]int{group}
+	}
+
 	groups := make([][]int, 0)
 	var currentRank SortValues
 	for _, idx := range partition {
-		if view.sortValuesInEachRecord == nil || !view.sortValuesInEachRecord[idx].EquivalentTo(currentRank) {
+		if !view.sortValuesInEachRecord[idx].EquivalentTo(currentRank) {
 			groups = append(groups, []int{idx})
-			if view.sortValuesInEachRecord != nil {
-				currentRank = view.sortValuesInEachRecord[idx]
-			}
+			currentRank = view.sortValuesInEachRecord[idx]
 		} else {
 			groups[len(groups)-1] = append(groups[len(groups)-1], idx)
 		}
@@ -783,3 +793,36 @@ func (fn AnalyticJsonAgg) Execute(partition Partition, expr parser.AnalyticFunct
 
 	return list, nil
 }
+
+type AnalyticArrayAgg struct{}
+
+func (fn AnalyticArrayAgg) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{1})
+}
+
+func (fn AnalyticArrayAgg) Execute(partition Partition, expr parser.AnalyticFunction, filter *Filter) (map[int]value.Primary, error) {
+	argsFilter := filter.CreateNode()
+	argsFilter.Records = nil
+
+	values := make([]value.Primary, len(partition))
+	for i, idx := range partition {
+		filter.Records[0].RecordIndex = idx
+		val, e := filter.Evaluate(expr.Args[0])
+		if e != nil {
+			return nil, e
+		}
+		values[i] = val
+	}
+	if expr.IsDistinct() {
+		values = Distinguish(values)
+	}
+
+	val := ArrayAgg(values)
+
+	list := make(map[int]value.Primary, len(partition))
+	for _, idx := range partition {
+		list[idx] = val
+	}
+
+	return list, nil
+}
@@ -1550,6 +1550,19 @@ var cumeDistExecuteTests = []analyticFunctionExecuteTests{
 			3: value.NewFloat(1),
 		},
 	},
+	{
+		Name:  "CumeDist Execute Without Sort Values",
+		Items: Partition{2, 4, 1, 3},
+		Function: parser.AnalyticFunction{
+			Name: "cume_dist",
+		},
+		Result: map[int]value.Primary{
+			2: value.NewFloat(1),
+			4: value.NewFloat(1),
+			1: value.NewFloat(1),
+			3: value.NewFloat(1),
+		},
+	},
 }
 
 func TestCumeDist_Execute(t *testing.T) {
@@ -1595,6 +1608,28 @@ var percentRankExecuteTests = []analyticFunctionExecuteTests{
 			5: value.NewFloat(1),
 		},
 	},
+	{
+		Name:  "PercentRank Execute Without Sort Values",
+		Items: Partition{2, 4, 1},
+		Function: parser.AnalyticFunction{
+			Name: "percent_rank",
+		},
+		Result: map[int]value.Primary{
+			2: value.NewFloat(0),
+			4: value.NewFloat(0),
+			1: value.NewFloat(0),
+		},
+	},
+	{
+		Name:  "PercentRank Execute Single Record",
+		Items: Partition{2},
+		Function: parser.AnalyticFunction{
+			Name: "percent_rank",
+		},
+		Result: map[int]value.Primary{
+			2: value.NewFloat(0),
+		},
+	},
 }
 
 func TestPercentRank_Execute(t *testing.T) {
@@ -2417,3 +2452,28 @@ var analyticJsonAggExecuteTests = []analyticFunctionExecuteTests{
 func TestAnalyticJsonAgg_Execute(t *testing.T) {
 	testAnalyticFunctionExecute(t, AnalyticJsonAgg{}, analyticJsonAggExecuteTests)
 }
+
+var analyticArrayAggExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticArrayAgg Execute With Distinct",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name:     "array_agg",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewArray([]value.Primary{value.NewInteger(100), value.NewInteger(200), value.NewNull(), value.NewInteger(300)}),
+			1: value.NewArray([]value.Primary{value.NewInteger(100), value.NewInteger(200), value.NewNull(), value.NewInteger(300)}),
+			2: value.NewArray([]value.Primary{value.NewInteger(100), value.NewInteger(200), value.NewNull(), value.NewInteger(300)}),
+			3: value.NewArray([]value.Primary{value.NewInteger(100), value.NewInteger(200), value.NewNull(), value.NewInteger(300)}),
+			4: value.NewArray([]value.Primary{value.NewInteger(100), value.NewInteger(200), value.NewNull(), value.NewInteger(300)}),
+		},
+	},
+}
+
+func TestAnalyticArrayAgg_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticArrayAgg{}, analyticArrayAggExecuteTests)
+}
@@ -0,0 +1,123 @@
+package query
+
+import (
+	"io"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+const (
+	LoadPhase   = "Load"
+	FilterPhase = "Filter"
+	SortPhase   = "Sort"
+	OtherPhase  = "Other"
+	EncodePhase = "Encode"
+)
+
+// BenchmarkPhases is the list of the phases measured by BenchmarkQuery.
+var BenchmarkPhases = []string{
+	LoadPhase,
+	FilterPhase,
+	SortPhase,
+	OtherPhase,
+	EncodePhase,
+}
+
+// BenchmarkResult is the costs of an execution of a query summed up by phases.
+type BenchmarkResult struct {
+	Phases map[string]*OperationStats
+	Rows   int
+}
+
+func NewBenchmarkResult() *BenchmarkResult {
+	phases := make(map[string]*OperationStats, len(BenchmarkPhases))
+	for _, phase := range BenchmarkPhases {
+		phases[phase] = &OperationStats{}
+	}
+	return &BenchmarkResult{
+		Phases: phases,
+	}
+}
+
+// Total returns the sum of the costs of all the phases.
+func (r *BenchmarkResult) Total() *OperationStats {
+	total := &OperationStats{Rows: r.Rows}
+	for _, stats := range r.Phases {
+		total.Elapsed = total.Elapsed + stats.Elapsed
+		total.Memory = total.Memory + stats.Memory
+		total.Allocs = total.Allocs + stats.Allocs
+	}
+	return total
+}
+
+func (r *BenchmarkResult) add(node *ExplainNode) {
+	if node == nil {
+		return
+	}
+	if node.Stats != nil {
+		stats := r.Phases[benchmarkPhase(node.Operation)]
+		stats.Elapsed = stats.Elapsed + node.Stats.Elapsed
+		stats.Rows = stats.Rows + node.Stats.Rows
+		stats.Memory = stats.Memory + node.Stats.Memory
+		stats.Allocs = stats.Allocs + node.Stats.Allocs
+	}
+	for _, child := range node.Children {
+		r.add(child)
+	}
+}
+
+// benchmarkPhase returns the phase including the step of EXPLAIN ANALYZE.
+func benchmarkPhase(operation string) string {
+	switch {
+	case strings.HasPrefix(operation, "Scan"), operation == "Subquery":
+		return LoadPhase
+	case operation == "Filter":
+		return FilterPhase
+	case operation == "Sort":
+		return SortPhase
+	}
+	return OtherPhase
+}
+
+// BenchmarkQuery executes the select query and encodes the result to w, then returns the costs of the execution.
+// The costs of the steps measured in the same way as EXPLAIN ANALYZE are summed up by their phases.
+func BenchmarkQuery(query parser.SelectQuery, filter *Filter, fileInfo *FileInfo, w io.Writer) (*BenchmarkResult, error) {
+	profiler := newQueryProfiler()
+
+	filter = filter.CreateNode()
+	filter.profiler = profiler
+	view, err := Select(query, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := NewBenchmarkResult()
+	result.add(profiler.root())
+	result.Rows = view.RecordLen()
+
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	alloc := mem.TotalAlloc
+	mallocs := mem.Mallocs
+	start := time.Now()
+
+	if err = EncodeView(w, view, fileInfo); err != nil {
+		if _, ok := err.(*EmptyResultSetError); !ok {
+			return nil, err
+		}
+	}
+
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&mem)
+
+	result.Phases[EncodePhase] = &OperationStats{
+		Elapsed: elapsed,
+		Rows:    view.RecordLen(),
+		Memory:  mem.TotalAlloc - alloc,
+		Allocs:  mem.Mallocs - mallocs,
+	}
+	return result, nil
+}
@@ -0,0 +1,76 @@
+package query
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+)
+
+var benchmarkPhaseTests = []struct {
+	Operation string
+	Phase     string
+}{
+	{Operation: "Scan File", Phase: LoadPhase},
+	{Operation: "Scan View", Phase: LoadPhase},
+	{Operation: "Subquery", Phase: LoadPhase},
+	{Operation: "Filter", Phase: FilterPhase},
+	{Operation: "Sort", Phase: SortPhase},
+	{Operation: "Hash Join", Phase: OtherPhase},
+	{Operation: "Project", Phase: OtherPhase},
+}
+
+func TestBenchmarkPhase(t *testing.T) {
+	for _, v := range benchmarkPhaseTests {
+		if phase := benchmarkPhase(v.Operation); phase != v.Phase {
+			t.Errorf("phase = %q, want %q for %q", phase, v.Phase, v.Operation)
+		}
+	}
+}
+
+func TestBenchmarkQuery(t *testing.T) {
+	filter := &Filter{
+		TempViews: TemporaryViewScopes{
+			ViewMap{
+				"VIEW1": &View{
+					Header: NewHeader("view1", []string{"column1"}),
+					RecordSet: []Record{
+						NewRecord([]value.Primary{value.NewInteger(3)}),
+						NewRecord([]value.Primary{value.NewInteger(1)}),
+						NewRecord([]value.Primary{value.NewInteger(2)}),
+					},
+					FileInfo: &FileInfo{Path: "view1", IsTemporary: true},
+				},
+			},
+		},
+	}
+	fileInfo := &FileInfo{
+		Format:    cmd.CSV,
+		Delimiter: ',',
+		Encoding:  text.UTF8,
+		LineBreak: text.LF,
+	}
+
+	program, _ := parser.Parse("select column1 from view1 where column1 > 1 order by column1", "")
+	buf := &bytes.Buffer{}
+	result, err := BenchmarkQuery(program[0].(parser.SelectQuery), filter, fileInfo, buf)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	if result.Rows != 2 {
+		t.Errorf("rows = %d, want %d", result.Rows, 2)
+	}
+	for phase, rows := range map[string]int{LoadPhase: 3, FilterPhase: 2, SortPhase: 2, EncodePhase: 2} {
+		if result.Phases[phase].Rows != rows {
+			t.Errorf("rows of %s phase = %d, want %d", phase, result.Phases[phase].Rows, rows)
+		}
+	}
+	if expect := "column1\n2\n3"; buf.String() != expect {
+		t.Errorf("output = %q, want %q", buf.String(), expect)
+	}
+}
@@ -1,6 +1,7 @@
 package query
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -120,9 +121,59 @@ func Source(expr parser.Source, filter *Filter) ([]parser.Statement, error) {
 		return nil, NewSourceInvalidFilePathError(expr, expr.FilePath)
 	}
 
+	if !filepath.IsAbs(fpath) && cmd.GetFlags().SourceRelative {
+		if src := expr.SourceFile(); 0 < len(src) && file.Exists(src) {
+			fpath = filepath.Join(filepath.Dir(src), fpath)
+		}
+	}
+
 	return LoadStatementsFromFile(expr, fpath)
 }
 
+// ResolveModulePath returns the absolute path of the module specified by the IMPORT statement.
+// A module name without an extension is completed with ".sql", and a relative name is searched
+// in the directories of the source path in order.
+func ResolveModulePath(expr parser.Import, filter *Filter) (string, error) {
+	var name string
+
+	if ident, ok := expr.Module.(parser.Identifier); ok {
+		name = ident.Literal
+	} else if fr, ok := expr.Module.(parser.FieldReference); ok && len(fr.View.Literal) < 1 {
+		name = fr.Column.Literal
+	} else {
+		p, err := filter.Evaluate(expr.Module)
+		if err != nil {
+			return "", err
+		}
+		s := value.ToString(p)
+		if value.IsNull(s) {
+			return "", NewImportInvalidModuleNameError(expr, expr.Module)
+		}
+		name = s.(value.String).Raw()
+	}
+
+	if len(name) < 1 {
+		return "", NewImportInvalidModuleNameError(expr, expr.Module)
+	}
+
+	if len(filepath.Ext(name)) < 1 {
+		name = name + ".sql"
+	}
+
+	if filepath.IsAbs(name) {
+		if file.Exists(name) {
+			return name, nil
+		}
+	} else {
+		for _, dir := range cmd.GetFlags().SourcePath {
+			if fpath := filepath.Join(dir, name); file.Exists(fpath) {
+				return fpath, nil
+			}
+		}
+	}
+	return "", NewModuleNotFoundError(expr, name)
+}
+
 func LoadStatementsFromFile(expr parser.Source, fpath string) ([]parser.Statement, error) {
 	if !filepath.IsAbs(fpath) {
 		if abs, err := filepath.Abs(fpath); err == nil {
@@ -166,6 +217,9 @@ func ParseExecuteStatements(expr parser.Execute, filter *Filter) ([]parser.State
 
 	args := make([]value.Primary, len(expr.Values))
 	for i, v := range expr.Values {
+		if rv, ok := v.(parser.ReplaceValue); ok {
+			return nil, NewNamedReplaceValueNotAllowedError(rv)
+		}
 		p, err := filter.Evaluate(v)
 		if err != nil {
 			return nil, err
@@ -198,15 +252,15 @@ func SetFlag(expr parser.SetFlag, filter *Filter) error {
 	}
 
 	switch strings.ToUpper(expr.Name) {
-	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DatetimeFormatFlag, cmd.DelimiterFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
+	case cmd.RepositoryFlag, cmd.CatalogFlag, cmd.CacheDirFlag, cmd.SourcePathFlag, cmd.MergeToolFlag, cmd.ConflictDirFlag, cmd.GitCheckFlag, cmd.EncodingErrorsFlag, cmd.QuoteStyleFlag, cmd.TimezoneFlag, cmd.DatetimeFormatFlag, cmd.DecimalSeparatorFlag, cmd.ThousandsSeparatorFlag, cmd.CollationFlag, cmd.LanguageFlag, cmd.DelimiterFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
 		cmd.WriteEncodingFlag, cmd.FormatFlag, cmd.WriteDelimiterFlag, cmd.LineBreakFlag, cmd.JsonEscape:
 		p = value.ToString(p)
-	case cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
-		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag:
+	case cmd.SourceRelativeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.RecoverQuotesFlag, cmd.WithoutHeaderFlag, cmd.NormalizeLineBreakFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
+		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag, cmd.PlainFlag, cmd.QuietFlag, cmd.StatsFlag, cmd.StreamFlag, cmd.FilterOnLoadFlag:
 		p = value.ToBoolean(p)
 	case cmd.WaitTimeoutFlag:
 		p = value.ToFloat(p)
-	case cmd.CPUFlag:
+	case cmd.MaxFieldSizeFlag, cmd.MaxRowSizeFlag, cmd.CPUFlag, cmd.SortMemoryFlag:
 		p = value.ToInteger(p)
 	default:
 		return NewInvalidFlagNameError(expr, expr.Name)
@@ -215,27 +269,67 @@ func SetFlag(expr parser.SetFlag, filter *Filter) error {
 		return NewFlagValueNotAllowedFormatError(expr)
 	}
 
-	flags := cmd.GetFlags()
+	err = cmd.UpdateFlags(func(flags *cmd.Flags) error {
+		return setFlagValue(flags, strings.ToUpper(expr.Name), p)
+	})
+	if err != nil {
+		return NewInvalidFlagValueError(expr, err.Error())
+	}
+	return nil
+}
 
-	switch strings.ToUpper(expr.Name) {
+func setFlagValue(flags *cmd.Flags, name string, p value.Primary) error {
+	var err error
+
+	switch name {
 	case cmd.RepositoryFlag:
 		err = flags.SetRepository(p.(value.String).Raw())
+	case cmd.CatalogFlag:
+		err = flags.SetCatalog(p.(value.String).Raw())
+	case cmd.CacheDirFlag:
+		err = flags.SetCacheDir(p.(value.String).Raw())
+	case cmd.SourcePathFlag:
+		flags.SetSourcePath(p.(value.String).Raw())
+	case cmd.SourceRelativeFlag:
+		flags.SetSourceRelative(p.(value.Boolean).Raw())
 	case cmd.TimezoneFlag:
 		err = flags.SetLocation(p.(value.String).Raw())
 	case cmd.DatetimeFormatFlag:
 		flags.SetDatetimeFormat(p.(value.String).Raw())
+	case cmd.DecimalSeparatorFlag:
+		err = flags.SetDecimalSeparator(p.(value.String).Raw())
+	case cmd.ThousandsSeparatorFlag:
+		err = flags.SetThousandsSeparator(p.(value.String).Raw())
+	case cmd.CollationFlag:
+		err = flags.SetCollation(p.(value.String).Raw())
+	case cmd.LanguageFlag:
+		err = flags.SetLanguage(p.(value.String).Raw())
 	case cmd.WaitTimeoutFlag:
 		flags.SetWaitTimeout(p.(value.Float).Raw())
+	case cmd.MergeToolFlag:
+		flags.SetMergeTool(p.(value.String).Raw())
+	case cmd.ConflictDirFlag:
+		err = flags.SetConflictDir(p.(value.String).Raw())
+	case cmd.GitCheckFlag:
+		err = flags.SetGitCheck(p.(value.String).Raw())
 	case cmd.DelimiterFlag:
 		err = flags.SetDelimiter(p.(value.String).Raw())
 	case cmd.JsonQueryFlag:
 		flags.SetJsonQuery(p.(value.String).Raw())
 	case cmd.EncodingFlag:
 		err = flags.SetEncoding(p.(value.String).Raw())
+	case cmd.EncodingErrorsFlag:
+		err = flags.SetEncodingErrors(p.(value.String).Raw())
 	case cmd.NoHeaderFlag:
 		flags.SetNoHeader(p.(value.Boolean).Raw())
 	case cmd.WithoutNullFlag:
 		flags.SetWithoutNull(p.(value.Boolean).Raw())
+	case cmd.MaxFieldSizeFlag:
+		flags.SetMaxFieldSize(int(p.(value.Integer).Raw()))
+	case cmd.MaxRowSizeFlag:
+		flags.SetMaxRowSize(int(p.(value.Integer).Raw()))
+	case cmd.RecoverQuotesFlag:
+		flags.SetRecoverQuotes(p.(value.Boolean).Raw())
 	case cmd.FormatFlag:
 		err = flags.SetFormat(p.(value.String).Raw(), "")
 	case cmd.WriteEncodingFlag:
@@ -246,8 +340,12 @@ func SetFlag(expr parser.SetFlag, filter *Filter) error {
 		flags.SetWithoutHeader(p.(value.Boolean).Raw())
 	case cmd.LineBreakFlag:
 		err = flags.SetLineBreak(p.(value.String).Raw())
+	case cmd.NormalizeLineBreakFlag:
+		flags.SetNormalizeLineBreak(p.(value.Boolean).Raw())
 	case cmd.EncloseAll:
 		flags.SetEncloseAll(p.(value.Boolean).Raw())
+	case cmd.QuoteStyleFlag:
+		err = flags.SetQuoteStyle(p.(value.String).Raw())
 	case cmd.JsonEscape:
 		err = flags.SetJsonEscape(p.(value.String).Raw())
 	case cmd.PrettyPrintFlag:
@@ -260,18 +358,22 @@ func SetFlag(expr parser.SetFlag, filter *Filter) error {
 		flags.SetCountFormatCode(p.(value.Boolean).Raw())
 	case cmd.ColorFlag:
 		flags.SetColor(p.(value.Boolean).Raw())
+	case cmd.PlainFlag:
+		flags.SetPlain(p.(value.Boolean).Raw())
 	case cmd.QuietFlag:
 		flags.SetQuiet(p.(value.Boolean).Raw())
 	case cmd.CPUFlag:
 		flags.SetCPU(int(p.(value.Integer).Raw()))
+	case cmd.SortMemoryFlag:
+		flags.SetSortMemory(int(p.(value.Integer).Raw()))
 	case cmd.StatsFlag:
 		flags.SetStats(p.(value.Boolean).Raw())
+	case cmd.StreamFlag:
+		flags.SetStream(p.(value.Boolean).Raw())
+	case cmd.FilterOnLoadFlag:
+		flags.SetFilterOnLoad(p.(value.Boolean).Raw())
 	}
-
-	if err != nil {
-		return NewInvalidFlagValueError(expr, err.Error())
-	}
-	return nil
+	return err
 }
 
 func AddFlagElement(expr parser.AddFlagElement, filter *Filter) error {
@@ -283,12 +385,26 @@ func AddFlagElement(expr parser.AddFlagElement, filter *Filter) error {
 			Value:    expr.Value,
 		}
 		return SetFlag(e, filter)
-	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DelimiterFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
+	case cmd.SourcePathFlag:
+		p, err := filter.Evaluate(expr.Value)
+		if err != nil {
+			return err
+		}
+		s := value.ToString(p)
+		if value.IsNull(s) {
+			return NewFlagValueNotAllowedFormatError(parser.SetFlag{BaseExpr: expr.GetBaseExpr(), Name: expr.Name, Value: expr.Value})
+		}
+		_ = cmd.UpdateFlags(func(flags *cmd.Flags) error {
+			flags.AddSourcePath(s.(value.String).Raw())
+			return nil
+		})
+		return nil
+	case cmd.RepositoryFlag, cmd.CatalogFlag, cmd.CacheDirFlag, cmd.MergeToolFlag, cmd.ConflictDirFlag, cmd.GitCheckFlag, cmd.EncodingErrorsFlag, cmd.QuoteStyleFlag, cmd.TimezoneFlag, cmd.DecimalSeparatorFlag, cmd.ThousandsSeparatorFlag, cmd.CollationFlag, cmd.LanguageFlag, cmd.DelimiterFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
 		cmd.WriteEncodingFlag, cmd.FormatFlag, cmd.WriteDelimiterFlag, cmd.LineBreakFlag, cmd.JsonEscape,
-		cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
-		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag,
+		cmd.SourceRelativeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.RecoverQuotesFlag, cmd.WithoutHeaderFlag, cmd.NormalizeLineBreakFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
+		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag, cmd.PlainFlag, cmd.QuietFlag, cmd.StatsFlag, cmd.StreamFlag, cmd.FilterOnLoadFlag,
 		cmd.WaitTimeoutFlag,
-		cmd.CPUFlag:
+		cmd.MaxFieldSizeFlag, cmd.MaxRowSizeFlag, cmd.CPUFlag, cmd.SortMemoryFlag:
 
 		return NewAddFlagNotSupportedNameError(expr)
 	default:
@@ -307,32 +423,64 @@ func RemoveFlagElement(expr parser.RemoveFlagElement, filter *Filter) error {
 
 	switch strings.ToUpper(expr.Name) {
 	case cmd.DatetimeFormatFlag:
-		flags := cmd.GetFlags()
-
 		if i := value.ToInteger(p); !value.IsNull(i) {
 			idx := int(i.(value.Integer).Raw())
-			if -1 < idx && idx < len(flags.DatetimeFormat) {
-				flags.DatetimeFormat = append(flags.DatetimeFormat[:idx], flags.DatetimeFormat[idx+1:]...)
-			}
+			_ = cmd.UpdateFlags(func(flags *cmd.Flags) error {
+				if -1 < idx && idx < len(flags.DatetimeFormat) {
+					flags.DatetimeFormat = append(flags.DatetimeFormat[:idx], flags.DatetimeFormat[idx+1:]...)
+				}
+				return nil
+			})
 
 		} else if s := value.ToString(p); !value.IsNull(s) {
 			val := s.(value.String).Raw()
-			formats := make([]string, 0, len(flags.DatetimeFormat))
-			for _, v := range flags.DatetimeFormat {
-				if val != v {
-					formats = append(formats, v)
+			_ = cmd.UpdateFlags(func(flags *cmd.Flags) error {
+				formats := make([]string, 0, len(flags.DatetimeFormat))
+				for _, v := range flags.DatetimeFormat {
+					if val != v {
+						formats = append(formats, v)
+					}
+				}
+				flags.DatetimeFormat = formats
+				return nil
+			})
+		} else {
+			return NewInvalidFlagValueToBeRemovedError(expr)
+		}
+	case cmd.SourcePathFlag:
+		if i := value.ToInteger(p); !value.IsNull(i) {
+			idx := int(i.(value.Integer).Raw())
+			_ = cmd.UpdateFlags(func(flags *cmd.Flags) error {
+				if -1 < idx && idx < len(flags.SourcePath) {
+					flags.SourcePath = append(flags.SourcePath[:idx], flags.SourcePath[idx+1:]...)
 				}
+				return nil
+			})
+
+		} else if s := value.ToString(p); !value.IsNull(s) {
+			val := s.(value.String).Raw()
+			if path, err := filepath.Abs(val); err == nil {
+				val = path
 			}
-			flags.DatetimeFormat = formats
+			_ = cmd.UpdateFlags(func(flags *cmd.Flags) error {
+				paths := make([]string, 0, len(flags.SourcePath))
+				for _, v := range flags.SourcePath {
+					if val != v {
+						paths = append(paths, v)
+					}
+				}
+				flags.SourcePath = paths
+				return nil
+			})
 		} else {
 			return NewInvalidFlagValueToBeRemovedError(expr)
 		}
-	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DelimiterFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
+	case cmd.RepositoryFlag, cmd.CatalogFlag, cmd.CacheDirFlag, cmd.MergeToolFlag, cmd.ConflictDirFlag, cmd.GitCheckFlag, cmd.EncodingErrorsFlag, cmd.QuoteStyleFlag, cmd.TimezoneFlag, cmd.DecimalSeparatorFlag, cmd.ThousandsSeparatorFlag, cmd.CollationFlag, cmd.LanguageFlag, cmd.DelimiterFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
 		cmd.WriteEncodingFlag, cmd.FormatFlag, cmd.WriteDelimiterFlag, cmd.LineBreakFlag, cmd.JsonEscape,
-		cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
-		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag,
+		cmd.SourceRelativeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.RecoverQuotesFlag, cmd.WithoutHeaderFlag, cmd.NormalizeLineBreakFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
+		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag, cmd.PlainFlag, cmd.QuietFlag, cmd.StatsFlag, cmd.StreamFlag, cmd.FilterOnLoadFlag,
 		cmd.WaitTimeoutFlag,
-		cmd.CPUFlag:
+		cmd.MaxFieldSizeFlag, cmd.MaxRowSizeFlag, cmd.CPUFlag, cmd.SortMemoryFlag:
 
 		return NewRemoveFlagNotSupportedNameError(expr)
 	default:
@@ -366,6 +514,30 @@ func showFlag(flag string) (string, error) {
 		} else {
 			s = palette.Render(cmd.StringEffect, flags.Repository)
 		}
+	case cmd.CatalogFlag:
+		if len(flags.Catalog) < 1 {
+			s = palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			s = palette.Render(cmd.StringEffect, flags.Catalog)
+		}
+	case cmd.CacheDirFlag:
+		if len(flags.CacheDir) < 1 {
+			s = palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			s = palette.Render(cmd.StringEffect, flags.CacheDir)
+		}
+	case cmd.SourcePathFlag:
+		if len(flags.SourcePath) < 1 {
+			s = palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			list := make([]string, 0, len(flags.SourcePath))
+			for _, p := range flags.SourcePath {
+				list = append(list, "\""+p+"\"")
+			}
+			s = palette.Render(cmd.StringEffect, "["+strings.Join(list, ", ")+"]")
+		}
+	case cmd.SourceRelativeFlag:
+		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.SourceRelative))
 	case cmd.TimezoneFlag:
 		s = palette.Render(cmd.StringEffect, flags.Location)
 	case cmd.DatetimeFormatFlag:
@@ -378,8 +550,40 @@ func showFlag(flag string) (string, error) {
 			}
 			s = palette.Render(cmd.StringEffect, "["+strings.Join(list, ", ")+"]")
 		}
+	case cmd.DecimalSeparatorFlag:
+		s = palette.Render(cmd.StringEffect, "'"+flags.DecimalSeparator+"'")
+	case cmd.ThousandsSeparatorFlag:
+		if len(flags.ThousandsSeparator) < 1 {
+			s = palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			s = palette.Render(cmd.StringEffect, "'"+flags.ThousandsSeparator+"'")
+		}
+	case cmd.CollationFlag:
+		s = palette.Render(cmd.StringEffect, flags.Collation)
+	case cmd.LanguageFlag:
+		s = palette.Render(cmd.StringEffect, flags.Language)
 	case cmd.WaitTimeoutFlag:
 		s = palette.Render(cmd.NumberEffect, value.Float64ToStr(flags.WaitTimeout))
+	case cmd.MergeToolFlag:
+		if len(flags.MergeTool) < 1 {
+			s = palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			s = palette.Render(cmd.StringEffect, flags.MergeTool)
+		}
+	case cmd.ConflictDirFlag:
+		if len(flags.ConflictDir) < 1 {
+			s = palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			s = palette.Render(cmd.StringEffect, flags.ConflictDir)
+		}
+	case cmd.GitCheckFlag:
+		s = palette.Render(cmd.StringEffect, flags.GitCheck.String())
+	case cmd.EncodingErrorsFlag:
+		if flags.EncodingErrors == cmd.EncodingErrorsNotSet {
+			s = palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			s = palette.Render(cmd.StringEffect, flags.EncodingErrors.String())
+		}
 	case cmd.DelimiterFlag:
 		d := "'" + cmd.EscapeString(string(flags.Delimiter)) + "'"
 		p := fixedlen.DelimiterPositions(flags.DelimiterPositions).String()
@@ -410,6 +614,12 @@ func showFlag(flag string) (string, error) {
 		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.NoHeader))
 	case cmd.WithoutNullFlag:
 		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.WithoutNull))
+	case cmd.MaxFieldSizeFlag:
+		s = palette.Render(cmd.NumberEffect, strconv.Itoa(flags.MaxFieldSize))
+	case cmd.MaxRowSizeFlag:
+		s = palette.Render(cmd.NumberEffect, strconv.Itoa(flags.MaxRowSize))
+	case cmd.RecoverQuotesFlag:
+		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.RecoverQuotes))
 	case cmd.FormatFlag:
 		s = palette.Render(cmd.StringEffect, flags.Format.String())
 	case cmd.WriteEncodingFlag:
@@ -440,6 +650,8 @@ func showFlag(flag string) (string, error) {
 		}
 	case cmd.LineBreakFlag:
 		s = palette.Render(cmd.StringEffect, flags.LineBreak.String())
+	case cmd.NormalizeLineBreakFlag:
+		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.NormalizeLineBreak))
 	case cmd.EncloseAll:
 		s = strconv.FormatBool(flags.EncloseAll)
 		switch flags.Format {
@@ -448,6 +660,8 @@ func showFlag(flag string) (string, error) {
 		default:
 			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+s)
 		}
+	case cmd.QuoteStyleFlag:
+		s = palette.Render(cmd.StringEffect, flags.QuoteStyle.String())
 	case cmd.JsonEscape:
 		s = cmd.JsonEscapeTypeToString(flags.JsonEscape)
 		switch flags.Format {
@@ -490,12 +704,20 @@ func showFlag(flag string) (string, error) {
 		}
 	case cmd.ColorFlag:
 		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.Color))
+	case cmd.PlainFlag:
+		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.Plain))
 	case cmd.QuietFlag:
 		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.Quiet))
 	case cmd.CPUFlag:
 		s = palette.Render(cmd.NumberEffect, strconv.Itoa(flags.CPU))
+	case cmd.SortMemoryFlag:
+		s = palette.Render(cmd.NumberEffect, strconv.Itoa(flags.SortMemory))
 	case cmd.StatsFlag:
 		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.Stats))
+	case cmd.StreamFlag:
+		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.Stream))
+	case cmd.FilterOnLoadFlag:
+		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.FilterOnLoad))
 	default:
 		return s, errors.New("invalid flag name")
 	}
@@ -695,12 +917,18 @@ func ShowObjects(expr parser.ShowObjects, filter *Filter) (string, error) {
 	case ShowRuninfo:
 		for _, ri := range RuntimeInformatinList {
 			label := string(parser.VariableSign) + string(parser.RuntimeInformationSign) + ri
-			p, _ := GetRuntimeInformation(parser.RuntimeInformation{Name: ri})
-
 			w.WriteSpaces(19 - len(label))
 			w.WriteColorWithoutLineBreak(label, cmd.LableEffect)
 			w.WriteColorWithoutLineBreak(":", cmd.LableEffect)
 			w.WriteSpaces(1)
+
+			if ri == LatestVersionInformation {
+				w.WriteColorWithoutLineBreak("(fetched on reference)", cmd.NullEffect)
+				w.NewLine()
+				continue
+			}
+
+			p, _ := GetRuntimeInformation(parser.RuntimeInformation{Name: ri})
 			switch ri {
 			case WorkingDirectory, VersionInformation:
 				w.WriteColorWithoutLineBreak(p.(value.String).Raw(), cmd.StringEffect)
@@ -810,35 +1038,42 @@ func writeFunctions(w *ObjectWriter, funcs UserDefinedFunctionMap) {
 	keys := funcs.SortedKeys()
 
 	for _, key := range keys {
-		fn := funcs[key]
+		for _, fn := range funcs[key].variants() {
+			writeFunction(w, fn)
+		}
+	}
+}
 
-		w.WriteColor(fn.Name.String(), cmd.ObjectEffect)
-		w.WriteWithoutLineBreak(" (")
+func writeFunction(w *ObjectWriter, fn *UserDefinedFunction) {
+	w.WriteColor(fn.Name.String(), cmd.ObjectEffect)
+	w.WriteWithoutLineBreak(" (")
 
-		if fn.IsAggregate {
-			w.WriteColorWithoutLineBreak(fn.Cursor.String(), cmd.IdentifierEffect)
-			if 0 < len(fn.Parameters) {
-				w.WriteWithoutLineBreak(", ")
-			}
+	if fn.IsAggregate {
+		w.WriteColorWithoutLineBreak(fn.Cursor.String(), cmd.IdentifierEffect)
+		if 0 < len(fn.Parameters) {
+			w.WriteWithoutLineBreak(", ")
 		}
+	}
 
-		for i, p := range fn.Parameters {
-			if 0 < i {
-				w.WriteWithoutLineBreak(", ")
-			}
-			if def, ok := fn.Defaults[p.Name]; ok {
-				w.WriteColorWithoutLineBreak(p.String(), cmd.AttributeEffect)
-				w.WriteWithoutLineBreak(" = ")
-				w.WriteColorWithoutLineBreak(def.String(), cmd.ValueEffect)
-			} else {
-				w.WriteColorWithoutLineBreak(p.String(), cmd.AttributeEffect)
-			}
+	for i, p := range fn.Parameters {
+		if 0 < i {
+			w.WriteWithoutLineBreak(", ")
+		}
+		if fn.Variadic && i == len(fn.Parameters)-1 {
+			w.WriteWithoutLineBreak("VARIADIC ")
+			w.WriteColorWithoutLineBreak(p.String(), cmd.AttributeEffect)
+		} else if def, ok := fn.Defaults[p.Name]; ok {
+			w.WriteColorWithoutLineBreak(p.String(), cmd.AttributeEffect)
+			w.WriteWithoutLineBreak(" = ")
+			w.WriteColorWithoutLineBreak(def.String(), cmd.ValueEffect)
+		} else {
+			w.WriteColorWithoutLineBreak(p.String(), cmd.AttributeEffect)
 		}
-
-		w.WriteWithoutLineBreak(")")
-		w.ClearBlock()
-		w.NewLine()
 	}
+
+	w.WriteWithoutLineBreak(")")
+	w.ClearBlock()
+	w.NewLine()
 }
 
 func ShowFields(expr parser.ShowFields, filter *Filter) (string, error) {
@@ -951,6 +1186,52 @@ func UnsetEnvVar(expr parser.UnsetEnvVar) error {
 	return os.Unsetenv(expr.EnvVar.Name)
 }
 
+// Export returns the commands that reproduce the environment variables in a POSIX shell.
+// If a file path is specified, then the commands are appended to the file and an empty string is returned.
+func Export(expr parser.Export, filter *Filter) (string, error) {
+	buf := &bytes.Buffer{}
+	for _, v := range expr.EnvVars {
+		if val, ok := os.LookupEnv(v.Name); ok {
+			buf.WriteString("export " + v.Name + "=" + shellQuote(val) + "\n")
+		} else {
+			buf.WriteString("unset " + v.Name + "\n")
+		}
+	}
+
+	if expr.FilePath == nil {
+		return strings.TrimSuffix(buf.String(), "\n"), nil
+	}
+
+	p, err := filter.Evaluate(expr.FilePath)
+	if err != nil {
+		return "", err
+	}
+	s := value.ToString(p)
+	if value.IsNull(s) || len(s.(value.String).Raw()) < 1 {
+		return "", NewPathError(expr, expr.FilePath.String(), "invalid file path")
+	}
+	fpath := s.(value.String).Raw()
+
+	fp, err := os.OpenFile(fpath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err == nil {
+		_, err = fp.Write(buf.Bytes())
+		if e := fp.Close(); err == nil {
+			err = e
+		}
+	}
+	if err != nil {
+		if patherr, ok := err.(*os.PathError); ok {
+			return "", NewPathError(expr, patherr.Path, patherr.Err.Error())
+		}
+		return "", NewPathError(expr, fpath, err.Error())
+	}
+	return "", nil
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", "'\\''", -1) + "'"
+}
+
 func Chdir(expr parser.Chdir, filter *Filter) error {
 	var dirpath string
 	var err error
@@ -996,10 +1277,12 @@ func Reload(expr parser.Reload) error {
 
 		env, _ := cmd.GetEnvironment()
 
-		flags := cmd.GetFlags()
-		for _, v := range env.DatetimeFormat {
-			flags.DatetimeFormat = cmd.AppendStrIfNotExist(flags.DatetimeFormat, v)
-		}
+		_ = cmd.UpdateFlags(func(flags *cmd.Flags) error {
+			for _, v := range env.DatetimeFormat {
+				flags.DatetimeFormat = cmd.AppendStrIfNotExist(flags.DatetimeFormat, v)
+			}
+			return nil
+		})
 
 		palette, err := color.GeneratePalette(env.Palette)
 		if err != nil {
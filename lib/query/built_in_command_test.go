@@ -202,10 +202,11 @@ func TestPrintf(t *testing.T) {
 }
 
 var sourceTests = []struct {
-	Name   string
-	Expr   parser.Source
-	Result []parser.Statement
-	Error  string
+	Name           string
+	Expr           parser.Source
+	SourceRelative bool
+	Result         []parser.Statement
+	Error          string
 }{
 	{
 		Name: "Source",
@@ -214,7 +215,8 @@ var sourceTests = []struct {
 		},
 		Result: []parser.Statement{
 			parser.Print{
-				Value: parser.NewStringValue("external executable file"),
+				BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 1, SourceFile: GetTestFilePath("source.sql")}),
+				Value:    parser.NewStringValue("external executable file"),
 			},
 		},
 	},
@@ -225,7 +227,22 @@ var sourceTests = []struct {
 		},
 		Result: []parser.Statement{
 			parser.Print{
-				Value: parser.NewStringValue("external executable file"),
+				BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 1, SourceFile: GetTestFilePath("source.sql")}),
+				Value:    parser.NewStringValue("external executable file"),
+			},
+		},
+	},
+	{
+		Name: "Source Relative to the Including File",
+		Expr: parser.Source{
+			BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 1, SourceFile: GetTestFilePath("source_syntaxerror.sql")}),
+			FilePath: parser.NewStringValue("source.sql"),
+		},
+		SourceRelative: true,
+		Result: []parser.Statement{
+			parser.Print{
+				BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 1, SourceFile: GetTestFilePath("source.sql")}),
+				Value:    parser.NewStringValue("external executable file"),
 			},
 		},
 	},
@@ -267,9 +284,21 @@ var sourceTests = []struct {
 }
 
 func TestSource(t *testing.T) {
+	defer func() {
+		_ = cmd.UpdateFlags(func(flags *cmd.Flags) error {
+			flags.SetSourceRelative(false)
+			return nil
+		})
+	}()
+
 	filter := NewEmptyFilter()
 
 	for _, v := range sourceTests {
+		_ = cmd.UpdateFlags(func(flags *cmd.Flags) error {
+			flags.SetSourceRelative(v.SourceRelative)
+			return nil
+		})
+
 		result, err := Source(v.Expr, filter)
 		if err != nil {
 			if len(v.Error) < 1 {
@@ -289,6 +318,90 @@ func TestSource(t *testing.T) {
 	}
 }
 
+var resolveModulePathTests = []struct {
+	Name   string
+	Expr   parser.Import
+	Result string
+	Error  string
+}{
+	{
+		Name: "ResolveModulePath",
+		Expr: parser.Import{
+			Module: parser.Identifier{Literal: "source"},
+		},
+		Result: GetTestFilePath("source.sql"),
+	},
+	{
+		Name: "ResolveModulePath with Extension",
+		Expr: parser.Import{
+			Module: parser.NewStringValue("source.sql"),
+		},
+		Result: GetTestFilePath("source.sql"),
+	},
+	{
+		Name: "ResolveModulePath Absolute Path",
+		Expr: parser.Import{
+			Module: parser.NewStringValue(GetTestFilePath("source")),
+		},
+		Result: GetTestFilePath("source.sql"),
+	},
+	{
+		Name: "ResolveModulePath Argument Evaluation Error",
+		Expr: parser.Import{
+			Module: parser.FieldReference{View: parser.Identifier{Literal: "tbl"}, Column: parser.Identifier{Literal: "ident"}},
+		},
+		Error: "[L:- C:-] field tbl.ident does not exist",
+	},
+	{
+		Name: "ResolveModulePath Invalid Module Name Error",
+		Expr: parser.Import{
+			Module: parser.NewNullValueFromString("NULL"),
+		},
+		Error: "[L:- C:-] NULL is a invalid module name",
+	},
+	{
+		Name: "ResolveModulePath Module Not Found Error",
+		Expr: parser.Import{
+			Module: parser.Identifier{Literal: "notexist"},
+		},
+		Error: "[L:- C:-] module notexist.sql is not found in the source path",
+	},
+}
+
+func TestResolveModulePath(t *testing.T) {
+	_ = cmd.UpdateFlags(func(flags *cmd.Flags) error {
+		flags.SetSourcePath(TestDataDir + string(os.PathListSeparator) + TestDir)
+		return nil
+	})
+	defer func() {
+		_ = cmd.UpdateFlags(func(flags *cmd.Flags) error {
+			flags.SetSourcePath("")
+			return nil
+		})
+	}()
+
+	filter := NewEmptyFilter()
+
+	for _, v := range resolveModulePathTests {
+		result, err := ResolveModulePath(v.Expr, filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, v.Result)
+		}
+	}
+}
+
 var parseExecuteStatementsTests = []struct {
 	Name   string
 	Expr   parser.Execute
@@ -306,7 +419,8 @@ var parseExecuteStatementsTests = []struct {
 		},
 		Result: []parser.Statement{
 			parser.Print{
-				Value: parser.NewStringValue("executable string"),
+				BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 1, SourceFile: "(L:0 C:0) EXECUTE"}),
+				Value:    parser.NewStringValue("executable string"),
 			},
 		},
 	},
@@ -340,6 +454,20 @@ var parseExecuteStatementsTests = []struct {
 		},
 		Error: "[L:- C:-] field notexist does not exist",
 	},
+	{
+		Name: "ParseExecuteStatements Named Replace Value Error",
+		Expr: parser.Execute{
+			BaseExpr:   parser.NewBaseExpr(parser.Token{}),
+			Statements: parser.NewStringValue("print %q;"),
+			Values: []parser.QueryExpression{
+				parser.ReplaceValue{
+					Value: parser.NewStringValue("executable string"),
+					Name:  parser.Identifier{Literal: "str"},
+				},
+			},
+		},
+		Error: "[L:- C:-] named value str is allowed only for a prepared statement",
+	},
 	{
 		Name: "ParseExecuteStatements Parsing Error",
 		Expr: parser.Execute{
@@ -385,6 +513,36 @@ var setFlagTests = []struct {
 			Value: parser.NewStringValue(TestDir),
 		},
 	},
+	{
+		Name: "Set Catalog",
+		Expr: parser.SetFlag{
+			Name:  "catalog",
+			Value: parser.NewStringValue(filepath.Join(TestDir, "catalog.json")),
+		},
+	},
+	{
+		Name: "Set Cache Directory",
+		Expr: parser.SetFlag{
+			Name:  "cache_dir",
+			Value: parser.NewStringValue(TestDir),
+		},
+	},
+	{
+		Name: "Set Cache Directory Error",
+		Expr: parser.SetFlag{
+			Name:  "cache_dir",
+			Value: parser.NewStringValue(filepath.Join(TestDir, "notexist")),
+		},
+		Error: "[L:- C:-] cache directory does not exist",
+	},
+	{
+		Name: "Set Catalog Error",
+		Expr: parser.SetFlag{
+			Name:  "catalog",
+			Value: parser.NewStringValue(filepath.Join(TestDir, "notexist.json")),
+		},
+		Error: fmt.Sprintf("[L:- C:-] catalog file %q does not exist", filepath.Join(TestDir, "notexist.json")),
+	},
 	{
 		Name: "Set Timezone",
 		Expr: parser.SetFlag{
@@ -406,6 +564,110 @@ var setFlagTests = []struct {
 			Value: parser.NewFloatValue(15),
 		},
 	},
+	{
+		Name: "Set MergeTool",
+		Expr: parser.SetFlag{
+			Name:  "merge_tool",
+			Value: parser.NewStringValue("vimdiff ${MERGED} ${THEIRS}"),
+		},
+	},
+	{
+		Name: "Set ConflictDir",
+		Expr: parser.SetFlag{
+			Name:  "conflict_dir",
+			Value: parser.NewStringValue(TestDir),
+		},
+	},
+	{
+		Name: "Set ConflictDir Error",
+		Expr: parser.SetFlag{
+			Name:  "conflict_dir",
+			Value: parser.NewStringValue(filepath.Join(TestDir, "notexist")),
+		},
+		Error: "[L:- C:-] conflict directory does not exist",
+	},
+	{
+		Name: "Set GitCheck",
+		Expr: parser.SetFlag{
+			Name:  "git_check",
+			Value: parser.NewStringValue("warn"),
+		},
+	},
+	{
+		Name: "Set GitCheck Error",
+		Expr: parser.SetFlag{
+			Name:  "git_check",
+			Value: parser.NewStringValue("error"),
+		},
+		Error: "[L:- C:-] git-check must be one of NONE|WARN|REFUSE",
+	},
+	{
+		Name: "Set DecimalSeparator",
+		Expr: parser.SetFlag{
+			Name:  "decimal_separator",
+			Value: parser.NewStringValue(","),
+		},
+	},
+	{
+		Name: "Set DecimalSeparator Error",
+		Expr: parser.SetFlag{
+			Name:  "decimal_separator",
+			Value: parser.NewStringValue(";"),
+		},
+		Error: "[L:- C:-] decimal separator must be one of .|,",
+	},
+	{
+		Name: "Set ThousandsSeparator",
+		Expr: parser.SetFlag{
+			Name:  "thousands_separator",
+			Value: parser.NewStringValue("."),
+		},
+	},
+	{
+		Name: "Set Collation",
+		Expr: parser.SetFlag{
+			Name:  "collation",
+			Value: parser.NewStringValue("binary"),
+		},
+	},
+	{
+		Name: "Set Collation Error",
+		Expr: parser.SetFlag{
+			Name:  "collation",
+			Value: parser.NewStringValue("error"),
+		},
+		Error: "[L:- C:-] collation must be one of BINARY|NOCASE|UNICODE|UNICODE_CI|NATURAL",
+	},
+	{
+		Name: "Set Language",
+		Expr: parser.SetFlag{
+			Name:  "language",
+			Value: parser.NewStringValue("JA"),
+		},
+	},
+	{
+		Name: "Set Language Error",
+		Expr: parser.SetFlag{
+			Name:  "language",
+			Value: parser.NewStringValue("error"),
+		},
+		Error: "[L:- C:-] language must be one of en|ja",
+	},
+	{
+		Name: "Set EncodingErrors",
+		Expr: parser.SetFlag{
+			Name:  "encoding_errors",
+			Value: parser.NewStringValue("replace"),
+		},
+	},
+	{
+		Name: "Set EncodingErrors Error",
+		Expr: parser.SetFlag{
+			Name:  "encoding_errors",
+			Value: parser.NewStringValue("error"),
+		},
+		Error: "[L:- C:-] encoding-errors must be one of STRICT|REPLACE|IGNORE or an empty string",
+	},
 	{
 		Name: "Set Delimiter",
 		Expr: parser.SetFlag{
@@ -441,6 +703,27 @@ var setFlagTests = []struct {
 			Value: parser.NewTernaryValueFromString("true"),
 		},
 	},
+	{
+		Name: "Set MaxFieldSize",
+		Expr: parser.SetFlag{
+			Name:  "max_field_size",
+			Value: parser.NewIntegerValue(1024),
+		},
+	},
+	{
+		Name: "Set MaxRowSize",
+		Expr: parser.SetFlag{
+			Name:  "max_row_size",
+			Value: parser.NewIntegerValue(4096),
+		},
+	},
+	{
+		Name: "Set RecoverQuotes",
+		Expr: parser.SetFlag{
+			Name:  "recover_quotes",
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
 	{
 		Name: "Set Format",
 		Expr: parser.SetFlag{
@@ -476,6 +759,13 @@ var setFlagTests = []struct {
 			Value: parser.NewStringValue("CRLF"),
 		},
 	},
+	{
+		Name: "Set NormalizeLineBreak",
+		Expr: parser.SetFlag{
+			Name:  "normalize_line_break",
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
 	{
 		Name: "Set EncloseAll",
 		Expr: parser.SetFlag{
@@ -483,6 +773,21 @@ var setFlagTests = []struct {
 			Value: parser.NewTernaryValueFromString("true"),
 		},
 	},
+	{
+		Name: "Set QuoteStyle",
+		Expr: parser.SetFlag{
+			Name:  "quote_style",
+			Value: parser.NewStringValue("minimal"),
+		},
+	},
+	{
+		Name: "Set QuoteStyle Error",
+		Expr: parser.SetFlag{
+			Name:  "quote_style",
+			Value: parser.NewStringValue("none"),
+		},
+		Error: "[L:- C:-] quote-style must be one of PRESERVE|MINIMAL|ALL",
+	},
 	{
 		Name: "Set JsonEscape",
 		Expr: parser.SetFlag{
@@ -525,6 +830,13 @@ var setFlagTests = []struct {
 			Value: parser.NewTernaryValueFromString("true"),
 		},
 	},
+	{
+		Name: "Set Plain",
+		Expr: parser.SetFlag{
+			Name:  "plain",
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
 	{
 		Name: "Set Quiet",
 		Expr: parser.SetFlag{
@@ -585,6 +897,14 @@ var setFlagTests = []struct {
 		},
 		Error: "[L:- C:-] 'string' for @@without_null is not allowed",
 	},
+	{
+		Name: "Set MaxFieldSize Value Error",
+		Expr: parser.SetFlag{
+			Name:  "max_field_size",
+			Value: parser.NewStringValue("invalid"),
+		},
+		Error: "[L:- C:-] 'invalid' for @@max_field_size is not allowed",
+	},
 	{
 		Name: "Set CPU Value Error",
 		Expr: parser.SetFlag{
@@ -703,7 +1023,7 @@ func TestAddFlagElement(t *testing.T) {
 		}
 
 		expect := v.Expect()
-		if !reflect.DeepEqual(flags, expect) {
+		if flags = cmd.GetFlags(); !reflect.DeepEqual(flags, expect) {
 			t.Errorf("%s: result = %v, want %v", v.Name, flags, expect)
 		}
 	}
@@ -810,7 +1130,7 @@ func TestRemoveFlagElement(t *testing.T) {
 		}
 
 		expect := v.Expect()
-		if !reflect.DeepEqual(flags, expect) {
+		if flags = cmd.GetFlags(); !reflect.DeepEqual(flags, expect) {
 			t.Errorf("%s: result = %v, want %v", v.Name, flags, expect)
 		}
 	}
@@ -850,6 +1170,97 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@REPOSITORY:\033[0m \033[90m(current dir: " + GetWD() + ")\033[0m",
 	},
+	{
+		Name: "Show Catalog",
+		Expr: parser.ShowFlag{
+			Name: "catalog",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "catalog",
+				Value: parser.NewStringValue(filepath.Join(TestDir, "catalog.json")),
+			},
+		},
+		Result: "\033[34;1m@@CATALOG:\033[0m \033[32m" + filepath.Join(TestDir, "catalog.json") + "\033[0m",
+	},
+	{
+		Name: "Show Catalog Not Set",
+		Expr: parser.ShowFlag{
+			Name: "catalog",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "catalog",
+				Value: parser.NewStringValue(""),
+			},
+		},
+		Result: "\033[34;1m@@CATALOG:\033[0m \033[90m(not set)\033[0m",
+	},
+	{
+		Name: "Show Cache Directory",
+		Expr: parser.ShowFlag{
+			Name: "cache_dir",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "cache_dir",
+				Value: parser.NewStringValue(TestDir),
+			},
+		},
+		Result: "\033[34;1m@@CACHE_DIR:\033[0m \033[32m" + TestDir + "\033[0m",
+	},
+	{
+		Name: "Show Cache Directory Not Set",
+		Expr: parser.ShowFlag{
+			Name: "cache_dir",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "cache_dir",
+				Value: parser.NewStringValue(""),
+			},
+		},
+		Result: "\033[34;1m@@CACHE_DIR:\033[0m \033[90m(not set)\033[0m",
+	},
+	{
+		Name: "Show Source Path",
+		Expr: parser.ShowFlag{
+			Name: "source_path",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "source_path",
+				Value: parser.NewStringValue(TestDir),
+			},
+		},
+		Result: "\033[34;1m@@SOURCE_PATH:\033[0m \033[32m[\"" + TestDir + "\"]\033[0m",
+	},
+	{
+		Name: "Show Source Path Not Set",
+		Expr: parser.ShowFlag{
+			Name: "source_path",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "source_path",
+				Value: parser.NewStringValue(""),
+			},
+		},
+		Result: "\033[34;1m@@SOURCE_PATH:\033[0m \033[90m(not set)\033[0m",
+	},
+	{
+		Name: "Show Source Relative",
+		Expr: parser.ShowFlag{
+			Name: "source_relative",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "source_relative",
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@SOURCE_RELATIVE:\033[0m \033[33;1mtrue\033[0m",
+	},
 	{
 		Name: "Show Timezone",
 		Expr: parser.ShowFlag{
@@ -896,6 +1307,117 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@WAIT_TIMEOUT:\033[0m \033[35m15\033[0m",
 	},
+	{
+		Name: "Show MergeTool",
+		Expr: parser.ShowFlag{
+			Name: "merge_tool",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "merge_tool",
+				Value: parser.NewStringValue("vimdiff ${MERGED} ${THEIRS}"),
+			},
+		},
+		Result: "\033[34;1m@@MERGE_TOOL:\033[0m \033[32mvimdiff ${MERGED} ${THEIRS}\033[0m",
+	},
+	{
+		Name: "Show MergeTool Not Set",
+		Expr: parser.ShowFlag{
+			Name: "merge_tool",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "merge_tool",
+				Value: parser.NewStringValue(""),
+			},
+		},
+		Result: "\033[34;1m@@MERGE_TOOL:\033[0m \033[90m(not set)\033[0m",
+	},
+	{
+		Name: "Show ConflictDir",
+		Expr: parser.ShowFlag{
+			Name: "conflict_dir",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "conflict_dir",
+				Value: parser.NewStringValue(TestDir),
+			},
+		},
+		Result: "\033[34;1m@@CONFLICT_DIR:\033[0m \033[32m" + TestDir + "\033[0m",
+	},
+	{
+		Name: "Show GitCheck",
+		Expr: parser.ShowFlag{
+			Name: "git_check",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "git_check",
+				Value: parser.NewStringValue("refuse"),
+			},
+		},
+		Result: "\033[34;1m@@GIT_CHECK:\033[0m \033[32mREFUSE\033[0m",
+	},
+	{
+		Name: "Show DecimalSeparator",
+		Expr: parser.ShowFlag{
+			Name: "decimal_separator",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "decimal_separator",
+				Value: parser.NewStringValue(","),
+			},
+		},
+		Result: "\033[34;1m@@DECIMAL_SEPARATOR:\033[0m \033[32m','\033[0m",
+	},
+	{
+		Name: "Show ThousandsSeparator Not Set",
+		Expr: parser.ShowFlag{
+			Name: "thousands_separator",
+		},
+		Result: "\033[34;1m@@THOUSANDS_SEPARATOR:\033[0m \033[90m(not set)\033[0m",
+	},
+	{
+		Name: "Show Collation",
+		Expr: parser.ShowFlag{
+			Name: "collation",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "collation",
+				Value: parser.NewStringValue("unicode_ci"),
+			},
+		},
+		Result: "\033[34;1m@@COLLATION:\033[0m \033[32mUNICODE_CI\033[0m",
+	},
+	{
+		Name: "Show Language",
+		Expr: parser.ShowFlag{
+			Name: "language",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "language",
+				Value: parser.NewStringValue("ja"),
+			},
+		},
+		Result: "\033[34;1m@@LANGUAGE:\033[0m \033[32mja\033[0m",
+	},
+	{
+		Name: "Show EncodingErrors",
+		Expr: parser.ShowFlag{
+			Name: "encoding_errors",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "encoding_errors",
+				Value: parser.NewStringValue("ignore"),
+			},
+		},
+		Result: "\033[34;1m@@ENCODING_ERRORS:\033[0m \033[32mIGNORE\033[0m",
+	},
 	{
 		Name: "Show Delimiter for CSV",
 		Expr: parser.ShowFlag{
@@ -995,6 +1517,45 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@WITHOUT_NULL:\033[0m \033[33;1mtrue\033[0m",
 	},
+	{
+		Name: "Show MaxFieldSize",
+		Expr: parser.ShowFlag{
+			Name: "max_field_size",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "max_field_size",
+				Value: parser.NewIntegerValue(1024),
+			},
+		},
+		Result: "\033[34;1m@@MAX_FIELD_SIZE:\033[0m \033[35m1024\033[0m",
+	},
+	{
+		Name: "Show MaxRowSize",
+		Expr: parser.ShowFlag{
+			Name: "max_row_size",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "max_row_size",
+				Value: parser.NewIntegerValue(4096),
+			},
+		},
+		Result: "\033[34;1m@@MAX_ROW_SIZE:\033[0m \033[35m4096\033[0m",
+	},
+	{
+		Name: "Show RecoverQuotes",
+		Expr: parser.ShowFlag{
+			Name: "recover_quotes",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "recover_quotes",
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@RECOVER_QUOTES:\033[0m \033[33;1mtrue\033[0m",
+	},
 	{
 		Name: "Show Format",
 		Expr: parser.ShowFlag{
@@ -1136,6 +1697,19 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@LINE_BREAK:\033[0m \033[32mCRLF\033[0m",
 	},
+	{
+		Name: "Show NormalizeLineBreak",
+		Expr: parser.ShowFlag{
+			Name: "normalize_line_break",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "normalize_line_break",
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@NORMALIZE_LINE_BREAK:\033[0m \033[33;1mtrue\033[0m",
+	},
 	{
 		Name: "Show EncloseAll",
 		Expr: parser.ShowFlag{
@@ -1170,6 +1744,19 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@ENCLOSE_ALL:\033[0m \033[90m(ignored) true\033[0m",
 	},
+	{
+		Name: "Show QuoteStyle",
+		Expr: parser.ShowFlag{
+			Name: "quote_style",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "quote_style",
+				Value: parser.NewStringValue("all"),
+			},
+		},
+		Result: "\033[34;1m@@QUOTE_STYLE:\033[0m \033[32mALL\033[0m",
+	},
 	{
 		Name: "Show JsonEscape",
 		Expr: parser.ShowFlag{
@@ -1349,6 +1936,19 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@COLOR:\033[0m \033[33;1mtrue\033[0m",
 	},
+	{
+		Name: "Show Plain",
+		Expr: parser.ShowFlag{
+			Name: "plain",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "plain",
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "@@PLAIN: true",
+	},
 	{
 		Name: "Show Quiet",
 		Expr: parser.ShowFlag{
@@ -1388,6 +1988,32 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@STATS:\033[0m \033[33;1mtrue\033[0m",
 	},
+	{
+		Name: "Show Stream",
+		Expr: parser.ShowFlag{
+			Name: "stream",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "stream",
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@STREAM:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show Filter On Load",
+		Expr: parser.ShowFlag{
+			Name: "filter_on_load",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "filter_on_load",
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@FILTER_ON_LOAD:\033[0m \033[33;1mtrue\033[0m",
+	},
 	{
 		Name: "Invalid Flag Name Error",
 		Expr: parser.ShowFlag{
@@ -1854,29 +2480,50 @@ var showObjectsTests = []struct {
 			"                     Flags\n" +
 			"-----------------------------------------------\n" +
 			"             @@REPOSITORY: .\n" +
+			"                @@CATALOG: (not set)\n" +
+			"              @@CACHE_DIR: (not set)\n" +
+			"            @@SOURCE_PATH: (not set)\n" +
+			"        @@SOURCE_RELATIVE: false\n" +
 			"               @@TIMEZONE: UTC\n" +
 			"        @@DATETIME_FORMAT: (not set)\n" +
+			"      @@DECIMAL_SEPARATOR: '.'\n" +
+			"    @@THOUSANDS_SEPARATOR: (not set)\n" +
+			"              @@COLLATION: NOCASE\n" +
+			"               @@LANGUAGE: en\n" +
 			"           @@WAIT_TIMEOUT: 15\n" +
+			"             @@MERGE_TOOL: (not set)\n" +
+			"           @@CONFLICT_DIR: (not set)\n" +
+			"              @@GIT_CHECK: NONE\n" +
 			"              @@DELIMITER: ',' | SPACES\n" +
 			"             @@JSON_QUERY: (ignored) (empty)\n" +
 			"               @@ENCODING: UTF8\n" +
+			"        @@ENCODING_ERRORS: (not set)\n" +
 			"              @@NO_HEADER: false\n" +
 			"           @@WITHOUT_NULL: false\n" +
+			"         @@MAX_FIELD_SIZE: 0\n" +
+			"           @@MAX_ROW_SIZE: 0\n" +
+			"         @@RECOVER_QUOTES: false\n" +
 			"                 @@FORMAT: CSV\n" +
 			"         @@WRITE_ENCODING: UTF8\n" +
 			"        @@WRITE_DELIMITER: ',' | SPACES\n" +
 			"         @@WITHOUT_HEADER: false\n" +
 			"             @@LINE_BREAK: LF\n" +
+			"   @@NORMALIZE_LINE_BREAK: false\n" +
 			"            @@ENCLOSE_ALL: false\n" +
+			"            @@QUOTE_STYLE: PRESERVE\n" +
 			"            @@JSON_ESCAPE: (ignored) BACKSLASH\n" +
 			"           @@PRETTY_PRINT: (ignored) false\n" +
 			"    @@EAST_ASIAN_ENCODING: (ignored) false\n" +
 			" @@COUNT_DIACRITICAL_SIGN: (ignored) false\n" +
 			"      @@COUNT_FORMAT_CODE: (ignored) false\n" +
 			"                  @@COLOR: false\n" +
+			"                  @@PLAIN: false\n" +
 			"                  @@QUIET: false\n" +
 			"                    @@CPU: " + strconv.Itoa(cmd.GetFlags().CPU) + "\n" +
+			"            @@SORT_MEMORY: 0\n" +
 			"                  @@STATS: false\n" +
+			"                 @@STREAM: false\n" +
+			"         @@FILTER_ON_LOAD: false\n" +
 			"\n",
 	},
 	{
@@ -1893,6 +2540,7 @@ var showObjectsTests = []struct {
 			"     @#LOADED_TABLES: 0\n" +
 			" @#WORKING_DIRECTORY: " + GetWD() + "\n" +
 			"           @#VERSION: v1.0.0\n" +
+			"    @#LATEST_VERSION: (fetched on reference)\n" +
 			"\n",
 	},
 	{
@@ -2270,6 +2918,84 @@ func TestSetEnvVar(t *testing.T) {
 	}
 }
 
+var exportTests = []struct {
+	Name       string
+	Expr       parser.Export
+	Expect     string
+	FileExpect string
+	Error      string
+}{
+	{
+		Name: "Export",
+		Expr: parser.Export{
+			EnvVars: []parser.EnvironmentVariable{
+				{Name: "CSVQ_EXPORT_TEST"},
+				{Name: "CSVQ_EXPORT_TEST_UNSET"},
+			},
+		},
+		Expect: "export CSVQ_EXPORT_TEST='it'\\''s'\n" +
+			"unset CSVQ_EXPORT_TEST_UNSET",
+	},
+	{
+		Name: "Export to File",
+		Expr: parser.Export{
+			EnvVars:  []parser.EnvironmentVariable{{Name: "CSVQ_EXPORT_TEST"}},
+			FilePath: parser.NewStringValue(filepath.Join(TestDir, "export_test.env")),
+		},
+		Expect:     "",
+		FileExpect: "export CSVQ_EXPORT_TEST='it'\\''s'\n",
+	},
+	{
+		Name: "Export to Invalid File Path Error",
+		Expr: parser.Export{
+			EnvVars:  []parser.EnvironmentVariable{{Name: "CSVQ_EXPORT_TEST"}},
+			FilePath: parser.NewNullValue(),
+		},
+		Error: "[L:- C:-] NULL: invalid file path",
+	},
+}
+
+func TestExport(t *testing.T) {
+	filter := NewEmptyFilter()
+	_ = os.Setenv("CSVQ_EXPORT_TEST", "it's")
+	_ = os.Unsetenv("CSVQ_EXPORT_TEST_UNSET")
+	defer func() {
+		_ = os.Unsetenv("CSVQ_EXPORT_TEST")
+	}()
+
+	for _, v := range exportTests {
+		if v.Expr.FilePath != nil {
+			_ = os.Remove(filepath.Join(TestDir, "export_test.env"))
+		}
+
+		result, err := Export(v.Expr, filter)
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if result != v.Expect {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, v.Expect)
+		}
+		if 0 < len(v.FileExpect) {
+			b, _ := os.ReadFile(filepath.Join(TestDir, "export_test.env"))
+			if string(b) != v.FileExpect {
+				t.Errorf("%s: file content = %q, want %q", v.Name, string(b), v.FileExpect)
+			}
+			_ = os.Remove(filepath.Join(TestDir, "export_test.env"))
+		}
+	}
+}
+
 var syntaxTests = []struct {
 	Expr   parser.Syntax
 	Expect string
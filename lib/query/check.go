@@ -0,0 +1,654 @@
+package query
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// checkTable is a table referred to in a query. The fields are nil if they are unknown.
+type checkTable struct {
+	name   string
+	fields []string
+}
+
+// checkLevel is the tables and the field aliases that can be referred to in a select entity.
+type checkLevel struct {
+	tables  []checkTable
+	aliases []string
+}
+
+type fieldResolution int
+
+const (
+	fieldNotFound fieldResolution = iota
+	fieldFound
+	fieldUnknown
+)
+
+func (l *checkLevel) resolve(expr parser.FieldReference) fieldResolution {
+	column := expr.Column.Literal
+	if len(expr.View.Literal) < 1 && InStrSliceWithCaseInsensitive(column, l.aliases) {
+		return fieldFound
+	}
+
+	result := fieldNotFound
+	for _, t := range l.tables {
+		if 0 < len(expr.View.Literal) && !strings.EqualFold(t.name, expr.View.Literal) {
+			continue
+		}
+		if t.fields == nil {
+			result = fieldUnknown
+			continue
+		}
+		if column == "*" || InStrSliceWithCaseInsensitive(column, t.fields) {
+			return fieldFound
+		}
+	}
+	return result
+}
+
+func (l *checkLevel) table(name string) (checkTable, bool) {
+	for _, t := range l.tables {
+		if strings.EqualFold(t.name, name) {
+			return t, true
+		}
+	}
+	return checkTable{}, false
+}
+
+type checker struct {
+	filter       *Filter
+	inlineTables []map[string][]string
+	views        []map[string][]string
+	levels       []*checkLevel
+	errors       []error
+
+	// dynamic is true after the statements that load other statements at runtime,
+	// such as SOURCE, IMPORT and EXECUTE. Variables and functions declared by them cannot be known.
+	dynamic bool
+	// deferred is the depth of the statements executed later in the scopes of the callers,
+	// such as function bodies and cursor queries.
+	deferred int
+}
+
+// CheckStatements validates the statements without executing them, and returns the errors found.
+// References to undeclared variables and functions, fields that do not exist in the tables, and
+// operands of arithmetic operations that cannot be converted to numbers are reported.
+// Files are not loaded, so the fields of a table are checked only if the table is a temporary table,
+// an inline table, or a table with columns in the catalog.
+func CheckStatements(statements []parser.Statement, filter *Filter) []error {
+	c := &checker{
+		filter: filter.CreateChildScope(),
+		views:  []map[string][]string{{}},
+	}
+	c.statements(statements)
+	return c.errors
+}
+
+func (c *checker) addError(err error) {
+	c.errors = append(c.errors, err)
+}
+
+func (c *checker) checksDeclaration() bool {
+	return !c.dynamic && c.deferred < 1
+}
+
+func (c *checker) enterScope() *Filter {
+	parent := c.filter
+	c.filter = parent.CreateChildScope()
+	c.views = append([]map[string][]string{{}}, c.views...)
+	return parent
+}
+
+func (c *checker) leaveScope(parent *Filter) {
+	c.views = c.views[1:]
+	c.filter = parent
+}
+
+func (c *checker) pushLevel(level *checkLevel) {
+	c.levels = append(c.levels, level)
+}
+
+func (c *checker) popLevel() {
+	c.levels = c.levels[:len(c.levels)-1]
+}
+
+func (c *checker) statements(statements []parser.Statement) {
+	for _, stmt := range statements {
+		c.statement(stmt)
+	}
+}
+
+func (c *checker) block(statements []parser.Statement) {
+	parent := c.enterScope()
+	c.statements(statements)
+	c.leaveScope(parent)
+}
+
+func (c *checker) statement(stmt parser.Statement) {
+	switch stmt.(type) {
+	case parser.VariableDeclaration:
+		for _, assignment := range stmt.(parser.VariableDeclaration).Assignments {
+			if assignment.Value != nil {
+				c.expr(assignment.Value)
+			}
+			c.declareVariable(assignment.Variable)
+		}
+	case parser.DisposeVariable:
+		if err := c.filter.Variables.Dispose(stmt.(parser.DisposeVariable).Variable); err != nil && c.checksDeclaration() {
+			c.addError(err)
+		}
+	case parser.FunctionDeclaration:
+		decl := stmt.(parser.FunctionDeclaration)
+		if err := c.filter.Functions.Declare(decl); err != nil {
+			c.addError(err)
+		}
+		c.functionBody(decl.Parameters, decl.Statements)
+	case parser.AggregateDeclaration:
+		decl := stmt.(parser.AggregateDeclaration)
+		if err := c.filter.Functions.DeclareAggregate(decl); err != nil {
+			c.addError(err)
+		}
+		c.functionBody(decl.Parameters, decl.Statements)
+	case parser.TableTriggerDeclaration:
+		c.functionBody([]parser.VariableAssignment{
+			{Variable: parser.Variable{Name: TriggerOldVariable}},
+			{Variable: parser.Variable{Name: TriggerNewVariable}},
+		}, stmt.(parser.TableTriggerDeclaration).Statements)
+	case parser.CursorDeclaration:
+		c.deferred++
+		c.selectQuery(stmt.(parser.CursorDeclaration).Query)
+		c.deferred--
+	case parser.ViewDeclaration:
+		c.viewDeclaration(stmt.(parser.ViewDeclaration))
+	case parser.DisposeView:
+		name := strings.ToUpper(stmt.(parser.DisposeView).View.Literal)
+		for _, m := range c.views {
+			if _, ok := m[name]; ok {
+				delete(m, name)
+				break
+			}
+		}
+	case parser.If:
+		ifStmt := stmt.(parser.If)
+		c.expr(ifStmt.Condition)
+		c.block(ifStmt.Statements)
+		for _, v := range ifStmt.ElseIf {
+			c.expr(v.Condition)
+			c.block(v.Statements)
+		}
+		c.block(ifStmt.Else.Statements)
+	case parser.Case:
+		caseStmt := stmt.(parser.Case)
+		if caseStmt.Value != nil {
+			c.expr(caseStmt.Value)
+		}
+		for _, v := range caseStmt.When {
+			c.expr(v.Condition)
+			c.block(v.Statements)
+		}
+		c.block(caseStmt.Else.Statements)
+	case parser.While:
+		whileStmt := stmt.(parser.While)
+		c.expr(whileStmt.Condition)
+		c.block(whileStmt.Statements)
+	case parser.WhileInCursor:
+		whileStmt := stmt.(parser.WhileInCursor)
+		parent := c.enterScope()
+		for _, v := range whileStmt.Variables {
+			if whileStmt.WithDeclaration {
+				c.declareVariable(v)
+			} else {
+				c.variable(v)
+			}
+		}
+		c.statements(whileStmt.Statements)
+		c.leaveScope(parent)
+	case parser.Try:
+		tryStmt := stmt.(parser.Try)
+		c.block(tryStmt.Statements)
+		c.block(tryStmt.CatchStatements)
+	case parser.Source, parser.Import, parser.Execute:
+		c.expr(stmt)
+		c.dynamic = true
+	case parser.ExternalCommand:
+	default:
+		c.expr(stmt)
+	}
+}
+
+func (c *checker) declareVariable(variable parser.Variable) {
+	if err := c.filter.Variables[0].Add(variable, value.NewNull()); err != nil {
+		c.addError(err)
+	}
+}
+
+// functionBody checks the statements of the function with the parameters declared.
+// The function is executed in the scope of the caller, so undeclared variables and functions
+// are not reported in the statements.
+func (c *checker) functionBody(parameters []parser.VariableAssignment, statements []parser.Statement) {
+	parent := c.enterScope()
+	c.deferred++
+	for _, p := range parameters {
+		if p.Value != nil {
+			c.expr(p.Value)
+		}
+		_ = c.filter.Variables[0].Add(p.Variable, value.NewNull())
+	}
+	c.statements(statements)
+	c.deferred--
+	c.leaveScope(parent)
+}
+
+func (c *checker) viewDeclaration(decl parser.ViewDeclaration) {
+	var fields []string
+	if decl.Query != nil {
+		fields = c.selectQuery(decl.Query.(parser.SelectQuery))
+	}
+	if 0 < len(decl.Fields) {
+		fields = identifierLiterals(decl.Fields)
+	}
+
+	if 0 < len(decl.Constraints) {
+		c.pushLevel(&checkLevel{tables: []checkTable{{name: decl.View.Literal, fields: fields}}})
+		c.expr(decl.Constraints)
+		c.popLevel()
+	}
+	c.views[0][strings.ToUpper(decl.View.Literal)] = fields
+}
+
+func identifierLiterals(list []parser.QueryExpression) []string {
+	literals := make([]string, 0, len(list))
+	for _, v := range list {
+		literals = append(literals, v.(parser.Identifier).Literal)
+	}
+	return literals
+}
+
+func (c *checker) expr(expr interface{}) {
+	c.walk(reflect.ValueOf(expr))
+}
+
+// walk checks the expressions in the value, and descends into the nodes that are not checked by themselves.
+func (c *checker) walk(v reflect.Value) {
+	if !v.IsValid() || !v.CanInterface() {
+		return
+	}
+	if v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		if !v.IsNil() {
+			c.walk(v.Elem())
+		}
+		return
+	}
+
+	switch expr := v.Interface().(type) {
+	case parser.Variable:
+		c.variable(expr)
+		return
+	case parser.VariableSubstitution:
+		c.expr(expr.Value)
+		c.variable(expr.Variable)
+		return
+	case parser.FieldReference:
+		c.fieldReference(expr)
+		return
+	case parser.SelectQuery:
+		c.selectQuery(expr)
+		return
+	case parser.InsertQuery:
+		c.insertQuery(expr)
+		return
+	case parser.UpdateQuery:
+		c.updateQuery(expr)
+		return
+	case parser.DeleteQuery:
+		c.deleteQuery(expr)
+		return
+	case parser.Function:
+		c.function(expr)
+	case parser.Arithmetic:
+		c.arithmeticOperand(expr, expr.LHS)
+		c.arithmeticOperand(expr, expr.RHS)
+	case parser.UnaryArithmetic:
+		c.arithmeticOperand(expr, expr.Operand)
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			c.walk(v.Field(i))
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			c.walk(v.Index(i))
+		}
+	}
+}
+
+func (c *checker) variable(expr parser.Variable) {
+	if !c.checksDeclaration() {
+		return
+	}
+	if _, err := c.filter.Variables.Get(expr); err != nil {
+		c.addError(err)
+	}
+}
+
+func (c *checker) function(expr parser.Function) {
+	name := strings.ToUpper(expr.Name)
+	if _, ok := ExceptionFunctions[name]; ok {
+		return
+	}
+	if _, ok := Functions[name]; ok || name == "NOW" || name == "UUID" || name == "JSON_OBJECT" {
+		if argsLen, ok := FunctionArgsLen[name]; ok && !InIntSlice(len(expr.Args), argsLen) {
+			c.addError(NewFunctionArgumentLengthError(expr, expr.Name, argsLen))
+		}
+		return
+	}
+
+	fn, err := c.filter.Functions.Get(expr, name)
+	if err != nil {
+		if c.checksDeclaration() {
+			c.addError(NewFunctionNotExistError(expr, expr.Name))
+		}
+		return
+	}
+	if !fn.IsAggregate {
+		if err = fn.CheckArgsLen(expr, expr.Name, len(expr.Args)); err != nil {
+			c.addError(err)
+		}
+	}
+}
+
+// arithmeticOperand reports the literal that is always evaluated as null in arithmetic operations.
+func (c *checker) arithmeticOperand(expr parser.QueryExpression, operand parser.QueryExpression) {
+	for {
+		p, ok := operand.(parser.Parentheses)
+		if !ok {
+			break
+		}
+		operand = p.Expr
+	}
+
+	p, ok := operand.(parser.PrimitiveType)
+	if !ok || value.IsNull(p.Value) {
+		return
+	}
+	if value.IsNull(value.ToFloat(p.Value)) {
+		c.addError(NewArithmeticOperandTypeError(expr, p))
+	}
+}
+
+func (c *checker) resolvesField(expr parser.FieldReference) bool {
+	for i := len(c.levels) - 1; 0 <= i; i-- {
+		if c.levels[i].resolve(expr) != fieldNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *checker) fieldReference(expr parser.FieldReference) {
+	if len(c.levels) < 1 {
+		return
+	}
+	if !c.resolvesField(expr) {
+		c.addError(NewFieldNotExistError(expr))
+	}
+}
+
+func (c *checker) withClause(clause parser.WithClause) {
+	m := make(map[string][]string)
+	c.inlineTables = append(c.inlineTables, m)
+
+	for _, v := range clause.InlineTables {
+		it := v.(parser.InlineTable)
+		name := strings.ToUpper(it.Name.Literal)
+
+		// A recursive reference is resolved with the fields declared explicitly.
+		var fields []string
+		if 0 < len(it.Fields) {
+			fields = identifierLiterals(it.Fields)
+		}
+		m[name] = fields
+
+		result := c.selectQuery(it.Query)
+		if fields == nil {
+			m[name] = result
+		}
+	}
+}
+
+// selectQuery checks the select query, and returns the fields of the result set.
+// Nil is returned if the fields are unknown.
+func (c *checker) selectQuery(query parser.SelectQuery) []string {
+	n := len(c.inlineTables)
+	if query.WithClause != nil {
+		c.withClause(query.WithClause.(parser.WithClause))
+	}
+
+	fields := c.selectEntity(query.SelectEntity, query.OrderByClause)
+
+	if query.LimitClause != nil {
+		c.expr(query.LimitClause)
+	}
+	if query.OffsetClause != nil {
+		c.expr(query.OffsetClause)
+	}
+
+	c.inlineTables = c.inlineTables[:n]
+	return fields
+}
+
+func (c *checker) selectEntity(expr parser.QueryExpression, orderBy parser.QueryExpression) []string {
+	var fields []string
+
+	switch expr.(type) {
+	case parser.SelectSet:
+		set := expr.(parser.SelectSet)
+		fields = c.selectEntity(set.LHS, nil)
+		c.selectEntity(set.RHS, nil)
+	case parser.Subquery:
+		fields = c.selectQuery(expr.(parser.Subquery).Query)
+	default:
+		entity := expr.(parser.SelectEntity)
+		selectClause := entity.SelectClause.(parser.SelectClause)
+
+		level := &checkLevel{}
+		c.pushLevel(level)
+		if entity.FromClause != nil {
+			for _, v := range entity.FromClause.(parser.FromClause).Tables {
+				c.table(level, v)
+			}
+		}
+		c.expr(selectClause.Fields)
+		if entity.WhereClause != nil {
+			c.expr(entity.WhereClause)
+		}
+		if entity.GroupByClause != nil {
+			c.expr(entity.GroupByClause)
+		}
+		if entity.HavingClause != nil {
+			c.expr(entity.HavingClause)
+		}
+
+		fields = resultFields(level, selectClause)
+		if orderBy != nil {
+			for _, v := range selectClause.Fields {
+				if f := v.(parser.Field); f.Alias != nil {
+					level.aliases = append(level.aliases, f.Alias.(parser.Identifier).Literal)
+				}
+			}
+			c.expr(orderBy)
+		}
+		c.popLevel()
+		return fields
+	}
+
+	if orderBy != nil {
+		c.pushLevel(&checkLevel{tables: []checkTable{{fields: fields}}})
+		c.expr(orderBy)
+		c.popLevel()
+	}
+	return fields
+}
+
+func resultFields(level *checkLevel, clause parser.SelectClause) []string {
+	fields := make([]string, 0, len(clause.Fields))
+	for _, v := range clause.Fields {
+		f := v.(parser.Field)
+		switch f.Object.(type) {
+		case parser.AllColumns:
+			for _, t := range level.tables {
+				if t.fields == nil {
+					return nil
+				}
+				fields = append(fields, t.fields...)
+			}
+		case parser.FieldReference:
+			fr := f.Object.(parser.FieldReference)
+			if fr.Column.Literal != "*" {
+				fields = append(fields, f.Name())
+				continue
+			}
+			t, ok := level.table(fr.View.Literal)
+			if !ok || t.fields == nil {
+				return nil
+			}
+			fields = append(fields, t.fields...)
+		default:
+			fields = append(fields, f.Name())
+		}
+	}
+	return fields
+}
+
+// table checks the table and appends it to the level, so that the tables following it
+// such as lateral joins can refer to it.
+func (c *checker) table(level *checkLevel, expr parser.QueryExpression) {
+	if parentheses, ok := expr.(parser.Parentheses); ok {
+		c.table(level, parentheses.Expr)
+		return
+	}
+
+	table := expr.(parser.Table)
+	var fields []string
+
+	switch table.Object.(type) {
+	case parser.Join:
+		join := table.Object.(parser.Join)
+		c.table(level, join.Table)
+		c.table(level, join.JoinTable)
+		if join.Condition != nil {
+			c.expr(join.Condition)
+		}
+		return
+	case parser.Subquery:
+		fields = c.selectQuery(table.Object.(parser.Subquery).Query)
+	case parser.Identifier:
+		fields = c.tableFields(table.Object.(parser.Identifier))
+	case parser.Unnest, parser.GenerateSeries, parser.JsonTable:
+		c.expr(table.Object)
+		fields = lateralHeader(table).TableColumnNames()
+	case parser.Dual:
+		fields = []string{}
+	default:
+		c.expr(table.Object)
+	}
+
+	level.tables = append(level.tables, checkTable{name: table.Name().Literal, fields: fields})
+}
+
+func (c *checker) tableFields(ident parser.Identifier) []string {
+	name := strings.ToUpper(ident.Literal)
+	for i := len(c.inlineTables) - 1; 0 <= i; i-- {
+		if fields, ok := c.inlineTables[i][name]; ok {
+			return fields
+		}
+	}
+	for _, m := range c.views {
+		if fields, ok := m[name]; ok {
+			return fields
+		}
+	}
+	if view, err := c.filter.TempViews.Get(ident); err == nil {
+		return view.Header.TableColumnNames()
+	}
+	if t, ok := cmd.GetFlags().TableCatalog().Get(ident.Literal); ok && 0 < len(t.Columns) {
+		return t.Columns
+	}
+	return nil
+}
+
+func (c *checker) insertQuery(query parser.InsertQuery) {
+	n := len(c.inlineTables)
+	if query.WithClause != nil {
+		c.withClause(query.WithClause.(parser.WithClause))
+	}
+
+	level := &checkLevel{}
+	c.table(level, query.Table)
+	c.pushLevel(level)
+	c.expr(query.Fields)
+	c.popLevel()
+
+	c.expr(query.ValuesList)
+	if query.Query != nil {
+		c.expr(query.Query)
+	}
+	c.inlineTables = c.inlineTables[:n]
+}
+
+func (c *checker) updateQuery(query parser.UpdateQuery) {
+	n := len(c.inlineTables)
+	if query.WithClause != nil {
+		c.withClause(query.WithClause.(parser.WithClause))
+	}
+
+	level := &checkLevel{}
+	if query.FromClause != nil {
+		for _, v := range query.FromClause.(parser.FromClause).Tables {
+			c.table(level, v)
+		}
+	} else {
+		for _, v := range query.Tables {
+			c.table(level, v)
+		}
+	}
+
+	c.pushLevel(level)
+	for _, uset := range query.SetList {
+		if fr, ok := uset.Field.(parser.FieldReference); ok && !c.resolvesField(fr) {
+			c.addError(NewUpdateFieldNotExistError(fr))
+		}
+		c.expr(uset.Value)
+	}
+	if query.WhereClause != nil {
+		c.expr(query.WhereClause)
+	}
+	c.popLevel()
+	c.inlineTables = c.inlineTables[:n]
+}
+
+func (c *checker) deleteQuery(query parser.DeleteQuery) {
+	n := len(c.inlineTables)
+	if query.WithClause != nil {
+		c.withClause(query.WithClause.(parser.WithClause))
+	}
+
+	level := &checkLevel{}
+	for _, v := range query.FromClause.Tables {
+		c.table(level, v)
+	}
+
+	c.pushLevel(level)
+	if query.WhereClause != nil {
+		c.expr(query.WhereClause)
+	}
+	c.popLevel()
+	c.inlineTables = c.inlineTables[:n]
+}
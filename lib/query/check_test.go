@@ -0,0 +1,116 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var checkStatementsTests = []struct {
+	Name   string
+	Input  string
+	Errors []string
+}{
+	{
+		Name: "CheckStatements No Errors",
+		Input: "VAR @a := 1;\n" +
+			"DECLARE v VIEW (id, name);\n" +
+			"DECLARE f FUNCTION (@p) AS BEGIN RETURN @p + @outer; END;\n" +
+			"WITH it (n) AS (SELECT 1) SELECT v.id, name, n, f(@a) AS x FROM v CROSS JOIN it ORDER BY x;\n" +
+			"SELECT s.k FROM (SELECT 1 AS k) s WHERE EXISTS (SELECT 1 FROM v WHERE v.id = s.k);\n" +
+			"SELECT * FROM table1 WHERE column9 = 1;\n" +
+			"SELECT 1 + '2';",
+	},
+	{
+		Name: "CheckStatements Undeclared Variables",
+		Input: "VAR @a := @b;\n" +
+			"IF @a = 1 THEN VAR @c := 1; END IF;\n" +
+			"PRINT @c;\n" +
+			"VAR @a;",
+		Errors: []string{
+			"[L:1 C:11] variable @b is undeclared",
+			"[L:3 C:7] variable @c is undeclared",
+			"[L:4 C:5] variable @a is redeclared",
+		},
+	},
+	{
+		Name: "CheckStatements Variables Declared Dynamically",
+		Input: "SOURCE 'statements.sql';\n" +
+			"PRINT @declared;",
+	},
+	{
+		Name: "CheckStatements Table Triggers",
+		Input: "DECLARE v VIEW (id, name);\n" +
+			"DECLARE t TRIGGER BEFORE UPDATE ON v AS BEGIN @NEW.name := @OLD.name || @suffix; END;",
+	},
+	{
+		Name: "CheckStatements Fields",
+		Input: "DECLARE v VIEW (id, name);\n" +
+			"SELECT id, nam FROM v;\n" +
+			"SELECT x.id FROM v x ORDER BY y.id;\n" +
+			"WITH it AS (SELECT id AS k FROM v) SELECT id FROM it;\n" +
+			"UPDATE v SET title = 1;\n" +
+			"INSERT INTO v (id, title) VALUES (1, 2);",
+		Errors: []string{
+			"[L:2 C:12] field nam does not exist",
+			"[L:3 C:31] field y.id does not exist",
+			"[L:4 C:43] field id does not exist",
+			"[L:5 C:14] field title does not exist in the tables to update",
+			"[L:6 C:20] field title does not exist",
+		},
+	},
+	{
+		Name:  "CheckStatements Alias in Where Clause",
+		Input: "SELECT 1 AS a WHERE a = 1 ORDER BY a;",
+		Errors: []string{
+			"[L:1 C:21] field a does not exist",
+		},
+	},
+	{
+		Name: "CheckStatements Functions",
+		Input: "DECLARE f FUNCTION (@p) AS BEGIN RETURN @p; END;\n" +
+			"PRINT f(1, 2);\n" +
+			"PRINT undefined(1);",
+		Errors: []string{
+			"[L:2 C:7] function f takes exactly 1 argument",
+			"[L:3 C:7] function undefined does not exist",
+		},
+	},
+	{
+		Name:  "CheckStatements Built-in Functions",
+		Input: "SELECT SUBSTR('abc'), SUBSTR('abc', 2), NOW(1), COALESCE(1, 2);",
+		Errors: []string{
+			"[L:1 C:8] function SUBSTR takes 2 or 3 arguments",
+			"[L:1 C:41] function NOW takes no argument",
+		},
+	},
+	{
+		Name:  "CheckStatements Arithmetic Operand Type",
+		Input: "VAR @a := 1;\nPRINT @a + 'abc';",
+		Errors: []string{
+			"[L:2 C:10] 'abc' cannot be converted to a number",
+		},
+	},
+}
+
+func TestCheckStatements(t *testing.T) {
+	initCmdFlag()
+
+	for _, v := range checkStatementsTests {
+		statements, err := parser.Parse(v.Input, "")
+		if err != nil {
+			t.Errorf("%s: unexpected parse error %q", v.Name, err)
+			continue
+		}
+
+		errs := CheckStatements(statements, NewEmptyFilter())
+		var result []string
+		for _, e := range errs {
+			result = append(result, e.Error())
+		}
+		if !reflect.DeepEqual(result, v.Errors) {
+			t.Errorf("%s: errors = %q, want %q", v.Name, result, v.Errors)
+		}
+	}
+}
@@ -19,6 +19,12 @@ func Is(p1 value.Primary, p2 value.Primary) ternary.Value {
 }
 
 func Like(p1 value.Primary, p2 value.Primary) ternary.Value {
+	return LikeWithCollation(p1, p2, value.DefaultCollation())
+}
+
+// LikeWithCollation matches the string with the pattern case-sensitively
+// unless the collation ignores case.
+func LikeWithCollation(p1 value.Primary, p2 value.Primary, collation value.Collation) ternary.Value {
 	if value.IsNull(p1) || value.IsNull(p2) {
 		return ternary.UNKNOWN
 	}
@@ -32,8 +38,12 @@ func Like(p1 value.Primary, p2 value.Primary) ternary.Value {
 		return ternary.UNKNOWN
 	}
 
-	s := strings.ToUpper(p1.(value.String).Raw())
-	pattern := strings.ToUpper(p2.(value.String).Raw())
+	s := s1.(value.String).Raw()
+	pattern := s2.(value.String).Raw()
+	if collation.IgnoresCase() {
+		s = strings.ToUpper(s)
+		pattern = strings.ToUpper(pattern)
+	}
 
 	if s == pattern {
 		return ternary.TRUE
@@ -127,10 +137,14 @@ func stringPattern(pattern []rune, position int) (int, int, string, int) {
 }
 
 func InRowValueList(rowValue value.RowValue, list []value.RowValue, matchType int, operator string) (ternary.Value, error) {
+	return InRowValueListWithCollation(rowValue, list, matchType, operator, value.DefaultCollation())
+}
+
+func InRowValueListWithCollation(rowValue value.RowValue, list []value.RowValue, matchType int, operator string, collation value.Collation) (ternary.Value, error) {
 	results := make([]ternary.Value, len(list))
 
 	for i, v := range list {
-		t, err := value.CompareRowValues(rowValue, v, operator)
+		t, err := value.CompareRowValuesWithCollation(rowValue, v, operator, collation)
 		if err != nil {
 			return ternary.FALSE, NewRowValueLengthInListError(i)
 		}
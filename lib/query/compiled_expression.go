@@ -0,0 +1,387 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+// compiledExpression evaluates an expression for the current record of a filter
+// without walking the syntax tree.
+type compiledExpression func(f *Filter) (value.Primary, error)
+
+// compileExpression converts the expression into a compiledExpression once before
+// it is evaluated for each record in the view.
+//
+// Field references to the view are resolved to the indices of the fields, and the
+// operations are converted into closures calling the compiled operands.
+// The expressions that are not supported are evaluated by Filter.Evaluate, so the
+// compiled expression returns the same results and errors as Filter.Evaluate.
+//
+// The compiled expression must be called with a filter whose first record is a record
+// in the view, such as a filter passed by Filter.EvaluateSequentially.
+func compileExpression(expr parser.QueryExpression, view *View) compiledExpression {
+	switch expr.(type) {
+	case nil:
+		return func(_ *Filter) (value.Primary, error) {
+			return value.NewTernary(ternary.TRUE), nil
+		}
+	case parser.PrimitiveType:
+		val := expr.(parser.PrimitiveType).Value
+		return func(_ *Filter) (value.Primary, error) {
+			return val, nil
+		}
+	case parser.Parentheses:
+		return compileExpression(expr.(parser.Parentheses).Expr, view)
+	case parser.FieldReference, parser.ColumnNumber:
+		return compileFieldReference(expr, view)
+	case parser.Arithmetic:
+		return compileArithmetic(expr.(parser.Arithmetic), view)
+	case parser.Concat:
+		return compileConcat(expr.(parser.Concat), view)
+	case parser.Comparison:
+		if e := expr.(parser.Comparison); !isRowValueExpression(e.LHS) {
+			if collation, err := comparisonCollation(e); err == nil {
+				return compileComparison(e, collation, view)
+			}
+		}
+	case parser.Is:
+		return compileIs(expr.(parser.Is), view)
+	case parser.Between:
+		if e := expr.(parser.Between); !isRowValueExpression(e.LHS) {
+			if collation, _, err := operandsCollation(e.LHS, e.Low, e.High); err == nil {
+				return compileBetween(e, collation, view)
+			}
+		}
+	case parser.Like:
+		e := expr.(parser.Like)
+		if collation, _, err := operandsCollation(e.LHS, e.Pattern); err == nil {
+			return compileLike(e, collation, view)
+		}
+	case parser.Function:
+		e := expr.(parser.Function)
+		name := strings.ToUpper(e.Name)
+		if fn, ok := Functions[name]; ok && name != "NOW" && name != "JSON_OBJECT" {
+			return compileFunction(e, fn, view)
+		}
+	case parser.CaseExpr:
+		return compileCaseExpr(expr.(parser.CaseExpr), view)
+	case parser.Logic:
+		return compileLogic(expr.(parser.Logic), view)
+	case parser.UnaryLogic:
+		return compileUnaryLogic(expr.(parser.UnaryLogic), view)
+	}
+
+	return func(f *Filter) (value.Primary, error) {
+		return f.Evaluate(expr)
+	}
+}
+
+func compileExpressions(list []parser.QueryExpression, view *View) []compiledExpression {
+	compiled := make([]compiledExpression, len(list))
+	for i, v := range list {
+		compiled[i] = compileExpression(v, view)
+	}
+	return compiled
+}
+
+// isRowValueExpression reports whether the expression can return multiple values
+// when it is evaluated by Filter.evalRowValue.
+func isRowValueExpression(expr parser.QueryExpression) bool {
+	switch expr.(type) {
+	case parser.Subquery, parser.JsonQuery, parser.ValueList, parser.RowValue:
+		return true
+	}
+	return false
+}
+
+func compileFieldReference(expr parser.QueryExpression, view *View) compiledExpression {
+	idx, err := view.FieldIndex(expr)
+	if err != nil || (view.isGrouped && view.Header[idx].IsFromTable && !view.Header[idx].IsGroupKey) {
+		return func(f *Filter) (value.Primary, error) {
+			return f.Evaluate(expr)
+		}
+	}
+
+	return func(f *Filter) (value.Primary, error) {
+		return f.Records[0].View.RecordSet[f.Records[0].RecordIndex][idx].Value(), nil
+	}
+}
+
+func compileArithmetic(expr parser.Arithmetic, view *View) compiledExpression {
+	lhsFn := compileExpression(expr.LHS, view)
+	rhsFn := compileExpression(expr.RHS, view)
+
+	return func(f *Filter) (value.Primary, error) {
+		lhs, err := lhsFn(f)
+		if err != nil {
+			return nil, err
+		}
+		if value.IsNull(lhs) {
+			return value.NewNull(), nil
+		}
+
+		rhs, err := rhsFn(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return Calculate(lhs, rhs, expr.Operator), nil
+	}
+}
+
+func compileConcat(expr parser.Concat, view *View) compiledExpression {
+	itemFns := compileExpressions(expr.Items, view)
+
+	return func(f *Filter) (value.Primary, error) {
+		items := make([]string, len(itemFns))
+		for i, fn := range itemFns {
+			s, err := fn(f)
+			if err != nil {
+				return nil, err
+			}
+			s = value.ToString(s)
+			if value.IsNull(s) {
+				return value.NewNull(), nil
+			}
+			items[i] = s.(value.String).Raw()
+		}
+		return value.NewString(strings.Join(items, "")), nil
+	}
+}
+
+func compileComparison(expr parser.Comparison, collation value.Collation, view *View) compiledExpression {
+	lhsFn := compileExpression(expr.LHS, view)
+	rhsFn := compileExpression(expr.RHS, view)
+
+	return func(f *Filter) (value.Primary, error) {
+		lhs, err := lhsFn(f)
+		if err != nil {
+			return nil, err
+		}
+		if value.IsNull(lhs) {
+			return value.NewTernary(ternary.UNKNOWN), nil
+		}
+
+		rhs, err := rhsFn(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return value.NewTernary(value.CompareWithCollation(lhs, rhs, expr.Operator, collation)), nil
+	}
+}
+
+func compileIs(expr parser.Is, view *View) compiledExpression {
+	lhsFn := compileExpression(expr.LHS, view)
+	rhsFn := compileExpression(expr.RHS, view)
+	negated := expr.IsNegated()
+
+	return func(f *Filter) (value.Primary, error) {
+		lhs, err := lhsFn(f)
+		if err != nil {
+			return nil, err
+		}
+		rhs, err := rhsFn(f)
+		if err != nil {
+			return nil, err
+		}
+
+		t := Is(lhs, rhs)
+		if negated {
+			t = ternary.Not(t)
+		}
+		return value.NewTernary(t), nil
+	}
+}
+
+func compileBetween(expr parser.Between, collation value.Collation, view *View) compiledExpression {
+	lhsFn := compileExpression(expr.LHS, view)
+	lowFn := compileExpression(expr.Low, view)
+	highFn := compileExpression(expr.High, view)
+	negated := expr.IsNegated()
+
+	return func(f *Filter) (value.Primary, error) {
+		lhs, err := lhsFn(f)
+		if err != nil {
+			return nil, err
+		}
+		if value.IsNull(lhs) {
+			return value.NewTernary(ternary.UNKNOWN), nil
+		}
+
+		low, err := lowFn(f)
+		if err != nil {
+			return nil, err
+		}
+
+		var t ternary.Value
+		lowResult := value.CompareWithCollation(lhs, low, ">=", collation)
+		if lowResult == ternary.FALSE {
+			t = ternary.FALSE
+		} else {
+			high, err := highFn(f)
+			if err != nil {
+				return nil, err
+			}
+			t = ternary.And(lowResult, value.CompareWithCollation(lhs, high, "<=", collation))
+		}
+
+		if negated {
+			t = ternary.Not(t)
+		}
+		return value.NewTernary(t), nil
+	}
+}
+
+func compileLike(expr parser.Like, collation value.Collation, view *View) compiledExpression {
+	lhsFn := compileExpression(expr.LHS, view)
+	patternFn := compileExpression(expr.Pattern, view)
+	negated := expr.IsNegated()
+
+	return func(f *Filter) (value.Primary, error) {
+		lhs, err := lhsFn(f)
+		if err != nil {
+			return nil, err
+		}
+		pattern, err := patternFn(f)
+		if err != nil {
+			return nil, err
+		}
+
+		t := LikeWithCollation(lhs, pattern, collation)
+		if negated {
+			t = ternary.Not(t)
+		}
+		return value.NewTernary(t), nil
+	}
+}
+
+func compileFunction(expr parser.Function, fn func(parser.Function, []value.Primary) (value.Primary, error), view *View) compiledExpression {
+	argFns := compileExpressions(expr.Args, view)
+
+	return func(f *Filter) (value.Primary, error) {
+		args := make([]value.Primary, len(argFns))
+		for i, argFn := range argFns {
+			arg, err := argFn(f)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = arg
+		}
+		return fn(expr, args)
+	}
+}
+
+func compileCaseExpr(expr parser.CaseExpr, view *View) compiledExpression {
+	var valueFn compiledExpression
+	if expr.Value != nil {
+		valueFn = compileExpression(expr.Value, view)
+	}
+
+	conditionFns := make([]compiledExpression, len(expr.When))
+	resultFns := make([]compiledExpression, len(expr.When))
+	for i, v := range expr.When {
+		when := v.(parser.CaseExprWhen)
+		conditionFns[i] = compileExpression(when.Condition, view)
+		resultFns[i] = compileExpression(when.Result, view)
+	}
+
+	var elseFn compiledExpression
+	if expr.Else != nil {
+		elseFn = compileExpression(expr.Else.(parser.CaseExprElse).Result, view)
+	}
+
+	return func(f *Filter) (value.Primary, error) {
+		var val value.Primary
+		if valueFn != nil {
+			p, err := valueFn(f)
+			if err != nil {
+				return nil, err
+			}
+			val = p
+		}
+
+		for i, conditionFn := range conditionFns {
+			cond, err := conditionFn(f)
+			if err != nil {
+				return nil, err
+			}
+
+			var t ternary.Value
+			if val == nil {
+				t = cond.Ternary()
+			} else {
+				t = value.Equal(val, cond)
+			}
+
+			if t == ternary.TRUE {
+				return resultFns[i](f)
+			}
+		}
+
+		if elseFn == nil {
+			return value.NewNull(), nil
+		}
+		return elseFn(f)
+	}
+}
+
+func compileLogic(expr parser.Logic, view *View) compiledExpression {
+	lhsFn := compileExpression(expr.LHS, view)
+	rhsFn := compileExpression(expr.RHS, view)
+	operator := expr.Operator.Token
+
+	return func(f *Filter) (value.Primary, error) {
+		lhs, err := lhsFn(f)
+		if err != nil {
+			return nil, err
+		}
+		switch operator {
+		case parser.AND:
+			if lhs.Ternary() == ternary.FALSE {
+				return value.NewTernary(ternary.FALSE), nil
+			}
+		case parser.OR:
+			if lhs.Ternary() == ternary.TRUE {
+				return value.NewTernary(ternary.TRUE), nil
+			}
+		}
+
+		rhs, err := rhsFn(f)
+		if err != nil {
+			return nil, err
+		}
+
+		var t ternary.Value
+		switch operator {
+		case parser.AND:
+			t = ternary.And(lhs.Ternary(), rhs.Ternary())
+		case parser.OR:
+			t = ternary.Or(lhs.Ternary(), rhs.Ternary())
+		}
+		return value.NewTernary(t), nil
+	}
+}
+
+func compileUnaryLogic(expr parser.UnaryLogic, view *View) compiledExpression {
+	operandFn := compileExpression(expr.Operand, view)
+	operator := expr.Operator.Token
+
+	return func(f *Filter) (value.Primary, error) {
+		ope, err := operandFn(f)
+		if err != nil {
+			return nil, err
+		}
+
+		var t ternary.Value
+		switch operator {
+		case parser.NOT, '!':
+			t = ternary.Not(ope.Ternary())
+		}
+		return value.NewTernary(t), nil
+	}
+}
@@ -0,0 +1,166 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var compileExpressionTests = []struct {
+	Name string
+	Expr string
+}{
+	{
+		Name: "Field Reference",
+		Expr: "column1",
+	},
+	{
+		Name: "Field Reference with View Name",
+		Expr: "table1.column2",
+	},
+	{
+		Name: "Column Number",
+		Expr: "table1.2",
+	},
+	{
+		Name: "Arithmetic",
+		Expr: "column1 * 2 + 1",
+	},
+	{
+		Name: "Concat",
+		Expr: "column2 || '-' || column1",
+	},
+	{
+		Name: "Comparison",
+		Expr: "column1 >= 2",
+	},
+	{
+		Name: "Row Value Comparison",
+		Expr: "(column1, column2) = (2, 'str2')",
+	},
+	{
+		Name: "Is",
+		Expr: "column1 IS NOT NULL",
+	},
+	{
+		Name: "Between",
+		Expr: "column1 NOT BETWEEN 2 AND 3",
+	},
+	{
+		Name: "Like",
+		Expr: "column2 LIKE '%2'",
+	},
+	{
+		Name: "Function",
+		Expr: "UPPER(column2)",
+	},
+	{
+		Name: "Case Expression",
+		Expr: "CASE column1 WHEN 1 THEN 'a' WHEN 2 THEN 'b' ELSE column2 END",
+	},
+	{
+		Name: "Case Expression without Value",
+		Expr: "CASE WHEN column1 = 1 THEN 'a' END",
+	},
+	{
+		Name: "Logic",
+		Expr: "NOT (column1 = 1 OR column2 = 'str3') AND TRUE",
+	},
+	{
+		Name: "Not Compiled Expression",
+		Expr: "column1 IN (1, 3)",
+	},
+	{
+		Name: "Field Not Exist Error",
+		Expr: "column1 = 1 OR notexist = 1",
+	},
+	{
+		Name: "Function Not Exist Error",
+		Expr: "column1 = 2 AND notexist(column1)",
+	},
+}
+
+func TestCompileExpression(t *testing.T) {
+	view := &View{
+		Header: NewHeader("table1", []string{"column1", "column2"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewString("str1")}),
+			NewRecord([]value.Primary{value.NewInteger(2), value.NewString("str2")}),
+			NewRecord([]value.Primary{value.NewNull(), value.NewString("str3")}),
+		},
+	}
+
+	for _, v := range compileExpressionTests {
+		program, err := parser.Parse("SELECT "+v.Expr, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+		expr := program[0].(parser.SelectQuery).SelectEntity.(parser.SelectEntity).SelectClause.(parser.SelectClause).Fields[0].(parser.Field).Object
+
+		compiled := compileExpression(expr, view)
+
+		filter := NewFilterForSequentialEvaluation(view, NewEmptyFilter())
+		filter.init()
+		for filter.next() {
+			expect, expectErr := filter.Evaluate(expr)
+			result, err := compiled(filter)
+
+			if !reflect.DeepEqual(err, expectErr) {
+				t.Errorf("%s: record %d: error = %v, want %v", v.Name, filter.currentIndex(), err, expectErr)
+				continue
+			}
+			if !reflect.DeepEqual(result, expect) {
+				t.Errorf("%s: record %d: result = %#v, want %#v", v.Name, filter.currentIndex(), result, expect)
+			}
+		}
+	}
+}
+
+var benchCompiledCondition = parser.Logic{
+	LHS: parser.Comparison{
+		LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "c1"}},
+		RHS:      parser.NewIntegerValue(100),
+		Operator: ">",
+	},
+	RHS: parser.Comparison{
+		LHS: parser.Arithmetic{
+			LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "c1"}},
+			RHS:      parser.NewIntegerValue(2),
+			Operator: '%',
+		},
+		RHS:      parser.NewIntegerValue(0),
+		Operator: "=",
+	},
+	Operator: parser.Token{Token: parser.AND, Literal: "and"},
+}
+
+func BenchmarkFilter_EvaluateCondition(b *testing.B) {
+	view := GenerateBenchView("t", 10000)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		filter := NewFilterForSequentialEvaluation(view, NewEmptyFilter())
+		filter.init()
+		for filter.next() {
+			_, _ = filter.Evaluate(benchCompiledCondition)
+		}
+	}
+}
+
+func BenchmarkCompiledExpression_EvaluateCondition(b *testing.B) {
+	view := GenerateBenchView("t", 10000)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		evaluate := compileExpression(benchCompiledCondition, view)
+		filter := NewFilterForSequentialEvaluation(view, NewEmptyFilter())
+		filter.init()
+		for filter.next() {
+			_, _ = evaluate(filter)
+		}
+	}
+}
@@ -30,6 +30,7 @@ var statementPrefix = []string{
 	"INSERT",
 	"UPDATE",
 	"DELETE",
+	"COPY",
 	"CREATE",
 	"ALTER",
 	"DECLARE",
@@ -43,7 +44,10 @@ var statementPrefix = []string{
 	"PRINTF",
 	"CHDIR",
 	"EXECUTE",
+	"PREPARE",
 	"SHOW",
+	"EXPLAIN",
+	"ESTIMATE",
 	"SOURCE",
 	"SYNTAX",
 	"RELOAD",
@@ -147,21 +151,27 @@ func NewCompleter(filter *Filter) *Completer {
 	sort.Strings(completer.flagList)
 	sort.Strings(completer.runinfoList)
 
-	completer.funcs = make([]string, 0, len(Functions)+2)
+	completer.funcs = make([]string, 0, len(Functions)+len(ExceptionFunctions)+3)
 	for k := range Functions {
 		completer.funcs = append(completer.funcs, k)
 	}
+	for k := range ExceptionFunctions {
+		completer.funcs = append(completer.funcs, k)
+	}
 	completer.funcs = append(completer.funcs, "NOW")
+	completer.funcs = append(completer.funcs, "UUID")
 	completer.funcs = append(completer.funcs, "JSON_OBJECT")
 
-	completer.aggFuncs = make([]string, 0, len(AggregateFunctions)+2)
+	completer.aggFuncs = make([]string, 0, len(AggregateFunctions)+4)
 	completer.analyticFuncs = make([]string, 0, len(AnalyticFunctions)+len(AggregateFunctions))
 	for k := range AggregateFunctions {
 		completer.aggFuncs = append(completer.aggFuncs, k)
 		completer.analyticFuncs = append(completer.analyticFuncs, k)
 	}
 	completer.aggFuncs = append(completer.aggFuncs, "LISTAGG")
+	completer.aggFuncs = append(completer.aggFuncs, "GROUP_CONCAT")
 	completer.aggFuncs = append(completer.aggFuncs, "JSON_AGG")
+	completer.aggFuncs = append(completer.aggFuncs, "ARRAY_AGG")
 	for k := range AnalyticFunctions {
 		completer.analyticFuncs = append(completer.analyticFuncs, k)
 	}
@@ -554,7 +564,7 @@ func (c *Completer) FunctionArgs(line string, origLine string, index int) readli
 							if funcName == "FIRST_VALUE" ||
 								funcName == "LAST_VALUE" ||
 								funcName == "NTH_VALUE" ||
-								(funcName != "LISTAGG" && funcName != "JSON_AGG" && InStrSliceWithCaseInsensitive(funcName, c.aggFuncs)) ||
+								(funcName != "LISTAGG" && funcName != "GROUP_CONCAT" && funcName != "JSON_AGG" && funcName != "ARRAY_AGG" && InStrSliceWithCaseInsensitive(funcName, c.aggFuncs)) ||
 								InStrSliceWithCaseInsensitive(funcName, c.userAggFuncs) {
 
 								customList = append(customList, c.candidate("ROWS", true))
@@ -1233,7 +1243,7 @@ func (c *Completer) CreateArgs(line string, origLine string, index int) readline
 				}
 			case parser.CREATE:
 				if i == c.lastIdx {
-					return []string{"TABLE"}, nil, true
+					return []string{"TABLE", "INDEX"}, nil, true
 				}
 			}
 			return nil, nil, false
@@ -1392,6 +1402,7 @@ func (c *Completer) DeclareArgs(line string, origLine string, index int) readlin
 						"VIEW",
 						"FUNCTION",
 						"AGGREGATE",
+						"TRIGGER",
 					}
 					return obj, nil, true
 				}
@@ -1473,13 +1484,21 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.SearchDirs(line, origLine, index), true
 					case cmd.TimezoneFlag:
 						return nil, c.candidateList([]string{"Local", "UTC"}, false), true
+					case cmd.DecimalSeparatorFlag:
+						return nil, c.candidateList([]string{".", ","}, false), true
+					case cmd.ThousandsSeparatorFlag:
+						return nil, c.candidateList([]string{",", ".", "'", "_"}, false), true
+					case cmd.CollationFlag:
+						return nil, c.candidateList([]string{"BINARY", "NOCASE", "UNICODE", "UNICODE_CI", "NATURAL"}, false), true
+					case cmd.LanguageFlag:
+						return nil, c.candidateList([]string{cmd.EnglishLanguage, cmd.JapaneseLanguage}, false), true
 					case cmd.DelimiterFlag, cmd.WriteDelimiterFlag:
 						return nil, c.candidateList(delimiterCandidates, false), true
 					case cmd.EncodingFlag, cmd.WriteEncodingFlag:
 						return nil, c.candidateList(c.encodingList(), false), true
-					case cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
+					case cmd.SourceRelativeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.RecoverQuotesFlag, cmd.WithoutHeaderFlag, cmd.NormalizeLineBreakFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
 						cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag,
-						cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag:
+						cmd.ColorFlag, cmd.PlainFlag, cmd.QuietFlag, cmd.StatsFlag, cmd.StreamFlag, cmd.FilterOnLoadFlag:
 						return nil, c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false), true
 					case cmd.FormatFlag:
 						return nil, c.candidateList(c.tableFormatList(), false), true
@@ -1487,6 +1506,8 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.candidateList(c.lineBreakList(), false), true
 					case cmd.JsonEscape:
 						return nil, c.candidateList(c.jsonEscapeTypeList(), false), true
+					case cmd.QuoteStyleFlag:
+						return nil, c.candidateList(c.quoteStyleList(), false), true
 					}
 				}
 				return nil, c.SearchValues(line, origLine, index), true
@@ -2374,6 +2395,15 @@ func (c *Completer) lineBreakList() []string {
 	return list
 }
 
+func (c *Completer) quoteStyleList() []string {
+	list := make([]string, 0, len(cmd.QuoteStyleLiteral))
+	for _, v := range cmd.QuoteStyleLiteral {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return list
+}
+
 func (c *Completer) jsonEscapeTypeList() []string {
 	list := make([]string, 0, len(cmd.JsonEscapeTypeLiteral))
 	for _, v := range cmd.JsonEscapeTypeLiteral {
@@ -98,10 +98,10 @@ func TestCompleter_Update(t *testing.T) {
 	if len(c.runinfoList) != len(RuntimeInformatinList) || !strings.HasPrefix(c.runinfoList[0], cmd.RuntimeInformationSign) {
 		t.Error("runtime information are not set correctly")
 	}
-	if len(c.funcs) != len(Functions)+2 {
+	if len(c.funcs) != len(Functions)+len(ExceptionFunctions)+3 {
 		t.Error("functions are not set correctly")
 	}
-	if len(c.aggFuncs) != len(AggregateFunctions)+2 {
+	if len(c.aggFuncs) != len(AggregateFunctions)+4 {
 		t.Error("aggregate functions are not set correctly")
 	}
 	if len(c.analyticFuncs) != len(AnalyticFunctions)+len(AggregateFunctions) {
@@ -118,10 +118,10 @@ func TestCompleter_Update(t *testing.T) {
 	if !reflect.DeepEqual(c.userFuncList, []string{"aggfunc", "scalafunc"}) {
 		t.Error("user defined functions are not set correctly")
 	}
-	if len(c.funcList) != len(Functions)+2+1 || !strings.HasSuffix(c.funcList[0], "()") {
+	if len(c.funcList) != len(Functions)+len(ExceptionFunctions)+3+1 || !strings.HasSuffix(c.funcList[0], "()") {
 		t.Error("function list are not set correctly")
 	}
-	if len(c.aggFuncList) != len(AggregateFunctions)+2+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
+	if len(c.aggFuncList) != len(AggregateFunctions)+4+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
 		t.Error("aggregate function list are not set correctly")
 	}
 	if len(c.analyticFuncList) != len(AnalyticFunctions)+len(AggregateFunctions)+1 || !strings.HasSuffix(c.analyticFuncList[0], "() OVER ()") {
@@ -213,16 +213,20 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("CHDIR"), AppendSpace: true},
 			{Name: []rune("CLOSE"), AppendSpace: true},
 			{Name: []rune("COMMIT")},
+			{Name: []rune("COPY"), AppendSpace: true},
 			{Name: []rune("CREATE"), AppendSpace: true},
 			{Name: []rune("DECLARE"), AppendSpace: true},
 			{Name: []rune("DELETE"), AppendSpace: true},
 			{Name: []rune("DISPOSE"), AppendSpace: true},
 			{Name: []rune("ECHO"), AppendSpace: true},
+			{Name: []rune("ESTIMATE"), AppendSpace: true},
 			{Name: []rune("EXECUTE"), AppendSpace: true},
 			{Name: []rune("EXIT")},
+			{Name: []rune("EXPLAIN"), AppendSpace: true},
 			{Name: []rune("FETCH"), AppendSpace: true},
 			{Name: []rune("INSERT"), AppendSpace: true},
 			{Name: []rune("OPEN"), AppendSpace: true},
+			{Name: []rune("PREPARE"), AppendSpace: true},
 			{Name: []rune("PRINT"), AppendSpace: true},
 			{Name: []rune("PRINTF"), AppendSpace: true},
 			{Name: []rune("PWD")},
@@ -291,6 +295,7 @@ var completerStatementsTests = []completerTest{
 		OrigLine: "create ",
 		Index:    7,
 		Expect: readline.CandidateList{
+			{Name: []rune("INDEX"), AppendSpace: true},
 			{Name: []rune("TABLE"), AppendSpace: true},
 		},
 	},
@@ -312,6 +317,7 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("AGGREGATE"), AppendSpace: true},
 			{Name: []rune("CURSOR"), AppendSpace: true},
 			{Name: []rune("FUNCTION"), AppendSpace: true},
+			{Name: []rune("TRIGGER"), AppendSpace: true},
 			{Name: []rune("VIEW"), AppendSpace: true},
 		},
 	},
@@ -1897,6 +1903,7 @@ var completerCreateArgsTests = []completerTest{
 		OrigLine: "create ",
 		Index:    7,
 		Expect: readline.CandidateList{
+			{Name: []rune("INDEX"), AppendSpace: true},
 			{Name: []rune("TABLE"), AppendSpace: true},
 		},
 	},
@@ -2254,6 +2261,7 @@ var completerDeclareArgsTests = []completerTest{
 			{Name: []rune("AGGREGATE"), AppendSpace: true},
 			{Name: []rune("CURSOR"), AppendSpace: true},
 			{Name: []rune("FUNCTION"), AppendSpace: true},
+			{Name: []rune("TRIGGER"), AppendSpace: true},
 			{Name: []rune("VIEW"), AppendSpace: true},
 		},
 	},
@@ -0,0 +1,165 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/excmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+// ConflictFiles are the files to resolve a conflict between the changes in a transaction
+// and the modification by another application.
+type ConflictFiles struct {
+	Dir    string
+	Base   string
+	Ours   string
+	Theirs string
+	Merged string
+}
+
+// ResolveConflict is called when the file to be committed has been modified by another application.
+//
+// If neither a merge tool nor a conflict directory is specified, then returns an error.
+// Otherwise the base, ours and theirs files are saved, and the merge tool is executed if specified.
+// When the merge tool exits successfully, the merged contents are to be committed.
+func ResolveConflict(expr parser.Expression, fileInfo *FileInfo) error {
+	flags := cmd.GetFlags()
+
+	if len(flags.MergeTool) < 1 && len(flags.ConflictDir) < 1 {
+		return NewCommitError(expr, errorMessage(ErrorFileModified, fileInfo.Path))
+	}
+
+	dir := flags.ConflictDir
+	if len(dir) < 1 {
+		dir = os.TempDir()
+	}
+
+	files, err := SaveConflictFiles(dir, fileInfo)
+	if err != nil {
+		return NewCommitError(expr, err.Error())
+	}
+	modifiedErr := NewCommitError(expr, errorMessage(ErrorFileModifiedWithConflictFiles, fileInfo.Path, files.Dir))
+
+	if len(flags.MergeTool) < 1 {
+		return modifiedErr
+	}
+
+	if err = runMergeTool(flags.MergeTool, files); err != nil {
+		LogError(err.Error())
+		return modifiedErr
+	}
+
+	merged, err := ioutil.ReadFile(files.Merged)
+	if err != nil {
+		return NewCommitError(expr, err.Error())
+	}
+
+	fp := fileInfo.Handler.FileForUpdate()
+	if err = fp.Truncate(0); err != nil {
+		return NewCommitError(expr, err.Error())
+	}
+	if _, err = fp.Seek(0, io.SeekStart); err != nil {
+		return NewCommitError(expr, err.Error())
+	}
+	if _, err = fp.Write(merged); err != nil {
+		return NewCommitError(expr, err.Error())
+	}
+
+	os.RemoveAll(files.Dir)
+	LogNotice(fmt.Sprintf(cmd.Message("Commit: conflict in file %q is resolved by the merge tool."), fileInfo.Path), cmd.GetFlags().Quiet)
+	return nil
+}
+
+// SaveConflictFiles creates a new directory in dir, and saves the files to resolve the conflict.
+func SaveConflictFiles(dir string, fileInfo *FileInfo) (*ConflictFiles, error) {
+	base, err := fileInfo.Handler.Base()
+	if err != nil {
+		return nil, err
+	}
+
+	fp := fileInfo.Handler.FileForUpdate()
+	if _, err = fp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	ours, err := ioutil.ReadAll(fp)
+	if err != nil {
+		return nil, err
+	}
+
+	theirs, err := ioutil.ReadFile(fileInfo.Path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	fname := filepath.Base(fileInfo.Path)
+	ext := filepath.Ext(fname)
+	name := fname[:len(fname)-len(ext)]
+
+	conflictDir, err := ioutil.TempDir(dir, fname+".conflict.")
+	if err != nil {
+		return nil, err
+	}
+
+	files := &ConflictFiles{
+		Dir:    conflictDir,
+		Base:   filepath.Join(conflictDir, name+".base"+ext),
+		Ours:   filepath.Join(conflictDir, name+".ours"+ext),
+		Theirs: filepath.Join(conflictDir, name+".theirs"+ext),
+		Merged: filepath.Join(conflictDir, name+".merged"+ext),
+	}
+
+	for _, f := range []struct {
+		path     string
+		contents []byte
+	}{
+		{path: files.Base, contents: base},
+		{path: files.Ours, contents: ours},
+		{path: files.Theirs, contents: theirs},
+		{path: files.Merged, contents: ours},
+	} {
+		if err = ioutil.WriteFile(f.path, f.contents, 0600); err != nil {
+			os.RemoveAll(conflictDir)
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+func runMergeTool(command string, files *ConflictFiles) error {
+	replacer := strings.NewReplacer(
+		"${BASE}", files.Base,
+		"${OURS}", files.Ours,
+		"${THEIRS}", files.Theirs,
+		"${MERGED}", files.Merged,
+	)
+
+	splitter := new(excmd.ArgsSplitter).Init(command)
+	args := make([]string, 0, 8)
+	for splitter.Scan() {
+		args = append(args, replacer.Replace(splitter.Text()))
+	}
+	if err := splitter.Err(); err != nil {
+		return errors.New(fmt.Sprintf("merge tool: %s", err.Error()))
+	}
+	if len(args) < 1 {
+		return errors.New("merge tool: command is empty")
+	}
+
+	c := exec.Command(args[0], args[1:]...)
+	c.Stdin = Stdin
+	c.Stdout = Stdout
+	c.Stderr = Stderr
+
+	if err := c.Run(); err != nil {
+		return errors.New(fmt.Sprintf("merge tool: %s", err.Error()))
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package query
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var resolveConflictTests = []struct {
+	Name           string
+	MergeTool      string
+	ConflictDir    bool
+	Error          string
+	ConflictFiles  bool
+	ExpectContents map[string]string
+}{
+	{
+		Name:  "ResolveConflict",
+		Error: "[L:- C:-] failed to commit: file %s has been modified by another application",
+	},
+	{
+		Name:          "ResolveConflict Save Conflict Files",
+		ConflictDir:   true,
+		Error:         "[L:- C:-] failed to commit: file %s has been modified by another application, and files to resolve the conflict are saved in %s",
+		ConflictFiles: true,
+		ExpectContents: map[string]string{
+			"conflict.base.csv":   "c1\nbase",
+			"conflict.ours.csv":   "c1\nours",
+			"conflict.theirs.csv": "c1\ntheirs",
+			"conflict.merged.csv": "c1\nours",
+		},
+	},
+	{
+		Name:          "ResolveConflict Merge Tool Error",
+		MergeTool:     "notexistcommand ${MERGED} ${BASE} ${THEIRS}",
+		ConflictDir:   true,
+		Error:         "[L:- C:-] failed to commit: file %s has been modified by another application, and files to resolve the conflict are saved in %s",
+		ConflictFiles: true,
+	},
+}
+
+func TestResolveConflict(t *testing.T) {
+	defer initFlag(cmd.GetFlags())
+
+	flags := cmd.GetFlags()
+	conflictDir := filepath.Join(TestDir, "conflict_dir")
+	fpath := filepath.Join(TestDir, "conflict.csv")
+
+	for _, v := range resolveConflictTests {
+		os.RemoveAll(conflictDir)
+		if err := os.Mkdir(conflictDir, 0755); err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+		if err := ioutil.WriteFile(fpath, []byte("c1\nbase"), 0600); err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		flags.SetMergeTool(v.MergeTool)
+		if v.ConflictDir {
+			flags.SetConflictDir(conflictDir)
+		} else {
+			flags.SetConflictDir("")
+		}
+
+		h, err := file.NewHandlerForUpdate(fpath)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+		h.KeepBase()
+		h.FileForUpdate().Write([]byte("c1\nours"))
+		ioutil.WriteFile(fpath, []byte("c1\ntheirs"), 0600)
+
+		fileInfo := &FileInfo{Path: fpath, Handler: h}
+		if !fileInfo.IsModified() {
+			t.Errorf("%s: file is not detected as modified", v.Name)
+		}
+
+		err = ResolveConflict(parser.TransactionControl{BaseExpr: &parser.BaseExpr{}}, fileInfo)
+		h.Close()
+
+		var savedDir string
+		if dirs, _ := ioutil.ReadDir(conflictDir); 0 < len(dirs) {
+			savedDir = filepath.Join(conflictDir, dirs[0].Name())
+		}
+		if v.ConflictFiles && len(savedDir) < 1 {
+			t.Errorf("%s: conflict files are not saved", v.Name)
+			continue
+		}
+		if !v.ConflictFiles && 0 < len(savedDir) {
+			t.Errorf("%s: conflict files are saved unexpectedly", v.Name)
+		}
+
+		expectErr := fmt.Sprintf(v.Error, fpath, savedDir)
+		if strings.Count(v.Error, "%s") < 2 {
+			expectErr = fmt.Sprintf(v.Error, fpath)
+		}
+		if err == nil {
+			t.Errorf("%s: no error, want error %q", v.Name, expectErr)
+		} else if err.Error() != expectErr {
+			t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), expectErr)
+		}
+
+		for fname, expect := range v.ExpectContents {
+			buf, err := ioutil.ReadFile(filepath.Join(savedDir, fname))
+			if err != nil {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+				continue
+			}
+			if string(buf) != expect {
+				t.Errorf("%s: contents of %s = %q, want %q", v.Name, fname, string(buf), expect)
+			}
+		}
+	}
+	os.RemoveAll(conflictDir)
+}
@@ -0,0 +1,180 @@
+package query
+
+import (
+	"bytes"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+// CheckConstraints verifies that the records at the indices satisfy the constraints
+// declared for the table with CREATE TABLE or DECLARE VIEW.
+//
+// A record violates a check constraint only if the condition is FALSE,
+// so the condition that results in UNKNOWN is regarded as satisfied.
+// As with the comparison of NULLs, records having NULL in any column of a unique constraint
+// never violate the unique constraint.
+func (view *View) CheckConstraints(expr parser.QueryExpression, indices []int, parentFilter *Filter) error {
+	if view.FileInfo == nil || len(view.FileInfo.Constraints) < 1 {
+		return nil
+	}
+
+	table := parser.FormatTableName(view.FileInfo.Path)
+
+	var filter *Filter
+	for _, c := range view.FileInfo.Constraints {
+		var err error
+
+		switch c.(type) {
+		case parser.CheckConstraint:
+			if filter == nil {
+				filter = NewFilterForSequentialEvaluation(view, parentFilter)
+			}
+			err = view.checkCondition(expr, c.(parser.CheckConstraint), table, indices, filter)
+		case parser.NotNullConstraint:
+			err = view.checkNotNull(expr, c.(parser.NotNullConstraint), table, indices)
+		case parser.UniqueConstraint:
+			err = view.checkUnique(expr, c.(parser.UniqueConstraint), table, indices)
+		case parser.AutoIncrement:
+			_, err = view.constraintFieldIndex(c.(parser.AutoIncrement).Column)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (view *View) checkCondition(expr parser.QueryExpression, constraint parser.CheckConstraint, table string, indices []int, filter *Filter) error {
+	for _, idx := range indices {
+		filter.Records[0].RecordIndex = idx
+
+		p, err := filter.Evaluate(constraint.Condition)
+		if err != nil {
+			return err
+		}
+		if p.Ternary() == ternary.FALSE {
+			return NewCheckConstraintViolationError(expr, constraint, table, view.RecordSet[idx])
+		}
+	}
+	return nil
+}
+
+func (view *View) checkNotNull(expr parser.QueryExpression, constraint parser.NotNullConstraint, table string, indices []int) error {
+	fieldIdx, err := view.constraintFieldIndex(constraint.Column)
+	if err != nil {
+		return err
+	}
+
+	for _, idx := range indices {
+		if value.IsNull(view.RecordSet[idx][fieldIdx].Value()) {
+			return NewNotNullConstraintViolationError(expr, constraint, table, view.RecordSet[idx])
+		}
+	}
+	return nil
+}
+
+func (view *View) checkUnique(expr parser.QueryExpression, constraint parser.UniqueConstraint, table string, indices []int) error {
+	fieldIndices := make([]int, len(constraint.Columns))
+	for i, c := range constraint.Columns {
+		idx, err := view.constraintFieldIndex(c.(parser.Identifier))
+		if err != nil {
+			return err
+		}
+		fieldIndices[i] = idx
+	}
+
+	if len(indices) < 1 {
+		return nil
+	}
+
+	checking := make(map[int]bool, len(indices))
+	for _, idx := range indices {
+		checking[idx] = true
+	}
+
+	buf := new(bytes.Buffer)
+	keys := make(map[string]int, view.RecordLen())
+	values := make([]value.Primary, len(fieldIndices))
+
+RecordLoop:
+	for i, record := range view.RecordSet {
+		for j, fieldIdx := range fieldIndices {
+			values[j] = record[fieldIdx].Value()
+			if value.IsNull(values[j]) {
+				continue RecordLoop
+			}
+		}
+
+		buf.Reset()
+		SerializeComparisonKeys(buf, values)
+		key := buf.String()
+
+		if first, ok := keys[key]; ok {
+			if checking[i] || checking[first] {
+				return NewUniqueConstraintViolationError(expr, constraint, table, values)
+			}
+			continue
+		}
+		keys[key] = i
+	}
+	return nil
+}
+
+// assignAutoIncrements sets sequential integers to the fields declared with AUTO_INCREMENT
+// in the records to be inserted, if the fields are not specified in the INSERT query.
+//
+// The sequence starts at the next integer after the largest integer in the column,
+// or at 1 if the column has no integer.
+func (view *View) assignAutoIncrements(records []Record, fieldIndices []int) error {
+	if view.FileInfo == nil {
+		return nil
+	}
+
+	for _, c := range view.FileInfo.Constraints {
+		autoIncrement, ok := c.(parser.AutoIncrement)
+		if !ok {
+			continue
+		}
+
+		fieldIdx, err := view.constraintFieldIndex(autoIncrement.Column)
+		if err != nil {
+			return err
+		}
+		if InIntSlice(fieldIdx, fieldIndices) {
+			continue
+		}
+
+		next := view.maxInteger(fieldIdx) + 1
+		for _, record := range records {
+			record[fieldIdx] = NewCell(value.NewInteger(next))
+			next++
+		}
+	}
+	return nil
+}
+
+func (view *View) maxInteger(fieldIdx int) int64 {
+	var max int64
+	for _, record := range view.RecordSet {
+		if i := value.ToInteger(record[fieldIdx].Value()); !value.IsNull(i) && max < i.(value.Integer).Raw() {
+			max = i.(value.Integer).Raw()
+		}
+	}
+	return max
+}
+
+func (view *View) constraintFieldIndex(column parser.Identifier) (int, error) {
+	return view.FieldIndex(parser.FieldReference{BaseExpr: column.BaseExpr, Column: column})
+}
+
+func recordIndices(from int, to int) []int {
+	indices := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		indices = append(indices, i)
+	}
+	return indices
+}
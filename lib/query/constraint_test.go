@@ -0,0 +1,281 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var viewCheckConstraintsTests = []struct {
+	Name        string
+	Constraints []parser.QueryExpression
+	Indices     []int
+	Error       string
+}{
+	{
+		Name:    "CheckConstraints Without Constraints",
+		Indices: []int{0, 1, 2},
+	},
+	{
+		Name: "CheckConstraints",
+		Constraints: []parser.QueryExpression{
+			parser.CheckConstraint{
+				Condition: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					Operator: ">",
+					RHS:      parser.NewIntegerValueFromString("0"),
+				},
+			},
+		},
+		Indices: []int{0, 1},
+	},
+	{
+		Name: "CheckConstraints Unknown Result",
+		Constraints: []parser.QueryExpression{
+			parser.CheckConstraint{
+				Condition: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					Operator: "<>",
+					RHS:      parser.NewStringValue("x"),
+				},
+			},
+		},
+		Indices: []int{0, 1, 2},
+	},
+	{
+		Name: "CheckConstraints Violation Error",
+		Constraints: []parser.QueryExpression{
+			parser.CheckConstraint{
+				Condition: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					Operator: ">",
+					RHS:      parser.NewIntegerValueFromString("0"),
+				},
+			},
+		},
+		Indices: []int{1, 2},
+		Error:   "[L:- C:-] CHECK (column1 > 0) of table tbl is violated by values (0, \"str\")",
+	},
+	{
+		Name: "CheckConstraints Named Constraint Violation Error",
+		Constraints: []parser.QueryExpression{
+			parser.CheckConstraint{
+				Name: parser.Identifier{Literal: "positive"},
+				Condition: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					Operator: ">",
+					RHS:      parser.NewIntegerValueFromString("0"),
+				},
+			},
+		},
+		Indices: []int{2},
+		Error:   "[L:- C:-] CONSTRAINT positive CHECK (column1 > 0) of table tbl is violated by values (0, \"str\")",
+	},
+	{
+		Name: "CheckConstraints Evaluation Error",
+		Constraints: []parser.QueryExpression{
+			parser.CheckConstraint{
+				Condition: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+					Operator: ">",
+					RHS:      parser.NewIntegerValueFromString("0"),
+				},
+			},
+		},
+		Indices: []int{0},
+		Error:   "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "CheckConstraints Not Null",
+		Constraints: []parser.QueryExpression{
+			parser.NotNullConstraint{
+				Column: parser.Identifier{Literal: "column2"},
+			},
+		},
+		Indices: []int{0, 2},
+	},
+	{
+		Name: "CheckConstraints Not Null Violation Error",
+		Constraints: []parser.QueryExpression{
+			parser.NotNullConstraint{
+				Column: parser.Identifier{Literal: "column2"},
+			},
+		},
+		Indices: []int{0, 1},
+		Error:   "[L:- C:-] NOT NULL of field column2 in table tbl is violated by values (2, NULL)",
+	},
+	{
+		Name: "CheckConstraints Not Null Field Not Exist Error",
+		Constraints: []parser.QueryExpression{
+			parser.NotNullConstraint{
+				Column: parser.Identifier{Literal: "notexist"},
+			},
+		},
+		Indices: []int{},
+		Error:   "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "CheckConstraints Unique",
+		Constraints: []parser.QueryExpression{
+			parser.UniqueConstraint{
+				Columns: []parser.QueryExpression{
+					parser.Identifier{Literal: "column1"},
+				},
+			},
+		},
+		Indices: []int{0, 1, 2, 3},
+	},
+	{
+		Name: "CheckConstraints Unique Ignoring Nulls",
+		Constraints: []parser.QueryExpression{
+			parser.UniqueConstraint{
+				Columns: []parser.QueryExpression{
+					parser.Identifier{Literal: "column2"},
+					parser.Identifier{Literal: "column1"},
+				},
+			},
+		},
+		Indices: []int{1, 3},
+	},
+	{
+		Name: "CheckConstraints Unique Violation Error",
+		Constraints: []parser.QueryExpression{
+			parser.UniqueConstraint{
+				Name: parser.Identifier{Literal: "uq"},
+				Columns: []parser.QueryExpression{
+					parser.Identifier{Literal: "column2"},
+				},
+			},
+		},
+		Indices: []int{2},
+		Error:   "[L:- C:-] CONSTRAINT uq UNIQUE (column2) of table tbl is violated by duplicate values (\"str\")",
+	},
+	{
+		Name: "CheckConstraints Unique Field Not Exist Error",
+		Constraints: []parser.QueryExpression{
+			parser.UniqueConstraint{
+				Columns: []parser.QueryExpression{
+					parser.Identifier{Literal: "notexist"},
+				},
+			},
+		},
+		Indices: []int{},
+		Error:   "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "CheckConstraints Auto Increment Field Not Exist Error",
+		Constraints: []parser.QueryExpression{
+			parser.AutoIncrement{
+				Column: parser.Identifier{Literal: "notexist"},
+			},
+		},
+		Indices: []int{},
+		Error:   "[L:- C:-] field notexist does not exist",
+	},
+}
+
+func TestView_CheckConstraints(t *testing.T) {
+	for _, v := range viewCheckConstraintsTests {
+		view := &View{
+			Header: NewHeader("tbl", []string{"column1", "column2"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("str")}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewNull()}),
+				NewRecord([]value.Primary{value.NewInteger(0), value.NewString("str")}),
+				NewRecord([]value.Primary{value.NewInteger(3), value.NewNull()}),
+			},
+			FileInfo: &FileInfo{
+				Path:        "tbl",
+				IsTemporary: true,
+				Constraints: v.Constraints,
+			},
+		}
+
+		err := view.CheckConstraints(parser.Identifier{Literal: "tbl"}, v.Indices, NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+}
+
+var viewInsertValuesWithAutoIncrementTests = []struct {
+	Name       string
+	Fields     []parser.QueryExpression
+	ValuesList []parser.QueryExpression
+	Result     RecordSet
+}{
+	{
+		Name: "Insert Values With Auto Increment",
+		Fields: []parser.QueryExpression{
+			parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+		},
+		ValuesList: []parser.QueryExpression{
+			parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewStringValue("a")}}},
+			parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewStringValue("b")}}},
+		},
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("str")}),
+			NewRecord([]value.Primary{value.NewString("5"), value.NewNull()}),
+			NewRecord([]value.Primary{value.NewString("abc"), value.NewString("str")}),
+			NewRecord([]value.Primary{value.NewInteger(6), value.NewString("a")}),
+			NewRecord([]value.Primary{value.NewInteger(7), value.NewString("b")}),
+		},
+	},
+	{
+		Name: "Insert Values With Auto Increment Field Specified",
+		Fields: []parser.QueryExpression{
+			parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+		},
+		ValuesList: []parser.QueryExpression{
+			parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewIntegerValue(10), parser.NewStringValue("a")}}},
+		},
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("str")}),
+			NewRecord([]value.Primary{value.NewString("5"), value.NewNull()}),
+			NewRecord([]value.Primary{value.NewString("abc"), value.NewString("str")}),
+			NewRecord([]value.Primary{value.NewInteger(10), value.NewString("a")}),
+		},
+	},
+}
+
+func TestView_InsertValuesWithAutoIncrement(t *testing.T) {
+	for _, v := range viewInsertValuesWithAutoIncrementTests {
+		view := &View{
+			Header: NewHeader("tbl", []string{"column1", "column2"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{value.NewString("1"), value.NewString("str")}),
+				NewRecord([]value.Primary{value.NewString("5"), value.NewNull()}),
+				NewRecord([]value.Primary{value.NewString("abc"), value.NewString("str")}),
+			},
+			FileInfo: &FileInfo{
+				Path:        "tbl",
+				IsTemporary: true,
+				Constraints: []parser.QueryExpression{
+					parser.AutoIncrement{
+						Column: parser.Identifier{Literal: "column1"},
+					},
+				},
+			},
+			Filter: NewEmptyFilter(),
+		}
+
+		if _, err := view.InsertValues(v.Fields, v.ValuesList); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if !reflect.DeepEqual(view.RecordSet, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, view.RecordSet, v.Result)
+		}
+	}
+}
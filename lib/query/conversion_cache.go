@@ -0,0 +1,189 @@
+package query
+
+import (
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	txjson "github.com/mithrandie/go-text/json"
+)
+
+// conversionCacheVersion must be incremented when the format of cache files is changed.
+const conversionCacheVersion = 1
+
+const conversionCacheExt = ".cache"
+
+const (
+	cachedNull uint8 = iota
+	cachedString
+	cachedInteger
+	cachedFloat
+	cachedBoolean
+	cachedArray
+	cachedMap
+)
+
+type cachedTable struct {
+	Version    int
+	Header     []string
+	Rows       [][]cachedValue
+	JsonEscape txjson.EscapeType
+}
+
+type cachedValue struct {
+	Type    uint8
+	String  string
+	Integer int64
+	Float   float64
+	Boolean bool
+	Keys    []string
+	Values  []cachedValue
+}
+
+// ConversionCachePath returns the path of the cache file for the data converted from a JSON text.
+// Cache files are identified by the checksums of the texts and the queries.
+func ConversionCachePath(dir string, jsonQuery string, jsonText []byte) string {
+	h := sha256.New()
+	h.Write([]byte(strconv.Itoa(conversionCacheVersion)))
+	h.Write([]byte{0})
+	h.Write([]byte(jsonQuery))
+	h.Write([]byte{0})
+	h.Write(jsonText)
+	return filepath.Join(dir, hex.EncodeToString(h.Sum(nil))+conversionCacheExt)
+}
+
+func LoadConversionCache(fpath string) ([]string, [][]value.Primary, txjson.EscapeType, error) {
+	fp, err := os.Open(fpath)
+	if err != nil {
+		return nil, nil, txjson.Backslash, err
+	}
+	defer fp.Close()
+
+	table := cachedTable{}
+	if err = gob.NewDecoder(fp).Decode(&table); err != nil {
+		return nil, nil, txjson.Backslash, err
+	}
+	if table.Version != conversionCacheVersion {
+		return nil, nil, txjson.Backslash, errors.New("cache version does not match")
+	}
+
+	rows := make([][]value.Primary, len(table.Rows))
+	for i, row := range table.Rows {
+		rows[i] = decodeCachedValues(row)
+	}
+	return table.Header, rows, table.JsonEscape, nil
+}
+
+// SaveConversionCache writes the converted data to a cache file.
+// The file is written to a temporary file first and renamed, so that
+// other processes never read incomplete files.
+func SaveConversionCache(fpath string, header []string, rows [][]value.Primary, escapeType txjson.EscapeType) error {
+	table := cachedTable{
+		Version:    conversionCacheVersion,
+		Header:     header,
+		Rows:       make([][]cachedValue, len(rows)),
+		JsonEscape: escapeType,
+	}
+	for i, row := range rows {
+		values, err := encodeCachedValues(row)
+		if err != nil {
+			return err
+		}
+		table.Rows[i] = values
+	}
+
+	fp, err := ioutil.TempFile(filepath.Dir(fpath), filepath.Base(fpath)+".")
+	if err != nil {
+		return err
+	}
+	tmpPath := fp.Name()
+
+	if err = gob.NewEncoder(fp).Encode(&table); err != nil {
+		fp.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err = fp.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err = os.Rename(tmpPath, fpath); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+func encodeCachedValues(list []value.Primary) ([]cachedValue, error) {
+	values := make([]cachedValue, len(list))
+	for i, p := range list {
+		v, err := encodeCachedValue(p)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+func encodeCachedValue(p value.Primary) (cachedValue, error) {
+	switch p.(type) {
+	case value.Null:
+		return cachedValue{Type: cachedNull}, nil
+	case value.String:
+		return cachedValue{Type: cachedString, String: p.(value.String).Raw()}, nil
+	case value.Integer:
+		return cachedValue{Type: cachedInteger, Integer: p.(value.Integer).Raw()}, nil
+	case value.Float:
+		return cachedValue{Type: cachedFloat, Float: p.(value.Float).Raw()}, nil
+	case value.Boolean:
+		return cachedValue{Type: cachedBoolean, Boolean: p.(value.Boolean).Raw()}, nil
+	case value.Array:
+		values, err := encodeCachedValues(p.(value.Array).Raw())
+		if err != nil {
+			return cachedValue{}, err
+		}
+		return cachedValue{Type: cachedArray, Values: values}, nil
+	case value.Map:
+		m := p.(value.Map)
+		values, err := encodeCachedValues(m.Values())
+		if err != nil {
+			return cachedValue{}, err
+		}
+		return cachedValue{Type: cachedMap, Keys: m.Keys(), Values: values}, nil
+	}
+	return cachedValue{}, errors.New("value cannot be cached: " + p.String())
+}
+
+func decodeCachedValues(values []cachedValue) []value.Primary {
+	list := make([]value.Primary, len(values))
+	for i, v := range values {
+		list[i] = decodeCachedValue(v)
+	}
+	return list
+}
+
+func decodeCachedValue(v cachedValue) value.Primary {
+	switch v.Type {
+	case cachedString:
+		return value.NewString(v.String)
+	case cachedInteger:
+		return value.NewInteger(v.Integer)
+	case cachedFloat:
+		return value.NewFloat(v.Float)
+	case cachedBoolean:
+		return value.NewBoolean(v.Boolean)
+	case cachedArray:
+		return value.NewArray(decodeCachedValues(v.Values))
+	case cachedMap:
+		return value.NewMap(v.Keys, decodeCachedValues(v.Values))
+	}
+	return value.NewNull()
+}
@@ -0,0 +1,131 @@
+package query
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	txjson "github.com/mithrandie/go-text/json"
+)
+
+func TestConversionCachePath(t *testing.T) {
+	p1 := ConversionCachePath(TestDir, "", []byte("[{\"a\":1}]"))
+	p2 := ConversionCachePath(TestDir, "", []byte("[{\"a\":1}]"))
+	if p1 != p2 {
+		t.Errorf("cache paths = %q and %q, want the same paths for the same data", p1, p2)
+	}
+	if filepath.Dir(p1) != TestDir {
+		t.Errorf("cache path = %q, want a path in %q", p1, TestDir)
+	}
+
+	if p := ConversionCachePath(TestDir, "", []byte("[{\"a\":2}]")); p == p1 {
+		t.Errorf("cache path = %q, want a different path for different data", p)
+	}
+	if p := ConversionCachePath(TestDir, "a", []byte("[{\"a\":1}]")); p == p1 {
+		t.Errorf("cache path = %q, want a different path for a different query", p)
+	}
+}
+
+var conversionCacheHeader = []string{"c1", "c2", "c3"}
+
+var conversionCacheRows = [][]value.Primary{
+	{
+		value.NewString("abc"),
+		value.NewInteger(1),
+		value.NewMap(
+			[]string{"k1", "k2"},
+			[]value.Primary{
+				value.NewArray([]value.Primary{value.NewFloat(1.5), value.NewBoolean(true)}),
+				value.NewNull(),
+			},
+		),
+	},
+	{
+		value.NewNull(),
+		value.NewFloat(-2.5),
+		value.NewArray([]value.Primary{}),
+	},
+}
+
+func TestConversionCache(t *testing.T) {
+	dir := filepath.Join(TestDir, "conversion_cache")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer os.RemoveAll(dir)
+
+	fpath := ConversionCachePath(dir, "", []byte("[]"))
+
+	if _, _, _, err := LoadConversionCache(fpath); err == nil {
+		t.Errorf("no error, want error for a cache that does not exist")
+	}
+
+	if err := SaveConversionCache(fpath, conversionCacheHeader, conversionCacheRows, txjson.HexDigits); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	header, rows, escapeType, err := LoadConversionCache(fpath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(header, conversionCacheHeader) {
+		t.Errorf("header = %v, want %v", header, conversionCacheHeader)
+	}
+	if !reflect.DeepEqual(rows, conversionCacheRows) {
+		t.Errorf("rows = %v, want %v", rows, conversionCacheRows)
+	}
+	if escapeType != txjson.HexDigits {
+		t.Errorf("escape type = %d, want %d", escapeType, txjson.HexDigits)
+	}
+
+	if err := SaveConversionCache(fpath, []string{"c1"}, [][]value.Primary{{value.NewTernary(0)}}, txjson.Backslash); err == nil {
+		t.Errorf("no error, want error for a value that cannot be cached")
+	}
+}
+
+func TestLoadJsonTable(t *testing.T) {
+	dir := filepath.Join(TestDir, "conversion_cache")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer os.RemoveAll(dir)
+
+	jsonText := []byte("[{\"c1\":\"abc\",\"c2\":{\"k\":[1]}}]")
+	fpath := ConversionCachePath(dir, "", jsonText)
+
+	expectHeader := []string{"c1", "c2"}
+	expectRows := [][]value.Primary{
+		{
+			value.NewString("abc"),
+			value.NewMap([]string{"k"}, []value.Primary{value.NewArray([]value.Primary{value.NewInteger(1)})}),
+		},
+	}
+
+	header, rows, _, err := loadJsonTable("", jsonText, fpath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(header, expectHeader) || !reflect.DeepEqual(rows, expectRows) {
+		t.Errorf("result = %v %v, want %v %v", header, rows, expectHeader, expectRows)
+	}
+	if _, err := os.Stat(fpath); err != nil {
+		t.Errorf("cache file is not created: %s", err)
+	}
+
+	cachedHeader := []string{"cached"}
+	cachedRows := [][]value.Primary{{value.NewString("from cache")}}
+	if err := SaveConversionCache(fpath, cachedHeader, cachedRows, txjson.Backslash); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	header, rows, _, err = loadJsonTable("", jsonText, fpath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(header, cachedHeader) || !reflect.DeepEqual(rows, cachedRows) {
+		t.Errorf("result = %v %v, want %v %v loaded from the cache", header, rows, cachedHeader, cachedRows)
+	}
+}
@@ -78,6 +78,19 @@ func (list CursorScopes) Fetch(name parser.Identifier, position int, number int)
 	return nil, NewUndeclaredCursorError(name)
 }
 
+func (list CursorScopes) ResultSet(name parser.Identifier, filter *Filter) (*View, error) {
+	for _, m := range list {
+		view, err := m.ResultSet(name, filter)
+		if err == nil {
+			return view, nil
+		}
+		if _, ok := err.(*UndeclaredCursorError); !ok {
+			return nil, err
+		}
+	}
+	return nil, NewUndeclaredCursorError(name)
+}
+
 func (list CursorScopes) IsOpen(name parser.Identifier) (ternary.Value, error) {
 	for _, m := range list {
 		if ok, err := m.IsOpen(name); err == nil {
@@ -188,6 +201,13 @@ func (m CursorMap) Fetch(name parser.Identifier, position int, number int) ([]va
 	return nil, NewUndeclaredCursorError(name)
 }
 
+func (m CursorMap) ResultSet(name parser.Identifier, filter *Filter) (*View, error) {
+	if cur, ok := m[strings.ToUpper(name.Literal)]; ok {
+		return cur.ResultSet(filter)
+	}
+	return nil, NewUndeclaredCursorError(name)
+}
+
 func (m CursorMap) IsOpen(name parser.Identifier) (ternary.Value, error) {
 	if cur, ok := m[strings.ToUpper(name.Literal)]; ok {
 		return cur.IsOpen(), nil
@@ -341,6 +361,15 @@ func (c *Cursor) Fetch(name parser.Identifier, position int, number int) ([]valu
 	return list, nil
 }
 
+// ResultSet returns a copy of the result set of the cursor.
+// If the cursor is not open, the query of the cursor is executed.
+func (c *Cursor) ResultSet(filter *Filter) (*View, error) {
+	if c.view != nil {
+		return c.view.Copy(), nil
+	}
+	return Select(c.query, filter)
+}
+
 func (c *Cursor) IsOpen() ternary.Value {
 	return ternary.ConvertFromBool(c.view != nil)
 }
@@ -702,6 +702,77 @@ func TestCursorScopes_Count(t *testing.T) {
 	}
 }
 
+var cursorScopesResultSetTests = []struct {
+	Name      string
+	CurName   parser.Identifier
+	RecordLen int
+	Error     string
+}{
+	{
+		Name:      "CursorScopes ResultSet",
+		CurName:   parser.Identifier{Literal: "cur"},
+		RecordLen: 3,
+	},
+	{
+		Name:      "CursorScopes ResultSet Not Open",
+		CurName:   parser.Identifier{Literal: "cur2"},
+		RecordLen: 3,
+	},
+	{
+		Name:    "CursorScopes ResultSet Query Error",
+		CurName: parser.Identifier{Literal: "cur3"},
+		Error:   "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name:    "CursorScopes ResultSet Undeclared Error",
+		CurName: parser.Identifier{Literal: "notexist"},
+		Error:   "[L:- C:-] cursor notexist is undeclared",
+	},
+}
+
+func TestCursorScopes_ResultSet(t *testing.T) {
+	initCmdFlag()
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	list := CursorScopes{
+		{},
+		{
+			"CUR": &Cursor{
+				query: selectQueryForCursorTest,
+			},
+			"CUR2": &Cursor{
+				query: selectQueryForCursorTest,
+			},
+			"CUR3": &Cursor{
+				query: selectQueryForCursorQueryErrorTest,
+			},
+		},
+	}
+
+	ViewCache.Clean()
+	list[1]["CUR"].Open(parser.Identifier{Literal: "cur"}, NewEmptyFilter())
+
+	for _, v := range cursorScopesResultSetTests {
+		result, err := list.ResultSet(v.CurName, NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result.RecordLen() != v.RecordLen {
+			t.Errorf("%s: record length = %d, want %d", v.Name, result.RecordLen(), v.RecordLen)
+		}
+	}
+}
+
 func TestCursorScopes_All(t *testing.T) {
 	list := CursorScopes{
 		{
@@ -1220,6 +1291,15 @@ var cursorMapFetchTests = []struct {
 		Number:   100,
 		Result:   nil,
 	},
+	{
+		Name:     "CursorMap Fetch Prior from Later than Last",
+		CurName:  parser.Identifier{Literal: "cur"},
+		Position: parser.PRIOR,
+		Result: []value.Primary{
+			value.NewString("3"),
+			value.NewString("str3"),
+		},
+	},
 	{
 		Name:     "CursorMap Fetch Undeclared Error",
 		CurName:  parser.Identifier{Literal: "notexist"},
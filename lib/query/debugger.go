@@ -0,0 +1,351 @@
+package query
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+const DebuggerPrompt = "(debug) "
+
+// Debug is the debugger that suspends procedures before executing statements.
+// Procedures are executed without suspension if it is nil.
+var Debug *Debugger
+
+type DebugMode int
+
+const (
+	DebugContinue DebugMode = iota
+	DebugStep
+	DebugNext
+)
+
+type Breakpoint struct {
+	SourceFile string
+	Line       int
+}
+
+// ParseBreakpoint parses a string in the form of "[FILE:]LINE".
+// The breakpoint without a file matches the statements in any file.
+func ParseBreakpoint(s string) (Breakpoint, error) {
+	var bp Breakpoint
+
+	lineStr := s
+	if i := strings.LastIndexByte(s, ':'); -1 < i {
+		bp.SourceFile = s[:i]
+		lineStr = s[i+1:]
+		if len(bp.SourceFile) < 1 {
+			return bp, errors.New(fmt.Sprintf("invalid breakpoint %q", s))
+		}
+		if abs, err := filepath.Abs(bp.SourceFile); err == nil {
+			bp.SourceFile = abs
+		}
+	}
+
+	line, err := strconv.Atoi(lineStr)
+	if err != nil || line < 1 {
+		return bp, errors.New(fmt.Sprintf("invalid breakpoint %q", s))
+	}
+	bp.Line = line
+	return bp, nil
+}
+
+func (bp Breakpoint) Match(expr parser.Expression) bool {
+	return bp.Line == expr.Line() && (len(bp.SourceFile) < 1 || bp.SourceFile == expr.SourceFile())
+}
+
+func (bp Breakpoint) String() string {
+	if len(bp.SourceFile) < 1 {
+		return strconv.Itoa(bp.Line)
+	}
+	return bp.SourceFile + ":" + strconv.Itoa(bp.Line)
+}
+
+// Debugger reads commands from the reader and controls the execution of statements.
+type Debugger struct {
+	Breakpoints []Breakpoint
+
+	mode DebugMode
+	// depth is the number of statement blocks being executed.
+	depth int
+	// nextDepth is the depth of the statement where the NEXT command is issued.
+	nextDepth int
+	// inspecting is true while statements entered at the prompt are executed.
+	inspecting bool
+	detached   bool
+
+	reader  *bufio.Reader
+	sources map[string][]string
+}
+
+// NewDebugger returns a debugger that stops at the first statement if stopOnEntry is true,
+// otherwise stops only at breakpoints.
+func NewDebugger(r io.Reader, stopOnEntry bool) *Debugger {
+	mode := DebugContinue
+	if stopOnEntry {
+		mode = DebugStep
+	}
+
+	return &Debugger{
+		mode:    mode,
+		reader:  bufio.NewReader(r),
+		sources: make(map[string][]string),
+	}
+}
+
+func (d *Debugger) AddBreakpoint(s string) error {
+	bp, err := ParseBreakpoint(s)
+	if err != nil {
+		return err
+	}
+	for _, b := range d.Breakpoints {
+		if b == bp {
+			return nil
+		}
+	}
+	d.Breakpoints = append(d.Breakpoints, bp)
+	return nil
+}
+
+// SetSource registers the source text of statements to show the lines where the debugger stops.
+func (d *Debugger) SetSource(sourceFile string, src string) {
+	d.sources[sourceFile] = strings.Split(src, "\n")
+}
+
+func (d *Debugger) enter() {
+	d.depth++
+}
+
+func (d *Debugger) leave() {
+	d.depth--
+}
+
+// Trace is called before a statement is executed, and waits for commands if the statement is a point to stop.
+func (d *Debugger) Trace(proc *Procedure, stmt parser.Statement) error {
+	if d.inspecting || d.detached {
+		return nil
+	}
+
+	expr := StatementPosition(stmt)
+	if expr == nil || !d.stops(expr) {
+		return nil
+	}
+	return d.prompt(proc, expr)
+}
+
+var statementsType = reflect.TypeOf([]parser.Statement(nil))
+
+// StatementPosition returns the expression that represents the position of the statement.
+// Not all statements hold their own positions, so the first expression with parse information
+// in the statement is used, excluding the nested statements.
+// Nil is returned if the statement has no position.
+func StatementPosition(stmt parser.Statement) parser.Expression {
+	return findPositionedExpression(reflect.ValueOf(stmt))
+}
+
+func findPositionedExpression(v reflect.Value) parser.Expression {
+	if !v.IsValid() || !v.CanInterface() || v.Type() == statementsType {
+		return nil
+	}
+	if expr, ok := v.Interface().(parser.Expression); ok && expr.HasParseInfo() {
+		return expr
+	}
+
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if !v.IsNil() {
+			return findPositionedExpression(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if expr := findPositionedExpression(v.Field(i)); expr != nil {
+				return expr
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if expr := findPositionedExpression(v.Index(i)); expr != nil {
+				return expr
+			}
+		}
+	}
+	return nil
+}
+
+func (d *Debugger) stops(expr parser.Expression) bool {
+	switch d.mode {
+	case DebugStep:
+		return true
+	case DebugNext:
+		if d.depth <= d.nextDepth {
+			return true
+		}
+	}
+
+	for _, bp := range d.Breakpoints {
+		if bp.Match(expr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Debugger) prompt(proc *Procedure, expr parser.Expression) error {
+	d.writeLocation(expr)
+
+	for {
+		_ = WriteToStdout(DebuggerPrompt)
+		line, err := d.reader.ReadString('\n')
+		if err != nil && len(line) < 1 {
+			if err == io.EOF {
+				_ = WriteToStdout("\n")
+				d.detached = true
+				return nil
+			}
+			return err
+		}
+
+		words := strings.Fields(line)
+		if len(words) < 1 {
+			continue
+		}
+
+		switch strings.ToLower(words[0]) {
+		case "s", "step":
+			d.mode = DebugStep
+			return nil
+		case "n", "next":
+			d.mode = DebugNext
+			d.nextDepth = d.depth
+			return nil
+		case "c", "continue":
+			d.mode = DebugContinue
+			return nil
+		case "q", "quit":
+			return NewForcedExit(1)
+		case "b", "break":
+			if len(words) < 2 {
+				d.writeBreakpoints()
+			} else if err := d.AddBreakpoint(words[1]); err != nil {
+				LogError(err.Error())
+			}
+		case "d", "delete":
+			if len(words) < 2 {
+				LogError("breakpoint number is not specified")
+			} else if i, err := strconv.Atoi(words[1]); err != nil || i < 1 || len(d.Breakpoints) < i {
+				LogError(fmt.Sprintf("breakpoint %s does not exist", words[1]))
+			} else {
+				d.Breakpoints = append(d.Breakpoints[:i-1], d.Breakpoints[i:]...)
+			}
+		case "w", "where":
+			d.writeLocation(expr)
+		case "v", "vars":
+			d.writeVariables(proc)
+		case "cursors":
+			d.inspect(proc, "SHOW CURSORS")
+		case "tables":
+			d.inspect(proc, "SHOW VIEWS")
+		case "h", "help":
+			d.writeHelp()
+		default:
+			d.inspect(proc, line)
+		}
+	}
+}
+
+// inspect executes the statements entered at the prompt in the scope of the suspended procedure.
+func (d *Debugger) inspect(proc *Procedure, src string) {
+	statements, err := parser.Parse(src, "")
+	if err != nil {
+		LogError(NewSyntaxError(err.(*parser.SyntaxError)).Error())
+		return
+	}
+
+	d.inspecting = true
+	defer func() {
+		d.inspecting = false
+	}()
+
+	if _, err := proc.Execute(statements); err != nil {
+		if _, ok := err.(*ForcedExit); !ok {
+			LogError(err.Error())
+		}
+	}
+}
+
+func (d *Debugger) writeLocation(expr parser.Expression) {
+	location := "line " + strconv.Itoa(expr.Line())
+	if 0 < len(expr.SourceFile()) {
+		location = expr.SourceFile() + ":" + strconv.Itoa(expr.Line())
+	}
+	s := "Stopped at " + location
+
+	if src := d.sourceLine(expr.SourceFile(), expr.Line()); 0 < len(src) {
+		s = s + "\n" + fmt.Sprintf("%4d  %s", expr.Line(), src)
+	}
+	_ = WriteToStdoutWithLineBreak(s)
+}
+
+func (d *Debugger) sourceLine(sourceFile string, line int) string {
+	lines, ok := d.sources[sourceFile]
+	if !ok {
+		if 0 < len(sourceFile) {
+			if buf, err := ioutil.ReadFile(sourceFile); err == nil {
+				lines = strings.Split(string(buf), "\n")
+			}
+		}
+		d.sources[sourceFile] = lines
+	}
+
+	if line < 1 || len(lines) < line {
+		return ""
+	}
+	return strings.TrimRight(lines[line-1], "\r")
+}
+
+func (d *Debugger) writeBreakpoints() {
+	if len(d.Breakpoints) < 1 {
+		_ = WriteToStdoutWithLineBreak(cmd.Warn("No breakpoint is set"))
+		return
+	}
+	for i, bp := range d.Breakpoints {
+		_ = WriteToStdoutWithLineBreak(fmt.Sprintf("%d: %s", i+1, bp.String()))
+	}
+}
+
+func (d *Debugger) writeVariables(proc *Procedure) {
+	vars := proc.Filter.Variables.All()
+	keys := vars.SortedKeys()
+	if len(keys) < 1 {
+		_ = WriteToStdoutWithLineBreak(cmd.Warn("No variable is declared"))
+		return
+	}
+	for _, k := range keys {
+		v, _ := vars.Get(parser.Variable{Name: k})
+		_ = WriteToStdoutWithLineBreak(cmd.VariableSymbol(k) + " = " + v.String())
+	}
+}
+
+func (d *Debugger) writeHelp() {
+	_ = WriteToStdoutWithLineBreak("" +
+		"step, s          Execute the next statement, stopping in nested blocks and functions\n" +
+		"next, n          Execute the next statement without stopping in nested blocks and functions\n" +
+		"continue, c      Execute statements until a breakpoint is reached\n" +
+		"break, b [LOC]   Set a breakpoint at [FILE:]LINE, or show breakpoints\n" +
+		"delete, d NUM    Delete the breakpoint\n" +
+		"where, w         Show the statement to be executed\n" +
+		"vars, v          Show variables\n" +
+		"cursors          Show cursors\n" +
+		"tables           Show temporary tables\n" +
+		"quit, q          Abort the execution\n" +
+		"Other input is executed as statements in the current scope.")
+}
@@ -0,0 +1,239 @@
+package query
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var parseBreakpointTests = []struct {
+	Input  string
+	Result Breakpoint
+	Error  string
+}{
+	{
+		Input:  "3",
+		Result: Breakpoint{Line: 3},
+	},
+	{
+		Input:  GetTestFilePath("source.sql") + ":12",
+		Result: Breakpoint{SourceFile: GetTestFilePath("source.sql"), Line: 12},
+	},
+	{
+		Input: "0",
+		Error: "invalid breakpoint \"0\"",
+	},
+	{
+		Input: ":3",
+		Error: "invalid breakpoint \":3\"",
+	},
+	{
+		Input: "source.sql",
+		Error: "invalid breakpoint \"source.sql\"",
+	},
+}
+
+func TestParseBreakpoint(t *testing.T) {
+	for _, v := range parseBreakpointTests {
+		result, err := ParseBreakpoint(v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.Input)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.Input)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Input)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %v, want %v for %q", result, v.Result, v.Input)
+		}
+	}
+}
+
+var statementPositionTests = []struct {
+	Name   string
+	Stmt   parser.Statement
+	Result parser.Expression
+}{
+	{
+		Name: "StatementPosition",
+		Stmt: parser.Print{
+			BaseExpr: parser.NewBaseExpr(parser.Token{Line: 2, Char: 1}),
+			Value:    parser.NewStringValue("str"),
+		},
+		Result: parser.Print{
+			BaseExpr: parser.NewBaseExpr(parser.Token{Line: 2, Char: 1}),
+			Value:    parser.NewStringValue("str"),
+		},
+	},
+	{
+		Name: "StatementPosition from Expression",
+		Stmt: parser.VariableDeclaration{
+			Assignments: []parser.VariableAssignment{
+				{
+					Variable: parser.Variable{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 3, Char: 5}), Name: "var"},
+				},
+			},
+		},
+		Result: parser.Variable{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 3, Char: 5}), Name: "var"},
+	},
+	{
+		Name: "StatementPosition Excluding Nested Statements",
+		Stmt: parser.Try{
+			Statements: []parser.Statement{
+				parser.Print{
+					BaseExpr: parser.NewBaseExpr(parser.Token{Line: 2, Char: 3}),
+					Value:    parser.NewStringValue("str"),
+				},
+			},
+		},
+		Result: nil,
+	},
+}
+
+func TestStatementPosition(t *testing.T) {
+	for _, v := range statementPositionTests {
+		result := StatementPosition(v.Stmt)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %#v, want %#v", v.Name, result, v.Result)
+		}
+	}
+}
+
+var debuggerTests = []struct {
+	Name        string
+	Script      string
+	StopOnEntry bool
+	Breakpoints []string
+	Commands    string
+	Output      string
+}{
+	{
+		Name: "Debugger Step and Next",
+		Script: "VAR @a := 1;\n" +
+			"IF @a = 1 THEN\n" +
+			"  PRINT 'in if';\n" +
+			"END IF;\n" +
+			"PRINT @a;",
+		StopOnEntry: true,
+		Commands:    "next\nvars\nstep\nstep\ncontinue\n",
+		Output: "Stopped at line 1\n" +
+			"   1  VAR @a := 1;\n" +
+			"(debug) Stopped at line 2\n" +
+			"   2  IF @a = 1 THEN\n" +
+			"(debug) @a = 1\n" +
+			"(debug) Stopped at line 3\n" +
+			"   3    PRINT 'in if';\n" +
+			"(debug) \"in if\"\n" +
+			"Stopped at line 5\n" +
+			"   5  PRINT @a;\n" +
+			"(debug) 1\n",
+	},
+	{
+		Name: "Debugger Next over Nested Blocks",
+		Script: "VAR @a := 1;\n" +
+			"IF @a = 1 THEN\n" +
+			"  PRINT 'in if';\n" +
+			"END IF;\n" +
+			"PRINT @a;",
+		StopOnEntry: true,
+		Commands:    "n\nn\nn\n",
+		Output: "Stopped at line 1\n" +
+			"   1  VAR @a := 1;\n" +
+			"(debug) Stopped at line 2\n" +
+			"   2  IF @a = 1 THEN\n" +
+			"(debug) \"in if\"\n" +
+			"Stopped at line 5\n" +
+			"   5  PRINT @a;\n" +
+			"(debug) 1\n",
+	},
+	{
+		Name: "Debugger Breakpoints and Inspection",
+		Script: "VAR @a := 0;\n" +
+			"WHILE @a < 2\n" +
+			"DO\n" +
+			"  @a := @a + 1;\n" +
+			"END WHILE;\n" +
+			"PRINT 'end';",
+		Breakpoints: []string{"4"},
+		Commands:    "PRINT @a * 10;\nbreak 6\nc\ndelete 1\nbreak\nc\nwhere\n",
+		Output: "Stopped at line 4\n" +
+			"   4    @a := @a + 1;\n" +
+			"(debug) 0\n" +
+			"(debug) (debug) Stopped at line 4\n" +
+			"   4    @a := @a + 1;\n" +
+			"(debug) (debug) 1: 6\n" +
+			"(debug) Stopped at line 6\n" +
+			"   6  PRINT 'end';\n" +
+			"(debug) Stopped at line 6\n" +
+			"   6  PRINT 'end';\n" +
+			"(debug) \n" +
+			"\"end\"\n",
+	},
+	{
+		Name:        "Debugger Quit",
+		Script:      "PRINT 1;\nPRINT 2;",
+		StopOnEntry: true,
+		Commands:    "q\n",
+		Output: "Stopped at line 1\n" +
+			"   1  PRINT 1;\n" +
+			"(debug) ",
+	},
+}
+
+func TestDebugger(t *testing.T) {
+	initCmdFlag()
+	defer func() {
+		Debug = nil
+	}()
+
+	for _, v := range debuggerTests {
+		statements, _ := parser.Parse(v.Script, "")
+
+		Debug = NewDebugger(strings.NewReader(v.Commands), v.StopOnEntry)
+		Debug.SetSource("", v.Script)
+		for _, bp := range v.Breakpoints {
+			_ = Debug.AddBreakpoint(bp)
+		}
+
+		oldStdout := Stdout
+		r, w, _ := os.Pipe()
+		Stdout = w
+
+		proc := NewProcedure()
+		_, err := proc.Execute(statements)
+
+		w.Close()
+		Stdout = oldStdout
+		log, _ := ioutil.ReadAll(r)
+
+		if err != nil {
+			if _, ok := err.(*ForcedExit); !ok {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			}
+		}
+		if string(log) != v.Output {
+			t.Errorf("%s: output = %q, want %q", v.Name, string(log), v.Output)
+		}
+	}
+}
+
+func TestDebugger_SourceLine(t *testing.T) {
+	d := NewDebugger(strings.NewReader(""), false)
+	fpath := GetTestFilePath("source.sql")
+
+	if s := d.sourceLine(fpath, 1); s != "PRINT 'external executable file';" {
+		t.Errorf("source line = %q, want %q", s, "PRINT 'external executable file';")
+	}
+	if s := d.sourceLine(fpath, 100); s != "" {
+		t.Errorf("source line = %q, want empty", s)
+	}
+}
@@ -0,0 +1,402 @@
+package query
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// DecorrelatedSubquery is a correlated scalar subquery rewritten as a grouped query executed once.
+type DecorrelatedSubquery struct {
+	decorrelated bool
+
+	outerKeys []parser.QueryExpression
+	values    map[string][]value.Primary
+
+	once *sync.Once
+}
+
+func NewDecorrelatedSubquery() *DecorrelatedSubquery {
+	return &DecorrelatedSubquery{
+		once: &sync.Once{},
+	}
+}
+
+func (sq *DecorrelatedSubquery) Prepare(expr parser.Subquery, filter *Filter) bool {
+	sq.once.Do(func() {
+		sq.prepare(expr, filter)
+	})
+	return sq.decorrelated
+}
+
+func (sq *DecorrelatedSubquery) prepare(expr parser.Subquery, filter *Filter) {
+	innerFilter := filter.CreateNode()
+	innerFilter.Records = nil
+
+	query, outerKeys, ok := decorrelateSubquery(expr, innerFilter)
+	if !ok {
+		return
+	}
+
+	view, err := Select(query, innerFilter)
+	if err != nil {
+		return
+	}
+
+	keyLen := len(outerKeys)
+	values := make(map[string][]value.Primary, view.RecordLen())
+	keyBuf := new(bytes.Buffer)
+	for _, record := range view.RecordSet {
+		keys := make([]value.Primary, keyLen)
+		for i := 0; i < keyLen; i++ {
+			keys[i] = record[i].Value()
+		}
+		if containsNull(keys) {
+			continue
+		}
+
+		keyBuf.Reset()
+		SerializeComparisonKeys(keyBuf, keys)
+		key := keyBuf.String()
+		values[key] = append(values[key], record[keyLen].Value())
+	}
+
+	sq.outerKeys = outerKeys
+	sq.values = values
+	sq.decorrelated = true
+}
+
+func (sq *DecorrelatedSubquery) Evaluate(expr parser.Subquery, filter *Filter) (value.Primary, error) {
+	keys := make([]value.Primary, len(sq.outerKeys))
+	for i, v := range sq.outerKeys {
+		p, err := filter.Evaluate(v)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = p
+	}
+	if containsNull(keys) {
+		return value.NewNull(), nil
+	}
+
+	keyBuf := new(bytes.Buffer)
+	SerializeComparisonKeys(keyBuf, keys)
+	list, ok := sq.values[keyBuf.String()]
+	if !ok {
+		return value.NewNull(), nil
+	}
+	if 1 < len(list) {
+		return nil, NewSubqueryTooManyRecordsError(expr)
+	}
+	return list[0], nil
+}
+
+func containsNull(values []value.Primary) bool {
+	for _, v := range values {
+		if value.IsNull(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// decorrelateSubquery rewrites the subquery to group by its inner correlation keys.
+func decorrelateSubquery(expr parser.Subquery, filter *Filter) (parser.SelectQuery, []parser.QueryExpression, bool) {
+	query := expr.Query
+	if query.WithClause != nil || query.OrderByClause != nil || query.LimitClause != nil || query.OffsetClause != nil {
+		return query, nil, false
+	}
+
+	entity, ok := query.SelectEntity.(parser.SelectEntity)
+	if !ok || entity.FromClause == nil || entity.WhereClause == nil || entity.GroupByClause != nil || entity.HavingClause != nil {
+		return query, nil, false
+	}
+
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	if selectClause.IsDistinct() || len(selectClause.Fields) != 1 {
+		return query, nil, false
+	}
+	field := selectClause.Fields[0].(parser.Field)
+	if _, ok := field.Object.(parser.AllColumns); ok {
+		return query, nil, false
+	}
+
+	fromClause := entity.FromClause.(parser.FromClause)
+	for _, table := range fromClause.Tables {
+		if !isIndependentTable(table) {
+			return query, nil, false
+		}
+	}
+
+	view := NewView()
+	if err := view.Load(fromClause, filter.CreateNode()); err != nil {
+		return query, nil, false
+	}
+
+	isAggregated := false
+	fieldIsInner := walkExpression(field.Object, func(e parser.QueryExpression) bool {
+		switch e.(type) {
+		case parser.AggregateFunction, parser.ListFunction:
+			isAggregated = true
+		}
+		return isDecorrelatableNode(e, view)
+	})
+	if !fieldIsInner {
+		return query, nil, false
+	}
+
+	innerKeys := make([]parser.QueryExpression, 0, 2)
+	outerKeys := make([]parser.QueryExpression, 0, 2)
+	conditions := make([]parser.QueryExpression, 0, 2)
+
+	for _, condition := range splitConjunction(entity.WhereClause.(parser.WhereClause).Filter) {
+		if isInnerExpression(condition, view) {
+			conditions = append(conditions, condition)
+			continue
+		}
+
+		comparison, ok := condition.(parser.Comparison)
+		if !ok || comparison.Operator != "=" {
+			return query, nil, false
+		}
+
+		if isInnerExpression(comparison.LHS, view) && isOuterExpression(comparison.RHS, view) {
+			innerKeys = append(innerKeys, comparison.LHS)
+			outerKeys = append(outerKeys, comparison.RHS)
+		} else if isOuterExpression(comparison.LHS, view) && isInnerExpression(comparison.RHS, view) {
+			innerKeys = append(innerKeys, comparison.RHS)
+			outerKeys = append(outerKeys, comparison.LHS)
+		} else {
+			return query, nil, false
+		}
+	}
+
+	if len(outerKeys) < 1 {
+		return query, nil, false
+	}
+
+	fields := make([]parser.QueryExpression, 0, len(innerKeys)+1)
+	for _, key := range innerKeys {
+		fields = append(fields, parser.Field{Object: key})
+	}
+	fields = append(fields, parser.Field{Object: field.Object})
+
+	rewritten := parser.SelectEntity{
+		BaseExpr:     entity.BaseExpr,
+		SelectClause: parser.SelectClause{BaseExpr: selectClause.BaseExpr, Select: selectClause.Select, Fields: fields},
+		FromClause:   fromClause,
+	}
+	if 0 < len(conditions) {
+		rewritten.WhereClause = parser.WhereClause{Where: "WHERE", Filter: joinConjunction(conditions)}
+	}
+	if isAggregated {
+		rewritten.GroupByClause = parser.GroupByClause{GroupBy: "GROUP BY", Items: innerKeys}
+	}
+
+	return parser.SelectQuery{BaseExpr: query.BaseExpr, SelectEntity: rewritten}, outerKeys, true
+}
+
+func isIndependentTable(expr parser.QueryExpression) bool {
+	switch expr.(type) {
+	case parser.Table:
+		switch expr.(parser.Table).Object.(type) {
+		case parser.Identifier, parser.TableObject:
+			return true
+		case parser.Join:
+			return isIndependentTable(expr.(parser.Table).Object)
+		}
+	case parser.Join:
+		join := expr.(parser.Join)
+		if !isIndependentTable(join.Table) || !isIndependentTable(join.JoinTable) {
+			return false
+		}
+		if join.Condition != nil {
+			if condition := join.Condition.(parser.JoinCondition); condition.On != nil {
+				return walkExpression(condition.On, func(e parser.QueryExpression) bool {
+					return isDecorrelatableNode(e, nil)
+				})
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func isDecorrelatableNode(expr parser.QueryExpression, view *View) bool {
+	switch expr.(type) {
+	case parser.FieldReference, parser.ColumnNumber:
+		if view == nil {
+			return true
+		}
+		_, err := view.FieldIndex(expr)
+		return err == nil
+	case parser.Function:
+		name := expr.(parser.Function).Name
+		return !isVolatileFunction(name) && !strings.EqualFold(name, "JSON_OBJECT")
+	case parser.AggregateFunction:
+		return isBuiltInAggregateFunction(expr.(parser.AggregateFunction).Name)
+	case parser.Collate:
+		return false
+	}
+	return true
+}
+
+func isInnerExpression(expr parser.QueryExpression, view *View) bool {
+	return walkExpression(expr, func(e parser.QueryExpression) bool {
+		switch e.(type) {
+		case parser.AggregateFunction, parser.ListFunction:
+			return false
+		}
+		return isDecorrelatableNode(e, view)
+	})
+}
+
+func isOuterExpression(expr parser.QueryExpression, view *View) bool {
+	hasReference := false
+	isOuter := walkExpression(expr, func(e parser.QueryExpression) bool {
+		switch e.(type) {
+		case parser.FieldReference, parser.ColumnNumber:
+			hasReference = true
+			_, err := view.FieldIndex(e)
+			if err == nil {
+				return false
+			}
+			if _, ok := err.(*FieldNotExistError); !ok {
+				return false
+			}
+			return true
+		case parser.AggregateFunction, parser.ListFunction:
+			return false
+		}
+		return isDecorrelatableNode(e, nil)
+	})
+	return isOuter && hasReference
+}
+
+func splitConjunction(expr parser.QueryExpression) []parser.QueryExpression {
+	switch expr.(type) {
+	case parser.Parentheses:
+		return splitConjunction(expr.(parser.Parentheses).Expr)
+	case parser.Logic:
+		logic := expr.(parser.Logic)
+		if logic.Operator.Token == parser.AND {
+			return append(splitConjunction(logic.LHS), splitConjunction(logic.RHS)...)
+		}
+	}
+	return []parser.QueryExpression{expr}
+}
+
+func joinConjunction(list []parser.QueryExpression) parser.QueryExpression {
+	expr := list[0]
+	for i := 1; i < len(list); i++ {
+		expr = parser.Logic{
+			LHS:      expr,
+			Operator: parser.Token{Token: parser.AND, Literal: "AND"},
+			RHS:      list[i],
+		}
+	}
+	return expr
+}
+
+// walkExpression calls fn for the expression and its descendants in depth-first order.
+func walkExpression(expr parser.QueryExpression, fn func(parser.QueryExpression) bool) bool {
+	if expr == nil {
+		return true
+	}
+
+	var walkList = func(list []parser.QueryExpression) bool {
+		for _, v := range list {
+			if !walkExpression(v, fn) {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch expr.(type) {
+	case parser.PrimitiveType, parser.FieldReference, parser.ColumnNumber, parser.AllColumns:
+	case parser.Parentheses:
+		return fn(expr) && walkExpression(expr.(parser.Parentheses).Expr, fn)
+	case parser.RowValue:
+		return fn(expr) && walkExpression(expr.(parser.RowValue).Value, fn)
+	case parser.ValueList:
+		return fn(expr) && walkList(expr.(parser.ValueList).Values)
+	case parser.RowValueList:
+		return fn(expr) && walkList(expr.(parser.RowValueList).RowValues)
+	case parser.ArrayValue:
+		return fn(expr) && walkList(expr.(parser.ArrayValue).Values)
+	case parser.ArrayElement:
+		e := expr.(parser.ArrayElement)
+		return fn(expr) && walkExpression(e.Array, fn) && walkExpression(e.Index, fn)
+	case parser.MemberReference:
+		return fn(expr) && walkExpression(expr.(parser.MemberReference).Value, fn)
+	case parser.Collate:
+		return fn(expr) && walkExpression(expr.(parser.Collate).Value, fn)
+	case parser.AtTimeZone:
+		e := expr.(parser.AtTimeZone)
+		return fn(expr) && walkExpression(e.Value, fn) && walkExpression(e.TimeZone, fn)
+	case parser.Arithmetic:
+		e := expr.(parser.Arithmetic)
+		return fn(expr) && walkExpression(e.LHS, fn) && walkExpression(e.RHS, fn)
+	case parser.UnaryArithmetic:
+		return fn(expr) && walkExpression(expr.(parser.UnaryArithmetic).Operand, fn)
+	case parser.Concat:
+		return fn(expr) && walkList(expr.(parser.Concat).Items)
+	case parser.Comparison:
+		e := expr.(parser.Comparison)
+		return fn(expr) && walkExpression(e.LHS, fn) && walkExpression(e.RHS, fn)
+	case parser.Is:
+		e := expr.(parser.Is)
+		return fn(expr) && walkExpression(e.LHS, fn) && walkExpression(e.RHS, fn)
+	case parser.Between:
+		e := expr.(parser.Between)
+		return fn(expr) && walkExpression(e.LHS, fn) && walkExpression(e.Low, fn) && walkExpression(e.High, fn)
+	case parser.Like:
+		e := expr.(parser.Like)
+		return fn(expr) && walkExpression(e.LHS, fn) && walkExpression(e.Pattern, fn)
+	case parser.In:
+		e := expr.(parser.In)
+		return fn(expr) && walkExpression(e.LHS, fn) && walkExpression(e.Values, fn)
+	case parser.Any:
+		e := expr.(parser.Any)
+		return fn(expr) && walkExpression(e.LHS, fn) && walkExpression(e.Values, fn)
+	case parser.All:
+		e := expr.(parser.All)
+		return fn(expr) && walkExpression(e.LHS, fn) && walkExpression(e.Values, fn)
+	case parser.Function:
+		return fn(expr) && walkList(expr.(parser.Function).Args)
+	case parser.AggregateFunction:
+		e := expr.(parser.AggregateFunction)
+		return fn(expr) && walkList(e.Args) && walkExpression(e.OrderBy, fn) && walkExpression(e.Filter, fn)
+	case parser.ListFunction:
+		e := expr.(parser.ListFunction)
+		return fn(expr) && walkList(e.Args) && walkExpression(e.OrderBy, fn) && walkExpression(e.Filter, fn)
+	case parser.OrderByClause:
+		return fn(expr) && walkList(expr.(parser.OrderByClause).Items)
+	case parser.OrderItem:
+		return fn(expr) && walkExpression(expr.(parser.OrderItem).Value, fn)
+	case parser.FilterClause:
+		return fn(expr) && walkExpression(expr.(parser.FilterClause).Where, fn)
+	case parser.WhereClause:
+		return fn(expr) && walkExpression(expr.(parser.WhereClause).Filter, fn)
+	case parser.CaseExpr:
+		e := expr.(parser.CaseExpr)
+		return fn(expr) && walkExpression(e.Value, fn) && walkList(e.When) && walkExpression(e.Else, fn)
+	case parser.CaseExprWhen:
+		e := expr.(parser.CaseExprWhen)
+		return fn(expr) && walkExpression(e.Condition, fn) && walkExpression(e.Result, fn)
+	case parser.CaseExprElse:
+		return fn(expr) && walkExpression(expr.(parser.CaseExprElse).Result, fn)
+	case parser.Logic:
+		e := expr.(parser.Logic)
+		return fn(expr) && walkExpression(e.LHS, fn) && walkExpression(e.RHS, fn)
+	case parser.UnaryLogic:
+		return fn(expr) && walkExpression(expr.(parser.UnaryLogic).Operand, fn)
+	default:
+		return false
+	}
+	return fn(expr)
+}
@@ -0,0 +1,170 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var decorrelateSubqueryTests = []struct {
+	Name   string
+	Query  string
+	Result string
+	Ok     bool
+}{
+	{
+		Name:   "Decorrelate Aggregate Subquery",
+		Query:  "SELECT (SELECT COUNT(*) FROM group_table g WHERE g.column1 = t.column1) FROM table1 t",
+		Result: "SELECT g.column1, COUNT(*) FROM group_table g GROUP BY g.column1",
+		Ok:     true,
+	},
+	{
+		Name:   "Decorrelate Subquery with Reversed Equality",
+		Query:  "SELECT (SELECT column4 FROM table2 WHERE t.column1 = column3) FROM table1 t",
+		Result: "SELECT column3, column4 FROM table2",
+		Ok:     true,
+	},
+	{
+		Name:   "Decorrelate Subquery with Inner Conditions",
+		Query:  "SELECT (SELECT SUM(g.column1) FROM group_table g WHERE g.column2 <> 'str1' AND g.column1 = t.column1 AND g.column1 < 3) FROM table1 t",
+		Result: "SELECT g.column1, SUM(g.column1) FROM group_table g WHERE g.column2 <> 'str1' AND g.column1 < 3 GROUP BY g.column1",
+		Ok:     true,
+	},
+	{
+		Name:  "Uncorrelated Subquery",
+		Query: "SELECT (SELECT COUNT(*) FROM group_table g WHERE g.column1 = 1) FROM table1 t",
+		Ok:    false,
+	},
+	{
+		Name:  "Not Equality Correlation",
+		Query: "SELECT (SELECT COUNT(*) FROM group_table g WHERE g.column1 < t.column1) FROM table1 t",
+		Ok:    false,
+	},
+	{
+		Name:  "Correlation in Disjunction",
+		Query: "SELECT (SELECT COUNT(*) FROM group_table g WHERE g.column1 = t.column1 OR g.column1 = 1) FROM table1 t",
+		Ok:    false,
+	},
+	{
+		Name:  "Outer Reference in Select Field",
+		Query: "SELECT (SELECT COUNT(*) + t.column1 FROM group_table g WHERE g.column1 = t.column1) FROM table1 t",
+		Ok:    false,
+	},
+	{
+		Name:  "Subquery with Limit Clause",
+		Query: "SELECT (SELECT column2 FROM group_table g WHERE g.column1 = t.column1 LIMIT 1) FROM table1 t",
+		Ok:    false,
+	},
+	{
+		Name:  "Subquery with Variable",
+		Query: "SELECT (SELECT COUNT(*) FROM group_table g WHERE g.column1 = t.column1 AND g.column1 = @var) FROM table1 t",
+		Ok:    false,
+	},
+}
+
+func TestDecorrelateSubquery(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	for _, v := range decorrelateSubqueryTests {
+		ViewCache.Clean()
+
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+		selectClause := program[0].(parser.SelectQuery).SelectEntity.(parser.SelectEntity).SelectClause.(parser.SelectClause)
+		subquery := selectClause.Fields[0].(parser.Field).Object.(parser.Subquery)
+
+		query, _, ok := decorrelateSubquery(subquery, NewEmptyFilter().CreateNode())
+		if ok != v.Ok {
+			t.Errorf("%s: decorrelated = %t, want %t", v.Name, ok, v.Ok)
+			continue
+		}
+		if ok && query.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, query.String(), v.Result)
+		}
+	}
+}
+
+var decorrelatedSubqueryEvaluateTests = []struct {
+	Name   string
+	Query  string
+	Result [][]value.Primary
+	Error  string
+}{
+	{
+		Name:  "Evaluate Decorrelated Aggregate Subquery",
+		Query: "SELECT column1, (SELECT COUNT(*) FROM group_table g WHERE g.column1 = t.column1), (SELECT SUM(g.column1) FROM group_table g WHERE g.column1 = t.column1 AND g.column2 <> 'str1') FROM table2 t2 RIGHT JOIN table1 t ON t2.column3 = t.column1 + 3",
+		Result: [][]value.Primary{
+			{value.NewString("1"), value.NewInteger(2), value.NewInteger(1)},
+			{value.NewString("2"), value.NewInteger(2), value.NewInteger(4)},
+			{value.NewString("3"), value.NewInteger(1), value.NewInteger(3)},
+		},
+	},
+	{
+		Name:  "Evaluate Decorrelated Subquery Returns No Record",
+		Query: "SELECT column1, (SELECT column4 FROM table2 WHERE column3 = t.column1) FROM table1 t",
+		Result: [][]value.Primary{
+			{value.NewString("1"), value.NewNull()},
+			{value.NewString("2"), value.NewString("str22")},
+			{value.NewString("3"), value.NewString("str33")},
+		},
+	},
+	{
+		Name:  "Evaluate Decorrelated Aggregate Subquery Returns No Record",
+		Query: "SELECT column1, (SELECT COUNT(*) FROM table2 WHERE column3 = t.column1) FROM table1 t",
+		Result: [][]value.Primary{
+			{value.NewString("1"), value.NewNull()},
+			{value.NewString("2"), value.NewInteger(1)},
+			{value.NewString("3"), value.NewInteger(1)},
+		},
+	},
+	{
+		Name:  "Evaluate Decorrelated Subquery Too Many Records Error",
+		Query: "SELECT column1, (SELECT column2 FROM group_table g WHERE g.column1 = t.column1) FROM table1 t",
+		Error: "[L:1 C:17] subquery returns too many records, should return only one record",
+	},
+}
+
+func TestDecorrelatedSubquery_Evaluate(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	for _, v := range decorrelatedSubqueryEvaluateTests {
+		ViewCache.Clean()
+
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		view, err := Select(program[0].(parser.SelectQuery), NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		result := make([][]value.Primary, view.RecordLen())
+		for i, record := range view.RecordSet {
+			result[i] = make([]value.Primary, len(record))
+			for j, cell := range record {
+				result[i][j] = cell.Value()
+			}
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
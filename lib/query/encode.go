@@ -6,7 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -14,6 +16,7 @@ import (
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/color"
 	"github.com/mithrandie/go-text/csv"
 	"github.com/mithrandie/go-text/fixedlen"
 	txjson "github.com/mithrandie/go-text/json"
@@ -33,6 +36,17 @@ func NewEmptyResultSetError() *EmptyResultSetError {
 }
 
 func EncodeView(fp io.Writer, view *View, fileInfo *FileInfo) error {
+	if fileInfo.LineBreaks != nil && !cmd.GetFlags().NormalizeLineBreak {
+		w := newLineBreakWriter(fp, fileInfo.LineBreaks, fileInfo.LineBreak)
+		if err := encodeView(w, view, fileInfo); err != nil {
+			return err
+		}
+		return w.Flush()
+	}
+	return encodeView(fp, view, fileInfo)
+}
+
+func encodeView(fp io.Writer, view *View, fileInfo *FileInfo) error {
 	switch fileInfo.Format {
 	case cmd.FIXED:
 		return encodeFixedLengthFormat(fp, view, fileInfo.DelimiterPositions, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding)
@@ -46,7 +60,7 @@ func EncodeView(fp io.Writer, view *View, fileInfo *FileInfo) error {
 		fileInfo.Delimiter = '\t'
 		fallthrough
 	default: // cmd.CSV
-		return encodeCSV(fp, view, fileInfo.Delimiter, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding, fileInfo.EncloseAll)
+		return encodeCSV(fp, view, fileInfo.Delimiter, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding, fileInfo.EncloseAll, fileInfo.FieldQuotes)
 	}
 }
 
@@ -63,35 +77,51 @@ func bareValues(view *View) ([]string, [][]value.Primary) {
 	return header, records
 }
 
-func encodeCSV(fp io.Writer, view *View, delimiter rune, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding, encloseAll bool) error {
+func warnEncodingErrors(count int, encoding text.Encoding) {
+	if 0 < count {
+		LogWarn(encodingErrorsWarning(fmt.Sprintf(cmd.Message("%s not encodable in %s"), FormatCount(count, "character"), encoding)), cmd.GetFlags().Quiet)
+	}
+}
+
+func encodeCSV(fp io.Writer, view *View, delimiter rune, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding, encloseAll bool, fieldQuotes [][]FieldQuote) error {
 	header, records := bareValues(view)
+	warnEncodingErrors(encodableValues(header, records, encoding), encoding)
 
 	w := csv.NewWriter(fp, lineBreak, encoding)
 	w.Delimiter = delimiter
 
 	fields := make([]csv.Field, len(header))
 
+	line := 0
+	if !withoutHeader {
+		line = 1
+	}
+	quoter := newFieldQuoter(delimiter, encloseAll, cmd.GetFlags().QuoteStyle, fieldQuotes, line)
+
 	if !withoutHeader {
 		for i, v := range header {
-			fields[i] = csv.NewField(v, encloseAll)
+			fields[i] = csv.NewField(v, quoter.Quote(0, i, v, cmd.StringEffect, true))
 		}
 		if err := w.Write(fields); err != nil {
 			return err
 		}
+		if err := endLine(w, fp); err != nil {
+			return err
+		}
 	}
 
 	for _, record := range records {
 		for i, v := range record {
 			str, e, _ := ConvertFieldContents(v, false)
-			quote := false
-			if encloseAll && (e == cmd.StringEffect || e == cmd.DatetimeEffect) {
-				quote = true
-			}
-			fields[i] = csv.NewField(str, quote)
+			fields[i] = csv.NewField(str, quoter.Quote(line, i, str, e, false))
 		}
 		if err := w.Write(fields); err != nil {
 			return err
 		}
+		if err := endLine(w, fp); err != nil {
+			return err
+		}
+		line++
 	}
 	w.Flush()
 	return nil
@@ -99,6 +129,7 @@ func encodeCSV(fp io.Writer, view *View, delimiter rune, lineBreak text.LineBrea
 
 func encodeFixedLengthFormat(fp io.Writer, view *View, positions []int, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding) error {
 	header, records := bareValues(view)
+	warnEncodingErrors(encodableValues(header, records, encoding), encoding)
 
 	if positions == nil {
 		m := fixedlen.NewMeasure()
@@ -131,6 +162,9 @@ func encodeFixedLengthFormat(fp io.Writer, view *View, positions []int, lineBrea
 			if err := w.Write(fields); err != nil {
 				return err
 			}
+			if err := endLine(w, fp); err != nil {
+				return err
+			}
 		}
 		w.Flush()
 
@@ -146,6 +180,9 @@ func encodeFixedLengthFormat(fp io.Writer, view *View, positions []int, lineBrea
 			if err := w.Write(fields); err != nil {
 				return err
 			}
+			if err := endLine(w, fp); err != nil {
+				return err
+			}
 		}
 
 		for _, record := range records {
@@ -156,6 +193,9 @@ func encodeFixedLengthFormat(fp io.Writer, view *View, positions []int, lineBrea
 			if err := w.Write(fields); err != nil {
 				return err
 			}
+			if err := endLine(w, fp); err != nil {
+				return err
+			}
 		}
 		w.Flush()
 	}
@@ -192,6 +232,7 @@ func encodeJson(fp io.Writer, view *View, lineBreak text.LineBreak, escapeType t
 
 func encodeText(fp io.Writer, view *View, format cmd.Format, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding) error {
 	header, records := bareValues(view)
+	warnEncodingErrors(encodableValues(header, records, encoding), encoding)
 
 	isPlainTable := false
 
@@ -213,72 +254,28 @@ func encodeText(fp io.Writer, view *View, format cmd.Format, lineBreak text.Line
 		isPlainTable = true
 	}
 
-	e := table.NewEncoder(tableFormat, len(records))
-	e.LineBreak = lineBreak
-	e.EastAsianEncoding = cmd.GetFlags().EastAsianEncoding
-	e.CountDiacriticalSign = cmd.GetFlags().CountDiacriticalSign
-	e.CountFormatCode = cmd.GetFlags().CountFormatCode
-	e.WithoutHeader = withoutHeader
-	e.Encoding = encoding
+	if isPlainTable && cmd.GetFlags().Plain {
+		return encodePlainText(fp, header, records, lineBreak, withoutHeader, encoding)
+	}
 
-	palette, _ := cmd.GetPalette()
+	te := newTextEncoder(format, tableFormat, isPlainTable, lineBreak, encoding)
 
-	if !withoutHeader {
-		hfields := make([]table.Field, 0, len(header))
-		for _, v := range header {
-			hfields = append(hfields, table.NewField(v, text.Centering))
-		}
-		e.SetHeader(hfields)
+	if 0 < len(header) && PipeChunkSize < len(records) && (!isPlainTable || !withoutHeader) && isPipe(fp) {
+		return te.encodeInChunks(fp, header, records, withoutHeader)
 	}
 
-	aligns := make([]text.FieldAlignment, 0, len(header))
+	e := te.newEncoder(len(records))
+	e.WithoutHeader = withoutHeader
 
-	var textStrBuf bytes.Buffer
-	var textLineBuf bytes.Buffer
-	for i, record := range records {
-		rfields := make([]table.Field, 0, len(header))
-		for _, v := range record {
-			str, effect, align := ConvertFieldContents(v, isPlainTable)
-			if format == cmd.TEXT {
-				textStrBuf.Reset()
-				textLineBuf.Reset()
-
-				runes := []rune(str)
-				pos := 0
-				for {
-					if len(runes) <= pos {
-						if 0 < textLineBuf.Len() {
-							textStrBuf.WriteString(palette.Render(effect, textLineBuf.String()))
-						}
-						break
-					}
-
-					r := runes[pos]
-					switch r {
-					case '\r':
-						if (pos+1) < len(runes) && runes[pos+1] == '\n' {
-							pos++
-						}
-						fallthrough
-					case '\n':
-						if 0 < textLineBuf.Len() {
-							textStrBuf.WriteString(palette.Render(effect, textLineBuf.String()))
-						}
-						textStrBuf.WriteByte('\n')
-						textLineBuf.Reset()
-					default:
-						textLineBuf.WriteRune(r)
-					}
-
-					pos++
-				}
-				str = textStrBuf.String()
-			}
-			rfields = append(rfields, table.NewField(str, align))
+	if !withoutHeader {
+		e.SetHeader(te.headerFields(header))
+	}
 
-			if i == 0 {
-				aligns = append(aligns, align)
-			}
+	var aligns []text.FieldAlignment
+	for i, record := range records {
+		rfields := te.recordFields(record)
+		if i == 0 {
+			aligns = fieldAlignments(rfields)
 		}
 		e.AppendRecord(rfields)
 	}
@@ -298,8 +295,295 @@ func encodeText(fp io.Writer, view *View, format cmd.Format, lineBreak text.Line
 	return w.Flush()
 }
 
+// encodePlainText writes the text table as tab-separated lines without box drawing
+// and alignment padding, so that the output can be read by screen readers.
+// Tabs and line breaks in fields are replaced with spaces to keep one record per line.
+func encodePlainText(fp io.Writer, header []string, records [][]value.Primary, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding) error {
+	w := csv.NewWriter(fp, lineBreak, encoding)
+	w.Delimiter = '\t'
+
+	fields := make([]csv.Field, len(header))
+
+	if !withoutHeader {
+		for i, v := range header {
+			fields[i] = csv.NewField(plainFieldReplacer.Replace(v), false)
+		}
+		if err := w.Write(fields); err != nil {
+			return err
+		}
+		if err := endLine(w, fp); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range records {
+		for i, v := range record {
+			str, _, _ := ConvertFieldContents(v, true)
+			fields[i] = csv.NewField(plainFieldReplacer.Replace(str), false)
+		}
+		if err := w.Write(fields); err != nil {
+			return err
+		}
+		if err := endLine(w, fp); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return nil
+}
+
+var plainFieldReplacer = strings.NewReplacer("\t", " ", "\r\n", " ", "\r", " ", "\n", " ")
+
+// PipeChunkSize is the number of records of a text table rendered at a time
+// when the table is written to a pipe.
+var PipeChunkSize = 1000
+
+func isPipe(fp io.Writer) bool {
+	f, ok := fp.(*os.File)
+	if !ok {
+		return false
+	}
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeNamedPipe != 0
+}
+
+type textEncoder struct {
+	Format       cmd.Format
+	TableFormat  table.Format
+	IsPlainTable bool
+	LineBreak    text.LineBreak
+	Encoding     text.Encoding
+
+	palette *color.Palette
+	strBuf  bytes.Buffer
+	lineBuf bytes.Buffer
+}
+
+func newTextEncoder(format cmd.Format, tableFormat table.Format, isPlainTable bool, lineBreak text.LineBreak, encoding text.Encoding) *textEncoder {
+	palette, _ := cmd.GetPalette()
+	return &textEncoder{
+		Format:       format,
+		TableFormat:  tableFormat,
+		IsPlainTable: isPlainTable,
+		LineBreak:    lineBreak,
+		Encoding:     encoding,
+		palette:      palette,
+	}
+}
+
+func (te *textEncoder) newEncoder(recordCounts int) *table.Encoder {
+	flags := cmd.GetFlags()
+
+	e := table.NewEncoder(te.TableFormat, recordCounts)
+	e.LineBreak = te.LineBreak
+	e.EastAsianEncoding = flags.EastAsianEncoding
+	e.CountDiacriticalSign = flags.CountDiacriticalSign
+	e.CountFormatCode = flags.CountFormatCode
+	e.Encoding = te.Encoding
+	return e
+}
+
+func (te *textEncoder) headerFields(header []string) []table.Field {
+	fields := make([]table.Field, 0, len(header))
+	for _, v := range header {
+		fields = append(fields, table.NewField(v, text.Centering))
+	}
+	return fields
+}
+
+func (te *textEncoder) recordFields(record []value.Primary) []table.Field {
+	fields := make([]table.Field, 0, len(record))
+	for _, v := range record {
+		str, effect, align := ConvertFieldContents(v, te.IsPlainTable)
+		if te.Format == cmd.TEXT {
+			str = te.render(str, effect)
+		}
+		fields = append(fields, table.NewField(str, align))
+	}
+	return fields
+}
+
+func (te *textEncoder) render(str string, effect string) string {
+	te.strBuf.Reset()
+	te.lineBuf.Reset()
+
+	runes := []rune(str)
+	pos := 0
+	for {
+		if len(runes) <= pos {
+			if 0 < te.lineBuf.Len() {
+				te.strBuf.WriteString(te.palette.Render(effect, te.lineBuf.String()))
+			}
+			break
+		}
+
+		r := runes[pos]
+		switch r {
+		case '\r':
+			if (pos+1) < len(runes) && runes[pos+1] == '\n' {
+				pos++
+			}
+			fallthrough
+		case '\n':
+			if 0 < te.lineBuf.Len() {
+				te.strBuf.WriteString(te.palette.Render(effect, te.lineBuf.String()))
+			}
+			te.strBuf.WriteByte('\n')
+			te.lineBuf.Reset()
+		default:
+			te.lineBuf.WriteRune(r)
+		}
+
+		pos++
+	}
+	return te.strBuf.String()
+}
+
+// measureFields sets the lines and the widths of the fields in the same way as the table encoder.
+func (te *textEncoder) measureFields(fields []table.Field) {
+	flags := cmd.GetFlags()
+	markdown := te.TableFormat == table.GFMTable || te.TableFormat == table.OrgTable
+
+	for i := range fields {
+		contents := strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(fields[i].Contents)
+		if markdown {
+			contents = strings.NewReplacer(
+				"\n", table.MarkdownLineBreak,
+				string(table.VLine), string(table.EscapeChar)+string(table.VLine),
+			).Replace(contents)
+		}
+
+		fields[i].Lines = strings.Split(contents, "\n")
+		fields[i].Width = 0
+		for _, l := range fields[i].Lines {
+			if w := text.Width(l, flags.EastAsianEncoding, flags.CountDiacriticalSign, flags.CountFormatCode); fields[i].Width < w {
+				fields[i].Width = w
+			}
+		}
+	}
+}
+
+func fieldAlignments(fields []table.Field) []text.FieldAlignment {
+	aligns := make([]text.FieldAlignment, 0, len(fields))
+	for _, f := range fields {
+		aligns = append(aligns, f.Alignment)
+	}
+	return aligns
+}
+
+// encodeInChunks writes the table rendering PipeChunkSize records at a time,
+// so that the whole rendered table is not held in memory and writing to a slow
+// consumer blocks until the consumer reads the previous records.
+//
+// The widths of the columns are measured over all the records first.
+// Each chunk is rendered with a header padded to the widths so that all the chunks
+// are aligned, and the header and the borders repeated in each chunk are removed.
+func (te *textEncoder) encodeInChunks(fp io.Writer, header []string, records [][]value.Primary, withoutHeader bool) error {
+	flags := cmd.GetFlags()
+	isPlainTable := te.TableFormat == table.PlainTable
+	showHeader := isPlainTable || !withoutHeader
+
+	hfields := te.headerFields(header)
+	te.measureFields(hfields)
+
+	widths := make([]int, len(header))
+	var aligns []text.FieldAlignment
+	for i, record := range records {
+		rfields := te.recordFields(record)
+		if i == 0 {
+			aligns = fieldAlignments(rfields)
+		}
+		te.measureFields(rfields)
+		for j := range rfields {
+			if widths[j] < rfields[j].Width {
+				widths[j] = rfields[j].Width
+			}
+		}
+	}
+
+	if showHeader {
+		for i, f := range hfields {
+			if widths[i] < f.Width {
+				widths[i] = f.Width
+			}
+			if te.TableFormat == table.GFMTable && widths[i] < 3 {
+				widths[i] = 3
+			}
+			if (widths[i]-f.Width)%2 == 1 {
+				widths[i] = widths[i] + 1
+			}
+		}
+	}
+
+	headerLines := 1
+	padded := make([]table.Field, len(hfields))
+	for i, f := range hfields {
+		var lines []string
+		if isPlainTable {
+			lines = make([]string, len(f.Lines))
+			for j, l := range f.Lines {
+				lines[j] = padCenter(l, widths[i]-text.Width(l, flags.EastAsianEncoding, flags.CountDiacriticalSign, flags.CountFormatCode))
+			}
+			if headerLines < len(lines) {
+				headerLines = len(lines)
+			}
+		} else {
+			lines = []string{padCenter(header[i], widths[i]-f.Width)}
+		}
+		padded[i] = table.NewField(strings.Join(lines, "\n"), text.Centering)
+	}
+
+	leadingLines := headerLines + 1
+	if isPlainTable {
+		leadingLines++
+	}
+	lb := te.LineBreak.Value()
+
+	for start := 0; start < len(records); start += PipeChunkSize {
+		e := te.newEncoder(PipeChunkSize)
+		e.SetHeader(padded)
+		if te.Format == cmd.GFM {
+			e.SetFieldAlignments(aligns)
+		}
+		for i := start; i < start+PipeChunkSize && i < len(records); i++ {
+			e.AppendRecord(te.recordFields(records[i]))
+		}
+
+		s, err := e.Encode()
+		if err != nil {
+			return err
+		}
+
+		if 0 < start || !showHeader {
+			for i := 0; i < leadingLines; i++ {
+				s = s[strings.Index(s, lb)+len(lb):]
+			}
+			if 0 < start {
+				s = lb + s
+			}
+		}
+		if isPlainTable && start+PipeChunkSize < len(records) {
+			s = s[:strings.LastIndex(s, lb)]
+		}
+
+		if _, err = io.WriteString(fp, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func padCenter(s string, padLen int) string {
+	if padLen < 1 {
+		return s
+	}
+	half := padLen / 2
+	return strings.Repeat(" ", half) + s + strings.Repeat(" ", padLen-half)
+}
+
 func encodeLTSV(fp io.Writer, view *View, lineBreak text.LineBreak, encoding text.Encoding) error {
 	header, records := bareValues(view)
+	warnEncodingErrors(encodableValues(header, records, encoding), encoding)
 	w, err := ltsv.NewWriter(fp, header, lineBreak, encoding)
 	if err != nil {
 		return err
@@ -313,6 +597,9 @@ func encodeLTSV(fp io.Writer, view *View, lineBreak text.LineBreak, encoding tex
 		if err := w.Write(fields); err != nil {
 			return err
 		}
+		if err := endLine(w, fp); err != nil {
+			return err
+		}
 	}
 	w.Flush()
 	return nil
@@ -353,6 +640,8 @@ func ConvertFieldContents(val value.Primary, forTextTable bool) (string, string,
 	case value.Datetime:
 		s = val.(value.Datetime).Format(time.RFC3339Nano)
 		effect = cmd.DatetimeEffect
+	case value.Array, value.Map:
+		s = json.ParseValueToStructure(val).Encode()
 	case value.Null:
 		if forTextTable {
 			s = "NULL"
@@ -2,6 +2,8 @@ package query
 
 import (
 	"bytes"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -26,6 +28,7 @@ var encodeViewTests = []struct {
 	JsonEscape              json.EscapeType
 	PrettyPrint             bool
 	UseColor                bool
+	Plain                   bool
 	Result                  string
 	Error                   string
 }{
@@ -91,6 +94,22 @@ var encodeViewTests = []struct {
 			"|        | \033[32mghijkl\033[0m |\n" +
 			"+--------+--------+",
 	},
+	{
+		Name: "Text in Plain Mode",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2\nsecond line", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewString("abc\tdef\r\nghi"), value.NewNull()}),
+			},
+		},
+		Format:   cmd.TEXT,
+		UseColor: true,
+		Plain:    true,
+		Result: "c1\tc2 second line\tc3\n" +
+			"-1\tUNKNOWN\ttrue\n" +
+			"2.0123\tabc def ghi\tNULL",
+	},
 	{
 		Name: "Fixed-Length Format",
 		View: &View{
@@ -405,6 +424,11 @@ var encodeViewTests = []struct {
 }
 
 func TestEncodeView(t *testing.T) {
+	defer func() {
+		cmd.GetFlags().SetPlain(false)
+		cmd.GetFlags().SetColor(false)
+	}()
+
 	buf := new(bytes.Buffer)
 
 	for _, v := range encodeViewTests {
@@ -417,6 +441,7 @@ func TestEncodeView(t *testing.T) {
 		if v.WriteDelimiter == 0 {
 			v.WriteDelimiter = ','
 		}
+		cmd.GetFlags().SetPlain(v.Plain)
 		cmd.GetFlags().SetColor(v.UseColor)
 
 		fileInfo := &FileInfo{
@@ -452,3 +477,54 @@ func TestEncodeView(t *testing.T) {
 		}
 	}
 }
+
+func TestEncodeView_Pipe(t *testing.T) {
+	chunkSize := PipeChunkSize
+	PipeChunkSize = 1
+	defer func() {
+		PipeChunkSize = chunkSize
+		cmd.GetFlags().SetPlain(false)
+		cmd.GetFlags().SetColor(false)
+	}()
+
+	for _, v := range encodeViewTests {
+		if 0 < len(v.Error) || (v.Format != cmd.TEXT && v.Format != cmd.GFM && v.Format != cmd.ORG) {
+			continue
+		}
+		if v.WriteEncoding == "" {
+			v.WriteEncoding = text.UTF8
+		}
+		if v.LineBreak == "" {
+			v.LineBreak = text.LF
+		}
+		cmd.GetFlags().SetPlain(v.Plain)
+		cmd.GetFlags().SetColor(v.UseColor)
+
+		fileInfo := &FileInfo{
+			Format:    v.Format,
+			Encoding:  v.WriteEncoding,
+			LineBreak: v.LineBreak,
+			NoHeader:  v.WithoutHeader,
+		}
+
+		r, w, _ := os.Pipe()
+		result := make(chan string)
+		go func() {
+			b, _ := ioutil.ReadAll(r)
+			result <- string(b)
+		}()
+
+		err := EncodeView(w, v.View, fileInfo)
+		w.Close()
+		s := <-result
+		r.Close()
+
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if s != v.Result {
+			t.Errorf("%s: result = %s, want %s for a pipe", v.Name, s, v.Result)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package query
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+)
+
+const EncodingReplacementChar = '?'
+
+type DecodingReader struct {
+	Errors int
+
+	reader   *bufio.Reader
+	encoding text.Encoding
+	policy   cmd.EncodingErrorsType
+	line     int
+}
+
+func NewDecodingReader(r io.Reader, enc text.Encoding, policy cmd.EncodingErrorsType) *DecodingReader {
+	return &DecodingReader{
+		reader:   bufio.NewReader(text.GetTransformDecoder(r, enc)),
+		encoding: enc,
+		policy:   policy,
+		line:     1,
+	}
+}
+
+func (r *DecodingReader) Read(p []byte) (int, error) {
+	if r.policy == cmd.EncodingErrorsNotSet {
+		return r.reader.Read(p)
+	}
+
+	n := 0
+	for n < len(p) {
+		if i := r.copyValid(p[n:]); 0 < i {
+			n += i
+			continue
+		}
+
+		c, size, err := r.reader.ReadRune()
+		if err != nil {
+			if err == io.EOF && 0 < n {
+				return n, nil
+			}
+			return n, err
+		}
+
+		// Decoders of other encodings replace invalid byte sequences with U+FFFD.
+		invalid := c == utf8.RuneError && (size == 1 || r.encoding != text.UTF8)
+		if invalid {
+			switch r.policy {
+			case cmd.EncodingErrorsIgnore:
+				r.Errors++
+				continue
+			case cmd.EncodingErrorsStrict:
+				return n, errors.New(fmt.Sprintf("line %d: invalid byte sequence in %s", r.line, r.encoding))
+			}
+		}
+
+		if len(p) < n+utf8.RuneLen(c) {
+			r.reader.UnreadRune()
+			break
+		}
+		if invalid {
+			r.Errors++
+		}
+		if c == '\n' {
+			r.line++
+		}
+		n += utf8.EncodeRune(p[n:], c)
+	}
+
+	if n < 1 {
+		return 0, io.ErrShortBuffer
+	}
+	return n, nil
+}
+
+func (r *DecodingReader) copyValid(p []byte) int {
+	b, _ := r.reader.Peek(r.reader.Buffered())
+
+	i := 0
+	for i < len(b) && i < len(p) {
+		if b[i] < utf8.RuneSelf {
+			if b[i] == '\n' {
+				r.line++
+			}
+			i++
+			continue
+		}
+
+		c, size := utf8.DecodeRune(b[i:])
+		if c == utf8.RuneError || len(p) < i+size {
+			break
+		}
+		i += size
+	}
+
+	copy(p, b[:i])
+	r.reader.Discard(i)
+	return i
+}
+
+func encodingErrorsWarning(subject string) string {
+	template := "%s replaced"
+	if cmd.GetFlags().EncodingErrors == cmd.EncodingErrorsIgnore {
+		template = "%s ignored"
+	}
+	return fmt.Sprintf(cmd.Message(template), subject)
+}
+
+func encodableValues(header []string, records [][]value.Primary, enc text.Encoding) int {
+	policy := cmd.GetFlags().EncodingErrors
+	if enc == text.UTF8 || policy == cmd.EncodingErrorsNotSet || policy == cmd.EncodingErrorsStrict {
+		return 0
+	}
+
+	e := &encodabilityChecker{
+		encoding: enc,
+		policy:   policy,
+		cache:    make(map[rune]bool),
+	}
+	for i := range header {
+		header[i] = e.apply(header[i])
+	}
+	for _, record := range records {
+		for i := range record {
+			if s, ok := record[i].(value.String); ok {
+				if str := e.apply(s.Raw()); str != s.Raw() {
+					record[i] = value.NewString(str)
+				}
+			}
+		}
+	}
+	return e.count
+}
+
+type encodabilityChecker struct {
+	encoding text.Encoding
+	policy   cmd.EncodingErrorsType
+	cache    map[rune]bool
+	count    int
+}
+
+func (e *encodabilityChecker) encodable(c rune) bool {
+	if c < utf8.RuneSelf {
+		return true
+	}
+	if ok, cached := e.cache[c]; cached {
+		return ok
+	}
+	_, err := text.Encode(string(c), e.encoding)
+	e.cache[c] = err == nil
+	return err == nil
+}
+
+func (e *encodabilityChecker) apply(s string) string {
+	i := strings.IndexFunc(s, func(c rune) bool { return !e.encodable(c) })
+	if i < 0 {
+		return s
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(s[:i])
+	for _, c := range s[i:] {
+		if e.encodable(c) {
+			buf.WriteRune(c)
+			continue
+		}
+		e.count++
+		if e.policy == cmd.EncodingErrorsReplace {
+			buf.WriteRune(EncodingReplacementChar)
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,188 @@
+package query
+
+import (
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+)
+
+var decodingReaderTests = []struct {
+	Name     string
+	Input    string
+	Encoding text.Encoding
+	Policy   cmd.EncodingErrorsType
+	Result   string
+	Errors   int
+	Error    string
+}{
+	{
+		Name:     "DecodingReader UTF8",
+		Input:    "a,b\n1,あ�\n",
+		Encoding: text.UTF8,
+		Policy:   cmd.EncodingErrorsStrict,
+		Result:   "a,b\n1,あ�\n",
+	},
+	{
+		Name:     "DecodingReader UTF8 Not Set",
+		Input:    "a,b\n1,x\xffy\n",
+		Encoding: text.UTF8,
+		Policy:   cmd.EncodingErrorsNotSet,
+		Result:   "a,b\n1,x\xffy\n",
+	},
+	{
+		Name:     "DecodingReader UTF8 Strict",
+		Input:    "a,b\n1,x\xffy\n",
+		Encoding: text.UTF8,
+		Policy:   cmd.EncodingErrorsStrict,
+		Error:    "line 2: invalid byte sequence in UTF8",
+	},
+	{
+		Name:     "DecodingReader UTF8 Replace",
+		Input:    "a,b\n1,x\xffy\n2,\xe3\x81",
+		Encoding: text.UTF8,
+		Policy:   cmd.EncodingErrorsReplace,
+		Result:   "a,b\n1,x�y\n2,��",
+		Errors:   3,
+	},
+	{
+		Name:     "DecodingReader UTF8 Ignore",
+		Input:    "a,b\n1,x\xffy\n",
+		Encoding: text.UTF8,
+		Policy:   cmd.EncodingErrorsIgnore,
+		Result:   "a,b\n1,xy\n",
+		Errors:   1,
+	},
+	{
+		Name:     "DecodingReader SJIS",
+		Input:    "a,b\n1,\x82\xa0\n",
+		Encoding: text.SJIS,
+		Policy:   cmd.EncodingErrorsStrict,
+		Result:   "a,b\n1,あ\n",
+	},
+	{
+		Name:     "DecodingReader SJIS Not Set",
+		Input:    "a,b\n1,\x82\xa0\x82\n",
+		Encoding: text.SJIS,
+		Policy:   cmd.EncodingErrorsNotSet,
+		Result:   "a,b\n1,あ�\n",
+	},
+	{
+		Name:     "DecodingReader SJIS Strict",
+		Input:    "a,b\n1,\x82\xa0\x82\n",
+		Encoding: text.SJIS,
+		Policy:   cmd.EncodingErrorsStrict,
+		Error:    "line 2: invalid byte sequence in SJIS",
+	},
+	{
+		Name:     "DecodingReader SJIS Replace",
+		Input:    "a,b\n1,\x82\xa0\x82\n",
+		Encoding: text.SJIS,
+		Policy:   cmd.EncodingErrorsReplace,
+		Result:   "a,b\n1,あ�\n",
+		Errors:   1,
+	},
+	{
+		Name:     "DecodingReader SJIS Ignore",
+		Input:    "a,b\n1,\x82\xa0\x82\n",
+		Encoding: text.SJIS,
+		Policy:   cmd.EncodingErrorsIgnore,
+		Result:   "a,b\n1,あ\n",
+		Errors:   1,
+	},
+}
+
+func TestDecodingReader_Read(t *testing.T) {
+	for _, v := range decodingReaderTests {
+		r := NewDecodingReader(strings.NewReader(v.Input), v.Encoding, v.Policy)
+		result, err := ioutil.ReadAll(r)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if string(result) != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(result), v.Result)
+		}
+		if r.Errors != v.Errors {
+			t.Errorf("%s: errors = %d, want %d", v.Name, r.Errors, v.Errors)
+		}
+	}
+}
+
+var encodableValuesTests = []struct {
+	Name          string
+	Policy        cmd.EncodingErrorsType
+	Encoding      text.Encoding
+	ResultHeader  []string
+	ResultRecords [][]value.Primary
+	Count         int
+}{
+	{
+		Name:          "EncodableValues Strict",
+		Policy:        cmd.EncodingErrorsStrict,
+		Encoding:      text.SJIS,
+		ResultHeader:  []string{"cé", "c2"},
+		ResultRecords: [][]value.Primary{{value.NewString("a\U0001F600b"), value.NewInteger(1)}},
+	},
+	{
+		Name:          "EncodableValues UTF8",
+		Policy:        cmd.EncodingErrorsReplace,
+		Encoding:      text.UTF8,
+		ResultHeader:  []string{"cé", "c2"},
+		ResultRecords: [][]value.Primary{{value.NewString("a\U0001F600b"), value.NewInteger(1)}},
+	},
+	{
+		Name:          "EncodableValues Replace",
+		Policy:        cmd.EncodingErrorsReplace,
+		Encoding:      text.SJIS,
+		ResultHeader:  []string{"c?", "c2"},
+		ResultRecords: [][]value.Primary{{value.NewString("a?b"), value.NewInteger(1)}},
+		Count:         2,
+	},
+	{
+		Name:          "EncodableValues Ignore",
+		Policy:        cmd.EncodingErrorsIgnore,
+		Encoding:      text.SJIS,
+		ResultHeader:  []string{"c", "c2"},
+		ResultRecords: [][]value.Primary{{value.NewString("ab"), value.NewInteger(1)}},
+		Count:         2,
+	},
+}
+
+func TestEncodableValues(t *testing.T) {
+	flags := cmd.GetFlags()
+	defer func() {
+		flags.EncodingErrors = cmd.EncodingErrorsStrict
+	}()
+
+	for _, v := range encodableValuesTests {
+		flags.EncodingErrors = v.Policy
+
+		header := []string{"cé", "c2"}
+		records := [][]value.Primary{{value.NewString("a\U0001F600b"), value.NewInteger(1)}}
+
+		count := encodableValues(header, records, v.Encoding)
+		if count != v.Count {
+			t.Errorf("%s: count = %d, want %d", v.Name, count, v.Count)
+		}
+		if !reflect.DeepEqual(header, v.ResultHeader) {
+			t.Errorf("%s: header = %q, want %q", v.Name, header, v.ResultHeader)
+		}
+		if !reflect.DeepEqual(records, v.ResultRecords) {
+			t.Errorf("%s: records = %v, want %v", v.Name, records, v.ResultRecords)
+		}
+	}
+}
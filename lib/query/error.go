@@ -3,6 +3,7 @@ package query
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
@@ -16,11 +17,16 @@ const (
 	ErrorMessageWithCustomPrefixTemplate  = "[%s] %s"
 
 	ErrorInvalidValue                         = "%s: cannot evaluate as a value"
+	ErrorArithmeticOperandType                = "%s cannot be converted to a number"
 	ErrorPath                                 = "%s: %s"
 	ErrorReadFile                             = "failed to read from file: %s"
 	ErrorWriteFile                            = "failed to write to file: %s"
+	ErrorHttpSink                             = "failed to send the result to %s: %s"
 	ErrorCommit                               = "failed to commit: %s"
 	ErrorRollback                             = "failed to rollback: %s"
+	ErrorFileModified                         = "file %s has been modified by another application"
+	ErrorFileModifiedWithConflictFiles        = "file %s has been modified by another application, and files to resolve the conflict are saved in %s"
+	ErrorFileModifiedInGit                    = "file %s has uncommitted modifications in git"
 	ErrorFieldAmbiguous                       = "field %s is ambiguous"
 	ErrorFieldNotExist                        = "field %s does not exist"
 	ErrorFieldNotGroupKey                     = "field %s is not a group key"
@@ -33,6 +39,7 @@ const (
 	ErrorFunctionInvalidArgument              = "%s for function %s"
 	ErrorUnpermittedStatementFunction         = "function %s cannot be used as a statement"
 	ErrorNestedAggregateFunctions             = "aggregate functions are nested at %s"
+	ErrorNotTableFunction                     = "function %s does not return a table"
 	ErrorFunctionRedeclared                   = "function %s is redeclared"
 	ErrorBuiltInFunctionDeclared              = "function %s is a built-in function"
 	ErrorDuplicateParameter                   = "parameter %s is a duplicate"
@@ -42,6 +49,22 @@ const (
 	ErrorJsonQuery                            = "json query error: %s"
 	ErrorJsonQueryEmpty                       = "json query is empty"
 	ErrorJsonTableEmpty                       = "json table is empty"
+	ErrorRevisionEmpty                        = "revision of table %s is empty"
+	ErrorReadRevision                         = "failed to read %s at revision %s: %s"
+	ErrorUnnestNotArray                       = "%s: value is not an array"
+	ErrorGenerateSeriesInvalidArgument        = "%s: %s is not a number or a datetime"
+	ErrorGenerateSeriesInvalidStep            = "%s: %s is not a valid step"
+	ErrorGenerateSeriesTooLong                = "%s: series exceeds the limit of %d values"
+	ErrorTailFormat                           = "%s: %s format is not supported, only CSV and TSV files can be followed"
+	ErrorTailNotStreaming                     = "%s can only be used as the only table in the from clause of a select query"
+	ErrorIncrementalNotTopLevel               = "table object %s can only be used as the only table in the from clause of a select query"
+	ErrorIncrementalAggregation               = "%s cannot be used in an incremental query"
+	ErrorIncrementalState                     = "failed to use state file %s: %s"
+	ErrorExternalSort                         = "failed to sort records using temporary files: %s"
+	ErrorCatalogColumnsLength                 = "%s: catalog defines %s, but the table has %s"
+	ErrorLateralJoinDirection                 = "%s cannot be joined with %s OUTER JOIN"
+	ErrorInvalidCollation                     = "%s: %s"
+	ErrorCollationConflict                    = "collations %s and %s are in conflict"
 	ErrorTableObjectInvalidObject             = "invalid table object: %s"
 	ErrorTableObjectInvalidDelimiter          = "invalid delimiter: %s"
 	ErrorTableObjectInvalidDelimiterPositions = "invalid delimiter positions: %s"
@@ -51,11 +74,25 @@ const (
 	ErrorTableObjectInvalidArgument           = "invalid argument for %s: %s"
 	ErrorCursorRedeclared                     = "cursor %s is redeclared"
 	ErrorUndeclaredCursor                     = "cursor %s is undeclared"
+	ErrorStatementRedeclared                  = "statement %s is redeclared"
+	ErrorUndeclaredStatement                  = "statement %s is undeclared"
+	ErrorStatementReplaceValueLength          = "statement %s takes %s, but %s specified"
+	ErrorNamedReplaceValueNotAllowed          = "named value %s is allowed only for a prepared statement"
+	ErrorPlaceholderValueNotSpecified         = "value for placeholder %s is not specified"
+	ErrorInvalidIdentifierValue               = "%s is not a valid identifier for placeholder %s"
+	ErrorImmediateReplaceValueLength          = "statement takes %s, but %s specified"
+	ErrorTriggerRedeclared                    = "trigger %s is redeclared"
+	ErrorUndeclaredTrigger                    = "trigger %s is undeclared"
+	ErrorTableModifiedInTrigger               = "table %s cannot be modified in trigger %s"
+	ErrorIndexAlreadyExist                    = "index %s already exists"
+	ErrorIndexNotExist                        = "index %s does not exist"
+	ErrorInvalidIndexMethod                   = "%s is an unknown index method"
 	ErrorCursorClosed                         = "cursor %s is closed"
 	errorCursorOpen                           = "cursor %s is already open"
 	ErrorPseudoCursor                         = "cursor %s is a pseudo cursor"
 	ErrorCursorFetchLength                    = "fetching from cursor %s returns %s"
 	ErrorInvalidFetchPosition                 = "fetching position %s is not an integer value"
+	ErrorInvalidFetchBulkSize                 = "fetching bulk size %s is not a positive integer value"
 	ErrorInlineTableRedefined                 = "inline table %s is redefined"
 	ErrorUndefinedInlineTable                 = "inline table %s is undefined"
 	ErrorInlineTableFieldLength               = "select query should return exactly %s for inline table %s"
@@ -70,6 +107,11 @@ const (
 	ErrorUndeclaredTemporaryTable             = "view %s is undeclared"
 	ErrorTemporaryTableFieldLength            = "select query should return exactly %s for view %s"
 	ErrorDuplicateTableName                   = "table name %s is a duplicate"
+	ErrorCheckConstraintViolation             = "%s of table %s is violated by values (%s)"
+	ErrorNotNullConstraintViolation           = "%s of field %s in table %s is violated by values (%s)"
+	ErrorUniqueConstraintViolation            = "%s of table %s is violated by duplicate values (%s)"
+	ErrorInvalidColumnType                    = "%s is an unknown column type"
+	ErrorColumnTypeConversion                 = "field %s cannot be converted to %s at %s"
 	ErrorTableNotLoaded                       = "table %s is not loaded"
 	ErrorStdinEmpty                           = "stdin is empty"
 	ErrorRowValueLengthInComparison           = "row value should contain exactly %s"
@@ -87,6 +129,9 @@ const (
 	ErrorReplaceValueLength                   = "%s"
 	ErrorSourceInvalidFilePath                = "%s is a invalid file path"
 	ErrorSourceFileNotExist                   = "file %s does not exist"
+	ErrorImportInvalidModuleName              = "%s is a invalid module name"
+	ErrorModuleNotFound                       = "module %s is not found in the source path"
+	ErrorCopyInvalidFilePath                  = "%s is a invalid file path"
 	ErrorInvalidFlagName                      = "%s is an unknown flag"
 	ErrorFlagValueNowAllowedFormat            = "%s for %s is not allowed"
 	ErrorInvalidFlagValue                     = "%s"
@@ -94,11 +139,18 @@ const (
 	ErrorRemoveFlagNotSupportedName           = "remove flag element syntax does not support %s"
 	ErrorInvalidFlagValueToBeRemoved          = "%s is an invalid value for %s to specify the element"
 	ErrorInvalidRuntimeInformation            = "%s is an unknown runtime information"
+	ErrorFetchLatestVersion                   = "failed to fetch the latest version: %s"
+	ErrorInvalidTimeZone                      = "timezone %q does not exist"
 	ErrorNotTable                             = "view has no attributes"
 	ErrorInvalidTableAttributeName            = "table attribute %s does not exist"
 	ErrorTableAttributeValueNotAllowedFormat  = "%s for %s is not allowed"
 	ErrorInvalidTableAttributeValue           = "%s"
 	ErrorInvalidEventName                     = "%s is an unknown event"
+	ErrorAssertionFailed                      = "assertion %s failed"
+	ErrorExpectedFieldsNotMatch               = "fields %s do not equal the expected fields %s"
+	ErrorExpectedRecordCountNotMatch          = "query returns %s, expected %s"
+	ErrorExpectedRecordNotMatch               = "record %d is %s, expected %s"
+	ErrorUndeclaredLabel                      = "label %s is undeclared"
 	ErrorInternalRecordIdNotExist             = "internal record id does not exist"
 	ErrorInternalRecordIdEmpty                = "internal record id is empty"
 	ErrorFieldLengthNotMatch                  = "field length does not match"
@@ -165,6 +217,15 @@ func (e BaseError) GetCode() int {
 	return e.Code
 }
 
+func (e BaseError) position() (string, int, int) {
+	return e.SourceFile, e.Line, e.Char
+}
+
+// errorMessage formats the message translated into the language specified by the flag.
+func errorMessage(template string, a ...interface{}) string {
+	return fmt.Sprintf(cmd.Message(template), a...)
+}
+
 func NewBaseError(expr parser.Expression, message string) *BaseError {
 	return NewBaseErrorWithCode(expr, message, 1)
 }
@@ -202,16 +263,18 @@ func NewBaseErrorWithPrefix(prefix string, message string, code int) *BaseError
 
 type UserTriggeredError struct {
 	*BaseError
+	Data value.Primary
 }
 
-func NewUserTriggeredError(expr parser.Trigger, message string) error {
+func NewUserTriggeredError(expr parser.Trigger, message string, data value.Primary) error {
 	code := 1
 	if expr.Code != nil {
 		code = int(expr.Code.(value.Integer).Raw())
 	}
 
 	return &UserTriggeredError{
-		NewBaseErrorWithCode(expr, message, code),
+		BaseError: NewBaseErrorWithCode(expr, message, code),
+		Data:      data,
 	}
 }
 
@@ -237,7 +300,17 @@ type InvalidValueError struct {
 
 func NewInvalidValueError(expr parser.QueryExpression) error {
 	return &InvalidValueError{
-		NewBaseError(expr, fmt.Sprintf(ErrorInvalidValue, expr)),
+		NewBaseError(expr, errorMessage(ErrorInvalidValue, expr)),
+	}
+}
+
+type ArithmeticOperandTypeError struct {
+	*BaseError
+}
+
+func NewArithmeticOperandTypeError(expr parser.Expression, operand parser.QueryExpression) error {
+	return &ArithmeticOperandTypeError{
+		NewBaseError(expr, errorMessage(ErrorArithmeticOperandType, operand)),
 	}
 }
 
@@ -247,7 +320,7 @@ type PathError struct {
 
 func NewPathError(expr parser.Expression, path string, message string) error {
 	return &PathError{
-		NewBaseError(expr, fmt.Sprintf(ErrorPath, path, message)),
+		NewBaseError(expr, errorMessage(ErrorPath, path, message)),
 	}
 }
 
@@ -257,7 +330,7 @@ type ReadFileError struct {
 
 func NewReadFileError(expr parser.Expression, message string) error {
 	return &ReadFileError{
-		NewBaseError(expr, fmt.Sprintf(ErrorReadFile, message)),
+		NewBaseError(expr, errorMessage(ErrorReadFile, message)),
 	}
 }
 
@@ -267,7 +340,17 @@ type WriteFileError struct {
 
 func NewWriteFileError(expr parser.Expression, message string) error {
 	return &WriteFileError{
-		NewBaseError(expr, fmt.Sprintf(ErrorWriteFile, message)),
+		NewBaseError(expr, errorMessage(ErrorWriteFile, message)),
+	}
+}
+
+type HttpSinkError struct {
+	*BaseError
+}
+
+func NewHttpSinkError(expr parser.Expression, url string, message string) error {
+	return &HttpSinkError{
+		NewBaseError(expr, errorMessage(ErrorHttpSink, url, message)),
 	}
 }
 
@@ -277,10 +360,12 @@ type CommitError struct {
 
 func NewCommitError(expr parser.Expression, message string) error {
 	if expr == nil {
-		NewBaseErrorWithPrefix("Auto Commit", fmt.Sprintf(ErrorCommit, message), 1)
+		return &CommitError{
+			NewBaseErrorWithPrefix("Auto Commit", errorMessage(ErrorCommit, message), 1),
+		}
 	}
 	return &CommitError{
-		NewBaseError(expr, fmt.Sprintf(ErrorCommit, message)),
+		NewBaseError(expr, errorMessage(ErrorCommit, message)),
 	}
 }
 
@@ -290,10 +375,12 @@ type RollbackError struct {
 
 func NewRollbackError(expr parser.Expression, message string) error {
 	if expr == nil {
-		NewBaseErrorWithPrefix("Auto Rollback", fmt.Sprintf(ErrorRollback, message), 1)
+		return &RollbackError{
+			NewBaseErrorWithPrefix("Auto Rollback", errorMessage(ErrorRollback, message), 1),
+		}
 	}
 	return &RollbackError{
-		NewBaseError(expr, fmt.Sprintf(ErrorRollback, message)),
+		NewBaseError(expr, errorMessage(ErrorRollback, message)),
 	}
 }
 
@@ -303,7 +390,7 @@ type FieldAmbiguousError struct {
 
 func NewFieldAmbiguousError(field parser.QueryExpression) error {
 	return &FieldAmbiguousError{
-		NewBaseError(field, fmt.Sprintf(ErrorFieldAmbiguous, field)),
+		NewBaseError(field, errorMessage(ErrorFieldAmbiguous, field)),
 	}
 }
 
@@ -313,7 +400,7 @@ type FieldNotExistError struct {
 
 func NewFieldNotExistError(field parser.QueryExpression) error {
 	return &FieldNotExistError{
-		NewBaseError(field, fmt.Sprintf(ErrorFieldNotExist, field)),
+		NewBaseError(field, errorMessage(ErrorFieldNotExist, field)),
 	}
 }
 
@@ -323,7 +410,7 @@ type FieldNotGroupKeyError struct {
 
 func NewFieldNotGroupKeyError(field parser.QueryExpression) error {
 	return &FieldNotGroupKeyError{
-		NewBaseError(field, fmt.Sprintf(ErrorFieldNotGroupKey, field)),
+		NewBaseError(field, errorMessage(ErrorFieldNotGroupKey, field)),
 	}
 }
 
@@ -333,7 +420,7 @@ type DuplicateFieldNameError struct {
 
 func NewDuplicateFieldNameError(fieldName parser.Identifier) error {
 	return &DuplicateFieldNameError{
-		NewBaseError(fieldName, fmt.Sprintf(ErrorDuplicateFieldName, fieldName)),
+		NewBaseError(fieldName, errorMessage(ErrorDuplicateFieldName, fieldName)),
 	}
 }
 
@@ -343,7 +430,7 @@ type NotGroupingRecordsError struct {
 
 func NewNotGroupingRecordsError(expr parser.QueryExpression, funcname string) error {
 	return &NotGroupingRecordsError{
-		NewBaseError(expr, fmt.Sprintf(ErrorNotGroupingRecords, funcname)),
+		NewBaseError(expr, errorMessage(ErrorNotGroupingRecords, funcname)),
 	}
 }
 
@@ -353,7 +440,7 @@ type UndeclaredVariableError struct {
 
 func NewUndeclaredVariableError(expr parser.Variable) error {
 	return &UndeclaredVariableError{
-		NewBaseError(expr, fmt.Sprintf(ErrorUndeclaredVariable, expr)),
+		NewBaseError(expr, errorMessage(ErrorUndeclaredVariable, expr)),
 	}
 }
 
@@ -363,7 +450,7 @@ type VariableRedeclaredError struct {
 
 func NewVariableRedeclaredError(expr parser.Variable) error {
 	return &VariableRedeclaredError{
-		NewBaseError(expr, fmt.Sprintf(ErrorVariableRedeclared, expr)),
+		NewBaseError(expr, errorMessage(ErrorVariableRedeclared, expr)),
 	}
 }
 
@@ -373,7 +460,7 @@ type FunctionNotExistError struct {
 
 func NewFunctionNotExistError(expr parser.QueryExpression, funcname string) error {
 	return &FunctionNotExistError{
-		NewBaseError(expr, fmt.Sprintf(ErrorFunctionNotExist, funcname)),
+		NewBaseError(expr, errorMessage(ErrorFunctionNotExist, funcname)),
 	}
 }
 
@@ -399,13 +486,13 @@ func NewFunctionArgumentLengthError(expr parser.QueryExpression, funcname string
 		}
 	}
 	return &FunctionArgumentLengthError{
-		NewBaseError(expr, fmt.Sprintf(ErrorFunctionArgumentsLength, funcname, argstr)),
+		NewBaseError(expr, errorMessage(ErrorFunctionArgumentsLength, funcname, argstr)),
 	}
 }
 
 func NewFunctionArgumentLengthErrorWithCustomArgs(expr parser.QueryExpression, funcname string, argstr string) error {
 	return &FunctionArgumentLengthError{
-		NewBaseError(expr, fmt.Sprintf(ErrorFunctionArgumentsLength, funcname, argstr)),
+		NewBaseError(expr, errorMessage(ErrorFunctionArgumentsLength, funcname, argstr)),
 	}
 }
 
@@ -415,7 +502,7 @@ type FunctionInvalidArgumentError struct {
 
 func NewFunctionInvalidArgumentError(function parser.QueryExpression, funcname string, message string) error {
 	return &FunctionInvalidArgumentError{
-		NewBaseError(function, fmt.Sprintf(ErrorFunctionInvalidArgument, message, funcname)),
+		NewBaseError(function, errorMessage(ErrorFunctionInvalidArgument, message, funcname)),
 	}
 }
 
@@ -425,7 +512,7 @@ type UnpermittedStatementFunctionError struct {
 
 func NewUnpermittedStatementFunctionError(expr parser.QueryExpression, funcname string) error {
 	return &UnpermittedStatementFunctionError{
-		NewBaseError(expr, fmt.Sprintf(ErrorUnpermittedStatementFunction, funcname)),
+		NewBaseError(expr, errorMessage(ErrorUnpermittedStatementFunction, funcname)),
 	}
 }
 
@@ -435,7 +522,17 @@ type NestedAggregateFunctionsError struct {
 
 func NewNestedAggregateFunctionsError(expr parser.QueryExpression) error {
 	return &NestedAggregateFunctionsError{
-		NewBaseError(expr, fmt.Sprintf(ErrorNestedAggregateFunctions, expr)),
+		NewBaseError(expr, errorMessage(ErrorNestedAggregateFunctions, expr)),
+	}
+}
+
+type NotTableFunctionError struct {
+	*BaseError
+}
+
+func NewNotTableFunctionError(expr parser.TableFunction) error {
+	return &NotTableFunctionError{
+		NewBaseError(expr.Function, errorMessage(ErrorNotTableFunction, expr.Function.Name)),
 	}
 }
 
@@ -445,7 +542,7 @@ type FunctionRedeclaredError struct {
 
 func NewFunctionRedeclaredError(expr parser.Identifier) error {
 	return &FunctionRedeclaredError{
-		NewBaseError(expr, fmt.Sprintf(ErrorFunctionRedeclared, expr.Literal)),
+		NewBaseError(expr, errorMessage(ErrorFunctionRedeclared, expr.Literal)),
 	}
 }
 
@@ -455,7 +552,7 @@ type BuiltInFunctionDeclaredError struct {
 
 func NewBuiltInFunctionDeclaredError(expr parser.Identifier) error {
 	return &BuiltInFunctionDeclaredError{
-		NewBaseError(expr, fmt.Sprintf(ErrorBuiltInFunctionDeclared, expr.Literal)),
+		NewBaseError(expr, errorMessage(ErrorBuiltInFunctionDeclared, expr.Literal)),
 	}
 }
 
@@ -465,7 +562,7 @@ type DuplicateParameterError struct {
 
 func NewDuplicateParameterError(expr parser.Variable) error {
 	return &DuplicateParameterError{
-		NewBaseError(expr, fmt.Sprintf(ErrorDuplicateParameter, expr.String())),
+		NewBaseError(expr, errorMessage(ErrorDuplicateParameter, expr.String())),
 	}
 }
 
@@ -475,7 +572,7 @@ type SubqueryTooManyRecordsError struct {
 
 func NewSubqueryTooManyRecordsError(expr parser.Subquery) error {
 	return &SubqueryTooManyRecordsError{
-		NewBaseError(expr, ErrorSubqueryTooManyRecords),
+		NewBaseError(expr, cmd.Message(ErrorSubqueryTooManyRecords)),
 	}
 }
 
@@ -485,7 +582,7 @@ type SubqueryTooManyFieldsError struct {
 
 func NewSubqueryTooManyFieldsError(expr parser.Subquery) error {
 	return &SubqueryTooManyFieldsError{
-		NewBaseError(expr, ErrorSubqueryTooManyFields),
+		NewBaseError(expr, cmd.Message(ErrorSubqueryTooManyFields)),
 	}
 }
 
@@ -495,7 +592,7 @@ type JsonQueryTooManyRecordsError struct {
 
 func NewJsonQueryTooManyRecordsError(expr parser.JsonQuery) error {
 	return &JsonQueryTooManyRecordsError{
-		NewBaseError(expr, ErrorJsonQueryTooManyRecords),
+		NewBaseError(expr, cmd.Message(ErrorJsonQueryTooManyRecords)),
 	}
 }
 
@@ -503,9 +600,9 @@ type JsonQueryError struct {
 	*BaseError
 }
 
-func NewJsonQueryError(expr parser.JsonQuery, message string) error {
+func NewJsonQueryError(expr parser.QueryExpression, message string) error {
 	return &JsonQueryError{
-		NewBaseError(expr, fmt.Sprintf(ErrorJsonQuery, message)),
+		NewBaseError(expr, errorMessage(ErrorJsonQuery, message)),
 	}
 }
 
@@ -513,9 +610,9 @@ type JsonQueryEmptyError struct {
 	*BaseError
 }
 
-func NewJsonQueryEmptyError(expr parser.JsonQuery) error {
+func NewJsonQueryEmptyError(expr parser.QueryExpression) error {
 	return &JsonQueryEmptyError{
-		NewBaseError(expr, ErrorJsonQueryEmpty),
+		NewBaseError(expr, cmd.Message(ErrorJsonQueryEmpty)),
 	}
 }
 
@@ -525,7 +622,167 @@ type JsonTableEmptyError struct {
 
 func NewJsonTableEmptyError(expr parser.JsonQuery) error {
 	return &JsonTableEmptyError{
-		NewBaseError(expr, ErrorJsonTableEmpty),
+		NewBaseError(expr, cmd.Message(ErrorJsonTableEmpty)),
+	}
+}
+
+type CatalogColumnsLengthError struct {
+	*BaseError
+}
+
+func NewCatalogColumnsLengthError(table parser.Identifier, columnsLen int, fieldLen int) error {
+	return &CatalogColumnsLengthError{
+		NewBaseError(table, errorMessage(ErrorCatalogColumnsLength, table, FormatCount(columnsLen, "column"), FormatCount(fieldLen, "field"))),
+	}
+}
+
+type RevisionEmptyError struct {
+	*BaseError
+}
+
+func NewRevisionEmptyError(expr parser.RevisionTable) error {
+	return &RevisionEmptyError{
+		NewBaseError(expr, errorMessage(ErrorRevisionEmpty, expr.Table)),
+	}
+}
+
+type ReadRevisionError struct {
+	*BaseError
+}
+
+func NewReadRevisionError(expr parser.RevisionTable, revision string, message string) error {
+	return &ReadRevisionError{
+		NewBaseError(expr, errorMessage(ErrorReadRevision, expr.Table, revision, message)),
+	}
+}
+
+type UnnestNotArrayError struct {
+	*BaseError
+}
+
+func NewUnnestNotArrayError(expr parser.Unnest) error {
+	return &UnnestNotArrayError{
+		NewBaseError(expr, errorMessage(ErrorUnnestNotArray, expr.Value)),
+	}
+}
+
+type GenerateSeriesInvalidArgumentError struct {
+	*BaseError
+}
+
+func NewGenerateSeriesInvalidArgumentError(expr parser.GenerateSeries, arg parser.QueryExpression) error {
+	return &GenerateSeriesInvalidArgumentError{
+		NewBaseError(expr, errorMessage(ErrorGenerateSeriesInvalidArgument, expr.GenerateSeries, arg)),
+	}
+}
+
+type GenerateSeriesInvalidStepError struct {
+	*BaseError
+}
+
+func NewGenerateSeriesInvalidStepError(expr parser.GenerateSeries) error {
+	return &GenerateSeriesInvalidStepError{
+		NewBaseError(expr, errorMessage(ErrorGenerateSeriesInvalidStep, expr.GenerateSeries, expr.Step)),
+	}
+}
+
+type GenerateSeriesTooLongError struct {
+	*BaseError
+}
+
+func NewGenerateSeriesTooLongError(expr parser.GenerateSeries) error {
+	return &GenerateSeriesTooLongError{
+		NewBaseError(expr, errorMessage(ErrorGenerateSeriesTooLong, expr.GenerateSeries, MaxSeriesLength)),
+	}
+}
+
+type TailFormatError struct {
+	*BaseError
+}
+
+func NewTailFormatError(expr parser.QueryExpression, format cmd.Format) error {
+	return &TailFormatError{
+		NewBaseError(expr, errorMessage(ErrorTailFormat, expr, format)),
+	}
+}
+
+type TailNotStreamingError struct {
+	*BaseError
+}
+
+func NewTailNotStreamingError(expr parser.TailTable) error {
+	return &TailNotStreamingError{
+		NewBaseError(expr, errorMessage(ErrorTailNotStreaming, expr.Tail)),
+	}
+}
+
+type IncrementalNotTopLevelError struct {
+	*BaseError
+}
+
+func NewIncrementalNotTopLevelError(expr parser.TableObject) error {
+	return &IncrementalNotTopLevelError{
+		NewBaseError(expr, errorMessage(ErrorIncrementalNotTopLevel, expr.Type.Literal)),
+	}
+}
+
+type IncrementalAggregationError struct {
+	*BaseError
+}
+
+func NewIncrementalAggregationError(table parser.QueryExpression, expr parser.QueryExpression) error {
+	return &IncrementalAggregationError{
+		NewBaseError(table, errorMessage(ErrorIncrementalAggregation, expr)),
+	}
+}
+
+type IncrementalStateError struct {
+	*BaseError
+}
+
+func NewIncrementalStateError(expr parser.TableObject, path string, message string) error {
+	return &IncrementalStateError{
+		NewBaseError(expr, errorMessage(ErrorIncrementalState, path, message)),
+	}
+}
+
+type ExternalSortError struct {
+	*BaseError
+}
+
+func NewExternalSortError(expr parser.OrderByClause, message string) error {
+	return &ExternalSortError{
+		NewBaseError(expr, errorMessage(ErrorExternalSort, message)),
+	}
+}
+
+type LateralJoinDirectionError struct {
+	*BaseError
+}
+
+func NewLateralJoinDirectionError(expr parser.QueryExpression, name string, direction parser.Token) error {
+	return &LateralJoinDirectionError{
+		NewBaseError(expr, errorMessage(ErrorLateralJoinDirection, name, direction.Literal)),
+	}
+}
+
+type InvalidCollationError struct {
+	*BaseError
+}
+
+func NewInvalidCollationError(expr parser.Collate, message string) error {
+	return &InvalidCollationError{
+		NewBaseError(expr, errorMessage(ErrorInvalidCollation, expr.Collation, message)),
+	}
+}
+
+type CollationConflictError struct {
+	*BaseError
+}
+
+func NewCollationConflictError(expr parser.Collate, collation1 value.Collation, collation2 value.Collation) error {
+	return &CollationConflictError{
+		NewBaseError(expr, errorMessage(ErrorCollationConflict, collation1, collation2)),
 	}
 }
 
@@ -535,7 +792,7 @@ type TableObjectInvalidObjectError struct {
 
 func NewTableObjectInvalidObjectError(expr parser.TableObject, objectName string) error {
 	return &TableObjectInvalidObjectError{
-		NewBaseError(expr, fmt.Sprintf(ErrorTableObjectInvalidObject, objectName)),
+		NewBaseError(expr, errorMessage(ErrorTableObjectInvalidObject, objectName)),
 	}
 }
 
@@ -545,7 +802,7 @@ type TableObjectInvalidDelimiterError struct {
 
 func NewTableObjectInvalidDelimiterError(expr parser.TableObject, delimiter string) error {
 	return &TableObjectInvalidObjectError{
-		NewBaseError(expr, fmt.Sprintf(ErrorTableObjectInvalidDelimiter, delimiter)),
+		NewBaseError(expr, errorMessage(ErrorTableObjectInvalidDelimiter, delimiter)),
 	}
 }
 
@@ -555,7 +812,7 @@ type TableObjectInvalidDelimiterPositionsError struct {
 
 func NewTableObjectInvalidDelimiterPositionsError(expr parser.TableObject, positions string) error {
 	return &TableObjectInvalidObjectError{
-		NewBaseError(expr, fmt.Sprintf(ErrorTableObjectInvalidDelimiterPositions, positions)),
+		NewBaseError(expr, errorMessage(ErrorTableObjectInvalidDelimiterPositions, positions)),
 	}
 }
 
@@ -565,7 +822,7 @@ type TableObjectInvalidJsonQueryError struct {
 
 func NewTableObjectInvalidJsonQueryError(expr parser.TableObject, jsonQuery string) error {
 	return &TableObjectInvalidObjectError{
-		NewBaseError(expr, fmt.Sprintf(ErrorTableObjectInvalidJsonQuery, jsonQuery)),
+		NewBaseError(expr, errorMessage(ErrorTableObjectInvalidJsonQuery, jsonQuery)),
 	}
 }
 
@@ -575,7 +832,7 @@ type TableObjectArgumentsLengthError struct {
 
 func NewTableObjectArgumentsLengthError(expr parser.TableObject, argLen int) error {
 	return &TableObjectArgumentsLengthError{
-		NewBaseError(expr, fmt.Sprintf(ErrorTableObjectArgumentsLength, expr.Type.Literal, argLen)),
+		NewBaseError(expr, errorMessage(ErrorTableObjectArgumentsLength, expr.Type.Literal, argLen)),
 	}
 }
 
@@ -585,7 +842,7 @@ type TableObjectJsonArgumentsLengthError struct {
 
 func NewTableObjectJsonArgumentsLengthError(expr parser.TableObject, argLen int) error {
 	return &TableObjectJsonArgumentsLengthError{
-		NewBaseError(expr, fmt.Sprintf(ErrorTableObjectJsonArgumentsLength, expr.Type.Literal, argLen)),
+		NewBaseError(expr, errorMessage(ErrorTableObjectJsonArgumentsLength, expr.Type.Literal, argLen)),
 	}
 }
 
@@ -595,7 +852,7 @@ type TableObjectInvalidArgumentError struct {
 
 func NewTableObjectInvalidArgumentError(expr parser.TableObject, message string) error {
 	return &TableObjectInvalidArgumentError{
-		NewBaseError(expr, fmt.Sprintf(ErrorTableObjectInvalidArgument, expr.Type.Literal, message)),
+		NewBaseError(expr, errorMessage(ErrorTableObjectInvalidArgument, expr.Type.Literal, message)),
 	}
 }
 
@@ -605,7 +862,7 @@ type CursorRedeclaredError struct {
 
 func NewCursorRedeclaredError(cursor parser.Identifier) error {
 	return &CursorRedeclaredError{
-		NewBaseError(cursor, fmt.Sprintf(ErrorCursorRedeclared, cursor)),
+		NewBaseError(cursor, errorMessage(ErrorCursorRedeclared, cursor)),
 	}
 }
 
@@ -615,7 +872,127 @@ type UndeclaredCursorError struct {
 
 func NewUndeclaredCursorError(cursor parser.Identifier) error {
 	return &UndeclaredCursorError{
-		NewBaseError(cursor, fmt.Sprintf(ErrorUndeclaredCursor, cursor)),
+		NewBaseError(cursor, errorMessage(ErrorUndeclaredCursor, cursor)),
+	}
+}
+
+type StatementRedeclaredError struct {
+	*BaseError
+}
+
+func NewStatementRedeclaredError(name parser.Identifier) error {
+	return &StatementRedeclaredError{
+		NewBaseError(name, errorMessage(ErrorStatementRedeclared, name)),
+	}
+}
+
+type UndeclaredStatementError struct {
+	*BaseError
+}
+
+func NewUndeclaredStatementError(name parser.Identifier) error {
+	return &UndeclaredStatementError{
+		NewBaseError(name, errorMessage(ErrorUndeclaredStatement, name)),
+	}
+}
+
+type StatementReplaceValueLengthError struct {
+	*BaseError
+}
+
+func NewStatementReplaceValueLengthError(expr parser.Execute, name string, expect int, actual int) error {
+	return &StatementReplaceValueLengthError{
+		NewBaseError(expr, errorMessage(ErrorStatementReplaceValueLength, name, FormatCount(expect, "positional value"), FormatCount(actual, "value"))),
+	}
+}
+
+type ImmediateReplaceValueLengthError struct {
+	*BaseError
+}
+
+func NewImmediateReplaceValueLengthError(expr parser.Execute, expect int, actual int) error {
+	return &ImmediateReplaceValueLengthError{
+		NewBaseError(expr, errorMessage(ErrorImmediateReplaceValueLength, FormatCount(expect, "positional value"), FormatCount(actual, "value"))),
+	}
+}
+
+type NamedReplaceValueNotAllowedError struct {
+	*BaseError
+}
+
+func NewNamedReplaceValueNotAllowedError(expr parser.ReplaceValue) error {
+	return &NamedReplaceValueNotAllowedError{
+		NewBaseError(expr.Name, errorMessage(ErrorNamedReplaceValueNotAllowed, expr.Name)),
+	}
+}
+
+type PlaceholderValueNotSpecifiedError struct {
+	*BaseError
+}
+
+func NewPlaceholderValueNotSpecifiedError(expr parser.Placeholder) error {
+	return &PlaceholderValueNotSpecifiedError{
+		NewBaseError(expr, errorMessage(ErrorPlaceholderValueNotSpecified, expr)),
+	}
+}
+
+type TriggerRedeclaredError struct {
+	*BaseError
+}
+
+func NewTriggerRedeclaredError(name parser.Identifier) error {
+	return &TriggerRedeclaredError{
+		NewBaseError(name, errorMessage(ErrorTriggerRedeclared, name)),
+	}
+}
+
+type UndeclaredTriggerError struct {
+	*BaseError
+}
+
+func NewUndeclaredTriggerError(name parser.Identifier) error {
+	return &UndeclaredTriggerError{
+		NewBaseError(name, errorMessage(ErrorUndeclaredTrigger, name)),
+	}
+}
+
+type TableModifiedInTriggerError struct {
+	*BaseError
+}
+
+func NewTableModifiedInTriggerError(table parser.QueryExpression, trigger parser.Identifier) error {
+	return &TableModifiedInTriggerError{
+		NewBaseError(table, errorMessage(ErrorTableModifiedInTrigger, table, trigger)),
+	}
+}
+
+type IndexAlreadyExistError struct {
+	*BaseError
+}
+
+func NewIndexAlreadyExistError(name parser.Identifier) error {
+	return &IndexAlreadyExistError{
+		NewBaseError(name, errorMessage(ErrorIndexAlreadyExist, name)),
+	}
+}
+
+type IndexNotExistError struct {
+	*BaseError
+}
+
+func NewIndexNotExistError(name parser.Identifier) error {
+	return &IndexNotExistError{
+		NewBaseError(name, errorMessage(ErrorIndexNotExist, name)),
+	}
+}
+
+type InvalidIndexMethodError struct {
+	*BaseError
+}
+
+func NewInvalidIndexMethodError(method parser.Identifier) error {
+	return &InvalidIndexMethodError{
+		NewBaseError(method, errorMessage(ErrorInvalidIndexMethod, method)),
 	}
 }
 
@@ -625,7 +1002,7 @@ type CursorClosedError struct {
 
 func NewCursorClosedError(cursor parser.Identifier) error {
 	return &CursorClosedError{
-		NewBaseError(cursor, fmt.Sprintf(ErrorCursorClosed, cursor)),
+		NewBaseError(cursor, errorMessage(ErrorCursorClosed, cursor)),
 	}
 }
 
@@ -635,7 +1012,7 @@ type CursorOpenError struct {
 
 func NewCursorOpenError(cursor parser.Identifier) error {
 	return &CursorOpenError{
-		NewBaseError(cursor, fmt.Sprintf(errorCursorOpen, cursor)),
+		NewBaseError(cursor, errorMessage(errorCursorOpen, cursor)),
 	}
 }
 
@@ -645,7 +1022,7 @@ type PseudoCursorError struct {
 
 func NewPseudoCursorError(cursor parser.Identifier) error {
 	return &PseudoCursorError{
-		NewBaseError(cursor, fmt.Sprintf(ErrorPseudoCursor, cursor)),
+		NewBaseError(cursor, errorMessage(ErrorPseudoCursor, cursor)),
 	}
 }
 
@@ -655,7 +1032,7 @@ type CursorFetchLengthError struct {
 
 func NewCursorFetchLengthError(cursor parser.Identifier, returnLen int) error {
 	return &CursorFetchLengthError{
-		NewBaseError(cursor, fmt.Sprintf(ErrorCursorFetchLength, cursor, FormatCount(returnLen, "value"))),
+		NewBaseError(cursor, errorMessage(ErrorCursorFetchLength, cursor, FormatCount(returnLen, "value"))),
 	}
 }
 
@@ -665,7 +1042,17 @@ type InvalidFetchPositionError struct {
 
 func NewInvalidFetchPositionError(position parser.FetchPosition) error {
 	return &InvalidFetchPositionError{
-		NewBaseError(position, fmt.Sprintf(ErrorInvalidFetchPosition, position.Number)),
+		NewBaseError(position, errorMessage(ErrorInvalidFetchPosition, position.Number)),
+	}
+}
+
+type InvalidFetchBulkSizeError struct {
+	*BaseError
+}
+
+func NewInvalidFetchBulkSizeError(size parser.QueryExpression) error {
+	return &InvalidFetchBulkSizeError{
+		NewBaseError(size, errorMessage(ErrorInvalidFetchBulkSize, size)),
 	}
 }
 
@@ -675,7 +1062,7 @@ type InLineTableRedefinedError struct {
 
 func NewInLineTableRedefinedError(table parser.Identifier) error {
 	return &InLineTableRedefinedError{
-		NewBaseError(table, fmt.Sprintf(ErrorInlineTableRedefined, table)),
+		NewBaseError(table, errorMessage(ErrorInlineTableRedefined, table)),
 	}
 }
 
@@ -685,7 +1072,7 @@ type UndefinedInLineTableError struct {
 
 func NewUndefinedInLineTableError(table parser.Identifier) error {
 	return &UndefinedInLineTableError{
-		NewBaseError(table, fmt.Sprintf(ErrorUndefinedInlineTable, table)),
+		NewBaseError(table, errorMessage(ErrorUndefinedInlineTable, table)),
 	}
 }
 
@@ -697,7 +1084,7 @@ func NewInlineTableFieldLengthError(query parser.SelectQuery, table parser.Ident
 	selectClause := searchSelectClause(query)
 
 	return &InlineTableFieldLengthError{
-		NewBaseError(selectClause, fmt.Sprintf(ErrorInlineTableFieldLength, FormatCount(fieldLen, "field"), table)),
+		NewBaseError(selectClause, errorMessage(ErrorInlineTableFieldLength, FormatCount(fieldLen, "field"), table)),
 	}
 }
 
@@ -707,7 +1094,7 @@ type FileNotExistError struct {
 
 func NewFileNotExistError(file parser.Identifier) error {
 	return &FileNotExistError{
-		NewBaseError(file, fmt.Sprintf(ErrorFileNotExist, file)),
+		NewBaseError(file, errorMessage(ErrorFileNotExist, file)),
 	}
 }
 
@@ -717,7 +1104,7 @@ type FileAlreadyExistError struct {
 
 func NewFileAlreadyExistError(file parser.Identifier) error {
 	return &FileAlreadyExistError{
-		NewBaseError(file, fmt.Sprintf(ErrorFileAlreadyExist, file)),
+		NewBaseError(file, errorMessage(ErrorFileAlreadyExist, file)),
 	}
 }
 
@@ -727,7 +1114,7 @@ type FileUnableToReadError struct {
 
 func NewFileUnableToReadError(file parser.Identifier) error {
 	return &FileUnableToReadError{
-		NewBaseError(file, fmt.Sprintf(ErrorFileUnableToRead, file)),
+		NewBaseError(file, errorMessage(ErrorFileUnableToRead, file)),
 	}
 }
 
@@ -737,7 +1124,7 @@ type FileLockTimeoutError struct {
 
 func NewFileLockTimeoutError(file parser.Identifier, path string) error {
 	return &FileLockTimeoutError{
-		NewBaseError(file, fmt.Sprintf(ErrorFileLockTimeout, path)),
+		NewBaseError(file, errorMessage(ErrorFileLockTimeout, path)),
 	}
 }
 
@@ -747,7 +1134,7 @@ type FileNameAmbiguousError struct {
 
 func NewFileNameAmbiguousError(file parser.Identifier) error {
 	return &FileNameAmbiguousError{
-		NewBaseError(file, fmt.Sprintf(ErrorFileNameAmbiguous, file)),
+		NewBaseError(file, errorMessage(ErrorFileNameAmbiguous, file)),
 	}
 }
 
@@ -757,7 +1144,7 @@ type DataParsingError struct {
 
 func NewDataParsingError(file parser.QueryExpression, filepath string, message string) error {
 	return &DataParsingError{
-		NewBaseError(file, fmt.Sprintf(ErrorDataParsing, filepath, message)),
+		NewBaseError(file, errorMessage(ErrorDataParsing, filepath, message)),
 	}
 }
 
@@ -769,7 +1156,7 @@ func NewTableFieldLengthError(query parser.SelectQuery, table parser.Identifier,
 	selectClause := searchSelectClause(query)
 
 	return &TableFieldLengthError{
-		NewBaseError(selectClause, fmt.Sprintf(ErrorTableFieldLength, FormatCount(fieldLen, "field"), table)),
+		NewBaseError(selectClause, errorMessage(ErrorTableFieldLength, FormatCount(fieldLen, "field"), table)),
 	}
 }
 
@@ -779,7 +1166,7 @@ type TemporaryTableRedeclaredError struct {
 
 func NewTemporaryTableRedeclaredError(table parser.Identifier) error {
 	return &TemporaryTableRedeclaredError{
-		NewBaseError(table, fmt.Sprintf(ErrorTemporaryTableRedeclared, table)),
+		NewBaseError(table, errorMessage(ErrorTemporaryTableRedeclared, table)),
 	}
 }
 
@@ -789,7 +1176,7 @@ type UndeclaredTemporaryTableError struct {
 
 func NewUndeclaredTemporaryTableError(table parser.Identifier) error {
 	return &UndeclaredTemporaryTableError{
-		NewBaseError(table, fmt.Sprintf(ErrorUndeclaredTemporaryTable, table)),
+		NewBaseError(table, errorMessage(ErrorUndeclaredTemporaryTable, table)),
 	}
 }
 
@@ -801,7 +1188,42 @@ func NewTemporaryTableFieldLengthError(query parser.SelectQuery, table parser.Id
 	selectClause := searchSelectClause(query)
 
 	return &TemporaryTableFieldLengthError{
-		NewBaseError(selectClause, fmt.Sprintf(ErrorTemporaryTableFieldLength, FormatCount(fieldLen, "field"), table)),
+		NewBaseError(selectClause, errorMessage(ErrorTemporaryTableFieldLength, FormatCount(fieldLen, "field"), table)),
+	}
+}
+
+type CheckConstraintViolationError struct {
+	*BaseError
+}
+
+func NewCheckConstraintViolationError(expr parser.QueryExpression, constraint parser.CheckConstraint, table string, record Record) error {
+	return &CheckConstraintViolationError{
+		NewBaseError(expr, errorMessage(ErrorCheckConstraintViolation, constraint, table, listRecordValues(record))),
+	}
+}
+
+type NotNullConstraintViolationError struct {
+	*BaseError
+}
+
+func NewNotNullConstraintViolationError(expr parser.QueryExpression, constraint parser.NotNullConstraint, table string, record Record) error {
+	return &NotNullConstraintViolationError{
+		NewBaseError(expr, errorMessage(ErrorNotNullConstraintViolation, constraint, constraint.Column, table, listRecordValues(record))),
+	}
+}
+
+type UniqueConstraintViolationError struct {
+	*BaseError
+}
+
+func NewUniqueConstraintViolationError(expr parser.QueryExpression, constraint parser.UniqueConstraint, table string, values []value.Primary) error {
+	list := make([]string, len(values))
+	for i, v := range values {
+		list[i] = v.String()
+	}
+
+	return &UniqueConstraintViolationError{
+		NewBaseError(expr, errorMessage(ErrorUniqueConstraintViolation, constraint, table, strings.Join(list, ", "))),
 	}
 }
 
@@ -811,7 +1233,27 @@ type DuplicateTableNameError struct {
 
 func NewDuplicateTableNameError(table parser.Identifier) error {
 	return &DuplicateTableNameError{
-		NewBaseError(table, fmt.Sprintf(ErrorDuplicateTableName, table)),
+		NewBaseError(table, errorMessage(ErrorDuplicateTableName, table)),
+	}
+}
+
+type InvalidColumnTypeError struct {
+	*BaseError
+}
+
+func NewInvalidColumnTypeError(columnType parser.Identifier) error {
+	return &InvalidColumnTypeError{
+		NewBaseError(columnType, errorMessage(ErrorInvalidColumnType, columnType)),
+	}
+}
+
+type ColumnTypeConversionError struct {
+	*BaseError
+}
+
+func NewColumnTypeConversionError(columnType parser.Identifier, field string, rows []int) error {
+	return &ColumnTypeConversionError{
+		NewBaseError(columnType, errorMessage(ErrorColumnTypeConversion, field, strings.ToUpper(columnType.Literal), formatNumbers("row", rows))),
 	}
 }
 
@@ -821,7 +1263,7 @@ type TableNotLoadedError struct {
 
 func NewTableNotLoadedError(table parser.Identifier) error {
 	return &TableNotLoadedError{
-		NewBaseError(table, fmt.Sprintf(ErrorTableNotLoaded, table)),
+		NewBaseError(table, errorMessage(ErrorTableNotLoaded, table)),
 	}
 }
 
@@ -831,7 +1273,7 @@ type StdinEmptyError struct {
 
 func NewStdinEmptyError(stdin parser.Stdin) error {
 	return &StdinEmptyError{
-		NewBaseError(stdin, ErrorStdinEmpty),
+		NewBaseError(stdin, cmd.Message(ErrorStdinEmpty)),
 	}
 }
 
@@ -841,7 +1283,7 @@ type RowValueLengthInComparisonError struct {
 
 func NewRowValueLengthInComparisonError(expr parser.QueryExpression, valueLen int) error {
 	return &RowValueLengthInComparisonError{
-		NewBaseError(expr, fmt.Sprintf(ErrorRowValueLengthInComparison, FormatCount(valueLen, "value"))),
+		NewBaseError(expr, errorMessage(ErrorRowValueLengthInComparison, FormatCount(valueLen, "value"))),
 	}
 }
 
@@ -851,7 +1293,7 @@ type SelectFieldLengthInComparisonError struct {
 
 func NewSelectFieldLengthInComparisonError(query parser.Subquery, valueLen int) error {
 	return &SelectFieldLengthInComparisonError{
-		NewBaseError(query, fmt.Sprintf(ErrorFieldLengthInComparison, FormatCount(valueLen, "field"))),
+		NewBaseError(query, errorMessage(ErrorFieldLengthInComparison, FormatCount(valueLen, "field"))),
 	}
 }
 
@@ -861,7 +1303,7 @@ type InvalidLimitPercentageError struct {
 
 func NewInvalidLimitPercentageError(clause parser.LimitClause) error {
 	return &InvalidLimitPercentageError{
-		NewBaseError(clause, fmt.Sprintf(ErrorInvalidLimitPercentage, clause.Value)),
+		NewBaseError(clause, errorMessage(ErrorInvalidLimitPercentage, clause.Value)),
 	}
 }
 
@@ -871,7 +1313,7 @@ type InvalidLimitNumberError struct {
 
 func NewInvalidLimitNumberError(clause parser.LimitClause) error {
 	return &InvalidLimitNumberError{
-		NewBaseError(clause, fmt.Sprintf(ErrorInvalidLimitNumber, clause.Value)),
+		NewBaseError(clause, errorMessage(ErrorInvalidLimitNumber, clause.Value)),
 	}
 }
 
@@ -881,7 +1323,7 @@ type InvalidOffsetNumberError struct {
 
 func NewInvalidOffsetNumberError(clause parser.OffsetClause) error {
 	return &InvalidOffsetNumberError{
-		NewBaseError(clause, fmt.Sprintf(ErrorInvalidOffsetNumber, clause.Value)),
+		NewBaseError(clause, errorMessage(ErrorInvalidOffsetNumber, clause.Value)),
 	}
 }
 
@@ -893,7 +1335,7 @@ func NewCombinedSetFieldLengthError(selectEntity parser.QueryExpression, fieldLe
 	selectClause := searchSelectClauseInSelectEntity(selectEntity)
 
 	return &CombinedSetFieldLengthError{
-		NewBaseError(selectClause, fmt.Sprintf(ErrorCombinedSetFieldLength, FormatCount(fieldLen, "field"))),
+		NewBaseError(selectClause, errorMessage(ErrorCombinedSetFieldLength, FormatCount(fieldLen, "field"))),
 	}
 }
 
@@ -903,7 +1345,7 @@ type InsertRowValueLengthError struct {
 
 func NewInsertRowValueLengthError(rowValue parser.RowValue, valueLen int) error {
 	return &InsertRowValueLengthError{
-		NewBaseError(rowValue, fmt.Sprintf(ErrorInsertRowValueLength, FormatCount(valueLen, "value"))),
+		NewBaseError(rowValue, errorMessage(ErrorInsertRowValueLength, FormatCount(valueLen, "value"))),
 	}
 }
 
@@ -915,7 +1357,7 @@ func NewInsertSelectFieldLengthError(query parser.SelectQuery, fieldLen int) err
 	selectClause := searchSelectClause(query)
 
 	return &InsertSelectFieldLengthError{
-		NewBaseError(selectClause, fmt.Sprintf(ErrorInsertSelectFieldLength, FormatCount(fieldLen, "field"))),
+		NewBaseError(selectClause, errorMessage(ErrorInsertSelectFieldLength, FormatCount(fieldLen, "field"))),
 	}
 }
 
@@ -925,7 +1367,7 @@ type UpdateFieldNotExistError struct {
 
 func NewUpdateFieldNotExistError(field parser.QueryExpression) error {
 	return &UpdateFieldNotExistError{
-		NewBaseError(field, fmt.Sprintf(ErrorUpdateFieldNotExist, field)),
+		NewBaseError(field, errorMessage(ErrorUpdateFieldNotExist, field)),
 	}
 }
 
@@ -935,7 +1377,7 @@ type UpdateValueAmbiguousError struct {
 
 func NewUpdateValueAmbiguousError(field parser.QueryExpression, value parser.QueryExpression) error {
 	return &UpdateValueAmbiguousError{
-		NewBaseError(field, fmt.Sprintf(ErrorUpdateValueAmbiguous, value, field)),
+		NewBaseError(field, errorMessage(ErrorUpdateValueAmbiguous, value, field)),
 	}
 }
 
@@ -945,7 +1387,7 @@ type DeleteTableNotSpecifiedError struct {
 
 func NewDeleteTableNotSpecifiedError(query parser.DeleteQuery) error {
 	return &DeleteTableNotSpecifiedError{
-		NewBaseError(query, ErrorDeleteTableNotSpecified),
+		NewBaseError(query, cmd.Message(ErrorDeleteTableNotSpecified)),
 	}
 }
 
@@ -955,7 +1397,7 @@ type ShowInvalidObjectTypeError struct {
 
 func NewShowInvalidObjectTypeError(expr parser.Expression, objectType string) error {
 	return &ShowInvalidObjectTypeError{
-		NewBaseError(expr, fmt.Sprintf(ErrorShowInvalidObjectType, objectType)),
+		NewBaseError(expr, errorMessage(ErrorShowInvalidObjectType, objectType)),
 	}
 }
 
@@ -965,7 +1407,7 @@ type ReplaceValueLengthError struct {
 
 func NewReplaceValueLengthError(expr parser.Expression, message string) error {
 	return &ReplaceValueLengthError{
-		NewBaseError(expr, fmt.Sprintf(ErrorReplaceValueLength, message)),
+		NewBaseError(expr, errorMessage(ErrorReplaceValueLength, message)),
 	}
 }
 
@@ -975,7 +1417,7 @@ type SourceInvalidFilePathError struct {
 
 func NewSourceInvalidFilePathError(source parser.Source, arg parser.QueryExpression) error {
 	return &SourceInvalidFilePathError{
-		NewBaseError(source, fmt.Sprintf(ErrorSourceInvalidFilePath, arg)),
+		NewBaseError(source, errorMessage(ErrorSourceInvalidFilePath, arg)),
 	}
 }
 
@@ -985,7 +1427,37 @@ type SourceFileNotExistError struct {
 
 func NewSourceFileNotExistError(source parser.Source, fpath string) error {
 	return &SourceFileNotExistError{
-		NewBaseError(source, fmt.Sprintf(ErrorSourceFileNotExist, fpath)),
+		NewBaseError(source, errorMessage(ErrorSourceFileNotExist, fpath)),
+	}
+}
+
+type ImportInvalidModuleNameError struct {
+	*BaseError
+}
+
+func NewImportInvalidModuleNameError(expr parser.Import, arg parser.QueryExpression) error {
+	return &ImportInvalidModuleNameError{
+		NewBaseError(expr, errorMessage(ErrorImportInvalidModuleName, arg)),
+	}
+}
+
+type ModuleNotFoundError struct {
+	*BaseError
+}
+
+func NewModuleNotFoundError(expr parser.Import, name string) error {
+	return &ModuleNotFoundError{
+		NewBaseError(expr, errorMessage(ErrorModuleNotFound, name)),
+	}
+}
+
+type CopyInvalidFilePathError struct {
+	*BaseError
+}
+
+func NewCopyInvalidFilePathError(expr parser.Copy, arg parser.QueryExpression) error {
+	return &CopyInvalidFilePathError{
+		NewBaseError(expr, errorMessage(ErrorCopyInvalidFilePath, arg)),
 	}
 }
 
@@ -995,7 +1467,7 @@ type InvalidFlagNameError struct {
 
 func NewInvalidFlagNameError(expr parser.Expression, name string) error {
 	return &InvalidFlagNameError{
-		NewBaseError(expr, fmt.Sprintf(ErrorInvalidFlagName, cmd.FlagSymbol(name))),
+		NewBaseError(expr, errorMessage(ErrorInvalidFlagName, cmd.FlagSymbol(name))),
 	}
 }
 
@@ -1005,7 +1477,27 @@ type InvalidRuntimeInformationError struct {
 
 func NewInvalidRuntimeInformationError(expr parser.RuntimeInformation) error {
 	return &InvalidRuntimeInformationError{
-		NewBaseError(expr, fmt.Sprintf(ErrorInvalidRuntimeInformation, expr)),
+		NewBaseError(expr, errorMessage(ErrorInvalidRuntimeInformation, expr)),
+	}
+}
+
+type FetchLatestVersionError struct {
+	*BaseError
+}
+
+func NewFetchLatestVersionError(expr parser.RuntimeInformation, message string) error {
+	return &FetchLatestVersionError{
+		NewBaseError(expr, errorMessage(ErrorFetchLatestVersion, message)),
+	}
+}
+
+type InvalidTimeZoneError struct {
+	*BaseError
+}
+
+func NewInvalidTimeZoneError(expr parser.QueryExpression, timezone string) error {
+	return &InvalidTimeZoneError{
+		NewBaseError(expr, errorMessage(ErrorInvalidTimeZone, timezone)),
 	}
 }
 
@@ -1015,7 +1507,7 @@ type FlagValueNotAllowedFormatError struct {
 
 func NewFlagValueNotAllowedFormatError(setFlag parser.SetFlag) error {
 	return &FlagValueNotAllowedFormatError{
-		NewBaseError(setFlag, fmt.Sprintf(ErrorFlagValueNowAllowedFormat, setFlag.Value, cmd.FlagSymbol(setFlag.Name))),
+		NewBaseError(setFlag, errorMessage(ErrorFlagValueNowAllowedFormat, setFlag.Value, cmd.FlagSymbol(setFlag.Name))),
 	}
 }
 
@@ -1025,7 +1517,7 @@ type InvalidFlagValueError struct {
 
 func NewInvalidFlagValueError(expr parser.SetFlag, message string) error {
 	return &InvalidFlagValueError{
-		NewBaseError(expr, fmt.Sprintf(ErrorInvalidFlagValue, message)),
+		NewBaseError(expr, errorMessage(ErrorInvalidFlagValue, message)),
 	}
 }
 
@@ -1035,7 +1527,7 @@ type AddFlagNotSupportedNameError struct {
 
 func NewAddFlagNotSupportedNameError(expr parser.AddFlagElement) error {
 	return &AddFlagNotSupportedNameError{
-		NewBaseError(expr, fmt.Sprintf(ErrorAddFlagNotSupportedName, cmd.FlagSymbol(expr.Name))),
+		NewBaseError(expr, errorMessage(ErrorAddFlagNotSupportedName, cmd.FlagSymbol(expr.Name))),
 	}
 }
 
@@ -1045,7 +1537,7 @@ type RemoveFlagNotSupportedNameError struct {
 
 func NewRemoveFlagNotSupportedNameError(expr parser.RemoveFlagElement) error {
 	return &RemoveFlagNotSupportedNameError{
-		NewBaseError(expr, fmt.Sprintf(ErrorRemoveFlagNotSupportedName, cmd.FlagSymbol(expr.Name))),
+		NewBaseError(expr, errorMessage(ErrorRemoveFlagNotSupportedName, cmd.FlagSymbol(expr.Name))),
 	}
 }
 
@@ -1055,7 +1547,7 @@ type InvalidFlagValueToBeRemoveError struct {
 
 func NewInvalidFlagValueToBeRemovedError(unsetFlag parser.RemoveFlagElement) error {
 	return &InvalidFlagValueToBeRemoveError{
-		NewBaseError(unsetFlag, fmt.Sprintf(ErrorInvalidFlagValueToBeRemoved, unsetFlag.Value, cmd.FlagSymbol(unsetFlag.Name))),
+		NewBaseError(unsetFlag, errorMessage(ErrorInvalidFlagValueToBeRemoved, unsetFlag.Value, cmd.FlagSymbol(unsetFlag.Name))),
 	}
 }
 
@@ -1065,7 +1557,7 @@ type NotTableError struct {
 
 func NewNotTableError(expr parser.QueryExpression) error {
 	return &NotTableError{
-		NewBaseError(expr, ErrorNotTable),
+		NewBaseError(expr, cmd.Message(ErrorNotTable)),
 	}
 }
 
@@ -1075,7 +1567,7 @@ type InvalidTableAttributeNameError struct {
 
 func NewInvalidTableAttributeNameError(expr parser.Identifier) error {
 	return &InvalidTableAttributeNameError{
-		NewBaseError(expr, fmt.Sprintf(ErrorInvalidTableAttributeName, expr)),
+		NewBaseError(expr, errorMessage(ErrorInvalidTableAttributeName, expr)),
 	}
 }
 
@@ -1085,7 +1577,7 @@ type TableAttributeValueNotAllowedFormatError struct {
 
 func NewTableAttributeValueNotAllowedFormatError(expr parser.SetTableAttribute) error {
 	return &TableAttributeValueNotAllowedFormatError{
-		NewBaseError(expr, fmt.Sprintf(ErrorTableAttributeValueNotAllowedFormat, expr.Value, expr.Attribute)),
+		NewBaseError(expr, errorMessage(ErrorTableAttributeValueNotAllowedFormat, expr.Value, expr.Attribute)),
 	}
 }
 
@@ -1095,7 +1587,7 @@ type InvalidTableAttributeValueError struct {
 
 func NewInvalidTableAttributeValueError(expr parser.SetTableAttribute, message string) error {
 	return &InvalidTableAttributeValueError{
-		NewBaseError(expr, fmt.Sprintf(ErrorInvalidTableAttributeValue, message)),
+		NewBaseError(expr, errorMessage(ErrorInvalidTableAttributeValue, message)),
 	}
 }
 
@@ -1105,7 +1597,55 @@ type InvalidEventNameError struct {
 
 func NewInvalidEventNameError(expr parser.Identifier) error {
 	return &InvalidEventNameError{
-		NewBaseError(expr, fmt.Sprintf(ErrorInvalidEventName, expr)),
+		NewBaseError(expr, errorMessage(ErrorInvalidEventName, expr)),
+	}
+}
+
+// AssertionFailedCode is the exit code of the error returned when an ASSERT or EXPECT statement fails.
+const AssertionFailedCode = 2
+
+type AssertionFailedError struct {
+	*BaseError
+}
+
+func NewAssertionFailedError(expr parser.Assert, message string) error {
+	if len(message) < 1 {
+		message = errorMessage(ErrorAssertionFailed, expr.Condition)
+	}
+	return &AssertionFailedError{
+		NewBaseErrorWithCode(expr, message, AssertionFailedCode),
+	}
+}
+
+type ExpectationFailedError struct {
+	*BaseError
+}
+
+func NewExpectedFieldsNotMatchError(expr parser.Expect, fields []string, expected []string) error {
+	return &ExpectationFailedError{
+		NewBaseErrorWithCode(expr, errorMessage(ErrorExpectedFieldsNotMatch, "("+strings.Join(fields, ", ")+")", "("+strings.Join(expected, ", ")+")"), AssertionFailedCode),
+	}
+}
+
+func NewExpectedRecordCountNotMatchError(expr parser.Expect, count int, expected int) error {
+	return &ExpectationFailedError{
+		NewBaseErrorWithCode(expr, errorMessage(ErrorExpectedRecordCountNotMatch, FormatCount(count, "record"), FormatCount(expected, "record")), AssertionFailedCode),
+	}
+}
+
+func NewExpectedRecordNotMatchError(expr parser.Expect, recordNumber int, record string, expected string) error {
+	return &ExpectationFailedError{
+		NewBaseErrorWithCode(expr, errorMessage(ErrorExpectedRecordNotMatch, recordNumber, record, expected), AssertionFailedCode),
+	}
+}
+
+type UndeclaredLabelError struct {
+	*BaseError
+}
+
+func NewUndeclaredLabelError(label parser.Identifier) error {
+	return &UndeclaredLabelError{
+		NewBaseError(label, errorMessage(ErrorUndeclaredLabel, label)),
 	}
 }
 
@@ -1115,7 +1655,7 @@ type InternalRecordIdNotExistError struct {
 
 func NewInternalRecordIdNotExistError() error {
 	return &InternalRecordIdNotExistError{
-		NewBaseError(parser.NewNullValue(), ErrorInternalRecordIdNotExist),
+		NewBaseError(parser.NewNullValue(), cmd.Message(ErrorInternalRecordIdNotExist)),
 	}
 }
 
@@ -1125,7 +1665,7 @@ type InternalRecordIdEmptyError struct {
 
 func NewInternalRecordIdEmptyError() error {
 	return &InternalRecordIdEmptyError{
-		NewBaseError(parser.NewNullValue(), ErrorInternalRecordIdEmpty),
+		NewBaseError(parser.NewNullValue(), cmd.Message(ErrorInternalRecordIdEmpty)),
 	}
 }
 
@@ -1135,7 +1675,7 @@ type FieldLengthNotMatchError struct {
 
 func NewFieldLengthNotMatchError() error {
 	return &FieldLengthNotMatchError{
-		NewBaseError(parser.NewNullValue(), ErrorFieldLengthNotMatch),
+		NewBaseError(parser.NewNullValue(), cmd.Message(ErrorFieldLengthNotMatch)),
 	}
 }
 
@@ -1146,7 +1686,7 @@ type RowValueLengthInListError struct {
 
 func NewRowValueLengthInListError(i int) error {
 	return &RowValueLengthInListError{
-		BaseError: NewBaseError(parser.NewNullValue(), fmt.Sprintf(ErrorRowValueLengthInList, i)),
+		BaseError: NewBaseError(parser.NewNullValue(), errorMessage(ErrorRowValueLengthInList, i)),
 		Index:     i,
 	}
 }
@@ -1157,7 +1697,7 @@ type FormatStringLengthNotMatchError struct {
 
 func NewFormatStringLengthNotMatchError() error {
 	return &FormatStringLengthNotMatchError{
-		BaseError: NewBaseError(parser.NewNullValue(), ErrorFormatStringLengthNotMatch),
+		BaseError: NewBaseError(parser.NewNullValue(), cmd.Message(ErrorFormatStringLengthNotMatch)),
 	}
 }
 
@@ -1167,7 +1707,7 @@ type UnknownFormatPlaceholderError struct {
 
 func NewUnknownFormatPlaceholderError(placeholder rune) error {
 	return &UnknownFormatPlaceholderError{
-		BaseError: NewBaseError(parser.NewNullValue(), fmt.Sprintf(ErrorUnknownFormatPlaceholder, string(placeholder))),
+		BaseError: NewBaseError(parser.NewNullValue(), errorMessage(ErrorUnknownFormatPlaceholder, string(placeholder))),
 	}
 }
 
@@ -1177,7 +1717,7 @@ type FormatUnexpectedTerminationError struct {
 
 func NewFormatUnexpectedTerminationError() error {
 	return &FormatUnexpectedTerminationError{
-		BaseError: NewBaseError(parser.NewNullValue(), ErrorFormatUnexpectedTermination),
+		BaseError: NewBaseError(parser.NewNullValue(), cmd.Message(ErrorFormatUnexpectedTermination)),
 	}
 }
 
@@ -1187,7 +1727,7 @@ type ExternalCommandError struct {
 
 func NewExternalCommandError(expr parser.Expression, message string) error {
 	return &ExternalCommandError{
-		NewBaseError(expr, fmt.Sprintf(ErrorExternalCommand, message)),
+		NewBaseError(expr, errorMessage(ErrorExternalCommand, message)),
 	}
 }
 
@@ -1197,7 +1737,7 @@ type InvalidReloadTypeError struct {
 
 func NewInvalidReloadTypeError(expr parser.Reload, name string) error {
 	return &InvalidReloadTypeError{
-		NewBaseError(expr, fmt.Sprintf(ErrorInvalidReloadType, name)),
+		NewBaseError(expr, errorMessage(ErrorInvalidReloadType, name)),
 	}
 }
 
@@ -1207,7 +1747,7 @@ type LoadConfigurationError struct {
 
 func NewLoadConfigurationError(expr parser.Reload, message string) error {
 	return &LoadConfigurationError{
-		NewBaseError(expr, fmt.Sprintf(ErrorLoadConfiguration, message)),
+		NewBaseError(expr, errorMessage(ErrorLoadConfiguration, message)),
 	}
 }
 
@@ -1228,3 +1768,11 @@ func searchSelectClauseInSelectSetEntity(selectSetEntity parser.QueryExpression)
 	}
 	return searchSelectClauseInSelectEntity(selectSetEntity)
 }
+
+func listRecordValues(record Record) string {
+	values := make([]string, len(record))
+	for i, cell := range record {
+		values[i] = cell.Value().String()
+	}
+	return strings.Join(values, ", ")
+}
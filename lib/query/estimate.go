@@ -0,0 +1,467 @@
+package query
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// EstimationSampleSize is the number of bytes read from the head of each file to estimate its size.
+var EstimationSampleSize = 64 * 1024
+
+const (
+	estimationSampleRecords = 100
+
+	equalitySelectivity = 0.1
+	defaultSelectivity  = 1.0 / 3
+	groupingRatio       = 0.1
+)
+
+// Estimation is the approximate size of the result set of a select query.
+type Estimation struct {
+	Rows  int64
+	Bytes int64
+}
+
+// estimate is the approximate size of the records output by a step.
+type estimate struct {
+	rows     float64
+	fields   int
+	rowBytes float64
+}
+
+func (e *estimate) fieldBytes() float64 {
+	if e.fields < 1 {
+		return 0
+	}
+	return e.rowBytes / float64(e.fields)
+}
+
+type estimator struct {
+	filter       *Filter
+	inlineTables map[string]*estimate
+}
+
+// EstimateQuery returns the approximate number of records and bytes of the result set of the select query.
+// Files are not loaded entirely. The number of records in a file is estimated from the records at the head
+// of the file, and the numbers of records filtered, grouped or joined are estimated by fixed ratios.
+func EstimateQuery(query parser.SelectQuery, filter *Filter) (*Estimation, error) {
+	e := &estimator{
+		filter:       filter.CreateNode(),
+		inlineTables: make(map[string]*estimate),
+	}
+	est, err := e.selectQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := math.Round(est.rows)
+	return &Estimation{
+		Rows:  int64(rows),
+		Bytes: int64(math.Round(rows * est.rowBytes)),
+	}, nil
+}
+
+func Estimate(expr parser.Estimate, filter *Filter) (string, error) {
+	estimation, err := EstimateQuery(expr.Query.(parser.SelectQuery), filter)
+	if err != nil {
+		return "", err
+	}
+
+	w := NewObjectWriter()
+	w.WriteColorWithoutLineBreak("Rows: ", cmd.LableEffect)
+	w.WriteWithoutLineBreak(cmd.FormatNumber(float64(estimation.Rows), 0, ".", ",", ""))
+	w.NewLine()
+	w.WriteColorWithoutLineBreak("Size: ", cmd.LableEffect)
+	w.WriteWithoutLineBreak(cmd.FormatNumber(float64(estimation.Bytes), 0, ".", ",", "") + " bytes")
+	w.NewLine()
+	w.Title1 = "Estimation"
+	return "\n" + w.String() + "\n", nil
+}
+
+func (e *estimator) selectQuery(query parser.SelectQuery) (*estimate, error) {
+	if query.WithClause != nil {
+		for _, v := range query.WithClause.(parser.WithClause).InlineTables {
+			it := v.(parser.InlineTable)
+			name := strings.ToUpper(it.Name.Literal)
+
+			// A recursive reference is estimated as an empty table.
+			e.inlineTables[name] = &estimate{fields: len(it.Fields)}
+
+			est, err := e.selectQuery(it.Query)
+			if err != nil {
+				return nil, err
+			}
+			if 0 < len(it.Fields) {
+				est.rowBytes = est.fieldBytes() * float64(len(it.Fields))
+				est.fields = len(it.Fields)
+			}
+			e.inlineTables[name] = est
+		}
+	}
+
+	est, err := e.selectEntity(query.SelectEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.OffsetClause != nil {
+		if n, ok := e.evalInteger(query.OffsetClause.(parser.OffsetClause).Value); ok {
+			est.rows = math.Max(est.rows-float64(n), 0)
+		}
+	}
+	if query.LimitClause != nil {
+		limit := query.LimitClause.(parser.LimitClause)
+		if limit.IsPercentage() {
+			if p, err := e.filter.Evaluate(limit.Value); err == nil {
+				if f := value.ToFloat(p); !value.IsNull(f) {
+					est.rows = est.rows * math.Min(math.Max(f.(value.Float).Raw(), 0), 100) / 100
+				}
+			}
+		} else if n, ok := e.evalInteger(limit.Value); ok {
+			est.rows = math.Min(est.rows, math.Max(float64(n), 0))
+		}
+	}
+	return est, nil
+}
+
+func (e *estimator) evalInteger(expr parser.QueryExpression) (int64, bool) {
+	p, err := e.filter.Evaluate(expr)
+	if err != nil {
+		return 0, false
+	}
+	i := value.ToInteger(p)
+	if value.IsNull(i) {
+		return 0, false
+	}
+	return i.(value.Integer).Raw(), true
+}
+
+func (e *estimator) selectEntity(expr parser.QueryExpression) (*estimate, error) {
+	switch expr.(type) {
+	case parser.SelectSet:
+		set := expr.(parser.SelectSet)
+		lhs, err := e.selectEntity(set.LHS)
+		if err != nil {
+			return nil, err
+		}
+		rhs, err := e.selectEntity(set.RHS)
+		if err != nil {
+			return nil, err
+		}
+
+		switch set.Operator.Token {
+		case parser.UNION:
+			lhs.rows = lhs.rows + rhs.rows
+		case parser.INTERSECT:
+			lhs.rows = math.Min(lhs.rows, rhs.rows)
+		}
+		return lhs, nil
+	case parser.Subquery:
+		return e.selectQuery(expr.(parser.Subquery).Query)
+	}
+
+	entity := expr.(parser.SelectEntity)
+	selectClause := entity.SelectClause.(parser.SelectClause)
+
+	est := &estimate{rows: 1}
+	if entity.FromClause != nil {
+		for i, v := range entity.FromClause.(parser.FromClause).Tables {
+			t, err := e.table(v)
+			if err != nil {
+				return nil, err
+			}
+			if i == 0 {
+				est = t
+			} else {
+				est = crossJoinEstimate(est, t)
+			}
+		}
+	}
+
+	if entity.WhereClause != nil {
+		est.rows = est.rows * conditionSelectivity(entity.WhereClause.(parser.WhereClause).Filter)
+	}
+
+	if entity.GroupByClause != nil {
+		if 1 < est.rows {
+			est.rows = math.Max(est.rows*groupingRatio, 1)
+		}
+	} else if entity.HavingClause != nil || containsAggregation(selectClause.Fields) {
+		est.rows = 1
+	}
+
+	if entity.HavingClause != nil {
+		est.rows = est.rows * conditionSelectivity(entity.HavingClause.(parser.HavingClause).Filter)
+	}
+
+	return projectEstimate(est, selectClause), nil
+}
+
+func (e *estimator) table(expr parser.QueryExpression) (*estimate, error) {
+	if parentheses, ok := expr.(parser.Parentheses); ok {
+		return e.table(parentheses.Expr)
+	}
+
+	table := expr.(parser.Table)
+	switch table.Object.(type) {
+	case parser.Join:
+		return e.join(table.Object.(parser.Join))
+	case parser.Subquery:
+		return e.selectQuery(table.Object.(parser.Subquery).Query)
+	case parser.Identifier:
+		ident := table.Object.(parser.Identifier)
+		if est, ok := e.inlineTables[strings.ToUpper(ident.Literal)]; ok {
+			return &estimate{rows: est.rows, fields: est.fields, rowBytes: est.rowBytes}, nil
+		}
+		if view, err := e.filter.InlineTables.Get(ident); err == nil {
+			return viewEstimate(view), nil
+		}
+		for _, m := range e.filter.TempViews {
+			if view, ok := m[strings.ToUpper(ident.Literal)]; ok {
+				return viewEstimate(view), nil
+			}
+		}
+		return e.file(table, ident)
+	}
+	return e.load(table)
+}
+
+func (e *estimator) join(join parser.Join) (*estimate, error) {
+	lhs, err := e.table(join.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := lateralTable(join.JoinTable); ok {
+		return &estimate{rows: lhs.rows, fields: lhs.fields + 1, rowBytes: lhs.rowBytes + lhs.fieldBytes()}, nil
+	}
+
+	rhs, err := e.table(join.JoinTable)
+	if err != nil {
+		return nil, err
+	}
+
+	est := crossJoinEstimate(lhs, rhs)
+	if join.JoinType.Token == parser.CROSS {
+		return est, nil
+	}
+
+	if !join.Natural.IsEmpty() {
+		est.rows = math.Max(lhs.rows, rhs.rows)
+	} else if join.Condition != nil {
+		condition := join.Condition.(parser.JoinCondition)
+		if condition.On == nil || isEquiJoinCondition(condition.On) {
+			est.rows = math.Max(lhs.rows, rhs.rows)
+		} else {
+			est.rows = est.rows * conditionSelectivity(condition.On)
+		}
+	}
+
+	switch join.Direction.Token {
+	case parser.LEFT:
+		est.rows = math.Max(est.rows, lhs.rows)
+	case parser.RIGHT:
+		est.rows = math.Max(est.rows, rhs.rows)
+	case parser.FULL:
+		est.rows = math.Max(est.rows, lhs.rows+rhs.rows)
+	}
+	return est, nil
+}
+
+// file estimates the size of the file from the records at the head of it.
+func (e *estimator) file(table parser.Table, ident parser.Identifier) (*estimate, error) {
+	flags := cmd.GetFlags()
+
+	importFormat := cmd.AutoSelect
+	delimiter := flags.Delimiter
+	delimiterPositions := flags.DelimiterPositions
+	encoding := flags.Encoding
+	noHeader := flags.NoHeader
+	withoutNull := flags.WithoutNull
+	if t, ok := flags.TableCatalog().Get(ident.Literal); ok {
+		ident = parser.Identifier{BaseExpr: ident.BaseExpr, Literal: t.Path, Quoted: ident.Quoted}
+		importFormat = t.ImportFormat()
+		if 0 < len(t.Delimiter) {
+			delimiter, delimiterPositions = t.ParsedDelimiter()
+		}
+		if 0 < len(t.Encoding) {
+			encoding = t.ParsedEncoding()
+		}
+		if t.NoHeader != nil {
+			noHeader = *t.NoHeader
+		}
+		if t.WithoutNull != nil {
+			withoutNull = *t.WithoutNull
+		}
+	}
+
+	if fpath, err := CreateFilePath(ident, flags.Repository); err == nil {
+		if view, ok := ViewCache[strings.ToUpper(fpath)]; ok {
+			return viewEstimate(view), nil
+		}
+	}
+
+	fileInfo, err := NewFileInfo(ident, flags.Repository, importFormat, delimiter, encoding)
+	if err != nil {
+		return nil, err
+	}
+	if fileInfo.Format == cmd.JSON {
+		return e.load(table)
+	}
+	fileInfo.DelimiterPositions = delimiterPositions
+	fileInfo.NoHeader = noHeader
+
+	stat, err := os.Stat(fileInfo.Path)
+	if err != nil {
+		return nil, NewReadFileError(ident, err.Error())
+	}
+
+	h, err := file.NewHandlerForRead(fileInfo.Path)
+	if err != nil {
+		if _, ok := err.(*file.TimeoutError); ok {
+			return nil, NewFileLockTimeoutError(ident, fileInfo.Path)
+		}
+		return nil, NewReadFileError(ident, err.Error())
+	}
+	defer h.Close()
+
+	buf := make([]byte, EstimationSampleSize)
+	n, err := io.ReadFull(h.FileForRead(), buf)
+	isPartial := err == nil && int64(n) < stat.Size()
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, NewReadFileError(ident, err.Error())
+	}
+	buf = buf[:n]
+	if isPartial {
+		// The last record may be incomplete.
+		if i := bytes.LastIndexByte(buf, '\n'); 0 <= i {
+			buf = buf[:i+1]
+		}
+	}
+
+	view, err := loadViewFromFile(bytes.NewReader(buf), fileInfo, withoutNull)
+	if err != nil {
+		if isPartial {
+			return e.load(table)
+		}
+		return nil, NewDataParsingError(ident, fileInfo.Path, err.Error())
+	}
+
+	est := viewEstimate(view)
+	if isPartial && 0 < len(buf) {
+		est.rows = est.rows * float64(stat.Size()) / float64(len(buf))
+	}
+	return est, nil
+}
+
+// load loads the table to estimate the size of the table that cannot be sampled.
+func (e *estimator) load(table parser.Table) (*estimate, error) {
+	view, err := loadView(table, e.filter.CreateNode(), false, false)
+	if err != nil {
+		return nil, err
+	}
+	return viewEstimate(view), nil
+}
+
+// viewEstimate returns the size of the view measured from the records at the head of it.
+func viewEstimate(view *View) *estimate {
+	est := &estimate{
+		rows:   float64(view.RecordLen()),
+		fields: view.FieldLen(),
+	}
+
+	n := view.RecordLen()
+	if estimationSampleRecords < n {
+		n = estimationSampleRecords
+	}
+	if n < 1 {
+		est.rowBytes = float64(est.fields)
+		return est
+	}
+
+	var total int
+	for _, record := range view.RecordSet[:n] {
+		for _, cell := range record {
+			total = total + len(estimationFieldString(cell.Value())) + 1
+		}
+	}
+	est.rowBytes = float64(total) / float64(n)
+	return est
+}
+
+func estimationFieldString(p value.Primary) string {
+	if value.IsNull(p) {
+		return ""
+	}
+	if s := value.ToString(p); !value.IsNull(s) {
+		return s.(value.String).Raw()
+	}
+	return p.String()
+}
+
+func crossJoinEstimate(lhs *estimate, rhs *estimate) *estimate {
+	return &estimate{
+		rows:     lhs.rows * rhs.rows,
+		fields:   lhs.fields + rhs.fields,
+		rowBytes: lhs.rowBytes + rhs.rowBytes,
+	}
+}
+
+func projectEstimate(est *estimate, clause parser.SelectClause) *estimate {
+	fields := 0
+	for _, v := range clause.Fields {
+		f := v.(parser.Field)
+		switch f.Object.(type) {
+		case parser.AllColumns:
+			fields = fields + est.fields
+		case parser.FieldReference:
+			if f.Object.(parser.FieldReference).Column.Literal == "*" {
+				fields = fields + est.fields
+			} else {
+				fields++
+			}
+		default:
+			fields++
+		}
+	}
+
+	fieldBytes := est.fieldBytes()
+	if est.fields < 1 {
+		fieldBytes = 1
+	}
+	return &estimate{
+		rows:     est.rows,
+		fields:   fields,
+		rowBytes: fieldBytes * float64(fields),
+	}
+}
+
+// conditionSelectivity returns the estimated ratio of the records that satisfy the condition.
+func conditionSelectivity(condition parser.QueryExpression) float64 {
+	selectivity := 1.0
+	for _, expr := range splitConjunction(condition) {
+		if comparison, ok := expr.(parser.Comparison); ok && (comparison.Operator == "=" || comparison.Operator == "==") {
+			selectivity = selectivity * equalitySelectivity
+		} else {
+			selectivity = selectivity * defaultSelectivity
+		}
+	}
+	return selectivity
+}
+
+func isEquiJoinCondition(condition parser.QueryExpression) bool {
+	for _, expr := range splitConjunction(condition) {
+		if comparison, ok := expr.(parser.Comparison); ok && comparison.Operator == "=" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var estimateQueryTests = []struct {
+	Name       string
+	Query      string
+	SampleSize int
+	Result     *Estimation
+	Error      string
+}{
+	{
+		Name:   "Estimate File",
+		Query:  "SELECT * FROM table1",
+		Result: &Estimation{Rows: 3, Bytes: 21},
+	},
+	{
+		Name:       "Estimate File From Sample",
+		Query:      "SELECT * FROM table1",
+		SampleSize: 30,
+		Result:     &Estimation{Rows: 2, Bytes: 14},
+	},
+	{
+		Name:   "Estimate Filter",
+		Query:  "SELECT column1 FROM table1 WHERE column1 > 1",
+		Result: &Estimation{Rows: 1, Bytes: 4},
+	},
+	{
+		Name:   "Estimate Aggregation",
+		Query:  "SELECT COUNT(*) FROM table1",
+		Result: &Estimation{Rows: 1, Bytes: 4},
+	},
+	{
+		Name:   "Estimate Cross Join",
+		Query:  "SELECT * FROM table1 CROSS JOIN table2",
+		Result: &Estimation{Rows: 9, Bytes: 135},
+	},
+	{
+		Name:   "Estimate Equi Join",
+		Query:  "SELECT * FROM table1 JOIN table2 ON table1.column1 = table2.column3",
+		Result: &Estimation{Rows: 3, Bytes: 45},
+	},
+	{
+		Name:   "Estimate Union",
+		Query:  "SELECT column1 FROM table1 UNION ALL SELECT column3 FROM table2",
+		Result: &Estimation{Rows: 6, Bytes: 21},
+	},
+	{
+		Name:   "Estimate Limit",
+		Query:  "SELECT * FROM table1 LIMIT 2",
+		Result: &Estimation{Rows: 2, Bytes: 14},
+	},
+	{
+		Name:   "Estimate Inline Table",
+		Query:  "WITH it AS (SELECT 1 AS a) SELECT * FROM it",
+		Result: &Estimation{Rows: 1, Bytes: 1},
+	},
+	{
+		Name:  "Estimate File Not Exist Error",
+		Query: "SELECT * FROM notexist",
+		Error: "[L:1 C:15] file notexist does not exist",
+	},
+}
+
+func TestEstimateQuery(t *testing.T) {
+	defer func() {
+		EstimationSampleSize = 64 * 1024
+	}()
+
+	initCmdFlag()
+	flags := cmd.GetFlags()
+	flags.Repository = TestDir
+
+	for _, v := range estimateQueryTests {
+		ViewCache.Clean()
+		EstimationSampleSize = 64 * 1024
+		if 0 < v.SampleSize {
+			EstimationSampleSize = v.SampleSize
+		}
+
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		result, err := EstimateQuery(program[0].(parser.SelectQuery), NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
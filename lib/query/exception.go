@@ -0,0 +1,107 @@
+package query
+
+import (
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var ExceptionFunctions = map[string]func(parser.Function, []value.Primary, *Exception) (value.Primary, error){
+	"ERROR_CODE":    ErrorCode,
+	"ERROR_MESSAGE": ErrorMessage,
+	"ERROR_DATA":    ErrorData,
+	"ERROR_SOURCE":  ErrorSource,
+	"ERROR_LINE":    ErrorLine,
+	"ERROR_CHAR":    ErrorChar,
+}
+
+// Exception holds the information of an error caught by a TRY statement.
+type Exception struct {
+	Message    string
+	Code       int
+	Data       value.Primary
+	SourceFile string
+	Line       int
+	Char       int
+}
+
+func NewException(err error) *Exception {
+	e := &Exception{
+		Message: err.Error(),
+		Code:    1,
+	}
+
+	if apperr, ok := err.(AppError); ok {
+		e.Message = apperr.ErrorMessage()
+		e.Code = apperr.GetCode()
+	}
+	if perr, ok := err.(interface {
+		position() (string, int, int)
+	}); ok {
+		e.SourceFile, e.Line, e.Char = perr.position()
+	}
+	if uerr, ok := err.(*UserTriggeredError); ok {
+		e.Data = uerr.Data
+	}
+
+	return e
+}
+
+func ErrorCode(fn parser.Function, args []value.Primary, exception *Exception) (value.Primary, error) {
+	if 0 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0})
+	}
+	if exception == nil {
+		return value.NewNull(), nil
+	}
+	return value.NewInteger(int64(exception.Code)), nil
+}
+
+func ErrorMessage(fn parser.Function, args []value.Primary, exception *Exception) (value.Primary, error) {
+	if 0 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0})
+	}
+	if exception == nil {
+		return value.NewNull(), nil
+	}
+	return value.NewString(exception.Message), nil
+}
+
+func ErrorData(fn parser.Function, args []value.Primary, exception *Exception) (value.Primary, error) {
+	if 0 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0})
+	}
+	if exception == nil || exception.Data == nil {
+		return value.NewNull(), nil
+	}
+	return exception.Data, nil
+}
+
+func ErrorSource(fn parser.Function, args []value.Primary, exception *Exception) (value.Primary, error) {
+	if 0 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0})
+	}
+	if exception == nil || len(exception.SourceFile) < 1 {
+		return value.NewNull(), nil
+	}
+	return value.NewString(exception.SourceFile), nil
+}
+
+func ErrorLine(fn parser.Function, args []value.Primary, exception *Exception) (value.Primary, error) {
+	if 0 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0})
+	}
+	if exception == nil || exception.Line < 1 {
+		return value.NewNull(), nil
+	}
+	return value.NewInteger(int64(exception.Line)), nil
+}
+
+func ErrorChar(fn parser.Function, args []value.Primary, exception *Exception) (value.Primary, error) {
+	if 0 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0})
+	}
+	if exception == nil || exception.Line < 1 {
+		return value.NewNull(), nil
+	}
+	return value.NewInteger(int64(exception.Char)), nil
+}
@@ -0,0 +1,165 @@
+package query
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var newExceptionTests = []struct {
+	Name   string
+	Error  error
+	Expect *Exception
+}{
+	{
+		Name: "User Triggered Error",
+		Error: NewUserTriggeredError(
+			parser.Trigger{
+				BaseExpr: parser.NewBaseExpr(parser.Token{Line: 3, Char: 5, SourceFile: "source.sql"}),
+				Code:     value.NewInteger(300),
+			},
+			"user error",
+			value.NewString("payload"),
+		),
+		Expect: &Exception{
+			Message:    "user error",
+			Code:       300,
+			Data:       value.NewString("payload"),
+			SourceFile: "source.sql",
+			Line:       3,
+			Char:       5,
+		},
+	},
+	{
+		Name:  "Application Error",
+		Error: NewFieldNotExistError(parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}),
+		Expect: &Exception{
+			Message: "field notexist does not exist",
+			Code:    1,
+		},
+	},
+	{
+		Name:  "Other Error",
+		Error: errors.New("error"),
+		Expect: &Exception{
+			Message: "error",
+			Code:    1,
+		},
+	},
+}
+
+func TestNewException(t *testing.T) {
+	for _, v := range newExceptionTests {
+		result := NewException(v.Error)
+		if !reflect.DeepEqual(result, v.Expect) {
+			t.Errorf("%s: result = %#v, want %#v", v.Name, result, v.Expect)
+		}
+	}
+}
+
+var exceptionFunctionTests = []struct {
+	Name      string
+	Function  parser.Function
+	Args      []value.Primary
+	Exception *Exception
+	Result    value.Primary
+	Error     string
+}{
+	{
+		Name:     "ErrorCode",
+		Function: parser.Function{Name: "error_code"},
+		Exception: &Exception{
+			Message: "user error",
+			Code:    300,
+		},
+		Result: value.NewInteger(300),
+	},
+	{
+		Name:     "ErrorMessage",
+		Function: parser.Function{Name: "error_message"},
+		Exception: &Exception{
+			Message: "user error",
+			Code:    300,
+		},
+		Result: value.NewString("user error"),
+	},
+	{
+		Name:     "ErrorData",
+		Function: parser.Function{Name: "error_data"},
+		Exception: &Exception{
+			Message: "user error",
+			Code:    300,
+			Data:    value.NewInteger(1),
+		},
+		Result: value.NewInteger(1),
+	},
+	{
+		Name:     "ErrorSource",
+		Function: parser.Function{Name: "error_source"},
+		Exception: &Exception{
+			Message:    "user error",
+			Code:       300,
+			SourceFile: "source.sql",
+			Line:       3,
+			Char:       5,
+		},
+		Result: value.NewString("source.sql"),
+	},
+	{
+		Name:     "ErrorLine",
+		Function: parser.Function{Name: "error_line"},
+		Exception: &Exception{
+			Message:    "user error",
+			Code:       300,
+			SourceFile: "source.sql",
+			Line:       3,
+			Char:       5,
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name:     "ErrorChar",
+		Function: parser.Function{Name: "error_char"},
+		Exception: &Exception{
+			Message: "user error",
+			Code:    300,
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name:     "ErrorCode Outside Catch Statements",
+		Function: parser.Function{Name: "error_code"},
+		Result:   value.NewNull(),
+	},
+	{
+		Name:     "ErrorCode Arguments Error",
+		Function: parser.Function{Name: "error_code"},
+		Args:     []value.Primary{value.NewInteger(1)},
+		Error:    "[L:- C:-] function error_code takes no argument",
+	},
+}
+
+func TestExceptionFunctions(t *testing.T) {
+	for _, v := range exceptionFunctionTests {
+		result, err := ExceptionFunctions[strings.ToUpper(v.Function.Name)](v.Function, v.Args, v.Exception)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
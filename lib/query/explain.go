@@ -0,0 +1,649 @@
+package query
+
+import (
+	"bytes"
+	"runtime"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// ExplainNode is a step of the execution of a query.
+type ExplainNode struct {
+	Operation string
+	Detail    string
+	Children  []*ExplainNode
+
+	Stats   *OperationStats
+	Columns []*ColumnStats
+}
+
+func (node *ExplainNode) add(child *ExplainNode) *ExplainNode {
+	node.Children = append(node.Children, child)
+	return node
+}
+
+func (node *ExplainNode) totalStats() []*OperationStats {
+	var list []*OperationStats
+	if node.Stats != nil {
+		list = append(list, node.Stats)
+	}
+	for _, child := range node.Children {
+		list = append(list, child.totalStats()...)
+	}
+	return list
+}
+
+// OperationStats is the cost of a step excluding the other steps.
+type OperationStats struct {
+	Elapsed time.Duration
+	Rows    int
+	Memory  uint64
+	Allocs  uint64
+}
+
+// ColumnStats is the observed values of a column in a loaded table.
+type ColumnStats struct {
+	Name        string
+	Cardinality int
+	NullRatio   float64
+	Memory      uint64
+}
+
+type explainer struct {
+	filter       *Filter
+	inlineTables map[string]bool
+}
+
+// ExplainQuery returns the steps to execute the select query without loading any table.
+func ExplainQuery(query parser.SelectQuery, filter *Filter) *ExplainNode {
+	e := &explainer{
+		filter:       filter,
+		inlineTables: make(map[string]bool),
+	}
+	return e.selectQuery(query)
+}
+
+// AnalyzeQuery executes the select query and returns the executed steps with their costs.
+func AnalyzeQuery(query parser.SelectQuery, filter *Filter) (*ExplainNode, error) {
+	profiler := newQueryProfiler()
+	profiler.columnStats = true
+
+	filter = filter.CreateNode()
+	filter.profiler = profiler
+	if _, err := Select(query, filter); err != nil {
+		return nil, err
+	}
+	return profiler.root(), nil
+}
+
+func Explain(expr parser.Explain, filter *Filter) (string, error) {
+	var node *ExplainNode
+	if expr.IsAnalyze() {
+		var err error
+		if node, err = AnalyzeQuery(expr.Query.(parser.SelectQuery), filter); err != nil {
+			return "", err
+		}
+	} else {
+		node = ExplainQuery(expr.Query.(parser.SelectQuery), filter.CreateNode())
+	}
+
+	w := NewObjectWriter()
+	w.IndentWidth = 2
+	writeExplainNode(w, node)
+	w.Title1 = "Execution Plan"
+	return "\n" + w.String() + "\n", nil
+}
+
+func writeExplainNode(w *ObjectWriter, node *ExplainNode) {
+	if 0 < len(node.Detail) {
+		w.WriteColorWithoutLineBreak(node.Operation+": ", cmd.LableEffect)
+		w.BeginSubBlock()
+		w.Write(node.Detail)
+		w.EndSubBlock()
+	} else {
+		w.WriteColorWithoutLineBreak(node.Operation, cmd.LableEffect)
+	}
+	w.NewLine()
+
+	w.BeginBlock()
+	if node.Stats != nil {
+		w.WriteColorWithoutLineBreak("Time: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(cmd.FormatNumber(node.Stats.Elapsed.Seconds(), 6, ".", ",", "") + " seconds, ")
+		w.WriteColorWithoutLineBreak("Rows: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(cmd.FormatNumber(float64(node.Stats.Rows), 0, ".", ",", "") + ", ")
+		w.WriteColorWithoutLineBreak("Memory: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(cmd.FormatNumber(float64(node.Stats.Memory), 0, ".", ",", "") + " bytes")
+		w.NewLine()
+	}
+	for _, stats := range node.Columns {
+		w.WriteColorWithoutLineBreak("Column: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(stats.Name + ", ")
+		w.WriteColorWithoutLineBreak("Cardinality: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(cmd.FormatNumber(float64(stats.Cardinality), 0, ".", ",", "") + ", ")
+		w.WriteColorWithoutLineBreak("Nulls: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(cmd.FormatNumber(stats.NullRatio*100, 2, ".", ",", "") + "%, ")
+		w.WriteColorWithoutLineBreak("Memory: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(cmd.FormatNumber(float64(stats.Memory), 0, ".", ",", "") + " bytes")
+		w.NewLine()
+	}
+	for _, child := range node.Children {
+		writeExplainNode(w, child)
+	}
+	w.EndBlock()
+}
+
+func (e *explainer) selectQuery(query parser.SelectQuery) *ExplainNode {
+	var with *ExplainNode
+	if query.WithClause != nil {
+		with = &ExplainNode{Operation: "With"}
+		for _, v := range query.WithClause.(parser.WithClause).InlineTables {
+			it := v.(parser.InlineTable)
+			e.inlineTables[strings.ToUpper(it.Name.Literal)] = true
+			with.add(explainInlineTableNode(it).add(e.selectQuery(it.Query)))
+		}
+	}
+
+	node := e.selectEntity(query.SelectEntity)
+
+	if query.OrderByClause != nil {
+		node = explainSortNode(query.OrderByClause.(parser.OrderByClause)).add(node)
+	}
+	if query.OffsetClause != nil {
+		node = explainOffsetNode(query.OffsetClause.(parser.OffsetClause)).add(node)
+	}
+	if query.LimitClause != nil {
+		node = explainLimitNode(query.LimitClause.(parser.LimitClause)).add(node)
+	}
+
+	if with != nil {
+		with.add(node)
+		node = with
+	}
+	return node
+}
+
+func (e *explainer) selectEntity(expr parser.QueryExpression) *ExplainNode {
+	switch expr.(type) {
+	case parser.SelectSet:
+		set := expr.(parser.SelectSet)
+		return explainSetOperationNode(set).
+			add(e.selectEntity(set.LHS)).
+			add(e.selectEntity(set.RHS))
+	case parser.Subquery:
+		return e.selectQuery(expr.(parser.Subquery).Query)
+	}
+
+	entity := expr.(parser.SelectEntity)
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	if 0 < len(selectClause.Hints) {
+		e = &explainer{
+			filter:       e.filter.withHints(selectClause.Hints),
+			inlineTables: e.inlineTables,
+		}
+	}
+
+	var node *ExplainNode
+	if entity.FromClause == nil {
+		node = &ExplainNode{Operation: "Scan", Detail: "DUAL"}
+	} else {
+		tables := entity.FromClause.(parser.FromClause).Tables
+		node = e.table(tables[0])
+		for _, v := range tables[1:] {
+			if _, ok := lateralTable(v); ok {
+				node = (&ExplainNode{Operation: "Lateral Join"}).add(node).add(e.table(v))
+			} else {
+				node = (&ExplainNode{Operation: "Cross Join"}).add(node).add(e.table(v))
+			}
+		}
+	}
+
+	if entity.WhereClause != nil {
+		if where := explainWhereNode(entity.WhereClause.(parser.WhereClause), e.filter); where != nil {
+			node = where.add(node)
+		}
+	}
+
+	if entity.GroupByClause != nil {
+		node = explainGroupByNode(entity.GroupByClause.(parser.GroupByClause)).add(node)
+	} else if entity.HavingClause != nil || containsAggregation(selectClause.Fields) {
+		node = (&ExplainNode{Operation: "Aggregate", Detail: "all records"}).add(node)
+	}
+
+	if entity.HavingClause != nil {
+		node = explainHavingNode(entity.HavingClause.(parser.HavingClause), e.filter).add(node)
+	}
+
+	if analytic := analyticFunctions(selectClause.Fields); 0 < len(analytic) {
+		node = (&ExplainNode{Operation: "Window", Detail: listExplainDetails(analytic)}).add(node)
+	}
+
+	return explainProjectNode(selectClause).add(node)
+}
+
+func (e *explainer) table(expr parser.QueryExpression) *ExplainNode {
+	if parentheses, ok := expr.(parser.Parentheses); ok {
+		return e.table(parentheses.Expr)
+	}
+
+	table := expr.(parser.Table)
+	switch table.Object.(type) {
+	case parser.Join:
+		join := table.Object.(parser.Join)
+		if lateral, ok := lateralTable(join.JoinTable); ok {
+			return explainJoinNode(join, e.filter).
+				add(e.table(join.Table)).
+				add(&ExplainNode{Operation: "Scan", Detail: lateral.String()})
+		}
+		return explainJoinNode(join, e.filter).add(e.table(join.Table)).add(e.table(join.JoinTable))
+	case parser.Subquery:
+		return e.tableNode(table).add(e.selectQuery(table.Object.(parser.Subquery).Query))
+	}
+	return e.tableNode(table)
+}
+
+func (e *explainer) tableNode(table parser.Table) *ExplainNode {
+	alias := ""
+	if table.Alias != nil {
+		alias = " AS " + table.Alias.String()
+	}
+
+	switch table.Object.(type) {
+	case parser.Join:
+		return explainJoinNode(table.Object.(parser.Join), e.filter)
+	case parser.Subquery:
+		return &ExplainNode{Operation: "Subquery", Detail: strings.TrimPrefix(alias, " AS ")}
+	case parser.Identifier:
+		ident := table.Object.(parser.Identifier)
+		if e.inlineTables[strings.ToUpper(ident.Literal)] {
+			return &ExplainNode{Operation: "Scan Inline Table", Detail: ident.String() + alias}
+		}
+		if _, err := e.filter.InlineTables.Get(ident); err == nil {
+			return &ExplainNode{Operation: "Scan Inline Table", Detail: ident.String() + alias}
+		}
+		if e.filter.TempViews.Exists(ident.Literal) {
+			return &ExplainNode{Operation: "Scan View", Detail: ident.String() + alias}
+		}
+
+		detail := ident.String()
+		if t, ok := cmd.GetFlags().TableCatalog().Get(ident.Literal); ok {
+			ident = parser.Identifier{Literal: t.Path}
+		}
+		if fpath, _, err := SearchFilePath(ident, cmd.GetFlags().Repository, cmd.AutoSelect); err == nil {
+			detail = fpath
+		}
+		return &ExplainNode{Operation: "Scan File", Detail: detail + alias}
+	case parser.Dual:
+		return &ExplainNode{Operation: "Scan", Detail: "DUAL"}
+	}
+	return &ExplainNode{Operation: "Scan", Detail: table.String()}
+}
+
+func explainTableNode(table parser.Table, filter *Filter) *ExplainNode {
+	return (&explainer{filter: filter}).tableNode(table)
+}
+
+func explainJoinNode(join parser.Join, filter *Filter) *ExplainNode {
+	if _, ok := lateralTable(join.JoinTable); ok {
+		return &ExplainNode{Operation: "Lateral Join", Detail: explainJoinCondition(join)}
+	}
+
+	joinType := join.JoinType.Token
+	if join.JoinType.IsEmpty() {
+		if join.Direction.IsEmpty() {
+			joinType = parser.INNER
+		} else {
+			joinType = parser.OUTER
+		}
+	}
+
+	switch {
+	case joinType == parser.CROSS:
+		return &ExplainNode{Operation: "Cross Join"}
+	case joinType == parser.INNER && join.Condition == nil && join.Natural.IsEmpty():
+		return &ExplainNode{Operation: "Cross Join"}
+	case joinType == parser.INNER:
+		return &ExplainNode{Operation: explainJoinMethod(join, filter), Detail: "INNER " + explainJoinCondition(join)}
+	}
+
+	direction := "LEFT"
+	if !join.Direction.IsEmpty() {
+		direction = strings.ToUpper(join.Direction.Literal)
+	}
+	return &ExplainNode{Operation: explainJoinMethod(join, filter), Detail: direction + " OUTER " + explainJoinCondition(join)}
+}
+
+func explainJoinMethod(join parser.Join, filter *Filter) string {
+	if method, _ := filter.hints.joinMethod(join); method == hashJoin {
+		if !join.Natural.IsEmpty() {
+			return "Hash Join"
+		}
+		if condition, ok := join.Condition.(parser.JoinCondition); ok {
+			if 0 < len(condition.Using) {
+				return "Hash Join"
+			}
+			for _, expr := range splitConjunction(condition.On) {
+				if comparison, ok := expr.(parser.Comparison); ok && comparison.Operator == "=" {
+					return "Hash Join"
+				}
+			}
+		}
+	}
+	return "Nested Loop Join"
+}
+
+func explainJoinCondition(join parser.Join) string {
+	if !join.Natural.IsEmpty() {
+		return "NATURAL"
+	}
+	if join.Condition == nil {
+		return ""
+	}
+	condition := join.Condition.(parser.JoinCondition)
+	if condition.On != nil {
+		return "ON " + condition.On.String()
+	}
+	return "USING (" + listExplainDetails(condition.Using) + ")"
+}
+
+func explainInlineTableNode(it parser.InlineTable) *ExplainNode {
+	if it.IsRecursive() {
+		return &ExplainNode{Operation: "Recursive Inline Table", Detail: it.Name.String()}
+	}
+	return &ExplainNode{Operation: "Inline Table", Detail: it.Name.String()}
+}
+
+func explainSetOperationNode(set parser.SelectSet) *ExplainNode {
+	operation := strings.ToUpper(set.Operator.Literal)
+	if !set.All.IsEmpty() {
+		operation = operation + " ALL"
+	}
+	return &ExplainNode{Operation: "Set Operation", Detail: operation}
+}
+
+func explainWhereNode(clause parser.WhereClause, filter *Filter) *ExplainNode {
+	condition, alwaysTrue := simplifyCondition(clause.Filter, filter)
+	if alwaysTrue {
+		return nil
+	}
+	return &ExplainNode{Operation: "Filter", Detail: reorderPredicates(condition).String()}
+}
+
+func explainGroupByNode(clause parser.GroupByClause) *ExplainNode {
+	return &ExplainNode{Operation: "Aggregate", Detail: listExplainDetails(clause.Items)}
+}
+
+func explainHavingNode(clause parser.HavingClause, filter *Filter) *ExplainNode {
+	condition, _ := simplifyCondition(clause.Filter, filter)
+	return &ExplainNode{Operation: "Filter", Detail: reorderPredicates(condition).String()}
+}
+
+func explainProjectNode(clause parser.SelectClause) *ExplainNode {
+	if clause.IsDistinct() {
+		return &ExplainNode{Operation: "Project Distinct", Detail: listExplainDetails(clause.Fields)}
+	}
+	return &ExplainNode{Operation: "Project", Detail: listExplainDetails(clause.Fields)}
+}
+
+func explainSortNode(clause parser.OrderByClause) *ExplainNode {
+	return &ExplainNode{Operation: "Sort", Detail: listExplainDetails(clause.Items)}
+}
+
+func explainOffsetNode(clause parser.OffsetClause) *ExplainNode {
+	return &ExplainNode{Operation: "Offset", Detail: clause.Value.String()}
+}
+
+func explainLimitNode(clause parser.LimitClause) *ExplainNode {
+	detail := clause.Value.String()
+	if clause.IsPercentage() {
+		detail = detail + " " + clause.Percent
+	}
+	if clause.With != nil {
+		detail = detail + " " + clause.With.String()
+	}
+	return &ExplainNode{Operation: "Limit", Detail: detail}
+}
+
+func listExplainDetails(list []parser.QueryExpression) string {
+	s := make([]string, 0, len(list))
+	for _, v := range list {
+		s = append(s, v.String())
+	}
+	return strings.Join(s, ", ")
+}
+
+func containsAggregation(fields []parser.QueryExpression) bool {
+	for _, field := range fields {
+		aggregated := false
+		walkExpression(field.(parser.Field).Object, func(e parser.QueryExpression) bool {
+			switch e.(type) {
+			case parser.AggregateFunction, parser.ListFunction:
+				aggregated = true
+				return false
+			}
+			return true
+		})
+		if aggregated {
+			return true
+		}
+	}
+	return false
+}
+
+func analyticFunctions(fields []parser.QueryExpression) []parser.QueryExpression {
+	var list []parser.QueryExpression
+	for _, field := range fields {
+		if fn, ok := field.(parser.Field).Object.(parser.AnalyticFunction); ok {
+			list = append(list, fn)
+		}
+	}
+	return list
+}
+
+type queryProfiler struct {
+	frames      [][]*ExplainNode
+	columnStats bool
+}
+
+func newQueryProfiler() *queryProfiler {
+	return &queryProfiler{
+		frames: make([][]*ExplainNode, 1),
+	}
+}
+
+func (p *queryProfiler) measure(newNode func() *ExplainNode, n int, fn func() (int, error)) error {
+	if p == nil {
+		_, err := fn()
+		return err
+	}
+
+	inputs := p.take(n)
+	p.frames = append(p.frames, nil)
+
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	alloc := mem.TotalAlloc
+	mallocs := mem.Mallocs
+	start := time.Now()
+
+	rows, err := fn()
+
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&mem)
+
+	executed := p.frames[len(p.frames)-1]
+	p.frames = p.frames[:len(p.frames)-1]
+	if err != nil {
+		return err
+	}
+
+	memory := mem.TotalAlloc - alloc
+	allocs := mem.Mallocs - mallocs
+	for _, v := range executed {
+		for _, stats := range v.totalStats() {
+			elapsed = elapsed - stats.Elapsed
+			if stats.Memory < memory {
+				memory = memory - stats.Memory
+			} else {
+				memory = 0
+			}
+			if stats.Allocs < allocs {
+				allocs = allocs - stats.Allocs
+			} else {
+				allocs = 0
+			}
+		}
+	}
+	children := append(inputs, executed...)
+
+	node := newNode()
+	if node == nil {
+		p.push(children...)
+		return nil
+	}
+	node.Children = append(children, node.Children...)
+	node.Stats = &OperationStats{
+		Elapsed: elapsed,
+		Rows:    rows,
+		Memory:  memory,
+		Allocs:  allocs,
+	}
+	p.push(node)
+	return nil
+}
+
+func (p *queryProfiler) group(node *ExplainNode, n int) {
+	if p == nil {
+		return
+	}
+	node.Children = append(node.Children, p.take(n)...)
+	p.push(node)
+}
+
+func (p *queryProfiler) take(n int) []*ExplainNode {
+	if p == nil {
+		return nil
+	}
+
+	frame := p.frames[len(p.frames)-1]
+	if len(frame) < n {
+		n = len(frame)
+	}
+	nodes := make([]*ExplainNode, n)
+	copy(nodes, frame[len(frame)-n:])
+	p.frames[len(p.frames)-1] = frame[:len(frame)-n]
+	return nodes
+}
+
+func (p *queryProfiler) push(nodes ...*ExplainNode) {
+	if p == nil {
+		return
+	}
+	p.frames[len(p.frames)-1] = append(p.frames[len(p.frames)-1], nodes...)
+}
+
+func (p *queryProfiler) root() *ExplainNode {
+	frame := p.frames[0]
+	if len(frame) < 1 {
+		return nil
+	}
+	return frame[len(frame)-1]
+}
+
+func measureStep(filter *Filter, view *View, newNode func() *ExplainNode, fn func() error) error {
+	return filter.profiler.measure(newNode, 1, func() (int, error) {
+		if err := fn(); err != nil {
+			return 0, err
+		}
+		return view.RecordLen(), nil
+	})
+}
+
+func measureLoad(filter *Filter, newNode func() *ExplainNode, fn func() (*View, error)) (*View, error) {
+	var view *View
+	err := filter.profiler.measure(newNode, 0, func() (int, error) {
+		var err error
+		if view, err = fn(); err != nil {
+			return 0, err
+		}
+		return view.RecordLen(), nil
+	})
+	return view, err
+}
+
+func measureScan(filter *Filter, newNode func() *ExplainNode, fn func() (*View, error)) (*View, error) {
+	var view *View
+	return measureLoad(filter, func() *ExplainNode {
+		node := newNode()
+		if filter.profiler.columnStats {
+			node.Columns = columnStatistics(view)
+		}
+		return node
+	}, func() (*View, error) {
+		var err error
+		view, err = fn()
+		return view, err
+	})
+}
+
+var cellSize = uint64(unsafe.Sizeof(Cell{}) + unsafe.Sizeof(value.Primary(nil)))
+
+func columnStatistics(view *View) []*ColumnStats {
+	list := make([]*ColumnStats, 0, view.FieldLen())
+	buf := &bytes.Buffer{}
+	for i, field := range view.Header {
+		if field.isInternalId() {
+			continue
+		}
+
+		keys := make(map[string]bool)
+		nulls := 0
+		memory := uint64(0)
+		for _, record := range view.RecordSet {
+			val := record[i][0]
+			if value.IsNull(val) {
+				nulls++
+			} else {
+				buf.Reset()
+				SerializeKey(buf, val)
+				keys[buf.String()] = true
+			}
+			memory = memory + cellSize + primarySize(val)
+		}
+
+		stats := &ColumnStats{
+			Name:        field.Column,
+			Cardinality: len(keys),
+			Memory:      memory,
+		}
+		if 0 < view.RecordLen() {
+			stats.NullRatio = float64(nulls) / float64(view.RecordLen())
+		}
+		list = append(list, stats)
+	}
+	return list
+}
+
+func primarySize(val value.Primary) uint64 {
+	switch v := val.(type) {
+	case value.String:
+		return uint64(unsafe.Sizeof(v)) + uint64(len(v.Raw()))
+	case value.Integer:
+		return uint64(unsafe.Sizeof(v))
+	case value.Float:
+		return uint64(unsafe.Sizeof(v))
+	case value.Boolean:
+		return uint64(unsafe.Sizeof(v))
+	case value.Ternary:
+		return uint64(unsafe.Sizeof(v))
+	case value.Datetime:
+		return uint64(unsafe.Sizeof(v))
+	case value.Null:
+		return 0
+	}
+	return uint64(len(val.String()))
+}
@@ -0,0 +1,279 @@
+package query
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var explainTests = []struct {
+	Name   string
+	Query  string
+	Filter *Filter
+	Expect string
+}{
+	{
+		Name:  "Explain Select Entity",
+		Query: "EXPLAIN SELECT DISTINCT column1, COUNT(*) FROM table1 WHERE 1 = 1 AND column1 > 1 + 1 GROUP BY column1 HAVING COUNT(*) > 1",
+		Expect: "\n" +
+			strings.Repeat(" ", (calcExplainWidth(GetTestFilePath("table1.csv"))-14)/2) + "Execution Plan\n" +
+			strings.Repeat("-", calcExplainWidth(GetTestFilePath("table1.csv"))) + "\n" +
+			" Project Distinct: column1, COUNT(*)\n" +
+			"   Filter: COUNT(*) > 1\n" +
+			"     Aggregate: column1\n" +
+			"       Filter: column1 > 2\n" +
+			"         Scan File: " + GetTestFilePath("table1.csv") + "\n" +
+			"\n",
+	},
+	{
+		Name:  "Explain Sort and Limit",
+		Query: "EXPLAIN SELECT SUM(column1) OVER () FROM DUAL ORDER BY 1 LIMIT 10 PERCENT OFFSET 2",
+		Expect: "\n" +
+			"            Execution Plan\n" +
+			"--------------------------------------\n" +
+			" Limit: 10 PERCENT\n" +
+			"   Offset: 2\n" +
+			"     Sort: 1\n" +
+			"       Project: SUM(column1) OVER ()\n" +
+			"         Window: SUM(column1) OVER ()\n" +
+			"           Scan: DUAL\n" +
+			"\n",
+	},
+	{
+		Name:  "Explain Aggregation without Group By",
+		Query: "EXPLAIN SELECT COUNT(*) FROM view1 WHERE TRUE",
+		Filter: &Filter{
+			TempViews: TemporaryViewScopes{
+				ViewMap{
+					"VIEW1": &View{
+						Header:   NewHeader("view1", []string{"column1"}),
+						FileInfo: &FileInfo{Path: "view1", IsTemporary: true},
+					},
+				},
+			},
+		},
+		Expect: "\n" +
+			"      Execution Plan\n" +
+			"--------------------------\n" +
+			" Project: COUNT(*)\n" +
+			"   Aggregate: all records\n" +
+			"     Scan View: view1\n" +
+			"\n",
+	},
+	{
+		Name:  "Explain Joins and Inline Tables",
+		Query: "EXPLAIN WITH it AS (SELECT 1 AS a) SELECT * FROM view1 v LEFT JOIN it ON v.column1 = it.a CROSS JOIN (SELECT 2) s, view1",
+		Filter: &Filter{
+			TempViews: TemporaryViewScopes{
+				ViewMap{
+					"VIEW1": &View{
+						Header:   NewHeader("view1", []string{"column1"}),
+						FileInfo: &FileInfo{Path: "view1", IsTemporary: true},
+					},
+				},
+			},
+		},
+		Expect: "\n" +
+			"                  Execution Plan\n" +
+			"---------------------------------------------------\n" +
+			" With\n" +
+			"   Inline Table: it\n" +
+			"     Project: 1 AS a\n" +
+			"       Scan: DUAL\n" +
+			"   Project: *\n" +
+			"     Cross Join\n" +
+			"       Cross Join\n" +
+			"         Hash Join: LEFT OUTER ON v.column1 = it.a\n" +
+			"           Scan View: view1 AS v\n" +
+			"           Scan Inline Table: it\n" +
+			"         Subquery: s\n" +
+			"           Project: 2\n" +
+			"             Scan: DUAL\n" +
+			"       Scan View: view1\n" +
+			"\n",
+	},
+	{
+		Name:  "Explain Joins with Hints",
+		Query: "EXPLAIN SELECT /*+ NESTED_LOOP_JOIN(v3) */ * FROM view1 v1 JOIN view1 v2 ON v1.column1 = v2.column1 RIGHT JOIN view1 v3 ON v2.column1 = v3.column1",
+		Filter: &Filter{
+			TempViews: TemporaryViewScopes{
+				ViewMap{
+					"VIEW1": &View{
+						Header:   NewHeader("view1", []string{"column1"}),
+						FileInfo: &FileInfo{Path: "view1", IsTemporary: true},
+					},
+				},
+			},
+		},
+		Expect: "\n" +
+			"                       Execution Plan\n" +
+			"------------------------------------------------------------\n" +
+			" Project: *\n" +
+			"   Nested Loop Join: RIGHT OUTER ON v2.column1 = v3.column1\n" +
+			"     Hash Join: INNER ON v1.column1 = v2.column1\n" +
+			"       Scan View: view1 AS v1\n" +
+			"       Scan View: view1 AS v2\n" +
+			"     Scan View: view1 AS v3\n" +
+			"\n",
+	},
+	{
+		Name:  "Explain Set Operation",
+		Query: "EXPLAIN SELECT 1 UNION ALL SELECT 2",
+		Expect: "\n" +
+			"      Execution Plan\n" +
+			"--------------------------\n" +
+			" Set Operation: UNION ALL\n" +
+			"   Project: 1\n" +
+			"     Scan: DUAL\n" +
+			"   Project: 2\n" +
+			"     Scan: DUAL\n" +
+			"\n",
+	},
+}
+
+func calcExplainWidth(path string) int {
+	w := 36
+	pathLen := 20 + len(path)
+	if w < pathLen {
+		w = pathLen
+	}
+	w++
+	if 75 < w {
+		w = 75
+	}
+	return w
+}
+
+func TestExplain(t *testing.T) {
+	initCmdFlag()
+	flags := cmd.GetFlags()
+	flags.Repository = TestDir
+
+	for _, v := range explainTests {
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		filter := v.Filter
+		if filter == nil {
+			filter = NewEmptyFilter()
+		}
+
+		result, err := Explain(program[0].(parser.Explain), filter)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if result != v.Expect {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Expect)
+		}
+	}
+}
+
+var analyzeQueryTests = []struct {
+	Name   string
+	Query  string
+	Result string
+}{
+	{
+		Name:  "Analyze Select Query",
+		Query: "SELECT column1 FROM view1 WHERE column1 > 1 ORDER BY column1 LIMIT 1",
+		Result: "Limit: 1 (1)\n" +
+			"  Sort: column1 (2)\n" +
+			"    Project: column1 (2)\n" +
+			"      Filter: column1 > 1 (2)\n" +
+			"        Scan View: view1 (3)\n",
+	},
+	{
+		Name:  "Analyze Aggregation",
+		Query: "SELECT COUNT(*) FROM view1 v1 CROSS JOIN view1 v2",
+		Result: "Project: COUNT(*) (1)\n" +
+			"  Cross Join (9)\n" +
+			"    Scan View: view1 AS v1 (3)\n" +
+			"    Scan View: view1 AS v2 (3)\n",
+	},
+}
+
+func formatAnalyzedNode(node *ExplainNode, indent string) string {
+	s := indent + node.Operation
+	if 0 < len(node.Detail) {
+		s += ": " + node.Detail
+	}
+	if node.Stats != nil {
+		s += " (" + strconv.Itoa(node.Stats.Rows) + ")"
+	}
+	s += "\n"
+	for _, child := range node.Children {
+		s += formatAnalyzedNode(child, indent+"  ")
+	}
+	return s
+}
+
+func TestAnalyzeQuery(t *testing.T) {
+	filter := &Filter{
+		TempViews: TemporaryViewScopes{
+			ViewMap{
+				"VIEW1": &View{
+					Header: NewHeader("view1", []string{"column1"}),
+					RecordSet: []Record{
+						NewRecord([]value.Primary{value.NewInteger(1)}),
+						NewRecord([]value.Primary{value.NewInteger(2)}),
+						NewRecord([]value.Primary{value.NewInteger(3)}),
+					},
+					FileInfo: &FileInfo{Path: "view1", IsTemporary: true},
+				},
+			},
+		},
+	}
+
+	for _, v := range analyzeQueryTests {
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		node, err := AnalyzeQuery(program[0].(parser.SelectQuery), filter)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if result := formatAnalyzedNode(node, ""); result != v.Result {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestColumnStatistics(t *testing.T) {
+	view := &View{
+		Header: NewHeaderWithId("view1", []string{"column1", "column2"}),
+		RecordSet: []Record{
+			NewRecordWithId(1, []value.Primary{value.NewInteger(1), value.NewString("a")}),
+			NewRecordWithId(2, []value.Primary{value.NewInteger(1), value.NewNull()}),
+			NewRecordWithId(3, []value.Primary{value.NewString("2"), value.NewString("bc")}),
+			NewRecordWithId(4, []value.Primary{value.NewInteger(2), value.NewNull()}),
+		},
+	}
+
+	result := columnStatistics(view)
+	if len(result) != 2 {
+		t.Fatalf("the number of columns = %d, want %d", len(result), 2)
+	}
+
+	expect := []ColumnStats{
+		{Name: "column1", Cardinality: 2, NullRatio: 0},
+		{Name: "column2", Cardinality: 2, NullRatio: 0.5},
+	}
+	for i, v := range expect {
+		if result[i].Name != v.Name || result[i].Cardinality != v.Cardinality || result[i].NullRatio != v.NullRatio {
+			t.Errorf("column %d = %+v, want %+v", i, *result[i], v)
+		}
+	}
+	if result[0].Memory != 4*cellSize+3*primarySize(value.NewInteger(1))+primarySize(value.NewString("2")) {
+		t.Errorf("memory of column1 = %d, want the sum of the sizes of the values", result[0].Memory)
+	}
+}
@@ -0,0 +1,329 @@
+package query
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"sort"
+	"unsafe"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+// externalSortBlockSize is the number of records whose sort keys are generated at a time in external sorting.
+const externalSortBlockSize = 10000
+
+var sortValueSize = int(unsafe.Sizeof(SortValue{}))
+
+// sortValuesSize returns the approximate size in bytes of memory used by the sort keys of a record.
+func sortValuesSize(values SortValues) int {
+	size := int(unsafe.Sizeof(values)) + len(values)*(int(unsafe.Sizeof(&SortValue{}))+sortValueSize)
+	for _, v := range values {
+		size = size + len(v.String)
+	}
+	return size
+}
+
+// sortRunEntry is the sort keys of a record written to a sorted run.
+type sortRunEntry struct {
+	Index  int
+	Values SortValues
+}
+
+type sortRunEntries struct {
+	entries       []sortRunEntry
+	directions    []int
+	nullPositions []int
+}
+
+func (e sortRunEntries) Len() int {
+	return len(e.entries)
+}
+
+func (e sortRunEntries) Swap(i, j int) {
+	e.entries[i], e.entries[j] = e.entries[j], e.entries[i]
+}
+
+func (e sortRunEntries) Less(i, j int) bool {
+	return e.entries[i].Values.Less(e.entries[j].Values, e.directions, e.nullPositions)
+}
+
+// sortRun is a temporary file that has the sort keys of records in sorted order.
+type sortRun struct {
+	fp     *os.File
+	reader *bufio.Reader
+	entry  sortRunEntry
+	buf    []byte
+}
+
+func writeSortRun(entries []sortRunEntry) (*sortRun, error) {
+	fp, err := ioutil.TempFile("", "csvq_sort_")
+	if err != nil {
+		return nil, err
+	}
+	run := &sortRun{fp: fp}
+
+	w := bufio.NewWriter(fp)
+	buf := make([]byte, binary.MaxVarintLen64)
+	var writeInt = func(i int64) {
+		n := binary.PutVarint(buf, i)
+		w.Write(buf[:n])
+	}
+
+	for _, entry := range entries {
+		writeInt(int64(entry.Index))
+		for _, v := range entry.Values {
+			w.WriteByte(byte(v.Type))
+			writeInt(v.Integer)
+			writeInt(int64(math.Float64bits(v.Float)))
+			writeInt(v.Datetime)
+			writeInt(int64(len(v.String)))
+			w.WriteString(v.String)
+			if v.Boolean {
+				w.WriteByte(1)
+			} else {
+				w.WriteByte(0)
+			}
+		}
+	}
+	if err = w.Flush(); err != nil {
+		run.Close()
+		return nil, err
+	}
+
+	if _, err = fp.Seek(0, io.SeekStart); err != nil {
+		run.Close()
+		return nil, err
+	}
+	run.reader = bufio.NewReader(fp)
+	run.entry.Values = make(SortValues, len(entries[0].Values))
+	for i := range run.entry.Values {
+		run.entry.Values[i] = &SortValue{}
+	}
+	return run, nil
+}
+
+// next reads the next entry of the run into the current entry.
+// If the run has no more entries, then returns false.
+func (run *sortRun) next() (bool, error) {
+	index, err := binary.ReadVarint(run.reader)
+	if err != nil {
+		if err == io.EOF {
+			return false, nil
+		}
+		return false, err
+	}
+	run.entry.Index = int(index)
+
+	for _, v := range run.entry.Values {
+		var t byte
+		var f, length int64
+		if t, err = run.reader.ReadByte(); err != nil {
+			return false, io.ErrUnexpectedEOF
+		}
+		v.Type = SortValueType(t)
+		if v.Integer, err = binary.ReadVarint(run.reader); err != nil {
+			return false, io.ErrUnexpectedEOF
+		}
+		if f, err = binary.ReadVarint(run.reader); err != nil {
+			return false, io.ErrUnexpectedEOF
+		}
+		v.Float = math.Float64frombits(uint64(f))
+		if v.Datetime, err = binary.ReadVarint(run.reader); err != nil {
+			return false, io.ErrUnexpectedEOF
+		}
+		if length, err = binary.ReadVarint(run.reader); err != nil {
+			return false, io.ErrUnexpectedEOF
+		}
+		if cap(run.buf) < int(length) {
+			run.buf = make([]byte, length)
+		}
+		if _, err = io.ReadFull(run.reader, run.buf[:length]); err != nil {
+			return false, io.ErrUnexpectedEOF
+		}
+		v.String = string(run.buf[:length])
+		if t, err = run.reader.ReadByte(); err != nil {
+			return false, io.ErrUnexpectedEOF
+		}
+		v.Boolean = t == 1
+	}
+	return true, nil
+}
+
+// Close closes and removes the temporary file.
+func (run *sortRun) Close() error {
+	err := run.fp.Close()
+	if e := os.Remove(run.fp.Name()); e != nil && err == nil {
+		err = e
+	}
+	return err
+}
+
+// sortRunHeap is a heap of runs ordered by their current entries.
+// Entries with the same sort keys are ordered by the indices of the records, so the sort is stable.
+type sortRunHeap struct {
+	runs          []*sortRun
+	directions    []int
+	nullPositions []int
+}
+
+func (h *sortRunHeap) Len() int {
+	return len(h.runs)
+}
+
+func (h *sortRunHeap) Swap(i, j int) {
+	h.runs[i], h.runs[j] = h.runs[j], h.runs[i]
+}
+
+func (h *sortRunHeap) Less(i, j int) bool {
+	ei, ej := h.runs[i].entry, h.runs[j].entry
+	if ei.Values.Less(ej.Values, h.directions, h.nullPositions) {
+		return true
+	}
+	if ej.Values.Less(ei.Values, h.directions, h.nullPositions) {
+		return false
+	}
+	return ei.Index < ej.Index
+}
+
+func (h *sortRunHeap) Push(x interface{}) {
+	h.runs = append(h.runs, x.(*sortRun))
+}
+
+func (h *sortRunHeap) Pop() interface{} {
+	last := len(h.runs) - 1
+	run := h.runs[last]
+	h.runs = h.runs[:last]
+	return run
+}
+
+// ExternalOrderBy sorts the records in the same order as OrderBy, keeping the memory used by
+// the sort keys within the budget in bytes.
+//
+// The sort keys are generated and sorted in runs that fit in the budget, and the runs are written
+// to temporary files. Then the runs are merged to determine the order of the records.
+// If all the sort keys fit in the budget, the records are sorted in memory without temporary files.
+// The sort keys are not kept in the view after sorting, so the view cannot be limited with ties.
+func (view *View) ExternalOrderBy(clause parser.OrderByClause, budget int) error {
+	sortIndices, collations, err := view.prepareOrderBy(clause)
+	if err != nil {
+		return err
+	}
+
+	var runs []*sortRun
+	defer func() {
+		for _, run := range runs {
+			_ = run.Close()
+		}
+	}()
+
+	entries := make([]sortRunEntry, 0, externalSortBlockSize)
+	size := 0
+	for start := 0; start < view.RecordLen(); start = start + externalSortBlockSize {
+		end := start + externalSortBlockSize
+		if view.RecordLen() < end {
+			end = view.RecordLen()
+		}
+
+		block := make([]sortRunEntry, end-start)
+		NewGoroutineTaskManager(len(block), -1, view.Filter.cpu()).Run(func(index int) {
+			values := make(SortValues, len(sortIndices))
+			for j, idx := range sortIndices {
+				values[j] = NewSortValueWithCollation(view.RecordSet[start+index][idx].Value(), collations[j])
+			}
+			block[index] = sortRunEntry{Index: start + index, Values: values}
+		})
+
+		for _, entry := range block {
+			entries = append(entries, entry)
+			size = size + sortValuesSize(entry.Values)
+			if budget <= size {
+				sort.Stable(sortRunEntries{entries: entries, directions: view.sortDirections, nullPositions: view.sortNullPositions})
+				run, err := writeSortRun(entries)
+				if err != nil {
+					return NewExternalSortError(clause, err.Error())
+				}
+				runs = append(runs, run)
+				entries = make([]sortRunEntry, 0, externalSortBlockSize)
+				size = 0
+			}
+		}
+	}
+
+	sort.Stable(sortRunEntries{entries: entries, directions: view.sortDirections, nullPositions: view.sortNullPositions})
+
+	order := make([]int, 0, view.RecordLen())
+	if len(runs) < 1 {
+		for _, entry := range entries {
+			order = append(order, entry.Index)
+		}
+	} else {
+		if 0 < len(entries) {
+			run, err := writeSortRun(entries)
+			if err != nil {
+				return NewExternalSortError(clause, err.Error())
+			}
+			runs = append(runs, run)
+		}
+		entries = nil
+
+		if order, err = mergeSortRuns(runs, order, view.sortDirections, view.sortNullPositions); err != nil {
+			return NewExternalSortError(clause, err.Error())
+		}
+	}
+
+	records := make(RecordSet, len(order))
+	for i, idx := range order {
+		records[i] = view.RecordSet[idx]
+	}
+	view.RecordSet = records
+	view.sortValuesInEachCell = nil
+	view.sortValuesInEachRecord = nil
+	return nil
+}
+
+// mergeSortRuns merges the runs, and appends the indices of the records in sorted order to the order.
+func mergeSortRuns(runs []*sortRun, order []int, directions []int, nullPositions []int) ([]int, error) {
+	h := &sortRunHeap{
+		runs:          make([]*sortRun, 0, len(runs)),
+		directions:    directions,
+		nullPositions: nullPositions,
+	}
+	for _, run := range runs {
+		ok, err := run.next()
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			h.runs = append(h.runs, run)
+		}
+	}
+	heap.Init(h)
+
+	for 0 < h.Len() {
+		run := h.runs[0]
+		order = append(order, run.entry.Index)
+
+		ok, err := run.next()
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return order, nil
+}
+
+// isLimitedWithTies returns whether the limit clause includes the records with the same sort keys as the last record.
+// The sort keys of the records must be kept in the view to limit the records with ties.
+func isLimitedWithTies(expr parser.QueryExpression) bool {
+	clause, ok := expr.(parser.LimitClause)
+	return ok && clause.IsWithTies()
+}
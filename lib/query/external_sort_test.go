@@ -0,0 +1,146 @@
+package query
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func externalSortTestView() *View {
+	r := rand.New(rand.NewSource(1))
+	numbers := []value.Primary{
+		value.NewNull(),
+		value.NewString("1325582295"),
+		value.NewInteger(-3),
+		value.NewFloat(2.5),
+		value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+	}
+	texts := []value.Primary{
+		value.NewNull(),
+		value.NewString("abc"),
+		value.NewString("ABD"),
+		value.NewString("b"),
+	}
+
+	records := make(RecordSet, 1000)
+	for i := range records {
+		records[i] = NewRecordWithId(i+1, []value.Primary{
+			numbers[r.Intn(len(numbers))],
+			texts[r.Intn(len(texts))],
+			value.NewInteger(int64(i)),
+		})
+	}
+
+	return &View{
+		Header: []HeaderField{
+			{View: "table1", Column: InternalIdColumn},
+			{View: "table1", Column: "column1", IsFromTable: true},
+			{View: "table1", Column: "column2", IsFromTable: true},
+			{View: "table1", Column: "column3", IsFromTable: true},
+		},
+		RecordSet: records,
+		Filter:    NewEmptyFilter(),
+	}
+}
+
+func TestView_ExternalOrderBy(t *testing.T) {
+	clause := parser.OrderByClause{
+		Items: []parser.QueryExpression{
+			parser.OrderItem{
+				Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				Direction: parser.Token{Token: parser.DESC, Literal: "desc"},
+				Position:  parser.Token{Token: parser.FIRST, Literal: "first"},
+			},
+			parser.OrderItem{
+				Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			parser.OrderItem{
+				Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				Direction: parser.Token{Token: parser.DESC, Literal: "desc"},
+			},
+		},
+	}
+
+	expect := externalSortTestView()
+	if err := expect.OrderBy(clause); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	tempFiles := func() int {
+		files, _ := filepath.Glob(filepath.Join(os.TempDir(), "csvq_sort_*"))
+		return len(files)
+	}
+	filesBefore := tempFiles()
+
+	for _, budget := range []int{1, 1000, 10000, 1000000} {
+		view := externalSortTestView()
+		if err := view.ExternalOrderBy(clause, budget); err != nil {
+			t.Errorf("budget %d: unexpected error %q", budget, err)
+			continue
+		}
+		if !reflect.DeepEqual(view.RecordSet, expect.RecordSet) {
+			t.Errorf("budget %d: records are not sorted in the same order as sorting in memory", budget)
+		}
+		if view.sortValuesInEachRecord != nil {
+			t.Errorf("budget %d: sort values are kept in the view", budget)
+		}
+	}
+
+	if n := tempFiles(); n != filesBefore {
+		t.Errorf("%d temporary files are left", n-filesBefore)
+	}
+}
+
+func TestSelect_ExternalOrderBy(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.SetSortMemory(100)
+	defer tf.SetSortMemory(0)
+
+	filter := &Filter{
+		TempViews: TemporaryViewScopes{
+			ViewMap{
+				"VIEW1": &View{
+					Header: NewHeader("view1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						NewRecord([]value.Primary{value.NewInteger(2), value.NewString("a")}),
+						NewRecord([]value.Primary{value.NewInteger(1), value.NewString("b")}),
+						NewRecord([]value.Primary{value.NewInteger(1), value.NewString("c")}),
+						NewRecord([]value.Primary{value.NewInteger(3), value.NewString("d")}),
+					},
+					FileInfo: &FileInfo{Path: "view1", IsTemporary: true},
+				},
+			},
+		},
+	}
+
+	for query, expect := range map[string][]string{
+		"SELECT column2 FROM view1 ORDER BY column1, column2 DESC":     {"c", "b", "a", "d"},
+		"SELECT column2 FROM view1 ORDER BY column1 LIMIT 1 WITH TIES": {"b", "c"},
+	} {
+		program, err := parser.Parse(query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", query, err)
+		}
+
+		view, err := Select(program[0].(parser.SelectQuery), filter)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", query, err)
+			continue
+		}
+
+		result := make([]string, view.RecordLen())
+		for i, record := range view.RecordSet {
+			result[i] = record[0].Value().(value.String).Raw()
+		}
+		if !reflect.DeepEqual(result, expect) {
+			t.Errorf("%s: result = %s, want %s", query, result, expect)
+		}
+	}
+}
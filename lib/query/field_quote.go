@@ -0,0 +1,207 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+)
+
+// FieldQuote represents whether a field in a CSV or TSV file is enclosed in double quotes.
+type FieldQuote uint8
+
+const (
+	// UnknownQuote is the quote of a field that is not read from the file, or has been updated.
+	UnknownQuote FieldQuote = iota
+	NotQuoted
+	Quoted
+)
+
+func equalFieldQuotes(q1 []FieldQuote, q2 []FieldQuote) bool {
+	if len(q1) != len(q2) {
+		return false
+	}
+	for i := range q1 {
+		if q1[i] != q2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeleteFieldQuotes removes the quotes of the deleted records.
+func (f *FileInfo) DeleteFieldQuotes(deleted map[int]bool) {
+	if f.FieldQuotes == nil {
+		return
+	}
+
+	offset := headerLines(f)
+	fieldQuotes := make([][]FieldQuote, 0, len(f.FieldQuotes))
+	for i, quotes := range f.FieldQuotes {
+		if !deleted[i-offset] {
+			fieldQuotes = append(fieldQuotes, quotes)
+		}
+	}
+	f.FieldQuotes = fieldQuotes
+}
+
+// UpdateFieldQuotes forgets the quotes of the updated fields,
+// so that the fields are written according to the quote style.
+func (f *FileInfo) UpdateFieldQuotes(updated map[int][]int) {
+	offset := headerLines(f)
+	for idx, fields := range updated {
+		f.forgetFieldQuotes(idx+offset, fields)
+	}
+}
+
+func (f *FileInfo) forgetFieldQuotes(line int, fields []int) {
+	if len(f.FieldQuotes) <= line {
+		return
+	}
+
+	quotes := make([]FieldQuote, len(f.FieldQuotes[line]))
+	copy(quotes, f.FieldQuotes[line])
+	for _, idx := range fields {
+		if idx < len(quotes) {
+			quotes[idx] = UnknownQuote
+		}
+	}
+	f.FieldQuotes[line] = quotes
+}
+
+// InsertFieldQuotes inserts the unknown quotes of the added columns at the position in all lines.
+func (f *FileInfo) InsertFieldQuotes(pos int, n int) {
+	f.mapFieldQuotes(func(quotes []FieldQuote) []FieldQuote {
+		if len(quotes) < pos {
+			return quotes
+		}
+		inserted := make([]FieldQuote, 0, len(quotes)+n)
+		inserted = append(inserted, quotes[:pos]...)
+		inserted = append(inserted, make([]FieldQuote, n)...)
+		return append(inserted, quotes[pos:]...)
+	})
+}
+
+// DropFieldQuotes removes the quotes of the dropped columns in all lines.
+func (f *FileInfo) DropFieldQuotes(indices []int) {
+	f.mapFieldQuotes(func(quotes []FieldQuote) []FieldQuote {
+		dropped := make([]FieldQuote, 0, len(quotes))
+		for i, q := range quotes {
+			if !InIntSlice(i, indices) {
+				dropped = append(dropped, q)
+			}
+		}
+		return dropped
+	})
+}
+
+// RenameFieldQuote forgets the quote of the renamed column in the header line.
+func (f *FileInfo) RenameFieldQuote(idx int) {
+	if 0 < headerLines(f) {
+		f.forgetFieldQuotes(0, []int{idx})
+	}
+}
+
+// mapFieldQuotes replaces the quotes of all lines.
+// Lines that shared the same quotes share the replaced quotes.
+func (f *FileInfo) mapFieldQuotes(fn func([]FieldQuote) []FieldQuote) {
+	var src []FieldQuote
+	var dst []FieldQuote
+	for i, quotes := range f.FieldQuotes {
+		if i < 1 || !sameFieldQuotes(src, quotes) {
+			src = quotes
+			dst = fn(quotes)
+		}
+		f.FieldQuotes[i] = dst
+	}
+}
+
+func sameFieldQuotes(q1 []FieldQuote, q2 []FieldQuote) bool {
+	return len(q1) == len(q2) && (len(q1) < 1 || &q1[0] == &q2[0])
+}
+
+// fieldQuoter determines whether each field written in CSV or TSV is enclosed in double quotes.
+type fieldQuoter struct {
+	delimiter   rune
+	encloseAll  bool
+	style       cmd.QuoteStyle
+	fieldQuotes [][]FieldQuote
+
+	columnQuotes []FieldQuote
+}
+
+func newFieldQuoter(delimiter rune, encloseAll bool, style cmd.QuoteStyle, fieldQuotes [][]FieldQuote, headerLines int) *fieldQuoter {
+	q := &fieldQuoter{
+		delimiter:   delimiter,
+		encloseAll:  encloseAll,
+		style:       style,
+		fieldQuotes: fieldQuotes,
+	}
+	if style == cmd.QuoteStylePreserve && headerLines < len(fieldQuotes) {
+		q.columnQuotes = columnQuotes(fieldQuotes[headerLines:])
+	}
+	return q
+}
+
+// columnQuotes returns the quotes used for the most of the fields in each column.
+func columnQuotes(fieldQuotes [][]FieldQuote) []FieldQuote {
+	var quoted []int
+	var notQuoted []int
+
+	for _, quotes := range fieldQuotes {
+		if len(quoted) < len(quotes) {
+			quoted = append(quoted, make([]int, len(quotes)-len(quoted))...)
+			notQuoted = append(notQuoted, make([]int, len(quotes)-len(notQuoted))...)
+		}
+		for i, q := range quotes {
+			switch q {
+			case Quoted:
+				quoted[i]++
+			case NotQuoted:
+				notQuoted[i]++
+			}
+		}
+	}
+
+	result := make([]FieldQuote, len(quoted))
+	for i := range result {
+		switch {
+		case notQuoted[i] < quoted[i]:
+			result[i] = Quoted
+		case 0 < notQuoted[i]:
+			result[i] = NotQuoted
+		}
+	}
+	return result
+}
+
+// Quote reports whether the field in the line is to be enclosed in double quotes.
+//
+// A field that contains delimiters, double quotes or line breaks is always enclosed.
+// A field that is read from the file and has not been updated is enclosed only if it was enclosed in the file.
+// Otherwise, strings and datetime values are enclosed according to the quote style.
+func (q *fieldQuoter) Quote(line int, field int, s string, effect string, isHeader bool) bool {
+	if strings.ContainsRune(s, q.delimiter) || strings.ContainsAny(s, "\"\r\n") {
+		return true
+	}
+
+	if line < len(q.fieldQuotes) && field < len(q.fieldQuotes[line]) {
+		switch q.fieldQuotes[line][field] {
+		case Quoted:
+			return true
+		case NotQuoted:
+			return false
+		}
+	}
+
+	switch q.style {
+	case cmd.QuoteStyleMinimal:
+		return false
+	case cmd.QuoteStyleAll:
+		return isHeader || effect == cmd.StringEffect || effect == cmd.DatetimeEffect
+	}
+
+	if !isHeader && effect != cmd.NoEffect && field < len(q.columnQuotes) && q.columnQuotes[field] != UnknownQuote {
+		return q.columnQuotes[field] == Quoted
+	}
+	return q.encloseAll && (isHeader || effect == cmd.StringEffect || effect == cmd.DatetimeEffect)
+}
@@ -0,0 +1,279 @@
+package query
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+)
+
+var fileInfoFieldQuotesTests = []struct {
+	Name     string
+	FileInfo *FileInfo
+	Fn       func(*FileInfo)
+	Expect   [][]FieldQuote
+}{
+	{
+		Name: "Delete Field Quotes",
+		FileInfo: &FileInfo{
+			FieldQuotes: [][]FieldQuote{
+				{NotQuoted, NotQuoted},
+				{Quoted, NotQuoted},
+				{NotQuoted, Quoted},
+				{Quoted, Quoted},
+			},
+		},
+		Fn: func(f *FileInfo) {
+			f.DeleteFieldQuotes(map[int]bool{1: true})
+		},
+		Expect: [][]FieldQuote{
+			{NotQuoted, NotQuoted},
+			{Quoted, NotQuoted},
+			{Quoted, Quoted},
+		},
+	},
+	{
+		Name: "Delete Field Quotes Without Header",
+		FileInfo: &FileInfo{
+			NoHeader: true,
+			FieldQuotes: [][]FieldQuote{
+				{Quoted, NotQuoted},
+				{NotQuoted, Quoted},
+			},
+		},
+		Fn: func(f *FileInfo) {
+			f.DeleteFieldQuotes(map[int]bool{1: true})
+		},
+		Expect: [][]FieldQuote{
+			{Quoted, NotQuoted},
+		},
+	},
+	{
+		Name:     "Delete Field Quotes Not Recorded",
+		FileInfo: &FileInfo{},
+		Fn: func(f *FileInfo) {
+			f.DeleteFieldQuotes(map[int]bool{1: true})
+		},
+		Expect: nil,
+	},
+	{
+		Name: "Update Field Quotes",
+		FileInfo: &FileInfo{
+			FieldQuotes: [][]FieldQuote{
+				{NotQuoted, NotQuoted},
+				{Quoted, Quoted},
+				{Quoted, Quoted},
+			},
+		},
+		Fn: func(f *FileInfo) {
+			f.UpdateFieldQuotes(map[int][]int{1: {0}})
+		},
+		Expect: [][]FieldQuote{
+			{NotQuoted, NotQuoted},
+			{Quoted, Quoted},
+			{UnknownQuote, Quoted},
+		},
+	},
+	{
+		Name: "Insert Field Quotes",
+		FileInfo: &FileInfo{
+			FieldQuotes: [][]FieldQuote{
+				{NotQuoted, NotQuoted},
+				{Quoted, NotQuoted},
+			},
+		},
+		Fn: func(f *FileInfo) {
+			f.InsertFieldQuotes(1, 2)
+		},
+		Expect: [][]FieldQuote{
+			{NotQuoted, UnknownQuote, UnknownQuote, NotQuoted},
+			{Quoted, UnknownQuote, UnknownQuote, NotQuoted},
+		},
+	},
+	{
+		Name: "Drop Field Quotes",
+		FileInfo: &FileInfo{
+			FieldQuotes: [][]FieldQuote{
+				{NotQuoted, NotQuoted, Quoted},
+				{Quoted, NotQuoted, NotQuoted},
+			},
+		},
+		Fn: func(f *FileInfo) {
+			f.DropFieldQuotes([]int{0, 2})
+		},
+		Expect: [][]FieldQuote{
+			{NotQuoted},
+			{NotQuoted},
+		},
+	},
+	{
+		Name: "Rename Field Quote",
+		FileInfo: &FileInfo{
+			FieldQuotes: [][]FieldQuote{
+				{Quoted, Quoted},
+				{Quoted, Quoted},
+			},
+		},
+		Fn: func(f *FileInfo) {
+			f.RenameFieldQuote(1)
+		},
+		Expect: [][]FieldQuote{
+			{Quoted, UnknownQuote},
+			{Quoted, Quoted},
+		},
+	},
+	{
+		Name: "Rename Field Quote Without Header",
+		FileInfo: &FileInfo{
+			NoHeader: true,
+			FieldQuotes: [][]FieldQuote{
+				{Quoted, Quoted},
+			},
+		},
+		Fn: func(f *FileInfo) {
+			f.RenameFieldQuote(1)
+		},
+		Expect: [][]FieldQuote{
+			{Quoted, Quoted},
+		},
+	},
+}
+
+func TestFileInfo_FieldQuotes(t *testing.T) {
+	for _, v := range fileInfoFieldQuotesTests {
+		v.Fn(v.FileInfo)
+		if !reflect.DeepEqual(v.FileInfo.FieldQuotes, v.Expect) {
+			t.Errorf("%s: field quotes = %v, want %v", v.Name, v.FileInfo.FieldQuotes, v.Expect)
+		}
+	}
+}
+
+func TestFileInfo_UpdateFieldQuotes_SharedLines(t *testing.T) {
+	shared := []FieldQuote{Quoted, NotQuoted}
+	fileInfo := &FileInfo{
+		FieldQuotes: [][]FieldQuote{shared, shared, shared},
+	}
+
+	fileInfo.UpdateFieldQuotes(map[int][]int{0: {1}})
+	expect := [][]FieldQuote{
+		{Quoted, NotQuoted},
+		{Quoted, UnknownQuote},
+		{Quoted, NotQuoted},
+	}
+	if !reflect.DeepEqual(fileInfo.FieldQuotes, expect) {
+		t.Errorf("field quotes = %v, want %v", fileInfo.FieldQuotes, expect)
+	}
+}
+
+var encodeViewWithFieldQuotesTests = []struct {
+	Name        string
+	QuoteStyle  cmd.QuoteStyle
+	EncloseAll  bool
+	NoHeader    bool
+	FieldQuotes [][]FieldQuote
+	Result      string
+}{
+	{
+		Name:       "Preserve Field Quotes",
+		QuoteStyle: cmd.QuoteStylePreserve,
+		FieldQuotes: [][]FieldQuote{
+			{Quoted, NotQuoted},
+			{Quoted, NotQuoted},
+			{NotQuoted, Quoted},
+			{Quoted, Quoted},
+		},
+		Result: "\"c1\",c2\n\"1\",a\n2,\"b\"\n\"3\",\"c,d\"\n\"4\",\"e\"",
+	},
+	{
+		Name:       "Preserve Field Quotes Without Header",
+		QuoteStyle: cmd.QuoteStylePreserve,
+		NoHeader:   true,
+		FieldQuotes: [][]FieldQuote{
+			{Quoted, NotQuoted},
+			{NotQuoted, Quoted},
+			{NotQuoted, Quoted},
+		},
+		Result: "\"1\",a\n2,\"b\"\n3,\"c,d\"\n4,\"e\"",
+	},
+	{
+		Name:       "Preserve Field Quotes of Updated Fields",
+		QuoteStyle: cmd.QuoteStylePreserve,
+		FieldQuotes: [][]FieldQuote{
+			{NotQuoted, NotQuoted},
+			{NotQuoted, UnknownQuote},
+			{NotQuoted, Quoted},
+			{NotQuoted, Quoted},
+		},
+		Result: "c1,c2\n1,\"a\"\n2,\"b\"\n3,\"c,d\"\n4,\"e\"",
+	},
+	{
+		Name:       "Preserve Field Quotes Not Recorded",
+		QuoteStyle: cmd.QuoteStylePreserve,
+		EncloseAll: true,
+		Result:     "\"c1\",\"c2\"\n1,\"a\"\n2,\"b\"\n3,\"c,d\"\n4,\"e\"",
+	},
+	{
+		Name:       "Minimal Field Quotes",
+		QuoteStyle: cmd.QuoteStyleMinimal,
+		EncloseAll: true,
+		FieldQuotes: [][]FieldQuote{
+			{NotQuoted, NotQuoted},
+			{NotQuoted, UnknownQuote},
+			{NotQuoted, Quoted},
+		},
+		Result: "c1,c2\n1,a\n2,\"b\"\n3,\"c,d\"\n4,e",
+	},
+	{
+		Name:       "All Field Quotes",
+		QuoteStyle: cmd.QuoteStyleAll,
+		FieldQuotes: [][]FieldQuote{
+			{NotQuoted, NotQuoted},
+			{NotQuoted, UnknownQuote},
+		},
+		Result: "c1,c2\n1,\"a\"\n2,\"b\"\n3,\"c,d\"\n4,\"e\"",
+	},
+}
+
+func TestEncodeView_FieldQuotes(t *testing.T) {
+	defer func() {
+		_ = cmd.GetFlags().SetQuoteStyle("PRESERVE")
+	}()
+
+	view := &View{
+		Header: NewHeader("test", []string{"c1", "c2"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+			NewRecord([]value.Primary{value.NewInteger(2), value.NewString("b")}),
+			NewRecord([]value.Primary{value.NewInteger(3), value.NewString("c,d")}),
+			NewRecord([]value.Primary{value.NewInteger(4), value.NewString("e")}),
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	for _, v := range encodeViewWithFieldQuotesTests {
+		cmd.GetFlags().QuoteStyle = v.QuoteStyle
+
+		fileInfo := &FileInfo{
+			Format:      cmd.CSV,
+			Delimiter:   ',',
+			Encoding:    text.UTF8,
+			LineBreak:   text.LF,
+			NoHeader:    v.NoHeader,
+			EncloseAll:  v.EncloseAll,
+			FieldQuotes: v.FieldQuotes,
+		}
+
+		buf.Reset()
+		if err := EncodeView(buf, view, fileInfo); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if buf.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, buf.String(), v.Result)
+		}
+	}
+}
@@ -26,6 +26,13 @@ const (
 	TableEncloseAll  = "ENCLOSE_ALL"
 	TableJsonEscape  = "JSON_ESCAPE"
 	TablePrettyPrint = "PRETTY_PRINT"
+
+	TablePretty = "PRETTY"
+)
+
+const (
+	CopyEncodingUTF8M = "UTF8M"
+	ByteOrderMark     = "\uFEFF"
 )
 
 var FileAttributeList = []string{
@@ -64,6 +71,8 @@ type FileInfo struct {
 	JsonQuery          string
 	Encoding           text.Encoding
 	LineBreak          text.LineBreak
+	LineBreaks         []text.LineBreak
+	FieldQuotes        [][]FieldQuote
 	NoHeader           bool
 	EncloseAll         bool
 	JsonEscape         json.EscapeType
@@ -74,6 +83,8 @@ type FileInfo struct {
 	IsTemporary      bool
 	InitialHeader    Header
 	InitialRecordSet RecordSet
+
+	Constraints []parser.QueryExpression
 }
 
 func NewFileInfo(
@@ -194,11 +205,12 @@ func (f *FileInfo) SetLineBreak(s string) error {
 		return err
 	}
 
-	if f.LineBreak == lb {
+	if f.LineBreak == lb && f.LineBreaks == nil {
 		return NewTableAttributeUnchangedError(f.Path)
 	}
 
 	f.LineBreak = lb
+	f.LineBreaks = nil
 	return nil
 }
 
@@ -207,14 +219,17 @@ func (f *FileInfo) SetNoHeader(b bool) error {
 		return NewTableAttributeUnchangedError(f.Path)
 	}
 	f.NoHeader = b
+	f.LineBreaks = nil
+	f.FieldQuotes = nil
 	return nil
 }
 
 func (f *FileInfo) SetEncloseAll(b bool) error {
-	if b == f.EncloseAll {
+	if b == f.EncloseAll && f.FieldQuotes == nil {
 		return NewTableAttributeUnchangedError(f.Path)
 	}
 	f.EncloseAll = b
+	f.FieldQuotes = nil
 	return nil
 }
 
@@ -254,6 +269,13 @@ func (f *FileInfo) CloseWithErrors() error {
 	return f.Handler.CloseWithErrors()
 }
 
+func (f *FileInfo) IsModified() bool {
+	if f.Handler == nil {
+		return false
+	}
+	return f.Handler.IsModified()
+}
+
 func (f *FileInfo) Commit() error {
 	if f.Handler == nil {
 		return nil
@@ -39,7 +39,16 @@ type Filter struct {
 
 	checkAvailableParallelRoutine bool
 
+	subqueries *SubqueryCache
+	profiler   *queryProfiler
+	hints      *queryHints
+	loadFilter *loadFilter
+
+	ReplaceValues *ReplaceValues
+
 	Now time.Time
+
+	Exception *Exception
 }
 
 type ContainsSubstitusion struct{}
@@ -102,16 +111,35 @@ func (f *Filter) Merge(filter *Filter) {
 	f.Functions = filter.Functions
 	f.InlineTables = filter.InlineTables
 	f.Aliases = filter.Aliases
+	f.subqueries = filter.subqueries
+	f.hints = filter.hints
+	f.ReplaceValues = filter.ReplaceValues
 	f.Now = filter.Now
+	f.Exception = filter.Exception
 }
 
 func (f *Filter) CreateChildScope() *Filter {
-	return NewFilter(
+	child := NewFilter(
 		append(VariableScopes{NewVariableMap()}, f.Variables...),
 		append(TemporaryViewScopes{{}}, f.TempViews...),
 		append(CursorScopes{{}}, f.Cursors...),
 		append(UserDefinedFunctionScopes{{}}, f.Functions...),
 	)
+	child.ReplaceValues = f.ReplaceValues
+	child.Exception = f.Exception
+	return child
+}
+
+// CreateGlobalScope returns a filter that refers only to the outermost scope of the filter.
+func (f *Filter) CreateGlobalScope() *Filter {
+	global := NewFilter(
+		f.Variables[len(f.Variables)-1:],
+		f.TempViews[len(f.TempViews)-1:],
+		f.Cursors[len(f.Cursors)-1:],
+		f.Functions[len(f.Functions)-1:],
+	)
+	global.ReplaceValues = f.ReplaceValues
+	return global
 }
 
 func (f *Filter) ResetCurrentScope() {
@@ -141,12 +169,21 @@ func (f *Filter) CreateNode() *Filter {
 		Aliases:          append(AliasNodes{{}}, f.Aliases...),
 		RecursiveTable:   f.RecursiveTable,
 		RecursiveTmpView: f.RecursiveTmpView,
+		ReplaceValues:    f.ReplaceValues,
 		Now:              f.Now,
+		Exception:        f.Exception,
+
+		subqueries: f.subqueries,
+		profiler:   f.profiler,
+		hints:      f.hints,
 	}
 
 	if filter.Now.IsZero() {
 		filter.Now = cmd.Now()
 	}
+	if filter.subqueries == nil {
+		filter.subqueries = NewSubqueryCache()
+	}
 
 	return filter
 }
@@ -176,6 +213,16 @@ func (f *Filter) Evaluate(expr parser.QueryExpression) (value.Primary, error) {
 		val, err = f.evalUnaryArithmetic(expr.(parser.UnaryArithmetic))
 	case parser.Concat:
 		val, err = f.evalConcat(expr.(parser.Concat))
+	case parser.ArrayValue:
+		val, err = f.evalArrayValue(expr.(parser.ArrayValue))
+	case parser.ArrayElement:
+		val, err = f.evalArrayElement(expr.(parser.ArrayElement))
+	case parser.MemberReference:
+		val, err = f.evalMemberReference(expr.(parser.MemberReference))
+	case parser.Collate:
+		val, err = f.evalCollate(expr.(parser.Collate))
+	case parser.AtTimeZone:
+		val, err = f.evalAtTimeZone(expr.(parser.AtTimeZone))
 	case parser.Comparison:
 		val, err = f.evalComparison(expr.(parser.Comparison))
 	case parser.Is:
@@ -212,6 +259,8 @@ func (f *Filter) Evaluate(expr parser.QueryExpression) (value.Primary, error) {
 		val = value.NewString(os.Getenv(expr.(parser.EnvironmentVariable).Name))
 	case parser.RuntimeInformation:
 		val, err = GetRuntimeInformation(expr.(parser.RuntimeInformation))
+	case parser.Placeholder:
+		val, err = f.ReplaceValues.Get(expr.(parser.Placeholder))
 	case parser.VariableSubstitution:
 		if f.checkAvailableParallelRoutine {
 			err = &ContainsSubstitusion{}
@@ -236,7 +285,7 @@ func (f *Filter) EvaluateSequentially(fn func(*Filter, int) error, expr interfac
 		isGrouped := f.Records[0].View.isGrouped
 		f.Records = f.Records[1:]
 
-		gm := NewGoroutineTaskManager(len(recordSet), -1)
+		gm := NewGoroutineTaskManager(len(recordSet), -1, f.cpu())
 		for i := 0; i < gm.Number; i++ {
 			gm.Add()
 			go func(thIdx int) {
@@ -358,11 +407,44 @@ func (f *Filter) evalFieldReference(expr parser.QueryExpression) (value.Primary,
 		}
 	}
 	if p == nil {
+		m, err := f.evalMapFieldReference(expr)
+		if err != nil {
+			return nil, err
+		}
+		if m != nil {
+			return m, nil
+		}
 		return nil, NewFieldNotExistError(expr)
 	}
 	return p, nil
 }
 
+// evalMapFieldReference evaluates a reference such as "column.key" to a member of the map in the column.
+// It returns nil if the column does not exist or has a value other than a map or null.
+func (f *Filter) evalMapFieldReference(expr parser.QueryExpression) (value.Primary, error) {
+	fieldRef, ok := expr.(parser.FieldReference)
+	if !ok || len(fieldRef.View.Literal) < 1 {
+		return nil, nil
+	}
+
+	p, err := f.evalFieldReference(parser.FieldReference{BaseExpr: fieldRef.BaseExpr, Column: fieldRef.View})
+	if err != nil {
+		if _, ok := err.(*FieldNotExistError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if value.IsNull(p) {
+		return value.NewNull(), nil
+	}
+	m, ok := p.(value.Map)
+	if !ok {
+		return nil, nil
+	}
+	member, _ := m.Value(fieldRef.Column.Literal)
+	return member, nil
+}
+
 func (f *Filter) evalArithmetic(expr parser.Arithmetic) (value.Primary, error) {
 	lhs, err := f.Evaluate(expr.LHS)
 	if err != nil {
@@ -426,6 +508,159 @@ func (f *Filter) evalConcat(expr parser.Concat) (value.Primary, error) {
 	return value.NewString(strings.Join(items, "")), nil
 }
 
+func (f *Filter) evalArrayValue(expr parser.ArrayValue) (value.Primary, error) {
+	values := make([]value.Primary, len(expr.Values))
+	for i, v := range expr.Values {
+		p, err := f.Evaluate(v)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = p
+	}
+	return value.NewArray(values), nil
+}
+
+func (f *Filter) evalArrayElement(expr parser.ArrayElement) (value.Primary, error) {
+	p, err := f.Evaluate(expr.Array)
+	if err != nil {
+		return nil, err
+	}
+	index, err := f.Evaluate(expr.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	if m, ok := p.(value.Map); ok {
+		key := value.ToString(index)
+		if value.IsNull(key) {
+			return value.NewNull(), nil
+		}
+		member, _ := m.Value(key.(value.String).Raw())
+		return member, nil
+	}
+
+	array, ok := p.(value.Array)
+	if !ok {
+		return value.NewNull(), nil
+	}
+	i := value.ToInteger(index)
+	if value.IsNull(i) {
+		return value.NewNull(), nil
+	}
+	return array.Elem(int(i.(value.Integer).Raw())), nil
+}
+
+func (f *Filter) evalMemberReference(expr parser.MemberReference) (value.Primary, error) {
+	p, err := f.Evaluate(expr.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	m, ok := p.(value.Map)
+	if !ok {
+		return value.NewNull(), nil
+	}
+	member, _ := m.Value(expr.Member.Literal)
+	return member, nil
+}
+
+// evalCollate returns the value as it is. Collations are applied by the operations comparing values.
+func (f *Filter) evalCollate(expr parser.Collate) (value.Primary, error) {
+	if _, _, err := collationOf(expr); err != nil {
+		return nil, err
+	}
+	return f.Evaluate(expr.Value)
+}
+
+// evalAtTimeZone returns the datetime converted into the timezone.
+// The wall clock time is changed, and the instant that the datetime represents is kept.
+func (f *Filter) evalAtTimeZone(expr parser.AtTimeZone) (value.Primary, error) {
+	p, err := f.Evaluate(expr.Value)
+	if err != nil {
+		return nil, err
+	}
+	tz, err := f.Evaluate(expr.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := value.ToDatetime(p)
+	if value.IsNull(dt) {
+		return value.NewNull(), nil
+	}
+	s := value.ToString(tz)
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+
+	location, err := cmd.LoadLocation(s.(value.String).Raw())
+	if err != nil {
+		return nil, NewInvalidTimeZoneError(expr, s.(value.String).Raw())
+	}
+	return value.NewDatetime(dt.(value.Datetime).Raw().In(location)), nil
+}
+
+// collationOf returns the collation specified to the expression with COLLATE.
+// If no collation is specified, then the default collation and nil are returned.
+func collationOf(expr parser.QueryExpression) (value.Collation, *parser.Collate, error) {
+	switch expr.(type) {
+	case parser.Parentheses:
+		return collationOf(expr.(parser.Parentheses).Expr)
+	case parser.Collate:
+		collate := expr.(parser.Collate)
+		collation, err := value.ParseCollation(collate.Collation.Literal)
+		if err != nil {
+			return collation, nil, NewInvalidCollationError(collate, err.Error())
+		}
+		return collation, &collate, nil
+	}
+	return value.DefaultCollation(), nil, nil
+}
+
+func comparisonCollation(expr parser.Comparison) (value.Collation, error) {
+	collation, _, err := operandsCollation(expr.LHS, expr.RHS)
+	return collation, err
+}
+
+// operandsCollation returns the collation to compare the operands, and whether it is specified with COLLATE.
+func operandsCollation(exprs ...parser.QueryExpression) (value.Collation, bool, error) {
+	collation := value.DefaultCollation()
+	var specified *parser.Collate
+
+	for _, operand := range collationOperands(exprs, nil) {
+		c, collate, err := collationOf(operand)
+		if err != nil {
+			return c, false, err
+		}
+		if collate == nil {
+			continue
+		}
+		if specified != nil && c != collation {
+			return collation, true, NewCollationConflictError(*collate, collation, c)
+		}
+		collation = c
+		specified = collate
+	}
+	return collation, specified != nil, nil
+}
+
+// collationOperands appends the operands to the list. The elements of row values and value lists are compared as operands.
+func collationOperands(exprs []parser.QueryExpression, operands []parser.QueryExpression) []parser.QueryExpression {
+	for _, expr := range exprs {
+		switch expr.(type) {
+		case parser.RowValue:
+			operands = collationOperands([]parser.QueryExpression{expr.(parser.RowValue).Value}, operands)
+		case parser.ValueList:
+			operands = collationOperands(expr.(parser.ValueList).Values, operands)
+		case parser.RowValueList:
+			operands = collationOperands(expr.(parser.RowValueList).RowValues, operands)
+		default:
+			operands = append(operands, expr)
+		}
+	}
+	return operands
+}
+
 func (f *Filter) evalComparison(expr parser.Comparison) (value.Primary, error) {
 	var t ternary.Value
 
@@ -449,14 +684,24 @@ func (f *Filter) evalComparison(expr parser.Comparison) (value.Primary, error) {
 			return nil, err
 		}
 
-		t = value.Compare(lhsVal, rhs, expr.Operator)
+		collation, err := comparisonCollation(expr)
+		if err != nil {
+			return nil, err
+		}
+
+		t = value.CompareWithCollation(lhsVal, rhs, expr.Operator, collation)
 	} else {
 		rhs, err := f.evalRowValue(expr.RHS.(parser.RowValue))
 		if err != nil {
 			return nil, err
 		}
 
-		t, err = value.CompareRowValues(lhs, rhs, expr.Operator)
+		collation, err := comparisonCollation(expr)
+		if err != nil {
+			return nil, err
+		}
+
+		t, err = value.CompareRowValuesWithCollation(lhs, rhs, expr.Operator, collation)
 		if err != nil {
 			return nil, NewRowValueLengthInComparisonError(expr.RHS.(parser.RowValue), len(lhs))
 		}
@@ -493,6 +738,11 @@ func (f *Filter) evalBetween(expr parser.Between) (value.Primary, error) {
 		return value.NewTernary(ternary.UNKNOWN), nil
 	}
 
+	collation, _, err := operandsCollation(expr.LHS, expr.Low, expr.High)
+	if err != nil {
+		return nil, err
+	}
+
 	if 1 == len(lhs) {
 		lhsVal := lhs[0]
 
@@ -505,7 +755,7 @@ func (f *Filter) evalBetween(expr parser.Between) (value.Primary, error) {
 			return nil, err
 		}
 
-		lowResult := value.GreaterOrEqual(lhsVal, low)
+		lowResult := value.CompareWithCollation(lhsVal, low, ">=", collation)
 		if lowResult == ternary.FALSE {
 			t = ternary.FALSE
 		} else {
@@ -514,7 +764,7 @@ func (f *Filter) evalBetween(expr parser.Between) (value.Primary, error) {
 				return nil, err
 			}
 
-			highResult := value.LessOrEqual(lhsVal, high)
+			highResult := value.CompareWithCollation(lhsVal, high, "<=", collation)
 			t = ternary.And(lowResult, highResult)
 		}
 	} else {
@@ -522,7 +772,7 @@ func (f *Filter) evalBetween(expr parser.Between) (value.Primary, error) {
 		if err != nil {
 			return nil, err
 		}
-		lowResult, err := value.CompareRowValues(lhs, low, ">=")
+		lowResult, err := value.CompareRowValuesWithCollation(lhs, low, ">=", collation)
 		if err != nil {
 			return nil, NewRowValueLengthInComparisonError(expr.Low.(parser.RowValue), len(lhs))
 		}
@@ -535,7 +785,7 @@ func (f *Filter) evalBetween(expr parser.Between) (value.Primary, error) {
 				return nil, err
 			}
 
-			highResult, err := value.CompareRowValues(lhs, high, "<=")
+			highResult, err := value.CompareRowValuesWithCollation(lhs, high, "<=", collation)
 			if err != nil {
 				return nil, NewRowValueLengthInComparisonError(expr.High.(parser.RowValue), len(lhs))
 			}
@@ -570,12 +820,23 @@ func (f *Filter) valuesForRowValueListComparison(lhs parser.QueryExpression, val
 }
 
 func (f *Filter) evalIn(expr parser.In) (value.Primary, error) {
+	collation, specified, err := operandsCollation(expr.LHS, expr.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	if subquery, ok := inSubquery(expr.Values); ok && !specified && 0 < len(f.Records) && f.subqueries.IsAvailable(subquery, f) {
+		if sq := f.subqueries.Uncorrelated(subquery, f); sq.Prepare(subquery, f) {
+			return f.evalInUncorrelatedSubquery(expr, subquery, sq)
+		}
+	}
+
 	val, list, err := f.valuesForRowValueListComparison(expr.LHS, expr.Values)
 	if err != nil {
 		return nil, err
 	}
 
-	t, err := Any(val, list, "=")
+	t, err := InRowValueListWithCollation(val, list, parser.ANY, "=", collation)
 	if err != nil {
 		if subquery, ok := expr.Values.(parser.Subquery); ok {
 			return nil, NewSelectFieldLengthInComparisonError(subquery, len(val))
@@ -594,13 +855,47 @@ func (f *Filter) evalIn(expr parser.In) (value.Primary, error) {
 	return value.NewTernary(t), nil
 }
 
+func inSubquery(expr parser.QueryExpression) (parser.Subquery, bool) {
+	if rowValue, ok := expr.(parser.RowValue); ok {
+		expr = rowValue.Value
+	}
+	subquery, ok := expr.(parser.Subquery)
+	return subquery, ok
+}
+
+func (f *Filter) evalInUncorrelatedSubquery(expr parser.In, subquery parser.Subquery, sq *UncorrelatedSubquery) (value.Primary, error) {
+	val, err := f.evalRowValue(expr.LHS)
+	if err != nil {
+		return nil, err
+	}
+
+	if (val == nil || len(val) < 2) && 1 < sq.View().FieldLen() {
+		return nil, NewSubqueryTooManyFieldsError(subquery)
+	}
+
+	t, err := sq.RowValueSet().Contains(val)
+	if err != nil {
+		return nil, NewSelectFieldLengthInComparisonError(subquery, len(val))
+	}
+
+	if expr.IsNegated() {
+		t = ternary.Not(t)
+	}
+	return value.NewTernary(t), nil
+}
+
 func (f *Filter) evalAny(expr parser.Any) (value.Primary, error) {
 	val, list, err := f.valuesForRowValueListComparison(expr.LHS, expr.Values)
 	if err != nil {
 		return nil, err
 	}
 
-	t, err := Any(val, list, expr.Operator)
+	collation, _, err := operandsCollation(expr.LHS, expr.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := InRowValueListWithCollation(val, list, parser.ANY, expr.Operator, collation)
 	if err != nil {
 		if subquery, ok := expr.Values.(parser.Subquery); ok {
 			return nil, NewSelectFieldLengthInComparisonError(subquery, len(val))
@@ -621,7 +916,12 @@ func (f *Filter) evalAll(expr parser.All) (value.Primary, error) {
 		return nil, err
 	}
 
-	t, err := All(val, list, expr.Operator)
+	collation, _, err := operandsCollation(expr.LHS, expr.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := InRowValueListWithCollation(val, list, parser.ALL, expr.Operator, collation)
 	if err != nil {
 		if subquery, ok := expr.Values.(parser.Subquery); ok {
 			return nil, NewSelectFieldLengthInComparisonError(subquery, len(val))
@@ -646,7 +946,12 @@ func (f *Filter) evalLike(expr parser.Like) (value.Primary, error) {
 		return nil, err
 	}
 
-	t := Like(lhs, pattern)
+	collation, _, err := operandsCollation(expr.LHS, expr.Pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	t := LikeWithCollation(lhs, pattern, collation)
 	if expr.IsNegated() {
 		t = ternary.Not(t)
 	}
@@ -654,9 +959,18 @@ func (f *Filter) evalLike(expr parser.Like) (value.Primary, error) {
 }
 
 func (f *Filter) evalExists(expr parser.Exists) (value.Primary, error) {
-	view, err := Select(expr.Query.Query, f)
-	if err != nil {
-		return nil, err
+	var view *View
+	var err error
+
+	if 0 < len(f.Records) && f.subqueries.IsAvailable(expr.Query, f) {
+		if sq := f.subqueries.Uncorrelated(expr.Query, f); sq.Prepare(expr.Query, f) {
+			view = sq.View()
+		}
+	}
+	if view == nil {
+		if view, err = Select(expr.Query.Query, f); err != nil {
+			return nil, err
+		}
 	}
 	if view.RecordLen() < 1 {
 		return value.NewTernary(ternary.FALSE), nil
@@ -665,11 +979,26 @@ func (f *Filter) evalExists(expr parser.Exists) (value.Primary, error) {
 }
 
 func (f *Filter) evalSubqueryForValue(expr parser.Subquery) (value.Primary, error) {
+	if 0 < len(f.Records) && f.subqueries.IsAvailable(expr, f) {
+		if sq := f.subqueries.Uncorrelated(expr, f); sq.Prepare(expr, f) {
+			return subqueryValue(expr, sq.View())
+		}
+		if sq := f.subqueries.Decorrelated(expr); sq.Prepare(expr, f) {
+			return sq.Evaluate(expr, f)
+		}
+	}
+	return f.evalSubquery(expr)
+}
+
+func (f *Filter) evalSubquery(expr parser.Subquery) (value.Primary, error) {
 	view, err := Select(expr.Query, f)
 	if err != nil {
 		return nil, err
 	}
+	return subqueryValue(expr, view)
+}
 
+func subqueryValue(expr parser.Subquery, view *View) (value.Primary, error) {
 	if 1 < view.FieldLen() {
 		return nil, NewSubqueryTooManyFieldsError(expr)
 	}
@@ -688,7 +1017,11 @@ func (f *Filter) evalSubqueryForValue(expr parser.Subquery) (value.Primary, erro
 func (f *Filter) evalFunction(expr parser.Function) (value.Primary, error) {
 	name := strings.ToUpper(expr.Name)
 
-	if _, ok := Functions[name]; !ok && name != "NOW" && name != "JSON_OBJECT" {
+	if _, ok := ExceptionFunctions[name]; ok {
+		return f.evalExceptionFunction(expr, name)
+	}
+
+	if _, ok := Functions[name]; !ok && name != "NOW" && name != "UUID" && name != "JSON_OBJECT" {
 		udfn, err := f.Functions.Get(expr, name)
 		if err != nil {
 			return nil, NewFunctionNotExistError(expr, expr.Name)
@@ -723,6 +1056,9 @@ func (f *Filter) evalFunction(expr parser.Function) (value.Primary, error) {
 	if name == "NOW" {
 		return Now(expr, args, f)
 	}
+	if name == "UUID" {
+		return Uuid(expr, args, f)
+	}
 
 	if fn, ok := Functions[name]; ok {
 		return fn(expr, args)
@@ -732,6 +1068,18 @@ func (f *Filter) evalFunction(expr parser.Function) (value.Primary, error) {
 	return udfn.Execute(args, f)
 }
 
+func (f *Filter) evalExceptionFunction(expr parser.Function, name string) (value.Primary, error) {
+	args := make([]value.Primary, len(expr.Args))
+	for i, v := range expr.Args {
+		arg, err := f.Evaluate(v)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = arg
+	}
+	return ExceptionFunctions[name](expr, args, f.Exception)
+}
+
 func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Primary, error) {
 	var aggfn func([]value.Primary) value.Primary
 	var udfn *UserDefinedFunction
@@ -739,6 +1087,10 @@ func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Pri
 	var err error
 
 	uname := strings.ToUpper(expr.Name)
+	if OrderedSetAggregateFunctions[uname] {
+		return f.evalOrderedSetAggregateFunction(expr)
+	}
+
 	if fn, ok := AggregateFunctions[uname]; ok {
 		aggfn = fn
 	} else {
@@ -748,13 +1100,15 @@ func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Pri
 		useUserDefined = true
 	}
 
+	var listExpr parser.QueryExpression
 	if useUserDefined {
 		if err = udfn.CheckArgsLen(expr, expr.Name, len(expr.Args)-1); err != nil {
 			return nil, err
 		}
+		listExpr = expr.Args[0]
 	} else {
-		if len(expr.Args) != 1 {
-			return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{1})
+		if listExpr, err = AggregateListExpression(expr, expr.Name, expr.Args); err != nil {
+			return nil, err
 		}
 	}
 
@@ -766,18 +1120,28 @@ func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Pri
 		return nil, NewNotGroupingRecordsError(expr, expr.Name)
 	}
 
-	listExpr := expr.Args[0]
 	if _, ok := listExpr.(parser.AllColumns); ok {
 		listExpr = parser.NewIntegerValue(1)
 	}
 
-	if uname == "COUNT" {
+	if uname == "COUNT" && expr.Filter == nil {
 		if _, ok := listExpr.(parser.PrimitiveType); ok {
 			return value.NewInteger(int64(f.Records[0].View.RecordSet[f.Records[0].RecordIndex].GroupLen())), nil
 		}
 	}
 
 	view := NewViewFromGroupedRecord(f.Records[0])
+	if expr.Filter != nil {
+		if err = view.FilterForAggregateFunctions(expr, expr.Filter.(parser.FilterClause), f); err != nil {
+			return nil, err
+		}
+	}
+	if expr.OrderBy != nil {
+		if err = view.OrderBy(expr.OrderBy.(parser.OrderByClause)); err != nil {
+			return nil, err
+		}
+	}
+
 	list, err := view.ListValuesForAggregateFunctions(expr, listExpr, expr.IsDistinct(), f)
 	if err != nil {
 		return nil, err
@@ -799,14 +1163,81 @@ func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Pri
 	return aggfn(list), nil
 }
 
+func (f *Filter) evalOrderedSetAggregateFunction(expr parser.AggregateFunction) (value.Primary, error) {
+	uname := strings.ToUpper(expr.Name)
+
+	var fraction float64
+	switch uname {
+	case "MODE":
+		if len(expr.Args) != 0 {
+			return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{0})
+		}
+	default: // PERCENTILE_DISC, PERCENTILE_CONT
+		if len(expr.Args) != 1 {
+			return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{1})
+		}
+	}
+
+	if expr.IsDistinct() {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "the DISTINCT option cannot be specified")
+	}
+	if expr.OrderBy == nil || len(expr.OrderBy.(parser.OrderByClause).Items) != 1 {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "the WITHIN GROUP clause with one sort key must be specified")
+	}
+
+	if len(f.Records) < 1 {
+		return nil, NewUnpermittedStatementFunctionError(expr, expr.Name)
+	}
+
+	if !f.Records[0].View.isGrouped {
+		return nil, NewNotGroupingRecordsError(expr, expr.Name)
+	}
+
+	if 0 < len(expr.Args) {
+		p, err := f.Evaluate(expr.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		fv := value.ToFloat(p)
+		if value.IsNull(fv) || fv.(value.Float).Raw() < 0 || 1 < fv.(value.Float).Raw() {
+			return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "the first argument must be a number between 0 and 1")
+		}
+		fraction = fv.(value.Float).Raw()
+	}
+
+	orderBy := expr.OrderBy.(parser.OrderByClause)
+	view := NewViewFromGroupedRecord(f.Records[0])
+	if expr.Filter != nil {
+		if err := view.FilterForAggregateFunctions(expr, expr.Filter.(parser.FilterClause), f); err != nil {
+			return nil, err
+		}
+	}
+	if err := view.OrderBy(orderBy); err != nil {
+		return nil, err
+	}
+
+	list, err := view.ListValuesForAggregateFunctions(expr, orderBy.Items[0].(parser.OrderItem).Value, false, f)
+	if err != nil {
+		return nil, err
+	}
+
+	switch uname {
+	case "MODE":
+		return Mode(list), nil
+	case "PERCENTILE_DISC":
+		return PercentileDisc(list, fraction), nil
+	}
+	return PercentileCont(list, fraction), nil
+}
+
 func (f *Filter) evalListFunction(expr parser.ListFunction) (value.Primary, error) {
 	var separator string
 	var err error
 
 	switch strings.ToUpper(expr.Name) {
-	case "JSON_AGG":
+	case "JSON_AGG", "ARRAY_AGG":
 		err = f.checkArgsForJsonAgg(expr)
-	default: // LISTAGG
+	default: // LISTAGG, GROUP_CONCAT
 		separator, err = f.checkArgsForListFunction(expr)
 	}
 
@@ -823,6 +1254,11 @@ func (f *Filter) evalListFunction(expr parser.ListFunction) (value.Primary, erro
 	}
 
 	view := NewViewFromGroupedRecord(f.Records[0])
+	if expr.Filter != nil {
+		if err := view.FilterForAggregateFunctions(expr, expr.Filter.(parser.FilterClause), f); err != nil {
+			return nil, err
+		}
+	}
 	if expr.OrderBy != nil {
 		err := view.OrderBy(expr.OrderBy.(parser.OrderByClause))
 		if err != nil {
@@ -838,6 +1274,8 @@ func (f *Filter) evalListFunction(expr parser.ListFunction) (value.Primary, erro
 	switch strings.ToUpper(expr.Name) {
 	case "JSON_AGG":
 		return JsonAgg(list), nil
+	case "ARRAY_AGG":
+		return ArrayAgg(list), nil
 	}
 	return ListAgg(list, separator), nil
 }
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
@@ -388,6 +389,110 @@ var filterEvaluateTests = []struct {
 		},
 		Error: "[L:- C:-] field notexist does not exist",
 	},
+	{
+		Name: "Comparison with Collation",
+		Expr: parser.Comparison{
+			LHS: parser.NewStringValue("abc"),
+			RHS: parser.Collate{
+				Value:     parser.NewStringValue("ABC"),
+				Collate:   "collate",
+				Collation: parser.Identifier{Literal: "binary"},
+			},
+			Operator: "=",
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "Comparison with Unicode Collation",
+		Expr: parser.Comparison{
+			LHS: parser.Parentheses{
+				Expr: parser.Collate{
+					Value:     parser.NewStringValue("é"),
+					Collate:   "collate",
+					Collation: parser.Identifier{Literal: "unicode"},
+				},
+			},
+			RHS:      parser.NewStringValue("g"),
+			Operator: "<",
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "Comparison Invalid Collation Error",
+		Expr: parser.Comparison{
+			LHS: parser.NewStringValue("abc"),
+			RHS: parser.Collate{
+				Value:     parser.NewStringValue("ABC"),
+				Collate:   "collate",
+				Collation: parser.Identifier{Literal: "notexist"},
+			},
+			Operator: "=",
+		},
+		Error: "[L:- C:-] notexist: collation must be one of BINARY|NOCASE|UNICODE|UNICODE_CI|NATURAL",
+	},
+	{
+		Name: "Comparison Collation Conflict Error",
+		Expr: parser.Comparison{
+			LHS: parser.Collate{
+				Value:     parser.NewStringValue("abc"),
+				Collate:   "collate",
+				Collation: parser.Identifier{Literal: "binary"},
+			},
+			RHS: parser.Collate{
+				Value:     parser.NewStringValue("ABC"),
+				Collate:   "collate",
+				Collation: parser.Identifier{Literal: "nocase"},
+			},
+			Operator: "=",
+		},
+		Error: "[L:- C:-] collations BINARY and NOCASE are in conflict",
+	},
+	{
+		Name: "AtTimeZone",
+		Expr: parser.AtTimeZone{
+			Value:      parser.NewDatetimeValue(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+			AtTimeZone: "at time zone",
+			TimeZone:   parser.NewStringValue("+09:00"),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 18, 18, 15, 0, time.FixedZone("+09:00", 9*60*60))),
+	},
+	{
+		Name: "AtTimeZone Datetime is Null",
+		Expr: parser.AtTimeZone{
+			Value:      parser.NewStringValue("abc"),
+			AtTimeZone: "at time zone",
+			TimeZone:   parser.NewStringValue("+09:00"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "AtTimeZone Timezone is Null",
+		Expr: parser.AtTimeZone{
+			Value:      parser.NewDatetimeValue(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+			AtTimeZone: "at time zone",
+			TimeZone:   parser.NewNullValue(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "AtTimeZone Invalid Timezone Error",
+		Expr: parser.AtTimeZone{
+			BaseExpr:   parser.NewBaseExpr(parser.Token{Line: 1, Char: 14}),
+			Value:      parser.NewDatetimeValue(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+			AtTimeZone: "at time zone",
+			TimeZone:   parser.NewStringValue("Asia/NotExist"),
+		},
+		Error: "[L:1 C:14] timezone \"Asia/NotExist\" does not exist",
+	},
+	{
+		Name: "AtTimeZone Value Evaluation Error",
+		Expr: parser.AtTimeZone{
+			Value:      parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			AtTimeZone: "at time zone",
+			TimeZone:   parser.NewStringValue("UTC"),
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
 	{
 		Name: "Comparison with Row Values",
 		Expr: parser.Comparison{
@@ -822,6 +927,36 @@ var filterEvaluateTests = []struct {
 		},
 		Result: value.NewTernary(ternary.FALSE),
 	},
+	{
+		Name: "Between with Collation",
+		Expr: parser.Between{
+			LHS: parser.NewStringValue("b"),
+			Low: parser.NewStringValue("A"),
+			High: parser.Collate{
+				Value:     parser.NewStringValue("C"),
+				Collate:   "collate",
+				Collation: parser.Identifier{Literal: "binary"},
+			},
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "Between Collation Conflict Error",
+		Expr: parser.Between{
+			LHS: parser.Collate{
+				Value:     parser.NewStringValue("b"),
+				Collate:   "collate",
+				Collation: parser.Identifier{Literal: "nocase"},
+			},
+			Low: parser.NewStringValue("A"),
+			High: parser.Collate{
+				Value:     parser.NewStringValue("C"),
+				Collate:   "collate",
+				Collation: parser.Identifier{Literal: "binary"},
+			},
+		},
+		Error: "[L:- C:-] collations NOCASE and BINARY are in conflict",
+	},
 	{
 		Name: "Between LHS Error",
 		Expr: parser.Between{
@@ -1142,6 +1277,66 @@ var filterEvaluateTests = []struct {
 		},
 		Result: value.NewTernary(ternary.FALSE),
 	},
+	{
+		Name: "In with Collation",
+		Expr: parser.In{
+			LHS: parser.Collate{
+				Value:     parser.NewStringValue("abc"),
+				Collate:   "collate",
+				Collation: parser.Identifier{Literal: "binary"},
+			},
+			Values: parser.RowValue{
+				Value: parser.ValueList{
+					Values: []parser.QueryExpression{
+						parser.NewStringValue("ABC"),
+						parser.NewStringValue("def"),
+					},
+				},
+			},
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "In with Collation in List",
+		Expr: parser.In{
+			LHS: parser.NewStringValue("abc"),
+			Values: parser.RowValue{
+				Value: parser.ValueList{
+					Values: []parser.QueryExpression{
+						parser.Collate{
+							Value:     parser.NewStringValue("ABC"),
+							Collate:   "collate",
+							Collation: parser.Identifier{Literal: "binary"},
+						},
+						parser.NewStringValue("def"),
+					},
+				},
+			},
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "In Collation Conflict Error",
+		Expr: parser.In{
+			LHS: parser.Collate{
+				Value:     parser.NewStringValue("abc"),
+				Collate:   "collate",
+				Collation: parser.Identifier{Literal: "binary"},
+			},
+			Values: parser.RowValue{
+				Value: parser.ValueList{
+					Values: []parser.QueryExpression{
+						parser.Collate{
+							Value:     parser.NewStringValue("ABC"),
+							Collate:   "collate",
+							Collation: parser.Identifier{Literal: "nocase"},
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] collations BINARY and NOCASE are in conflict",
+	},
 	{
 		Name: "In LHS Error",
 		Expr: parser.In{
@@ -1897,6 +2092,26 @@ var filterEvaluateTests = []struct {
 		},
 		Result: value.NewTernary(ternary.TRUE),
 	},
+	{
+		Name: "Any with Collation",
+		Expr: parser.Any{
+			LHS: parser.Collate{
+				Value:     parser.NewStringValue("abc"),
+				Collate:   "collate",
+				Collation: parser.Identifier{Literal: "binary"},
+			},
+			Values: parser.RowValue{
+				Value: parser.ValueList{
+					Values: []parser.QueryExpression{
+						parser.NewStringValue("ABC"),
+						parser.NewStringValue("def"),
+					},
+				},
+			},
+			Operator: "=",
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
 	{
 		Name: "Any LHS Error",
 		Expr: parser.Any{
@@ -2250,6 +2465,18 @@ var filterEvaluateTests = []struct {
 		},
 		Result: value.NewTernary(ternary.FALSE),
 	},
+	{
+		Name: "Like with Collation",
+		Expr: parser.Like{
+			LHS: parser.NewStringValue("abc"),
+			Pattern: parser.Collate{
+				Value:     parser.NewStringValue("A%"),
+				Collate:   "collate",
+				Collation: parser.Identifier{Literal: "binary"},
+			},
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
 	{
 		Name: "Like LHS Error",
 		Expr: parser.Like{
@@ -2676,34 +2903,44 @@ var filterEvaluateTests = []struct {
 		Error: "[L:- C:-] function avg takes exactly 1 argument",
 	},
 	{
-		Name: "Aggregate Function Not Grouped Error",
+		Name: "Aggregate Function With Two Arguments",
 		Filter: &Filter{
 			Records: []FilterRecord{
 				{
 					View: &View{
 						Header: NewHeader("table1", []string{"column1", "column2"}),
 						RecordSet: []Record{
-							NewRecordWithId(1, []value.Primary{
-								value.NewInteger(1),
-								value.NewString("str2"),
-							}),
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(2),
+									value.NewInteger(4),
+									value.NewInteger(7),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewNull(),
+								}),
+							},
 						},
+						isGrouped: true,
 					},
 					RecordIndex: 0,
 				},
 			},
 		},
 		Expr: parser.AggregateFunction{
-			Name:     "avg",
+			Name:     "regr_slope",
 			Distinct: parser.Token{},
 			Args: []parser.QueryExpression{
 				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
 			},
 		},
-		Error: "[L:- C:-] function avg cannot aggregate not grouping records",
+		Result: value.NewInteger(2),
 	},
 	{
-		Name: "Aggregate Function Nested Error",
+		Name: "Aggregate Function With Two Arguments Argument Length Error",
 		Filter: &Filter{
 			Records: []FilterRecord{
 				{
@@ -2713,13 +2950,9 @@ var filterEvaluateTests = []struct {
 							{
 								NewGroupCell([]value.Primary{
 									value.NewInteger(1),
-									value.NewNull(),
-									value.NewInteger(3),
 								}),
 								NewGroupCell([]value.Primary{
-									value.NewString("str1"),
-									value.NewString("str2"),
-									value.NewString("str3"),
+									value.NewInteger(1),
 								}),
 							},
 						},
@@ -2730,37 +2963,28 @@ var filterEvaluateTests = []struct {
 			},
 		},
 		Expr: parser.AggregateFunction{
-			Name:     "avg",
+			Name:     "corr",
 			Distinct: parser.Token{},
 			Args: []parser.QueryExpression{
-				parser.AggregateFunction{
-					Name: "avg",
-					Args: []parser.QueryExpression{
-						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-					},
-				},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
 			},
 		},
-		Error: "[L:- C:-] aggregate functions are nested at avg(avg(column1))",
+		Error: "[L:- C:-] function corr takes exactly 2 arguments",
 	},
 	{
-		Name: "Aggregate Function Count With AllColumns",
+		Name: "Aggregate Function Ordered-Set Mode",
 		Filter: &Filter{
 			Records: []FilterRecord{
 				{
 					View: &View{
-						Header: NewHeader("table1", []string{"column1", "column2"}),
+						Header: NewHeader("table1", []string{"column1"}),
 						RecordSet: []Record{
 							{
 								NewGroupCell([]value.Primary{
+									value.NewInteger(3),
 									value.NewInteger(1),
-									value.NewNull(),
 									value.NewInteger(3),
-								}),
-								NewGroupCell([]value.Primary{
-									value.NewString("str1"),
-									value.NewString("str2"),
-									value.NewString("str3"),
+									value.NewInteger(2),
 								}),
 							},
 						},
@@ -2771,46 +2995,100 @@ var filterEvaluateTests = []struct {
 			},
 		},
 		Expr: parser.AggregateFunction{
-			Name:     "count",
-			Distinct: parser.Token{},
-			Args: []parser.QueryExpression{
-				parser.AllColumns{},
+			Name:        "mode",
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						Direction: parser.Token{Token: parser.DESC, Literal: "desc"},
+					},
+				},
 			},
 		},
 		Result: value.NewInteger(3),
 	},
 	{
-		Name:   "Aggregate Function As a Statement Error",
-		Filter: &Filter{},
+		Name: "Aggregate Function Ordered-Set Percentile",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(3),
+									value.NewInteger(1),
+									value.NewInteger(3),
+									value.NewInteger(2),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
 		Expr: parser.AggregateFunction{
-			Name:     "avg",
-			Distinct: parser.Token{},
+			Name: "percentile_disc",
 			Args: []parser.QueryExpression{
-				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.NewFloatValue(0.5),
+			},
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
 			},
 		},
-		Error: "[L:- C:-] function avg cannot be used as a statement",
+		Result: value.NewInteger(2),
 	},
 	{
-		Name: "Aggregate Function User Defined",
+		Name: "Aggregate Function Ordered-Set Without Sort Key Error",
 		Filter: &Filter{
 			Records: []FilterRecord{
 				{
 					View: &View{
-						Header: NewHeader("table1", []string{"column1", "column2"}),
+						Header: NewHeader("table1", []string{"column1"}),
 						RecordSet: []Record{
 							{
 								NewGroupCell([]value.Primary{
-									value.NewInteger(1),
-									value.NewNull(),
 									value.NewInteger(3),
+									value.NewInteger(1),
 									value.NewInteger(3),
+									value.NewInteger(2),
 								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "mode",
+		},
+		Error: "[L:- C:-] the WITHIN GROUP clause with one sort key must be specified for function mode",
+	},
+	{
+		Name: "Aggregate Function Ordered-Set Fraction Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1"}),
+						RecordSet: []Record{
+							{
 								NewGroupCell([]value.Primary{
-									value.NewString("str1"),
-									value.NewString("str2"),
-									value.NewString("str3"),
-									value.NewString("str4"),
+									value.NewInteger(3),
+									value.NewInteger(1),
+									value.NewInteger(3),
+									value.NewInteger(2),
 								}),
 							},
 						},
@@ -2819,18 +3097,394 @@ var filterEvaluateTests = []struct {
 					RecordIndex: 0,
 				},
 			},
-			Functions: UserDefinedFunctionScopes{
+		},
+		Expr: parser.AggregateFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(1.5),
+			},
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] the first argument must be a number between 0 and 1 for function percentile_cont",
+	},
+	{
+		Name: "Aggregate Function Ordered-Set Argument Length Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
 				{
-					"USERAGGFUNC": &UserDefinedFunction{
-						Name:        parser.Identifier{Literal: "useraggfunc"},
-						IsAggregate: true,
-						Cursor:      parser.Identifier{Literal: "column1"},
-						Parameters: []parser.Variable{
-							{Name: "default"},
-						},
-						RequiredArgs: 1,
-						Statements: []parser.Statement{
-							parser.VariableDeclaration{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(3),
+									value.NewInteger(1),
+									value.NewInteger(3),
+									value.NewInteger(2),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name:        "percentile_cont",
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] function percentile_cont takes exactly 1 argument",
+	},
+	{
+		Name: "Aggregate Function With Within Group Clause",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(3),
+									value.NewInteger(1),
+									value.NewInteger(3),
+									value.NewInteger(2),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "sum",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Result: value.NewInteger(9),
+	},
+	{
+		Name: "Aggregate Function With Filter Clause",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("ok"),
+									value.NewString("error"),
+									value.NewString("error"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "count",
+			Args: []parser.QueryExpression{
+				parser.AllColumns{},
+			},
+			Filter: parser.FilterClause{
+				Filter: "filter",
+				Where: parser.WhereClause{
+					Where: "where",
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						RHS:      parser.NewStringValue("error"),
+						Operator: "=",
+					},
+				},
+			},
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Aggregate Function With Filter Clause Nested Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("ok"),
+									value.NewString("error"),
+									value.NewString("error"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "sum",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+			Filter: parser.FilterClause{
+				Filter: "filter",
+				Where: parser.WhereClause{
+					Where: "where",
+					Filter: parser.AggregateFunction{
+						Name: "count",
+						Args: []parser.QueryExpression{
+							parser.AllColumns{},
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] aggregate functions are nested at sum(column1) filter (where count(*))",
+	},
+	{
+		Name: "List Function With Filter Clause",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("ok"),
+									value.NewString("error"),
+									value.NewString("error"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.ListFunction{
+			Name: "listagg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.NewStringValue(","),
+			},
+			Filter: parser.FilterClause{
+				Filter: "filter",
+				Where: parser.WhereClause{
+					Where: "where",
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						RHS:      parser.NewStringValue("error"),
+						Operator: "=",
+					},
+				},
+			},
+		},
+		Result: value.NewString("2,3"),
+	},
+	{
+		Name: "Aggregate Function Not Grouped Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							NewRecordWithId(1, []value.Primary{
+								value.NewInteger(1),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name:     "avg",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Error: "[L:- C:-] function avg cannot aggregate not grouping records",
+	},
+	{
+		Name: "Aggregate Function Nested Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewNull(),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("str1"),
+									value.NewString("str2"),
+									value.NewString("str3"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name:     "avg",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.AggregateFunction{
+					Name: "avg",
+					Args: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] aggregate functions are nested at avg(avg(column1))",
+	},
+	{
+		Name: "Aggregate Function Count With AllColumns",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewNull(),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("str1"),
+									value.NewString("str2"),
+									value.NewString("str3"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name:     "count",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.AllColumns{},
+			},
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name:   "Aggregate Function As a Statement Error",
+		Filter: &Filter{},
+		Expr: parser.AggregateFunction{
+			Name:     "avg",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Error: "[L:- C:-] function avg cannot be used as a statement",
+	},
+	{
+		Name: "Aggregate Function User Defined",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewNull(),
+									value.NewInteger(3),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("str1"),
+									value.NewString("str2"),
+									value.NewString("str3"),
+									value.NewString("str4"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+			Functions: UserDefinedFunctionScopes{
+				{
+					"USERAGGFUNC": &UserDefinedFunction{
+						Name:        parser.Identifier{Literal: "useraggfunc"},
+						IsAggregate: true,
+						Cursor:      parser.Identifier{Literal: "column1"},
+						Parameters: []parser.Variable{
+							{Name: "default"},
+						},
+						RequiredArgs: 1,
+						Statements: []parser.Statement{
+							parser.VariableDeclaration{
 								Assignments: []parser.VariableAssignment{
 									{
 										Variable: parser.Variable{Name: "value"},
@@ -3266,6 +3920,57 @@ var filterEvaluateTests = []struct {
 		},
 		Result: value.NewString("str1,str2"),
 	},
+	{
+		Name: "GroupConcat Function",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("str2"),
+									value.NewString("str1"),
+									value.NewNull(),
+									value.NewString("str2"),
+								}),
+							},
+						},
+						Filter:    NewEmptyFilter(),
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.ListFunction{
+			Name:     "group_concat",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewStringValue(","),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: value.NewString("str1,str2"),
+	},
 	{
 		Name: "ListAgg Function Null",
 		Filter: &Filter{
@@ -3646,6 +4351,243 @@ var filterEvaluateTests = []struct {
 		},
 		Error: "[L:- C:-] function json_agg takes exactly 1 argument",
 	},
+	{
+		Name: "ArrayAgg Function",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("str2"),
+									value.NewNull(),
+									value.NewString("str1"),
+								}),
+							},
+						},
+						Filter:    NewEmptyFilter(),
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.ListFunction{
+			Name: "array_agg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Result: value.NewArray([]value.Primary{
+			value.NewString("str2"),
+			value.NewNull(),
+			value.NewString("str1"),
+		}),
+	},
+	{
+		Name: "ArrayValue",
+		Expr: parser.ArrayValue{
+			Values: []parser.QueryExpression{
+				parser.NewIntegerValue(1),
+				parser.NewStringValue("a"),
+			},
+		},
+		Result: value.NewArray([]value.Primary{
+			value.NewInteger(1),
+			value.NewString("a"),
+		}),
+	},
+	{
+		Name: "ArrayValue Evaluation Error",
+		Expr: parser.ArrayValue{
+			Values: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "ArrayElement",
+		Expr: parser.ArrayElement{
+			Array: parser.ArrayValue{
+				Values: []parser.QueryExpression{
+					parser.NewIntegerValue(1),
+					parser.NewStringValue("a"),
+				},
+			},
+			Index: parser.NewStringValue("1"),
+		},
+		Result: value.NewString("a"),
+	},
+	{
+		Name: "ArrayElement Index Out of Range",
+		Expr: parser.ArrayElement{
+			Array: parser.ArrayValue{
+				Values: []parser.QueryExpression{
+					parser.NewIntegerValue(1),
+				},
+			},
+			Index: parser.NewIntegerValue(1),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ArrayElement Not Array",
+		Expr: parser.ArrayElement{
+			Array: parser.NewStringValue("abc"),
+			Index: parser.NewIntegerValue(0),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ArrayElement Map Key",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1"}),
+						RecordSet: []Record{
+							NewRecord([]value.Primary{
+								value.NewMap([]string{"key1"}, []value.Primary{value.NewString("a")}),
+							}),
+						},
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.ArrayElement{
+			Array: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			Index: parser.NewStringValue("key1"),
+		},
+		Result: value.NewString("a"),
+	},
+	{
+		Name: "MemberReference",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1"}),
+						RecordSet: []Record{
+							NewRecord([]value.Primary{
+								value.NewMap([]string{"key1"}, []value.Primary{value.NewString("a")}),
+							}),
+						},
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.MemberReference{
+			Value:  parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+			Member: parser.Identifier{Literal: "key1"},
+		},
+		Result: value.NewString("a"),
+	},
+	{
+		Name: "MemberReference Key Not Exist",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1"}),
+						RecordSet: []Record{
+							NewRecord([]value.Primary{
+								value.NewMap([]string{"key1"}, []value.Primary{value.NewString("a")}),
+							}),
+						},
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.MemberReference{
+			Value:  parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			Member: parser.Identifier{Literal: "notexist"},
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "MemberReference Not Map",
+		Expr: parser.MemberReference{
+			Value:  parser.NewStringValue("abc"),
+			Member: parser.Identifier{Literal: "key1"},
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "FieldReference Map Member",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1"}),
+						RecordSet: []Record{
+							NewRecord([]value.Primary{
+								value.NewMap([]string{"key1"}, []value.Primary{value.NewString("a")}),
+							}),
+						},
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr:   parser.FieldReference{View: parser.Identifier{Literal: "column1"}, Column: parser.Identifier{Literal: "key1"}},
+		Result: value.NewString("a"),
+	},
+	{
+		Name: "FieldReference Map Member of Null",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1"}),
+						RecordSet: []Record{
+							NewRecord([]value.Primary{
+								value.NewNull(),
+							}),
+						},
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr:   parser.FieldReference{View: parser.Identifier{Literal: "column1"}, Column: parser.Identifier{Literal: "key1"}},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "FieldReference Map Member of Not Map",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1"}),
+						RecordSet: []Record{
+							NewRecord([]value.Primary{
+								value.NewString("a"),
+							}),
+						},
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr:  parser.FieldReference{View: parser.Identifier{Literal: "column1"}, Column: parser.Identifier{Literal: "key1"}},
+		Error: "[L:- C:-] field column1.key1 does not exist",
+	},
 	{
 		Name: "CaseExpr Comparison",
 		Expr: parser.CaseExpr{
@@ -1,18 +1,24 @@
 package query
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
 	"crypto/hmac"
 	"crypto/md5"
+	"crypto/rand"
 	"crypto/sha1"
 	"crypto/sha256"
 	"crypto/sha512"
 	"encoding/base64"
 	"encoding/hex"
 	"hash"
+	"hash/fnv"
 	"math"
+	"net/url"
 	"os/exec"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 	"unicode/utf8"
@@ -24,115 +30,284 @@ import (
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/ternary"
+	"golang.org/x/crypto/pbkdf2"
 )
 
 var Functions = map[string]func(parser.Function, []value.Primary) (value.Primary, error){
-	"COALESCE":         Coalesce,
-	"IF":               If,
-	"IFNULL":           Ifnull,
-	"NULLIF":           Nullif,
-	"CEIL":             Ceil,
-	"FLOOR":            Floor,
-	"ROUND":            Round,
-	"ABS":              Abs,
-	"ACOS":             Acos,
-	"ASIN":             Asin,
-	"ATAN":             Atan,
-	"ATAN2":            Atan2,
-	"COS":              Cos,
-	"SIN":              Sin,
-	"TAN":              Tan,
-	"EXP":              Exp,
-	"EXP2":             Exp2,
-	"EXPM1":            Expm1,
-	"LOG":              MathLog,
-	"LOG10":            Log10,
-	"LOG2":             Log2,
-	"LOG1P":            Log1p,
-	"SQRT":             Sqrt,
-	"POW":              Pow,
-	"BIN_TO_DEC":       BinToDec,
-	"OCT_TO_DEC":       OctToDec,
-	"HEX_TO_DEC":       HexToDec,
-	"ENOTATION_TO_DEC": EnotationToDec,
-	"BIN":              Bin,
-	"OCT":              Oct,
-	"HEX":              Hex,
-	"ENOTATION":        Enotation,
-	"NUMBER_FORMAT":    NumberFormat,
-	"RAND":             Rand,
-	"TRIM":             Trim,
-	"LTRIM":            Ltrim,
-	"RTRIM":            Rtrim,
-	"UPPER":            Upper,
-	"LOWER":            Lower,
-	"BASE64_ENCODE":    Base64Encode,
-	"BASE64_DECODE":    Base64Decode,
-	"HEX_ENCODE":       HexEncode,
-	"HEX_DECODE":       HexDecode,
-	"LEN":              Len,
-	"BYTE_LEN":         ByteLen,
-	"WIDTH":            Width,
-	"LPAD":             Lpad,
-	"RPAD":             Rpad,
-	"SUBSTR":           Substr,
-	"INSTR":            Instr,
-	"LIST_ELEM":        ListElem,
-	"REPLACE":          Replace,
-	"FORMAT":           Format,
-	"JSON_VALUE":       JsonValue,
-	"MD5":              Md5,
-	"SHA1":             Sha1,
-	"SHA256":           Sha256,
-	"SHA512":           Sha512,
-	"MD5_HMAC":         Md5Hmac,
-	"SHA1_HMAC":        Sha1Hmac,
-	"SHA256_HMAC":      Sha256Hmac,
-	"SHA512_HMAC":      Sha512Hmac,
-	"DATETIME_FORMAT":  DatetimeFormat,
-	"YEAR":             Year,
-	"MONTH":            Month,
-	"DAY":              Day,
-	"HOUR":             Hour,
-	"MINUTE":           Minute,
-	"SECOND":           Second,
-	"MILLISECOND":      Millisecond,
-	"MICROSECOND":      Microsecond,
-	"NANOSECOND":       Nanosecond,
-	"WEEKDAY":          Weekday,
-	"UNIX_TIME":        UnixTime,
-	"UNIX_NANO_TIME":   UnixNanoTime,
-	"DAY_OF_YEAR":      DayOfYear,
-	"WEEK_OF_YEAR":     WeekOfYear,
-	"ADD_YEAR":         AddYear,
-	"ADD_MONTH":        AddMonth,
-	"ADD_DAY":          AddDay,
-	"ADD_HOUR":         AddHour,
-	"ADD_MINUTE":       AddMinute,
-	"ADD_SECOND":       AddSecond,
-	"ADD_MILLI":        AddMilli,
-	"ADD_MICRO":        AddMicro,
-	"ADD_NANO":         AddNano,
-	"TRUNC_MONTH":      TruncMonth,
-	"TRUNC_DAY":        TruncDay,
-	"TRUNC_TIME":       TruncTime,
-	"TRUNC_HOUR":       TruncTime,
-	"TRUNC_MINUTE":     TruncMinute,
-	"TRUNC_SECOND":     TruncSecond,
-	"TRUNC_MILLI":      TruncMilli,
-	"TRUNC_MICRO":      TruncMicro,
-	"TRUNC_NANO":       TruncNano,
-	"DATE_DIFF":        DateDiff,
-	"TIME_DIFF":        TimeDiff,
-	"TIME_NANO_DIFF":   TimeNanoDiff,
-	"UTC":              UTC,
-	"STRING":           String,
-	"INTEGER":          Integer,
-	"FLOAT":            Float,
-	"BOOLEAN":          Boolean,
-	"TERNARY":          Ternary,
-	"DATETIME":         Datetime,
-	"CALL":             Call,
+	"COALESCE":             Coalesce,
+	"IF":                   If,
+	"IFNULL":               Ifnull,
+	"NULLIF":               Nullif,
+	"CEIL":                 Ceil,
+	"FLOOR":                Floor,
+	"ROUND":                Round,
+	"ABS":                  Abs,
+	"ACOS":                 Acos,
+	"ASIN":                 Asin,
+	"ATAN":                 Atan,
+	"ATAN2":                Atan2,
+	"COS":                  Cos,
+	"SIN":                  Sin,
+	"TAN":                  Tan,
+	"EXP":                  Exp,
+	"EXP2":                 Exp2,
+	"EXPM1":                Expm1,
+	"LOG":                  MathLog,
+	"LOG10":                Log10,
+	"LOG2":                 Log2,
+	"LOG1P":                Log1p,
+	"SQRT":                 Sqrt,
+	"POW":                  Pow,
+	"HAVERSINE_DISTANCE":   HaversineDistance,
+	"IN_BOUNDING_BOX":      InBoundingBox,
+	"BIN_TO_DEC":           BinToDec,
+	"OCT_TO_DEC":           OctToDec,
+	"HEX_TO_DEC":           HexToDec,
+	"ENOTATION_TO_DEC":     EnotationToDec,
+	"BIN":                  Bin,
+	"OCT":                  Oct,
+	"HEX":                  Hex,
+	"ENOTATION":            Enotation,
+	"NUMBER_FORMAT":        NumberFormat,
+	"RAND":                 Rand,
+	"TRIM":                 Trim,
+	"LTRIM":                Ltrim,
+	"RTRIM":                Rtrim,
+	"UPPER":                Upper,
+	"LOWER":                Lower,
+	"BASE64_ENCODE":        Base64Encode,
+	"BASE64_DECODE":        Base64Decode,
+	"TO_BASE64":            Base64Encode,
+	"FROM_BASE64":          Base64Decode,
+	"HEX_ENCODE":           HexEncode,
+	"HEX_DECODE":           HexDecode,
+	"TO_HEX":               HexEncode,
+	"FROM_HEX":             HexDecode,
+	"URL_ENCODE":           UrlEncode,
+	"URL_DECODE":           UrlDecode,
+	"URL_EXTRACT":          UrlExtract,
+	"LEN":                  Len,
+	"BYTE_LEN":             ByteLen,
+	"WIDTH":                Width,
+	"LPAD":                 Lpad,
+	"RPAD":                 Rpad,
+	"SUBSTR":               Substr,
+	"INSTR":                Instr,
+	"LIST_ELEM":            ListElem,
+	"SPLIT":                Split,
+	"REPLACE":              Replace,
+	"FORMAT":               Format,
+	"EDIT_DISTANCE":        EditDistance,
+	"JARO_WINKLER":         JaroWinkler,
+	"SOUNDEX":              Soundex,
+	"METAPHONE":            Metaphone,
+	"JSON_VALUE":           JsonValue,
+	"ARRAY_LENGTH":         ArrayLength,
+	"CONTAINS":             Contains,
+	"IS_UUID":              IsUuid,
+	"MD5":                  Md5,
+	"SHA1":                 Sha1,
+	"SHA256":               Sha256,
+	"SHA512":               Sha512,
+	"MD5_HMAC":             Md5Hmac,
+	"SHA1_HMAC":            Sha1Hmac,
+	"SHA256_HMAC":          Sha256Hmac,
+	"SHA512_HMAC":          Sha512Hmac,
+	"FNV32":                Fnv32,
+	"FNV64":                Fnv64,
+	"XXHASH64":             XxHash64,
+	"AES_ENCRYPT":          AesEncrypt,
+	"AES_DECRYPT":          AesDecrypt,
+	"DATETIME_FORMAT":      DatetimeFormat,
+	"CONVERT_TZ":           ConvertTz,
+	"YEAR":                 Year,
+	"MONTH":                Month,
+	"DAY":                  Day,
+	"HOUR":                 Hour,
+	"MINUTE":               Minute,
+	"SECOND":               Second,
+	"MILLISECOND":          Millisecond,
+	"MICROSECOND":          Microsecond,
+	"NANOSECOND":           Nanosecond,
+	"WEEKDAY":              Weekday,
+	"UNIX_TIME":            UnixTime,
+	"UNIX_MILLI_TIME":      UnixMilliTime,
+	"UNIX_MICRO_TIME":      UnixMicroTime,
+	"UNIX_NANO_TIME":       UnixNanoTime,
+	"FROM_UNIX_TIME":       FromUnixTime,
+	"FROM_UNIX_MILLI_TIME": FromUnixMilliTime,
+	"FROM_UNIX_MICRO_TIME": FromUnixMicroTime,
+	"FROM_UNIX_NANO_TIME":  FromUnixNanoTime,
+	"DAY_OF_YEAR":          DayOfYear,
+	"WEEK_OF_YEAR":         WeekOfYear,
+	"ADD_YEAR":             AddYear,
+	"ADD_MONTH":            AddMonth,
+	"ADD_DAY":              AddDay,
+	"ADD_HOUR":             AddHour,
+	"ADD_MINUTE":           AddMinute,
+	"ADD_SECOND":           AddSecond,
+	"ADD_MILLI":            AddMilli,
+	"ADD_MICRO":            AddMicro,
+	"ADD_NANO":             AddNano,
+	"TRUNC_MONTH":          TruncMonth,
+	"TRUNC_DAY":            TruncDay,
+	"TRUNC_TIME":           TruncTime,
+	"TRUNC_HOUR":           TruncTime,
+	"TRUNC_MINUTE":         TruncMinute,
+	"TRUNC_SECOND":         TruncSecond,
+	"TRUNC_MILLI":          TruncMilli,
+	"TRUNC_MICRO":          TruncMicro,
+	"TRUNC_NANO":           TruncNano,
+	"DATE_DIFF":            DateDiff,
+	"TIME_DIFF":            TimeDiff,
+	"TIME_NANO_DIFF":       TimeNanoDiff,
+	"UTC":                  UTC,
+	"STRING":               String,
+	"INTEGER":              Integer,
+	"FLOAT":                Float,
+	"BOOLEAN":              Boolean,
+	"TERNARY":              Ternary,
+	"DATETIME":             Datetime,
+	"CALL":                 Call,
+}
+
+// FunctionArgsLen holds the numbers of the arguments that the built-in functions take.
+// Functions taking any number of the arguments are not included.
+var FunctionArgsLen = map[string][]int{
+	"IF":                   {3},
+	"IFNULL":               {2},
+	"NULLIF":               {2},
+	"CEIL":                 {1, 2},
+	"FLOOR":                {1, 2},
+	"ROUND":                {1, 2},
+	"ABS":                  {1},
+	"ACOS":                 {1},
+	"ASIN":                 {1},
+	"ATAN":                 {1},
+	"ATAN2":                {2},
+	"COS":                  {1},
+	"SIN":                  {1},
+	"TAN":                  {1},
+	"EXP":                  {1},
+	"EXP2":                 {1},
+	"EXPM1":                {1},
+	"LOG":                  {1},
+	"LOG10":                {1},
+	"LOG2":                 {1},
+	"LOG1P":                {1},
+	"SQRT":                 {1},
+	"POW":                  {2},
+	"HAVERSINE_DISTANCE":   {4},
+	"IN_BOUNDING_BOX":      {6},
+	"BIN_TO_DEC":           {1},
+	"OCT_TO_DEC":           {1},
+	"HEX_TO_DEC":           {1},
+	"ENOTATION_TO_DEC":     {1},
+	"BIN":                  {1},
+	"OCT":                  {1},
+	"HEX":                  {1},
+	"ENOTATION":            {1},
+	"NUMBER_FORMAT":        {1, 2, 3, 4, 5},
+	"RAND":                 {0, 2},
+	"TRIM":                 {1, 2},
+	"LTRIM":                {1, 2},
+	"RTRIM":                {1, 2},
+	"UPPER":                {1},
+	"LOWER":                {1},
+	"BASE64_ENCODE":        {1},
+	"BASE64_DECODE":        {1},
+	"TO_BASE64":            {1},
+	"FROM_BASE64":          {1},
+	"HEX_ENCODE":           {1},
+	"HEX_DECODE":           {1},
+	"TO_HEX":               {1},
+	"FROM_HEX":             {1},
+	"URL_ENCODE":           {1},
+	"URL_DECODE":           {1},
+	"URL_EXTRACT":          {2, 3},
+	"LEN":                  {1},
+	"BYTE_LEN":             {1, 2},
+	"WIDTH":                {1},
+	"LPAD":                 {3, 4, 5},
+	"RPAD":                 {3, 4, 5},
+	"SUBSTR":               {2, 3},
+	"INSTR":                {2},
+	"LIST_ELEM":            {3},
+	"SPLIT":                {2},
+	"REPLACE":              {3},
+	"EDIT_DISTANCE":        {2},
+	"JARO_WINKLER":         {2},
+	"SOUNDEX":              {1},
+	"METAPHONE":            {1},
+	"JSON_VALUE":           {2},
+	"ARRAY_LENGTH":         {1},
+	"CONTAINS":             {2},
+	"UUID":                 {0, 1},
+	"IS_UUID":              {1},
+	"MD5":                  {1},
+	"SHA1":                 {1},
+	"SHA256":               {1},
+	"SHA512":               {1},
+	"MD5_HMAC":             {2},
+	"SHA1_HMAC":            {2},
+	"SHA256_HMAC":          {2},
+	"SHA512_HMAC":          {2},
+	"FNV32":                {1},
+	"FNV64":                {1},
+	"XXHASH64":             {1},
+	"AES_ENCRYPT":          {2, 3},
+	"AES_DECRYPT":          {2, 3},
+	"DATETIME_FORMAT":      {2},
+	"CONVERT_TZ":           {3},
+	"YEAR":                 {1},
+	"MONTH":                {1},
+	"DAY":                  {1},
+	"HOUR":                 {1},
+	"MINUTE":               {1},
+	"SECOND":               {1},
+	"MILLISECOND":          {1},
+	"MICROSECOND":          {1},
+	"NANOSECOND":           {1},
+	"WEEKDAY":              {1},
+	"UNIX_TIME":            {1},
+	"UNIX_MILLI_TIME":      {1},
+	"UNIX_MICRO_TIME":      {1},
+	"UNIX_NANO_TIME":       {1},
+	"FROM_UNIX_TIME":       {1},
+	"FROM_UNIX_MILLI_TIME": {1},
+	"FROM_UNIX_MICRO_TIME": {1},
+	"FROM_UNIX_NANO_TIME":  {1},
+	"DAY_OF_YEAR":          {1},
+	"WEEK_OF_YEAR":         {1},
+	"ADD_YEAR":             {2},
+	"ADD_MONTH":            {2},
+	"ADD_DAY":              {2},
+	"ADD_HOUR":             {2},
+	"ADD_MINUTE":           {2},
+	"ADD_SECOND":           {2},
+	"ADD_MILLI":            {2},
+	"ADD_MICRO":            {2},
+	"ADD_NANO":             {2},
+	"TRUNC_MONTH":          {1},
+	"TRUNC_DAY":            {1},
+	"TRUNC_TIME":           {1},
+	"TRUNC_HOUR":           {1},
+	"TRUNC_MINUTE":         {1},
+	"TRUNC_SECOND":         {1},
+	"TRUNC_MILLI":          {1},
+	"TRUNC_MICRO":          {1},
+	"TRUNC_NANO":           {1},
+	"DATE_DIFF":            {2},
+	"TIME_DIFF":            {2},
+	"TIME_NANO_DIFF":       {2},
+	"UTC":                  {1},
+	"STRING":               {1},
+	"INTEGER":              {1},
+	"FLOAT":                {1},
+	"BOOLEAN":              {1},
+	"TERNARY":              {1},
+	"DATETIME":             {1},
+	"NOW":                  {0},
 }
 
 type Direction string
@@ -378,6 +553,70 @@ func Pow(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return execMath2Args(fn, args, math.Pow)
 }
 
+// EarthRadius is the mean radius of the earth in meters used to calculate distances.
+const EarthRadius = 6371008.8
+
+func execFloatArgs(fn parser.Function, args []value.Primary, n int) ([]float64, bool, error) {
+	if len(args) != n {
+		return nil, false, NewFunctionArgumentLengthError(fn, fn.Name, []int{n})
+	}
+
+	floats := make([]float64, n)
+	for i := range args {
+		f := value.ToFloat(args[i])
+		if value.IsNull(f) {
+			return nil, false, nil
+		}
+		floats[i] = f.(value.Float).Raw()
+	}
+	return floats, true, nil
+}
+
+func HaversineDistance(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	f, ok, err := execFloatArgs(fn, args, 4)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return value.NewNull(), nil
+	}
+
+	lat1 := f[0] * math.Pi / 180
+	lat2 := f[2] * math.Pi / 180
+	dlat := (f[2] - f[0]) * math.Pi / 180
+	dlon := (f[3] - f[1]) * math.Pi / 180
+
+	h := math.Pow(math.Sin(dlat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dlon/2), 2)
+	if 1 < h {
+		h = 1
+	}
+	return value.NewFloat(2 * EarthRadius * math.Asin(math.Sqrt(h))), nil
+}
+
+func InBoundingBox(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	f, ok, err := execFloatArgs(fn, args, 6)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return value.NewTernary(ternary.UNKNOWN), nil
+	}
+
+	lat, lon, south, west, north, east := f[0], f[1], f[2], f[3], f[4], f[5]
+	if lat < south || north < lat {
+		return value.NewTernary(ternary.FALSE), nil
+	}
+
+	var inLon bool
+	if west <= east {
+		inLon = west <= lon && lon <= east
+	} else {
+		// The box crosses the 180th meridian.
+		inLon = west <= lon || lon <= east
+	}
+	return value.NewTernary(ternary.ConvertFromBool(inLon)), nil
+}
+
 func execParseInt(fn parser.Function, args []value.Primary, base int) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
@@ -546,6 +785,23 @@ func Rand(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return value.NewInteger(r.Int63n(delta) + low), nil
 }
 
+func execStringsDecode(fn parser.Function, args []value.Primary, decodef func(string) ([]byte, error)) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+
+	decoded, err := decodef(s.(value.String).Raw())
+	if err != nil {
+		return value.NewNull(), nil
+	}
+	return value.NewString(string(decoded)), nil
+}
+
 func execStrings1Arg(fn parser.Function, args []value.Primary, stringsf func(string) string) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
@@ -587,18 +843,17 @@ func base64Encode(s string) string {
 	return base64.StdEncoding.EncodeToString([]byte(s))
 }
 
-func base64Decode(s string) string {
-	bytes, _ := base64.StdEncoding.DecodeString(s)
-	return string(bytes)
-}
-
 func hexEncode(s string) string {
 	return hex.EncodeToString([]byte(s))
 }
 
-func hexDecode(s string) string {
-	bytes, _ := hex.DecodeString(s)
-	return string(bytes)
+func urlEncode(s string) string {
+	return url.QueryEscape(s)
+}
+
+func urlDecode(s string) string {
+	decoded, _ := url.QueryUnescape(s)
+	return decoded
 }
 
 func trim(s string, cutset string) string {
@@ -636,6 +891,22 @@ func execStringsLen(fn parser.Function, args []value.Primary, stringsf func(stri
 	return value.NewInteger(int64(result)), nil
 }
 
+func execStrings2Args(fn parser.Function, args []value.Primary) (string, string, bool, error) {
+	if len(args) != 2 {
+		return "", "", false, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	s1 := value.ToString(args[0])
+	if value.IsNull(s1) {
+		return "", "", false, nil
+	}
+	s2 := value.ToString(args[1])
+	if value.IsNull(s2) {
+		return "", "", false, nil
+	}
+	return s1.(value.String).Raw(), s2.(value.String).Raw(), true, nil
+}
+
 func execStringsPadding(fn parser.Function, args []value.Primary, direction Direction) (value.Primary, error) {
 	if len(args) < 3 || 5 < len(args) {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{3, 4, 5})
@@ -810,7 +1081,7 @@ func Base64Encode(fn parser.Function, args []value.Primary) (value.Primary, erro
 }
 
 func Base64Decode(fn parser.Function, args []value.Primary) (value.Primary, error) {
-	return execStrings1Arg(fn, args, base64Decode)
+	return execStringsDecode(fn, args, base64.StdEncoding.DecodeString)
 }
 
 func HexEncode(fn parser.Function, args []value.Primary) (value.Primary, error) {
@@ -818,7 +1089,208 @@ func HexEncode(fn parser.Function, args []value.Primary) (value.Primary, error)
 }
 
 func HexDecode(fn parser.Function, args []value.Primary) (value.Primary, error) {
-	return execStrings1Arg(fn, args, hexDecode)
+	return execStringsDecode(fn, args, hex.DecodeString)
+}
+
+func UrlEncode(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return execStrings1Arg(fn, args, urlEncode)
+}
+
+func UrlDecode(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return execStrings1Arg(fn, args, urlDecode)
+}
+
+func UrlExtract(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) < 2 || 3 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+
+	p := value.ToString(args[1])
+	if value.IsNull(p) {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be one of SCHEME|USER|HOST|PORT|PATH|QUERY|FRAGMENT")
+	}
+	part := strings.ToUpper(p.(value.String).Raw())
+	switch part {
+	case "SCHEME", "USER", "HOST", "PORT", "PATH", "QUERY", "FRAGMENT":
+	default:
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be one of SCHEME|USER|HOST|PORT|PATH|QUERY|FRAGMENT")
+	}
+
+	var key value.Primary
+	if 2 < len(args) {
+		if part != "QUERY" {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the third argument can be specified only for the QUERY part")
+		}
+		key = value.ToString(args[2])
+		if value.IsNull(key) {
+			return value.NewNull(), nil
+		}
+	}
+
+	u, err := url.Parse(s.(value.String).Raw())
+	if err != nil {
+		return value.NewNull(), nil
+	}
+
+	var result string
+	switch part {
+	case "SCHEME":
+		result = u.Scheme
+	case "USER":
+		if u.User != nil {
+			result = u.User.Username()
+		}
+	case "HOST":
+		result = u.Hostname()
+	case "PORT":
+		result = u.Port()
+	case "PATH":
+		result = u.Path
+	case "QUERY":
+		if key == nil {
+			result = u.RawQuery
+		} else {
+			values, ok := u.Query()[key.(value.String).Raw()]
+			if !ok {
+				return value.NewNull(), nil
+			}
+			result = values[0]
+		}
+	case "FRAGMENT":
+		result = u.Fragment
+	}
+
+	if len(result) < 1 {
+		return value.NewNull(), nil
+	}
+	return value.NewString(result), nil
+}
+
+func EditDistance(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	s1, s2, ok, err := execStrings2Args(fn, args)
+	if err != nil || !ok {
+		return value.NewNull(), err
+	}
+	return value.NewInteger(int64(editDistance([]rune(s1), []rune(s2)))), nil
+}
+
+func JaroWinkler(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	s1, s2, ok, err := execStrings2Args(fn, args)
+	if err != nil || !ok {
+		return value.NewNull(), err
+	}
+	return value.NewFloat(jaroWinkler([]rune(s1), []rune(s2))), nil
+}
+
+// editDistance returns the Levenshtein distance between r1 and r2.
+func editDistance(r1 []rune, r2 []rune) int {
+	row := make([]int, len(r2)+1)
+	for j := range row {
+		row[j] = j
+	}
+
+	for i := 1; i <= len(r1); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(r2); j++ {
+			cost := 1
+			if r1[i-1] == r2[j-1] {
+				cost = 0
+			}
+			d := prev + cost
+			if row[j]+1 < d {
+				d = row[j] + 1
+			}
+			if row[j-1]+1 < d {
+				d = row[j-1] + 1
+			}
+			prev = row[j]
+			row[j] = d
+		}
+	}
+	return row[len(r2)]
+}
+
+// jaroWinkler returns the Jaro-Winkler similarity between r1 and r2
+// with the prefix scale 0.1 and the maximum prefix length 4.
+func jaroWinkler(r1 []rune, r2 []rune) float64 {
+	if len(r1) < 1 && len(r2) < 1 {
+		return 1
+	}
+	if len(r1) < 1 || len(r2) < 1 {
+		return 0
+	}
+
+	window := len(r1)
+	if window < len(r2) {
+		window = len(r2)
+	}
+	window = window/2 - 1
+	if window < 0 {
+		window = 0
+	}
+
+	matched1 := make([]bool, len(r1))
+	matched2 := make([]bool, len(r2))
+	matches := 0
+	for i := range r1 {
+		start := i - window
+		if start < 0 {
+			start = 0
+		}
+		end := i + window + 1
+		if len(r2) < end {
+			end = len(r2)
+		}
+		for j := start; j < end; j++ {
+			if !matched2[j] && r1[i] == r2[j] {
+				matched1[i] = true
+				matched2[j] = true
+				matches++
+				break
+			}
+		}
+	}
+	if matches < 1 {
+		return 0
+	}
+
+	transpositions := 0
+	j := 0
+	for i := range r1 {
+		if !matched1[i] {
+			continue
+		}
+		for !matched2[j] {
+			j++
+		}
+		if r1[i] != r2[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(r1)) + m/float64(len(r2)) + (m-float64(transpositions/2))/m) / 3
+
+	prefix := 0
+	for prefix < len(r1) && prefix < len(r2) && prefix < 4 && r1[prefix] == r2[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+func Soundex(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return execStrings1Arg(fn, args, soundex)
+}
+
+func Metaphone(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return execStrings1Arg(fn, args, metaphone)
 }
 
 func Len(fn parser.Function, args []value.Primary) (value.Primary, error) {
@@ -962,6 +1434,30 @@ func ListElem(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return value.NewString(list[index]), nil
 }
 
+func Split(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+
+	sep := value.ToString(args[1])
+	if value.IsNull(sep) {
+		return value.NewNull(), nil
+	}
+
+	list := strings.Split(s.(value.String).Raw(), sep.(value.String).Raw())
+
+	values := make([]value.Primary, len(list))
+	for i, v := range list {
+		values[i] = value.NewString(v)
+	}
+	return value.NewArray(values), nil
+}
+
 func Replace(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	if 3 != len(args) {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{3})
@@ -1025,6 +1521,150 @@ func JsonValue(fn parser.Function, args []value.Primary) (value.Primary, error)
 	return v, nil
 }
 
+func ArrayLength(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	array, ok := args[0].(value.Array)
+	if !ok {
+		return value.NewNull(), nil
+	}
+	return value.NewInteger(int64(array.Len())), nil
+}
+
+func Contains(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	array, ok := args[0].(value.Array)
+	if !ok {
+		return value.NewTernary(ternary.UNKNOWN), nil
+	}
+
+	result := ternary.FALSE
+	for _, v := range array.Raw() {
+		switch value.Equal(args[1], v) {
+		case ternary.TRUE:
+			return value.NewTernary(ternary.TRUE), nil
+		case ternary.UNKNOWN:
+			result = ternary.UNKNOWN
+		}
+	}
+	return value.NewTernary(result), nil
+}
+
+type uuidClock struct {
+	ms  int64
+	seq uint16
+	mtx sync.Mutex
+}
+
+var uuidV7Clock = &uuidClock{}
+
+// Next returns the timestamp and the counter of a version 7 UUID by the method 1 of RFC 9562.
+func (c *uuidClock) Next(ms int64, random uint16) (int64, uint16) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.ms < ms {
+		c.ms = ms
+		c.seq = random & 0x07ff
+	} else if c.seq++; 0x0fff < c.seq {
+		c.ms++
+		c.seq = random & 0x07ff
+	}
+	return c.ms, c.seq
+}
+
+func Uuid(fn parser.Function, args []value.Primary, filter *Filter) (value.Primary, error) {
+	if 1 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0, 1})
+	}
+
+	version := int64(4)
+	if 0 < len(args) {
+		p := value.ToInteger(args[0])
+		if value.IsNull(p) {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the first argument must be an integer")
+		}
+		version = p.(value.Integer).Raw()
+	}
+
+	var u [16]byte
+	if _, err := rand.Read(u[:]); err != nil {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+	}
+
+	switch version {
+	case 4:
+		u[6] = (u[6] & 0x0f) | 0x40
+	case 7:
+		now := filter.Now
+		if now.IsZero() {
+			now = cmd.Now()
+		}
+		ms, seq := uuidV7Clock.Next(now.UnixNano()/int64(time.Millisecond), uint16(u[6])<<8|uint16(u[7]))
+		for i := 0; i < 6; i++ {
+			u[i] = byte(ms >> uint(40-i*8))
+		}
+		u[6] = 0x70 | byte(seq>>8)
+		u[7] = byte(seq)
+	default:
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the version must be 4 or 7")
+	}
+	u[8] = (u[8] & 0x3f) | 0x80
+
+	return value.NewString(formatUuid(u)), nil
+}
+
+func formatUuid(u [16]byte) string {
+	buf := make([]byte, 36)
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf)
+}
+
+func IsUuid(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewTernary(ternary.UNKNOWN), nil
+	}
+	return value.NewTernary(ternary.ConvertFromBool(isUuid(s.(value.String).Raw()))), nil
+}
+
+func isUuid(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return false
+			}
+		default:
+			c := s[i]
+			if !('0' <= c && c <= '9') && !('a' <= c && c <= 'f') && !('A' <= c && c <= 'F') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func Md5(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return execCrypto(fn, args, md5.New)
 }
@@ -1057,6 +1697,174 @@ func Sha512Hmac(fn parser.Function, args []value.Primary) (value.Primary, error)
 	return execCryptoHMAC(fn, args, sha512.New)
 }
 
+func Fnv32(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return execCrypto(fn, args, func() hash.Hash { return fnv.New32a() })
+}
+
+func Fnv64(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return execCrypto(fn, args, func() hash.Hash { return fnv.New64a() })
+}
+
+func XxHash64(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return execCrypto(fn, args, newXxHash64)
+}
+
+const (
+	aesSaltSize      = 16
+	aesKeyIterations = 100000
+	aesKeyCacheLimit = 256
+)
+
+type aesKeyCache struct {
+	salts map[string][]byte
+	keys  map[string][]byte
+	mtx   sync.Mutex
+}
+
+var aesKeys = &aesKeyCache{
+	salts: make(map[string][]byte),
+	keys:  make(map[string][]byte),
+}
+
+func (c *aesKeyCache) Salt(passphrase string) ([]byte, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if salt, ok := c.salts[passphrase]; ok {
+		return salt, nil
+	}
+
+	salt := make([]byte, aesSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	if aesKeyCacheLimit <= len(c.salts) {
+		c.salts = make(map[string][]byte)
+	}
+	c.salts[passphrase] = salt
+	return salt, nil
+}
+
+func (c *aesKeyCache) Key(passphrase string, salt []byte) []byte {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	id := passphrase + "\x00" + string(salt)
+	if key, ok := c.keys[id]; ok {
+		return key
+	}
+
+	key := pbkdf2.Key([]byte(passphrase), salt, aesKeyIterations, 32, sha256.New)
+	if aesKeyCacheLimit <= len(c.keys) {
+		c.keys = make(map[string][]byte)
+	}
+	c.keys[id] = key
+	return key
+}
+
+func newAesAEAD(passphrase string, salt []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(aesKeys.Key(passphrase, salt))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func execAes(fn parser.Function, args []value.Primary) (string, string, string, error) {
+	if len(args) < 2 || 3 < len(args) {
+		return "", "", "", NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return "", "", "", nil
+	}
+	key := value.ToString(args[1])
+	if value.IsNull(key) {
+		return "", "", "", nil
+	}
+
+	format := "BASE64"
+	if 2 < len(args) {
+		f := value.ToString(args[2])
+		if !value.IsNull(f) {
+			format = strings.ToUpper(f.(value.String).Raw())
+		}
+		if format != "BASE64" && format != "HEX" {
+			return "", "", "", NewFunctionInvalidArgumentError(fn, fn.Name, "the third argument must be one of BASE64|HEX")
+		}
+	}
+
+	return s.(value.String).Raw(), key.(value.String).Raw(), format, nil
+}
+
+func AesEncrypt(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	s, passphrase, format, err := execAes(fn, args)
+	if err != nil {
+		return nil, err
+	}
+	if len(format) < 1 {
+		return value.NewNull(), nil
+	}
+
+	salt, err := aesKeys.Salt(passphrase)
+	if err != nil {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+	}
+	aead, err := newAesAEAD(passphrase, salt)
+	if err != nil {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+	}
+	sealed := append(append(make([]byte, 0, len(salt)+len(nonce)), salt...), nonce...)
+	sealed = aead.Seal(sealed, nonce, []byte(s), nil)
+
+	if format == "HEX" {
+		return value.NewString(hex.EncodeToString(sealed)), nil
+	}
+	return value.NewString(base64.StdEncoding.EncodeToString(sealed)), nil
+}
+
+func AesDecrypt(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	s, passphrase, format, err := execAes(fn, args)
+	if err != nil {
+		return nil, err
+	}
+	if len(format) < 1 {
+		return value.NewNull(), nil
+	}
+
+	var sealed []byte
+	if format == "HEX" {
+		sealed, err = hex.DecodeString(s)
+	} else {
+		sealed, err = base64.StdEncoding.DecodeString(s)
+	}
+	if err != nil || len(sealed) < aesSaltSize {
+		return value.NewNull(), nil
+	}
+
+	salt := sealed[:aesSaltSize]
+	sealed = sealed[aesSaltSize:]
+	aead, err := newAesAEAD(passphrase, salt)
+	if err != nil {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+	}
+	if len(sealed) < aead.NonceSize() {
+		return value.NewNull(), nil
+	}
+
+	opened, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return value.NewNull(), nil
+	}
+	return value.NewString(string(opened)), nil
+}
+
 func DatetimeFormat(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	if len(args) != 2 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
@@ -1075,6 +1883,36 @@ func DatetimeFormat(fn parser.Function, args []value.Primary) (value.Primary, er
 	return value.NewString(dt.Format(value.DatetimeFormats.Get(format.(value.String).Raw()))), nil
 }
 
+// ConvertTz returns the datetime that has the wall clock time of the datetime in the
+// timezone from_tz converted into the timezone to_tz.
+func ConvertTz(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) != 3 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{3})
+	}
+
+	p := value.ToDatetime(args[0])
+	if value.IsNull(p) {
+		return value.NewNull(), nil
+	}
+
+	locations := make([]*time.Location, 0, 2)
+	for _, arg := range args[1:] {
+		s := value.ToString(arg)
+		if value.IsNull(s) {
+			return value.NewNull(), nil
+		}
+		location, err := cmd.LoadLocation(s.(value.String).Raw())
+		if err != nil {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+		}
+		locations = append(locations, location)
+	}
+
+	t := p.(value.Datetime).Raw()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), locations[0])
+	return value.NewDatetime(t.In(locations[1])), nil
+}
+
 func execDatetimeToInt(fn parser.Function, args []value.Primary, timef func(time.Time) int64) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
@@ -1089,6 +1927,20 @@ func execDatetimeToInt(fn parser.Function, args []value.Primary, timef func(time
 	return value.NewInteger(result), nil
 }
 
+func execIntToDatetime(fn parser.Function, args []value.Primary, timef func(int64) time.Time) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	i := value.ToInteger(args[0])
+	if value.IsNull(i) {
+		return value.NewNull(), nil
+	}
+
+	result := timef(i.(value.Integer).Raw())
+	return value.NewDatetime(result.In(cmd.GetLocation())), nil
+}
+
 func execDatetimeAdd(fn parser.Function, args []value.Primary, timef func(time.Time, int) time.Time) (value.Primary, error) {
 	if len(args) != 2 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
@@ -1152,10 +2004,26 @@ func unixTime(t time.Time) int64 {
 	return t.Unix()
 }
 
+func unixMilliTime(t time.Time) int64 {
+	return t.UnixMilli()
+}
+
+func unixMicroTime(t time.Time) int64 {
+	return t.UnixMicro()
+}
+
 func unixNanoTime(t time.Time) int64 {
 	return t.UnixNano()
 }
 
+func fromUnixTime(i int64) time.Time {
+	return time.Unix(i, 0)
+}
+
+func fromUnixNanoTime(i int64) time.Time {
+	return time.Unix(0, i)
+}
+
 func dayOfYear(t time.Time) int64 {
 	return int64(t.YearDay())
 }
@@ -1251,10 +2119,34 @@ func UnixTime(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return execDatetimeToInt(fn, args, unixTime)
 }
 
+func UnixMilliTime(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return execDatetimeToInt(fn, args, unixMilliTime)
+}
+
+func UnixMicroTime(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return execDatetimeToInt(fn, args, unixMicroTime)
+}
+
 func UnixNanoTime(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return execDatetimeToInt(fn, args, unixNanoTime)
 }
 
+func FromUnixTime(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return execIntToDatetime(fn, args, fromUnixTime)
+}
+
+func FromUnixMilliTime(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return execIntToDatetime(fn, args, time.UnixMilli)
+}
+
+func FromUnixMicroTime(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return execIntToDatetime(fn, args, time.UnixMicro)
+}
+
+func FromUnixNanoTime(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return execIntToDatetime(fn, args, fromUnixNanoTime)
+}
+
 func DayOfYear(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return execDatetimeToInt(fn, args, dayOfYear)
 }
@@ -1468,7 +2360,7 @@ func Integer(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	case value.Float:
 		return value.NewInteger(int64(round(args[0].(value.Float).Raw(), 0))), nil
 	case value.String:
-		s := strings.TrimSpace(args[0].(value.String).Raw())
+		s := strings.TrimSpace(value.LocalizedNumber(args[0].(value.String)))
 		if i, e := strconv.ParseInt(s, 10, 64); e == nil {
 			return value.NewInteger(i), nil
 		}
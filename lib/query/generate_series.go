@@ -0,0 +1,209 @@
+package query
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// seriesInterval is a step of a datetime series, such as "1 day" or "3 months".
+type seriesInterval struct {
+	Years    int
+	Months   int
+	Days     int
+	Duration time.Duration
+}
+
+// MaxSeriesLength is the maximum number of values that GENERATE_SERIES returns.
+const MaxSeriesLength = 10000000
+
+// addTo adds the interval n times to t, clamping the day to the last day of the target month.
+func (iv seriesInterval) addTo(t time.Time, n int) time.Time {
+	if iv.Years != 0 || iv.Months != 0 {
+		y, m, d := t.Date()
+		first := time.Date(y+iv.Years*n, m+time.Month(iv.Months*n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+		if last := first.AddDate(0, 1, -1).Day(); last < d {
+			d = last
+		}
+		t = first.AddDate(0, 0, d-1)
+	}
+	return t.AddDate(0, 0, iv.Days*n).Add(iv.Duration * time.Duration(n))
+}
+
+func parseSeriesInterval(s string) (seriesInterval, bool) {
+	var iv seriesInterval
+
+	words := strings.Fields(s)
+	n := 1
+	switch len(words) {
+	case 1:
+	case 2:
+		i, err := strconv.Atoi(words[0])
+		if err != nil {
+			return iv, false
+		}
+		n = i
+	default:
+		return iv, false
+	}
+
+	unit := strings.ToUpper(words[len(words)-1])
+	if 1 < len(unit) && unit[len(unit)-1] == 'S' {
+		unit = unit[:len(unit)-1]
+	}
+
+	switch unit {
+	case "YEAR":
+		iv.Years = n
+	case "MONTH":
+		iv.Months = n
+	case "WEEK":
+		iv.Days = n * 7
+	case "DAY":
+		iv.Days = n
+	case "HOUR":
+		iv.Duration = time.Duration(n) * time.Hour
+	case "MINUTE":
+		iv.Duration = time.Duration(n) * time.Minute
+	case "SECOND":
+		iv.Duration = time.Duration(n) * time.Second
+	case "MILLISECOND":
+		iv.Duration = time.Duration(n) * time.Millisecond
+	case "MICROSECOND":
+		iv.Duration = time.Duration(n) * time.Microsecond
+	case "NANOSECOND":
+		iv.Duration = time.Duration(n) * time.Nanosecond
+	default:
+		return iv, false
+	}
+	return iv, true
+}
+
+// EvaluateGenerateSeries returns the values of the sequence from start to stop.
+//
+// If start and stop are numbers, then the sequence is a series of integers or floats
+// incremented by step, the default of which is 1.
+// If start and stop are datetimes, then step is an interval such as "1 day" or "2 hours",
+// and its default is "1 day".
+// If start or stop is null, the sequence has no values.
+func EvaluateGenerateSeries(expr parser.GenerateSeries, filter *Filter) ([]value.Primary, error) {
+	start, err := filter.Evaluate(expr.Start)
+	if err != nil {
+		return nil, err
+	}
+	stop, err := filter.Evaluate(expr.Stop)
+	if err != nil {
+		return nil, err
+	}
+	var step value.Primary
+	if expr.Step != nil {
+		if step, err = filter.Evaluate(expr.Step); err != nil {
+			return nil, err
+		}
+	}
+
+	if value.IsNull(start) || value.IsNull(stop) {
+		return nil, nil
+	}
+
+	if !value.IsNull(value.ToFloat(start)) && !value.IsNull(value.ToFloat(stop)) {
+		return generateNumberSeries(expr, start, stop, step)
+	}
+
+	startDt := value.ToDatetime(start)
+	if value.IsNull(startDt) {
+		return nil, NewGenerateSeriesInvalidArgumentError(expr, expr.Start)
+	}
+	stopDt := value.ToDatetime(stop)
+	if value.IsNull(stopDt) {
+		return nil, NewGenerateSeriesInvalidArgumentError(expr, expr.Stop)
+	}
+	return generateDatetimeSeries(expr, startDt.(value.Datetime).Raw(), stopDt.(value.Datetime).Raw(), step)
+}
+
+func generateNumberSeries(expr parser.GenerateSeries, start value.Primary, stop value.Primary, step value.Primary) ([]value.Primary, error) {
+	if step == nil {
+		step = value.NewInteger(1)
+	}
+
+	stepF := value.ToFloat(step)
+	if value.IsNull(stepF) || stepF.(value.Float).Raw() == 0 {
+		return nil, NewGenerateSeriesInvalidStepError(expr)
+	}
+
+	startI := value.ToInteger(start)
+	stopI := value.ToInteger(stop)
+	stepI := value.ToInteger(step)
+	if !value.IsNull(startI) && !value.IsNull(stopI) && !value.IsNull(stepI) {
+		from, to, inc := startI.(value.Integer).Raw(), stopI.(value.Integer).Raw(), stepI.(value.Integer).Raw()
+		if MaxSeriesLength <= (float64(to)-float64(from))/float64(inc) {
+			return nil, NewGenerateSeriesTooLongError(expr)
+		}
+
+		list := make([]value.Primary, 0, 10)
+		for i := from; (0 < inc && i <= to) || (inc < 0 && to <= i); i += inc {
+			list = append(list, value.NewInteger(i))
+			if (0 < inc && math.MaxInt64-inc < i) || (inc < 0 && i < math.MinInt64-inc) {
+				break
+			}
+		}
+		return list, nil
+	}
+
+	from := value.ToFloat(start).(value.Float).Raw()
+	to := value.ToFloat(stop).(value.Float).Raw()
+	inc := stepF.(value.Float).Raw()
+	if MaxSeriesLength <= (to-from)/inc {
+		return nil, NewGenerateSeriesTooLongError(expr)
+	}
+
+	list := make([]value.Primary, 0, 10)
+	for n := 0; ; n++ {
+		f := from + inc*float64(n)
+		if (0 < inc && to < f) || (inc < 0 && f < to) {
+			break
+		}
+		list = append(list, value.NewFloat(f))
+	}
+	return list, nil
+}
+
+func generateDatetimeSeries(expr parser.GenerateSeries, start time.Time, stop time.Time, step value.Primary) ([]value.Primary, error) {
+	iv := seriesInterval{Days: 1}
+	if step != nil {
+		s := value.ToString(step)
+		if value.IsNull(s) {
+			return nil, NewGenerateSeriesInvalidStepError(expr)
+		}
+		var ok bool
+		if iv, ok = parseSeriesInterval(s.(value.String).Raw()); !ok {
+			return nil, NewGenerateSeriesInvalidStepError(expr)
+		}
+	}
+
+	next := iv.addTo(start, 1)
+	if next.Equal(start) {
+		return nil, NewGenerateSeriesInvalidStepError(expr)
+	}
+	ascending := next.After(start)
+	if MaxSeriesLength <= float64(stop.Sub(start))/float64(next.Sub(start)) {
+		return nil, NewGenerateSeriesTooLongError(expr)
+	}
+
+	list := make([]value.Primary, 0, 10)
+	for n := 0; ; n++ {
+		t := iv.addTo(start, n)
+		if (ascending && t.After(stop)) || (!ascending && t.Before(stop)) {
+			break
+		}
+		if MaxSeriesLength <= len(list) {
+			return nil, NewGenerateSeriesTooLongError(expr)
+		}
+		list = append(list, value.NewDatetime(t))
+	}
+	return list, nil
+}
@@ -3,8 +3,6 @@ package query
 import (
 	"math"
 	"sync"
-
-	"github.com/mithrandie/csvq/lib/cmd"
 )
 
 var (
@@ -31,7 +29,7 @@ type GoroutineManager struct {
 	MinimumRequiredPerCore int
 }
 
-func (m *GoroutineManager) AssignRoutineNumber(recordLen int, minimumRequiredPerCore int) int {
+func (m *GoroutineManager) AssignRoutineNumber(recordLen int, minimumRequiredPerCore int, cpu int) int {
 	var greaterThanZero = func(i int) int {
 		if i < 1 {
 			return 1
@@ -45,7 +43,7 @@ func (m *GoroutineManager) AssignRoutineNumber(recordLen int, minimumRequiredPer
 		return i2
 	}
 
-	number := cmd.GetFlags().CPU
+	number := cpu
 	if minimumRequiredPerCore < 1 {
 		minimumRequiredPerCore = m.MinimumRequiredPerCore
 	}
@@ -76,11 +74,12 @@ type GoroutineTaskManager struct {
 	grCount      int
 	recordLen    int
 	waitGroup    sync.WaitGroup
+	errMutex     sync.RWMutex
 	err          error
 }
 
-func NewGoroutineTaskManager(recordLen int, minimumRequiredPerCore int) *GoroutineTaskManager {
-	number := GetGoroutineManager().AssignRoutineNumber(recordLen, minimumRequiredPerCore)
+func NewGoroutineTaskManager(recordLen int, minimumRequiredPerCore int, cpu int) *GoroutineTaskManager {
+	number := GetGoroutineManager().AssignRoutineNumber(recordLen, minimumRequiredPerCore, cpu)
 
 	return &GoroutineTaskManager{
 		Number:    number,
@@ -90,14 +89,20 @@ func NewGoroutineTaskManager(recordLen int, minimumRequiredPerCore int) *Gorouti
 }
 
 func (m *GoroutineTaskManager) HasError() bool {
+	m.errMutex.RLock()
+	defer m.errMutex.RUnlock()
 	return m.err != nil
 }
 
 func (m *GoroutineTaskManager) SetError(e error) {
+	m.errMutex.Lock()
 	m.err = e
+	m.errMutex.Unlock()
 }
 
 func (m *GoroutineTaskManager) Err() error {
+	m.errMutex.RLock()
+	defer m.errMutex.RUnlock()
 	return m.err
 }
 
@@ -19,6 +19,10 @@ type HeaderField struct {
 	IsGroupKey   bool
 }
 
+func (f HeaderField) isInternalId() bool {
+	return !f.IsFromTable && f.Column == InternalIdColumn
+}
+
 type Header []HeaderField
 
 func NewDualHeader() Header {
@@ -135,7 +139,7 @@ func (h Header) ContainsObject(obj parser.QueryExpression) (int, error) {
 			continue
 		}
 
-		if !strings.EqualFold(f.Column, column) {
+		if !EqualFieldIdentifiers(f.Column, column) {
 			continue
 		}
 
@@ -177,6 +181,10 @@ func (h Header) Contains(fieldRef parser.FieldReference) (int, error) {
 	idx := -1
 
 	for i, f := range h {
+		if f.isInternalId() {
+			continue
+		}
+
 		if 0 < len(view) {
 			if !strings.EqualFold(f.View, view) || !strings.EqualFold(f.Column, column) {
 				continue
@@ -207,11 +215,15 @@ func (h Header) Contains(fieldRef parser.FieldReference) (int, error) {
 }
 
 func (h Header) ContainsInternalId(viewName string) (int, error) {
-	fieldRef := parser.FieldReference{
+	for i, f := range h {
+		if f.isInternalId() && strings.EqualFold(f.View, viewName) {
+			return i, nil
+		}
+	}
+	return -1, NewFieldNotExistError(parser.FieldReference{
 		View:   parser.Identifier{Literal: viewName},
 		Column: parser.Identifier{Literal: InternalIdColumn},
-	}
-	return h.Contains(fieldRef)
+	})
 }
 
 func (h Header) Update(reference string, fields []parser.QueryExpression) error {
@@ -350,6 +350,75 @@ func TestHeader_Contains(t *testing.T) {
 	}
 }
 
+var headerContainsInternalIdTests = []struct {
+	ViewName string
+	Result   int
+	Error    string
+}{
+	{
+		ViewName: "t1",
+		Result:   0,
+	},
+	{
+		ViewName: "T2",
+		Result:   3,
+	},
+	{
+		ViewName: "t3",
+		Error:    "[L:- C:-] field t3.@__internal_id does not exist",
+	},
+}
+
+func TestHeader_ContainsInternalId(t *testing.T) {
+	h := Header{
+		{
+			View:   "t1",
+			Column: InternalIdColumn,
+		},
+		{
+			View:        "t1",
+			Column:      InternalIdColumn,
+			Number:      1,
+			IsFromTable: true,
+		},
+		{
+			View:        "t1",
+			Column:      "c1",
+			Number:      2,
+			IsFromTable: true,
+		},
+		{
+			View:   "t2",
+			Column: InternalIdColumn,
+		},
+		{
+			View:        "t3",
+			Column:      InternalIdColumn,
+			Number:      1,
+			IsFromTable: true,
+		},
+	}
+
+	for _, v := range headerContainsInternalIdTests {
+		result, err := h.ContainsInternalId(v.ViewName)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.ViewName, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.ViewName, err, v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.ViewName, v.Error)
+			continue
+		}
+		if result != v.Result {
+			t.Errorf("%s: index = %d, want %d", v.ViewName, result, v.Result)
+		}
+	}
+}
+
 func TestNewHeader(t *testing.T) {
 	ref := "table1"
 	words := []string{"column1", "column2"}
@@ -0,0 +1,149 @@
+package query
+
+import (
+	"runtime"
+	"strconv"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+const (
+	HashJoinHint       = "HASH_JOIN"
+	NestedLoopJoinHint = "NESTED_LOOP_JOIN"
+	ParallelHint       = "PARALLEL"
+	NoParallelHint     = "NO_PARALLEL"
+)
+
+type joinMethod int
+
+const (
+	nestedLoopJoin joinMethod = iota
+	hashJoin
+)
+
+// queryHints is the set of the hints that are applied to a select query and its subqueries.
+type queryHints struct {
+	cpu         int
+	joinMethods []joinMethodHint
+}
+
+type joinMethodHint struct {
+	method joinMethod
+	tables []string
+}
+
+// newQueryHints returns the hints of the parent overridden by the hints in a select clause.
+// Unknown hints and hints with invalid arguments are ignored in the same way as comments.
+func newQueryHints(hints []parser.Hint, parent *queryHints) *queryHints {
+	qh := &queryHints{}
+	if parent != nil {
+		qh.cpu = parent.cpu
+		qh.joinMethods = append(qh.joinMethods, parent.joinMethods...)
+	}
+
+	for _, hint := range hints {
+		switch hint.Name {
+		case HashJoinHint, NestedLoopJoinHint:
+			method := nestedLoopJoin
+			if hint.Name == HashJoinHint {
+				method = hashJoin
+			}
+			qh.joinMethods = append(qh.joinMethods, joinMethodHint{method: method, tables: hint.Args})
+		case ParallelHint:
+			switch len(hint.Args) {
+			case 0:
+				qh.cpu = runtime.NumCPU()
+			case 1:
+				if i, err := strconv.Atoi(hint.Args[0]); err == nil && 0 < i {
+					if runtime.NumCPU() < i {
+						i = runtime.NumCPU()
+					}
+					qh.cpu = i
+				}
+			}
+		case NoParallelHint:
+			if len(hint.Args) < 1 {
+				qh.cpu = 1
+			}
+		}
+	}
+	return qh
+}
+
+// joinMethod returns the method specified by the last hint that matches the join.
+// If no hint matches, the hash join is chosen, which is executed as the nested loop join
+// when the join condition has no equalities that can be used as keys.
+//
+// A hint without arguments matches all the joins. A hint with a table name matches
+// the joins with the table on the right-hand side, and a hint with multiple table names
+// matches the joins in which both sides contain any of the tables.
+func (qh *queryHints) joinMethod(join parser.Join) (joinMethod, bool) {
+	if qh == nil {
+		return hashJoin, false
+	}
+
+	var lhs []string
+	var rhs []string
+	for i := len(qh.joinMethods) - 1; 0 <= i; i-- {
+		hint := qh.joinMethods[i]
+		if len(hint.tables) < 1 {
+			return hint.method, true
+		}
+
+		if rhs == nil {
+			lhs = joinedTableNames(join.Table)
+			rhs = joinedTableNames(join.JoinTable)
+		}
+		if !containsTableName(hint.tables, rhs) {
+			continue
+		}
+		if len(hint.tables) == 1 || containsTableName(hint.tables, lhs) {
+			return hint.method, true
+		}
+	}
+	return hashJoin, false
+}
+
+// joinedTableNames returns the names of the tables that can be referred in the query,
+// including the tables in the nested joins.
+func joinedTableNames(expr parser.QueryExpression) []string {
+	switch expr.(type) {
+	case parser.Parentheses:
+		return joinedTableNames(expr.(parser.Parentheses).Expr)
+	case parser.Table:
+		table := expr.(parser.Table)
+		if join, ok := table.Object.(parser.Join); ok {
+			return append(joinedTableNames(join.Table), joinedTableNames(join.JoinTable)...)
+		}
+		return []string{table.Name().Literal}
+	}
+	return []string{}
+}
+
+func containsTableName(hintTables []string, tables []string) bool {
+	for _, v := range tables {
+		if InStrSliceWithCaseInsensitive(v, hintTables) {
+			return true
+		}
+	}
+	return false
+}
+
+// withHints returns a filter to execute a select entity with the hints written in its select clause.
+func (f *Filter) withHints(hints []parser.Hint) *Filter {
+	if len(hints) < 1 {
+		return f
+	}
+	filter := f.CreateNode()
+	filter.hints = newQueryHints(hints, f.hints)
+	return filter
+}
+
+// cpu returns the maximum number of goroutines used to process records.
+func (f *Filter) cpu() int {
+	if f != nil && f.hints != nil && 0 < f.hints.cpu {
+		return f.hints.cpu
+	}
+	return cmd.GetFlags().CPU
+}
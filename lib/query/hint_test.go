@@ -0,0 +1,182 @@
+package query
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var newQueryHintsTests = []struct {
+	Name   string
+	Hints  []parser.Hint
+	Parent *queryHints
+	Result *queryHints
+}{
+	{
+		Name:   "No Hints",
+		Result: &queryHints{},
+	},
+	{
+		Name: "Join Method Hints",
+		Hints: []parser.Hint{
+			{Name: HashJoinHint, Args: []string{"t1", "t2"}},
+			{Name: NestedLoopJoinHint},
+		},
+		Result: &queryHints{
+			joinMethods: []joinMethodHint{
+				{method: hashJoin, tables: []string{"t1", "t2"}},
+				{method: nestedLoopJoin},
+			},
+		},
+	},
+	{
+		Name: "Parallel Hint",
+		Hints: []parser.Hint{
+			{Name: ParallelHint, Args: []string{"1"}},
+		},
+		Result: &queryHints{cpu: 1},
+	},
+	{
+		Name: "Parallel Hint without Arguments",
+		Hints: []parser.Hint{
+			{Name: ParallelHint},
+		},
+		Result: &queryHints{cpu: runtime.NumCPU()},
+	},
+	{
+		Name: "Parallel Hint Exceeding the Number of CPUs",
+		Hints: []parser.Hint{
+			{Name: ParallelHint, Args: []string{"10000"}},
+		},
+		Result: &queryHints{cpu: runtime.NumCPU()},
+	},
+	{
+		Name: "Ignore Invalid Hints",
+		Hints: []parser.Hint{
+			{Name: ParallelHint, Args: []string{"0"}},
+			{Name: ParallelHint, Args: []string{"a"}},
+			{Name: NoParallelHint, Args: []string{"t1"}},
+			{Name: "UNKNOWN_HINT"},
+		},
+		Result: &queryHints{},
+	},
+	{
+		Name: "Override Parent Hints",
+		Hints: []parser.Hint{
+			{Name: NoParallelHint},
+			{Name: NestedLoopJoinHint, Args: []string{"t2"}},
+		},
+		Parent: &queryHints{
+			cpu: 4,
+			joinMethods: []joinMethodHint{
+				{method: hashJoin},
+			},
+		},
+		Result: &queryHints{
+			cpu: 1,
+			joinMethods: []joinMethodHint{
+				{method: hashJoin},
+				{method: nestedLoopJoin, tables: []string{"t2"}},
+			},
+		},
+	},
+}
+
+func TestNewQueryHints(t *testing.T) {
+	for _, v := range newQueryHintsTests {
+		result := newQueryHints(v.Hints, v.Parent)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %#v, want %#v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func joinMethodTestJoin(joinTable string) parser.Join {
+	return parser.Join{
+		Table: parser.Table{
+			Object: parser.Join{
+				Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t1"}},
+				JoinTable: parser.Table{Object: parser.Identifier{Literal: "table2"}, Alias: parser.Identifier{Literal: "t2"}},
+			},
+		},
+		JoinTable: parser.Table{Object: parser.Identifier{Literal: joinTable}},
+	}
+}
+
+var queryHintsJoinMethodTests = []struct {
+	Name     string
+	Hints    *queryHints
+	Join     parser.Join
+	Result   joinMethod
+	IsHinted bool
+}{
+	{
+		Name:     "No Hints",
+		Join:     joinMethodTestJoin("table3"),
+		Result:   hashJoin,
+		IsHinted: false,
+	},
+	{
+		Name: "Hint for All Joins",
+		Hints: &queryHints{
+			joinMethods: []joinMethodHint{{method: hashJoin}},
+		},
+		Join:     joinMethodTestJoin("table3"),
+		Result:   hashJoin,
+		IsHinted: true,
+	},
+	{
+		Name: "Hint for the Right-Hand Table",
+		Hints: &queryHints{
+			joinMethods: []joinMethodHint{{method: hashJoin, tables: []string{"TABLE3"}}},
+		},
+		Join:     joinMethodTestJoin("table3"),
+		Result:   hashJoin,
+		IsHinted: true,
+	},
+	{
+		Name: "Hint for Both Sides",
+		Hints: &queryHints{
+			joinMethods: []joinMethodHint{{method: hashJoin, tables: []string{"t2", "table3"}}},
+		},
+		Join:     joinMethodTestJoin("table3"),
+		Result:   hashJoin,
+		IsHinted: true,
+	},
+	{
+		Name: "Hint Not Matching",
+		Hints: &queryHints{
+			joinMethods: []joinMethodHint{
+				{method: hashJoin, tables: []string{"t1"}},
+				{method: hashJoin, tables: []string{"table3", "table4"}},
+			},
+		},
+		Join:     joinMethodTestJoin("table3"),
+		Result:   hashJoin,
+		IsHinted: false,
+	},
+	{
+		Name: "Last Matching Hint",
+		Hints: &queryHints{
+			joinMethods: []joinMethodHint{
+				{method: hashJoin},
+				{method: nestedLoopJoin, tables: []string{"table3"}},
+				{method: hashJoin, tables: []string{"table4"}},
+			},
+		},
+		Join:     joinMethodTestJoin("table3"),
+		Result:   nestedLoopJoin,
+		IsHinted: true,
+	},
+}
+
+func TestQueryHints_JoinMethod(t *testing.T) {
+	for _, v := range queryHintsJoinMethodTests {
+		result, isHinted := v.Hints.joinMethod(v.Join)
+		if result != v.Result || isHinted != v.IsHinted {
+			t.Errorf("%s: result = %d, %t, want %d, %t", v.Name, result, isHinted, v.Result, v.IsHinted)
+		}
+	}
+}
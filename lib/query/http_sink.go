@@ -0,0 +1,77 @@
+package query
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+)
+
+const HttpSinkTimeout = 30 * time.Second
+
+// HttpSink is an output destination that sends the result set of each query
+// to a URL as the body of an HTTP POST request.
+//
+// Written data are buffered until Flush is called.
+type HttpSink struct {
+	Url    string
+	Client *http.Client
+
+	buf bytes.Buffer
+}
+
+func NewHttpSink(url string) *HttpSink {
+	return &HttpSink{
+		Url:    url,
+		Client: &http.Client{Timeout: HttpSinkTimeout},
+	}
+}
+
+func HttpContentType(format cmd.Format) string {
+	switch format {
+	case cmd.JSON:
+		return "application/json"
+	case cmd.CSV:
+		return "text/csv"
+	case cmd.TSV:
+		return "text/tab-separated-values"
+	case cmd.GFM:
+		return "text/markdown"
+	}
+	return "text/plain"
+}
+
+func (s *HttpSink) Write(p []byte) (int, error) {
+	return s.buf.Write(p)
+}
+
+// Flush sends the buffered data as a batch.
+// The content type of the request is determined by the current output format.
+// If the server does not respond with a 2xx status code, then returns an error.
+func (s *HttpSink) Flush() error {
+	if s.buf.Len() < 1 {
+		return nil
+	}
+
+	res, err := s.Client.Post(s.Url, HttpContentType(cmd.GetFlags().Format), bytes.NewReader(s.buf.Bytes()))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+
+	if res.StatusCode < 200 || 299 < res.StatusCode {
+		return errors.New(fmt.Sprintf("server responded with status %s", res.Status))
+	}
+	return nil
+}
+
+// Reset discards the buffered data.
+func (s *HttpSink) Reset() {
+	s.buf.Reset()
+}
@@ -0,0 +1,68 @@
+package query
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+)
+
+func TestHttpSink_Flush(t *testing.T) {
+	var contentType string
+	var body string
+	var requests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		contentType = r.Header.Get("Content-Type")
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		if body == "error" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	flags := cmd.GetFlags()
+	oldFormat := flags.Format
+	flags.Format = cmd.JSON
+	defer func() {
+		flags.Format = oldFormat
+	}()
+
+	sink := NewHttpSink(server.URL)
+
+	if err := sink.Flush(); err != nil {
+		t.Errorf("unexpected error %q for empty buffer", err)
+	}
+	if requests != 0 {
+		t.Errorf("%d requests are sent, want no request for empty buffer", requests)
+	}
+
+	_, _ = sink.Write([]byte("[{\"c1\":1}]"))
+	_, _ = sink.Write([]byte("\n"))
+	if err := sink.Flush(); err != nil {
+		t.Errorf("unexpected error %q", err)
+	}
+	if body != "[{\"c1\":1}]\n" {
+		t.Errorf("body = %q, want %q", body, "[{\"c1\":1}]\n")
+	}
+	if contentType != "application/json" {
+		t.Errorf("content type = %q, want %q", contentType, "application/json")
+	}
+	sink.Reset()
+
+	_, _ = sink.Write([]byte("error"))
+	if err := sink.Flush(); err == nil {
+		t.Error("no error, want error for status 400")
+	} else if err.Error() != "server responded with status 400 Bad Request" {
+		t.Errorf("error = %q, want %q", err.Error(), "server responded with status 400 Bad Request")
+	}
+	sink.Reset()
+
+	if requests != 2 {
+		t.Errorf("%d requests are sent, want %d", requests, 2)
+	}
+}
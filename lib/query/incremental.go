@@ -0,0 +1,379 @@
+package query
+
+import (
+	"bytes"
+	gojson "encoding/json"
+	"errors"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// IncrementalObject is the type of the table object that reads only the records
+// appended to a file since the last run recorded in a state file.
+const IncrementalObject = "INCREMENTAL"
+
+// IncrementalTable returns the table if the query is an incremental query,
+// that is a query whose FROM clause has only one INCREMENTAL table object.
+func IncrementalTable(query parser.SelectQuery) (parser.Table, bool) {
+	if table, ok := singleTable(query); ok {
+		if tableObject, ok := table.Object.(parser.TableObject); ok && strings.EqualFold(tableObject.Type.Literal, IncrementalObject) {
+			return table, true
+		}
+	}
+	return parser.Table{}, false
+}
+
+// IncrementalState is the state of an incremental query saved to a state file.
+//
+// Offset is the position in bytes of the file from which the next run reads records.
+// If the query aggregates records, then Header and Records hold the aggregated result
+// to which the result of the next run is merged.
+type IncrementalState struct {
+	Offset  int64
+	Header  []string
+	Records [][]value.Primary
+}
+
+type incrementalStateJson struct {
+	Offset  int64           `json:"offset"`
+	Header  []string        `json:"header,omitempty"`
+	Records [][]interface{} `json:"records,omitempty"`
+}
+
+// LoadIncrementalState reads the state file.
+// If the file does not exist, then returns nil.
+func LoadIncrementalState(path string) (*IncrementalState, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var sj incrementalStateJson
+	d := gojson.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err = d.Decode(&sj); err != nil {
+		return nil, err
+	}
+
+	state := &IncrementalState{
+		Offset: sj.Offset,
+		Header: sj.Header,
+	}
+	if sj.Records != nil {
+		state.Records = make([][]value.Primary, len(sj.Records))
+		for i, r := range sj.Records {
+			state.Records[i] = make([]value.Primary, len(r))
+			for j, v := range r {
+				if state.Records[i][j], err = decodeStateValue(v); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return state, nil
+}
+
+// Save writes the state to the file.
+// The state is written to a temporary file first, and the file is replaced with it.
+func (s *IncrementalState) Save(path string) error {
+	sj := incrementalStateJson{
+		Offset: s.Offset,
+		Header: s.Header,
+	}
+	if s.Records != nil {
+		sj.Records = make([][]interface{}, len(s.Records))
+		for i, r := range s.Records {
+			sj.Records[i] = make([]interface{}, len(r))
+			for j, v := range r {
+				sj.Records[i][j] = encodeStateValue(v)
+			}
+		}
+	}
+
+	b, err := gojson.Marshal(sj)
+	if err != nil {
+		return err
+	}
+
+	fp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".")
+	if err != nil {
+		return err
+	}
+	if _, err = fp.Write(b); err != nil {
+		fp.Close()
+		os.Remove(fp.Name())
+		return err
+	}
+	if err = fp.Close(); err != nil {
+		os.Remove(fp.Name())
+		return err
+	}
+	return os.Rename(fp.Name(), path)
+}
+
+func encodeStateValue(p value.Primary) interface{} {
+	switch p.(type) {
+	case value.Integer:
+		return gojson.Number(p.(value.Integer).String())
+	case value.Float:
+		f := p.(value.Float).Raw()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return map[string]string{"float": strconv.FormatFloat(f, 'g', -1, 64)}
+		}
+		s := strconv.FormatFloat(f, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".eE") {
+			s = s + ".0"
+		}
+		return gojson.Number(s)
+	case value.Boolean:
+		return p.(value.Boolean).Raw()
+	case value.Datetime:
+		return map[string]string{"datetime": p.(value.Datetime).Raw().Format(time.RFC3339Nano)}
+	case value.String:
+		return p.(value.String).Raw()
+	}
+	return nil
+}
+
+func decodeStateValue(v interface{}) (value.Primary, error) {
+	switch v.(type) {
+	case nil:
+		return value.NewNull(), nil
+	case bool:
+		return value.NewBoolean(v.(bool)), nil
+	case string:
+		return value.NewString(v.(string)), nil
+	case gojson.Number:
+		s := v.(gojson.Number).String()
+		if !strings.ContainsAny(s, ".eE") {
+			if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+				return value.NewInteger(i), nil
+			}
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, err
+		}
+		return value.NewFloat(f), nil
+	case map[string]interface{}:
+		m := v.(map[string]interface{})
+		if s, ok := m["datetime"].(string); ok {
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return nil, err
+			}
+			return value.NewDatetime(t), nil
+		}
+		if s, ok := m["float"].(string); ok {
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return nil, err
+			}
+			return value.NewFloat(f), nil
+		}
+	}
+	return nil, errors.New("invalid value in state")
+}
+
+// IncrementalAggregation merges the aggregated result of new records into
+// the result saved in the state.
+//
+// The fields of an aggregating query must be grouping keys, or COUNT, SUM, MIN or MAX
+// without DISTINCT, which can be merged.
+type IncrementalAggregation struct {
+	Aggregated bool
+	Functions  []string
+}
+
+func NewIncrementalAggregation(query parser.SelectQuery) (*IncrementalAggregation, error) {
+	entity := query.SelectEntity.(parser.SelectEntity)
+	clause := entity.SelectClause.(parser.SelectClause)
+
+	table, _ := singleTable(query)
+
+	agg := &IncrementalAggregation{
+		Aggregated: entity.GroupByClause != nil,
+		Functions:  make([]string, len(clause.Fields)),
+	}
+
+	for i, v := range clause.Fields {
+		field := v.(parser.Field)
+		if fn, ok := field.Object.(parser.AggregateFunction); ok {
+			name := strings.ToUpper(fn.Name)
+			switch name {
+			case "COUNT", "SUM", "MIN", "MAX":
+				if fn.Distinct.IsEmpty() && fn.Filter == nil {
+					agg.Functions[i] = name
+					agg.Aggregated = true
+					continue
+				}
+			}
+			return nil, NewIncrementalAggregationError(table.Object, field.Object)
+		}
+		if containsAggregateFunction(field.Object) {
+			return nil, NewIncrementalAggregationError(table.Object, field.Object)
+		}
+	}
+
+	if !agg.Aggregated {
+		return agg, nil
+	}
+
+	for _, v := range clause.Fields {
+		if _, ok := v.(parser.Field).Object.(parser.AllColumns); ok {
+			return nil, NewIncrementalAggregationError(table.Object, v.(parser.Field).Object)
+		}
+	}
+	// Records in the state are merged by the values of the grouping keys in the fields.
+	if entity.GroupByClause != nil {
+		for _, item := range entity.GroupByClause.(parser.GroupByClause).Items {
+			if !isSelectedGroupKey(item, clause.Fields, agg.Functions) {
+				return nil, NewIncrementalAggregationError(table.Object, item)
+			}
+		}
+	}
+	for _, expr := range []parser.QueryExpression{entity.HavingClause, query.OrderByClause, query.LimitClause, query.OffsetClause} {
+		if expr != nil {
+			return nil, NewIncrementalAggregationError(table.Object, expr)
+		}
+	}
+	return agg, nil
+}
+
+func isSelectedGroupKey(item parser.QueryExpression, fields []parser.QueryExpression, functions []string) bool {
+	for i, v := range fields {
+		if 0 < len(functions[i]) {
+			continue
+		}
+
+		field := v.(parser.Field)
+		if strings.EqualFold(field.Object.String(), item.String()) {
+			return true
+		}
+
+		ref, ok := item.(parser.FieldReference)
+		if !ok {
+			continue
+		}
+		if len(ref.View.Literal) < 1 && field.Alias != nil && strings.EqualFold(field.Alias.(parser.Identifier).Literal, ref.Column.Literal) {
+			return true
+		}
+		if fieldRef, ok := field.Object.(parser.FieldReference); ok && strings.EqualFold(fieldRef.Column.Literal, ref.Column.Literal) &&
+			(len(ref.View.Literal) < 1 || len(fieldRef.View.Literal) < 1 || strings.EqualFold(fieldRef.View.Literal, ref.View.Literal)) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAggregateFunction(expr parser.QueryExpression) bool {
+	return !walkExpression(expr, func(e parser.QueryExpression) bool {
+		switch e.(type) {
+		case parser.AggregateFunction, parser.ListFunction, parser.AnalyticFunction:
+			return false
+		}
+		return true
+	})
+}
+
+// Merge merges the records of the state into the view, and returns the new state.
+func (agg *IncrementalAggregation) Merge(view *View, state *IncrementalState, offset int64) (*IncrementalState, error) {
+	if !agg.Aggregated {
+		return &IncrementalState{Offset: offset}, nil
+	}
+
+	header := make([]string, 0, view.FieldLen())
+	for _, f := range view.Header {
+		if !f.isInternalId() {
+			header = append(header, f.Column)
+		}
+	}
+
+	var records [][]value.Primary
+	if state != nil {
+		if !reflect.DeepEqual(state.Header, header) {
+			return nil, errors.New("state does not match the query")
+		}
+		records = state.Records
+	}
+
+	index := make(map[string]int, len(records))
+	keyBuf := new(bytes.Buffer)
+	key := func(values []value.Primary) string {
+		keyBuf.Reset()
+		for i, fn := range agg.Functions {
+			if len(fn) < 1 {
+				SerializeKey(keyBuf, values[i])
+				keyBuf.WriteString(":")
+			}
+		}
+		return keyBuf.String()
+	}
+
+	for i, r := range records {
+		if len(r) != len(header) {
+			return nil, errors.New("state does not match the query")
+		}
+		index[key(r)] = i
+	}
+
+	for _, record := range view.RecordSet {
+		values := make([]value.Primary, len(header))
+		for i := range values {
+			values[i] = record[i].Value()
+		}
+
+		k := key(values)
+		i, ok := index[k]
+		if !ok {
+			index[k] = len(records)
+			records = append(records, values)
+			continue
+		}
+
+		for j, fn := range agg.Functions {
+			switch fn {
+			case "COUNT", "SUM":
+				records[i][j] = Sum([]value.Primary{records[i][j], values[j]})
+			case "MIN":
+				records[i][j] = Min([]value.Primary{records[i][j], values[j]})
+			case "MAX":
+				records[i][j] = Max([]value.Primary{records[i][j], values[j]})
+			}
+		}
+	}
+
+	view.RecordSet = make(RecordSet, len(records))
+	for i, r := range records {
+		view.RecordSet[i] = NewRecord(r)
+	}
+
+	return &IncrementalState{
+		Offset:  offset,
+		Header:  header,
+		Records: records,
+	}, nil
+}
+
+func loadIncrementalBatch(table parser.Table, filter *Filter) (*View, error) {
+	view, ok := loadTailBatch(table, filter)
+	if !ok {
+		return nil, NewIncrementalNotTopLevelError(table.Object.(parser.TableObject))
+	}
+	if err := filter.Aliases.Add(table.Name(), ""); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
@@ -0,0 +1,181 @@
+package query
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func TestIncrementalState_Save(t *testing.T) {
+	fpath := GetTestFilePath("incremental_save.state")
+	defer os.Remove(fpath)
+
+	state := &IncrementalState{
+		Offset: 12,
+		Header: []string{"c1", "c2"},
+		Records: [][]value.Primary{
+			{value.NewString("a"), value.NewInteger(3)},
+			{value.NewNull(), value.NewFloat(2)},
+			{value.NewBoolean(true), value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123, time.UTC))},
+		},
+	}
+
+	if err := state.Save(fpath); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	loaded, err := LoadIncrementalState(fpath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(loaded, state) {
+		t.Errorf("state = %v, want %v", loaded, state)
+	}
+
+	loaded, err = LoadIncrementalState(GetTestFilePath("notexist.state"))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if loaded != nil {
+		t.Errorf("state = %v, want nil for a file that does not exist", loaded)
+	}
+}
+
+var newIncrementalAggregationTests = []struct {
+	Query  string
+	Result *IncrementalAggregation
+	Error  string
+}{
+	{
+		Query: "SELECT * FROM INCREMENTAL(`log.csv`, 'log.state') WHERE c1 = 1",
+		Result: &IncrementalAggregation{
+			Aggregated: false,
+			Functions:  []string{""},
+		},
+	},
+	{
+		Query: "SELECT c1, COUNT(*), SUM(c2), MIN(c2), MAX(c2) FROM INCREMENTAL(`log.csv`, 'log.state') GROUP BY c1",
+		Result: &IncrementalAggregation{
+			Aggregated: true,
+			Functions:  []string{"", "COUNT", "SUM", "MIN", "MAX"},
+		},
+	},
+	{
+		Query: "SELECT log.c1 AS key, COUNT(*) FROM INCREMENTAL(`log.csv`, 'log.state') AS log GROUP BY c1, key",
+		Result: &IncrementalAggregation{
+			Aggregated: true,
+			Functions:  []string{"", "COUNT"},
+		},
+	},
+	{
+		Query: "SELECT COUNT(*), SUM(c2) FROM INCREMENTAL(`log.csv`, 'log.state') GROUP BY c1",
+		Error: "[L:1 C:31] c1 cannot be used in an incremental query",
+	},
+	{
+		Query: "SELECT c1, AVG(c2) FROM INCREMENTAL(`log.csv`, 'log.state') GROUP BY c1",
+		Error: "[L:1 C:25] AVG(c2) cannot be used in an incremental query",
+	},
+	{
+		Query: "SELECT COUNT(DISTINCT c1) FROM INCREMENTAL(`log.csv`, 'log.state')",
+		Error: "[L:1 C:32] COUNT(DISTINCT c1) cannot be used in an incremental query",
+	},
+	{
+		Query: "SELECT COUNT(*) + 1 FROM INCREMENTAL(`log.csv`, 'log.state')",
+		Error: "[L:1 C:26] COUNT(*) + 1 cannot be used in an incremental query",
+	},
+	{
+		Query: "SELECT c1, COUNT(*) FROM INCREMENTAL(`log.csv`, 'log.state') GROUP BY c1 HAVING COUNT(*) > 1",
+		Error: "[L:1 C:26] HAVING COUNT(*) > 1 cannot be used in an incremental query",
+	},
+}
+
+func TestNewIncrementalAggregation(t *testing.T) {
+	for _, v := range newIncrementalAggregationTests {
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Query, err)
+		}
+
+		result, err := NewIncrementalAggregation(program[0].(parser.SelectQuery))
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Query, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Query, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Query, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Query, result, v.Result)
+		}
+	}
+}
+
+func TestProcedure_Incremental(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.Format = cmd.CSV
+	defer func() {
+		tf.Format = cmd.TEXT
+	}()
+
+	fpath := GetTestFilePath("incremental.csv")
+	statePath := GetTestFilePath("incremental.state")
+	if err := ioutil.WriteFile(fpath, []byte("k,v\na,1\nb,2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		os.Remove(fpath)
+		os.Remove(statePath)
+	}()
+
+	query := "SELECT k, COUNT(*) AS n, SUM(v) AS total FROM INCREMENTAL(`incremental.csv`, 'incremental.state') GROUP BY k"
+	program, err := parser.Parse(query, "")
+	if err != nil {
+		t.Fatalf("unexpected parse error %q", err)
+	}
+
+	run := func() string {
+		buf := new(bytes.Buffer)
+		OutFile = buf
+		defer func() {
+			OutFile = nil
+		}()
+
+		if _, err := NewProcedure().ExecuteStatement(program[0]); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		return buf.String()
+	}
+
+	expect := "k,n,total\na,1,1\nb,1,2\n"
+	if result := run(); result != expect {
+		t.Errorf("result = %q, want %q", result, expect)
+	}
+
+	appendToFile(t, fpath, "a,5\nc,7\n")
+	expect = "k,n,total\na,2,6\nb,1,2\nc,1,7\n"
+	if result := run(); result != expect {
+		t.Errorf("result = %q, want %q", result, expect)
+	}
+
+	if result := run(); result != expect {
+		t.Errorf("result = %q, want %q for no appended records", result, expect)
+	}
+
+	state, _ := LoadIncrementalState(statePath)
+	if state.Offset != 20 {
+		t.Errorf("offset = %d, want %d", state.Offset, 20)
+	}
+}
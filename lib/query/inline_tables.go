@@ -44,8 +44,12 @@ func (it InlineTableMap) Set(inlineTable parser.InlineTable, parentFilter *Filte
 	filter := parentFilter.CreateNode()
 	if inlineTable.IsRecursive() {
 		filter.RecursiveTable = &inlineTable
+		// Each iteration of the recursion is not recorded as a step.
+		filter.profiler = nil
 	}
-	view, err := Select(inlineTable.Query, filter)
+	view, err := measureLoad(parentFilter, func() *ExplainNode { return explainInlineTableNode(inlineTable) }, func() (*View, error) {
+		return Select(inlineTable.Query, filter)
+	})
 	if err != nil {
 		return err
 	}
@@ -1,9 +1,14 @@
 package query
 
 import (
+	"bytes"
 	"math"
+	"strings"
 
+	"github.com/mithrandie/csvq/lib/json"
 	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
 	"github.com/mithrandie/ternary"
 )
 
@@ -16,7 +21,7 @@ func ParseJoinCondition(join parser.Join, view *View, joinView *View) (parser.Qu
 
 	if !join.Natural.IsEmpty() {
 		for _, field := range view.Header {
-			if field.Column == InternalIdColumn {
+			if field.isInternalId() {
 				continue
 			}
 			ref := parser.FieldReference{BaseExpr: parser.NewBaseExpr(join.Natural), Column: parser.Identifier{Literal: field.Column}}
@@ -101,11 +106,11 @@ func ParseJoinCondition(join parser.Join, view *View, joinView *View) (parser.Qu
 	return logic, includeFields, excludeFields, nil
 }
 
-func CrossJoin(view *View, joinView *View) {
+func CrossJoin(view *View, joinView *View, parentFilter *Filter) {
 	mergedHeader := MergeHeader(view.Header, joinView.Header)
 	records := make(RecordSet, view.RecordLen()*joinView.RecordLen())
 
-	NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore)).Run(func(index int) {
+	NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), parentFilter.cpu()).Run(func(index int) {
 		start := index * joinView.RecordLen()
 		for i := 0; i < joinView.RecordLen(); i++ {
 			records[start+i] = append(view.RecordSet[index], joinView.RecordSet[i]...)
@@ -118,14 +123,23 @@ func CrossJoin(view *View, joinView *View) {
 }
 
 func InnerJoin(view *View, joinView *View, condition parser.QueryExpression, parentFilter *Filter) error {
+	return innerJoin(view, joinView, condition, nestedLoopJoin, parentFilter)
+}
+
+func innerJoin(view *View, joinView *View, condition parser.QueryExpression, method joinMethod, parentFilter *Filter) error {
 	if condition == nil {
-		CrossJoin(view, joinView)
+		CrossJoin(view, joinView, parentFilter)
 		return nil
 	}
 
 	mergedHeader := MergeHeader(view.Header, joinView.Header)
 
-	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore))
+	targets, err := newJoinTargets(view, joinView, condition, method, parentFilter)
+	if err != nil {
+		return err
+	}
+
+	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), parentFilter.cpu())
 	recordsList := make([]RecordSet, gm.Number)
 	for i := 0; i < gm.Number; i++ {
 		gm.Add()
@@ -143,7 +157,7 @@ func InnerJoin(view *View, joinView *View, condition parser.QueryExpression, par
 
 		InnerJoinLoop:
 			for i := start; i < end; i++ {
-				for j := 0; j < joinView.RecordLen(); j++ {
+				for _, j := range targets.get(i) {
 					if gm.HasError() {
 						break InnerJoinLoop
 					}
@@ -179,6 +193,10 @@ func InnerJoin(view *View, joinView *View, condition parser.QueryExpression, par
 }
 
 func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, direction int, parentFilter *Filter) error {
+	return outerJoin(view, joinView, condition, direction, nestedLoopJoin, parentFilter)
+}
+
+func outerJoin(view *View, joinView *View, condition parser.QueryExpression, direction int, method joinMethod, parentFilter *Filter) error {
 	if direction == parser.TokenUndefined {
 		direction = parser.LEFT
 	}
@@ -192,7 +210,12 @@ func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, dir
 	viewEmptyRecord := NewEmptyRecord(view.FieldLen())
 	joinViewEmptyRecord := NewEmptyRecord(joinView.FieldLen())
 
-	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore))
+	targets, err := newJoinTargets(view, joinView, condition, method, parentFilter)
+	if err != nil {
+		return err
+	}
+
+	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), parentFilter.cpu())
 
 	recordsList := make([]RecordSet, gm.Number)
 	joinViewMatchesList := make([][]bool, gm.Number)
@@ -216,7 +239,7 @@ func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, dir
 		OuterJoinLoop:
 			for i := start; i < end; i++ {
 				match := false
-				for j := 0; j < joinView.RecordLen(); j++ {
+				for _, j := range targets.get(i) {
 					if gm.HasError() {
 						break OuterJoinLoop
 					}
@@ -294,6 +317,176 @@ func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, dir
 	return nil
 }
 
+// joinTargets holds the indices of the records in the join view that can satisfy the join
+// condition with each record in the view.
+//
+// For the nested loop join, all the records in the join view are candidates. For the hash join,
+// the records of the smaller side are grouped by the values of the expressions in the equalities
+// of the join condition, and only the records that have the same values are candidates.
+// If one side is a table that has an index on the columns compared by the equalities, the index
+// is used instead of the hash table.
+// The whole condition is evaluated for each candidate in both methods, so the methods
+// do not change the results.
+type joinTargets struct {
+	all []int
+
+	keys    []string
+	hasKey  []bool
+	buckets map[string][]int
+	index   *TableIndex
+
+	matches [][]int
+}
+
+func newJoinTargets(view *View, joinView *View, condition parser.QueryExpression, method joinMethod, parentFilter *Filter) (*joinTargets, error) {
+	if method == hashJoin {
+		if keyExprs, joinKeyExprs := equiJoinKeys(condition, view, joinView); 0 < len(keyExprs) {
+			if index, exprs := indexJoinKeys(joinView, joinKeyExprs, keyExprs, parentFilter); index != nil {
+				keys, hasKey, err := evalJoinKeys(view, exprs, parentFilter)
+				if err != nil {
+					return nil, err
+				}
+				return &joinTargets{keys: keys, hasKey: hasKey, index: index}, nil
+			}
+			if index, exprs := indexJoinKeys(view, keyExprs, joinKeyExprs, parentFilter); index != nil {
+				joinKeys, joinHasKey, err := evalJoinKeys(joinView, exprs, parentFilter)
+				if err != nil {
+					return nil, err
+				}
+				matches := make([][]int, view.RecordLen())
+				for j := range joinKeys {
+					if joinHasKey[j] {
+						for _, i := range index.lookup(joinKeys[j]) {
+							matches[i] = append(matches[i], j)
+						}
+					}
+				}
+				return &joinTargets{matches: matches}, nil
+			}
+
+			keys, hasKey, err := evalJoinKeys(view, keyExprs, parentFilter)
+			if err != nil {
+				return nil, err
+			}
+			joinKeys, joinHasKey, err := evalJoinKeys(joinView, joinKeyExprs, parentFilter)
+			if err != nil {
+				return nil, err
+			}
+
+			if joinView.RecordLen() <= view.RecordLen() {
+				return &joinTargets{keys: keys, hasKey: hasKey, buckets: buildJoinBuckets(joinKeys, joinHasKey)}, nil
+			}
+
+			// The hash table is built on the view, and the candidates for each record in the view
+			// are collected by probing it with the join view in order, so that the order of
+			// the candidates is the same as when the hash table is built on the join view.
+			buckets := buildJoinBuckets(keys, hasKey)
+			matches := make([][]int, view.RecordLen())
+			for j := range joinKeys {
+				if joinHasKey[j] {
+					for _, i := range buckets[joinKeys[j]] {
+						matches[i] = append(matches[i], j)
+					}
+				}
+			}
+			return &joinTargets{matches: matches}, nil
+		}
+	}
+
+	all := make([]int, joinView.RecordLen())
+	for j := range all {
+		all[j] = j
+	}
+	return &joinTargets{all: all}, nil
+}
+
+func buildJoinBuckets(keys []string, hasKey []bool) map[string][]int {
+	buckets := make(map[string][]int, len(keys))
+	for i := range keys {
+		if hasKey[i] {
+			buckets[keys[i]] = append(buckets[keys[i]], i)
+		}
+	}
+	return buckets
+}
+
+func (c *joinTargets) get(i int) []int {
+	switch {
+	case c.matches != nil:
+		return c.matches[i]
+	case c.index != nil:
+		if !c.hasKey[i] {
+			return nil
+		}
+		return c.index.lookup(c.keys[i])
+	case c.buckets != nil:
+		if !c.hasKey[i] {
+			return nil
+		}
+		return c.buckets[c.keys[i]]
+	default:
+		return c.all
+	}
+}
+
+// equiJoinKeys returns the pairs of the expressions compared by the equalities in the join
+// condition combined with AND, each of which refers only to the fields of one side.
+func equiJoinKeys(condition parser.QueryExpression, view *View, joinView *View) ([]parser.QueryExpression, []parser.QueryExpression) {
+	var keys []parser.QueryExpression
+	var joinKeys []parser.QueryExpression
+
+	for _, expr := range splitConjunction(condition) {
+		comparison, ok := expr.(parser.Comparison)
+		if !ok || comparison.Operator != "=" || isRowValueExpression(comparison.LHS) || isRowValueExpression(comparison.RHS) {
+			continue
+		}
+
+		if isJoinKey(comparison.LHS, view, joinView) && isJoinKey(comparison.RHS, joinView, view) {
+			keys = append(keys, comparison.LHS)
+			joinKeys = append(joinKeys, comparison.RHS)
+		} else if isJoinKey(comparison.RHS, view, joinView) && isJoinKey(comparison.LHS, joinView, view) {
+			keys = append(keys, comparison.RHS)
+			joinKeys = append(joinKeys, comparison.LHS)
+		}
+	}
+	return keys, joinKeys
+}
+
+func isJoinKey(expr parser.QueryExpression, view *View, otherView *View) bool {
+	return isInnerExpression(expr, view) && isOuterExpression(expr, otherView)
+}
+
+// evalJoinKeys returns the serialized values of the expressions for each record in the view.
+// A record that has a null value cannot match any record, so that its key is invalid.
+func evalJoinKeys(view *View, exprs []parser.QueryExpression, parentFilter *Filter) ([]string, []bool, error) {
+	keys := make([]string, view.RecordLen())
+	hasKey := make([]bool, view.RecordLen())
+
+	filter := NewFilterForRecord(view, 0, parentFilter)
+	values := make([]value.Primary, len(exprs))
+	keyBuf := new(bytes.Buffer)
+
+	for i := range view.RecordSet {
+		filter.Records[0].RecordIndex = i
+		for j, expr := range exprs {
+			p, err := filter.Evaluate(expr)
+			if err != nil {
+				return nil, nil, err
+			}
+			values[j] = p
+		}
+		if containsNull(values) {
+			continue
+		}
+
+		keyBuf.Reset()
+		SerializeComparisonKeys(keyBuf, values)
+		keys[i] = keyBuf.String()
+		hasKey[i] = true
+	}
+	return keys, hasKey, nil
+}
+
 func CalcMinimumRequired(i1 int, i2 int, defaultMinimumRequired int) int {
 	if i1 < 1 || i2 < 1 {
 		return defaultMinimumRequired
@@ -305,3 +498,191 @@ func CalcMinimumRequired(i1 int, i2 int, defaultMinimumRequired int) int {
 	}
 	return int(math.Ceil(float64(i1) / math.Floor(float64(p)/float64(defaultMinimumRequired))))
 }
+
+// LateralJoin joins each record of the view with the rows expanded from the
+// expression that is evaluated for the record.
+func LateralJoin(view *View, joinView *View, expr parser.QueryExpression, condition parser.QueryExpression, outer bool, parentFilter *Filter) error {
+	mergedHeader := MergeHeader(view.Header, joinView.Header)
+
+	nullRow := make([]value.Primary, joinView.FieldLen())
+	for i := range nullRow {
+		nullRow[i] = value.NewNull()
+	}
+
+	gm := NewGoroutineTaskManager(view.RecordLen(), -1, parentFilter.cpu())
+	recordsList := make([]RecordSet, gm.Number)
+	for i := 0; i < gm.Number; i++ {
+		gm.Add()
+		go func(thIdx int) {
+			start, end := gm.RecordRange(thIdx)
+			records := make(RecordSet, 0, end-start)
+			filter := NewFilterForRecord(view, 0, parentFilter)
+			conditionFilter := NewFilterForRecord(
+				&View{
+					Header:    mergedHeader,
+					RecordSet: make(RecordSet, 1),
+				},
+				0,
+				parentFilter,
+			)
+
+		LateralJoinLoop:
+			for i := start; i < end; i++ {
+				if gm.HasError() {
+					break LateralJoinLoop
+				}
+
+				filter.Records[0].RecordIndex = i
+				rows, e := EvaluateLateral(expr, filter)
+				if e != nil {
+					gm.SetError(e)
+					break LateralJoinLoop
+				}
+
+				match := false
+				for _, row := range rows {
+					mergedRecord := appendLateralCells(view.RecordSet[i], row)
+
+					if condition != nil {
+						conditionFilter.Records[0].View.RecordSet[0] = mergedRecord
+						primary, e := conditionFilter.Evaluate(condition)
+						if e != nil {
+							gm.SetError(e)
+							break LateralJoinLoop
+						}
+						if primary.Ternary() != ternary.TRUE {
+							continue
+						}
+					}
+					records = append(records, mergedRecord)
+					match = true
+				}
+
+				if outer && !match {
+					records = append(records, appendLateralCells(view.RecordSet[i], nullRow))
+				}
+			}
+
+			recordsList[thIdx] = records
+			gm.Done()
+		}(i)
+	}
+	gm.Wait()
+
+	if gm.HasError() {
+		return gm.Err()
+	}
+
+	view.Header = mergedHeader
+	view.RecordSet = MergeRecordSetList(recordsList)
+	view.FileInfo = nil
+	return nil
+}
+
+func appendLateralCells(record Record, row []value.Primary) Record {
+	mergedRecord := make(Record, len(record), len(record)+len(row))
+	copy(mergedRecord, record)
+	for _, v := range row {
+		mergedRecord = append(mergedRecord, NewCell(v))
+	}
+	return mergedRecord
+}
+
+// EvaluateLateral returns the rows to be joined with a record.
+func EvaluateLateral(expr parser.QueryExpression, filter *Filter) ([][]value.Primary, error) {
+	var values []value.Primary
+	var err error
+
+	switch expr.(type) {
+	case parser.JsonTable:
+		return EvaluateJsonTable(expr.(parser.JsonTable), filter)
+	case parser.GenerateSeries:
+		values, err = EvaluateGenerateSeries(expr.(parser.GenerateSeries), filter)
+	default:
+		values, err = EvaluateUnnest(expr.(parser.Unnest), filter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([][]value.Primary, 0, len(values))
+	for _, v := range values {
+		rows = append(rows, []value.Primary{v})
+	}
+	return rows, nil
+}
+
+// EvaluateUnnest returns the elements of the array to be expanded into rows.
+// A null value is expanded into no rows.
+func EvaluateUnnest(expr parser.Unnest, filter *Filter) ([]value.Primary, error) {
+	p, err := filter.Evaluate(expr.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	switch p.(type) {
+	case value.Array:
+		return p.(value.Array).Raw(), nil
+	case value.Null:
+		return nil, nil
+	}
+	return nil, NewUnnestNotArrayError(expr)
+}
+
+// EvaluateJsonTable returns the rows and columns extracted from the json text.
+// A null value is expanded into no rows.
+func EvaluateJsonTable(expr parser.JsonTable, filter *Filter) ([][]value.Primary, error) {
+	p, err := filter.Evaluate(expr.JsonText)
+	if err != nil {
+		return nil, err
+	}
+	jsonText := value.ToString(p)
+	if value.IsNull(jsonText) {
+		return nil, nil
+	}
+
+	query, err := evalJsonTableQuery(expr, expr.Query, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]json.QueryExpression, 0, len(expr.Columns))
+	for _, c := range expr.Columns {
+		column := c.(parser.JsonTableColumn)
+		if column.Path == nil {
+			columns = append(columns, json.Element{Label: column.Name.Literal})
+			continue
+		}
+
+		q, err := evalJsonTableQuery(expr, column.Path, filter)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, q)
+	}
+
+	rows, err := json.LoadColumns(query, jsonText.(value.String).Raw(), columns)
+	if err != nil {
+		return nil, NewJsonQueryError(expr, err.Error())
+	}
+	return rows, nil
+}
+
+func evalJsonTableQuery(expr parser.JsonTable, queryExpr parser.QueryExpression, filter *Filter) (json.QueryExpression, error) {
+	p, err := filter.Evaluate(queryExpr)
+	if err != nil {
+		return nil, err
+	}
+	s := value.ToString(p)
+	if value.IsNull(s) {
+		return nil, NewJsonQueryEmptyError(expr)
+	}
+
+	// The cache of the parsed queries is not used because this function
+	// is called concurrently.
+	query, err := json.ParseQuery(strings.TrimSpace(s.(value.String).Raw()))
+	if err != nil {
+		return nil, NewJsonQueryError(expr, err.Error())
+	}
+	return query, nil
+}
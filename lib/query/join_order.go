@@ -0,0 +1,366 @@
+package query
+
+import (
+	"math"
+	"sort"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const (
+	minReorderedJoinTables = 3
+	minJoinReorderGain     = 10
+	joinOrderColumn        = "@__join_order"
+)
+
+type joinSequence struct {
+	tables     []parser.QueryExpression
+	conditions []joinSequenceCondition
+}
+
+type joinSequenceCondition struct {
+	expr      parser.QueryExpression
+	joinIndex int
+	tables    []bool
+}
+
+func newJoinSequence(join parser.Join, filter *Filter) (*joinSequence, bool) {
+	if filter.hints != nil && 0 < len(filter.hints.joinMethods) {
+		return nil, false
+	}
+
+	seq := &joinSequence{}
+	if !seq.add(join) || len(seq.tables) < minReorderedJoinTables {
+		return nil, false
+	}
+	return seq, true
+}
+
+func (seq *joinSequence) add(join parser.Join) bool {
+	if !isReorderableJoin(join) {
+		return false
+	}
+
+	if table, ok := join.Table.(parser.Table); ok {
+		if lhs, ok := table.Object.(parser.Join); ok && isReorderableJoin(lhs) {
+			seq.add(lhs)
+		} else {
+			seq.tables = append(seq.tables, join.Table)
+		}
+	} else {
+		seq.tables = append(seq.tables, join.Table)
+	}
+	seq.tables = append(seq.tables, join.JoinTable)
+
+	if join.Condition != nil {
+		for _, expr := range splitConjunction(join.Condition.(parser.JoinCondition).On) {
+			seq.conditions = append(seq.conditions, joinSequenceCondition{expr: expr, joinIndex: len(seq.tables) - 1})
+		}
+	}
+	return true
+}
+
+func isReorderableJoin(join parser.Join) bool {
+	if !join.Natural.IsEmpty() || !join.Direction.IsEmpty() {
+		return false
+	}
+	switch join.JoinType.Token {
+	case parser.TokenUndefined, parser.INNER, parser.CROSS:
+	default:
+		return false
+	}
+	if join.Condition != nil && join.Condition.(parser.JoinCondition).On == nil {
+		return false
+	}
+	_, isLateral := lateralTable(join.JoinTable)
+	return !isLateral
+}
+
+func (seq *joinSequence) resolveConditions(views []*View) bool {
+	for i := range seq.conditions {
+		c := &seq.conditions[i]
+		c.tables = make([]bool, len(views))
+
+		isResolvable := true
+		isSimple := walkExpression(c.expr, func(e parser.QueryExpression) bool {
+			switch e.(type) {
+			case parser.FieldReference, parser.ColumnNumber:
+				matches := 0
+				for j, view := range views {
+					if _, err := view.FieldIndex(e); err == nil {
+						c.tables[j] = true
+						matches++
+						if c.joinIndex < j {
+							isResolvable = false
+						}
+					}
+				}
+				if 1 < matches {
+					isResolvable = false
+				}
+				return true
+			}
+			return isDecorrelatableNode(e, nil)
+		})
+		if !isResolvable {
+			return false
+		}
+
+		// Conditions with side effects are evaluated after all the tables are joined.
+		if !isSimple {
+			for j := range c.tables {
+				c.tables[j] = true
+			}
+		}
+	}
+	return true
+}
+
+func (seq *joinSequence) order(views []*View) []int {
+	joined := make([]bool, len(views))
+	applied := make([]bool, len(seq.conditions))
+
+	first, second := 0, 1
+	rows := math.Inf(1)
+	for i := 0; i < len(views); i++ {
+		for j := i + 1; j < len(views); j++ {
+			joined[i], joined[j] = true, true
+			if est := joinRowsEstimate(float64(views[i].RecordLen()), float64(views[j].RecordLen()), seq.applicableConditions(joined, applied)); est < rows {
+				first, second = i, j
+				rows = est
+			}
+			joined[i], joined[j] = false, false
+		}
+	}
+
+	order := []int{first, second}
+	joined[first], joined[second] = true, true
+	seq.markApplied(joined, applied)
+
+	for len(order) < len(views) {
+		next := -1
+		nextRows := math.Inf(1)
+		for i := range views {
+			if joined[i] {
+				continue
+			}
+			joined[i] = true
+			if est := joinRowsEstimate(rows, float64(views[i].RecordLen()), seq.applicableConditions(joined, applied)); est < nextRows {
+				next = i
+				nextRows = est
+			}
+			joined[i] = false
+		}
+
+		order = append(order, next)
+		joined[next] = true
+		seq.markApplied(joined, applied)
+		rows = nextRows
+	}
+	return order
+}
+
+func (seq *joinSequence) intermediateRows(views []*View, order []int) float64 {
+	joined := make([]bool, len(views))
+	applied := make([]bool, len(seq.conditions))
+
+	joined[order[0]] = true
+	rows := float64(views[order[0]].RecordLen())
+	total := 0.0
+	for _, idx := range order[1 : len(order)-1] {
+		joined[idx] = true
+		rows = joinRowsEstimate(rows, float64(views[idx].RecordLen()), seq.applicableConditions(joined, applied))
+		seq.markApplied(joined, applied)
+		total = total + rows
+	}
+	return total
+}
+
+func (seq *joinSequence) applicableConditions(joined []bool, applied []bool) []parser.QueryExpression {
+	var list []parser.QueryExpression
+	for i, c := range seq.conditions {
+		if !applied[i] && containsAllTables(joined, c.tables) {
+			list = append(list, c.expr)
+		}
+	}
+	return list
+}
+
+func (seq *joinSequence) markApplied(joined []bool, applied []bool) {
+	for i, c := range seq.conditions {
+		if containsAllTables(joined, c.tables) {
+			applied[i] = true
+		}
+	}
+}
+
+func containsAllTables(joined []bool, tables []bool) bool {
+	for i := range tables {
+		if tables[i] && !joined[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func joinRowsEstimate(lhs float64, rhs float64, conditions []parser.QueryExpression) float64 {
+	if len(conditions) < 1 {
+		return lhs * rhs
+	}
+	condition := joinConjunction(conditions)
+	if isEquiJoinCondition(condition) {
+		return math.Max(lhs, rhs)
+	}
+	return lhs * rhs * conditionSelectivity(condition)
+}
+
+func loadJoinSequence(seq *joinSequence, filter *Filter, useInternalId bool, forUpdate bool) (*View, error) {
+	views := make([]*View, len(seq.tables))
+	nodes := make([][]*ExplainNode, len(seq.tables))
+	for i, v := range seq.tables {
+		loaded, err := loadView(v, filter, useInternalId, forUpdate)
+		if err != nil {
+			return nil, err
+		}
+		views[i] = loaded
+		nodes[i] = filter.profiler.take(1)
+	}
+
+	order := make([]int, len(views))
+	for i := range order {
+		order[i] = i
+	}
+	if seq.resolveConditions(views) {
+		if estimated := seq.order(views); seq.intermediateRows(views, estimated)*minJoinReorderGain < seq.intermediateRows(views, order) {
+			order = estimated
+		}
+	} else {
+		for i := range seq.conditions {
+			c := &seq.conditions[i]
+			c.tables = make([]bool, len(views))
+			for j := 0; j <= c.joinIndex; j++ {
+				c.tables[j] = true
+			}
+		}
+	}
+
+	isReordered := false
+	for i, idx := range order {
+		if i != idx {
+			isReordered = true
+			break
+		}
+	}
+	fieldLens := make([]int, len(views))
+	if isReordered {
+		for i, view := range views {
+			addJoinOrderField(view)
+			fieldLens[i] = view.FieldLen()
+		}
+	}
+
+	view := views[order[0]]
+	filter.profiler.push(nodes[order[0]]...)
+
+	joined := make([]bool, len(views))
+	applied := make([]bool, len(seq.conditions))
+	joined[order[0]] = true
+	for _, idx := range order[1:] {
+		joinView := views[idx]
+		joined[idx] = true
+		conditions := seq.applicableConditions(joined, applied)
+		seq.markApplied(joined, applied)
+
+		var condition parser.QueryExpression
+		if 0 < len(conditions) {
+			condition = joinConjunction(conditions)
+		}
+
+		node := joinSequenceNode(condition, view, joinView)
+
+		filter.profiler.push(nodes[idx]...)
+		if err := filter.profiler.measure(func() *ExplainNode { return node }, 2, func() (int, error) {
+			if condition == nil {
+				CrossJoin(view, joinView, filter)
+				return view.RecordLen(), nil
+			}
+			err := innerJoin(view, joinView, condition, hashJoin, filter)
+			return view.RecordLen(), err
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if isReordered {
+		restoreJoinOrder(view, fieldLens, order, filter)
+	}
+	return view, nil
+}
+
+func joinSequenceNode(condition parser.QueryExpression, view *View, joinView *View) *ExplainNode {
+	if condition == nil {
+		return &ExplainNode{Operation: "Cross Join"}
+	}
+	operation := "Nested Loop Join"
+	if keys, _ := equiJoinKeys(condition, view, joinView); 0 < len(keys) {
+		operation = "Hash Join"
+	}
+	return &ExplainNode{Operation: operation, Detail: "INNER ON " + condition.String()}
+}
+
+func addJoinOrderField(view *View) {
+	view.Header = append(view.Header.Copy(), HeaderField{Column: joinOrderColumn})
+
+	records := make(RecordSet, view.RecordLen())
+	for i, record := range view.RecordSet {
+		r := make(Record, len(record)+1)
+		copy(r, record)
+		r[len(record)] = NewCell(value.NewInteger(int64(i)))
+		records[i] = r
+	}
+	view.RecordSet = records
+}
+
+func restoreJoinOrder(view *View, fieldLens []int, order []int, filter *Filter) {
+	offsets := make([]int, len(fieldLens))
+	pos := 0
+	for _, idx := range order {
+		offsets[idx] = pos
+		pos = pos + fieldLens[idx]
+	}
+
+	orderFields := make([]int, len(fieldLens))
+	fieldIndices := make([]int, 0, view.FieldLen()-len(fieldLens))
+	for i, n := range fieldLens {
+		orderFields[i] = offsets[i] + n - 1
+		for j := 0; j < n-1; j++ {
+			fieldIndices = append(fieldIndices, offsets[i]+j)
+		}
+	}
+
+	sort.SliceStable(view.RecordSet, func(i, j int) bool {
+		for _, idx := range orderFields {
+			vi := view.RecordSet[i][idx].Value().(value.Integer).Raw()
+			vj := view.RecordSet[j][idx].Value().(value.Integer).Raw()
+			if vi != vj {
+				return vi < vj
+			}
+		}
+		return false
+	})
+
+	header := make(Header, len(fieldIndices))
+	for i, idx := range fieldIndices {
+		header[i] = view.Header[idx]
+	}
+	view.Header = header
+
+	NewGoroutineTaskManager(view.RecordLen(), -1, filter.cpu()).Run(func(index int) {
+		record := make(Record, len(fieldIndices))
+		for i, idx := range fieldIndices {
+			record[i] = view.RecordSet[index][idx]
+		}
+		view.RecordSet[index] = record
+	})
+}
@@ -0,0 +1,154 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func joinOrderTestFilter() *Filter {
+	var view = func(name string, columns []string, n int, fn func(i int) []value.Primary) *View {
+		records := make(RecordSet, n)
+		for i := range records {
+			records[i] = NewRecord(fn(i))
+		}
+		return &View{
+			Header:    NewHeader(name, columns),
+			RecordSet: records,
+			FileInfo:  &FileInfo{Path: name, IsTemporary: true},
+		}
+	}
+
+	return &Filter{
+		TempViews: TemporaryViewScopes{
+			ViewMap{
+				"VIEW1": view("view1", []string{"id", "x"}, 40, func(i int) []value.Primary {
+					return []value.Primary{value.NewInteger(int64(i)), value.NewInteger(int64(i % 7))}
+				}),
+				"VIEW2": view("view2", []string{"id", "y"}, 40, func(i int) []value.Primary {
+					return []value.Primary{value.NewInteger(int64(i)), value.NewInteger(int64(i % 5))}
+				}),
+				"VIEW3": view("view3", []string{"x", "y"}, 4, func(i int) []value.Primary {
+					return []value.Primary{value.NewInteger(int64(i + 2)), value.NewInteger(int64(4 - i))}
+				}),
+			},
+		},
+	}
+}
+
+var joinSequenceOrderTests = []struct {
+	Name   string
+	Query  string
+	Order  []int
+	IsJoin bool
+}{
+	{
+		Name:   "Cross Join Avoided",
+		Query:  "SELECT * FROM view1 CROSS JOIN view2 JOIN view3 ON view1.x = view3.x AND view2.y = view3.y",
+		Order:  []int{0, 2, 1},
+		IsJoin: true,
+	},
+	{
+		Name:   "Written Order",
+		Query:  "SELECT * FROM view1 JOIN view3 ON view1.x = view3.x JOIN view2 ON view2.y = view3.y",
+		Order:  []int{0, 1, 2},
+		IsJoin: true,
+	},
+	{
+		Name:   "Condition Referring to a Later Table",
+		Query:  "SELECT * FROM view1 CROSS JOIN view2 JOIN view3 ON view1.x = view3.x AND view2.y = view3.y AND view1.id = v4.id JOIN view1 v4 ON TRUE",
+		Order:  []int{0, 1, 2, 3},
+		IsJoin: true,
+	},
+	{
+		Name:  "Two Tables",
+		Query: "SELECT * FROM view1 JOIN view3 ON view1.x = view3.x",
+	},
+	{
+		Name:  "Outer Join",
+		Query: "SELECT * FROM view1 CROSS JOIN view2 LEFT JOIN view3 ON view1.x = view3.x",
+	},
+	{
+		Name:  "Join Method Hint",
+		Query: "SELECT /*+ HASH_JOIN */ * FROM view1 CROSS JOIN view2 JOIN view3 ON view1.x = view3.x AND view2.y = view3.y",
+	},
+}
+
+func TestJoinSequence_Order(t *testing.T) {
+	for _, v := range joinSequenceOrderTests {
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+		query := program[0].(parser.SelectQuery)
+		entity := query.SelectEntity.(parser.SelectEntity)
+		table := entity.FromClause.(parser.FromClause).Tables[0].(parser.Table)
+
+		filter := joinOrderTestFilter().CreateNode()
+		filter.hints = newQueryHints(entity.SelectClause.(parser.SelectClause).Hints, nil)
+
+		seq, ok := newJoinSequence(table.Object.(parser.Join), filter)
+		if ok != v.IsJoin {
+			t.Errorf("%s: sequence = %t, want %t", v.Name, ok, v.IsJoin)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		views := make([]*View, len(seq.tables))
+		for i, expr := range seq.tables {
+			if views[i], err = loadView(expr, filter, false, false); err != nil {
+				t.Fatalf("%s: unexpected error %q", v.Name, err)
+			}
+		}
+
+		order := []int{0, 1, 2, 3}[:len(views)]
+		if seq.resolveConditions(views) {
+			if estimated := seq.order(views); seq.intermediateRows(views, estimated)*minJoinReorderGain < seq.intermediateRows(views, order) {
+				order = estimated
+			}
+		}
+		if !reflect.DeepEqual(order, v.Order) {
+			t.Errorf("%s: order = %v, want %v", v.Name, order, v.Order)
+		}
+	}
+}
+
+func TestSelect_JoinOrder(t *testing.T) {
+	queries := []string{
+		"SELECT * FROM view1 CROSS JOIN view2 JOIN view3 ON view1.x = view3.x AND view2.y = view3.y",
+		"SELECT view2.id, v.x, view1.id FROM view2 CROSS JOIN view1 JOIN view3 v ON view1.x = v.x AND view2.y = v.y WHERE view2.id < 30",
+	}
+
+	for _, query := range queries {
+		program, err := parser.Parse(query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", query, err)
+		}
+		result, err := Select(program[0].(parser.SelectQuery), joinOrderTestFilter())
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", query, err)
+			continue
+		}
+
+		// A join method hint keeps the written order.
+		program, _ = parser.Parse("SELECT /*+ HASH_JOIN */"+query[len("SELECT"):], "")
+		expect, err := Select(program[0].(parser.SelectQuery), joinOrderTestFilter())
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", query, err)
+		}
+
+		if result.RecordLen() < 1 {
+			t.Errorf("%s: no records", query)
+		}
+		if !reflect.DeepEqual(result.Header, expect.Header) {
+			t.Errorf("%s: header = %v, want %v", query, result.Header, expect.Header)
+		}
+		if !reflect.DeepEqual(result.RecordSet, expect.RecordSet) {
+			t.Errorf("%s: records are not in the same order as joining in the written order", query)
+		}
+	}
+}
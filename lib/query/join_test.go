@@ -300,7 +300,7 @@ func TestCrossJoin(t *testing.T) {
 		},
 	}
 
-	CrossJoin(view, joinView)
+	CrossJoin(view, joinView, NewEmptyFilter())
 	if !reflect.DeepEqual(view, expect) {
 		t.Errorf("Cross Join: result = %v, want %v", view, expect)
 	}
@@ -1003,6 +1003,152 @@ func TestOuterJoin(t *testing.T) {
 	}
 }
 
+func hashJoinTestView(name string, columns []string, values [][]value.Primary) *View {
+	records := make([]Record, len(values))
+	for i, v := range values {
+		records[i] = NewRecordWithId(i+1, v)
+	}
+	return &View{
+		Header:    NewHeaderWithId(name, columns),
+		RecordSet: records,
+	}
+}
+
+var hashJoinTestView1 = hashJoinTestView("table1", []string{"column1", "column2"}, [][]value.Primary{
+	{value.NewInteger(1), value.NewString("str1")},
+	{value.NewInteger(2), value.NewString("str2")},
+	{value.NewNull(), value.NewString("str3")},
+	{value.NewInteger(2), value.NewString("str4")},
+	{value.NewInteger(3), value.NewString("str5")},
+})
+
+var hashJoinTestView2 = hashJoinTestView("table2", []string{"column3", "column4"}, [][]value.Primary{
+	{value.NewInteger(2), value.NewString("str2")},
+	{value.NewString("3"), value.NewString("str22")},
+	{value.NewNull(), value.NewString("str3")},
+	{value.NewInteger(2), value.NewString("str4")},
+	{value.NewInteger(4), value.NewString("str5")},
+})
+
+var hashJoinTests = []struct {
+	Name      string
+	Condition parser.QueryExpression
+	KeyLen    int
+}{
+	{
+		Name: "Hash Join with Equality",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+			Operator: "=",
+		},
+		KeyLen: 1,
+	},
+	{
+		Name: "Hash Join with Reversed Equality and Other Condition",
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				Operator: "=",
+			},
+			RHS: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+				Operator: "<>",
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "and"},
+		},
+		KeyLen: 1,
+	},
+	{
+		Name: "Hash Join with Multiple Equalities",
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				Operator: "=",
+			},
+			RHS: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+				Operator: "=",
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "and"},
+		},
+		KeyLen: 2,
+	},
+	{
+		Name: "Hash Join without Equi-Join Keys",
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				Operator: "=",
+			},
+			RHS: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+				Operator: "=",
+			},
+			Operator: parser.Token{Token: parser.OR, Literal: "or"},
+		},
+		KeyLen: 0,
+	},
+}
+
+func TestHashJoin(t *testing.T) {
+	filter := NewEmptyFilter()
+
+	smallView1 := hashJoinTestView1.Copy()
+	smallView1.RecordSet = smallView1.RecordSet[:2]
+	smallView2 := hashJoinTestView2.Copy()
+	smallView2.RecordSet = smallView2.RecordSet[:2]
+
+	sides := []struct {
+		Name     string
+		View     *View
+		JoinView *View
+	}{
+		{Name: "same size", View: hashJoinTestView1, JoinView: hashJoinTestView2},
+		{Name: "smaller left side", View: smallView1, JoinView: hashJoinTestView2},
+		{Name: "smaller right side", View: hashJoinTestView1, JoinView: smallView2},
+	}
+
+	for _, v := range hashJoinTests {
+		keys, joinKeys := equiJoinKeys(v.Condition, hashJoinTestView1, hashJoinTestView2)
+		if len(keys) != v.KeyLen || len(joinKeys) != v.KeyLen {
+			t.Errorf("%s: %d keys extracted, want %d", v.Name, len(keys), v.KeyLen)
+		}
+
+		for _, side := range sides {
+			expect := side.View.Copy()
+			if err := innerJoin(expect, side.JoinView.Copy(), v.Condition, nestedLoopJoin, filter); err != nil {
+				t.Fatalf("%s with %s: unexpected error %q", v.Name, side.Name, err)
+			}
+			result := side.View.Copy()
+			if err := innerJoin(result, side.JoinView.Copy(), v.Condition, hashJoin, filter); err != nil {
+				t.Errorf("%s with %s: unexpected error %q", v.Name, side.Name, err)
+			} else if !reflect.DeepEqual(result, expect) {
+				t.Errorf("%s with %s: inner join result = %v, want %v", v.Name, side.Name, result, expect)
+			}
+
+			for _, direction := range []int{parser.LEFT, parser.RIGHT, parser.FULL} {
+				expect := side.View.Copy()
+				if err := outerJoin(expect, side.JoinView.Copy(), v.Condition, direction, nestedLoopJoin, filter); err != nil {
+					t.Fatalf("%s with %s: unexpected error %q", v.Name, side.Name, err)
+				}
+				result := side.View.Copy()
+				if err := outerJoin(result, side.JoinView.Copy(), v.Condition, direction, hashJoin, filter); err != nil {
+					t.Errorf("%s with %s: unexpected error %q", v.Name, side.Name, err)
+				} else if !reflect.DeepEqual(result, expect) {
+					t.Errorf("%s with %s: %s outer join result = %v, want %v", v.Name, side.Name, parser.TokenLiteral(direction), result, expect)
+				}
+			}
+		}
+	}
+}
+
 var calcMinimumRequiredTests = []struct {
 	Int1    int
 	Int2    int
@@ -1055,7 +1201,7 @@ func BenchmarkCrossJoin(b *testing.B) {
 		view := GenerateBenchView("t1", 100)
 		joinView := GenerateBenchView("t2", 100)
 
-		CrossJoin(view, joinView)
+		CrossJoin(view, joinView, NewEmptyFilter())
 	}
 }
 
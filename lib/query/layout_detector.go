@@ -0,0 +1,365 @@
+package query
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+)
+
+type LayoutDetector struct {
+	LineBreaks  []text.LineBreak
+	FieldQuotes [][]FieldQuote
+
+	MaxFieldSize   int
+	MaxRowSize     int
+	RecoverQuotes  bool
+	RecoveredLines []int
+
+	reader    io.Reader
+	quoting   bool
+	delimiter []byte
+
+	buf     []byte
+	in      []byte
+	out     bytes.Buffer
+	held    []byte
+	err     error
+	readErr error
+
+	fieldStart   bool
+	quoted       bool
+	literal      bool
+	pendingQuote bool
+	pendingCR    bool
+	recent       []byte
+
+	fieldQuote FieldQuote
+	lineQuotes []FieldQuote
+	lineEmpty  bool
+	anyQuoted  bool
+
+	line       int
+	prevCR     bool
+	rowLine    int
+	rowSize    int
+	fieldLine  int
+	fieldIndex int
+	fieldSize  int
+}
+
+func NewLayoutDetector(r io.Reader, quoting bool, delimiter rune) *LayoutDetector {
+	d := &LayoutDetector{
+		LineBreaks: make([]text.LineBreak, 0, 1000),
+		reader:     r,
+		quoting:    quoting,
+		buf:        make([]byte, 4096),
+		fieldStart: true,
+		fieldQuote: NotQuoted,
+		lineEmpty:  true,
+		line:       1,
+		rowLine:    1,
+		fieldLine:  1,
+	}
+	if quoting {
+		buf := make([]byte, utf8.UTFMax)
+		d.delimiter = buf[:utf8.EncodeRune(buf, delimiter)]
+		d.recent = make([]byte, 0, len(d.delimiter))
+		d.FieldQuotes = make([][]FieldQuote, 0, 1000)
+	}
+	return d
+}
+
+func requiresLayoutDetector(flags *cmd.Flags) bool {
+	return 0 < flags.MaxFieldSize || 0 < flags.MaxRowSize || flags.RecoverQuotes
+}
+
+func (d *LayoutDetector) Read(p []byte) (int, error) {
+	for d.out.Len() < 1 && d.err == nil {
+		d.fill()
+	}
+	if 0 < d.out.Len() {
+		return d.out.Read(p)
+	}
+	return 0, d.err
+}
+
+func (d *LayoutDetector) fill() {
+	if len(d.in) < 1 && d.readErr == nil {
+		n, err := d.reader.Read(d.buf)
+		d.in = d.buf[:n]
+		d.readErr = err
+	}
+
+	for 0 < len(d.in) && d.err == nil {
+		b := d.in[0]
+		d.in = d.in[1:]
+		d.scan(b)
+	}
+
+	if len(d.in) < 1 && d.readErr != nil && d.err == nil {
+		d.terminate(d.readErr)
+	}
+}
+
+func (d *LayoutDetector) terminate(err error) {
+	if err == io.EOF {
+		if d.quoted && !d.pendingQuote && d.recover() {
+			return
+		}
+		if d.pendingCR {
+			d.pendingCR = false
+			d.LineBreaks = append(d.LineBreaks, text.CR)
+		}
+		d.closeLiteral(0)
+		d.release()
+		d.endLine()
+	}
+	d.err = err
+}
+
+func (d *LayoutDetector) scan(b byte) {
+	d.countLine(b)
+
+	if d.pendingCR {
+		d.pendingCR = false
+		if b == '\n' {
+			d.LineBreaks = append(d.LineBreaks, text.CRLF)
+			d.emit(b)
+			return
+		}
+		d.LineBreaks = append(d.LineBreaks, text.CR)
+	}
+
+	if d.quoted {
+		if !d.pendingQuote || b == '"' {
+			d.pendingQuote = !d.pendingQuote && b == '"'
+			d.emit(b)
+			d.grow()
+			return
+		}
+		if b != '\r' && b != '\n' && b != d.delimiter[0] {
+			// The field is closed by a double quote in the middle of another field.
+			d.in = append([]byte{b}, d.in...)
+			if d.recover() {
+				return
+			}
+			d.in = d.in[1:]
+		}
+		d.pendingQuote = false
+		d.quoted = false
+		d.release()
+	}
+
+	switch b {
+	case '\r':
+		d.closeLiteral(0)
+		d.pendingCR = true
+		d.endLine()
+		d.startField()
+	case '\n':
+		d.closeLiteral(0)
+		d.LineBreaks = append(d.LineBreaks, text.LF)
+		d.endLine()
+		d.startField()
+	default:
+		if d.quoting {
+			d.lineEmpty = false
+			if b == '"' && d.fieldStart {
+				d.quoted = true
+				d.fieldStart = false
+				d.fieldQuote = Quoted
+				d.anyQuoted = true
+				d.hold()
+				d.emit(b)
+				d.grow()
+				return
+			}
+			d.recent = append(d.recent, b)
+			if len(d.delimiter) < len(d.recent) {
+				d.recent = d.recent[1:]
+			}
+			d.fieldStart = bytes.Equal(d.recent, d.delimiter)
+			if d.fieldStart {
+				d.closeLiteral(len(d.delimiter) - 1)
+				d.endField()
+				d.emit(b)
+				d.rowSize++
+				d.checkSize()
+				return
+			}
+			if d.literal && b == '"' {
+				d.emit(b)
+			}
+		}
+		d.emit(b)
+		d.grow()
+		return
+	}
+	d.emit(b)
+}
+
+func (d *LayoutDetector) countLine(b byte) {
+	if b == '\r' || (b == '\n' && !d.prevCR) {
+		d.line++
+	}
+	d.prevCR = b == '\r'
+}
+
+func (d *LayoutDetector) emit(b byte) {
+	if d.held != nil {
+		d.held = append(d.held, b)
+	} else {
+		d.out.WriteByte(b)
+	}
+}
+
+func (d *LayoutDetector) hold() {
+	if d.RecoverQuotes {
+		d.held = make([]byte, 0, 1024)
+	}
+}
+
+func (d *LayoutDetector) release() {
+	if d.held != nil {
+		d.out.Write(d.held)
+		d.held = nil
+	}
+}
+
+func (d *LayoutDetector) grow() {
+	d.fieldSize++
+	d.rowSize++
+	d.checkSize()
+}
+
+func (d *LayoutDetector) checkSize() {
+	var err error
+	if d.quoting && 0 < d.MaxFieldSize && d.MaxFieldSize < d.fieldSize {
+		err = errors.New(fmt.Sprintf("line %d, field %d: size of the field exceeds the limit of %s", d.fieldLine, d.fieldIndex+1, FormatCount(d.MaxFieldSize, "byte")))
+	} else if 0 < d.MaxRowSize && d.MaxRowSize < d.rowSize {
+		err = errors.New(fmt.Sprintf("line %d: size of the row exceeds the limit of %s", d.rowLine, FormatCount(d.MaxRowSize, "byte")))
+	} else {
+		return
+	}
+
+	if d.quoted {
+		if d.recover() {
+			return
+		}
+		err = errors.New(err.Error() + ", the field may have an unclosed double quote")
+	}
+	d.err = err
+}
+
+// A quoted field spanning lines is rescanned as an unquoted field starting with a stray double quote.
+func (d *LayoutDetector) recover() bool {
+	if d.held == nil || bytes.IndexAny(d.held, "\r\n") < 0 {
+		return false
+	}
+
+	d.out.WriteString("\"\"\"")
+
+	in := make([]byte, 0, len(d.held)-1+len(d.in))
+	in = append(in, d.held[1:]...)
+	d.in = append(in, d.in...)
+
+	d.rowSize = d.rowSize - len(d.held) + 1
+	d.fieldSize = 1
+	d.held = d.held[:0]
+	d.quoted = false
+	d.literal = true
+	d.pendingQuote = false
+	d.RecoveredLines = append(d.RecoveredLines, d.fieldLine)
+	d.line = d.fieldLine
+	d.prevCR = false
+	return true
+}
+
+func (d *LayoutDetector) closeLiteral(trailing int) {
+	if !d.literal {
+		return
+	}
+
+	d.out.Write(d.held[:len(d.held)-trailing])
+	d.out.WriteByte('"')
+	d.out.Write(d.held[len(d.held)-trailing:])
+	d.held = nil
+	d.literal = false
+}
+
+func (d *LayoutDetector) startField() {
+	d.fieldStart = true
+	d.recent = d.recent[:0]
+	d.rowLine = d.line
+	d.rowSize = 0
+	d.fieldLine = d.line
+	d.fieldIndex = 0
+	d.fieldSize = 0
+}
+
+func (d *LayoutDetector) endField() {
+	d.lineQuotes = append(d.lineQuotes, d.fieldQuote)
+	d.fieldQuote = NotQuoted
+	d.fieldLine = d.line
+	d.fieldIndex++
+	d.fieldSize = 0
+}
+
+func (d *LayoutDetector) endLine() {
+	if !d.quoting {
+		return
+	}
+
+	if !d.lineEmpty {
+		d.endField()
+
+		if last := len(d.FieldQuotes) - 1; -1 < last && equalFieldQuotes(d.FieldQuotes[last], d.lineQuotes) {
+			d.FieldQuotes = append(d.FieldQuotes, d.FieldQuotes[last])
+		} else {
+			quotes := make([]FieldQuote, len(d.lineQuotes))
+			copy(quotes, d.lineQuotes)
+			d.FieldQuotes = append(d.FieldQuotes, quotes)
+		}
+	}
+
+	d.lineQuotes = d.lineQuotes[:0]
+	d.fieldQuote = NotQuoted
+	d.lineEmpty = true
+}
+
+func (d *LayoutDetector) Mixed(lines int) ([]text.LineBreak, text.LineBreak, bool) {
+	if len(d.LineBreaks) != lines && len(d.LineBreaks) != lines-1 {
+		return nil, "", false
+	}
+
+	var mostUsed text.LineBreak
+	counts := make(map[text.LineBreak]int, 3)
+	for _, lb := range d.LineBreaks {
+		counts[lb]++
+		if counts[mostUsed] < counts[lb] {
+			mostUsed = lb
+		}
+	}
+	if len(counts) < 2 {
+		return nil, "", false
+	}
+
+	breaks := d.LineBreaks
+	if lines-1 < len(breaks) {
+		breaks = breaks[:lines-1]
+	}
+	return breaks, mostUsed, true
+}
+
+func (d *LayoutDetector) Quotes(lines int) ([][]FieldQuote, bool) {
+	if !d.anyQuoted || len(d.FieldQuotes) != lines {
+		return nil, false
+	}
+	return d.FieldQuotes, true
+}
@@ -0,0 +1,261 @@
+package query
+
+import (
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/go-text"
+)
+
+var layoutDetectorTests = []struct {
+	Name       string
+	Text       string
+	Quoting    bool
+	Delimiter  rune
+	Lines      int
+	LineBreaks []text.LineBreak
+	Mixed      []text.LineBreak
+	MostUsed   text.LineBreak
+	IsMixed    bool
+	Quotes     [][]FieldQuote
+}{
+	{
+		Name:       "Single Line Break",
+		Text:       "a,b\r\n1,2\r\n3,4",
+		Quoting:    true,
+		Delimiter:  ',',
+		Lines:      3,
+		LineBreaks: []text.LineBreak{text.CRLF, text.CRLF},
+		IsMixed:    false,
+		Quotes: [][]FieldQuote{
+			{NotQuoted, NotQuoted},
+			{NotQuoted, NotQuoted},
+			{NotQuoted, NotQuoted},
+		},
+	},
+	{
+		Name:       "Mixed Line Breaks",
+		Text:       "a,b\r\n1,2\n3,4\r\n5,6\r",
+		Quoting:    true,
+		Delimiter:  ',',
+		Lines:      4,
+		LineBreaks: []text.LineBreak{text.CRLF, text.LF, text.CRLF, text.CR},
+		Mixed:      []text.LineBreak{text.CRLF, text.LF, text.CRLF},
+		MostUsed:   text.CRLF,
+		IsMixed:    true,
+		Quotes: [][]FieldQuote{
+			{NotQuoted, NotQuoted},
+			{NotQuoted, NotQuoted},
+			{NotQuoted, NotQuoted},
+			{NotQuoted, NotQuoted},
+		},
+	},
+	{
+		Name:       "Line Breaks in Quoted Fields",
+		Text:       "a,\"b\r\n\"\"c\"\"\"\n\"1\n\",2\r\n3,\"4\r5\"",
+		Quoting:    true,
+		Delimiter:  ',',
+		Lines:      3,
+		LineBreaks: []text.LineBreak{text.LF, text.CRLF},
+		Mixed:      []text.LineBreak{text.LF, text.CRLF},
+		MostUsed:   text.LF,
+		IsMixed:    true,
+		Quotes: [][]FieldQuote{
+			{NotQuoted, Quoted},
+			{Quoted, NotQuoted},
+			{NotQuoted, Quoted},
+		},
+	},
+	{
+		Name:       "Double Quotes Not at Field Start",
+		Text:       "a\tb\"\n1\t2\r\n",
+		Quoting:    true,
+		Delimiter:  '\t',
+		Lines:      2,
+		LineBreaks: []text.LineBreak{text.LF, text.CRLF},
+		Mixed:      []text.LineBreak{text.LF},
+		MostUsed:   text.LF,
+		IsMixed:    true,
+		Quotes: [][]FieldQuote{
+			{NotQuoted, NotQuoted},
+			{NotQuoted, NotQuoted},
+		},
+	},
+	{
+		Name:       "Without Quoting",
+		Text:       "a\"\n\"b\r\n",
+		Quoting:    false,
+		Lines:      2,
+		LineBreaks: []text.LineBreak{text.LF, text.CRLF},
+		Mixed:      []text.LineBreak{text.LF},
+		MostUsed:   text.LF,
+		IsMixed:    true,
+	},
+	{
+		Name:       "Number of Lines Mismatched",
+		Text:       "a,b\r\n\n1,2\r\n",
+		Quoting:    true,
+		Delimiter:  ',',
+		Lines:      2,
+		LineBreaks: []text.LineBreak{text.CRLF, text.LF, text.CRLF},
+		IsMixed:    false,
+		Quotes: [][]FieldQuote{
+			{NotQuoted, NotQuoted},
+			{NotQuoted, NotQuoted},
+		},
+	},
+}
+
+func TestLayoutDetector(t *testing.T) {
+	for _, v := range layoutDetectorTests {
+		d := NewLayoutDetector(strings.NewReader(v.Text), v.Quoting, v.Delimiter)
+		b, err := ioutil.ReadAll(d)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if string(b) != v.Text {
+			t.Errorf("%s: read text = %q, want %q", v.Name, string(b), v.Text)
+		}
+		if !reflect.DeepEqual(d.LineBreaks, v.LineBreaks) {
+			t.Errorf("%s: line breaks = %v, want %v", v.Name, d.LineBreaks, v.LineBreaks)
+		}
+
+		if !reflect.DeepEqual(d.FieldQuotes, v.Quotes) {
+			t.Errorf("%s: field quotes = %v, want %v", v.Name, d.FieldQuotes, v.Quotes)
+		}
+
+		mixed, mostUsed, ok := d.Mixed(v.Lines)
+		if ok != v.IsMixed {
+			t.Errorf("%s: mixed = %t, want %t", v.Name, ok, v.IsMixed)
+			continue
+		}
+		if !reflect.DeepEqual(mixed, v.Mixed) {
+			t.Errorf("%s: mixed line breaks = %v, want %v", v.Name, mixed, v.Mixed)
+		}
+		if mostUsed != v.MostUsed {
+			t.Errorf("%s: most used line break = %q, want %q", v.Name, mostUsed, v.MostUsed)
+		}
+	}
+}
+
+var layoutDetectorSizeLimitsTests = []struct {
+	Name          string
+	Text          string
+	Quoting       bool
+	MaxFieldSize  int
+	MaxRowSize    int
+	RecoverQuotes bool
+	Result        string
+	Recovered     []int
+	Error         string
+}{
+	{
+		Name:         "Within Size Limits",
+		Text:         "a,b\n\"1\n2\",3\n",
+		Quoting:      true,
+		MaxFieldSize: 6,
+		MaxRowSize:   8,
+		Result:       "a,b\n\"1\n2\",3\n",
+	},
+	{
+		Name:         "Field Size Exceeded",
+		Text:         "a,b\n1,2345\n",
+		Quoting:      true,
+		MaxFieldSize: 3,
+		Error:        "line 2, field 2: size of the field exceeds the limit of 3 bytes",
+	},
+	{
+		Name:         "Field Size Exceeded in Quoted Field",
+		Text:         "a,b\r\n1,\"2\r\n3,4\r\n5,6\r\n",
+		Quoting:      true,
+		MaxFieldSize: 8,
+		Error:        "line 2, field 2: size of the field exceeds the limit of 8 bytes, the field may have an unclosed double quote",
+	},
+	{
+		Name:       "Row Size Exceeded",
+		Text:       "a,b\n1,2\n345,678\n",
+		MaxRowSize: 5,
+		Error:      "line 3: size of the row exceeds the limit of 5 bytes",
+	},
+	{
+		Name:          "Recover Quotes",
+		Text:          "a,b,c\n1,\"2\"\"3,x\n4,y,z\n5,\"\"\"6\",z",
+		Quoting:       true,
+		MaxFieldSize:  10,
+		RecoverQuotes: true,
+		Result:        "a,b,c\n1,\"\"\"2\"\"\"\"3\",x\n4,y,z\n5,\"\"\"6\",z",
+		Recovered:     []int{2},
+	},
+	{
+		Name:          "Recover Quotes at Line End",
+		Text:          "a,b\r\n1,\"2\r\n3,4\r\n5,6",
+		Quoting:       true,
+		MaxRowSize:    8,
+		RecoverQuotes: true,
+		Result:        "a,b\r\n1,\"\"\"2\"\r\n3,4\r\n5,6",
+		Recovered:     []int{2},
+	},
+	{
+		Name:          "Recover Quotes Closed in Another Field",
+		Text:          "a,b,c\n1,\"2,x\n3,y,\"z\"\n4,\"5\n6\",w",
+		Quoting:       true,
+		RecoverQuotes: true,
+		Result:        "a,b,c\n1,\"\"\"2\",x\n3,y,\"z\"\n4,\"5\n6\",w",
+		Recovered:     []int{2},
+	},
+	{
+		Name:          "Recover Quotes Not Closed",
+		Text:          "a,b\r\n1,\"2\r\n3,4\r\n",
+		Quoting:       true,
+		RecoverQuotes: true,
+		Result:        "a,b\r\n1,\"\"\"2\"\r\n3,4\r\n",
+		Recovered:     []int{2},
+	},
+	{
+		Name:          "Recover Quotes Closed by Double Quote at End",
+		Text:          "a,b\n1,\"2\n3\"",
+		Quoting:       true,
+		RecoverQuotes: true,
+		Result:        "a,b\n1,\"2\n3\"",
+	},
+	{
+		Name:          "Recover Quotes Not Spanning Lines",
+		Text:          "a,b\n1,\"23456\"\n",
+		Quoting:       true,
+		MaxFieldSize:  4,
+		RecoverQuotes: true,
+		Error:         "line 2, field 2: size of the field exceeds the limit of 4 bytes, the field may have an unclosed double quote",
+	},
+}
+
+func TestLayoutDetector_SizeLimits(t *testing.T) {
+	for _, v := range layoutDetectorSizeLimitsTests {
+		d := NewLayoutDetector(strings.NewReader(v.Text), v.Quoting, ',')
+		d.MaxFieldSize = v.MaxFieldSize
+		d.MaxRowSize = v.MaxRowSize
+		d.RecoverQuotes = v.RecoverQuotes
+
+		b, err := ioutil.ReadAll(d)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if string(b) != v.Result {
+			t.Errorf("%s: read text = %q, want %q", v.Name, string(b), v.Result)
+		}
+		if !reflect.DeepEqual(d.RecoveredLines, v.Recovered) {
+			t.Errorf("%s: recovered lines = %v, want %v", v.Name, d.RecoveredLines, v.Recovered)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package query
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+)
+
+// headerLines returns the number of lines preceding the records in a file.
+func headerLines(fileInfo *FileInfo) int {
+	switch fileInfo.Format {
+	case cmd.CSV, cmd.TSV, cmd.FIXED:
+		if !fileInfo.NoHeader {
+			return 1
+		}
+	}
+	return 0
+}
+
+// DeleteLineBreaks removes the line breaks following the deleted records
+// so that the remaining lines keep their line breaks.
+func (f *FileInfo) DeleteLineBreaks(deleted map[int]bool) {
+	if f.LineBreaks == nil {
+		return
+	}
+
+	offset := headerLines(f)
+	lineBreaks := make([]text.LineBreak, 0, len(f.LineBreaks))
+	for i, lb := range f.LineBreaks {
+		if !deleted[i-offset] {
+			lineBreaks = append(lineBreaks, lb)
+		}
+	}
+	f.LineBreaks = lineBreaks
+}
+
+// lineBreakWriter replaces the line breaks written between the lines with the line breaks
+// of the original file, so that the lines of a file with mixed line breaks keep their
+// own line breaks when the file is updated.
+//
+// Writers of each format write a line break before every line except the first one.
+// After writing a line, the writer must be flushed, and NextLine must be called.
+type lineBreakWriter struct {
+	writer     *bufio.Writer
+	lineBreaks []text.LineBreak
+	lineBreak  text.LineBreak
+
+	line    int
+	skip    int
+	pending bool
+}
+
+func newLineBreakWriter(w io.Writer, lineBreaks []text.LineBreak, lineBreak text.LineBreak) *lineBreakWriter {
+	return &lineBreakWriter{
+		writer:     bufio.NewWriter(w),
+		lineBreaks: lineBreaks,
+		lineBreak:  lineBreak,
+	}
+}
+
+func (w *lineBreakWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	if 0 < w.skip {
+		if w.pending {
+			w.pending = false
+			lb := w.lineBreak
+			if w.line-1 < len(w.lineBreaks) {
+				lb = w.lineBreaks[w.line-1]
+			}
+			if _, err := w.writer.WriteString(lb.Value()); err != nil {
+				return 0, err
+			}
+		}
+
+		i := w.skip
+		if len(p) < i {
+			i = len(p)
+		}
+		w.skip -= i
+		p = p[i:]
+	}
+
+	if _, err := w.writer.Write(p); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// NextLine notices that the next bytes to be written begin with the line break before the next line.
+func (w *lineBreakWriter) NextLine() {
+	w.line++
+	w.skip = len(w.lineBreak.Value())
+	w.pending = true
+}
+
+func (w *lineBreakWriter) Flush() error {
+	return w.writer.Flush()
+}
+
+type flusher interface {
+	Flush() error
+}
+
+// endLine flushes the writer after a line is written if the line breaks are replaced.
+func endLine(w flusher, fp io.Writer) error {
+	if lw, ok := fp.(*lineBreakWriter); ok {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		lw.NextLine()
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package query
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+)
+
+var fileInfoDeleteLineBreaksTests = []struct {
+	Name     string
+	FileInfo *FileInfo
+	Deleted  map[int]bool
+	Expect   []text.LineBreak
+}{
+	{
+		Name: "Delete Line Breaks",
+		FileInfo: &FileInfo{
+			Format:     cmd.CSV,
+			LineBreaks: []text.LineBreak{text.CRLF, text.LF, text.CR, text.LF},
+		},
+		Deleted: map[int]bool{0: true, 3: true},
+		Expect:  []text.LineBreak{text.CRLF, text.CR, text.LF},
+	},
+	{
+		Name: "Delete Line Breaks Without Header",
+		FileInfo: &FileInfo{
+			Format:     cmd.CSV,
+			NoHeader:   true,
+			LineBreaks: []text.LineBreak{text.CRLF, text.LF, text.CR},
+		},
+		Deleted: map[int]bool{0: true},
+		Expect:  []text.LineBreak{text.LF, text.CR},
+	},
+	{
+		Name: "Delete Line Breaks Not Mixed",
+		FileInfo: &FileInfo{
+			Format: cmd.CSV,
+		},
+		Deleted: map[int]bool{0: true},
+		Expect:  nil,
+	},
+}
+
+func TestFileInfo_DeleteLineBreaks(t *testing.T) {
+	for _, v := range fileInfoDeleteLineBreaksTests {
+		v.FileInfo.DeleteLineBreaks(v.Deleted)
+		if !reflect.DeepEqual(v.FileInfo.LineBreaks, v.Expect) {
+			t.Errorf("%s: line breaks = %v, want %v", v.Name, v.FileInfo.LineBreaks, v.Expect)
+		}
+	}
+}
+
+var encodeViewWithLineBreaksTests = []struct {
+	Name         string
+	Format       cmd.Format
+	NoHeader     bool
+	LineBreaks   []text.LineBreak
+	Normalize    bool
+	DelimiterPos []int
+	Result       string
+}{
+	{
+		Name:       "CSV",
+		Format:     cmd.CSV,
+		LineBreaks: []text.LineBreak{text.CRLF, text.LF},
+		Result:     "c1,c2\r\n1,a\n2,c\r\n3,d",
+	},
+	{
+		Name:       "CSV Without Header",
+		Format:     cmd.CSV,
+		NoHeader:   true,
+		LineBreaks: []text.LineBreak{text.LF},
+		Result:     "1,a\n2,c\r\n3,d",
+	},
+	{
+		Name:       "CSV Normalized",
+		Format:     cmd.CSV,
+		LineBreaks: []text.LineBreak{text.CRLF, text.LF},
+		Normalize:  true,
+		Result:     "c1,c2\r\n1,a\r\n2,c\r\n3,d",
+	},
+	{
+		Name:         "Fixed-Length Format",
+		Format:       cmd.FIXED,
+		DelimiterPos: []int{2, 6},
+		LineBreaks:   []text.LineBreak{text.LF, text.CR, text.CRLF},
+		Result:       "c1c2  \n 1a   \r 2c   \r\n 3d   ",
+	},
+	{
+		Name:       "LTSV",
+		Format:     cmd.LTSV,
+		LineBreaks: []text.LineBreak{text.LF},
+		Result:     "c1:1\tc2:a\nc1:2\tc2:c\r\nc1:3\tc2:d",
+	},
+}
+
+func TestEncodeView_LineBreaks(t *testing.T) {
+	defer func() {
+		cmd.GetFlags().SetNormalizeLineBreak(false)
+	}()
+
+	view := &View{
+		Header: NewHeader("test", []string{"c1", "c2"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+			NewRecord([]value.Primary{value.NewInteger(2), value.NewString("c")}),
+			NewRecord([]value.Primary{value.NewInteger(3), value.NewString("d")}),
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	for _, v := range encodeViewWithLineBreaksTests {
+		cmd.GetFlags().SetNormalizeLineBreak(v.Normalize)
+
+		fileInfo := &FileInfo{
+			Format:             v.Format,
+			Delimiter:          ',',
+			DelimiterPositions: v.DelimiterPos,
+			Encoding:           text.UTF8,
+			LineBreak:          text.CRLF,
+			LineBreaks:         v.LineBreaks,
+			NoHeader:           v.NoHeader,
+		}
+
+		buf.Reset()
+		if err := EncodeView(buf, view, fileInfo); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if buf.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, buf.String(), v.Result)
+		}
+	}
+}
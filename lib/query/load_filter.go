@@ -0,0 +1,138 @@
+package query
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+type loadFilter struct {
+	TableName string
+	Condition parser.QueryExpression
+
+	columns    []string
+	separators *value.NumberSeparators
+
+	parentFilter *Filter
+	filter       *Filter
+	acceptAll    bool
+	err          error
+
+	isApplied bool
+}
+
+func newLoadFilter(entity parser.SelectEntity, filter *Filter) (*loadFilter, []parser.QueryExpression, bool) {
+	if !cmd.GetFlags().FilterOnLoad || entity.WhereClause == nil {
+		return nil, nil, false
+	}
+
+	table, ok := singleTable(parser.SelectQuery{SelectEntity: entity})
+	if !ok {
+		return nil, nil, false
+	}
+	if _, ok := table.Object.(parser.Identifier); !ok {
+		return nil, nil, false
+	}
+
+	// Conditions after one that cannot be evaluated while reading keep their order.
+	conjuncts := splitConjunction(entity.WhereClause.(parser.WhereClause).Filter)
+	n := 0
+	for n < len(conjuncts) && isLoadFilterCondition(conjuncts[n]) {
+		n++
+	}
+	if n < 1 {
+		return nil, nil, false
+	}
+	conditions := conjuncts[:n]
+	others := conjuncts[n:]
+
+	return &loadFilter{
+		TableName:    table.Name().Literal,
+		Condition:    reorderPredicates(joinConjunction(conditions)),
+		parentFilter: filter,
+	}, others, true
+}
+
+func isLoadFilterCondition(expr parser.QueryExpression) bool {
+	return walkExpression(expr, func(e parser.QueryExpression) bool {
+		switch e.(type) {
+		case parser.AggregateFunction, parser.ListFunction:
+			return false
+		}
+		return isDecorrelatableNode(e, nil)
+	})
+}
+
+func (f *Filter) loadFilterFor(tableName parser.Identifier, fileInfo *FileInfo, useInternalId bool, forUpdate bool) *loadFilter {
+	lf := f.loadFilter
+	if lf == nil || useInternalId || forUpdate || !strings.EqualFold(lf.TableName, tableName.Literal) {
+		return nil
+	}
+	if fileInfo.Format != cmd.CSV && fileInfo.Format != cmd.TSV {
+		return nil
+	}
+	return lf
+}
+
+func (lf *loadFilter) init(header []string, fieldLen int) {
+	if header == nil {
+		header = make([]string, fieldLen)
+		for i := range header {
+			header[i] = "c" + strconv.Itoa(i+1)
+		}
+	}
+	if lf.columns != nil {
+		if len(lf.columns) != len(header) {
+			lf.acceptAll = true
+			return
+		}
+		header = lf.columns
+	}
+
+	lf.filter = NewFilterForRecord(
+		&View{
+			Header:    NewHeader(lf.TableName, header),
+			RecordSet: make(RecordSet, 1),
+		},
+		0,
+		lf.parentFilter,
+	)
+}
+
+func (lf *loadFilter) accept(header []string, fields []value.Primary) bool {
+	if lf.err != nil {
+		return false
+	}
+	if lf.filter == nil && !lf.acceptAll {
+		lf.init(header, len(fields))
+	}
+	if lf.acceptAll {
+		return true
+	}
+
+	record := NewRecord(fields)
+	if lf.separators != nil {
+		convertLocalizedNumbersInRecord(record, lf.separators)
+	}
+	lf.filter.Records[0].View.RecordSet[0] = record
+
+	p, err := lf.filter.Evaluate(lf.Condition)
+	if err != nil {
+		lf.err = err
+		return false
+	}
+	return p.Ternary() == ternary.TRUE
+}
+
+func remainingWhereClause(clause parser.WhereClause, others []parser.QueryExpression) parser.QueryExpression {
+	if len(others) < 1 {
+		return nil
+	}
+	clause.Filter = joinConjunction(others)
+	return clause
+}
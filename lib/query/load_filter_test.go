@@ -0,0 +1,164 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var newLoadFilterTests = []struct {
+	Query     string
+	Condition string
+	Others    string
+	Expect    bool
+}{
+	{
+		Query:     "SELECT * FROM table1 WHERE column2 LIKE 'str%' AND column1 > 1",
+		Condition: "column1 > 1 AND column2 LIKE 'str%'",
+		Expect:    true,
+	},
+	{
+		Query:     "SELECT * FROM table1 AS t WHERE t.column1 > 1 AND EXISTS (SELECT 1) AND column2 = 'str2'",
+		Condition: "t.column1 > 1",
+		Others:    "EXISTS (SELECT 1) AND column2 = 'str2'",
+		Expect:    true,
+	},
+	{
+		Query:  "SELECT * FROM table1 WHERE @var := 1 AND column1 > 1",
+		Expect: false,
+	},
+	{
+		Query:  "SELECT * FROM table1",
+		Expect: false,
+	},
+	{
+		Query:  "SELECT * FROM table1, table2 WHERE column1 > 1",
+		Expect: false,
+	},
+	{
+		Query:  "SELECT * FROM (SELECT * FROM table1) AS t WHERE column1 > 1",
+		Expect: false,
+	},
+}
+
+func TestNewLoadFilter(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.FilterOnLoad = true
+	defer func() {
+		tf.FilterOnLoad = false
+	}()
+
+	for _, v := range newLoadFilterTests {
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Query, err)
+		}
+		entity := program[0].(parser.SelectQuery).SelectEntity.(parser.SelectEntity)
+
+		lf, others, ok := newLoadFilter(entity, NewEmptyFilter())
+		if ok != v.Expect {
+			t.Errorf("%s: result = %t, want %t", v.Query, ok, v.Expect)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if lf.Condition.String() != v.Condition {
+			t.Errorf("%s: condition = %q, want %q", v.Query, lf.Condition.String(), v.Condition)
+		}
+		remaining := ""
+		if clause := remainingWhereClause(entity.WhereClause.(parser.WhereClause), others); clause != nil {
+			remaining = clause.(parser.WhereClause).Filter.String()
+		}
+		if remaining != v.Others {
+			t.Errorf("%s: others = %q, want %q", v.Query, remaining, v.Others)
+		}
+	}
+
+	tf.FilterOnLoad = false
+	program, _ := parser.Parse("SELECT * FROM table1 WHERE column1 > 1", "")
+	if _, _, ok := newLoadFilter(program[0].(parser.SelectQuery).SelectEntity.(parser.SelectEntity), NewEmptyFilter()); ok {
+		t.Errorf("result = %t, want %t when the flag is not set", ok, false)
+	}
+}
+
+var selectFilterOnLoadTests = []struct {
+	Query    string
+	NoHeader bool
+	Result   []string
+	Error    string
+}{
+	{
+		Query:  "SELECT column2 FROM table1 WHERE column1 <> 2",
+		Result: []string{"str1", "str3"},
+	},
+	{
+		Query:  "SELECT t.column2 FROM table1 AS t WHERE t.column1 > 1 AND t.column1 IN (SELECT column3 FROM table2)",
+		Result: []string{"str2", "str3"},
+	},
+	{
+		Query:    "SELECT c2 FROM table_noheader WHERE c1 = 2",
+		NoHeader: true,
+		Result:   []string{"str2"},
+	},
+	{
+		Query:  "SELECT column2 FROM table1 WHERE column1 > 3",
+		Result: []string{},
+	},
+	{
+		Query: "SELECT column2 FROM table1 WHERE notexist = 1",
+		Error: "[L:1 C:34] field notexist does not exist",
+	},
+}
+
+func TestSelect_FilterOnLoad(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.FilterOnLoad = true
+	defer func() {
+		tf.FilterOnLoad = false
+		tf.NoHeader = false
+	}()
+
+	_ = ReleaseResourcesWithErrors()
+
+	for _, v := range selectFilterOnLoadTests {
+		tf.NoHeader = v.NoHeader
+
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Query, err)
+		}
+
+		view, err := Select(program[0].(parser.SelectQuery), NewEmptyFilter())
+
+		if ViewCache.Exists(GetTestFilePath("table1.csv")) || ViewCache.Exists(GetTestFilePath("table_noheader.csv")) {
+			t.Errorf("%s: filtered records are cached", v.Query)
+		}
+		_ = ReleaseResourcesWithErrors()
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Query, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Query, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Query, v.Error)
+			continue
+		}
+
+		result := make([]string, view.RecordLen())
+		for i, record := range view.RecordSet {
+			result[i] = record[0].Value().(value.String).Raw()
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Query, result, v.Result)
+		}
+	}
+}
@@ -84,6 +84,7 @@ func setup() {
 	copyfile(filepath.Join(TestDir, "rename_column.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	copyfile(filepath.Join(TestDir, "updated_file_1.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	copyfile(filepath.Join(TestDir, "dup_name.csv"), filepath.Join(TestDataDir, "dup_name.csv"))
+	copyfile(filepath.Join(TestDir, "table_eu.csv"), filepath.Join(TestDataDir, "table_eu.csv"))
 
 	copyfile(filepath.Join(TestDir, "table3.tsv"), filepath.Join(TestDataDir, "table3.tsv"))
 	copyfile(filepath.Join(TestDir, "dup_name.tsv"), filepath.Join(TestDataDir, "dup_name.tsv"))
@@ -97,6 +98,7 @@ func setup() {
 	copyfile(filepath.Join(TestDir, "fixed_length.txt"), filepath.Join(TestDataDir, "fixed_length.txt"))
 
 	copyfile(filepath.Join(TestDir, "autoselect"), filepath.Join(TestDataDir, "autoselect"))
+	copyfile(filepath.Join(TestDir, "catalog.json"), filepath.Join(TestDataDir, "catalog.json"))
 
 	copyfile(filepath.Join(TestDir, "source.sql"), filepath.Join(filepath.Join(GetWD(), "..", "..", "testdata"), "source.sql"))
 	copyfile(filepath.Join(TestDir, "source_syntaxerror.sql"), filepath.Join(filepath.Join(GetWD(), "..", "..", "testdata"), "source_syntaxerror.sql"))
@@ -138,29 +140,50 @@ func initFlag(flags *cmd.Flags) {
 	}
 
 	flags.Repository = "."
-	flags.Location = TestLocation
+	flags.SetCatalog("")
+	flags.SetCacheDir("")
+	flags.SetSourcePath("")
+	flags.SourceRelative = false
+	_ = flags.SetLocation(TestLocation)
 	flags.DatetimeFormat = []string{}
+	flags.DecimalSeparator = "."
+	flags.ThousandsSeparator = ""
+	flags.Collation = "NOCASE"
+	flags.Language = cmd.EnglishLanguage
 	flags.WaitTimeout = 15
+	flags.SetMergeTool("")
+	flags.SetConflictDir("")
+	flags.GitCheck = cmd.GitCheckNone
 	flags.Delimiter = ','
 	flags.JsonQuery = ""
 	flags.Encoding = text.UTF8
 	flags.NoHeader = false
 	flags.WithoutNull = false
+	flags.MaxFieldSize = 0
+	flags.MaxRowSize = 0
+	flags.RecoverQuotes = false
+	flags.EncodingErrors = cmd.EncodingErrorsNotSet
 	flags.Format = cmd.TEXT
 	flags.WriteEncoding = text.UTF8
 	flags.WriteDelimiter = ','
 	flags.WithoutHeader = false
 	flags.LineBreak = text.LF
+	flags.NormalizeLineBreak = false
 	flags.EncloseAll = false
+	flags.QuoteStyle = cmd.QuoteStylePreserve
 	flags.JsonEscape = json.Backslash
 	flags.PrettyPrint = false
 	flags.EastAsianEncoding = false
 	flags.CountDiacriticalSign = false
 	flags.CountFormatCode = false
 	flags.Color = false
+	flags.Plain = false
 	flags.Quiet = false
 	flags.CPU = cpu
+	flags.SortMemory = 0
 	flags.Stats = false
+	flags.Stream = false
+	flags.FilterOnLoad = false
 	flags.DelimitAutomatically = false
 	flags.DelimiterPositions = nil
 	flags.WriteDelimiterPositions = nil
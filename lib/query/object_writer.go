@@ -29,6 +29,10 @@ type ObjectWriter struct {
 	Title2       string
 	Title2Effect string
 
+	// Plain disables the alignment padding, the line wrapping and the ruled title
+	// so that the output can be read by screen readers.
+	Plain bool
+
 	buf bytes.Buffer
 
 	subBlock  int
@@ -49,13 +53,20 @@ func NewObjectWriter() *ObjectWriter {
 	}
 
 	palette, _ := cmd.GetPalette()
+	plain := cmd.GetFlags().Plain
+
+	padding := DefaultPadding
+	if plain {
+		padding = 0
+	}
 
 	return &ObjectWriter{
 		MaxWidth:    maxWidth,
 		Indent:      0,
 		IndentWidth: 4,
-		Padding:     DefaultPadding,
+		Padding:     padding,
 		Palette:     palette,
+		Plain:       plain,
 		lineWidth:   0,
 		column:      0,
 		subBlock:    0,
@@ -108,10 +119,16 @@ func (w *ObjectWriter) writeToBuf(s string) {
 }
 
 func (w *ObjectWriter) leadingSpacesWidth() int {
+	if w.Plain {
+		return 0
+	}
 	return w.Padding + (w.Indent * w.IndentWidth)
 }
 
 func (w *ObjectWriter) FitInLine(s string) bool {
+	if w.Plain {
+		return true
+	}
 	if w.MaxWidth-w.Padding < w.column+cmd.TextWidth(s) {
 		return false
 	}
@@ -177,6 +194,12 @@ func (w *ObjectWriter) WriteWithAutoLineBreak(s string) {
 }
 
 func (w *ObjectWriter) WriteSpaces(l int) {
+	if w.Plain {
+		if 0 < l && 0 < w.column {
+			w.WriteWithoutLineBreak("\t")
+		}
+		return
+	}
 	w.Write(strings.Repeat(" ", l))
 }
 
@@ -197,6 +220,9 @@ func (w *ObjectWriter) EndBlock() {
 }
 
 func (w *ObjectWriter) BeginSubBlock() {
+	if w.Plain {
+		return
+	}
 	w.subBlock = w.column - w.leadingSpacesWidth()
 }
 
@@ -210,6 +236,14 @@ func (w *ObjectWriter) ClearBlock() {
 
 func (w *ObjectWriter) String() string {
 	var header bytes.Buffer
+	if w.Plain {
+		if 0 < len(w.Title1) || 0 < len(w.Title2) {
+			header.WriteString(strings.TrimSpace(w.Title1 + " " + w.Title2))
+			header.WriteRune('\n')
+		}
+		return header.String() + w.buf.String()
+	}
+
 	if 0 < len(w.Title1) || 0 < len(w.Title2) {
 		tw := cmd.TextWidth(w.Title1) + cmd.TextWidth(w.Title2)
 		if 0 < len(w.Title1) && 0 < len(w.Title2) {
@@ -154,4 +154,36 @@ func TestObjectWriter_String(t *testing.T) {
 	}
 
 	cmd.GetFlags().SetColor(false)
+
+	cmd.GetFlags().SetColor(true)
+	cmd.GetFlags().SetPlain(true)
+	w = NewObjectWriter()
+	w.MaxWidth = 20
+
+	w.Title1 = "title1"
+	w.Title2 = "title2"
+	w.Title2Effect = cmd.IdentifierEffect
+
+	w.WriteSpaces(4)
+	w.WriteColorWithoutLineBreak("key:", cmd.LableEffect)
+	w.WriteSpaces(1)
+	w.Write("aaa")
+	w.BeginBlock()
+	w.NewLine()
+	w.Write("bbbbbbbbbb")
+	w.Write(", ")
+	w.Write("bbbbbbbbbb")
+
+	expect = "" +
+		"title1 title2\n" +
+		"key:\taaa\n" +
+		"bbbbbbbbbb, bbbbbbbbbb"
+	result = w.String()
+
+	if result != expect {
+		t.Errorf("result = %q, want %q", result, expect)
+	}
+
+	cmd.GetFlags().SetPlain(false)
+	cmd.GetFlags().SetColor(false)
 }
@@ -0,0 +1,224 @@
+package query
+
+import (
+	"bytes"
+	"strings"
+)
+
+var soundexCodes = map[byte]byte{
+	'B': '1', 'F': '1', 'P': '1', 'V': '1',
+	'C': '2', 'G': '2', 'J': '2', 'K': '2', 'Q': '2', 'S': '2', 'X': '2', 'Z': '2',
+	'D': '3', 'T': '3',
+	'L': '4',
+	'M': '5', 'N': '5',
+	'R': '6',
+}
+
+func phoneticLetters(s string) []byte {
+	s = strings.ToUpper(s)
+	letters := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if 'A' <= s[i] && s[i] <= 'Z' {
+			letters = append(letters, s[i])
+		}
+	}
+	return letters
+}
+
+// soundex returns the American Soundex code of s.
+// Characters other than ASCII letters are ignored.
+func soundex(s string) string {
+	letters := phoneticLetters(s)
+	if len(letters) < 1 {
+		return ""
+	}
+
+	code := []byte{letters[0], '0', '0', '0'}
+	n := 1
+	last := soundexCodes[letters[0]]
+	for _, c := range letters[1:] {
+		if n == len(code) {
+			break
+		}
+
+		switch c {
+		case 'H', 'W':
+			continue
+		}
+
+		d, ok := soundexCodes[c]
+		if !ok {
+			last = 0
+			continue
+		}
+		if d != last {
+			code[n] = d
+			n++
+		}
+		last = d
+	}
+	return string(code)
+}
+
+func isMetaphoneVowel(c byte) bool {
+	switch c {
+	case 'A', 'E', 'I', 'O', 'U':
+		return true
+	}
+	return false
+}
+
+func isMetaphoneFrontVowel(c byte) bool {
+	switch c {
+	case 'E', 'I', 'Y':
+		return true
+	}
+	return false
+}
+
+// metaphone returns the code of s by the original Metaphone algorithm of Lawrence Philips.
+// Characters other than ASCII letters are ignored, and "0" in the result represents "TH".
+func metaphone(s string) string {
+	w := phoneticLetters(s)
+	if len(w) < 1 {
+		return ""
+	}
+
+	buf := new(bytes.Buffer)
+
+	var second byte
+	if 1 < len(w) {
+		second = w[1]
+	}
+	switch {
+	case w[0] == 'A' && second == 'E':
+		buf.WriteByte('E')
+		w = w[2:]
+	case (w[0] == 'G' || w[0] == 'K' || w[0] == 'P') && second == 'N', w[0] == 'W' && second == 'R':
+		buf.WriteByte(second)
+		w = w[2:]
+	case w[0] == 'X':
+		buf.WriteByte('S')
+		w = w[1:]
+	case w[0] == 'W' && second == 'H':
+		buf.WriteByte('W')
+		w = w[2:]
+	}
+	first := buf.Len() < 1
+
+	at := func(i int) byte {
+		if i < 0 || len(w) <= i {
+			return 0
+		}
+		return w[i]
+	}
+
+	for i := 0; i < len(w); i++ {
+		c := w[i]
+		prev := at(i - 1)
+		next := at(i + 1)
+		last := i == len(w)-1
+
+		if c == prev && c != 'C' {
+			continue
+		}
+
+		switch c {
+		case 'A', 'E', 'I', 'O', 'U':
+			if i == 0 && first {
+				buf.WriteByte(c)
+			}
+		case 'B':
+			if !(last && prev == 'M') {
+				buf.WriteByte('B')
+			}
+		case 'C':
+			switch {
+			case prev == 'S' && isMetaphoneFrontVowel(next):
+			case next == 'I' && at(i+2) == 'A':
+				buf.WriteByte('X')
+			case isMetaphoneFrontVowel(next):
+				buf.WriteByte('S')
+			case next == 'H':
+				if prev == 'S' {
+					buf.WriteByte('K')
+				} else {
+					buf.WriteByte('X')
+				}
+				i++
+			default:
+				buf.WriteByte('K')
+			}
+		case 'D':
+			if next == 'G' && isMetaphoneFrontVowel(at(i+2)) {
+				buf.WriteByte('J')
+				i += 2
+			} else {
+				buf.WriteByte('T')
+			}
+		case 'G':
+			switch {
+			case next == 'H' && !(i+2 == len(w) || isMetaphoneVowel(at(i+2))):
+			case next == 'N' && (i+2 == len(w) || (i+4 == len(w) && at(i+2) == 'E' && at(i+3) == 'D')):
+			case isMetaphoneFrontVowel(next) && prev != 'G':
+				buf.WriteByte('J')
+			default:
+				buf.WriteByte('K')
+			}
+		case 'H':
+			switch {
+			case last:
+			case prev == 'C' || prev == 'G' || prev == 'P' || prev == 'S' || prev == 'T':
+			case isMetaphoneVowel(next):
+				buf.WriteByte('H')
+			}
+		case 'K':
+			if prev != 'C' {
+				buf.WriteByte('K')
+			}
+		case 'P':
+			if next == 'H' {
+				buf.WriteByte('F')
+			} else {
+				buf.WriteByte('P')
+			}
+		case 'Q':
+			buf.WriteByte('K')
+		case 'S':
+			switch {
+			case next == 'H':
+				buf.WriteByte('X')
+				i++
+			case next == 'I' && (at(i+2) == 'O' || at(i+2) == 'A'):
+				buf.WriteByte('X')
+			default:
+				buf.WriteByte('S')
+			}
+		case 'T':
+			switch {
+			case next == 'I' && (at(i+2) == 'O' || at(i+2) == 'A'):
+				buf.WriteByte('X')
+			case next == 'H':
+				buf.WriteByte('0')
+				i++
+			case next == 'C' && at(i+2) == 'H':
+			default:
+				buf.WriteByte('T')
+			}
+		case 'V':
+			buf.WriteByte('F')
+		case 'W', 'Y':
+			if isMetaphoneVowel(next) {
+				buf.WriteByte(c)
+			}
+		case 'X':
+			buf.WriteString("KS")
+		case 'Z':
+			buf.WriteByte('S')
+		default:
+			buf.WriteByte(c)
+		}
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,118 @@
+package query
+
+import (
+	"sort"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+const (
+	unmovablePredicate = -1
+
+	nodeCost      = 1
+	likeCost      = 20
+	rangeCompCost = 1
+	equalCompCost = 0
+	listValueCost = 1
+)
+
+// reorderPredicates rearranges the operands of AND operations so that cheaper comparisons are evaluated first.
+// Only operands that cannot raise errors or have side effects are moved, and they are not moved across the others.
+func reorderPredicates(condition parser.QueryExpression) parser.QueryExpression {
+	switch condition.(type) {
+	case parser.Parentheses:
+		e := condition.(parser.Parentheses)
+		e.Expr = reorderPredicates(e.Expr)
+		return e
+	case parser.UnaryLogic:
+		e := condition.(parser.UnaryLogic)
+		e.Operand = reorderPredicates(e.Operand)
+		return e
+	case parser.Logic:
+		e := condition.(parser.Logic)
+		if e.Operator.Token != parser.AND {
+			e.LHS = reorderPredicates(e.LHS)
+			e.RHS = reorderPredicates(e.RHS)
+			return e
+		}
+	default:
+		return condition
+	}
+
+	conjuncts := splitConjunction(condition)
+	costs := make([]int, len(conjuncts))
+	for i := range conjuncts {
+		conjuncts[i] = reorderPredicates(conjuncts[i])
+		costs[i] = predicateCost(conjuncts[i])
+
+		if _, ok := conjuncts[i].(parser.Logic); ok {
+			conjuncts[i] = parser.Parentheses{Expr: conjuncts[i]}
+		}
+	}
+
+	start := 0
+	for i := 0; i <= len(conjuncts); i++ {
+		if i < len(conjuncts) && costs[i] != unmovablePredicate {
+			continue
+		}
+		sortPredicates(conjuncts[start:i], costs[start:i])
+		start = i + 1
+	}
+	return joinConjunction(conjuncts)
+}
+
+func sortPredicates(conjuncts []parser.QueryExpression, costs []int) {
+	sort.Stable(predicateList{conjuncts: conjuncts, costs: costs})
+}
+
+type predicateList struct {
+	conjuncts []parser.QueryExpression
+	costs     []int
+}
+
+func (l predicateList) Len() int {
+	return len(l.conjuncts)
+}
+
+func (l predicateList) Less(i, j int) bool {
+	return l.costs[i] < l.costs[j]
+}
+
+func (l predicateList) Swap(i, j int) {
+	l.conjuncts[i], l.conjuncts[j] = l.conjuncts[j], l.conjuncts[i]
+	l.costs[i], l.costs[j] = l.costs[j], l.costs[i]
+}
+
+// predicateCost returns unmovablePredicate if the expression may raise an error or have side effects.
+func predicateCost(expr parser.QueryExpression) int {
+	if isConstant(expr) {
+		return unmovablePredicate
+	}
+
+	cost := 0
+	ok := walkExpression(expr, func(e parser.QueryExpression) bool {
+		switch e.(type) {
+		case parser.PrimitiveType, parser.FieldReference, parser.ColumnNumber, parser.Parentheses,
+			parser.Logic, parser.UnaryLogic, parser.Is, parser.Between, parser.In:
+			cost += nodeCost
+		case parser.Comparison:
+			switch e.(parser.Comparison).Operator {
+			case "=", "==":
+				cost += equalCompCost
+			default:
+				cost += rangeCompCost
+			}
+		case parser.Like:
+			cost += likeCost
+		case parser.ValueList:
+			cost += listValueCost * len(e.(parser.ValueList).Values)
+		default:
+			return false
+		}
+		return true
+	})
+	if !ok {
+		return unmovablePredicate
+	}
+	return cost
+}
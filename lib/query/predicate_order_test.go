@@ -0,0 +1,84 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var reorderPredicatesTests = []struct {
+	Name      string
+	Condition string
+	Result    string
+}{
+	{
+		Name:      "Cheap Comparisons First",
+		Condition: "column2 LIKE '%a%' AND column1 > 1 AND column1 = 2",
+		Result:    "column1 = 2 AND column1 > 1 AND column2 LIKE '%a%'",
+	},
+	{
+		Name:      "Not Move Across Function",
+		Condition: "(column2 LIKE '{%' OR column2 LIKE '[%') AND JSON_VALUE('a', column2) = 1 AND column1 = 1",
+		Result:    "(column2 LIKE '{%' OR column2 LIKE '[%') AND JSON_VALUE('a', column2) = 1 AND column1 = 1",
+	},
+	{
+		Name:      "Not Move Function",
+		Condition: "UPPER(column2) = 'A' AND column1 < 3",
+		Result:    "UPPER(column2) = 'A' AND column1 < 3",
+	},
+	{
+		Name:      "Keep Order of Same Costs",
+		Condition: "column2 = 'a' AND column1 = 1",
+		Result:    "column2 = 'a' AND column1 = 1",
+	},
+	{
+		Name:      "Flatten Nested Conjunctions",
+		Condition: "column2 LIKE 'a%' AND (column1 > 1 AND column1 = 1)",
+		Result:    "column1 = 1 AND column1 > 1 AND column2 LIKE 'a%'",
+	},
+	{
+		Name:      "Keep Parentheses of Disjunction",
+		Condition: "(column2 LIKE 'a%' OR column1 = 1) AND column1 = 2",
+		Result:    "column1 = 2 AND (column2 LIKE 'a%' OR column1 = 1)",
+	},
+	{
+		Name:      "Reorder in Disjunction",
+		Condition: "column2 LIKE 'a%' AND column1 = 1 OR column1 = 2",
+		Result:    "column1 = 1 AND column2 LIKE 'a%' OR column1 = 2",
+	},
+	{
+		Name:      "Not Move Across Variable",
+		Condition: "column2 LIKE 'a%' AND @var := 1 AND column1 = 1",
+		Result:    "column2 LIKE 'a%' AND @var := 1 AND column1 = 1",
+	},
+	{
+		Name:      "Not Move Across Subquery",
+		Condition: "column2 LIKE 'a%' AND column1 > 1 AND EXISTS (SELECT 1) AND UPPER(column2) = 'A' AND column1 = 1",
+		Result:    "column1 > 1 AND column2 LIKE 'a%' AND EXISTS (SELECT 1) AND UPPER(column2) = 'A' AND column1 = 1",
+	},
+	{
+		Name:      "Not Move User Defined Function",
+		Condition: "userfunc(column1) AND column1 = 1",
+		Result:    "userfunc(column1) AND column1 = 1",
+	},
+	{
+		Name:      "Not Move Constant",
+		Condition: "column2 LIKE 'a%' AND FALSE AND column1 = 1",
+		Result:    "column2 LIKE 'a%' AND FALSE AND column1 = 1",
+	},
+}
+
+func TestReorderPredicates(t *testing.T) {
+	for _, v := range reorderPredicatesTests {
+		program, err := parser.Parse("SELECT * FROM table1 WHERE "+v.Condition, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+		where := program[0].(parser.SelectQuery).SelectEntity.(parser.SelectEntity).WhereClause.(parser.WhereClause)
+
+		result := reorderPredicates(where.Filter)
+		if result.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, result.String(), v.Result)
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// PreparedStatement is a statement that is parsed once by PREPARE,
+// and executed by EXECUTE with values bound to its placeholders.
+type PreparedStatement struct {
+	Name            string
+	StatementString string
+	Statements      []parser.Statement
+	HolderNumber    int
+}
+
+func NewPreparedStatement(expr parser.Prepare, filter *Filter) (*PreparedStatement, error) {
+	var input string
+	p, err := filter.Evaluate(expr.Statement)
+	if err != nil {
+		return nil, err
+	}
+	p = value.ToString(p)
+	if !value.IsNull(p) {
+		input = p.(value.String).Raw()
+	}
+
+	statements, holderNumber, err := parser.ParsePreparedStatement(input, fmt.Sprintf("(L:%d C:%d) PREPARE %s", expr.Line(), expr.Char(), expr.Name.Literal))
+	if err != nil {
+		return nil, NewSyntaxError(err.(*parser.SyntaxError))
+	}
+
+	return &PreparedStatement{
+		Name:            expr.Name.Literal,
+		StatementString: input,
+		Statements:      statements,
+		HolderNumber:    holderNumber,
+	}, nil
+}
+
+type PreparedStatementMap map[string]*PreparedStatement
+
+func NewPreparedStatementMap() PreparedStatementMap {
+	return make(PreparedStatementMap, 4)
+}
+
+func (m PreparedStatementMap) Prepare(expr parser.Prepare, filter *Filter) error {
+	uname := strings.ToUpper(expr.Name.Literal)
+	if _, ok := m[uname]; ok {
+		return NewStatementRedeclaredError(expr.Name)
+	}
+
+	stmt, err := NewPreparedStatement(expr, filter)
+	if err != nil {
+		return err
+	}
+	m[uname] = stmt
+	return nil
+}
+
+func (m PreparedStatementMap) Get(name parser.Identifier) (*PreparedStatement, error) {
+	if stmt, ok := m[strings.ToUpper(name.Literal)]; ok {
+		return stmt, nil
+	}
+	return nil, NewUndeclaredStatementError(name)
+}
+
+func (m PreparedStatementMap) Dispose(name parser.Identifier) error {
+	uname := strings.ToUpper(name.Literal)
+	if _, ok := m[uname]; !ok {
+		return NewUndeclaredStatementError(name)
+	}
+	delete(m, uname)
+	return nil
+}
+
+// preparedStatementName returns the name if the statement of EXECUTE is specified
+// by an identifier, that refers to a prepared statement.
+func preparedStatementName(expr parser.QueryExpression) (parser.Identifier, bool) {
+	if fr, ok := expr.(parser.FieldReference); ok && len(fr.View.Literal) < 1 {
+		return fr.Column, true
+	}
+	return parser.Identifier{}, false
+}
+
+// ExecutePrepared executes the prepared statement with the values bound to the placeholders.
+func (proc *Procedure) ExecutePrepared(name parser.Identifier, expr parser.Execute) (StatementFlow, error) {
+	stmt, err := PreparedStatements.Get(name)
+	if err != nil {
+		return Error, err
+	}
+
+	rv, err := NewReplaceValues(stmt, expr, proc.Filter)
+	if err != nil {
+		return Error, err
+	}
+
+	outer := proc.Filter.ReplaceValues
+	proc.Filter.ReplaceValues = rv
+	defer func() {
+		proc.Filter.ReplaceValues = outer
+	}()
+
+	return proc.Execute(stmt.Statements)
+}
+
+// ExecuteImmediate parses the statements after replacing the identifier placeholders with the quoted identifiers,
+// and executes them with the values bound to the placeholders.
+func (proc *Procedure) ExecuteImmediate(expr parser.Execute) (StatementFlow, error) {
+	var input string
+	p, err := proc.Filter.Evaluate(expr.Statements)
+	if err != nil {
+		return Error, err
+	}
+	p = value.ToString(p)
+	if !value.IsNull(p) {
+		input = p.(value.String).Raw()
+	}
+
+	rv, positionalLen, err := evalReplaceValues(expr, proc.Filter)
+	if err != nil {
+		return Error, err
+	}
+
+	statements, holderNumber, err := parser.ParseImmediateStatement(input, fmt.Sprintf("(L:%d C:%d) EXECUTE IMMEDIATE", expr.Line(), expr.Char()), rv.identifier)
+	if err != nil {
+		return Error, NewSyntaxError(err.(*parser.SyntaxError))
+	}
+	if positionalLen != holderNumber {
+		return Error, NewImmediateReplaceValueLengthError(expr, holderNumber, positionalLen)
+	}
+
+	outer := proc.Filter.ReplaceValues
+	proc.Filter.ReplaceValues = rv
+	defer func() {
+		proc.Filter.ReplaceValues = outer
+	}()
+
+	return proc.Execute(statements)
+}
+
+// ReplaceValues holds the values bound to the placeholders of a prepared statement.
+// Positional placeholders "?" are replaced in order, and named placeholders such as ":id"
+// are replaced with the values specified with the same names.
+//
+// Named values that are not specified by EXECUTE are taken over from the enclosing
+// statement, and from the parameters passed with the --param option.
+type ReplaceValues struct {
+	Values []value.Primary
+	Names  map[string]int
+}
+
+func NewReplaceValues(stmt *PreparedStatement, expr parser.Execute, filter *Filter) (*ReplaceValues, error) {
+	rv, positionalLen, err := evalReplaceValues(expr, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if positionalLen != stmt.HolderNumber {
+		return nil, NewStatementReplaceValueLengthError(expr, stmt.Name, stmt.HolderNumber, positionalLen)
+	}
+	return rv, nil
+}
+
+// evalReplaceValues evaluates the values specified by EXECUTE, and returns them with the number of the positional values.
+func evalReplaceValues(expr parser.Execute, filter *Filter) (*ReplaceValues, int, error) {
+	rv := &ReplaceValues{
+		Values: make([]value.Primary, 0, len(expr.Values)),
+		Names:  make(map[string]int),
+	}
+
+	positionalLen := 0
+	for _, v := range expr.Values {
+		var name string
+		if r, ok := v.(parser.ReplaceValue); ok {
+			name = r.Name.Literal
+			v = r.Value
+		}
+
+		p, err := filter.Evaluate(v)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if 0 < len(name) {
+			rv.Names[strings.ToUpper(name)] = len(rv.Values)
+		} else {
+			positionalLen++
+		}
+		rv.Values = append(rv.Values, p)
+	}
+
+	if outer := filter.ReplaceValues; outer != nil {
+		for name, i := range outer.Names {
+			if _, ok := rv.Names[name]; !ok {
+				rv.Names[name] = len(rv.Values)
+				rv.Values = append(rv.Values, outer.Values[i])
+			}
+		}
+	}
+	return rv, positionalLen, nil
+}
+
+// SetParams declares the parameters specified in the form of "NAME=VALUE" as variables,
+// and makes them available to named placeholders.
+func (proc *Procedure) SetParams(params []string) error {
+	if len(params) < 1 {
+		return nil
+	}
+
+	rv := proc.Filter.ReplaceValues
+	if rv == nil {
+		rv = &ReplaceValues{
+			Values: make([]value.Primary, 0, len(params)),
+			Names:  make(map[string]int, len(params)),
+		}
+	}
+
+	for _, s := range params {
+		name, val, err := cmd.ParseParam(s)
+		if err != nil {
+			return err
+		}
+		uname := strings.ToUpper(name)
+		if _, ok := rv.Names[uname]; ok {
+			return errors.New(fmt.Sprintf("param %s is specified more than once", name))
+		}
+
+		p := value.NewString(val)
+		if err = proc.Filter.Variables[0].Add(parser.Variable{Name: name}, p); err != nil {
+			return err
+		}
+		rv.Names[uname] = len(rv.Values)
+		rv.Values = append(rv.Values, p)
+	}
+
+	proc.Filter.ReplaceValues = rv
+	return nil
+}
+
+// Get returns the value bound to the placeholder.
+func (rv *ReplaceValues) Get(expr parser.Placeholder) (value.Primary, error) {
+	if rv != nil {
+		if 0 < len(expr.Name) {
+			if i, ok := rv.Names[strings.ToUpper(expr.Name)]; ok {
+				return rv.Values[i], nil
+			}
+		} else if positional := rv.positionalIndex(expr.Ordinal); -1 < positional {
+			return rv.Values[positional], nil
+		}
+	}
+	return nil, NewPlaceholderValueNotSpecifiedError(expr)
+}
+
+// identifier returns the string value bound to the identifier placeholder.
+func (rv *ReplaceValues) identifier(expr parser.Placeholder) (string, error) {
+	p, err := rv.Get(expr)
+	if err != nil {
+		return "", errors.New(err.(AppError).ErrorMessage())
+	}
+	s := value.ToString(p)
+	if value.IsNull(s) || len(s.(value.String).Raw()) < 1 {
+		return "", errors.New(errorMessage(ErrorInvalidIdentifierValue, p, expr))
+	}
+	return s.(value.String).Raw(), nil
+}
+
+func (rv *ReplaceValues) positionalIndex(ordinal int) int {
+	named := make(map[int]bool, len(rv.Names))
+	for _, i := range rv.Names {
+		named[i] = true
+	}
+
+	n := 0
+	for i := range rv.Values {
+		if named[i] {
+			continue
+		}
+		n++
+		if n == ordinal {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,422 @@
+package query
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var preparedStatementMapPrepareTests = []struct {
+	Name   string
+	Expr   parser.Prepare
+	Result PreparedStatementMap
+	Error  string
+}{
+	{
+		Name: "PreparedStatementMap Prepare",
+		Expr: parser.Prepare{
+			BaseExpr:  parser.NewBaseExpr(parser.Token{}),
+			Name:      parser.Identifier{Literal: "stmt"},
+			Statement: parser.NewStringValue("print ?; print :name; print ?;"),
+		},
+		Result: PreparedStatementMap{
+			"STMT": &PreparedStatement{
+				Name:            "stmt",
+				StatementString: "print ?; print :name; print ?;",
+				Statements: []parser.Statement{
+					parser.Print{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 1, SourceFile: "(L:0 C:0) PREPARE stmt"}), Value: parser.Placeholder{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 7, SourceFile: "(L:0 C:0) PREPARE stmt"}), Literal: "?", Ordinal: 1}},
+					parser.Print{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 10, SourceFile: "(L:0 C:0) PREPARE stmt"}), Value: parser.Placeholder{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 16, SourceFile: "(L:0 C:0) PREPARE stmt"}), Literal: ":name", Name: "name"}},
+					parser.Print{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 23, SourceFile: "(L:0 C:0) PREPARE stmt"}), Value: parser.Placeholder{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 29, SourceFile: "(L:0 C:0) PREPARE stmt"}), Literal: "?", Ordinal: 2}},
+				},
+				HolderNumber: 2,
+			},
+		},
+	},
+	{
+		Name: "PreparedStatementMap Prepare Redeclared Error",
+		Expr: parser.Prepare{
+			BaseExpr:  parser.NewBaseExpr(parser.Token{}),
+			Name:      parser.Identifier{Literal: "stmt"},
+			Statement: parser.NewStringValue("print 1;"),
+		},
+		Error: "[L:- C:-] statement stmt is redeclared",
+	},
+	{
+		Name: "PreparedStatementMap Prepare Parsing Error",
+		Expr: parser.Prepare{
+			BaseExpr:  parser.NewBaseExpr(parser.Token{}),
+			Name:      parser.Identifier{Literal: "stmt2"},
+			Statement: parser.NewStringValue("print;"),
+		},
+		Error: "(L:0 C:0) PREPARE stmt2 [L:1 C:6] syntax error: unexpected token \";\"",
+	},
+}
+
+func TestPreparedStatementMap_Prepare(t *testing.T) {
+	m := NewPreparedStatementMap()
+	filter := NewEmptyFilter()
+
+	for _, v := range preparedStatementMapPrepareTests {
+		err := m.Prepare(v.Expr, filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(m, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, m, v.Result)
+		}
+	}
+}
+
+var preparedStatementMapDisposeTests = []struct {
+	Name   string
+	Stmt   parser.Identifier
+	Result PreparedStatementMap
+	Error  string
+}{
+	{
+		Name:   "PreparedStatementMap Dispose",
+		Stmt:   parser.Identifier{Literal: "stmt"},
+		Result: PreparedStatementMap{},
+	},
+	{
+		Name:  "PreparedStatementMap Dispose Undeclared Error",
+		Stmt:  parser.Identifier{Literal: "notexist"},
+		Error: "[L:- C:-] statement notexist is undeclared",
+	},
+}
+
+func TestPreparedStatementMap_Dispose(t *testing.T) {
+	m := PreparedStatementMap{
+		"STMT": &PreparedStatement{Name: "stmt"},
+	}
+
+	for _, v := range preparedStatementMapDisposeTests {
+		err := m.Dispose(v.Stmt)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(m, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, m, v.Result)
+		}
+	}
+}
+
+var replaceValuesGetTests = []struct {
+	Name   string
+	Expr   parser.Placeholder
+	Result value.Primary
+	Error  string
+}{
+	{
+		Name:   "ReplaceValues Get Positional",
+		Expr:   parser.Placeholder{Literal: "?", Ordinal: 2},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name:   "ReplaceValues Get Named",
+		Expr:   parser.Placeholder{Literal: ":ID", Name: "ID"},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name:  "ReplaceValues Get Positional Not Specified Error",
+		Expr:  parser.Placeholder{Literal: "?", Ordinal: 3},
+		Error: "[L:- C:-] value for placeholder ? is not specified",
+	},
+	{
+		Name:  "ReplaceValues Get Named Not Specified Error",
+		Expr:  parser.Placeholder{Literal: ":notexist", Name: "notexist"},
+		Error: "[L:- C:-] value for placeholder :notexist is not specified",
+	},
+}
+
+func TestReplaceValues_Get(t *testing.T) {
+	rv := &ReplaceValues{
+		Values: []value.Primary{value.NewInteger(1), value.NewInteger(2), value.NewInteger(3)},
+		Names:  map[string]int{"ID": 1},
+	}
+
+	for _, v := range replaceValuesGetTests {
+		result, err := rv.Get(v.Expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
+var procedureExecutePreparedTests = []struct {
+	Name   string
+	Input  string
+	Result string
+	Error  string
+}{
+	{
+		Name:   "Execute Prepared Statement",
+		Input:  "PREPARE stmt FROM 'SELECT ? AS a, :b AS b'; EXECUTE stmt USING 1, 'x' AS b; EXECUTE stmt USING 2, 'y' AS B; DISPOSE PREPARE stmt;",
+		Result: "a,b\n1,x\na,b\n2,y\n",
+	},
+	{
+		Name:   "Execute Prepared Statement with Variables",
+		Input:  "VAR @v := 5; PREPARE stmt FROM 'IF ? > 1 THEN PRINT :msg; END IF;'; EXECUTE stmt USING @v, 'greater' AS msg; DISPOSE PREPARE stmt;",
+		Result: "\"greater\"\n",
+	},
+	{
+		Name:  "Execute Prepared Statement Values Length Error",
+		Input: "PREPARE stmt FROM 'SELECT ?, ?'; EXECUTE stmt USING 1;",
+		Error: "[L:1 C:34] statement stmt takes 2 positional values, but 1 value specified",
+	},
+	{
+		Name:  "Execute Prepared Statement Named Value Error",
+		Input: "PREPARE stmt FROM 'SELECT :a'; EXECUTE stmt;",
+		Error: "(L:1 C:1) PREPARE stmt [L:1 C:8] value for placeholder :a is not specified",
+	},
+	{
+		Name:  "Execute Undeclared Statement Error",
+		Input: "EXECUTE stmt;",
+		Error: "[L:1 C:9] statement stmt is undeclared",
+	},
+	{
+		Name:  "Placeholder Outside Prepared Statement Error",
+		Input: "SELECT ?;",
+		Error: "[L:1 C:8] value for placeholder ? is not specified",
+	},
+}
+
+func TestProcedure_ExecutePrepared(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Format = cmd.CSV
+	defer func() {
+		tf.Format = cmd.TEXT
+		PreparedStatements = NewPreparedStatementMap()
+	}()
+
+	for _, v := range procedureExecutePreparedTests {
+		PreparedStatements = NewPreparedStatementMap()
+
+		program, err := parser.Parse(v.Input, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		oldStdout := Stdout
+		r, w, _ := os.Pipe()
+		Stdout = w
+
+		_, err = NewProcedure().Execute(program)
+
+		w.Close()
+		Stdout = oldStdout
+
+		log, _ := ioutil.ReadAll(r)
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if string(log) != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(log), v.Result)
+		}
+	}
+}
+
+var procedureExecuteImmediateTests = []struct {
+	Name   string
+	Input  string
+	Result string
+	Error  string
+}{
+	{
+		Name:   "Execute Immediate",
+		Input:  "EXECUTE IMMEDIATE 'SELECT ?? FROM ?? WHERE ?? = ?' USING 'column2', 'table1', 'column1', 2;",
+		Result: "column2\nstr2\n",
+	},
+	{
+		Name:   "Execute Immediate with Named Placeholders",
+		Input:  "VAR @col := 'column2'; EXECUTE IMMEDIATE 'SELECT ::col AS ::alias FROM table1 WHERE column1 = :id' USING @col AS col, 'a b' AS alias, 3 AS id;",
+		Result: "a b\nstr3\n",
+	},
+	{
+		Name:  "Execute Immediate Quoted Identifier",
+		Input: "EXECUTE IMMEDIATE 'SELECT ?? FROM table1 WHERE column1 = 1' USING 'column1` FROM table1; PRINT `x';",
+		Error: "(L:1 C:1) EXECUTE IMMEDIATE [L:1 C:8] field `column1\\` FROM table1; PRINT \\`x` does not exist",
+	},
+	{
+		Name:  "Execute Immediate Invalid Identifier Error",
+		Input: "EXECUTE IMMEDIATE 'SELECT ?? FROM table1' USING NULL;",
+		Error: "(L:1 C:1) EXECUTE IMMEDIATE [L:1 C:21] NULL is not a valid identifier for placeholder ??",
+	},
+	{
+		Name:  "Execute Immediate Values Length Error",
+		Input: "EXECUTE IMMEDIATE 'SELECT ??, ?' USING 'column1';",
+		Error: "[L:1 C:1] statement takes 2 positional values, but 1 value specified",
+	},
+	{
+		Name:  "Identifier Placeholder Outside Execute Immediate Error",
+		Input: "EXECUTE 'SELECT ?? FROM table1';",
+		Error: "(L:1 C:1) EXECUTE [L:1 C:21] identifier placeholder ?? is allowed only in EXECUTE IMMEDIATE",
+	},
+}
+
+func TestProcedure_ExecuteImmediate(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.Format = cmd.CSV
+	defer func() {
+		tf.Format = cmd.TEXT
+	}()
+
+	for _, v := range procedureExecuteImmediateTests {
+		ViewCache.Clean()
+
+		program, err := parser.Parse(v.Input, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		oldStdout := Stdout
+		r, w, _ := os.Pipe()
+		Stdout = w
+
+		_, err = NewProcedure().Execute(program)
+
+		w.Close()
+		Stdout = oldStdout
+
+		log, _ := ioutil.ReadAll(r)
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if string(log) != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(log), v.Result)
+		}
+	}
+}
+
+var procedureSetParamsTests = []struct {
+	Name   string
+	Params []string
+	Input  string
+	Result string
+	Error  string
+}{
+	{
+		Name:   "SetParams",
+		Params: []string{"id=2", "name=a=b"},
+		Input:  "SELECT @id AS id, :ID AS pid, :name AS name; PREPARE stmt FROM 'SELECT :id AS pid, ? AS v'; EXECUTE stmt USING 1; EXECUTE stmt USING 1, 3 AS id;",
+		Result: "id,pid,name\n2,2,a=b\npid,v\n2,1\npid,v\n3,1\n",
+	},
+	{
+		Name:   "SetParams Invalid Param Error",
+		Params: []string{"id"},
+		Error:  "param \"id\" must be in the form of NAME=VALUE",
+	},
+	{
+		Name:   "SetParams Duplicate Param Error",
+		Params: []string{"id=1", "ID=2"},
+		Error:  "param ID is specified more than once",
+	},
+}
+
+func TestProcedure_SetParams(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Format = cmd.CSV
+	defer func() {
+		tf.Format = cmd.TEXT
+		PreparedStatements = NewPreparedStatementMap()
+	}()
+
+	for _, v := range procedureSetParamsTests {
+		PreparedStatements = NewPreparedStatementMap()
+		proc := NewProcedure()
+
+		err := proc.SetParams(v.Params)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		program, err := parser.Parse(v.Input, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		oldStdout := Stdout
+		r, w, _ := os.Pipe()
+		Stdout = w
+
+		_, err = proc.Execute(program)
+
+		w.Close()
+		Stdout = oldStdout
+
+		log, _ := ioutil.ReadAll(r)
+
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if string(log) != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(log), v.Result)
+		}
+	}
+}
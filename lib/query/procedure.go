@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os/exec"
+	"reflect"
 	"strings"
 	"time"
 
@@ -30,6 +31,10 @@ const (
 var Version string
 var ViewCache = make(ViewMap, 10)
 var UncommittedViews = NewUncommittedViewMap()
+var PreparedStatements = NewPreparedStatementMap()
+var TableTriggers = NewTableTriggerMap()
+var TableIndexes = NewTableIndexMap()
+var ImportedModules = make(map[string]bool)
 
 var Formatter = NewStringFormatter()
 
@@ -61,7 +66,13 @@ func ReleaseResourcesWithErrors() error {
 type Procedure struct {
 	Filter           *Filter
 	ReturnVal        value.Primary
+	ReturnView       *View
 	MeasurementStart time.Time
+
+	// loopLabels holds the labels of the loops enclosing the procedure.
+	loopLabels []string
+	// flowLabel is the label referred by the executed CONTINUE or BREAK statement.
+	flowLabel string
 }
 
 func NewProcedure() *Procedure {
@@ -72,18 +83,64 @@ func NewProcedure() *Procedure {
 
 func (proc *Procedure) NewChildProcedure() *Procedure {
 	return &Procedure{
-		Filter: proc.Filter.CreateChildScope(),
+		Filter:     proc.Filter.CreateChildScope(),
+		loopLabels: proc.loopLabels,
 	}
 }
 
+func (proc *Procedure) newLoopProcedure(label parser.Identifier) *Procedure {
+	child := proc.NewChildProcedure()
+	if 0 < len(label.Literal) {
+		child.loopLabels = append(append(make([]string, 0, len(proc.loopLabels)+1), proc.loopLabels...), label.Literal)
+	}
+	return child
+}
+
 func (proc *Procedure) ExecuteChild(statements []parser.Statement) (StatementFlow, error) {
 	child := proc.NewChildProcedure()
-	return child.Execute(statements)
+	flow, err := child.Execute(statements)
+	proc.setFlow(flow, child)
+	return flow, err
+}
+
+func (proc *Procedure) setFlow(flow StatementFlow, child *Procedure) {
+	switch flow {
+	case Return:
+		proc.setReturn(child)
+	case Break, Continue:
+		proc.flowLabel = child.flowLabel
+	}
+}
+
+// exitsLoop reports whether the flow returned from the statements of the loop labeled with the label
+// leaves the loop to an outer loop, and passes the label of the flow to the procedure.
+func (proc *Procedure) exitsLoop(flow StatementFlow, child *Procedure, label parser.Identifier) bool {
+	if flow != Break && flow != Continue {
+		return false
+	}
+
+	flowLabel := child.flowLabel
+	child.flowLabel = ""
+	if len(flowLabel) < 1 || strings.EqualFold(flowLabel, label.Literal) {
+		return false
+	}
+	proc.flowLabel = flowLabel
+	return true
+}
+
+func (proc *Procedure) setReturn(child *Procedure) {
+	proc.ReturnVal = child.ReturnVal
+	proc.ReturnView = child.ReturnView
 }
 
 func (proc *Procedure) Execute(statements []parser.Statement) (StatementFlow, error) {
 	flow := Terminate
 
+	if Debug != nil {
+		Debug.enter()
+		defer Debug.leave()
+	}
+
 	for _, stmt := range statements {
 		f, err := proc.ExecuteStatement(stmt)
 		if err != nil {
@@ -105,6 +162,12 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 
 	var printstr string
 
+	if Debug != nil {
+		if err = Debug.Trace(proc, stmt); err != nil {
+			return Error, err
+		}
+	}
+
 	switch stmt.(type) {
 	case parser.SetFlag:
 		err = SetFlag(stmt.(parser.SetFlag), proc.Filter)
@@ -124,6 +187,10 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		err = SetEnvVar(stmt.(parser.SetEnvVar), proc.Filter)
 	case parser.UnsetEnvVar:
 		err = UnsetEnvVar(stmt.(parser.UnsetEnvVar))
+	case parser.Export:
+		if printstr, err = Export(stmt.(parser.Export), proc.Filter); err == nil && 0 < len(printstr) {
+			Log(printstr, false)
+		}
 	case parser.DisposeVariable:
 		err = proc.Filter.Variables.Dispose(stmt.(parser.DisposeVariable).Variable)
 	case parser.CursorDeclaration:
@@ -136,7 +203,11 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		err = proc.Filter.Cursors.Dispose(stmt.(parser.DisposeCursor).Cursor)
 	case parser.FetchCursor:
 		fetch := stmt.(parser.FetchCursor)
-		_, err = FetchCursor(fetch.Cursor, fetch.Position, fetch.Variables, proc.Filter)
+		if fetch.Bulk != nil {
+			_, err = FetchCursorBulk(fetch.Cursor, fetch.Position, fetch.Bulk, fetch.Variables[0], proc.Filter)
+		} else {
+			_, err = FetchCursor(fetch.Cursor, fetch.Position, fetch.Variables, proc.Filter)
+		}
 	case parser.ViewDeclaration:
 		err = DeclareView(stmt.(parser.ViewDeclaration), proc.Filter)
 	case parser.DisposeView:
@@ -147,36 +218,27 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		err = proc.Filter.Functions.Dispose(stmt.(parser.DisposeFunction).Name)
 	case parser.AggregateDeclaration:
 		err = proc.Filter.Functions.DeclareAggregate(stmt.(parser.AggregateDeclaration))
+	case parser.TableTriggerDeclaration:
+		err = TableTriggers.Declare(stmt.(parser.TableTriggerDeclaration), proc.Filter)
+	case parser.DisposeTableTrigger:
+		err = TableTriggers.Dispose(stmt.(parser.DisposeTableTrigger).Name)
+	case parser.CreateIndex:
+		err = TableIndexes.Create(stmt.(parser.CreateIndex), proc.Filter)
+	case parser.DropIndex:
+		err = TableIndexes.Drop(stmt.(parser.DropIndex).Name)
 	case parser.SelectQuery:
 		if flags.Stats {
 			proc.MeasurementStart = time.Now()
 		}
 
-		view, e := Select(stmt.(parser.SelectQuery), proc.Filter)
-		if e == nil {
-			fileInfo := &FileInfo{
-				Format:             flags.Format,
-				Delimiter:          flags.WriteDelimiter,
-				DelimiterPositions: flags.WriteDelimiterPositions,
-				Encoding:           flags.WriteEncoding,
-				LineBreak:          flags.LineBreak,
-				NoHeader:           flags.WithoutHeader,
-				EncloseAll:         flags.EncloseAll,
-				PrettyPrint:        flags.PrettyPrint,
-			}
-
-			var writer io.Writer
-			if OutFile != nil {
-				writer = OutFile
-			} else {
-				writer = Stdout
-			}
-			err = EncodeView(writer, view, fileInfo)
-			if err == nil {
-				writer.Write([]byte(cmd.GetFlags().LineBreak.Value()))
-			} else if _, ok := err.(*EmptyResultSetError); ok {
-				err = nil
-			}
+		if table, ok := TailStreamingTable(stmt.(parser.SelectQuery)); ok {
+			err = proc.Tail(stmt.(parser.SelectQuery), table)
+		} else if table, ok := IncrementalTable(stmt.(parser.SelectQuery)); ok {
+			err = proc.Incremental(stmt.(parser.SelectQuery), table)
+		} else if table, ok := StreamingTable(stmt.(parser.SelectQuery), proc.Filter); ok {
+			err = proc.Stream(stmt.(parser.SelectQuery), table)
+		} else if view, e := Select(stmt.(parser.SelectQuery), proc.Filter); e == nil {
+			err = proc.writeView(stmt.(parser.SelectQuery), view, flags.WithoutHeader)
 		} else {
 			err = e
 		}
@@ -194,7 +256,7 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 			if 0 < cnt {
 				UncommittedViews.SetForUpdatedView(fileInfo)
 			}
-			Log(fmt.Sprintf("%s inserted on %q.", FormatCount(cnt, "record"), fileInfo.Path), flags.Quiet)
+			Log(fmt.Sprintf(cmd.Message("%s inserted on %q."), FormatCount(cnt, "record"), fileInfo.Path), flags.Quiet)
 		} else {
 			err = e
 		}
@@ -213,7 +275,7 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 				if 0 < cnts[i] {
 					UncommittedViews.SetForUpdatedView(info)
 				}
-				Log(fmt.Sprintf("%s updated on %q.", FormatCount(cnts[i], "record"), info.Path), flags.Quiet)
+				Log(fmt.Sprintf(cmd.Message("%s updated on %q."), FormatCount(cnts[i], "record"), info.Path), flags.Quiet)
 			}
 		} else {
 			err = e
@@ -233,7 +295,7 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 				if 0 < cnts[i] {
 					UncommittedViews.SetForUpdatedView(info)
 				}
-				Log(fmt.Sprintf("%s deleted on %q.", FormatCount(cnts[i], "record"), info.Path), flags.Quiet)
+				Log(fmt.Sprintf(cmd.Message("%s deleted on %q."), FormatCount(cnts[i], "record"), info.Path), flags.Quiet)
 			}
 		} else {
 			err = e
@@ -242,11 +304,18 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		if flags.Stats {
 			proc.showExecutionTime()
 		}
+	case parser.Copy:
+		info, cnt, e := Copy(stmt.(parser.Copy), proc.Filter)
+		if e == nil {
+			Log(fmt.Sprintf(cmd.Message("%s exported to %q."), FormatCount(cnt, "record"), info.Path), flags.Quiet)
+		} else {
+			err = e
+		}
 	case parser.CreateTable:
 		info, e := CreateTable(stmt.(parser.CreateTable), proc.Filter)
 		if e == nil {
 			UncommittedViews.SetForCreatedView(info)
-			Log(fmt.Sprintf("file %q is created.", info.Path), flags.Quiet)
+			Log(fmt.Sprintf(cmd.Message("file %q is created."), info.Path), flags.Quiet)
 		} else {
 			err = e
 		}
@@ -254,7 +323,7 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		info, cnt, e := AddColumns(stmt.(parser.AddColumns), proc.Filter)
 		if e == nil {
 			UncommittedViews.SetForUpdatedView(info)
-			Log(fmt.Sprintf("%s added on %q.", FormatCount(cnt, "field"), info.Path), flags.Quiet)
+			Log(fmt.Sprintf(cmd.Message("%s added on %q."), FormatCount(cnt, "field"), info.Path), flags.Quiet)
 		} else {
 			err = e
 		}
@@ -262,7 +331,7 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		info, cnt, e := DropColumns(stmt.(parser.DropColumns), proc.Filter)
 		if e == nil {
 			UncommittedViews.SetForUpdatedView(info)
-			Log(fmt.Sprintf("%s dropped on %q.", FormatCount(cnt, "field"), info.Path), flags.Quiet)
+			Log(fmt.Sprintf(cmd.Message("%s dropped on %q."), FormatCount(cnt, "field"), info.Path), flags.Quiet)
 		} else {
 			err = e
 		}
@@ -270,7 +339,15 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		info, e := RenameColumn(stmt.(parser.RenameColumn), proc.Filter)
 		if e == nil {
 			UncommittedViews.SetForUpdatedView(info)
-			Log(fmt.Sprintf("%s renamed on %q.", FormatCount(1, "field"), info.Path), flags.Quiet)
+			Log(fmt.Sprintf(cmd.Message("%s renamed on %q."), FormatCount(1, "field"), info.Path), flags.Quiet)
+		} else {
+			err = e
+		}
+	case parser.SetColumnType:
+		info, e := SetColumnType(stmt.(parser.SetColumnType), proc.Filter)
+		if e == nil {
+			UncommittedViews.SetForUpdatedView(info)
+			Log(fmt.Sprintf(cmd.Message("%s altered on %q."), FormatCount(1, "field"), info.Path), flags.Quiet)
 		} else {
 			err = e
 		}
@@ -282,7 +359,7 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 			Log(log, flags.Quiet)
 		} else {
 			if unchanged, ok := e.(*TableAttributeUnchangedError); ok {
-				Log(fmt.Sprintf("Table attributes of %s remain unchanged.", unchanged.Path), flags.Quiet)
+				Log(fmt.Sprintf(cmd.Message("Table attributes of %s remain unchanged."), unchanged.Path), flags.Quiet)
 			} else {
 				err = e
 			}
@@ -295,7 +372,15 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 			err = Rollback(stmt.(parser.Expression), proc.Filter)
 		}
 	case parser.FlowControl:
-		switch stmt.(parser.FlowControl).Token {
+		fc := stmt.(parser.FlowControl)
+		if 0 < len(fc.Label.Literal) {
+			if !InStrSliceWithCaseInsensitive(fc.Label.Literal, proc.loopLabels) {
+				err = NewUndeclaredLabelError(fc.Label)
+				break
+			}
+			proc.flowLabel = fc.Label.Literal
+		}
+		switch fc.Token {
 		case parser.CONTINUE:
 			flow = Continue
 		case parser.BREAK:
@@ -319,6 +404,18 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 			proc.ReturnVal = ret
 			flow = Return
 		}
+	case parser.ReturnTable:
+		var view *View
+		if view, err = Select(stmt.(parser.ReturnTable).Query, proc.Filter); err == nil {
+			proc.ReturnView = view
+			flow = Return
+		}
+	case parser.ReturnCursor:
+		var view *View
+		if view, err = proc.Filter.Cursors.ResultSet(stmt.(parser.ReturnCursor).Cursor, proc.Filter); err == nil {
+			proc.ReturnView = view
+			flow = Return
+		}
 	case parser.If:
 		flow, err = proc.IfStmt(stmt.(parser.If))
 	case parser.Case:
@@ -327,6 +424,8 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		flow, err = proc.While(stmt.(parser.While))
 	case parser.WhileInCursor:
 		flow, err = proc.WhileInCursor(stmt.(parser.WhileInCursor))
+	case parser.Try:
+		flow, err = proc.Try(stmt.(parser.Try))
 	case parser.Echo:
 		if printstr, err = Echo(stmt.(parser.Echo), proc.Filter); err == nil {
 			Log(printstr, false)
@@ -344,11 +443,24 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		if externalStatements, err = Source(stmt.(parser.Source), proc.Filter); err == nil {
 			flow, err = proc.Execute(externalStatements)
 		}
+	case parser.Import:
+		flow, err = proc.Import(stmt.(parser.Import))
 	case parser.Execute:
-		var externalStatements []parser.Statement
-		if externalStatements, err = ParseExecuteStatements(stmt.(parser.Execute), proc.Filter); err == nil {
-			flow, err = proc.Execute(externalStatements)
+		execute := stmt.(parser.Execute)
+		if execute.Immediate {
+			flow, err = proc.ExecuteImmediate(execute)
+		} else if name, ok := preparedStatementName(execute.Statements); ok {
+			flow, err = proc.ExecutePrepared(name, execute)
+		} else {
+			var externalStatements []parser.Statement
+			if externalStatements, err = ParseExecuteStatements(execute, proc.Filter); err == nil {
+				flow, err = proc.Execute(externalStatements)
+			}
 		}
+	case parser.Prepare:
+		err = PreparedStatements.Prepare(stmt.(parser.Prepare), proc.Filter)
+	case parser.DisposePrepared:
+		err = PreparedStatements.Dispose(stmt.(parser.DisposePrepared).Name)
 	case parser.Chdir:
 		err = Chdir(stmt.(parser.Chdir), proc.Filter)
 	case parser.Pwd:
@@ -367,6 +479,14 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		if printstr, err = ShowFields(stmt.(parser.ShowFields), proc.Filter); err == nil {
 			Log(printstr, false)
 		}
+	case parser.Explain:
+		if printstr, err = Explain(stmt.(parser.Explain), proc.Filter); err == nil {
+			Log(printstr, false)
+		}
+	case parser.Estimate:
+		if printstr, err = Estimate(stmt.(parser.Estimate), proc.Filter); err == nil {
+			Log(printstr, false)
+		}
 	case parser.Syntax:
 		printstr = Syntax(stmt.(parser.Syntax), proc.Filter)
 		Log(printstr, false)
@@ -375,6 +495,7 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		switch strings.ToUpper(trigger.Event.Literal) {
 		case "ERROR":
 			var message string
+			var data value.Primary
 			if trigger.Message != nil {
 				if pt, ok := trigger.Message.(parser.PrimitiveType); ok && trigger.Code == nil && pt.IsInteger() {
 					trigger.Code = pt.Value
@@ -387,12 +508,19 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 					}
 				}
 			}
+			if err == nil && trigger.Data != nil {
+				data, err = proc.Filter.Evaluate(trigger.Data)
+			}
 			if err == nil {
-				err = NewUserTriggeredError(trigger, message)
+				err = NewUserTriggeredError(trigger, message, data)
 			}
 		default:
 			err = NewInvalidEventNameError(trigger.Event)
 		}
+	case parser.Assert:
+		err = proc.Assert(stmt.(parser.Assert))
+	case parser.Expect:
+		err = proc.Expect(stmt.(parser.Expect))
 	case parser.ExternalCommand:
 		err = proc.ExecExternalCommand(stmt.(parser.ExternalCommand))
 	default:
@@ -402,6 +530,12 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 	}
 
 	if err != nil {
+		switch err.(type) {
+		case *CheckConstraintViolationError, *NotNullConstraintViolationError, *UniqueConstraintViolationError:
+			if e := Rollback(nil, proc.Filter); e != nil {
+				LogError(e.Error())
+			}
+		}
 		flow = Error
 	}
 	return flow, err
@@ -469,7 +603,7 @@ func (proc *Procedure) Case(stmt parser.Case) (StatementFlow, error) {
 }
 
 func (proc *Procedure) While(stmt parser.While) (StatementFlow, error) {
-	childProc := proc.NewChildProcedure()
+	childProc := proc.newLoopProcedure(stmt.Label)
 
 	for {
 		childProc.Filter.ResetCurrentScope()
@@ -486,12 +620,19 @@ func (proc *Procedure) While(stmt parser.While) (StatementFlow, error) {
 			return Error, err
 		}
 
+		if proc.exitsLoop(f, childProc, stmt.Label) {
+			return f, nil
+		}
 		if f == Break {
 			return Terminate, nil
 		}
 		if f == Exit {
 			return Exit, nil
 		}
+		if f == Return {
+			proc.setReturn(childProc)
+			return Return, nil
+		}
 	}
 	return Terminate, nil
 }
@@ -501,7 +642,7 @@ func (proc *Procedure) WhileInCursor(stmt parser.WhileInCursor) (StatementFlow,
 		Position: parser.Token{Token: parser.NEXT},
 	}
 
-	childProc := proc.NewChildProcedure()
+	childProc := proc.newLoopProcedure(stmt.Label)
 	for {
 		childProc.Filter.ResetCurrentScope()
 		if stmt.WithDeclaration {
@@ -526,17 +667,149 @@ func (proc *Procedure) WhileInCursor(stmt parser.WhileInCursor) (StatementFlow,
 			return Error, err
 		}
 
+		if proc.exitsLoop(f, childProc, stmt.Label) {
+			return f, nil
+		}
 		if f == Break {
 			return Terminate, nil
 		}
 		if f == Exit {
 			return Exit, nil
 		}
+		if f == Return {
+			proc.setReturn(childProc)
+			return Return, nil
+		}
 	}
 
 	return Terminate, nil
 }
 
+// Try executes the statements, and executes the catch statements with the caught exception
+// if the statements return an error.
+func (proc *Procedure) Try(stmt parser.Try) (StatementFlow, error) {
+	flow, err := proc.ExecuteChild(stmt.Statements)
+	if err == nil {
+		return flow, nil
+	}
+	if _, ok := err.(*ForcedExit); ok {
+		return flow, err
+	}
+
+	child := proc.NewChildProcedure()
+	child.Filter.Exception = NewException(err)
+	flow, err = child.Execute(stmt.CatchStatements)
+	proc.setFlow(flow, child)
+	return flow, err
+}
+
+// Assert returns an error after rolling back the transaction if the condition is not TRUE.
+func (proc *Procedure) Assert(expr parser.Assert) error {
+	p, err := proc.Filter.Evaluate(expr.Condition)
+	if err != nil {
+		return err
+	}
+	if p.Ternary() == ternary.TRUE {
+		return nil
+	}
+
+	var message string
+	if expr.Message != nil {
+		m, err := proc.Filter.Evaluate(expr.Message)
+		if err != nil {
+			return err
+		}
+		if s := value.ToString(m); !value.IsNull(s) {
+			message = s.(value.String).Raw()
+		}
+	}
+
+	if err = Rollback(expr, proc.Filter); err != nil {
+		return err
+	}
+	return NewAssertionFailedError(expr, message)
+}
+
+// Expect returns an error after rolling back the transaction if the result of the query does not equal the expected result.
+// Fields are compared by their names, and records are compared in order.
+func (proc *Procedure) Expect(expr parser.Expect) error {
+	view, err := Select(expr.Query.(parser.SelectQuery), proc.Filter)
+	if err != nil {
+		return err
+	}
+	expected, err := Select(expr.Expected.(parser.SelectQuery), proc.Filter)
+	if err != nil {
+		return err
+	}
+
+	if err = compareExpectedView(expr, view, expected); err != nil {
+		if e := Rollback(expr, proc.Filter); e != nil {
+			return e
+		}
+	}
+	return err
+}
+
+func compareExpectedView(expr parser.Expect, view *View, expected *View) error {
+	fields := make([]string, view.FieldLen())
+	for i := range view.Header {
+		fields[i] = view.Header[i].Column
+	}
+	expectedFields := make([]string, expected.FieldLen())
+	for i := range expected.Header {
+		expectedFields[i] = expected.Header[i].Column
+	}
+	if !reflect.DeepEqual(fields, expectedFields) {
+		return NewExpectedFieldsNotMatchError(expr, fields, expectedFields)
+	}
+
+	if view.RecordLen() != expected.RecordLen() {
+		return NewExpectedRecordCountNotMatchError(expr, view.RecordLen(), expected.RecordLen())
+	}
+
+	for i := range view.RecordSet {
+		for j := range view.RecordSet[i] {
+			if value.Equivalent(view.RecordSet[i][j].Value(), expected.RecordSet[i][j].Value()) != ternary.TRUE {
+				return NewExpectedRecordNotMatchError(expr, i+1, formatRecordValues(view.RecordSet[i]), formatRecordValues(expected.RecordSet[i]))
+			}
+		}
+	}
+	return nil
+}
+
+func formatRecordValues(record Record) string {
+	list := make([]string, len(record))
+	for i := range record {
+		list[i] = record[i].Value().String()
+	}
+	return "(" + strings.Join(list, ", ") + ")"
+}
+
+// Import executes the statements of the module in the global scope.
+// A module that has already been imported is not executed again.
+func (proc *Procedure) Import(stmt parser.Import) (StatementFlow, error) {
+	fpath, err := ResolveModulePath(stmt, proc.Filter)
+	if err != nil {
+		return Error, err
+	}
+	if ImportedModules[fpath] {
+		return Terminate, nil
+	}
+
+	statements, err := LoadStatementsFromFile(parser.Source{BaseExpr: stmt.BaseExpr, FilePath: stmt.Module}, fpath)
+	if err != nil {
+		return Error, err
+	}
+	ImportedModules[fpath] = true
+
+	global := &Procedure{Filter: proc.Filter.CreateGlobalScope()}
+	flow, err := global.Execute(statements)
+	if flow != Exit && flow != Error {
+		flow = Terminate
+	}
+	return flow, err
+}
+
 func (proc *Procedure) ExecExternalCommand(stmt parser.ExternalCommand) error {
 	splitter := new(excmd.ArgsSplitter).Init(stmt.Command)
 	var argStrs = make([]string, 0, 8)
@@ -584,3 +857,181 @@ func (proc *Procedure) showExecutionTime() {
 	stats := fmt.Sprintf(palette.Render(cmd.LableEffect, "Query Execution Time: ")+"%s seconds", exectime)
 	Log(stats, false)
 }
+
+func (proc *Procedure) writeView(query parser.SelectQuery, view *View, noHeader bool) error {
+	flags := cmd.GetFlags()
+
+	fileInfo := &FileInfo{
+		Format:             flags.Format,
+		Delimiter:          flags.WriteDelimiter,
+		DelimiterPositions: flags.WriteDelimiterPositions,
+		Encoding:           flags.WriteEncoding,
+		LineBreak:          flags.LineBreak,
+		NoHeader:           noHeader,
+		EncloseAll:         flags.EncloseAll,
+		PrettyPrint:        flags.PrettyPrint,
+	}
+
+	var writer io.Writer
+	if OutFile != nil {
+		writer = OutFile
+	} else {
+		writer = Stdout
+	}
+	err := EncodeView(writer, view, fileInfo)
+	if err == nil {
+		writer.Write([]byte(flags.LineBreak.Value()))
+	} else if _, ok := err.(*EmptyResultSetError); ok {
+		err = nil
+	}
+
+	if sink, ok := writer.(*HttpSink); ok {
+		if err == nil {
+			if e := sink.Flush(); e != nil {
+				err = NewHttpSinkError(query, sink.Url, e.Error())
+			}
+		}
+		sink.Reset()
+	}
+	return err
+}
+
+// Tail follows the file specified with TAIL, and executes the query every time
+// records are appended to the file, until an error occurs or the process is interrupted.
+// The query is evaluated with only the appended records, so aggregate functions
+// summarize each batch of records.
+func (proc *Procedure) Tail(query parser.SelectQuery, table parser.Table) error {
+	reader, err := NewTailReader(table)
+	if err != nil {
+		return err
+	}
+
+	noHeader := cmd.GetFlags().WithoutHeader
+	for {
+		batch, err := reader.Read()
+		if err != nil {
+			return err
+		}
+
+		if 0 < batch.RecordLen() {
+			filter := proc.Filter.CreateNode()
+			filter.SetTailBatch(table, batch)
+
+			view, err := Select(query, filter)
+			if err != nil {
+				return err
+			}
+			if err = proc.writeView(query, view, noHeader); err != nil {
+				return err
+			}
+			if 0 < view.RecordLen() {
+				noHeader = true
+			}
+		}
+
+		time.Sleep(TailPollInterval)
+	}
+}
+
+// Stream executes the select query for every batch of records read from the file of the table,
+// and writes the results in order, so the whole table is not loaded into memory.
+// The query must be one for which StreamingTable returns the table.
+func (proc *Procedure) Stream(query parser.SelectQuery, table parser.Table) error {
+	reader, err := OpenStreamReader(table)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	batchQuery := streamingQuery(query, table)
+	noHeader := cmd.GetFlags().WithoutHeader
+	written := false
+	for !reader.EOF() {
+		batch, err := reader.Read()
+		if err != nil {
+			return err
+		}
+
+		filter := proc.Filter.CreateNode()
+		filter.SetStreamBatch(batch)
+
+		view, err := Select(batchQuery, filter)
+		if err != nil {
+			return err
+		}
+		if view.RecordLen() < 1 && (written || !reader.EOF()) {
+			continue
+		}
+		if err = proc.writeView(query, view, noHeader); err != nil {
+			return err
+		}
+		noHeader = true
+		written = true
+	}
+	return nil
+}
+
+// Incremental executes a select query with only the records appended to the file
+// since the last run, which is recorded in the state file of the INCREMENTAL table object.
+// If the query aggregates records, then the result is merged into the result saved
+// in the state file, so the output is the aggregation of all the records read so far.
+func (proc *Procedure) Incremental(query parser.SelectQuery, table parser.Table) error {
+	tableObject := table.Object.(parser.TableObject)
+	if tableObject.FormatElement != nil || len(tableObject.Args) != 1 {
+		return NewTableObjectJsonArgumentsLengthError(tableObject, 2)
+	}
+
+	p, err := proc.Filter.Evaluate(tableObject.Args[0])
+	if err != nil {
+		return err
+	}
+	s := value.ToString(p)
+	if value.IsNull(s) {
+		return NewTableObjectInvalidArgumentError(tableObject, "state file is not specified")
+	}
+	statePath, err := CreateFilePath(parser.Identifier{Literal: s.(value.String).Raw()}, cmd.GetFlags().Repository)
+	if err != nil {
+		return NewIncrementalStateError(tableObject, s.(value.String).Raw(), err.Error())
+	}
+
+	agg, err := NewIncrementalAggregation(query)
+	if err != nil {
+		return err
+	}
+
+	state, err := LoadIncrementalState(statePath)
+	if err != nil {
+		return NewIncrementalStateError(tableObject, statePath, err.Error())
+	}
+
+	reader, err := OpenTailReader(tableObject, tableObject.Path, table.Name().Literal)
+	if err != nil {
+		return err
+	}
+	if state != nil {
+		reader.SetOffset(state.Offset)
+	}
+	batch, err := reader.Read()
+	if err != nil {
+		return err
+	}
+
+	filter := proc.Filter.CreateNode()
+	filter.SetTailBatch(table, batch)
+	view, err := Select(query, filter)
+	if err != nil {
+		return err
+	}
+
+	newState, err := agg.Merge(view, state, reader.Offset())
+	if err != nil {
+		return NewIncrementalStateError(tableObject, statePath, err.Error())
+	}
+	if err = proc.writeView(query, view, cmd.GetFlags().WithoutHeader); err != nil {
+		return err
+	}
+	if err = newState.Save(statePath); err != nil {
+		return NewIncrementalStateError(tableObject, statePath, err.Error())
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -634,6 +635,26 @@ var procedureExecuteStatementTests = []struct {
 		},
 		Logs: fmt.Sprintf("1 field renamed on %q.\n", GetTestFilePath("table1.csv")),
 	},
+	{
+		Input: parser.SetColumnType{
+			Table:  parser.Identifier{Literal: "table1"},
+			Column: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			Type:   parser.Identifier{Literal: "string"},
+		},
+		UncommittedViews: &UncommittedViewMap{
+			Created: map[string]*FileInfo{},
+			Updated: map[string]*FileInfo{
+				strings.ToUpper(GetTestFilePath("TABLE1.CSV")): {
+					Path:      GetTestFilePath("table1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+				},
+			},
+		},
+		Logs: fmt.Sprintf("1 field altered on %q.\n", GetTestFilePath("table1.csv")),
+	},
 	{
 		Input: parser.SetTableAttribute{
 			Table:     parser.Identifier{Literal: "table1.csv"},
@@ -769,6 +790,30 @@ var procedureExecuteStatementTests = []struct {
 		Error:     "[L:- C:-] invalid is an unknown event",
 		ErrorCode: 1,
 	},
+	{
+		Input: parser.Assert{
+			Condition: parser.NewTernaryValueFromString("true"),
+		},
+	},
+	{
+		Input: parser.Assert{
+			Condition: parser.NewTernaryValueFromString("false"),
+			Message:   parser.NewStringValue("user error"),
+		},
+		Error:     "[L:- C:-] user error",
+		ErrorCode: 2,
+	},
+	{
+		Input: parser.Assert{
+			Condition: parser.Comparison{
+				LHS:      parser.NewIntegerValue(1),
+				RHS:      parser.NewIntegerValue(2),
+				Operator: "=",
+			},
+		},
+		Error:     "[L:- C:-] assertion 1 = 2 failed",
+		ErrorCode: 2,
+	},
 	{
 		Input: parser.ShowObjects{
 			Type: parser.Identifier{Literal: "cursors"},
@@ -1270,6 +1315,117 @@ var procedureWhileTests = []struct {
 		ResultFlow: Terminate,
 		Result:     "1\n3\n",
 	},
+	{
+		Name: "While Statement Continue Labeled Loop",
+		Stmt: parser.While{
+			Label: parser.Identifier{Literal: "lp"},
+			Condition: parser.Comparison{
+				LHS:      parser.Variable{Name: "while_test_count"},
+				RHS:      parser.NewIntegerValueFromString("3"),
+				Operator: "<",
+			},
+			Statements: []parser.Statement{
+				parser.VariableSubstitution{
+					Variable: parser.Variable{Name: "while_test_count"},
+					Value: parser.Arithmetic{
+						LHS:      parser.Variable{Name: "while_test_count"},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: '+',
+					},
+				},
+				parser.Print{Value: parser.Variable{Name: "while_test_count"}},
+				parser.While{
+					Condition: parser.NewTernaryValueFromString("true"),
+					Statements: []parser.Statement{
+						parser.FlowControl{Token: parser.CONTINUE, Label: parser.Identifier{Literal: "LP"}},
+					},
+				},
+				parser.Print{Value: parser.NewStringValue("not reached")},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "1\n2\n3\n",
+	},
+	{
+		Name: "While Statement Break Labeled Loop",
+		Stmt: parser.While{
+			Label:     parser.Identifier{Literal: "lp"},
+			Condition: parser.NewTernaryValueFromString("true"),
+			Statements: []parser.Statement{
+				parser.VariableSubstitution{
+					Variable: parser.Variable{Name: "while_test_count"},
+					Value: parser.Arithmetic{
+						LHS:      parser.Variable{Name: "while_test_count"},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: '+',
+					},
+				},
+				parser.Print{Value: parser.Variable{Name: "while_test_count"}},
+				parser.While{
+					Condition: parser.NewTernaryValueFromString("true"),
+					Statements: []parser.Statement{
+						parser.If{
+							Condition: parser.Comparison{
+								LHS:      parser.Variable{Name: "while_test_count"},
+								RHS:      parser.NewIntegerValueFromString("2"),
+								Operator: "=",
+							},
+							Statements: []parser.Statement{
+								parser.FlowControl{Token: parser.BREAK, Label: parser.Identifier{Literal: "lp"}},
+							},
+						},
+						parser.FlowControl{Token: parser.CONTINUE, Label: parser.Identifier{Literal: "lp"}},
+					},
+				},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "1\n2\n",
+	},
+	{
+		Name: "While Statement Undeclared Label Error",
+		Stmt: parser.While{
+			Label:     parser.Identifier{Literal: "lp"},
+			Condition: parser.NewTernaryValueFromString("true"),
+			Statements: []parser.Statement{
+				parser.FlowControl{Token: parser.BREAK, Label: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Error: "[L:- C:-] label notexist is undeclared",
+	},
+	{
+		Name: "While Statement Return",
+		Stmt: parser.While{
+			Condition: parser.Comparison{
+				LHS:      parser.Variable{Name: "while_test_count"},
+				RHS:      parser.NewIntegerValueFromString("3"),
+				Operator: "<",
+			},
+			Statements: []parser.Statement{
+				parser.VariableSubstitution{
+					Variable: parser.Variable{Name: "while_test_count"},
+					Value: parser.Arithmetic{
+						LHS:      parser.Variable{Name: "while_test_count"},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: '+',
+					},
+				},
+				parser.If{
+					Condition: parser.Comparison{
+						LHS:      parser.Variable{Name: "while_test_count"},
+						RHS:      parser.NewIntegerValueFromString("2"),
+						Operator: "=",
+					},
+					Statements: []parser.Statement{
+						parser.Return{Value: parser.Variable{Name: "while_test_count"}},
+					},
+				},
+				parser.Print{Value: parser.Variable{Name: "while_test_count"}},
+			},
+		},
+		ResultFlow: Return,
+		Result:     "1\n",
+	},
 	{
 		Name: "While Statement Break",
 		Stmt: parser.While{
@@ -1516,6 +1672,29 @@ var procedureWhileInCursorTests = []struct {
 		ResultFlow: Terminate,
 		Result:     "\"1\"\n\"3\"\n",
 	},
+	{
+		Name: "While In Cursor Continue Labeled Loop",
+		Stmt: parser.WhileInCursor{
+			Label: parser.Identifier{Literal: "lp"},
+			Variables: []parser.Variable{
+				{Name: "var1"},
+				{Name: "var2"},
+			},
+			Cursor: parser.Identifier{Literal: "cur"},
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.Variable{Name: "var1"}},
+				parser.While{
+					Condition: parser.NewTernaryValueFromString("true"),
+					Statements: []parser.Statement{
+						parser.FlowControl{Token: parser.CONTINUE, Label: parser.Identifier{Literal: "lp"}},
+					},
+				},
+				parser.TransactionControl{Token: parser.COMMIT},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "\"1\"\n\"2\"\n\"3\"\n",
+	},
 	{
 		Name: "While In Cursor Break",
 		Stmt: parser.WhileInCursor{
@@ -1661,6 +1840,249 @@ func TestProcedure_WhileInCursor(t *testing.T) {
 	}
 }
 
+var procedureTryTests = []struct {
+	Name       string
+	Stmt       parser.Try
+	ResultFlow StatementFlow
+	Result     string
+	Error      string
+}{
+	{
+		Name: "Try",
+		Stmt: parser.Try{
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.NewIntegerValue(1)},
+			},
+			CatchStatements: []parser.Statement{
+				parser.Print{Value: parser.NewIntegerValue(2)},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "1\n",
+	},
+	{
+		Name: "Try Catch User Triggered Error",
+		Stmt: parser.Try{
+			Statements: []parser.Statement{
+				parser.Trigger{
+					Event:   parser.Identifier{Literal: "error"},
+					Message: parser.NewStringValue("user error"),
+					Code:    value.NewInteger(300),
+					Data:    parser.NewStringValue("payload"),
+				},
+				parser.Print{Value: parser.NewIntegerValue(1)},
+			},
+			CatchStatements: []parser.Statement{
+				parser.Print{Value: parser.Function{Name: "error_code"}},
+				parser.Print{Value: parser.Function{Name: "error_message"}},
+				parser.Print{Value: parser.Function{Name: "error_data"}},
+				parser.Print{Value: parser.Function{Name: "error_line"}},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "300\n\"user error\"\n\"payload\"\nNULL\n",
+	},
+	{
+		Name: "Try Catch Query Error",
+		Stmt: parser.Try{
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.FieldReference{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 2, Char: 8}), Column: parser.Identifier{Literal: "notexist"}}},
+			},
+			CatchStatements: []parser.Statement{
+				parser.Print{Value: parser.Function{Name: "error_message"}},
+				parser.Print{Value: parser.Function{Name: "error_line"}},
+				parser.Print{Value: parser.Function{Name: "error_char"}},
+				parser.Print{Value: parser.Function{Name: "error_data"}},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "\"field notexist does not exist\"\n2\n8\nNULL\n",
+	},
+	{
+		Name: "Try Return In Catch Statements",
+		Stmt: parser.Try{
+			Statements: []parser.Statement{
+				parser.Trigger{
+					Event: parser.Identifier{Literal: "error"},
+				},
+			},
+			CatchStatements: []parser.Statement{
+				parser.Return{Value: parser.Function{Name: "error_code"}},
+			},
+		},
+		ResultFlow: Return,
+	},
+	{
+		Name: "Try Error In Catch Statements",
+		Stmt: parser.Try{
+			Statements: []parser.Statement{
+				parser.Trigger{
+					Event:   parser.Identifier{Literal: "error"},
+					Message: parser.NewStringValue("user error"),
+				},
+			},
+			CatchStatements: []parser.Statement{
+				parser.Trigger{
+					Event:   parser.Identifier{Literal: "error"},
+					Message: parser.Function{Name: "error_message"},
+				},
+			},
+		},
+		Error: "[L:- C:-] user error",
+	},
+}
+
+func TestProcedure_Try(t *testing.T) {
+	cmd.GetFlags().SetQuiet(true)
+	proc := NewProcedure()
+
+	for _, v := range procedureTryTests {
+		oldStdout := Stdout
+
+		r, w, _ := os.Pipe()
+		Stdout = w
+
+		flow, err := proc.Try(v.Stmt)
+
+		w.Close()
+		Stdout = oldStdout
+
+		log, _ := ioutil.ReadAll(r)
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if flow != v.ResultFlow {
+			t.Errorf("%s: result flow = %d, want %d", v.Name, flow, v.ResultFlow)
+		}
+		if string(log) != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(log), v.Result)
+		}
+	}
+}
+
+var procedureExpectTests = []struct {
+	Name  string
+	Input string
+	Error string
+	Code  int
+}{
+	{
+		Name:  "Expect",
+		Input: "expect select 1 as a, 'x' as b union all select 2, null to equal select '1' as a, 'x' as b union all select 2, null",
+	},
+	{
+		Name:  "Expect Fields Not Match",
+		Input: "expect select 1 as a, 2 as b to equal select 1 as a, 2 as c",
+		Error: "[L:1 C:1] fields (a, b) do not equal the expected fields (a, c)",
+		Code:  2,
+	},
+	{
+		Name:  "Expect Record Count Not Match",
+		Input: "expect select 1 as a union all select 2 to equal select 1 as a",
+		Error: "[L:1 C:1] query returns 2 records, expected 1 record",
+		Code:  2,
+	},
+	{
+		Name:  "Expect Record Not Match",
+		Input: "expect select 1 as a, 'x' as b to equal select 1 as a, 'y' as b",
+		Error: "[L:1 C:1] record 1 is (1, \"x\"), expected (1, \"y\")",
+		Code:  2,
+	},
+	{
+		Name:  "Expect Query Error",
+		Input: "expect select notexist to equal select 1",
+		Error: "[L:1 C:15] field notexist does not exist",
+		Code:  1,
+	},
+}
+
+func TestProcedure_Expect(t *testing.T) {
+	proc := NewProcedure()
+
+	for _, v := range procedureExpectTests {
+		statements, _ := parser.Parse(v.Input, "")
+		err := proc.Expect(statements[0].(parser.Expect))
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			} else if code := err.(AppError).GetCode(); code != v.Code {
+				t.Errorf("%s: error code %d, want error code %d", v.Name, code, v.Code)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+}
+
+func TestProcedure_Import(t *testing.T) {
+	moduleDir, _ := ioutil.TempDir("", "csvq_module_test")
+	defer os.RemoveAll(moduleDir)
+	_ = ioutil.WriteFile(filepath.Join(moduleDir, "funcs.sql"), []byte("PRINT 'imported'; DECLARE imported FUNCTION () AS BEGIN RETURN 1; END;"), 0644)
+
+	_ = cmd.UpdateFlags(func(flags *cmd.Flags) error {
+		flags.SetSourcePath(moduleDir)
+		return nil
+	})
+	defer func() {
+		_ = cmd.UpdateFlags(func(flags *cmd.Flags) error {
+			flags.SetSourcePath("")
+			return nil
+		})
+	}()
+	ImportedModules = make(map[string]bool)
+	defer func() {
+		ImportedModules = make(map[string]bool)
+	}()
+
+	proc := NewProcedure()
+	child := proc.NewChildProcedure()
+	stmt := parser.Import{Module: parser.FieldReference{Column: parser.Identifier{Literal: "funcs"}}}
+
+	oldStdout := Stdout
+	r, w, _ := os.Pipe()
+	Stdout = w
+
+	_, err1 := child.ExecuteStatement(stmt)
+	_, err2 := child.ExecuteStatement(stmt)
+
+	w.Close()
+	Stdout = oldStdout
+	log, _ := ioutil.ReadAll(r)
+
+	if err1 != nil || err2 != nil {
+		t.Fatalf("unexpected error %v, %v", err1, err2)
+	}
+	if string(log) != "\"imported\"\n" {
+		t.Errorf("result = %q, want %q", string(log), "\"imported\"\n")
+	}
+	if _, err := proc.Filter.Functions.Get(parser.Identifier{Literal: "imported"}, "imported"); err != nil {
+		t.Errorf("function imported is not declared in the global scope")
+	}
+
+	_, err := proc.ExecuteStatement(parser.Import{Module: parser.NewStringValue("notexist")})
+	expectErr := "[L:- C:-] module notexist.sql is not found in the source path"
+	if err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error %q, want error %q", err.Error(), expectErr)
+	}
+}
+
 var procedureExecExternalCommand = []struct {
 	Name  string
 	Stmt  parser.ExternalCommand
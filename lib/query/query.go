@@ -3,30 +3,21 @@ package query
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
 )
 
 func FetchCursor(name parser.Identifier, fetchPosition parser.FetchPosition, vars []parser.Variable, filter *Filter) (bool, error) {
-	position := parser.NEXT
-	number := -1
-	if !fetchPosition.Position.IsEmpty() {
-		position = fetchPosition.Position.Token
-		if fetchPosition.Number != nil {
-			p, err := filter.Evaluate(fetchPosition.Number)
-			if err != nil {
-				return false, err
-			}
-			i := value.ToInteger(p)
-			if value.IsNull(i) {
-				return false, NewInvalidFetchPositionError(fetchPosition)
-			}
-			number = int(i.(value.Integer).Raw())
-		}
+	position, number, err := evalFetchPosition(fetchPosition, filter)
+	if err != nil {
+		return false, err
 	}
 
 	primaries, err := filter.Cursors.Fetch(name, position, number)
@@ -49,6 +40,63 @@ func FetchCursor(name parser.Identifier, fetchPosition parser.FetchPosition, var
 	return true, nil
 }
 
+// FetchCursorBulk fetches at most the specified number of records from the position,
+// and sets the records to the variable as an array of arrays.
+func FetchCursorBulk(name parser.Identifier, fetchPosition parser.FetchPosition, size parser.QueryExpression, variable parser.Variable, filter *Filter) (bool, error) {
+	p, err := filter.Evaluate(size)
+	if err != nil {
+		return false, err
+	}
+	i := value.ToInteger(p)
+	if value.IsNull(i) || i.(value.Integer).Raw() < 1 {
+		return false, NewInvalidFetchBulkSizeError(size)
+	}
+	bulkSize := int(i.(value.Integer).Raw())
+
+	position, number, err := evalFetchPosition(fetchPosition, filter)
+	if err != nil {
+		return false, err
+	}
+
+	records := make([]value.Primary, 0, bulkSize)
+	for len(records) < bulkSize {
+		primaries, err := filter.Cursors.Fetch(name, position, number)
+		if err != nil {
+			return false, err
+		}
+		if primaries == nil {
+			break
+		}
+		records = append(records, value.NewArray(primaries))
+		position = parser.NEXT
+	}
+
+	if _, err = filter.Variables.SubstituteDirectly(variable, value.NewArray(records)); err != nil {
+		return false, err
+	}
+	return 0 < len(records), nil
+}
+
+func evalFetchPosition(fetchPosition parser.FetchPosition, filter *Filter) (int, int, error) {
+	position := parser.NEXT
+	number := -1
+	if !fetchPosition.Position.IsEmpty() {
+		position = fetchPosition.Position.Token
+		if fetchPosition.Number != nil {
+			p, err := filter.Evaluate(fetchPosition.Number)
+			if err != nil {
+				return 0, 0, err
+			}
+			i := value.ToInteger(p)
+			if value.IsNull(i) {
+				return 0, 0, NewInvalidFetchPositionError(fetchPosition)
+			}
+			number = int(i.(value.Integer).Raw())
+		}
+	}
+	return position, number, nil
+}
+
 func DeclareView(expr parser.ViewDeclaration, filter *Filter) error {
 	if filter.TempViews.Exists(expr.View.Literal) {
 		return NewTemporaryTableRedeclaredError(expr.View)
@@ -90,6 +138,11 @@ func DeclareView(expr parser.ViewDeclaration, filter *Filter) error {
 		IsTemporary:      true,
 		InitialHeader:    view.Header.Copy(),
 		InitialRecordSet: view.RecordSet.Copy(),
+		Constraints:      expr.Constraints,
+	}
+
+	if err = view.CheckConstraints(expr.View, recordIndices(0, view.RecordLen()), filter); err != nil {
+		return err
 	}
 
 	filter.TempViews.Set(view)
@@ -112,23 +165,39 @@ func Select(query parser.SelectQuery, parentFilter *Filter) (*View, error) {
 	}
 
 	if query.OrderByClause != nil {
-		if err := view.OrderBy(query.OrderByClause.(parser.OrderByClause)); err != nil {
+		clause := query.OrderByClause.(parser.OrderByClause)
+		if err := measureStep(filter, view, func() *ExplainNode { return explainSortNode(clause) }, func() error {
+			if budget := cmd.GetFlags().SortMemory; 0 < budget && !isLimitedWithTies(query.LimitClause) {
+				return view.ExternalOrderBy(clause, budget)
+			}
+			return view.OrderBy(clause)
+		}); err != nil {
 			return nil, err
 		}
 	}
 
 	if query.OffsetClause != nil {
-		if err := view.Offset(query.OffsetClause.(parser.OffsetClause)); err != nil {
+		clause := query.OffsetClause.(parser.OffsetClause)
+		if err := measureStep(filter, view, func() *ExplainNode { return explainOffsetNode(clause) }, func() error {
+			return view.Offset(clause)
+		}); err != nil {
 			return nil, err
 		}
 	}
 
 	if query.LimitClause != nil {
-		if err := view.Limit(query.LimitClause.(parser.LimitClause)); err != nil {
+		clause := query.LimitClause.(parser.LimitClause)
+		if err := measureStep(filter, view, func() *ExplainNode { return explainLimitNode(clause) }, func() error {
+			return view.Limit(clause)
+		}); err != nil {
 			return nil, err
 		}
 	}
 
+	if query.WithClause != nil {
+		filter.profiler.group(&ExplainNode{Operation: "With"}, len(query.WithClause.(parser.WithClause).InlineTables)+1)
+	}
+
 	view.Fix()
 
 	return view, nil
@@ -137,37 +206,76 @@ func Select(query parser.SelectQuery, parentFilter *Filter) (*View, error) {
 func selectEntity(expr parser.QueryExpression, filter *Filter) (*View, error) {
 	entity, ok := expr.(parser.SelectEntity)
 	if !ok {
-		return selectSet(expr.(parser.SelectSet), filter)
+		set := expr.(parser.SelectSet)
+		return measureLoad(filter, func() *ExplainNode { return explainSetOperationNode(set) }, func() (*View, error) {
+			return selectSet(set, filter)
+		})
 	}
 
+	filter = filter.withHints(entity.SelectClause.(parser.SelectClause).Hints)
+
 	if entity.FromClause == nil {
 		entity.FromClause = parser.FromClause{}
 	}
+	lf, others, isFiltered := newLoadFilter(entity, filter)
+	if isFiltered {
+		filter.loadFilter = lf
+	}
+
 	view := NewView()
 	err := view.Load(entity.FromClause.(parser.FromClause), filter)
+	filter.loadFilter = nil
 	if err != nil {
 		return nil, err
 	}
 
+	if isFiltered && lf.isApplied {
+		entity.WhereClause = remainingWhereClause(entity.WhereClause.(parser.WhereClause), others)
+	}
+
 	if entity.WhereClause != nil {
-		if err := view.Where(entity.WhereClause.(parser.WhereClause)); err != nil {
+		clause := entity.WhereClause.(parser.WhereClause)
+
+		var index *TableIndex
+		_ = measureStep(filter, view, func() *ExplainNode {
+			if index == nil {
+				return nil
+			}
+			return &ExplainNode{Operation: "Index Lookup", Detail: index.Name.String()}
+		}, func() error {
+			index, _ = lookupIndex(view, clause, filter)
+			return nil
+		})
+
+		if err := measureStep(filter, view, func() *ExplainNode { return explainWhereNode(clause, view.Filter) }, func() error {
+			return view.Where(clause)
+		}); err != nil {
 			return nil, err
 		}
 	}
 
 	if entity.GroupByClause != nil {
-		if err := view.GroupBy(entity.GroupByClause.(parser.GroupByClause)); err != nil {
+		clause := entity.GroupByClause.(parser.GroupByClause)
+		if err := measureStep(filter, view, func() *ExplainNode { return explainGroupByNode(clause) }, func() error {
+			return view.GroupBy(clause)
+		}); err != nil {
 			return nil, err
 		}
 	}
 
 	if entity.HavingClause != nil {
-		if err := view.Having(entity.HavingClause.(parser.HavingClause)); err != nil {
+		clause := entity.HavingClause.(parser.HavingClause)
+		if err := measureStep(filter, view, func() *ExplainNode { return explainHavingNode(clause, view.Filter) }, func() error {
+			return view.Having(clause)
+		}); err != nil {
 			return nil, err
 		}
 	}
 
-	if err := view.Select(entity.SelectClause.(parser.SelectClause)); err != nil {
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	if err := measureStep(filter, view, func() *ExplainNode { return explainProjectNode(selectClause) }, func() error {
+		return view.Select(selectClause)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -284,11 +392,16 @@ func Insert(query parser.InsertQuery, parentFilter *Filter) (*FileInfo, int, err
 		return nil, insertRecords, err
 	}
 
+	if err = TableTriggers.CheckModification(query.Table.Object, view.FileInfo); err != nil {
+		return nil, insertRecords, err
+	}
+
 	fields := query.Fields
 	if fields == nil {
 		fields = view.Header.TableColumns()
 	}
 
+	recordLen := view.RecordLen()
 	if query.ValuesList != nil {
 		if insertRecords, err = view.InsertValues(fields, query.ValuesList); err != nil {
 			return nil, insertRecords, err
@@ -299,6 +412,15 @@ func Insert(query parser.InsertQuery, parentFilter *Filter) (*FileInfo, int, err
 		}
 	}
 
+	insertedRecords := view.RecordSet[recordLen:]
+	if err = TableTriggers.Fire(view, parser.BEFORE, parser.INSERT, nil, insertedRecords, filter); err != nil {
+		return nil, 0, err
+	}
+
+	if err = view.CheckConstraints(query.Table.Object, recordIndices(recordLen, view.RecordLen()), filter); err != nil {
+		return nil, 0, err
+	}
+
 	view.RestoreHeaderReferences()
 	view.Filter = nil
 
@@ -308,6 +430,10 @@ func Insert(query parser.InsertQuery, parentFilter *Filter) (*FileInfo, int, err
 		ViewCache.Replace(view)
 	}
 
+	if err = TableTriggers.Fire(view, parser.AFTER, parser.INSERT, nil, insertedRecords, filter); err != nil {
+		return nil, 0, err
+	}
+
 	return view.FileInfo, insertRecords, nil
 }
 
@@ -340,6 +466,7 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*FileInfo, []int,
 
 	viewsToUpdate := make(map[string]*View)
 	updatedCount := make(map[string]int)
+	tableExprs := make(map[string]parser.QueryExpression)
 	for _, v := range query.Tables {
 		table := v.(parser.Table)
 		fpath, err := filter.Aliases.Get(table.Name())
@@ -354,9 +481,15 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*FileInfo, []int,
 			viewsToUpdate[viewKey], _ = ViewCache.Get(parser.Identifier{Literal: fpath})
 		}
 		viewsToUpdate[viewKey].Header.Update(table.Name().Literal, nil)
+		tableExprs[viewKey] = table.Object
+
+		if err := TableTriggers.CheckModification(table.Object, viewsToUpdate[viewKey].FileInfo); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	updatesList := make(map[string]map[int][]int)
+	oldRecords := make(map[string]map[int]Record)
 	filterForLoop := NewFilterForSequentialEvaluation(view, filter)
 	for i := range view.RecordSet {
 		filterForLoop.Records[0].RecordIndex = i
@@ -398,6 +531,11 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*FileInfo, []int,
 			if _, ok := updatesList[viewref][internalId]; !ok {
 				updatesList[viewref][internalId] = []int{}
 				updatedCount[viewref]++
+
+				if _, ok := oldRecords[viewref]; !ok {
+					oldRecords[viewref] = make(map[int]Record)
+				}
+				oldRecords[viewref][internalId] = viewsToUpdate[viewref].RecordSet[internalId].Copy()
 			}
 			if InIntSlice(fieldIdx, updatesList[viewref][internalId]) {
 				return nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
@@ -409,7 +547,33 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*FileInfo, []int,
 
 	fileInfos := make([]*FileInfo, 0)
 	updateRecords := make([]int, 0)
+	triggerRecords := make(map[string][2][]Record)
+	for k, v := range viewsToUpdate {
+		updatedIndices := make([]int, 0, len(updatesList[k]))
+		for internalId := range updatesList[k] {
+			updatedIndices = append(updatedIndices, internalId)
+		}
+		sort.Ints(updatedIndices)
+
+		olds := make([]Record, len(updatedIndices))
+		news := make([]Record, len(updatedIndices))
+		for i, internalId := range updatedIndices {
+			olds[i] = oldRecords[k][internalId]
+			news[i] = v.RecordSet[internalId]
+		}
+		triggerRecords[k] = [2][]Record{olds, news}
+
+		if err := TableTriggers.Fire(v, parser.BEFORE, parser.UPDATE, olds, news, filter); err != nil {
+			return nil, nil, err
+		}
+
+		if err := v.CheckConstraints(tableExprs[k], updatedIndices, filter); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	for k, v := range viewsToUpdate {
+		v.FileInfo.UpdateFieldQuotes(updatesList[k])
 		v.RestoreHeaderReferences()
 
 		if v.FileInfo.IsTemporary {
@@ -422,6 +586,12 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*FileInfo, []int,
 		updateRecords = append(updateRecords, updatedCount[k])
 	}
 
+	for k, v := range viewsToUpdate {
+		if err := TableTriggers.Fire(v, parser.AFTER, parser.UPDATE, triggerRecords[k][0], triggerRecords[k][1], filter); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	return fileInfos, updateRecords, nil
 }
 
@@ -479,6 +649,10 @@ func Delete(query parser.DeleteQuery, parentFilter *Filter) ([]*FileInfo, []int,
 		}
 		viewsToDelete[viewKey].Header.Update(table.Name().Literal, nil)
 		deletedIndices[viewKey] = make(map[int]bool)
+
+		if err := TableTriggers.CheckModification(table.Object, viewsToDelete[viewKey].FileInfo); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	for i := range view.RecordSet {
@@ -493,6 +667,21 @@ func Delete(query parser.DeleteQuery, parentFilter *Filter) ([]*FileInfo, []int,
 		}
 	}
 
+	deletedRecords := make(map[string][]Record)
+	for k, v := range viewsToDelete {
+		olds := make([]Record, 0, len(deletedIndices[k]))
+		for i, record := range v.RecordSet {
+			if deletedIndices[k][i] {
+				olds = append(olds, record)
+			}
+		}
+		deletedRecords[k] = olds
+
+		if err := TableTriggers.Fire(v, parser.BEFORE, parser.DELETE, olds, nil, filter); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	fileInfos := make([]*FileInfo, 0)
 	deletedCounts := make([]int, 0)
 	for k, v := range viewsToDelete {
@@ -503,6 +692,8 @@ func Delete(query parser.DeleteQuery, parentFilter *Filter) ([]*FileInfo, []int,
 			}
 		}
 		v.RecordSet = records
+		v.FileInfo.DeleteLineBreaks(deletedIndices[k])
+		v.FileInfo.DeleteFieldQuotes(deletedIndices[k])
 
 		v.RestoreHeaderReferences()
 
@@ -516,9 +707,99 @@ func Delete(query parser.DeleteQuery, parentFilter *Filter) ([]*FileInfo, []int,
 		deletedCounts = append(deletedCounts, len(deletedIndices[k]))
 	}
 
+	for k, v := range viewsToDelete {
+		if err := TableTriggers.Fire(v, parser.AFTER, parser.DELETE, deletedRecords[k], nil, filter); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	return fileInfos, deletedCounts, nil
 }
 
+func Copy(query parser.Copy, parentFilter *Filter) (*FileInfo, int, error) {
+	filter := parentFilter.CreateNode()
+
+	var filename parser.Identifier
+	if ident, ok := query.File.(parser.Identifier); ok {
+		filename = ident
+	} else if fr, ok := query.File.(parser.FieldReference); ok && len(fr.View.Literal) < 1 {
+		filename = fr.Column
+	} else {
+		p, err := filter.Evaluate(query.File)
+		if err != nil {
+			return nil, 0, err
+		}
+		s := value.ToString(p)
+		if value.IsNull(s) {
+			return nil, 0, NewCopyInvalidFilePathError(query, query.File)
+		}
+		filename = parser.Identifier{BaseExpr: query.GetBaseExpr(), Literal: s.(value.String).Raw()}
+	}
+	if len(filename.Literal) < 1 {
+		return nil, 0, NewCopyInvalidFilePathError(query, query.File)
+	}
+
+	fileInfo, err := NewFileInfoForCreate(filename, cmd.GetFlags().Repository, ',', text.UTF8)
+	if err != nil {
+		return nil, 0, err
+	}
+	fileInfo.LineBreak = text.LF
+
+	withBOM := false
+	for _, attr := range query.Attributes {
+		setAttr := parser.SetTableAttribute{BaseExpr: attr.BaseExpr, Table: filename, Attribute: attr.Attribute, Value: attr.Value}
+		if strings.ToUpper(attr.Attribute.Literal) == TableEncoding {
+			p, err := tableAttributeValue(setAttr, filter)
+			if err != nil {
+				return nil, 0, err
+			}
+			s := value.ToString(p)
+			withBOM = !value.IsNull(s) && strings.ToUpper(s.(value.String).Raw()) == CopyEncodingUTF8M
+			if withBOM {
+				fileInfo.Encoding = text.UTF8
+				continue
+			}
+		}
+
+		err = setTableAttribute(fileInfo, setAttr, filter)
+		if err != nil {
+			if _, ok := err.(*TableAttributeUnchangedError); !ok {
+				return nil, 0, err
+			}
+		}
+	}
+
+	view, err := Select(query.Query.(parser.SelectQuery), filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	h, err := file.NewHandlerForCreate(fileInfo.Path)
+	if err != nil {
+		return nil, 0, NewFileAlreadyExistError(filename)
+	}
+	fileInfo.Handler = h
+
+	if withBOM {
+		if _, err = h.FileForUpdate().Write([]byte(ByteOrderMark)); err != nil {
+			fileInfo.Close()
+			return nil, 0, NewWriteFileError(filename, err.Error())
+		}
+	}
+	if err = EncodeView(h.FileForUpdate(), view, fileInfo); err != nil {
+		if _, ok := err.(*EmptyResultSetError); !ok {
+			fileInfo.Close()
+			return nil, 0, NewWriteFileError(filename, err.Error())
+		}
+	}
+	if err = fileInfo.Commit(); err != nil {
+		fileInfo.Close()
+		return nil, 0, NewWriteFileError(filename, err.Error())
+	}
+
+	return fileInfo, view.RecordLen(), nil
+}
+
 func CreateTable(query parser.CreateTable, parentFilter *Filter) (*FileInfo, error) {
 	filter := parentFilter.CreateNode()
 
@@ -541,6 +822,16 @@ func CreateTable(query parser.CreateTable, parentFilter *Filter) (*FileInfo, err
 	fileInfo.NoHeader = flags.WithoutHeader
 	fileInfo.PrettyPrint = flags.PrettyPrint
 
+	for _, attr := range query.Attributes {
+		err = setTableAttribute(fileInfo, parser.SetTableAttribute{BaseExpr: attr.BaseExpr, Table: query.Table, Attribute: attr.Attribute, Value: attr.Value}, filter)
+		if err != nil {
+			if _, ok := err.(*TableAttributeUnchangedError); !ok {
+				fileInfo.Close()
+				return nil, err
+			}
+		}
+	}
+
 	if query.Query != nil {
 		view, err = Select(query.Query.(parser.SelectQuery), filter)
 		if err != nil {
@@ -572,9 +863,15 @@ func CreateTable(query parser.CreateTable, parentFilter *Filter) (*FileInfo, err
 		}
 	}
 
+	fileInfo.Constraints = query.Constraints
 	view.FileInfo = fileInfo
 	view.ForUpdate = true
 
+	if err = view.CheckConstraints(query.Table, recordIndices(0, view.RecordLen()), filter); err != nil {
+		fileInfo.Close()
+		return nil, err
+	}
+
 	ViewCache.Set(view)
 
 	return view.FileInfo, nil
@@ -619,12 +916,18 @@ func AddColumns(query parser.AddColumns, parentFilter *Filter) (*FileInfo, int,
 	columnNames := view.Header.TableColumnNames()
 	fields := make([]string, len(query.Columns))
 	defaults := make([]parser.QueryExpression, len(query.Columns))
+	converters := make([]func(value.Primary) (value.Primary, bool), len(query.Columns))
 	for i, coldef := range query.Columns {
 		if InStrSliceWithCaseInsensitive(coldef.Column.Literal, columnNames) || InStrSliceWithCaseInsensitive(coldef.Column.Literal, fields) {
 			return nil, 0, NewDuplicateFieldNameError(coldef.Column)
 		}
 		fields[i] = coldef.Column.Literal
 		defaults[i] = coldef.Value
+		if 0 < len(coldef.Type.Literal) {
+			if converters[i], err = columnTypeConverter(coldef.Type); err != nil {
+				return nil, 0, err
+			}
+		}
 	}
 	newFieldLen := view.FieldLen() + len(query.Columns)
 
@@ -670,6 +973,12 @@ func AddColumns(query parser.AddColumns, parentFilter *Filter) (*FileInfo, int,
 			if e != nil {
 				return e
 			}
+			if converters[i] != nil {
+				var ok bool
+				if val, ok = converters[i](val); !ok {
+					return NewColumnTypeConversionError(query.Columns[i].Type, query.Columns[i].Column.String(), []int{rIdx + 1})
+				}
+			}
 			record[i+insertPos] = NewCell(val)
 		}
 		records[rIdx] = record
@@ -682,6 +991,7 @@ func AddColumns(query parser.AddColumns, parentFilter *Filter) (*FileInfo, int,
 	view.Header = header
 	view.RecordSet = records
 	view.Filter = nil
+	view.FileInfo.InsertFieldQuotes(insertPos, len(fields))
 
 	if view.FileInfo.IsTemporary {
 		filter.TempViews.Replace(view)
@@ -719,6 +1029,7 @@ func DropColumns(query parser.DropColumns, parentFilter *Filter) (*FileInfo, int
 	}
 
 	view.Fix()
+	view.FileInfo.DropFieldQuotes(dropIndices)
 
 	if view.FileInfo.IsTemporary {
 		filter.TempViews.Replace(view)
@@ -752,6 +1063,61 @@ func RenameColumn(query parser.RenameColumn, parentFilter *Filter) (*FileInfo, e
 
 	view.Header[idx].Column = query.New.Literal
 	view.Filter = nil
+	view.FileInfo.RenameFieldQuote(idx)
+
+	if view.FileInfo.IsTemporary {
+		filter.TempViews.Replace(view)
+	} else {
+		ViewCache.Replace(view)
+	}
+
+	return view.FileInfo, nil
+}
+
+func SetColumnType(query parser.SetColumnType, parentFilter *Filter) (*FileInfo, error) {
+	filter := parentFilter.CreateNode()
+
+	convert, err := columnTypeConverter(query.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	view := NewView()
+	view.ForUpdate = true
+	err = view.LoadFromTableIdentifier(query.Table, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	idx, err := view.FieldIndex(query.Column)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(RecordSet, view.RecordLen())
+	updated := make(map[int][]int, view.RecordLen())
+	var failedRows []int
+	for i, record := range view.RecordSet {
+		val, ok := convert(record[idx].Value())
+		if !ok {
+			failedRows = append(failedRows, i+1)
+			continue
+		}
+		records[i] = record.Copy()
+		records[i][idx] = NewCell(val)
+		updated[i] = []int{idx}
+	}
+	if 0 < len(failedRows) {
+		return nil, NewColumnTypeConversionError(query.Type, query.Column.String(), failedRows)
+	}
+
+	view.RecordSet = records
+	view.Filter = nil
+
+	if err = view.CheckConstraints(query.Table, recordIndices(0, view.RecordLen()), filter); err != nil {
+		return nil, err
+	}
+	view.FileInfo.UpdateFieldQuotes(updated)
 
 	if view.FileInfo.IsTemporary {
 		filter.TempViews.Replace(view)
@@ -762,6 +1128,28 @@ func RenameColumn(query parser.RenameColumn, parentFilter *Filter) (*FileInfo, e
 	return view.FileInfo, nil
 }
 
+var columnTypes = map[string]func(parser.Function, []value.Primary) (value.Primary, error){
+	"STRING":   String,
+	"INTEGER":  Integer,
+	"FLOAT":    Float,
+	"BOOLEAN":  Boolean,
+	"DATETIME": Datetime,
+}
+
+// columnTypeConverter returns the function to convert values to the column type.
+// The function reports false if a value that is not null cannot be converted.
+func columnTypeConverter(columnType parser.Identifier) (func(value.Primary) (value.Primary, bool), error) {
+	fn, ok := columnTypes[strings.ToUpper(columnType.Literal)]
+	if !ok {
+		return nil, NewInvalidColumnTypeError(columnType)
+	}
+
+	return func(p value.Primary) (value.Primary, bool) {
+		converted, _ := fn(parser.Function{Name: columnType.Literal}, []value.Primary{p})
+		return converted, value.IsNull(p) || !value.IsNull(converted)
+	}, nil
+}
+
 func SetTableAttribute(query parser.SetTableAttribute, parentFilter *Filter) (*FileInfo, string, error) {
 	var log string
 	filter := parentFilter.CreateNode()
@@ -776,23 +1164,56 @@ func SetTableAttribute(query parser.SetTableAttribute, parentFilter *Filter) (*F
 		return nil, log, NewNotTableError(query.Table)
 	}
 
-	var p value.Primary
+	fileInfo := view.FileInfo
+	if err = setTableAttribute(fileInfo, query, filter); err != nil {
+		return nil, log, err
+	}
+
+	w := NewObjectWriter()
+	w.WriteColorWithoutLineBreak("Path: ", cmd.LableEffect)
+	w.WriteColorWithoutLineBreak(fileInfo.Path, cmd.ObjectEffect)
+	w.NewLine()
+	writeTableAttribute(w, fileInfo)
+	w.NewLine()
+
+	w.Title1 = "Attributes Updated in"
+	w.Title2 = query.Table.(parser.Identifier).Literal
+	w.Title2Effect = cmd.IdentifierEffect
+	log = "\n" + w.String() + "\n"
+
+	ViewCache.Replace(view)
+
+	return view.FileInfo, log, nil
+}
+
+func tableAttributeValue(query parser.SetTableAttribute, filter *Filter) (value.Primary, error) {
+	if query.Value == nil {
+		return value.NewBoolean(true), nil
+	}
 	if ident, ok := query.Value.(parser.Identifier); ok {
-		p = value.NewString(ident.Literal)
-	} else {
-		p, err = filter.Evaluate(query.Value)
-		if err != nil {
-			return nil, log, err
-		}
+		return value.NewString(ident.Literal), nil
+	}
+	if fr, ok := query.Value.(parser.FieldReference); ok && len(fr.View.Literal) < 1 {
+		return value.NewString(fr.Column.Literal), nil
+	}
+	return filter.Evaluate(query.Value)
+}
+
+func setTableAttribute(fileInfo *FileInfo, query parser.SetTableAttribute, filter *Filter) error {
+	p, err := tableAttributeValue(query, filter)
+	if err != nil {
+		return err
 	}
 
-	fileInfo := view.FileInfo
 	attr := strings.ToUpper(query.Attribute.Literal)
+	if attr == TablePretty {
+		attr = TablePrettyPrint
+	}
 	switch attr {
 	case TableDelimiter, TableFormat, TableEncoding, TableLineBreak, TableJsonEscape:
 		s := value.ToString(p)
 		if value.IsNull(s) {
-			return nil, log, NewTableAttributeValueNotAllowedFormatError(query)
+			return NewTableAttributeValueNotAllowedFormatError(query)
 		}
 		switch attr {
 		case TableDelimiter:
@@ -809,7 +1230,7 @@ func SetTableAttribute(query parser.SetTableAttribute, parentFilter *Filter) (*F
 	case TableHeader, TableEncloseAll, TablePrettyPrint:
 		b := value.ToBoolean(p)
 		if value.IsNull(b) {
-			return nil, log, NewTableAttributeValueNotAllowedFormatError(query)
+			return NewTableAttributeValueNotAllowedFormatError(query)
 		}
 		switch attr {
 		case TableHeader:
@@ -820,31 +1241,16 @@ func SetTableAttribute(query parser.SetTableAttribute, parentFilter *Filter) (*F
 			err = fileInfo.SetPrettyPrint(b.(value.Boolean).Raw())
 		}
 	default:
-		return nil, log, NewInvalidTableAttributeNameError(query.Attribute)
+		return NewInvalidTableAttributeNameError(query.Attribute)
 	}
 
 	if err != nil {
 		if _, ok := err.(*TableAttributeUnchangedError); ok {
-			return nil, log, err
+			return err
 		}
-		return nil, log, NewInvalidTableAttributeValueError(query, err.Error())
+		return NewInvalidTableAttributeValueError(query, err.Error())
 	}
-
-	w := NewObjectWriter()
-	w.WriteColorWithoutLineBreak("Path: ", cmd.LableEffect)
-	w.WriteColorWithoutLineBreak(fileInfo.Path, cmd.ObjectEffect)
-	w.NewLine()
-	writeTableAttribute(w, fileInfo)
-	w.NewLine()
-
-	w.Title1 = "Attributes Updated in"
-	w.Title2 = query.Table.(parser.Identifier).Literal
-	w.Title2Effect = cmd.IdentifierEffect
-	log = "\n" + w.String() + "\n"
-
-	ViewCache.Replace(view)
-
-	return view.FileInfo, log, nil
+	return nil
 }
 
 func Commit(expr parser.Expression, filter *Filter) error {
@@ -870,6 +1276,10 @@ func Commit(expr parser.Expression, filter *Filter) error {
 	}
 
 	if 0 < len(updatedFiles) {
+		if err := checkGitModifications(expr, updatedFiles); err != nil {
+			return err
+		}
+
 		for _, fileinfo := range updatedFiles {
 			view, _ := ViewCache.Get(parser.Identifier{Literal: fileinfo.Path})
 
@@ -881,6 +1291,12 @@ func Commit(expr parser.Expression, filter *Filter) error {
 				return NewCommitError(expr, err.Error())
 			}
 
+			if view.FileInfo.IsModified() {
+				if err := ResolveConflict(expr, view.FileInfo); err != nil {
+					return err
+				}
+			}
+
 			updateFileInfo = append(updateFileInfo, view.FileInfo)
 		}
 	}
@@ -890,14 +1306,14 @@ func Commit(expr parser.Expression, filter *Filter) error {
 			return NewCommitError(expr, err.Error())
 		}
 		UncommittedViews.Unset(f)
-		LogNotice(fmt.Sprintf("Commit: file %q is created.", f.Path), cmd.GetFlags().Quiet)
+		LogNotice(fmt.Sprintf(cmd.Message("Commit: file %q is created."), f.Path), cmd.GetFlags().Quiet)
 	}
 	for _, f := range updateFileInfo {
 		if err := f.Commit(); err != nil {
 			return NewCommitError(expr, err.Error())
 		}
 		UncommittedViews.Unset(f)
-		LogNotice(fmt.Sprintf("Commit: file %q is updated.", f.Path), cmd.GetFlags().Quiet)
+		LogNotice(fmt.Sprintf(cmd.Message("Commit: file %q is updated."), f.Path), cmd.GetFlags().Quiet)
 	}
 
 	filter.TempViews.Store(UncommittedViews.UncommittedTempViews())
@@ -908,18 +1324,40 @@ func Commit(expr parser.Expression, filter *Filter) error {
 	return nil
 }
 
+// checkGitModifications checks that the files to be updated have no uncommitted
+// modifications in git, according to the git-check option.
+func checkGitModifications(expr parser.Expression, files map[string]*FileInfo) error {
+	flags := cmd.GetFlags()
+	if flags.GitCheck == cmd.GitCheckNone {
+		return nil
+	}
+
+	for _, fileinfo := range files {
+		if !file.IsModifiedInGit(fileinfo.Path) {
+			continue
+		}
+
+		message := errorMessage(ErrorFileModifiedInGit, fileinfo.Path)
+		if flags.GitCheck == cmd.GitCheckRefuse {
+			return NewCommitError(expr, message)
+		}
+		LogWarn(fmt.Sprintf(cmd.Message("Commit: %s."), message), flags.Quiet)
+	}
+	return nil
+}
+
 func Rollback(expr parser.Expression, filter *Filter) error {
 	createdFiles, updatedFiles := UncommittedViews.UncommittedFiles()
 
 	if 0 < len(createdFiles) {
 		for _, fileinfo := range createdFiles {
-			LogNotice(fmt.Sprintf("Rollback: file %q is deleted.", fileinfo.Path), cmd.GetFlags().Quiet)
+			LogNotice(fmt.Sprintf(cmd.Message("Rollback: file %q is deleted."), fileinfo.Path), cmd.GetFlags().Quiet)
 		}
 	}
 
 	if 0 < len(updatedFiles) {
 		for _, fileinfo := range updatedFiles {
-			LogNotice(fmt.Sprintf("Rollback: file %q is restored.", fileinfo.Path), cmd.GetFlags().Quiet)
+			LogNotice(fmt.Sprintf(cmd.Message("Rollback: file %q is restored."), fileinfo.Path), cmd.GetFlags().Quiet)
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -202,6 +203,120 @@ func TestFetchCursor(t *testing.T) {
 	}
 }
 
+var fetchCursorBulkTests = []struct {
+	Name          string
+	CurName       parser.Identifier
+	FetchPosition parser.FetchPosition
+	Size          parser.QueryExpression
+	Success       bool
+	Result        value.Primary
+	Error         string
+}{
+	{
+		Name:    "Fetch Cursor Bulk",
+		CurName: parser.Identifier{Literal: "cur"},
+		Size:    parser.NewIntegerValue(2),
+		Success: true,
+		Result: value.NewArray([]value.Primary{
+			value.NewArray([]value.Primary{value.NewString("1"), value.NewString("str1")}),
+			value.NewArray([]value.Primary{value.NewString("2"), value.NewString("str2")}),
+		}),
+	},
+	{
+		Name:    "Fetch Cursor Bulk Less than Size",
+		CurName: parser.Identifier{Literal: "cur"},
+		Size:    parser.NewIntegerValue(2),
+		Success: true,
+		Result: value.NewArray([]value.Primary{
+			value.NewArray([]value.Primary{value.NewString("3"), value.NewString("str3")}),
+		}),
+	},
+	{
+		Name:    "Fetch Cursor Bulk No Record",
+		CurName: parser.Identifier{Literal: "cur"},
+		Size:    parser.NewIntegerValue(2),
+		Success: false,
+		Result:  value.NewArray([]value.Primary{}),
+	},
+	{
+		Name:    "Fetch Cursor Bulk with Position",
+		CurName: parser.Identifier{Literal: "cur"},
+		FetchPosition: parser.FetchPosition{
+			Position: parser.Token{Token: parser.ABSOLUTE, Literal: "absolute"},
+			Number:   parser.NewIntegerValue(1),
+		},
+		Size:    parser.NewIntegerValue(5),
+		Success: true,
+		Result: value.NewArray([]value.Primary{
+			value.NewArray([]value.Primary{value.NewString("2"), value.NewString("str2")}),
+			value.NewArray([]value.Primary{value.NewString("3"), value.NewString("str3")}),
+		}),
+	},
+	{
+		Name:    "Fetch Cursor Bulk Invalid Size Error",
+		CurName: parser.Identifier{Literal: "cur"},
+		Size:    parser.NewIntegerValue(0),
+		Error:   "[L:- C:-] fetching bulk size 0 is not a positive integer value",
+	},
+	{
+		Name:    "Fetch Cursor Bulk Closed Error",
+		CurName: parser.Identifier{Literal: "cur2"},
+		Size:    parser.NewIntegerValue(2),
+		Error:   "[L:- C:-] cursor cur2 is closed",
+	},
+}
+
+func TestFetchCursorBulk(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	filter := NewFilter(
+		[]VariableMap{
+			GenerateVariableMap(map[string]value.Primary{
+				"rows": value.NewNull(),
+			}),
+		},
+		[]ViewMap{{}},
+		[]CursorMap{
+			{
+				"CUR": &Cursor{
+					query: selectQueryForCursorTest,
+				},
+				"CUR2": &Cursor{
+					query: selectQueryForCursorTest,
+				},
+			},
+		},
+		[]UserDefinedFunctionMap{{}},
+	)
+
+	ViewCache.Clean()
+	filter.Cursors.Open(parser.Identifier{Literal: "cur"}, filter)
+
+	for _, v := range fetchCursorBulkTests {
+		success, err := FetchCursorBulk(v.CurName, v.FetchPosition, v.Size, parser.Variable{Name: "rows"}, filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if success != v.Success {
+			t.Errorf("%s: success = %t, want %t", v.Name, success, v.Success)
+		}
+		result, _ := filter.Variables.Get(parser.Variable{Name: "rows"})
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
 var declareViewTests = []struct {
 	Name    string
 	ViewMap ViewMap
@@ -346,6 +461,53 @@ var declareViewTests = []struct {
 		},
 		Error: "[L:- C:-] field name column1 is a duplicate",
 	},
+	{
+		Name: "Declare View From Query Check Constraint Error",
+		Expr: parser.ViewDeclaration{
+			View: parser.Identifier{Literal: "tbl"},
+			Fields: []parser.QueryExpression{
+				parser.Identifier{Literal: "column1"},
+				parser.Identifier{Literal: "column2"},
+			},
+			Constraints: []parser.QueryExpression{
+				parser.CheckConstraint{
+					Condition: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						Operator: ">",
+						RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					},
+				},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewIntegerValueFromString("1")},
+							parser.Field{Object: parser.NewIntegerValueFromString("2")},
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] CHECK (column1 > column2) of table tbl is violated by values (1, 2)",
+	},
+	{
+		Name: "Declare View Unique Constraint Field Not Exist Error",
+		Expr: parser.ViewDeclaration{
+			View: parser.Identifier{Literal: "tbl"},
+			Fields: []parser.QueryExpression{
+				parser.Identifier{Literal: "column1"},
+			},
+			Constraints: []parser.QueryExpression{
+				parser.UniqueConstraint{
+					Columns: []parser.QueryExpression{
+						parser.Identifier{Literal: "notexist"},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
 	{
 		Name: "Declare View Redeclaration Error",
 		ViewMap: ViewMap{
@@ -2518,6 +2680,70 @@ var createTableTests = []struct {
 		},
 		Error: "[L:- C:-] field name column1 is a duplicate",
 	},
+	{
+		Name: "Create Table With Attributes",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Attributes: []parser.TableAttribute{
+				{Attribute: parser.Identifier{Literal: "format"}, Value: parser.Identifier{Literal: "tsv"}},
+				{Attribute: parser.Identifier{Literal: "header"}, Value: parser.NewTernaryValueFromString("false")},
+				{Attribute: parser.Identifier{Literal: "line_break"}, Value: parser.NewStringValue("CRLF")},
+			},
+			Fields: []parser.QueryExpression{
+				parser.Identifier{Literal: "column1"},
+				parser.Identifier{Literal: "column2"},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("create_table_1.csv"),
+			Format:    cmd.TSV,
+			Delimiter: '\t',
+			NoHeader:  true,
+			Encoding:  text.UTF8,
+			LineBreak: text.CRLF,
+		},
+		ViewCache: ViewMap{
+			strings.ToUpper(GetTestFilePath("create_table_1.csv")): &View{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("create_table_1.csv"),
+					Format:    cmd.TSV,
+					Delimiter: '\t',
+					NoHeader:  true,
+					Encoding:  text.UTF8,
+					LineBreak: text.CRLF,
+				},
+				Header:    NewHeader("create_table_1", []string{"column1", "column2"}),
+				RecordSet: RecordSet{},
+				ForUpdate: true,
+			},
+		},
+	},
+	{
+		Name: "Create Table Invalid Attribute Error",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Attributes: []parser.TableAttribute{
+				{Attribute: parser.Identifier{Literal: "notexist"}, Value: parser.NewStringValue("1")},
+			},
+			Fields: []parser.QueryExpression{
+				parser.Identifier{Literal: "column1"},
+			},
+		},
+		Error: "[L:- C:-] table attribute notexist does not exist",
+	},
+	{
+		Name: "Create Table Invalid Attribute Value Error",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Attributes: []parser.TableAttribute{
+				{Attribute: parser.Identifier{Literal: "format"}, Value: parser.NewStringValue("notexist")},
+			},
+			Fields: []parser.QueryExpression{
+				parser.Identifier{Literal: "column1"},
+			},
+		},
+		Error: "[L:- C:-] format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT",
+	},
 }
 
 func TestCreateTable(t *testing.T) {
@@ -2567,6 +2793,180 @@ func TestCreateTable(t *testing.T) {
 	ReleaseResources()
 }
 
+var copyTestQuery = parser.SelectQuery{
+	SelectEntity: parser.SelectEntity{
+		SelectClause: parser.SelectClause{
+			Fields: []parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+			},
+		},
+		FromClause: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			},
+		},
+	},
+	LimitClause: parser.LimitClause{
+		Value: parser.NewIntegerValueFromString("2"),
+	},
+}
+
+var copyTests = []struct {
+	Name    string
+	Query   parser.Copy
+	Path    string
+	Count   int
+	Content string
+	Error   string
+}{
+	{
+		Name: "Copy",
+		Query: parser.Copy{
+			Query: copyTestQuery,
+			File:  parser.FieldReference{Column: parser.Identifier{Literal: "copy_1.csv"}},
+		},
+		Path:    GetTestFilePath("copy_1.csv"),
+		Count:   2,
+		Content: "column1,column2\n1,str1\n2,str2",
+	},
+	{
+		Name: "Copy With Attributes",
+		Query: parser.Copy{
+			Query: copyTestQuery,
+			File:  parser.NewStringValue("copy_1.txt"),
+			Attributes: []parser.TableAttribute{
+				{Attribute: parser.Identifier{Literal: "format"}, Value: parser.Identifier{Literal: "tsv"}},
+				{Attribute: parser.Identifier{Literal: "header"}, Value: parser.NewTernaryValueFromString("false")},
+				{Attribute: parser.Identifier{Literal: "line_break"}, Value: parser.NewStringValue("crlf")},
+			},
+		},
+		Path:    GetTestFilePath("copy_1.txt"),
+		Count:   2,
+		Content: "1\tstr1\r\n2\tstr2",
+	},
+	{
+		Name: "Copy With UTF8M Encoding",
+		Query: parser.Copy{
+			Query: copyTestQuery,
+			File:  parser.Identifier{Literal: "copy_1.txt"},
+			Attributes: []parser.TableAttribute{
+				{Attribute: parser.Identifier{Literal: "format"}, Value: parser.Identifier{Literal: "tsv"}},
+				{Attribute: parser.Identifier{Literal: "encoding"}, Value: parser.NewStringValue("UTF8M")},
+				{Attribute: parser.Identifier{Literal: "line_break"}, Value: parser.NewStringValue("CRLF")},
+			},
+		},
+		Path:    GetTestFilePath("copy_1.txt"),
+		Count:   2,
+		Content: "\uFEFFcolumn1\tcolumn2\r\n1\tstr1\r\n2\tstr2",
+	},
+	{
+		Name: "Copy Empty Result Set",
+		Query: parser.Copy{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewIntegerValueFromString("1"), Alias: parser.Identifier{Literal: "c1"}},
+						},
+					},
+					WhereClause: parser.WhereClause{
+						Filter: parser.NewTernaryValueFromString("false"),
+					},
+				},
+			},
+			File: parser.Identifier{Literal: "copy_1.csv"},
+		},
+		Path:    GetTestFilePath("copy_1.csv"),
+		Count:   0,
+		Content: "c1",
+	},
+	{
+		Name: "Copy Invalid File Path Error",
+		Query: parser.Copy{
+			Query: copyTestQuery,
+			File:  parser.NewNullValue(),
+		},
+		Error: "[L:- C:-] NULL is a invalid file path",
+	},
+	{
+		Name: "Copy File Already Exist Error",
+		Query: parser.Copy{
+			Query: copyTestQuery,
+			File:  parser.Identifier{Literal: "table1.csv"},
+		},
+		Error: "[L:- C:-] file table1.csv already exists",
+	},
+	{
+		Name: "Copy Invalid Attribute Error",
+		Query: parser.Copy{
+			Query: copyTestQuery,
+			File:  parser.Identifier{Literal: "copy_1.csv"},
+			Attributes: []parser.TableAttribute{
+				{Attribute: parser.Identifier{Literal: "invalid"}, Value: parser.NewStringValue("a")},
+			},
+		},
+		Error: "[L:- C:-] table attribute invalid does not exist",
+	},
+	{
+		Name: "Copy Query Error",
+		Query: parser.Copy{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+						},
+					},
+				},
+			},
+			File: parser.Identifier{Literal: "copy_1.csv"},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+}
+
+func TestCopy(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.Quiet = false
+
+	for _, v := range copyTests {
+		ReleaseResources()
+
+		result, cnt, err := Copy(v.Query, NewEmptyFilter())
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if result.Path != v.Path {
+			t.Errorf("%s: path = %q, want %q", v.Name, result.Path, v.Path)
+		}
+		if cnt != v.Count {
+			t.Errorf("%s: count = %d, want %d", v.Name, cnt, v.Count)
+		}
+
+		buf, err := ioutil.ReadFile(v.Path)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+		} else if string(buf) != v.Content {
+			t.Errorf("%s: content = %q, want %q", v.Name, string(buf), v.Content)
+		}
+		_ = os.Remove(v.Path)
+	}
+	ReleaseResources()
+}
+
 var addColumnsTests = []struct {
 	Name         string
 	Query        parser.AddColumns
@@ -2925,9 +3325,87 @@ var addColumnsTests = []struct {
 		},
 		Error: "[L:- C:-] field notexist does not exist",
 	},
-}
-
-func TestAddColumns(t *testing.T) {
+	{
+		Name: "Add Fields With Type",
+		Query: parser.AddColumns{
+			Table: parser.Identifier{Literal: "table1.csv"},
+			Columns: []parser.ColumnDefault{
+				{
+					Column: parser.Identifier{Literal: "column3"},
+					Type:   parser.Identifier{Literal: "integer"},
+					Value:  parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+		},
+		UpdateCount: 1,
+		ViewCache: ViewMap{
+			strings.ToUpper(GetTestFilePath("table1.csv")): &View{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("table1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+				},
+				Header: NewHeader("table1", []string{"column1", "column2", "column3"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("str1"),
+						value.NewInteger(1),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str2"),
+						value.NewInteger(2),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("str3"),
+						value.NewInteger(3),
+					}),
+				},
+				ForUpdate: true,
+			},
+		},
+	},
+	{
+		Name: "Add Fields Invalid Type Error",
+		Query: parser.AddColumns{
+			Table: parser.Identifier{Literal: "table1.csv"},
+			Columns: []parser.ColumnDefault{
+				{
+					Column: parser.Identifier{Literal: "column3"},
+					Type:   parser.Identifier{Literal: "number"},
+				},
+			},
+		},
+		Error: "[L:- C:-] number is an unknown column type",
+	},
+	{
+		Name: "Add Fields Type Conversion Error",
+		Query: parser.AddColumns{
+			Table: parser.Identifier{Literal: "table1.csv"},
+			Columns: []parser.ColumnDefault{
+				{
+					Column: parser.Identifier{Literal: "column3"},
+					Type:   parser.Identifier{Literal: "integer"},
+					Value:  parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				},
+			},
+		},
+		Error: "[L:- C:-] field column3 cannot be converted to INTEGER at row 1",
+	},
+}
+
+func TestAddColumns(t *testing.T) {
 	tf := cmd.GetFlags()
 	tf.Repository = TestDir
 	tf.Quiet = false
@@ -3376,6 +3854,203 @@ func TestRenameColumn(t *testing.T) {
 	ReleaseResources()
 }
 
+var setColumnTypeTests = []struct {
+	Name         string
+	Query        parser.SetColumnType
+	Result       *FileInfo
+	ViewCache    ViewMap
+	TempViewList TemporaryViewScopes
+	Error        string
+}{
+	{
+		Name: "Set Column Type",
+		Query: parser.SetColumnType{
+			Table:  parser.Identifier{Literal: "table1"},
+			Column: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			Type:   parser.Identifier{Literal: "integer"},
+		},
+		Result: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+		},
+		ViewCache: ViewMap{
+			strings.ToUpper(GetTestFilePath("table1.csv")): &View{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("table1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+				},
+				Header: NewHeader("table1", []string{"column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+						value.NewString("str1"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(2),
+						value.NewString("str2"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(3),
+						value.NewString("str3"),
+					}),
+				},
+				ForUpdate: true,
+			},
+		},
+	},
+	{
+		Name: "Set Column Type For Temporary View",
+		Query: parser.SetColumnType{
+			Table:  parser.Identifier{Literal: "tmpview"},
+			Column: parser.ColumnNumber{View: parser.Identifier{Literal: "tmpview"}, Number: value.NewInteger(1)},
+			Type:   parser.Identifier{Literal: "float"},
+		},
+		Result: &FileInfo{
+			Path:        "tmpview",
+			Delimiter:   ',',
+			IsTemporary: true,
+		},
+		TempViewList: TemporaryViewScopes{
+			ViewMap{
+				"TMPVIEW": &View{
+					Header: NewHeader("tmpview", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						NewRecord([]value.Primary{
+							value.NewFloat(1),
+							value.NewString("str1"),
+						}),
+						NewRecord([]value.Primary{
+							value.NewNull(),
+							value.NewString("str2"),
+						}),
+					},
+					FileInfo: &FileInfo{
+						Path:        "tmpview",
+						Delimiter:   ',',
+						IsTemporary: true,
+					},
+					ForUpdate: true,
+				},
+			},
+		},
+	},
+	{
+		Name: "Set Column Type Load Error",
+		Query: parser.SetColumnType{
+			Table:  parser.Identifier{Literal: "notexist"},
+			Column: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			Type:   parser.Identifier{Literal: "integer"},
+		},
+		Error: "[L:- C:-] file notexist does not exist",
+	},
+	{
+		Name: "Set Column Type Field Does Not Exist Error",
+		Query: parser.SetColumnType{
+			Table:  parser.Identifier{Literal: "table1"},
+			Column: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			Type:   parser.Identifier{Literal: "integer"},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Set Column Type Invalid Type Error",
+		Query: parser.SetColumnType{
+			Table:  parser.Identifier{Literal: "table1"},
+			Column: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			Type:   parser.Identifier{Literal: "number"},
+		},
+		Error: "[L:- C:-] number is an unknown column type",
+	},
+	{
+		Name: "Set Column Type Conversion Error",
+		Query: parser.SetColumnType{
+			Table:  parser.Identifier{Literal: "table1"},
+			Column: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			Type:   parser.Identifier{Literal: "integer"},
+		},
+		Error: "[L:- C:-] field column2 cannot be converted to INTEGER at rows 1, 2, 3",
+	},
+}
+
+func TestSetColumnType(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.Quiet = false
+
+	filter := NewEmptyFilter()
+	filter.TempViews = TemporaryViewScopes{
+		ViewMap{
+			"TMPVIEW": &View{
+				Header: NewHeader("tmpview", []string{"column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("str1"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewNull(),
+						value.NewString("str2"),
+					}),
+				},
+				FileInfo: &FileInfo{
+					Path:        "tmpview",
+					Delimiter:   ',',
+					IsTemporary: true,
+				},
+			},
+		},
+	}
+
+	for _, v := range setColumnTypeTests {
+		ReleaseResources()
+		result, err := SetColumnType(v.Query, filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		for _, v2 := range ViewCache {
+			if v2.FileInfo.Handler != nil {
+				if v2.FileInfo.Path != v2.FileInfo.Handler.Path() {
+					t.Errorf("file pointer = %q, want %q for %q", v2.FileInfo.Handler.Path(), v2.FileInfo.Path, v.Name)
+				}
+				v2.FileInfo.Close()
+				v2.FileInfo.Handler = nil
+			}
+		}
+
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+
+		if v.ViewCache != nil {
+			if !reflect.DeepEqual(ViewCache, v.ViewCache) {
+				t.Errorf("%s: view cache = %v, want %v", v.Name, ViewCache, v.ViewCache)
+			}
+		}
+		if v.TempViewList != nil {
+			if !reflect.DeepEqual(filter.TempViews, v.TempViewList) {
+				t.Errorf("%s: temporary views list = %v, want %v", v.Name, filter.TempViews, v.TempViewList)
+			}
+		}
+	}
+	ReleaseResources()
+}
+
 var setTableAttributeTests = []struct {
 	Name   string
 	Query  parser.SetTableAttribute
@@ -3654,6 +4329,21 @@ var setTableAttributeTests = []struct {
 			PrettyPrint: true,
 		},
 	},
+	{
+		Name: "Set Pretty without Value",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table.json"},
+			Attribute: parser.Identifier{Literal: "pretty"},
+		},
+		Expect: &FileInfo{
+			Path:        GetTestFilePath("table.json"),
+			Delimiter:   ',',
+			Format:      cmd.JSON,
+			Encoding:    text.UTF8,
+			LineBreak:   text.LF,
+			PrettyPrint: true,
+		},
+	},
 	{
 		Name: "Not Exist Table Error",
 		Query: parser.SetTableAttribute{
@@ -3677,9 +4367,9 @@ var setTableAttributeTests = []struct {
 		Query: parser.SetTableAttribute{
 			Table:     parser.Identifier{Literal: "table1.csv"},
 			Attribute: parser.Identifier{Literal: "delimiter"},
-			Value:     parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			Value:     parser.FieldReference{View: parser.Identifier{Literal: "tbl"}, Column: parser.Identifier{Literal: "notexist"}},
 		},
-		Error: "[L:- C:-] field notexist does not exist",
+		Error: "[L:- C:-] field tbl.notexist does not exist",
 	},
 	{
 		Name: "Not Exist Attribute Error",
@@ -3835,6 +4525,67 @@ func TestCommit(t *testing.T) {
 	}
 }
 
+var checkGitModificationsTests = []struct {
+	Name     string
+	GitCheck string
+	Modified bool
+	Error    string
+}{
+	{
+		Name:     "CheckGitModifications None",
+		GitCheck: "none",
+		Modified: true,
+	},
+	{
+		Name:     "CheckGitModifications Warn",
+		GitCheck: "warn",
+		Modified: true,
+	},
+	{
+		Name:     "CheckGitModifications Refuse",
+		GitCheck: "refuse",
+		Modified: true,
+		Error:    "[L:- C:-] failed to commit: file %s has uncommitted modifications in git",
+	},
+	{
+		Name:     "CheckGitModifications Refuse Not Modified",
+		GitCheck: "refuse",
+	},
+}
+
+func TestCheckGitModifications(t *testing.T) {
+	defer initFlag(cmd.GetFlags())
+
+	flags := cmd.GetFlags()
+	flags.SetQuiet(true)
+	fpath := filepath.Join(setupGitRepository(t), "data.csv")
+
+	for _, v := range checkGitModificationsTests {
+		if v.Modified {
+			ioutil.WriteFile(fpath, []byte("id,name\n1,z\n"), 0644)
+		} else {
+			ioutil.WriteFile(fpath, []byte("id,name\n1,a\n2,b\n"), 0644)
+		}
+		flags.SetGitCheck(v.GitCheck)
+
+		files := map[string]*FileInfo{
+			strings.ToUpper(fpath): {Path: fpath},
+		}
+		err := checkGitModifications(parser.TransactionControl{BaseExpr: &parser.BaseExpr{}}, files)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != fmt.Sprintf(v.Error, fpath) {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), fmt.Sprintf(v.Error, fpath))
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, fmt.Sprintf(v.Error, fpath))
+		}
+	}
+}
+
 func TestRollback(t *testing.T) {
 	cmd.GetFlags().SetQuiet(false)
 
@@ -0,0 +1,170 @@
+package query
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	StableChannel     = "stable"
+	PreReleaseChannel = "prerelease"
+)
+
+const (
+	ReleaseTimeout     = 30 * time.Second
+	ChecksumsAssetName = "checksums.txt"
+	SignatureAssetName = "checksums.txt.sig"
+)
+
+// ReleaseURL is the url of the GitHub API that lists the releases of csvq.
+var ReleaseURL = "https://api.github.com/repos/mithrandie/csvq/releases"
+
+// ReleasePublicKey is the base64-encoded ed25519 public key used to verify the signature
+// of the checksums of release archives. It is embedded at build time.
+var ReleasePublicKey = ""
+
+type Release struct {
+	Version    string
+	PreRelease bool
+	Assets     map[string]string
+}
+
+type releaseResponse struct {
+	TagName    string `json:"tag_name"`
+	Draft      bool   `json:"draft"`
+	PreRelease bool   `json:"prerelease"`
+	Assets     []struct {
+		Name               string `json:"name"`
+		BrowserDownloadURL string `json:"browser_download_url"`
+	} `json:"assets"`
+}
+
+func ParseReleaseChannel(s string) (string, error) {
+	c := strings.ToLower(s)
+	switch c {
+	case StableChannel, PreReleaseChannel:
+	default:
+		return c, errors.New("channel must be one of stable|prerelease")
+	}
+	return c, nil
+}
+
+// ArchiveName returns the name of the release archive for the current platform.
+func (r *Release) ArchiveName() string {
+	return fmt.Sprintf("csvq-%s-%s-%s.tar.gz", r.Version, runtime.GOOS, runtime.GOARCH)
+}
+
+// LatestRelease returns the newest release in the channel.
+// Pre-releases are included only in the prerelease channel.
+func LatestRelease(channel string) (*Release, error) {
+	body, err := DownloadReleaseAsset(ReleaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []releaseResponse
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to parse the release list: %s", err.Error()))
+	}
+
+	var latest *Release
+	for _, res := range list {
+		if res.Draft || (res.PreRelease && channel != PreReleaseChannel) {
+			continue
+		}
+		if latest != nil && CompareVersions(res.TagName, latest.Version) <= 0 {
+			continue
+		}
+
+		latest = &Release{
+			Version:    res.TagName,
+			PreRelease: res.PreRelease,
+			Assets:     make(map[string]string, len(res.Assets)),
+		}
+		for _, asset := range res.Assets {
+			latest.Assets[asset.Name] = asset.BrowserDownloadURL
+		}
+	}
+
+	if latest == nil {
+		return nil, errors.New(fmt.Sprintf("no release is found in the %s channel", channel))
+	}
+	return latest, nil
+}
+
+// DownloadReleaseAsset returns the contents at the url.
+// If the server does not respond with a 2xx status code, then returns an error.
+func DownloadReleaseAsset(url string) ([]byte, error) {
+	client := &http.Client{Timeout: ReleaseTimeout}
+	res, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || 299 < res.StatusCode {
+		return nil, errors.New(fmt.Sprintf("%s responded with status %s", url, res.Status))
+	}
+	return ioutil.ReadAll(res.Body)
+}
+
+// CompareVersions compares versions in the form of "v1.2.3" or "v1.2.3-beta.1",
+// and returns a negative number, 0 or a positive number if v1 is older than, equal to
+// or newer than v2.
+// A pre-release version is older than the release version with the same numbers.
+func CompareVersions(v1 string, v2 string) int {
+	n1, pre1 := splitVersion(v1)
+	n2, pre2 := splitVersion(v2)
+
+	for i := 0; i < len(n1) || i < len(n2); i++ {
+		var a, b int
+		if i < len(n1) {
+			a = n1[i]
+		}
+		if i < len(n2) {
+			b = n2[i]
+		}
+		if a != b {
+			return a - b
+		}
+	}
+
+	switch {
+	case pre1 == pre2:
+		return 0
+	case len(pre1) < 1:
+		return 1
+	case len(pre2) < 1:
+		return -1
+	}
+	return strings.Compare(pre1, pre2)
+}
+
+func splitVersion(v string) ([]int, string) {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+
+	if i := strings.IndexByte(v, '+'); -1 < i {
+		v = v[:i]
+	}
+
+	pre := ""
+	if i := strings.IndexByte(v, '-'); -1 < i {
+		pre = v[i+1:]
+		v = v[:i]
+	}
+
+	parts := strings.Split(v, ".")
+	numbers := make([]int, 0, len(parts))
+	for _, s := range parts {
+		n, _ := strconv.Atoi(s)
+		numbers = append(numbers, n)
+	}
+	return numbers, pre
+}
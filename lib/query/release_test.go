@@ -0,0 +1,195 @@
+package query
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+var testReleaseList = `[
+  {"tag_name": "v1.9.0-beta.1", "draft": false, "prerelease": true, "assets": []},
+  {"tag_name": "v2.0.0", "draft": true, "prerelease": false, "assets": []},
+  {"tag_name": "v1.8.2", "draft": false, "prerelease": false, "assets": [
+    {"name": "checksums.txt", "browser_download_url": "https://example.com/v1.8.2/checksums.txt"}
+  ]},
+  {"tag_name": "v1.8.10", "draft": false, "prerelease": false, "assets": [
+    {"name": "checksums.txt", "browser_download_url": "https://example.com/v1.8.10/checksums.txt"}
+  ]}
+]`
+
+func newTestReleaseServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/releases":
+			_, _ = w.Write([]byte(testReleaseList))
+		case "/empty":
+			_, _ = w.Write([]byte("[]"))
+		case "/broken":
+			_, _ = w.Write([]byte("["))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+var parseReleaseChannelTests = []struct {
+	Input  string
+	Expect string
+	Error  string
+}{
+	{
+		Input:  "Stable",
+		Expect: StableChannel,
+	},
+	{
+		Input:  "prerelease",
+		Expect: PreReleaseChannel,
+	},
+	{
+		Input: "nightly",
+		Error: "channel must be one of stable|prerelease",
+	},
+}
+
+func TestParseReleaseChannel(t *testing.T) {
+	for _, v := range parseReleaseChannelTests {
+		result, err := ParseReleaseChannel(v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.Input)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.Input)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Input)
+			continue
+		}
+		if result != v.Expect {
+			t.Errorf("result = %q, want %q for %q", result, v.Expect, v.Input)
+		}
+	}
+}
+
+var latestReleaseTests = []struct {
+	Name    string
+	Path    string
+	Channel string
+	Expect  *Release
+	Error   string
+}{
+	{
+		Name:    "Stable Channel",
+		Path:    "/releases",
+		Channel: StableChannel,
+		Expect: &Release{
+			Version:    "v1.8.10",
+			PreRelease: false,
+			Assets: map[string]string{
+				"checksums.txt": "https://example.com/v1.8.10/checksums.txt",
+			},
+		},
+	},
+	{
+		Name:    "PreRelease Channel",
+		Path:    "/releases",
+		Channel: PreReleaseChannel,
+		Expect: &Release{
+			Version:    "v1.9.0-beta.1",
+			PreRelease: true,
+			Assets:     map[string]string{},
+		},
+	},
+	{
+		Name:    "No Release",
+		Path:    "/empty",
+		Channel: StableChannel,
+		Error:   "no release is found in the stable channel",
+	},
+	{
+		Name:    "Broken Response",
+		Path:    "/broken",
+		Channel: StableChannel,
+		Error:   "failed to parse the release list: unexpected end of JSON input",
+	},
+	{
+		Name:    "Status Error",
+		Path:    "/notfound",
+		Channel: StableChannel,
+		Error:   "%s responded with status 404 Not Found",
+	},
+}
+
+func TestLatestRelease(t *testing.T) {
+	server := newTestReleaseServer()
+	defer server.Close()
+
+	oldURL := ReleaseURL
+	defer func() {
+		ReleaseURL = oldURL
+	}()
+
+	for _, v := range latestReleaseTests {
+		ReleaseURL = server.URL + v.Path
+
+		result, err := LatestRelease(v.Channel)
+		if err != nil {
+			expectErr := v.Error
+			if strings.Contains(expectErr, "%s") {
+				expectErr = fmt.Sprintf(expectErr, ReleaseURL)
+			}
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != expectErr {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), expectErr)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Expect) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Expect)
+		}
+	}
+}
+
+func TestRelease_ArchiveName(t *testing.T) {
+	r := &Release{Version: "v1.8.2"}
+	expect := "csvq-v1.8.2-" + runtime.GOOS + "-" + runtime.GOARCH + ".tar.gz"
+	if r.ArchiveName() != expect {
+		t.Errorf("archive name = %q, want %q", r.ArchiveName(), expect)
+	}
+}
+
+var compareVersionsTests = []struct {
+	V1     string
+	V2     string
+	Expect int
+}{
+	{V1: "v1.8.2", V2: "v1.8.2", Expect: 0},
+	{V1: "v1.8.10", V2: "v1.8.2", Expect: 1},
+	{V1: "v1.8", V2: "v1.8.1", Expect: -1},
+	{V1: "v2.0.0", V2: "v1.99.99", Expect: 1},
+	{V1: "v1.9.0-beta.1", V2: "v1.9.0", Expect: -1},
+	{V1: "v1.9.0", V2: "v1.9.0-beta.1", Expect: 1},
+	{V1: "v1.9.0-beta.2", V2: "v1.9.0-beta.1", Expect: 1},
+	{V1: "1.9.0+build.1", V2: "v1.9.0", Expect: 0},
+}
+
+func TestCompareVersions(t *testing.T) {
+	for _, v := range compareVersionsTests {
+		result := CompareVersions(v.V1, v.V2)
+		switch {
+		case v.Expect < 0 && result < 0, v.Expect == 0 && result == 0, 0 < v.Expect && 0 < result:
+		default:
+			t.Errorf("result = %d, want sign of %d for %q and %q", result, v.Expect, v.V1, v.V2)
+		}
+	}
+}
@@ -9,13 +9,14 @@ import (
 )
 
 const (
-	UncommittedInformation  = "UNCOMMITTED"
-	CreatedInformation      = "CREATED"
-	UpdatedInformation      = "UPDATED"
-	UpdatedViewsInformation = "UPDATED_VIEWS"
-	LoadedTablesInformation = "LOADED_TABLES"
-	WorkingDirectory        = "WORKING_DIRECTORY"
-	VersionInformation      = "VERSION"
+	UncommittedInformation   = "UNCOMMITTED"
+	CreatedInformation       = "CREATED"
+	UpdatedInformation       = "UPDATED"
+	UpdatedViewsInformation  = "UPDATED_VIEWS"
+	LoadedTablesInformation  = "LOADED_TABLES"
+	WorkingDirectory         = "WORKING_DIRECTORY"
+	VersionInformation       = "VERSION"
+	LatestVersionInformation = "LATEST_VERSION"
 )
 
 var RuntimeInformatinList = []string{
@@ -26,6 +27,7 @@ var RuntimeInformatinList = []string{
 	LoadedTablesInformation,
 	WorkingDirectory,
 	VersionInformation,
+	LatestVersionInformation,
 }
 
 func GetRuntimeInformation(expr parser.RuntimeInformation) (value.Primary, error) {
@@ -50,6 +52,12 @@ func GetRuntimeInformation(expr parser.RuntimeInformation) (value.Primary, error
 		p = value.NewString(wd)
 	case VersionInformation:
 		p = value.NewString(Version)
+	case LatestVersionInformation:
+		release, err := LatestRelease(StableChannel)
+		if err != nil {
+			return p, NewFetchLatestVersionError(expr, err.Error())
+		}
+		p = value.NewString(release.Version)
 	default:
 		return p, NewInvalidRuntimeInformationError(expr)
 	}
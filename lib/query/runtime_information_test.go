@@ -41,6 +41,10 @@ var getRuntimeInformationTests = []struct {
 		Input:  parser.RuntimeInformation{Name: "version"},
 		Expect: value.NewString("v1.0.0"),
 	},
+	{
+		Input:  parser.RuntimeInformation{Name: "latest_version"},
+		Expect: value.NewString("v1.8.10"),
+	},
 	{
 		Input: parser.RuntimeInformation{Name: "invalid"},
 		Error: "[L:- C:-] @#invalid is an unknown runtime information",
@@ -48,6 +52,15 @@ var getRuntimeInformationTests = []struct {
 }
 
 func TestGetRuntimeInformation(t *testing.T) {
+	server := newTestReleaseServer()
+	defer server.Close()
+
+	oldURL := ReleaseURL
+	ReleaseURL = server.URL + "/releases"
+	defer func() {
+		ReleaseURL = oldURL
+	}()
+
 	ViewCache = ViewMap{
 		"TABLE1": &View{},
 		"TABLE2": &View{},
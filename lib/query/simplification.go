@@ -0,0 +1,240 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+// simplifyCondition rewrites a condition in WHERE or HAVING clauses once before
+// the condition is evaluated for each record.
+//
+// Operations whose operands are all constants are replaced with their results,
+// logical operations that always have the same result regardless of the other operand
+// are reduced, WHEN clauses in CASE expressions that are never satisfied are removed,
+// and nested COALESCE functions are flattened.
+//
+// Expressions that the original condition evaluates are never removed except for
+// constants, so that the rewritten condition returns the same errors.
+// The second return value is true if the condition is always TRUE.
+func simplifyCondition(condition parser.QueryExpression, filter *Filter) (parser.QueryExpression, bool) {
+	condition = simplifyExpression(condition, true, filter)
+	if p, ok := condition.(parser.PrimitiveType); ok && p.Value.Ternary() == ternary.TRUE {
+		return condition, true
+	}
+	return condition, false
+}
+
+// simplifyExpression rewrites the expression. If asCondition is true, only the ternary
+// value of the result of the expression is used, and the expression can be replaced with
+// one that returns a different value of the same ternary value.
+func simplifyExpression(expr parser.QueryExpression, asCondition bool, filter *Filter) parser.QueryExpression {
+	switch expr.(type) {
+	case parser.Parentheses:
+		e := expr.(parser.Parentheses)
+		e.Expr = simplifyExpression(e.Expr, asCondition, filter)
+		if isConstant(e.Expr) {
+			return e.Expr
+		}
+		return e
+	case parser.Arithmetic:
+		e := expr.(parser.Arithmetic)
+		e.LHS = simplifyExpression(e.LHS, false, filter)
+		e.RHS = simplifyExpression(e.RHS, false, filter)
+		return foldConstants(e, filter, e.LHS, e.RHS)
+	case parser.UnaryArithmetic:
+		e := expr.(parser.UnaryArithmetic)
+		e.Operand = simplifyExpression(e.Operand, false, filter)
+		return foldConstants(e, filter, e.Operand)
+	case parser.Concat:
+		e := expr.(parser.Concat)
+		e.Items = simplifyExpressions(e.Items, filter)
+		return foldConstants(e, filter, e.Items...)
+	case parser.Comparison:
+		e := expr.(parser.Comparison)
+		e.LHS = simplifyExpression(e.LHS, false, filter)
+		e.RHS = simplifyExpression(e.RHS, false, filter)
+		return foldConstants(e, filter, e.LHS, e.RHS)
+	case parser.Is:
+		e := expr.(parser.Is)
+		e.LHS = simplifyExpression(e.LHS, false, filter)
+		return foldConstants(e, filter, e.LHS, e.RHS)
+	case parser.Between:
+		e := expr.(parser.Between)
+		e.LHS = simplifyExpression(e.LHS, false, filter)
+		e.Low = simplifyExpression(e.Low, false, filter)
+		e.High = simplifyExpression(e.High, false, filter)
+		return foldConstants(e, filter, e.LHS, e.Low, e.High)
+	case parser.Like:
+		e := expr.(parser.Like)
+		e.LHS = simplifyExpression(e.LHS, false, filter)
+		e.Pattern = simplifyExpression(e.Pattern, false, filter)
+		return foldConstants(e, filter, e.LHS, e.Pattern)
+	case parser.Logic:
+		return simplifyLogic(expr.(parser.Logic), asCondition, filter)
+	case parser.UnaryLogic:
+		e := expr.(parser.UnaryLogic)
+		e.Operand = simplifyExpression(e.Operand, true, filter)
+		return foldConstants(e, filter, e.Operand)
+	case parser.CaseExpr:
+		return simplifyCaseExpr(expr.(parser.CaseExpr), asCondition, filter)
+	case parser.Function:
+		e := expr.(parser.Function)
+		if strings.ToUpper(e.Name) == "COALESCE" && 0 < len(e.Args) {
+			return simplifyCoalesce(e, filter)
+		}
+	}
+	return expr
+}
+
+func simplifyExpressions(list []parser.QueryExpression, filter *Filter) []parser.QueryExpression {
+	simplified := make([]parser.QueryExpression, len(list))
+	for i, v := range list {
+		simplified[i] = simplifyExpression(v, false, filter)
+	}
+	return simplified
+}
+
+func simplifyLogic(expr parser.Logic, asCondition bool, filter *Filter) parser.QueryExpression {
+	expr.LHS = simplifyExpression(expr.LHS, true, filter)
+	expr.RHS = simplifyExpression(expr.RHS, true, filter)
+
+	if isConstant(expr.LHS) {
+		if isConstant(expr.RHS) {
+			return foldConstants(expr, filter, expr.LHS, expr.RHS)
+		}
+
+		// The right-hand side is not evaluated if the left-hand side determines the result.
+		switch t := expr.LHS.(parser.PrimitiveType).Value.Ternary(); {
+		case expr.Operator.Token == parser.AND && t == ternary.FALSE, expr.Operator.Token == parser.OR && t == ternary.TRUE:
+			return parser.PrimitiveType{BaseExpr: expr.BaseExpr, Value: value.NewTernary(t)}
+		case asCondition && expr.Operator.Token == parser.AND && t == ternary.TRUE, asCondition && expr.Operator.Token == parser.OR && t == ternary.FALSE:
+			return expr.RHS
+		}
+	} else if asCondition && isConstant(expr.RHS) {
+		switch t := expr.RHS.(parser.PrimitiveType).Value.Ternary(); {
+		case expr.Operator.Token == parser.AND && t == ternary.TRUE, expr.Operator.Token == parser.OR && t == ternary.FALSE:
+			return expr.LHS
+		}
+	}
+	return expr
+}
+
+func simplifyCaseExpr(expr parser.CaseExpr, asCondition bool, filter *Filter) parser.QueryExpression {
+	if expr.Value != nil {
+		expr.Value = simplifyExpression(expr.Value, false, filter)
+	}
+	if expr.Else != nil {
+		e := expr.Else.(parser.CaseExprElse)
+		e.Result = simplifyExpression(e.Result, asCondition, filter)
+		expr.Else = e
+	}
+
+	// Conditions can be decided only if the value to be compared is a constant.
+	decidable := expr.Value == nil || isConstant(expr.Value)
+
+	when := make([]parser.QueryExpression, 0, len(expr.When))
+	for _, v := range expr.When {
+		e := v.(parser.CaseExprWhen)
+		e.Condition = simplifyExpression(e.Condition, expr.Value == nil, filter)
+		e.Result = simplifyExpression(e.Result, asCondition, filter)
+
+		if decidable && isConstant(e.Condition) {
+			var t ternary.Value
+			if expr.Value == nil {
+				t = e.Condition.(parser.PrimitiveType).Value.Ternary()
+			} else {
+				t = value.Equal(expr.Value.(parser.PrimitiveType).Value, e.Condition.(parser.PrimitiveType).Value)
+			}
+
+			if t != ternary.TRUE {
+				continue
+			}
+			if len(when) < 1 {
+				return e.Result
+			}
+			expr.Else = parser.CaseExprElse{BaseExpr: e.BaseExpr, Else: "ELSE", Result: e.Result}
+			break
+		}
+		when = append(when, e)
+	}
+
+	if len(when) < 1 && decidable {
+		if expr.Else == nil {
+			return parser.PrimitiveType{BaseExpr: expr.BaseExpr, Value: value.NewNull()}
+		}
+		return expr.Else.(parser.CaseExprElse).Result
+	}
+	expr.When = when
+	return expr
+}
+
+// simplifyCoalesce flattens nested COALESCE functions, and removes NULL constants
+// and the arguments following a constant that is not NULL if all of them are constants.
+// The other arguments are left even if they are never returned because all the
+// arguments are evaluated.
+func simplifyCoalesce(expr parser.Function, filter *Filter) parser.QueryExpression {
+	args := make([]parser.QueryExpression, 0, len(expr.Args))
+	for _, v := range expr.Args {
+		arg := simplifyExpression(v, false, filter)
+
+		if fn, ok := arg.(parser.Function); ok && strings.ToUpper(fn.Name) == "COALESCE" && 0 < len(fn.Args) {
+			args = append(args, fn.Args...)
+			continue
+		}
+		if p, ok := arg.(parser.PrimitiveType); ok && value.IsNull(p.Value) {
+			continue
+		}
+		args = append(args, arg)
+	}
+
+	for i, v := range args {
+		if !isConstant(v) {
+			continue
+		}
+		truncate := true
+		for _, rest := range args[i+1:] {
+			if !isConstant(rest) {
+				truncate = false
+				break
+			}
+		}
+		if truncate {
+			args = args[:i+1]
+		}
+		break
+	}
+
+	switch len(args) {
+	case 0:
+		return parser.PrimitiveType{BaseExpr: expr.BaseExpr, Value: value.NewNull()}
+	case 1:
+		return args[0]
+	}
+	expr.Args = args
+	return expr
+}
+
+func isConstant(expr parser.QueryExpression) bool {
+	_, ok := expr.(parser.PrimitiveType)
+	return ok
+}
+
+// foldConstants replaces the expression with the result if all the operands are constants.
+// If the evaluation fails, the expression is left as it is to return the error when it is evaluated.
+func foldConstants(expr parser.QueryExpression, filter *Filter, operands ...parser.QueryExpression) parser.QueryExpression {
+	for _, v := range operands {
+		if !isConstant(v) {
+			return expr
+		}
+	}
+
+	p, err := filter.Evaluate(expr)
+	if err != nil {
+		return expr
+	}
+	return parser.PrimitiveType{BaseExpr: expr.GetBaseExpr(), Value: p}
+}
@@ -0,0 +1,119 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var simplifyConditionTests = []struct {
+	Name       string
+	Condition  string
+	Result     string
+	AlwaysTrue bool
+}{
+	{
+		Name:      "Fold Arithmetic",
+		Condition: "column1 = 1 + 2 * 3",
+		Result:    "column1 = 7",
+	},
+	{
+		Name:      "Fold Concatenation",
+		Condition: "column2 = 'str' || 1",
+		Result:    "column2 = \"str1\"",
+	},
+	{
+		Name:       "Always True",
+		Condition:  "1 < 2 AND (TRUE OR column1 = 1)",
+		Result:     "TRUE",
+		AlwaysTrue: true,
+	},
+	{
+		Name:      "Always False",
+		Condition: "FALSE AND column1 = 1",
+		Result:    "FALSE",
+	},
+	{
+		Name:      "Remove True Operand",
+		Condition: "1 = 1 AND column1 = 1 AND NOT FALSE",
+		Result:    "column1 = 1",
+	},
+	{
+		Name:      "Remove False Operand",
+		Condition: "column1 = 1 OR 1 = 2",
+		Result:    "column1 = 1",
+	},
+	{
+		Name:      "Keep Operand Evaluated Before Constant",
+		Condition: "column1 = 1 AND FALSE",
+		Result:    "column1 = 1 AND FALSE",
+	},
+	{
+		Name:      "Keep Function",
+		Condition: "column1 = RAND(1, 1)",
+		Result:    "column1 = RAND(1, 1)",
+	},
+	{
+		Name:      "Keep Expression Failed to Evaluate",
+		Condition: "column1 = NOTEXIST(1)",
+		Result:    "column1 = NOTEXIST(1)",
+	},
+	{
+		Name:      "Simplify Case Expression",
+		Condition: "CASE WHEN 1 = 2 THEN column1 WHEN column1 = 2 THEN TRUE WHEN 1 = 1 THEN column2 = 'a' WHEN column1 = 3 THEN FALSE END",
+		Result:    "CASE WHEN column1 = 2 THEN TRUE ELSE column2 = 'a' END",
+	},
+	{
+		Name:      "Simplify Case Expression to Result",
+		Condition: "CASE 1 WHEN 2 THEN FALSE WHEN 1 THEN column1 = 1 END",
+		Result:    "column1 = 1",
+	},
+	{
+		Name:      "Simplify Case Expression to Else",
+		Condition: "CASE WHEN 1 = 2 THEN FALSE ELSE column1 = 1 END",
+		Result:    "column1 = 1",
+	},
+	{
+		Name:      "Keep Case Expression with Variable Value",
+		Condition: "CASE column1 WHEN 2 THEN FALSE ELSE TRUE END",
+		Result:    "CASE column1 WHEN 2 THEN FALSE ELSE TRUE END",
+	},
+	{
+		Name:      "Flatten Coalesce",
+		Condition: "COALESCE(column1, NULL, COALESCE(NULL, column2, 'a', 'b')) = 'a'",
+		Result:    "COALESCE(column1, column2, 'a') = 'a'",
+	},
+	{
+		Name:      "Coalesce of Constants",
+		Condition: "column1 = COALESCE(NULL, 1 + 1, 3)",
+		Result:    "column1 = 2",
+	},
+	{
+		Name:      "Keep Coalesce Arguments Evaluated",
+		Condition: "COALESCE(NULL, 1, column1) = 1",
+		Result:    "COALESCE(1, column1) = 1",
+	},
+	{
+		Name:      "Coalesce of Single Argument",
+		Condition: "COALESCE(NULL, column1) = 1",
+		Result:    "column1 = 1",
+	},
+}
+
+func TestSimplifyCondition(t *testing.T) {
+	for _, v := range simplifyConditionTests {
+		program, err := parser.Parse("SELECT * FROM table1 WHERE "+v.Condition, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+		where := program[0].(parser.SelectQuery).SelectEntity.(parser.SelectEntity).WhereClause.(parser.WhereClause)
+
+		result, alwaysTrue := simplifyCondition(where.Filter, NewEmptyFilter())
+		if result.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, result.String(), v.Result)
+		}
+		if alwaysTrue != v.AlwaysTrue {
+			t.Errorf("%s: always true = %t, want %t", v.Name, alwaysTrue, v.AlwaysTrue)
+		}
+	}
+}
@@ -2,7 +2,6 @@ package query
 
 import (
 	"bytes"
-	"strings"
 
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
@@ -83,7 +82,7 @@ func (values SortValues) Serialize(buf *bytes.Buffer) {
 		case BooleanType:
 			serializeBoolean(buf, val.Boolean)
 		case StringType:
-			serializeString(buf, val.String)
+			serializeStringKey(buf, val.String)
 		}
 	}
 }
@@ -99,6 +98,11 @@ type SortValue struct {
 }
 
 func NewSortValue(val value.Primary) *SortValue {
+	return NewSortValueWithCollation(val, value.DefaultCollation())
+}
+
+// NewSortValueWithCollation returns a sort value in which strings are ordered by the collation.
+func NewSortValueWithCollation(val value.Primary, collation value.Collation) *SortValue {
 	sortValue := &SortValue{}
 
 	if value.IsNull(val) {
@@ -148,7 +152,7 @@ func NewSortValue(val value.Primary) *SortValue {
 		}
 	} else if s, ok := val.(value.String); ok {
 		sortValue.Type = StringType
-		sortValue.String = strings.ToUpper(strings.TrimSpace(s.Raw()))
+		sortValue.String = collation.Key(s.Raw())
 	} else {
 		sortValue.Type = NullType
 	}
@@ -121,6 +121,18 @@ var sortValueLessTests = []struct {
 		CompareValue: NewSortValue(value.NewTernary(ternary.FALSE)),
 		Result:       ternary.UNKNOWN,
 	},
+	{
+		Name:         "SortValue Less String with Binary Collation",
+		SortValue:    NewSortValueWithCollation(value.NewString("b"), value.BinaryCollation),
+		CompareValue: NewSortValueWithCollation(value.NewString("C"), value.BinaryCollation),
+		Result:       ternary.FALSE,
+	},
+	{
+		Name:         "SortValue Less String with Unicode Collation",
+		SortValue:    NewSortValueWithCollation(value.NewString("é"), value.UnicodeCollation),
+		CompareValue: NewSortValueWithCollation(value.NewString("g"), value.UnicodeCollation),
+		Result:       ternary.TRUE,
+	},
 	{
 		Name:         "SortValue Less Incommensurable Types",
 		SortValue:    NewSortValue(value.NewInteger(3)),
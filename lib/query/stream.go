@@ -0,0 +1,250 @@
+package query
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
+)
+
+// StreamBatchSize is the maximum number of records read at a time in streaming execution.
+var StreamBatchSize = 10000
+
+// streamBatchTable is the name of the inline table that holds a batch of records
+// in streaming execution. The name cannot be written as an identifier in queries,
+// so tables referred by subqueries are not replaced with the batch.
+const streamBatchTable = "@STREAM"
+
+// StreamReader reads the records of a CSV or TSV file in batches.
+type StreamReader struct {
+	Expr      parser.Identifier
+	TableName string
+	FileInfo  *FileInfo
+
+	handler  *file.Handler
+	decoder  *DecodingReader
+	detector *LayoutDetector
+	reader   *csv.Reader
+	header   []string
+	eof      bool
+}
+
+// OpenStreamReader opens the file, and reads the header.
+func OpenStreamReader(table parser.Table) (*StreamReader, error) {
+	flags := cmd.GetFlags()
+	expr := table.Object.(parser.Identifier)
+
+	fileInfo, err := NewFileInfo(expr, flags.Repository, cmd.AutoSelect, flags.Delimiter, flags.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	fileInfo.NoHeader = flags.NoHeader
+	fileInfo.IsTemporary = true
+
+	h, err := file.NewHandlerForRead(fileInfo.Path)
+	if err != nil {
+		if _, ok := err.(*file.TimeoutError); ok {
+			return nil, NewFileLockTimeoutError(expr, fileInfo.Path)
+		}
+		return nil, NewReadFileError(expr, err.Error())
+	}
+
+	r := &StreamReader{
+		Expr:      expr,
+		TableName: table.Name().Literal,
+		FileInfo:  fileInfo,
+		handler:   h,
+	}
+
+	r.decoder = NewDecodingReader(h.FileForRead(), fileInfo.Encoding, flags.EncodingErrors)
+
+	var fp io.Reader = r.decoder
+	if requiresLayoutDetector(flags) {
+		r.detector = NewLayoutDetector(r.decoder, true, fileInfo.Delimiter)
+		r.detector.MaxFieldSize = flags.MaxFieldSize
+		r.detector.MaxRowSize = flags.MaxRowSize
+		r.detector.RecoverQuotes = flags.RecoverQuotes
+		fp = r.detector
+	}
+
+	r.reader = csv.NewReader(fp, text.UTF8)
+	r.reader.Delimiter = fileInfo.Delimiter
+	r.reader.WithoutNull = flags.WithoutNull
+
+	if !fileInfo.NoHeader {
+		r.header, err = r.reader.ReadHeader()
+		if err != nil && err != io.EOF {
+			r.Close()
+			return nil, NewDataParsingError(expr, fileInfo.Path, err.Error())
+		}
+	}
+	return r, nil
+}
+
+// Read returns a view that has the next batch of records.
+// If all the records have been read, then returns a view without records.
+func (r *StreamReader) Read() (*View, error) {
+	records := make(RecordSet, 0, StreamBatchSize)
+	for !r.eof && len(records) < StreamBatchSize {
+		row, err := r.reader.Read()
+		if err == io.EOF {
+			r.eof = true
+			r.warn()
+			break
+		}
+		if err != nil {
+			return nil, NewDataParsingError(r.Expr, r.FileInfo.Path, err.Error())
+		}
+
+		fields := make([]value.Primary, len(row))
+		for i, v := range row {
+			if v == nil {
+				fields[i] = value.NewNull()
+			} else {
+				fields[i] = value.NewString(string(v))
+			}
+		}
+		records = append(records, NewRecord(fields))
+	}
+
+	// The layouts of the lines are not written back to the file, so they are discarded
+	// not to hold information for all the lines.
+	if r.detector != nil {
+		r.detector.LineBreaks = r.detector.LineBreaks[:0]
+		r.detector.FieldQuotes = r.detector.FieldQuotes[:0]
+	}
+
+	if r.header == nil && 0 < r.reader.FieldsPerRecord {
+		r.header = make([]string, r.reader.FieldsPerRecord)
+		for i := 0; i < r.reader.FieldsPerRecord; i++ {
+			r.header[i] = "c" + strconv.Itoa(i+1)
+		}
+	}
+
+	view := NewView()
+	view.Header = NewHeader(r.TableName, r.header)
+	view.RecordSet = records
+	view.FileInfo = r.FileInfo
+	return view, nil
+}
+
+// EOF returns whether all the records have been read.
+func (r *StreamReader) EOF() bool {
+	return r.eof
+}
+
+// Close closes the file.
+func (r *StreamReader) Close() error {
+	return r.handler.Close()
+}
+
+func (r *StreamReader) warn() {
+	flags := cmd.GetFlags()
+	if r.detector != nil && 0 < len(r.detector.RecoveredLines) {
+		LogWarn(fmt.Sprintf("%s: opening double quotes of the fields at %s are read as parts of the values", r.FileInfo.Path, formatNumbers("line", r.detector.RecoveredLines)), flags.Quiet)
+	}
+	if 0 < r.decoder.Errors {
+		LogWarn(encodingErrorsWarning(fmt.Sprintf("%s: %s", r.FileInfo.Path, FormatCount(r.decoder.Errors, "invalid byte sequence"))), flags.Quiet)
+	}
+}
+
+// StreamingTable returns the table if the query can be executed in streaming mode.
+//
+// A query is executed in streaming mode when the STREAM flag is set, the output format
+// is written line by line, and the query only filters and projects the records of
+// a CSV or TSV file that has not been loaded.
+func StreamingTable(query parser.SelectQuery, filter *Filter) (parser.Table, bool) {
+	flags := cmd.GetFlags()
+	if !flags.Stream {
+		return parser.Table{}, false
+	}
+	switch flags.Format {
+	case cmd.CSV, cmd.TSV, cmd.LTSV:
+	default:
+		return parser.Table{}, false
+	}
+
+	if query.WithClause != nil || query.OrderByClause != nil || query.OffsetClause != nil || query.LimitClause != nil {
+		return parser.Table{}, false
+	}
+
+	table, ok := singleTable(query)
+	if !ok {
+		return parser.Table{}, false
+	}
+	ident, ok := table.Object.(parser.Identifier)
+	if !ok {
+		return parser.Table{}, false
+	}
+
+	entity := query.SelectEntity.(parser.SelectEntity)
+	if entity.GroupByClause != nil || entity.HavingClause != nil {
+		return parser.Table{}, false
+	}
+	clause := entity.SelectClause.(parser.SelectClause)
+	if clause.IsDistinct() {
+		return parser.Table{}, false
+	}
+	for _, field := range clause.Fields {
+		if containsAggregateFunction(field.(parser.Field).Object) || containsUserDefinedAggregateFunction(field.(parser.Field).Object, filter) {
+			return parser.Table{}, false
+		}
+	}
+
+	if _, err := filter.InlineTables.Get(ident); err == nil {
+		return parser.Table{}, false
+	}
+	if filter.TempViews.Exists(ident.Literal) {
+		return parser.Table{}, false
+	}
+	if _, ok := flags.TableCatalog().Get(ident.Literal); ok {
+		return parser.Table{}, false
+	}
+
+	fileInfo, err := NewFileInfo(ident, flags.Repository, cmd.AutoSelect, flags.Delimiter, flags.Encoding)
+	if err != nil || (fileInfo.Format != cmd.CSV && fileInfo.Format != cmd.TSV) || ViewCache.Exists(fileInfo.Path) {
+		return parser.Table{}, false
+	}
+	return table, true
+}
+
+func containsUserDefinedAggregateFunction(expr parser.QueryExpression, filter *Filter) bool {
+	return !walkExpression(expr, func(e parser.QueryExpression) bool {
+		if fn, ok := e.(parser.Function); ok {
+			if udfn, err := filter.Functions.Get(fn, fn.Name); err == nil && udfn.IsAggregate {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// streamingQuery returns the query that selects from the batch set by SetStreamBatch
+// in place of the table.
+func streamingQuery(query parser.SelectQuery, table parser.Table) parser.SelectQuery {
+	entity := query.SelectEntity.(parser.SelectEntity)
+	from := entity.FromClause.(parser.FromClause)
+	from.Tables = []parser.QueryExpression{
+		parser.Table{
+			BaseExpr: table.BaseExpr,
+			Object:   parser.Identifier{BaseExpr: table.Object.(parser.Identifier).BaseExpr, Literal: streamBatchTable},
+			Alias:    table.Name(),
+		},
+	}
+	entity.FromClause = from
+	query.SelectEntity = entity
+	return query
+}
+
+// SetStreamBatch makes the records read by a StreamReader available as the table
+// to the query returned by streamingQuery.
+func (f *Filter) SetStreamBatch(batch *View) {
+	f.InlineTables[0][streamBatchTable] = batch
+}
@@ -0,0 +1,183 @@
+package query
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var streamingTableTests = []struct {
+	Query  string
+	Format cmd.Format
+	Expect bool
+}{
+	{
+		Query:  "SELECT column1, UPPER(column2) FROM table1 WHERE column1 > 1",
+		Format: cmd.CSV,
+		Expect: true,
+	},
+	{
+		Query:  "SELECT * FROM `table3.tsv` AS t",
+		Format: cmd.LTSV,
+		Expect: true,
+	},
+	{
+		Query:  "SELECT * FROM table1",
+		Format: cmd.TEXT,
+		Expect: false,
+	},
+	{
+		Query:  "SELECT * FROM `table6.ltsv`",
+		Format: cmd.CSV,
+		Expect: false,
+	},
+	{
+		Query:  "SELECT * FROM table1, table2",
+		Format: cmd.CSV,
+		Expect: false,
+	},
+	{
+		Query:  "SELECT * FROM table1 ORDER BY column1",
+		Format: cmd.CSV,
+		Expect: false,
+	},
+	{
+		Query:  "SELECT * FROM table1 LIMIT 1",
+		Format: cmd.CSV,
+		Expect: false,
+	},
+	{
+		Query:  "SELECT DISTINCT column1 FROM table1",
+		Format: cmd.CSV,
+		Expect: false,
+	},
+	{
+		Query:  "SELECT COUNT(*) FROM table1",
+		Format: cmd.CSV,
+		Expect: false,
+	},
+	{
+		Query:  "SELECT column1 FROM table1 GROUP BY column1",
+		Format: cmd.CSV,
+		Expect: false,
+	},
+	{
+		Query:  "SELECT ROW_NUMBER() OVER () FROM table1",
+		Format: cmd.CSV,
+		Expect: false,
+	},
+	{
+		Query:  "WITH it AS (SELECT 1) SELECT * FROM table1",
+		Format: cmd.CSV,
+		Expect: false,
+	},
+	{
+		Query:  "SELECT * FROM notexist",
+		Format: cmd.CSV,
+		Expect: false,
+	},
+}
+
+func TestStreamingTable(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.Stream = true
+	defer func() {
+		tf.Format = cmd.TEXT
+		tf.Stream = false
+	}()
+
+	for _, v := range streamingTableTests {
+		tf.Format = v.Format
+
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Query, err)
+		}
+
+		_, ok := StreamingTable(program[0].(parser.SelectQuery), NewEmptyFilter())
+		if ok != v.Expect {
+			t.Errorf("%s: result = %t, want %t", v.Query, ok, v.Expect)
+		}
+	}
+
+	tf.Format = cmd.CSV
+	tf.Stream = false
+	program, _ := parser.Parse("SELECT * FROM table1", "")
+	if _, ok := StreamingTable(program[0].(parser.SelectQuery), NewEmptyFilter()); ok {
+		t.Errorf("result = %t, want %t when the flag is not set", ok, false)
+	}
+}
+
+var procedureStreamTests = []struct {
+	Query  string
+	Result string
+	Error  string
+}{
+	{
+		Query:  "SELECT column1, column2 FROM table1 WHERE column1 <> 2",
+		Result: "column1,column2\n1,str1\n3,str3\n",
+	},
+	{
+		Query:  "SELECT t.column2 AS c FROM table1 AS t WHERE t.column1 IN (SELECT column3 FROM table2)",
+		Result: "c\nstr2\nstr3\n",
+	},
+	{
+		Query:  "SELECT column1 FROM table1 WHERE column1 > 3",
+		Result: "column1\n",
+	},
+	{
+		Query: "SELECT notexist FROM table1",
+		Error: "[L:1 C:8] field notexist does not exist",
+	},
+}
+
+func TestProcedure_Stream(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.Format = cmd.CSV
+	tf.Stream = true
+	oldBatchSize := StreamBatchSize
+	StreamBatchSize = 2
+	defer func() {
+		tf.Format = cmd.TEXT
+		tf.Stream = false
+		StreamBatchSize = oldBatchSize
+	}()
+
+	for _, v := range procedureStreamTests {
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Query, err)
+		}
+
+		buf := new(bytes.Buffer)
+		OutFile = buf
+
+		_, err = NewProcedure().ExecuteStatement(program[0])
+		OutFile = nil
+
+		if ViewCache.Exists(GetTestFilePath("table1.csv")) {
+			t.Errorf("%s: table is loaded, want to be streamed", v.Query)
+		}
+		_ = ReleaseResourcesWithErrors()
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Query, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Query, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Query, v.Error)
+			continue
+		}
+		if buf.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Query, buf.String(), v.Result)
+		}
+	}
+}
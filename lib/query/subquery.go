@@ -0,0 +1,328 @@
+package query
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+// SubqueryCache holds the results of subqueries evaluated in a statement.
+// Subqueries are identified by their positions in the parsed statement,
+// so the cache must not be shared between statement executions.
+//
+// Uncorrelated subqueries written identically in the same scope of inline tables
+// share the result, so that the same subquery repeated in a statement is executed only once.
+type SubqueryCache struct {
+	decorrelated map[*parser.BaseExpr]*DecorrelatedSubquery
+	uncorrelated map[*parser.BaseExpr]*UncorrelatedSubquery
+	identical    map[string]*UncorrelatedSubquery
+	mtx          *sync.Mutex
+}
+
+func NewSubqueryCache() *SubqueryCache {
+	return &SubqueryCache{
+		decorrelated: make(map[*parser.BaseExpr]*DecorrelatedSubquery),
+		uncorrelated: make(map[*parser.BaseExpr]*UncorrelatedSubquery),
+		identical:    make(map[string]*UncorrelatedSubquery),
+		mtx:          &sync.Mutex{},
+	}
+}
+
+func (c *SubqueryCache) IsAvailable(expr parser.Subquery, filter *Filter) bool {
+	return c != nil && expr.BaseExpr != nil && filter.RecursiveTable == nil
+}
+
+func (c *SubqueryCache) Decorrelated(expr parser.Subquery) *DecorrelatedSubquery {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if sq, ok := c.decorrelated[expr.BaseExpr]; ok {
+		return sq
+	}
+	sq := NewDecorrelatedSubquery()
+	c.decorrelated[expr.BaseExpr] = sq
+	return sq
+}
+
+func (c *SubqueryCache) Uncorrelated(expr parser.Subquery, filter *Filter) *UncorrelatedSubquery {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if sq, ok := c.uncorrelated[expr.BaseExpr]; ok {
+		return sq
+	}
+
+	query := expr.Query.String()
+	key := identicalSubqueryKey(query, filter)
+	sq, ok := c.identical[key]
+	if !ok {
+		sq = NewUncorrelatedSubquery()
+		sq.volatile = isVolatileQuery(expr.Query)
+		c.identical[key] = sq
+	}
+	c.uncorrelated[expr.BaseExpr] = sq
+	return sq
+}
+
+// identicalSubqueryKey returns the key to identify the subquery by the query and the inline tables
+// that can be referred to from the query.
+func identicalSubqueryKey(query string, filter *Filter) string {
+	buf := new(bytes.Buffer)
+	for _, tables := range filter.InlineTables {
+		if 0 < len(tables) {
+			fmt.Fprintf(buf, "%p:", tables)
+		}
+	}
+	buf.WriteString(query)
+	return buf.String()
+}
+
+// isVolatileQuery reports whether the query may return different results in each execution.
+// Queries that substitute variables or call non-deterministic or user-defined functions are volatile.
+func isVolatileQuery(query parser.QueryExpression) bool {
+	return containsVolatileNode(reflect.ValueOf(query))
+}
+
+func containsVolatileNode(v reflect.Value) bool {
+	if !v.IsValid() || !v.CanInterface() {
+		return false
+	}
+	if v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		return !v.IsNil() && containsVolatileNode(v.Elem())
+	}
+
+	switch expr := v.Interface().(type) {
+	case parser.VariableSubstitution:
+		return true
+	case parser.Function:
+		if isVolatileFunction(expr.Name) {
+			return true
+		}
+	case parser.AggregateFunction:
+		if !isBuiltInAggregateFunction(expr.Name) {
+			return true
+		}
+	case parser.AnalyticFunction:
+		if _, ok := AnalyticFunctions[strings.ToUpper(expr.Name)]; !ok && !isBuiltInAggregateFunction(expr.Name) {
+			return true
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if containsVolatileNode(v.Field(i)) {
+				return true
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if containsVolatileNode(v.Index(i)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isVolatileFunction reports whether the function is a non-deterministic built-in function
+// or a user-defined function.
+func isVolatileFunction(name string) bool {
+	name = strings.ToUpper(name)
+	switch name {
+	case "RAND", "UUID", "AES_ENCRYPT":
+		return true
+	case "NOW", "JSON_OBJECT":
+		return false
+	}
+	_, ok := Functions[name]
+	return !ok
+}
+
+func isBuiltInAggregateFunction(name string) bool {
+	name = strings.ToUpper(name)
+	_, ok := AggregateFunctions[name]
+	return ok || OrderedSetAggregateFunctions[name]
+}
+
+// UncorrelatedSubquery is a subquery that does not refer to any values of the outer query.
+// The subquery is executed only once in a statement, and the result is kept as a view
+// and a hash set of the records.
+type UncorrelatedSubquery struct {
+	uncorrelated bool
+	volatile     bool
+
+	view *View
+	set  *RowValueSet
+
+	once    *sync.Once
+	setOnce *sync.Once
+}
+
+func NewUncorrelatedSubquery() *UncorrelatedSubquery {
+	return &UncorrelatedSubquery{
+		once:    &sync.Once{},
+		setOnce: &sync.Once{},
+	}
+}
+
+func (sq *UncorrelatedSubquery) Prepare(expr parser.Subquery, filter *Filter) bool {
+	if sq.volatile {
+		return false
+	}
+
+	sq.once.Do(func() {
+		innerFilter := filter.CreateNode()
+		innerFilter.Records = nil
+
+		view, err := Select(expr.Query, innerFilter)
+		if err != nil {
+			return
+		}
+		sq.view = view
+		sq.uncorrelated = true
+	})
+	return sq.uncorrelated
+}
+
+func (sq *UncorrelatedSubquery) View() *View {
+	return sq.view
+}
+
+func (sq *UncorrelatedSubquery) RowValueSet() *RowValueSet {
+	sq.setOnce.Do(func() {
+		list := make([]value.RowValue, sq.view.RecordLen())
+		for i, r := range sq.view.RecordSet {
+			rowValue := make(value.RowValue, sq.view.FieldLen())
+			for j, cell := range r {
+				rowValue[j] = cell.Value()
+			}
+			list[i] = rowValue
+		}
+		sq.set = NewRowValueSet(list)
+	})
+	return sq.set
+}
+
+const (
+	integerComparable uint8 = 1 << iota
+	floatComparable
+	datetimeComparable
+	booleanComparable
+	stringComparable
+)
+
+func comparableTypes(p value.Primary) uint8 {
+	var t uint8
+	if !value.IsNull(value.ToInteger(p)) {
+		t |= integerComparable
+	}
+	if !value.IsNull(value.ToFloat(p)) {
+		t |= floatComparable
+	}
+	if !value.IsNull(value.ToDatetime(p)) {
+		t |= datetimeComparable
+	}
+	if !value.IsNull(value.ToBoolean(p)) {
+		t |= booleanComparable
+	}
+	if _, ok := p.(value.String); ok {
+		t |= stringComparable
+	}
+	return t
+}
+
+// RowValueSet is a hash set of row values used to evaluate equality with any of the values
+// in constant time. Row values are grouped by the same keys as GROUP BY clauses.
+//
+// A row value that matches no group can still be compared with some of the values as UNKNOWN,
+// when either one of them is null or their types are incommensurable. The set keeps the types
+// of the values in each column to decide that without comparing every value.
+type RowValueSet struct {
+	list   []value.RowValue
+	groups map[string][]value.RowValue
+
+	width          int
+	containsNull   bool
+	columnTypeSets []map[uint8]bool
+}
+
+func NewRowValueSet(list []value.RowValue) *RowValueSet {
+	set := &RowValueSet{
+		list:   list,
+		groups: make(map[string][]value.RowValue, len(list)),
+	}
+	if len(list) < 1 {
+		return set
+	}
+
+	set.width = len(list[0])
+	set.columnTypeSets = make([]map[uint8]bool, set.width)
+	for i := range set.columnTypeSets {
+		set.columnTypeSets[i] = make(map[uint8]bool)
+	}
+
+	keyBuf := new(bytes.Buffer)
+	for _, rowValue := range list {
+		if containsNull(rowValue) {
+			set.containsNull = true
+			continue
+		}
+
+		for i, p := range rowValue {
+			set.columnTypeSets[i][comparableTypes(p)] = true
+		}
+
+		keyBuf.Reset()
+		SerializeComparisonKeys(keyBuf, rowValue)
+		key := keyBuf.String()
+		set.groups[key] = append(set.groups[key], rowValue)
+	}
+	return set
+}
+
+// Contains returns the same result as Any(rowValue, list, "=").
+func (set *RowValueSet) Contains(rowValue value.RowValue) (ternary.Value, error) {
+	if len(set.list) < 1 {
+		return ternary.FALSE, nil
+	}
+	if rowValue == nil || len(rowValue) != set.width || containsNull(rowValue) {
+		return Any(rowValue, set.list, "=")
+	}
+
+	keyBuf := new(bytes.Buffer)
+	SerializeComparisonKeys(keyBuf, rowValue)
+	if group, ok := set.groups[keyBuf.String()]; ok {
+		if t, err := Any(rowValue, group, "="); err == nil && t == ternary.TRUE {
+			return t, nil
+		}
+		return Any(rowValue, set.list, "=")
+	}
+
+	if set.containsNull {
+		return set.unknownOrFalse(rowValue)
+	}
+	for i, p := range rowValue {
+		t := comparableTypes(p)
+		for types := range set.columnTypeSets[i] {
+			if t&types == 0 {
+				return set.unknownOrFalse(rowValue)
+			}
+		}
+	}
+	return ternary.FALSE, nil
+}
+
+func (set *RowValueSet) unknownOrFalse(rowValue value.RowValue) (ternary.Value, error) {
+	if set.width == 1 {
+		return ternary.UNKNOWN, nil
+	}
+	return Any(rowValue, set.list, "=")
+}
@@ -0,0 +1,371 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+var rowValueSetContainsList = []value.RowValue{
+	{value.NewInteger(1)},
+	{value.NewString("2")},
+	{value.NewFloat(3.5)},
+	{value.NewString(" abc ")},
+	{value.NewBoolean(false)},
+	{value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, GetTestLocation()))},
+}
+
+var rowValueSetContainsTests = []struct {
+	Name     string
+	List     []value.RowValue
+	RowValue value.RowValue
+	Result   ternary.Value
+	Error    string
+}{
+	{
+		Name:     "RowValueSet Contains Integer",
+		List:     rowValueSetContainsList,
+		RowValue: value.RowValue{value.NewFloat(1)},
+		Result:   ternary.TRUE,
+	},
+	{
+		Name:     "RowValueSet Contains Numeric String",
+		List:     rowValueSetContainsList,
+		RowValue: value.RowValue{value.NewInteger(2)},
+		Result:   ternary.TRUE,
+	},
+	{
+		Name:     "RowValueSet Contains String",
+		List:     rowValueSetContainsList,
+		RowValue: value.RowValue{value.NewString("ABC")},
+		Result:   ternary.TRUE,
+	},
+	{
+		Name:     "RowValueSet Contains Datetime",
+		List:     rowValueSetContainsList,
+		RowValue: value.RowValue{value.NewString("2012-02-03")},
+		Result:   ternary.TRUE,
+	},
+	{
+		Name:     "RowValueSet Contains Boolean",
+		List:     rowValueSetContainsList,
+		RowValue: value.RowValue{value.NewString("false")},
+		Result:   ternary.TRUE,
+	},
+	{
+		Name:     "RowValueSet Does Not Contain",
+		List:     []value.RowValue{{value.NewInteger(1)}, {value.NewInteger(2)}},
+		RowValue: value.RowValue{value.NewInteger(3)},
+		Result:   ternary.FALSE,
+	},
+	{
+		Name:     "RowValueSet Incommensurable Types",
+		List:     rowValueSetContainsList,
+		RowValue: value.RowValue{value.NewInteger(4)},
+		Result:   ternary.UNKNOWN,
+	},
+	{
+		Name:     "RowValueSet Contains Null",
+		List:     []value.RowValue{{value.NewInteger(1)}, {value.NewNull()}},
+		RowValue: value.RowValue{value.NewInteger(3)},
+		Result:   ternary.UNKNOWN,
+	},
+	{
+		Name:     "RowValueSet Null Value",
+		List:     []value.RowValue{{value.NewInteger(1)}},
+		RowValue: value.RowValue{value.NewNull()},
+		Result:   ternary.UNKNOWN,
+	},
+	{
+		Name:     "RowValueSet Empty List",
+		List:     []value.RowValue{},
+		RowValue: value.RowValue{value.NewInteger(1)},
+		Result:   ternary.FALSE,
+	},
+	{
+		Name: "RowValueSet Contains Row Value",
+		List: []value.RowValue{
+			{value.NewInteger(1), value.NewString("a")},
+			{value.NewInteger(2), value.NewString("b")},
+		},
+		RowValue: value.RowValue{value.NewString("2"), value.NewString("B")},
+		Result:   ternary.TRUE,
+	},
+	{
+		Name: "RowValueSet Row Value Partially Incommensurable",
+		List: []value.RowValue{
+			{value.NewInteger(1), value.NewString("a")},
+			{value.NewString("b"), value.NewString("b")},
+		},
+		RowValue: value.RowValue{value.NewInteger(2), value.NewString("b")},
+		Result:   ternary.UNKNOWN,
+	},
+	{
+		Name: "RowValueSet Row Value Length Error",
+		List: []value.RowValue{
+			{value.NewInteger(1), value.NewString("a")},
+		},
+		RowValue: value.RowValue{value.NewInteger(1), value.NewString("a"), value.NewString("b")},
+		Error:    "[L:- C:-] row value length does not match at index 0",
+	},
+}
+
+func TestRowValueSet_Contains(t *testing.T) {
+	for _, v := range rowValueSetContainsTests {
+		result, err := NewRowValueSet(v.List).Contains(v.RowValue)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result != v.Result {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+
+		expect, _ := Any(v.RowValue, v.List, "=")
+		if result != expect {
+			t.Errorf("%s: result = %s, but Any returns %s", v.Name, result, expect)
+		}
+	}
+}
+
+var uncorrelatedSubqueryEvaluateTests = []struct {
+	Name   string
+	Query  string
+	Result [][]value.Primary
+	Error  string
+}{
+	{
+		Name:  "Evaluate In Uncorrelated Subquery",
+		Query: "SELECT column1 FROM table1 WHERE column1 IN (SELECT column3 FROM table2)",
+		Result: [][]value.Primary{
+			{value.NewString("2")},
+			{value.NewString("3")},
+		},
+	},
+	{
+		Name:  "Evaluate Not In Uncorrelated Subquery",
+		Query: "SELECT column1 FROM table1 WHERE column1 NOT IN (SELECT column3 FROM table2)",
+		Result: [][]value.Primary{
+			{value.NewString("1")},
+		},
+	},
+	{
+		Name:  "Evaluate In Uncorrelated Subquery with Row Values",
+		Query: "SELECT column1 FROM table1 WHERE (column1, column2) IN (SELECT column1, column2 FROM group_table)",
+		Result: [][]value.Primary{
+			{value.NewString("1")},
+		},
+	},
+	{
+		Name:  "Evaluate In Correlated Subquery",
+		Query: "SELECT column1 FROM table1 t WHERE column1 IN (SELECT column3 FROM table2 WHERE column4 = t.column2 || column3)",
+		Result: [][]value.Primary{
+			{value.NewString("2")},
+			{value.NewString("3")},
+		},
+	},
+	{
+		Name:  "Evaluate In Uncorrelated Subquery Too Many Fields Error",
+		Query: "SELECT column1 FROM table1 WHERE column1 IN (SELECT column3, column4 FROM table2)",
+		Error: "[L:1 C:45] subquery returns too many fields, should return only one field",
+	},
+	{
+		Name:  "Evaluate In Uncorrelated Subquery Field Length Error",
+		Query: "SELECT column1 FROM table1 WHERE (column1, column2) IN (SELECT column3 FROM table2)",
+		Error: "[L:1 C:56] select query should return exactly 2 fields",
+	},
+	{
+		Name:  "Evaluate Exists Uncorrelated Subquery",
+		Query: "SELECT column1 FROM table1 WHERE EXISTS (SELECT 1 FROM table2 WHERE column3 = 4)",
+		Result: [][]value.Primary{
+			{value.NewString("1")},
+			{value.NewString("2")},
+			{value.NewString("3")},
+		},
+	},
+	{
+		Name:  "Evaluate Exists Correlated Subquery",
+		Query: "SELECT column1 FROM table1 t WHERE EXISTS (SELECT 1 FROM table2 WHERE column3 = t.column1)",
+		Result: [][]value.Primary{
+			{value.NewString("2")},
+			{value.NewString("3")},
+		},
+	},
+	{
+		Name:  "Evaluate Scalar Uncorrelated Subqueries",
+		Query: "SELECT column1, (SELECT MAX(column3) FROM table2) FROM table1 WHERE column1 < (SELECT MAX(column3) FROM table2) - 1",
+		Result: [][]value.Primary{
+			{value.NewString("1"), value.NewString("4")},
+			{value.NewString("2"), value.NewString("4")},
+		},
+	},
+	{
+		Name:  "Evaluate Scalar Uncorrelated Subquery Too Many Records Error",
+		Query: "SELECT column1, (SELECT column3 FROM table2) FROM table1",
+		Error: "[L:1 C:17] subquery returns too many records, should return only one record",
+	},
+}
+
+func TestSubqueryCache_Uncorrelated(t *testing.T) {
+	query := parser.SelectQuery{
+		SelectEntity: parser.SelectEntity{
+			SelectClause: parser.SelectClause{
+				Select: "SELECT",
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}},
+				},
+			},
+			FromClause: parser.FromClause{
+				From:   "FROM",
+				Tables: []parser.QueryExpression{parser.Table{Object: parser.Identifier{Literal: "table2"}}},
+			},
+		},
+	}
+	subquery1 := parser.Subquery{BaseExpr: &parser.BaseExpr{}, Query: query}
+	subquery2 := parser.Subquery{BaseExpr: &parser.BaseExpr{}, Query: query}
+
+	cache := NewSubqueryCache()
+	filter := NewEmptyFilter()
+
+	sq := cache.Uncorrelated(subquery1, filter)
+	if cache.Uncorrelated(subquery1, filter) != sq {
+		t.Error("the same subquery is not cached")
+	}
+	if cache.Uncorrelated(subquery2, filter) != sq {
+		t.Error("identical subqueries are not shared")
+	}
+
+	scope := filter.CreateNode()
+	scope.InlineTables[0]["TABLE2"] = &View{}
+	subquery3 := parser.Subquery{BaseExpr: &parser.BaseExpr{}, Query: query}
+	if cache.Uncorrelated(subquery3, scope) == sq {
+		t.Error("identical subqueries in different scopes of inline tables are shared")
+	}
+
+	rand := query
+	rand.SelectEntity = parser.SelectEntity{
+		SelectClause: parser.SelectClause{
+			Select: "SELECT",
+			Fields: []parser.QueryExpression{
+				parser.Field{Object: parser.Function{Name: "rand"}},
+			},
+		},
+	}
+	subquery4 := parser.Subquery{BaseExpr: &parser.BaseExpr{}, Query: rand}
+	if cache.Uncorrelated(subquery4, filter).Prepare(subquery4, filter) {
+		t.Error("subquery including a volatile function is regarded as uncorrelated")
+	}
+}
+
+var isVolatileQueryTests = []struct {
+	Query  string
+	Result bool
+}{
+	{
+		Query:  "SELECT column1 FROM table1 WHERE column2 = 'str'",
+		Result: false,
+	},
+	{
+		Query:  "SELECT RAND()",
+		Result: true,
+	},
+	{
+		Query:  "SELECT UUID()",
+		Result: true,
+	},
+	{
+		Query:  "SELECT AES_ENCRYPT('str', 'key')",
+		Result: true,
+	},
+	{
+		Query:  "SELECT NOW(), COUNT(*), SUM(column1) OVER () FROM table1",
+		Result: false,
+	},
+	{
+		Query:  "SELECT 'RAND()', ':=', `rand()` FROM table1",
+		Result: false,
+	},
+	{
+		Query:  "SELECT @var := 1",
+		Result: true,
+	},
+	{
+		Query:  "SELECT userfunc(column1) FROM table1",
+		Result: true,
+	},
+	{
+		Query:  "SELECT useraggfunc(DISTINCT column1) FROM table1",
+		Result: true,
+	},
+	{
+		Query:  "SELECT column1 FROM table1 WHERE EXISTS (SELECT RAND())",
+		Result: true,
+	},
+}
+
+func TestIsVolatileQuery(t *testing.T) {
+	for _, v := range isVolatileQueryTests {
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Query, err)
+		}
+
+		result := isVolatileQuery(program[0].(parser.SelectQuery))
+		if result != v.Result {
+			t.Errorf("%s: result = %t, want %t", v.Query, result, v.Result)
+		}
+	}
+}
+
+func TestUncorrelatedSubquery_Evaluate(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	for _, v := range uncorrelatedSubqueryEvaluateTests {
+		ViewCache.Clean()
+
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		view, err := Select(program[0].(parser.SelectQuery), NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		result := make([][]value.Primary, view.RecordLen())
+		for i, record := range view.RecordSet {
+			result[i] = make([]value.Primary, len(record))
+			for j, cell := range record {
+				result[i][j] = cell.Value()
+			}
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
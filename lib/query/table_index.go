@@ -0,0 +1,574 @@
+package query
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+type indexMethod int
+
+const (
+	hashIndex indexMethod = iota
+	btreeIndex
+)
+
+func parseIndexMethod(method parser.Identifier) (indexMethod, error) {
+	switch strings.ToUpper(method.Literal) {
+	case "", "HASH":
+		return hashIndex, nil
+	case "BTREE":
+		return btreeIndex, nil
+	}
+	return hashIndex, NewInvalidIndexMethodError(method)
+}
+
+// TableIndex is an index on columns of a table held in memory.
+type TableIndex struct {
+	Name    parser.Identifier
+	Path    string
+	Columns []string
+	Method  indexMethod
+
+	mtx         sync.Mutex
+	source      *View
+	records     RecordSet
+	keySettings string
+
+	buckets map[string][]int
+
+	keys      []string
+	positions []int
+
+	numbers         []float64
+	numberPositions []int
+}
+
+func indexKeySettings() string {
+	flags := cmd.GetFlags()
+	return flags.Collation + "\n" + flags.Location + "\n" + strings.Join(flags.DatetimeFormat, "\n")
+}
+
+func (idx *TableIndex) prepare(view *View) bool {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	keySettings := indexKeySettings()
+	if idx.source == view && isSameRecordSet(idx.records, view.RecordSet) && idx.keySettings == keySettings {
+		return idx.buckets != nil || idx.keys != nil
+	}
+
+	idx.source = view
+	idx.records = view.RecordSet
+	idx.keySettings = keySettings
+	idx.buckets = nil
+	idx.keys = nil
+	idx.positions = nil
+	idx.numbers = nil
+	idx.numberPositions = nil
+
+	fieldIndices := make([]int, len(idx.Columns))
+	for i, column := range idx.Columns {
+		fieldIndices[i] = -1
+		for j, f := range view.Header {
+			if f.IsFromTable && strings.EqualFold(f.Column, column) {
+				fieldIndices[i] = j
+				break
+			}
+		}
+		if fieldIndices[i] < 0 {
+			return false
+		}
+	}
+
+	keys := make([]string, view.RecordLen())
+	hasKey := make([]bool, view.RecordLen())
+	values := make([]value.Primary, len(fieldIndices))
+	keyBuf := new(bytes.Buffer)
+	for i, record := range view.RecordSet {
+		for j, fieldIdx := range fieldIndices {
+			values[j] = record[fieldIdx].Value()
+		}
+		if containsNull(values) {
+			continue
+		}
+
+		keyBuf.Reset()
+		SerializeComparisonKeys(keyBuf, values)
+		keys[i] = keyBuf.String()
+		hasKey[i] = true
+	}
+
+	switch idx.Method {
+	case btreeIndex:
+		positions := make([]int, 0, len(keys))
+		for i := range keys {
+			if hasKey[i] {
+				positions = append(positions, i)
+			}
+		}
+		sort.SliceStable(positions, func(i, j int) bool {
+			return keys[positions[i]] < keys[positions[j]]
+		})
+		idx.keys = make([]string, len(positions))
+		for i, pos := range positions {
+			idx.keys[i] = keys[pos]
+		}
+		idx.positions = positions
+
+		numbers := make([]float64, view.RecordLen())
+		numberPositions := make([]int, 0, view.RecordLen())
+		for i, record := range view.RecordSet {
+			if f := value.ToFloat(record[fieldIndices[0]].Value()); !value.IsNull(f) {
+				numbers[i] = f.(value.Float).Raw()
+				numberPositions = append(numberPositions, i)
+			}
+		}
+		sort.SliceStable(numberPositions, func(i, j int) bool {
+			return numbers[numberPositions[i]] < numbers[numberPositions[j]]
+		})
+		idx.numbers = make([]float64, len(numberPositions))
+		for i, pos := range numberPositions {
+			idx.numbers[i] = numbers[pos]
+		}
+		idx.numberPositions = numberPositions
+	default:
+		idx.buckets = buildJoinBuckets(keys, hasKey)
+	}
+	return true
+}
+
+func (idx *TableIndex) lookup(key string) []int {
+	if idx.Method == hashIndex {
+		return idx.buckets[key]
+	}
+
+	i := sort.SearchStrings(idx.keys, key)
+	j := i
+	for j < len(idx.keys) && idx.keys[j] == key {
+		j++
+	}
+	return idx.positions[i:j]
+}
+
+func (idx *TableIndex) lookupRange(r indexRange) []int {
+	i := 0
+	if r.hasLow {
+		i = sort.SearchFloat64s(idx.numbers, r.low)
+	}
+	j := len(idx.numbers)
+	if r.hasHigh {
+		j = sort.Search(len(idx.numbers), func(k int) bool { return r.high < idx.numbers[k] })
+	}
+	if j <= i {
+		return nil
+	}
+
+	positions := make([]int, j-i)
+	copy(positions, idx.numberPositions[i:j])
+	sort.Ints(positions)
+	return positions
+}
+
+func isSameRecordSet(r1 RecordSet, r2 RecordSet) bool {
+	if len(r1) != len(r2) {
+		return false
+	}
+	return len(r1) < 1 || &r1[0] == &r2[0]
+}
+
+type indexRange struct {
+	low     float64
+	high    float64
+	hasLow  bool
+	hasHigh bool
+}
+
+func (r *indexRange) setLow(f float64) {
+	if !r.hasLow || r.low < f {
+		r.low = f
+		r.hasLow = true
+	}
+}
+
+func (r *indexRange) setHigh(f float64) {
+	if !r.hasHigh || f < r.high {
+		r.high = f
+		r.hasHigh = true
+	}
+}
+
+type TableIndexMap map[string]*TableIndex
+
+func NewTableIndexMap() TableIndexMap {
+	return make(TableIndexMap, 4)
+}
+
+func (m TableIndexMap) Create(expr parser.CreateIndex, filter *Filter) error {
+	uname := strings.ToUpper(expr.Name.Literal)
+	if _, ok := m[uname]; ok {
+		return NewIndexAlreadyExistError(expr.Name)
+	}
+
+	method, err := parseIndexMethod(expr.Method)
+	if err != nil {
+		return err
+	}
+
+	view := NewView()
+	if err := view.Load(parser.FromClause{Tables: []parser.QueryExpression{parser.Table{Object: expr.Table}}}, filter.CreateNode()); err != nil {
+		return err
+	}
+
+	columns := make([]string, len(expr.Columns))
+	for i, column := range expr.Columns {
+		idx, err := view.FieldIndex(column)
+		if err != nil {
+			return err
+		}
+		columns[i] = view.Header[idx].Column
+	}
+
+	index := &TableIndex{
+		Name:    expr.Name,
+		Path:    strings.ToUpper(view.FileInfo.Path),
+		Columns: columns,
+		Method:  method,
+	}
+	if source := sourceView(view.FileInfo.Path, filter); source != nil {
+		index.prepare(source)
+	}
+	m[uname] = index
+	return nil
+}
+
+func (m TableIndexMap) Drop(name parser.Identifier) error {
+	uname := strings.ToUpper(name.Literal)
+	if _, ok := m[uname]; !ok {
+		return NewIndexNotExistError(name)
+	}
+	delete(m, uname)
+	return nil
+}
+
+func sourceView(path string, filter *Filter) *View {
+	upath := strings.ToUpper(path)
+	for _, m := range filter.TempViews {
+		if view, ok := m[upath]; ok {
+			return view
+		}
+	}
+	return ViewCache[upath]
+}
+
+func (m TableIndexMap) indexes(view *View, filter *Filter) []*TableIndex {
+	if len(m) < 1 || view.FileInfo == nil {
+		return nil
+	}
+
+	source := sourceView(view.FileInfo.Path, filter)
+	if source == nil || source.FileInfo != view.FileInfo || source.RecordLen() != view.RecordLen() {
+		return nil
+	}
+
+	upath := strings.ToUpper(view.FileInfo.Path)
+	var list []*TableIndex
+	for _, idx := range m {
+		if idx.Path == upath && idx.prepare(source) {
+			list = append(list, idx)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return strings.ToUpper(list[i].Name.Literal) < strings.ToUpper(list[j].Name.Literal)
+	})
+	return list
+}
+
+func indexedField(expr parser.QueryExpression, view *View) (string, bool) {
+	switch expr.(type) {
+	case parser.FieldReference, parser.ColumnNumber:
+	default:
+		return "", false
+	}
+
+	idx, err := view.FieldIndex(expr)
+	if err != nil || !view.Header[idx].IsFromTable {
+		return "", false
+	}
+	return strings.ToUpper(view.Header[idx].Column), true
+}
+
+func isLookupValue(expr parser.QueryExpression, view *View) bool {
+	switch expr.(type) {
+	case parser.PrimitiveType, parser.Variable, parser.Placeholder:
+		return true
+	case parser.FieldReference, parser.ColumnNumber:
+		_, err := view.FieldIndex(expr)
+		_, ok := err.(*FieldNotExistError)
+		return ok
+	case parser.Parentheses:
+		return isLookupValue(expr.(parser.Parentheses).Expr, view)
+	case parser.Arithmetic:
+		e := expr.(parser.Arithmetic)
+		return isLookupValue(e.LHS, view) && isLookupValue(e.RHS, view)
+	case parser.UnaryArithmetic:
+		return isLookupValue(expr.(parser.UnaryArithmetic).Operand, view)
+	case parser.Concat:
+		for _, item := range expr.(parser.Concat).Items {
+			if !isLookupValue(item, view) {
+				return false
+			}
+		}
+		return true
+	case parser.Function:
+		if !isDecorrelatableNode(expr, nil) {
+			return false
+		}
+		for _, arg := range expr.(parser.Function).Args {
+			if !isLookupValue(arg, view) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func indexJoinKeys(view *View, keyExprs []parser.QueryExpression, otherKeyExprs []parser.QueryExpression, filter *Filter) (*TableIndex, []parser.QueryExpression) {
+	indexes := TableIndexes.indexes(view, filter)
+	if len(indexes) < 1 {
+		return nil, nil
+	}
+
+	fields := make(map[string]parser.QueryExpression, len(keyExprs))
+	for i, expr := range keyExprs {
+		if column, ok := indexedField(expr, view); ok {
+			if _, ok := fields[column]; !ok {
+				fields[column] = otherKeyExprs[i]
+			}
+		}
+	}
+
+	var index *TableIndex
+	var exprs []parser.QueryExpression
+
+IndexLoop:
+	for _, idx := range indexes {
+		if index != nil && len(idx.Columns) <= len(index.Columns) {
+			continue
+		}
+
+		list := make([]parser.QueryExpression, len(idx.Columns))
+		for i, column := range idx.Columns {
+			expr, ok := fields[strings.ToUpper(column)]
+			if !ok {
+				continue IndexLoop
+			}
+			list[i] = expr
+		}
+		index = idx
+		exprs = list
+	}
+	return index, exprs
+}
+
+func lookupIndex(view *View, clause parser.WhereClause, filter *Filter) (*TableIndex, bool) {
+	indexes := TableIndexes.indexes(view, filter)
+	if len(indexes) < 1 {
+		return nil, false
+	}
+
+	equalities := make(map[string]parser.QueryExpression)
+	ranges := make(map[string][]parser.QueryExpression)
+
+	for _, expr := range splitConjunction(clause.Filter) {
+		switch expr.(type) {
+		case parser.Comparison:
+			e := expr.(parser.Comparison)
+			if column, ok := indexedField(e.LHS, view); ok && isLookupValue(e.RHS, view) {
+				if e.Operator == "=" {
+					if _, ok := equalities[column]; !ok {
+						equalities[column] = e.RHS
+					}
+				} else {
+					ranges[column] = append(ranges[column], e)
+				}
+			} else if column, ok := indexedField(e.RHS, view); ok && isLookupValue(e.LHS, view) {
+				if e.Operator == "=" {
+					if _, ok := equalities[column]; !ok {
+						equalities[column] = e.LHS
+					}
+				} else {
+					ranges[column] = append(ranges[column], e)
+				}
+			}
+		case parser.Between:
+			e := expr.(parser.Between)
+			if column, ok := indexedField(e.LHS, view); ok && e.Negation.IsEmpty() && isLookupValue(e.Low, view) && isLookupValue(e.High, view) {
+				ranges[column] = append(ranges[column], e)
+			}
+		}
+	}
+
+	var index *TableIndex
+	var exprs []parser.QueryExpression
+
+EqualityLoop:
+	for _, idx := range indexes {
+		if index != nil && len(idx.Columns) <= len(index.Columns) {
+			continue
+		}
+
+		list := make([]parser.QueryExpression, len(idx.Columns))
+		for i, column := range idx.Columns {
+			expr, ok := equalities[strings.ToUpper(column)]
+			if !ok {
+				continue EqualityLoop
+			}
+			list[i] = expr
+		}
+		index = idx
+		exprs = list
+	}
+
+	if index != nil {
+		values := make([]value.Primary, len(exprs))
+		for i, expr := range exprs {
+			p, err := filter.Evaluate(expr)
+			if err != nil {
+				return nil, false
+			}
+			values[i] = p
+		}
+
+		var positions []int
+		if !containsNull(values) {
+			keyBuf := new(bytes.Buffer)
+			SerializeComparisonKeys(keyBuf, values)
+			positions = index.lookup(keyBuf.String())
+		}
+		view.RecordSet = selectRecords(view.RecordSet, positions)
+		return index, true
+	}
+
+	for _, idx := range indexes {
+		if idx.Method != btreeIndex {
+			continue
+		}
+		conditions, ok := ranges[strings.ToUpper(idx.Columns[0])]
+		if !ok {
+			continue
+		}
+
+		r, isEmpty, ok := evalIndexRange(conditions, view, filter)
+		if !ok {
+			continue
+		}
+
+		var positions []int
+		if !isEmpty {
+			positions = idx.lookupRange(r)
+		}
+		view.RecordSet = selectRecords(view.RecordSet, positions)
+		return idx, true
+	}
+	return nil, false
+}
+
+func evalIndexRange(conditions []parser.QueryExpression, view *View, filter *Filter) (indexRange, bool, bool) {
+	var r indexRange
+
+	var eval = func(expr parser.QueryExpression) (float64, bool, bool) {
+		p, err := filter.Evaluate(expr)
+		if err != nil {
+			return 0, false, false
+		}
+		switch p.(type) {
+		case value.Null:
+			return 0, true, true
+		case value.Integer:
+			return float64(p.(value.Integer).Raw()), false, true
+		case value.Float:
+			return p.(value.Float).Raw(), false, true
+		}
+		return 0, false, false
+	}
+
+	hasRange := false
+	isEmpty := false
+	for _, expr := range conditions {
+		switch expr.(type) {
+		case parser.Comparison:
+			e := expr.(parser.Comparison)
+			operator := e.Operator
+			bound := e.RHS
+			if _, ok := indexedField(e.LHS, view); !ok {
+				bound = e.LHS
+				switch operator {
+				case "<":
+					operator = ">"
+				case "<=":
+					operator = ">="
+				case ">":
+					operator = "<"
+				case ">=":
+					operator = "<="
+				}
+			}
+
+			switch operator {
+			case "<", "<=", ">", ">=":
+			default:
+				continue
+			}
+
+			f, isNull, ok := eval(bound)
+			if !ok {
+				continue
+			}
+			hasRange = true
+			if isNull {
+				isEmpty = true
+				continue
+			}
+
+			switch operator {
+			case "<", "<=":
+				r.setHigh(f)
+			default:
+				r.setLow(f)
+			}
+		case parser.Between:
+			e := expr.(parser.Between)
+			low, lowIsNull, ok := eval(e.Low)
+			if !ok {
+				continue
+			}
+			high, highIsNull, ok := eval(e.High)
+			if !ok {
+				continue
+			}
+			hasRange = true
+			if lowIsNull || highIsNull {
+				isEmpty = true
+				continue
+			}
+			r.setLow(low)
+			r.setHigh(high)
+		}
+	}
+	return r, isEmpty, hasRange
+}
+
+func selectRecords(records RecordSet, positions []int) RecordSet {
+	selected := make(RecordSet, len(positions))
+	for i, pos := range positions {
+		selected[i] = records[pos]
+	}
+	return selected
+}
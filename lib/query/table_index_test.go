@@ -0,0 +1,336 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func tableIndexTestFilter() *Filter {
+	records := make(RecordSet, 50)
+	for i := range records {
+		var x value.Primary = value.NewString(value.Int64ToStr(int64(i % 10)))
+		if i%7 == 0 {
+			x = value.NewNull()
+		} else if i%11 == 0 {
+			x = value.NewString("abc")
+		}
+		records[i] = NewRecord([]value.Primary{value.NewInteger(int64(i)), x, value.NewString(string(rune('a' + i%3)))})
+	}
+
+	filter := NewEmptyFilter()
+	filter.TempViews = TemporaryViewScopes{
+		ViewMap{
+			"VIEW1": &View{
+				Header:    NewHeader("view1", []string{"id", "x", "y"}),
+				RecordSet: records,
+				FileInfo:  &FileInfo{Path: "view1", IsTemporary: true},
+			},
+			"VIEW2": &View{
+				Header: NewHeader("view2", []string{"x", "name"}),
+				RecordSet: RecordSet{
+					NewRecord([]value.Primary{value.NewInteger(3), value.NewString("three")}),
+					NewRecord([]value.Primary{value.NewInteger(1), value.NewString("one")}),
+					NewRecord([]value.Primary{value.NewNull(), value.NewString("null")}),
+					NewRecord([]value.Primary{value.NewInteger(3), value.NewString("three again")}),
+				},
+				FileInfo: &FileInfo{Path: "view2", IsTemporary: true},
+			},
+		},
+	}
+	_ = filter.Variables[0].Add(parser.Variable{Name: "var"}, value.NewInteger(3))
+	return filter
+}
+
+var tableIndexCreateTests = []struct {
+	Name  string
+	Query string
+	Error string
+}{
+	{
+		Name:  "Create Hash Index",
+		Query: "CREATE INDEX idx ON view1 (x)",
+	},
+	{
+		Name:  "Create Btree Index",
+		Query: "CREATE INDEX idx2 ON view1 (x, view1.y) USING BTREE",
+	},
+	{
+		Name:  "Index Already Exists",
+		Query: "CREATE INDEX IDX ON view2 (x)",
+		Error: "[L:1 C:14] index IDX already exists",
+	},
+	{
+		Name:  "Invalid Index Method",
+		Query: "CREATE INDEX idx3 ON view1 (x) USING BITMAP",
+		Error: "[L:1 C:38] BITMAP is an unknown index method",
+	},
+	{
+		Name:  "Field Not Exist",
+		Query: "CREATE INDEX idx3 ON view1 (notexist)",
+		Error: "[L:1 C:29] field notexist does not exist",
+	},
+	{
+		Name:  "Drop Index",
+		Query: "DROP INDEX idx2",
+	},
+	{
+		Name:  "Drop Index Not Exist",
+		Query: "DROP INDEX idx2",
+		Error: "[L:1 C:12] index idx2 does not exist",
+	},
+}
+
+func TestTableIndexMap_Create(t *testing.T) {
+	defer func() {
+		TableIndexes = NewTableIndexMap()
+	}()
+
+	proc := NewProcedure()
+	proc.Filter = tableIndexTestFilter()
+	for _, v := range tableIndexCreateTests {
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		_, err = proc.ExecuteStatement(program[0])
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+
+	if idx, ok := TableIndexes["IDX"]; !ok {
+		t.Errorf("index IDX is not created")
+	} else if idx.Path != "VIEW1" || !reflect.DeepEqual(idx.Columns, []string{"x"}) || idx.Method != hashIndex {
+		t.Errorf("index = %s %s %v %d, want %s %s %v %d", idx.Name, idx.Path, idx.Columns, idx.Method, "idx", "VIEW1", []string{"x"}, hashIndex)
+	}
+	if _, ok := TableIndexes["IDX2"]; ok {
+		t.Errorf("index IDX2 is not dropped")
+	}
+}
+
+var tableIndexLookupTests = []struct {
+	Name      string
+	Indexes   []string
+	Query     string
+	IndexName string
+	Count     int
+}{
+	{
+		Name:      "Equality",
+		Indexes:   []string{"CREATE INDEX idx ON view1 (x)"},
+		Query:     "SELECT id FROM view1 WHERE x = 3",
+		IndexName: "idx",
+		Count:     4,
+	},
+	{
+		Name:      "Equality with Variable",
+		Indexes:   []string{"CREATE INDEX idx ON view1 (x) USING BTREE"},
+		Query:     "SELECT id FROM view1 WHERE @var = x AND id > 10",
+		IndexName: "idx",
+		Count:     4,
+	},
+	{
+		Name:      "Equality with Null",
+		Indexes:   []string{"CREATE INDEX idx ON view1 (x)"},
+		Query:     "SELECT id FROM view1 WHERE x = NULL",
+		IndexName: "idx",
+		Count:     0,
+	},
+	{
+		Name:      "Multiple Columns",
+		Indexes:   []string{"CREATE INDEX idx ON view1 (x)", "CREATE INDEX idx2 ON view1 (y, x)"},
+		Query:     "SELECT id FROM view1 WHERE x = '1' AND y = 'B'",
+		IndexName: "idx2",
+		Count:     2,
+	},
+	{
+		Name:      "Range",
+		Indexes:   []string{"CREATE INDEX idx ON view1 (x) USING BTREE"},
+		Query:     "SELECT id FROM view1 WHERE x > 4 AND 7 >= x",
+		IndexName: "idx",
+		Count:     16,
+	},
+	{
+		Name:      "Between",
+		Indexes:   []string{"CREATE INDEX idx ON view1 (x) USING BTREE"},
+		Query:     "SELECT id FROM view1 WHERE x BETWEEN 2 AND 3.5",
+		IndexName: "idx",
+		Count:     7,
+	},
+	{
+		Name:    "Range with Hash Index",
+		Indexes: []string{"CREATE INDEX idx ON view1 (x)"},
+		Query:   "SELECT id FROM view1 WHERE x > 4",
+		Count:   50,
+	},
+	{
+		Name:    "Range with String",
+		Indexes: []string{"CREATE INDEX idx ON view1 (x) USING BTREE"},
+		Query:   "SELECT id FROM view1 WHERE x > '4'",
+		Count:   50,
+	},
+	{
+		Name:    "Not Lookup Value",
+		Indexes: []string{"CREATE INDEX idx ON view1 (x)"},
+		Query:   "SELECT id FROM view1 WHERE x = id",
+		Count:   50,
+	},
+	{
+		Name:    "Disjunction",
+		Indexes: []string{"CREATE INDEX idx ON view1 (x)"},
+		Query:   "SELECT id FROM view1 WHERE x = 3 OR id = 1",
+		Count:   50,
+	},
+}
+
+func TestSelect_IndexLookup(t *testing.T) {
+	defer func() {
+		TableIndexes = NewTableIndexMap()
+	}()
+
+	for _, v := range tableIndexLookupTests {
+		TableIndexes = NewTableIndexMap()
+		filter := tableIndexTestFilter()
+
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+		query := program[0].(parser.SelectQuery)
+		expect, err := Select(query, filter)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		for _, stmt := range v.Indexes {
+			p, _ := parser.Parse(stmt, "")
+			if err := TableIndexes.Create(p[0].(parser.CreateIndex), filter); err != nil {
+				t.Fatalf("%s: unexpected error %q", v.Name, err)
+			}
+		}
+
+		entity := query.SelectEntity.(parser.SelectEntity)
+		view := NewView()
+		if err := view.Load(entity.FromClause.(parser.FromClause), filter.CreateNode()); err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+		index, _ := lookupIndex(view, entity.WhereClause.(parser.WhereClause), view.Filter)
+		indexName := ""
+		if index != nil {
+			indexName = index.Name.Literal
+		}
+		if indexName != v.IndexName {
+			t.Errorf("%s: index = %q, want %q", v.Name, indexName, v.IndexName)
+		}
+		if view.RecordLen() != v.Count {
+			t.Errorf("%s: %d candidates, want %d", v.Name, view.RecordLen(), v.Count)
+		}
+
+		result, err := Select(query, filter)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if !reflect.DeepEqual(result.RecordSet, expect.RecordSet) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result.RecordSet, expect.RecordSet)
+		}
+	}
+}
+
+func TestSelect_IndexJoin(t *testing.T) {
+	defer func() {
+		TableIndexes = NewTableIndexMap()
+	}()
+
+	queries := []string{
+		"SELECT view1.id, view2.name FROM view1 JOIN view2 ON view1.x = view2.x",
+		"SELECT view1.id, view2.name FROM view2 JOIN view1 ON view2.x = view1.x AND view1.y <> 'a'",
+		"SELECT view1.id, view2.name FROM view2 LEFT JOIN view1 ON view1.x = view2.x",
+		"SELECT view1.id, view2.name FROM view2 RIGHT JOIN view1 ON view1.x = view2.x",
+	}
+
+	for _, method := range []string{"HASH", "BTREE"} {
+		TableIndexes = NewTableIndexMap()
+		filter := tableIndexTestFilter()
+
+		for _, stmt := range []string{"CREATE INDEX idx1 ON view1 (x) USING " + method, "CREATE INDEX idx2 ON view2 (x) USING " + method} {
+			p, _ := parser.Parse(stmt, "")
+			if err := TableIndexes.Create(p[0].(parser.CreateIndex), filter); err != nil {
+				t.Fatalf("%s: unexpected error %q", stmt, err)
+			}
+		}
+
+		for _, query := range queries {
+			program, err := parser.Parse(query, "")
+			if err != nil {
+				t.Fatalf("%s: unexpected parse error %q", query, err)
+			}
+			result, err := Select(program[0].(parser.SelectQuery), filter)
+			if err != nil {
+				t.Errorf("%s: unexpected error %q", query, err)
+				continue
+			}
+
+			program, _ = parser.Parse("SELECT /*+ NESTED_LOOP_JOIN */"+query[len("SELECT"):], "")
+			expect, err := Select(program[0].(parser.SelectQuery), filter)
+			if err != nil {
+				t.Fatalf("%s: unexpected error %q", query, err)
+			}
+
+			if result.RecordLen() < 1 {
+				t.Errorf("%s using %s: no records", query, method)
+			}
+			if !reflect.DeepEqual(result.RecordSet, expect.RecordSet) {
+				t.Errorf("%s using %s: result = %s, want %s", query, method, result.RecordSet, expect.RecordSet)
+			}
+		}
+	}
+}
+
+func TestTableIndex_Invalidation(t *testing.T) {
+	defer func() {
+		TableIndexes = NewTableIndexMap()
+	}()
+
+	filter := tableIndexTestFilter()
+	proc := NewProcedure()
+	proc.Filter = filter
+
+	for _, stmt := range []string{
+		"CREATE INDEX idx ON view2 (x)",
+		"UPDATE view2 SET x = 1 WHERE name = 'three'",
+		"INSERT INTO view2 VALUES (3, 'four')",
+	} {
+		program, _ := parser.Parse(stmt, "")
+		if _, err := proc.ExecuteStatement(program[0]); err != nil {
+			t.Fatalf("%s: unexpected error %q", stmt, err)
+		}
+	}
+
+	program, _ := parser.Parse("SELECT name FROM view2 WHERE x = 3", "")
+	result, err := Select(program[0].(parser.SelectQuery), filter)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	names := make([]string, result.RecordLen())
+	for i, record := range result.RecordSet {
+		names[i] = record[0].Value().(value.String).Raw()
+	}
+	expect := []string{"three again", "four"}
+	if !reflect.DeepEqual(names, expect) {
+		t.Errorf("result = %s, want %s", names, expect)
+	}
+}
@@ -0,0 +1,175 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+)
+
+const (
+	TableObjectOptionDelimiter          = "DELIMITER"
+	TableObjectOptionDelimiterPositions = "DELIMITER_POSITIONS"
+	TableObjectOptionJsonQuery          = "JSON_QUERY"
+	TableObjectOptionEncoding           = "ENCODING"
+	TableObjectOptionNoHeader           = "NO_HEADER"
+	TableObjectOptionWithoutNull        = "WITHOUT_NULL"
+	TableObjectOptionDecimalSeparator   = "DECIMAL_SEPARATOR"
+	TableObjectOptionThousandsSeparator = "THOUSANDS_SEPARATOR"
+)
+
+var tableObjectFormatElementOptions = map[string]string{
+	cmd.CSV.String():   TableObjectOptionDelimiter,
+	cmd.FIXED.String(): TableObjectOptionDelimiterPositions,
+	cmd.JSON.String():  TableObjectOptionJsonQuery,
+}
+
+func tableObjectOptionName(name string) string {
+	name = strings.ToUpper(name)
+	if name == "NOHEADER" {
+		return TableObjectOptionNoHeader
+	}
+	return name
+}
+
+// splitTableObjectOptions returns the table object and the options to load the file.
+//
+// If the file path is not specified as a positional argument, then it is the first argument, and
+// the format element is taken from the option for the format, such as "DELIMITER ';'" for CSV.
+func splitTableObjectOptions(tableObject parser.TableObject, filter *Filter) (parser.TableObject, []parser.TableObjectOption, error) {
+	if len(tableObject.Options) < 1 {
+		return tableObject, nil, nil
+	}
+
+	if len(tableObject.Path.Literal) < 1 {
+		if fr, ok := tableObject.FormatElement.(parser.FieldReference); ok && len(fr.View.Literal) < 1 {
+			tableObject.Path = fr.Column
+		} else {
+			p, err := filter.Evaluate(tableObject.FormatElement)
+			if err != nil {
+				return tableObject, nil, err
+			}
+			s := value.ToString(p)
+			if value.IsNull(s) {
+				return tableObject, nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("invalid file path: %s", tableObject.FormatElement.String()))
+			}
+			tableObject.Path = parser.Identifier{BaseExpr: tableObject.BaseExpr, Literal: s.(value.String).Raw(), Quoted: true}
+		}
+		tableObject.FormatElement = nil
+	}
+
+	typeName := strings.ToUpper(tableObject.Type.Literal)
+	formatElementOption, hasFormatElement := tableObjectFormatElementOptions[typeName]
+
+	specified := make(map[string]bool, len(tableObject.Options))
+	loadOptions := make([]parser.TableObjectOption, 0, len(tableObject.Options))
+	for _, expr := range tableObject.Options {
+		opt := expr.(parser.TableObjectOption)
+		name := tableObjectOptionName(opt.Name.Literal)
+		if specified[name] {
+			return tableObject, nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("option %s is specified more than once", opt.Name.Literal))
+		}
+		specified[name] = true
+
+		switch name {
+		case TableObjectOptionNoHeader, TableObjectOptionWithoutNull:
+			loadOptions = append(loadOptions, opt)
+		case TableObjectOptionEncoding, TableObjectOptionDecimalSeparator, TableObjectOptionThousandsSeparator:
+			if opt.Value == nil {
+				return tableObject, nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("option %s requires a value", opt.Name.Literal))
+			}
+			loadOptions = append(loadOptions, opt)
+		default:
+			if !hasFormatElement || name != formatElementOption {
+				return tableObject, nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("unknown option: %s", opt.Name.Literal))
+			}
+			if opt.Value == nil {
+				return tableObject, nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("option %s requires a value", opt.Name.Literal))
+			}
+			if tableObject.FormatElement != nil {
+				return tableObject, nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("option %s is specified more than once", opt.Name.Literal))
+			}
+			tableObject.FormatElement = opt.Value
+		}
+	}
+
+	if tableObject.FormatElement == nil {
+		flags := cmd.GetFlags()
+		switch typeName {
+		case cmd.CSV.String():
+			tableObject.FormatElement = parser.NewStringValue(string(flags.Delimiter))
+		case cmd.JSON.String():
+			tableObject.FormatElement = parser.NewStringValue(flags.JsonQuery)
+		}
+	}
+
+	return tableObject, loadOptions, nil
+}
+
+// applyTableObjectOptions overrides the load settings with the options of the table object.
+func applyTableObjectOptions(tableObject parser.TableObject, options []parser.TableObjectOption, filter *Filter, encoding *text.Encoding, noHeader *bool, withoutNull *bool, decimalSeparator *string, thousandsSeparator *string) error {
+	for _, opt := range options {
+		var p value.Primary = value.NewBoolean(true)
+		if opt.Value != nil {
+			v := opt.Value
+			if fr, ok := v.(parser.FieldReference); ok {
+				v = parser.NewStringValue(fr.Column.Literal)
+			}
+			pv, err := filter.Evaluate(v)
+			if err != nil {
+				return err
+			}
+			p = pv
+		}
+
+		switch tableObjectOptionName(opt.Name.Literal) {
+		case TableObjectOptionEncoding:
+			s := value.ToString(p)
+			if value.IsNull(s) {
+				return NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a encoding value: %s", opt.Value.String()))
+			}
+			enc, err := cmd.ParseEncoding(s.(value.String).Raw())
+			if err != nil {
+				return NewTableObjectInvalidArgumentError(tableObject, err.Error())
+			}
+			*encoding = enc
+		case TableObjectOptionNoHeader:
+			b := value.ToBoolean(p)
+			if value.IsNull(b) {
+				return NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a no-header value: %s", opt.Value.String()))
+			}
+			*noHeader = b.(value.Boolean).Raw()
+		case TableObjectOptionWithoutNull:
+			b := value.ToBoolean(p)
+			if value.IsNull(b) {
+				return NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a without-null value: %s", opt.Value.String()))
+			}
+			*withoutNull = b.(value.Boolean).Raw()
+		case TableObjectOptionDecimalSeparator:
+			s := value.ToString(p)
+			if value.IsNull(s) {
+				return NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a decimal-separator value: %s", opt.Value.String()))
+			}
+			sep, err := cmd.ParseDecimalSeparator(s.(value.String).Raw())
+			if err != nil {
+				return NewTableObjectInvalidArgumentError(tableObject, err.Error())
+			}
+			*decimalSeparator = sep
+		case TableObjectOptionThousandsSeparator:
+			s := value.ToString(p)
+			if value.IsNull(s) {
+				return NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a thousands-separator value: %s", opt.Value.String()))
+			}
+			sep, err := cmd.ParseThousandsSeparator(s.(value.String).Raw())
+			if err != nil {
+				return NewTableObjectInvalidArgumentError(tableObject, err.Error())
+			}
+			*thousandsSeparator = sep
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package query
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const (
+	TriggerOldVariable = "OLD"
+	TriggerNewVariable = "NEW"
+)
+
+// TableTrigger is a set of statements executed for each record inserted, updated or deleted in a table.
+type TableTrigger struct {
+	Name       parser.Identifier
+	Timing     int
+	Event      int
+	Path       string
+	Statements []parser.Statement
+
+	firing bool
+}
+
+type TableTriggerMap map[string]*TableTrigger
+
+func NewTableTriggerMap() TableTriggerMap {
+	return make(TableTriggerMap, 4)
+}
+
+func (m TableTriggerMap) Declare(expr parser.TableTriggerDeclaration, filter *Filter) error {
+	uname := strings.ToUpper(expr.Name.Literal)
+	if _, ok := m[uname]; ok {
+		return NewTriggerRedeclaredError(expr.Name)
+	}
+
+	view := NewView()
+	if err := view.Load(parser.FromClause{Tables: []parser.QueryExpression{parser.Table{Object: expr.Table}}}, filter.CreateNode()); err != nil {
+		return err
+	}
+
+	m[uname] = &TableTrigger{
+		Name:       expr.Name,
+		Timing:     expr.Timing.Token,
+		Event:      expr.Event.Token,
+		Path:       strings.ToUpper(view.FileInfo.Path),
+		Statements: expr.Statements,
+	}
+	return nil
+}
+
+func (m TableTriggerMap) Dispose(name parser.Identifier) error {
+	uname := strings.ToUpper(name.Literal)
+	if _, ok := m[uname]; !ok {
+		return NewUndeclaredTriggerError(name)
+	}
+	delete(m, uname)
+	return nil
+}
+
+// CheckModification returns an error if the table is being modified by one of its own triggers.
+func (m TableTriggerMap) CheckModification(table parser.QueryExpression, fileInfo *FileInfo) error {
+	upath := strings.ToUpper(fileInfo.Path)
+	for _, trigger := range m {
+		if trigger.firing && trigger.Path == upath {
+			return NewTableModifiedInTriggerError(table, trigger.Name)
+		}
+	}
+	return nil
+}
+
+// triggers returns the triggers declared for the timing and the event on the table in order of their names.
+func (m TableTriggerMap) triggers(fileInfo *FileInfo, timing int, event int) []*TableTrigger {
+	upath := strings.ToUpper(fileInfo.Path)
+	list := make([]*TableTrigger, 0, len(m))
+	for _, trigger := range m {
+		if trigger.Path == upath && trigger.Timing == timing && trigger.Event == event {
+			list = append(list, trigger)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return strings.ToUpper(list[i].Name.Literal) < strings.ToUpper(list[j].Name.Literal)
+	})
+	return list
+}
+
+// Fire executes the triggers declared for the timing and the event on the table of the view.
+// The triggers are executed for each pair of the records, and the records before and after the modification
+// are referred as @OLD and @NEW respectively.
+// The values of @NEW after the execution of BEFORE triggers are set to the new records.
+func (m TableTriggerMap) Fire(view *View, timing int, event int, oldRecords []Record, newRecords []Record, filter *Filter) error {
+	triggers := m.triggers(view.FileInfo, timing, event)
+	if len(triggers) < 1 {
+		return nil
+	}
+
+	columns := make([]string, 0, view.FieldLen())
+	indices := make([]int, 0, view.FieldLen())
+	for i, f := range view.Header {
+		if f.IsFromTable {
+			columns = append(columns, f.Column)
+			indices = append(indices, i)
+		}
+	}
+
+	recordLen := len(oldRecords)
+	if recordLen < len(newRecords) {
+		recordLen = len(newRecords)
+	}
+
+	for _, trigger := range triggers {
+		for i := 0; i < recordLen; i++ {
+			var oldRecord Record
+			var newRecord Record
+			if oldRecords != nil {
+				oldRecord = oldRecords[i]
+			}
+			if newRecords != nil {
+				newRecord = newRecords[i]
+			}
+
+			if err := trigger.execute(columns, indices, oldRecord, newRecord, filter); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (trigger *TableTrigger) execute(columns []string, indices []int, oldRecord Record, newRecord Record, filter *Filter) error {
+	child := filter.CreateChildScope()
+	if oldRecord != nil {
+		_ = child.Variables[0].Add(parser.Variable{Name: TriggerOldVariable}, recordMap(columns, indices, oldRecord))
+	}
+	if newRecord != nil {
+		_ = child.Variables[0].Add(parser.Variable{Name: TriggerNewVariable}, recordMap(columns, indices, newRecord))
+	}
+
+	trigger.firing = true
+	defer func() {
+		trigger.firing = false
+	}()
+
+	proc := NewProcedure()
+	proc.Filter = child
+	if _, err := proc.Execute(trigger.Statements); err != nil {
+		return err
+	}
+
+	if trigger.Timing == parser.BEFORE && newRecord != nil {
+		p, _ := child.Variables[0].Get(parser.Variable{Name: TriggerNewVariable})
+		if m, ok := p.(value.Map); ok {
+			for i, column := range columns {
+				if val, ok := m.Value(column); ok {
+					newRecord[indices[i]] = NewCell(val)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func recordMap(columns []string, indices []int, record Record) value.Map {
+	values := make([]value.Primary, len(columns))
+	for i, idx := range indices {
+		values[i] = record[idx].Value()
+	}
+	return value.NewMap(columns, values)
+}
@@ -0,0 +1,136 @@
+package query
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var tableTriggerTests = []struct {
+	Name   string
+	Script string
+	Output string
+	Error  string
+}{
+	{
+		Name: "Table Triggers",
+		Script: "DECLARE items VIEW (id, price, qty, total);\n" +
+			"DECLARE audit VIEW (action, id);\n" +
+			"DECLARE calc_insert TRIGGER BEFORE INSERT ON items AS BEGIN @NEW.total := @NEW.price * @NEW.qty; END;\n" +
+			"DECLARE calc_update TRIGGER BEFORE UPDATE ON items AS BEGIN @NEW.total := @NEW.price * @NEW.qty; END;\n" +
+			"DECLARE log_update TRIGGER AFTER UPDATE ON items AS BEGIN INSERT INTO audit VALUES ('update ' || @OLD.qty || ' to ' || @NEW.qty, @NEW.id); END;\n" +
+			"DECLARE log_delete TRIGGER AFTER DELETE ON items AS BEGIN INSERT INTO audit VALUES ('delete', @OLD.id); END;\n" +
+			"INSERT INTO items (id, price, qty) VALUES (1, 5, 3), (2, 1, 1);\n" +
+			"UPDATE items SET qty = 10 WHERE id = 1;\n" +
+			"DELETE FROM items WHERE id = 2;\n" +
+			"SELECT * FROM items;\n" +
+			"SELECT * FROM audit;",
+		Output: "id,price,qty,total\n" +
+			"1,5,10,50\n" +
+			"action,id\n" +
+			"update 3 to 10,1\n" +
+			"delete,2\n",
+	},
+	{
+		Name: "Table Triggers Fired in Order of Names",
+		Script: "DECLARE t VIEW (id, log);\n" +
+			"DECLARE b TRIGGER BEFORE INSERT ON t AS BEGIN @NEW.log := @NEW.log || 'b'; END;\n" +
+			"DECLARE a TRIGGER BEFORE INSERT ON t AS BEGIN @NEW.log := @NEW.log || 'a'; END;\n" +
+			"INSERT INTO t VALUES (1, '');\n" +
+			"SELECT * FROM t;",
+		Output: "id,log\n" +
+			"1,ab\n",
+	},
+	{
+		Name: "Table Triggers Disposed",
+		Script: "DECLARE t VIEW (id, log);\n" +
+			"DECLARE a TRIGGER BEFORE INSERT ON t AS BEGIN @NEW.log := 'triggered'; END;\n" +
+			"DISPOSE TRIGGER a;\n" +
+			"INSERT INTO t VALUES (1, '');\n" +
+			"SELECT * FROM t;",
+		Output: "id,log\n" +
+			"1,\n",
+	},
+	{
+		Name: "Table Triggers Error in Trigger",
+		Script: "DECLARE t VIEW (id);\n" +
+			"DECLARE a TRIGGER BEFORE INSERT ON t AS BEGIN PRINT @undefined; END;\n" +
+			"INSERT INTO t VALUES (1);",
+		Error: "[L:2 C:53] variable @undefined is undeclared",
+	},
+	{
+		Name: "Table Triggers Modify Table in Own Trigger Error",
+		Script: "DECLARE t VIEW (id);\n" +
+			"DECLARE a TRIGGER AFTER INSERT ON t AS BEGIN DELETE FROM t; END;\n" +
+			"INSERT INTO t VALUES (1);",
+		Error: "[L:2 C:58] table t cannot be modified in trigger a",
+	},
+	{
+		Name: "Table Triggers Redeclared Error",
+		Script: "DECLARE t VIEW (id);\n" +
+			"DECLARE a TRIGGER AFTER INSERT ON t AS BEGIN PRINT 1; END;\n" +
+			"DECLARE a TRIGGER AFTER DELETE ON t AS BEGIN PRINT 1; END;",
+		Error: "[L:3 C:9] trigger a is redeclared",
+	},
+	{
+		Name:   "Table Triggers Table Not Loaded Error",
+		Script: "DECLARE a TRIGGER AFTER INSERT ON notexist AS BEGIN PRINT 1; END;",
+		Error:  "[L:1 C:35] file notexist does not exist",
+	},
+	{
+		Name:   "Table Triggers Dispose Undeclared Error",
+		Script: "DISPOSE TRIGGER a;",
+		Error:  "[L:1 C:17] trigger a is undeclared",
+	},
+}
+
+func TestTableTriggers(t *testing.T) {
+	defer func() {
+		TableTriggers = NewTableTriggerMap()
+		initCmdFlag()
+	}()
+
+	for _, v := range tableTriggerTests {
+		initCmdFlag()
+		tf := cmd.GetFlags()
+		tf.Repository = TestDir
+		tf.Format = cmd.CSV
+		tf.Quiet = true
+		TableTriggers = NewTableTriggerMap()
+
+		statements, err := parser.Parse(v.Script, "")
+		if err != nil {
+			t.Errorf("%s: unexpected parse error %q", v.Name, err)
+			continue
+		}
+
+		oldStdout := Stdout
+		r, w, _ := os.Pipe()
+		Stdout = w
+
+		_, err = NewProcedure().Execute(statements)
+
+		w.Close()
+		Stdout = oldStdout
+		log, _ := ioutil.ReadAll(r)
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if string(log) != v.Output {
+			t.Errorf("%s: output = %q, want %q", v.Name, string(log), v.Output)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package query
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+
+	"github.com/mithrandie/go-text/csv"
+)
+
+// TailPollInterval is the interval to check for records appended to a file followed by TAIL.
+var TailPollInterval = 500 * time.Millisecond
+
+// TailReader follows a growing file and reads the records appended to it.
+//
+// Records are read from the offset of the reader. An incomplete last line is left
+// until its line break is written.
+type TailReader struct {
+	Expr        parser.QueryExpression
+	TableName   string
+	FileInfo    *FileInfo
+	WithoutNull bool
+
+	header       []string
+	headerOffset int64
+	offset       int64
+}
+
+// NewTailReader returns a reader that follows the file specified with TAIL.
+// Records that exist when the reader is created are skipped.
+func NewTailReader(table parser.Table) (*TailReader, error) {
+	expr := table.Object.(parser.TailTable)
+
+	r, err := OpenTailReader(expr, expr.Path, table.Name().Literal)
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := r.readAppended()
+	if err != nil {
+		return nil, NewReadFileError(expr, err.Error())
+	}
+	r.offset += int64(len(buf))
+	return r, nil
+}
+
+// OpenTailReader returns a reader that reads the file from the first record.
+func OpenTailReader(expr parser.QueryExpression, path parser.Identifier, tableName string) (*TailReader, error) {
+	flags := cmd.GetFlags()
+
+	fileInfo, err := NewFileInfo(path, flags.Repository, cmd.AutoSelect, flags.Delimiter, flags.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	if fileInfo.Format != cmd.CSV && fileInfo.Format != cmd.TSV {
+		return nil, NewTailFormatError(expr, fileInfo.Format)
+	}
+	fileInfo.NoHeader = flags.NoHeader
+	fileInfo.IsTemporary = true
+
+	r := &TailReader{
+		Expr:        expr,
+		TableName:   tableName,
+		FileInfo:    fileInfo,
+		WithoutNull: flags.WithoutNull,
+	}
+	if err = r.readHeader(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Offset returns the position in bytes from which the next records are read.
+func (r *TailReader) Offset() int64 {
+	return r.offset
+}
+
+// SetOffset sets the position in bytes from which the next records are read.
+// The position must be at the beginning of a line.
+func (r *TailReader) SetOffset(offset int64) {
+	if r.headerOffset < offset {
+		r.offset = offset
+	}
+}
+
+// Read returns a view that has the records appended since the last read.
+// If the file is truncated, then the records are read again from the beginning.
+func (r *TailReader) Read() (*View, error) {
+	buf, err := r.readAppended()
+	if err != nil {
+		return nil, NewReadFileError(r.Expr, err.Error())
+	}
+	if r.header == nil && !r.FileInfo.NoHeader {
+		if err = r.readHeader(); err != nil {
+			return nil, err
+		}
+		if buf, err = r.readAppended(); err != nil {
+			return nil, NewReadFileError(r.Expr, err.Error())
+		}
+	}
+	r.offset += int64(len(buf))
+
+	reader := csv.NewReader(bytes.NewReader(buf), r.FileInfo.Encoding)
+	reader.Delimiter = r.FileInfo.Delimiter
+	reader.WithoutNull = r.WithoutNull
+	if r.header != nil {
+		reader.FieldsPerRecord = len(r.header)
+	}
+
+	records, err := readRecordSet(reader)
+	if err != nil {
+		return nil, NewDataParsingError(r.Expr, r.FileInfo.Path, err.Error())
+	}
+
+	if r.header == nil && 0 < reader.FieldsPerRecord {
+		r.header = make([]string, reader.FieldsPerRecord)
+		for i := 0; i < reader.FieldsPerRecord; i++ {
+			r.header[i] = "c" + strconv.Itoa(i+1)
+		}
+	}
+
+	view := NewView()
+	view.Header = NewHeader(r.TableName, r.header)
+	view.RecordSet = records
+	view.FileInfo = r.FileInfo
+	return view, nil
+}
+
+// readAppended returns the complete lines written after the current offset.
+func (r *TailReader) readAppended() ([]byte, error) {
+	fp, err := os.Open(r.FileInfo.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close()
+
+	fi, err := fp.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	size := fi.Size()
+	if size < r.offset {
+		if size < r.headerOffset {
+			r.headerOffset = 0
+			if !r.FileInfo.NoHeader {
+				r.header = nil
+			}
+		}
+		r.offset = r.headerOffset
+	}
+	if size == r.offset || r.header == nil && !r.FileInfo.NoHeader {
+		return nil, nil
+	}
+
+	buf := make([]byte, size-r.offset)
+	if _, err = fp.ReadAt(buf, r.offset); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	i := bytes.LastIndexByte(buf, '\n')
+	if i < 0 {
+		return nil, nil
+	}
+	return buf[:i+1], nil
+}
+
+// readHeader reads the header line if it has been written.
+func (r *TailReader) readHeader() error {
+	if r.FileInfo.NoHeader {
+		return nil
+	}
+
+	fp, err := os.Open(r.FileInfo.Path)
+	if err != nil {
+		return NewReadFileError(r.Expr, err.Error())
+	}
+	defer fp.Close()
+
+	line, err := bufio.NewReader(fp).ReadBytes('\n')
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return NewReadFileError(r.Expr, err.Error())
+	}
+
+	reader := csv.NewReader(bytes.NewReader(line), r.FileInfo.Encoding)
+	reader.Delimiter = r.FileInfo.Delimiter
+	header, err := reader.ReadHeader()
+	if err != nil && err != io.EOF {
+		return NewDataParsingError(r.Expr, r.FileInfo.Path, err.Error())
+	}
+
+	r.header = header
+	r.headerOffset = int64(len(line))
+	if r.offset < r.headerOffset {
+		r.offset = r.headerOffset
+	}
+	return nil
+}
+
+// TailStreamingTable returns the table followed by TAIL if the query is a streaming query,
+// that is a query whose FROM clause has only one table with TAIL.
+func TailStreamingTable(query parser.SelectQuery) (parser.Table, bool) {
+	if table, ok := singleTable(query); ok {
+		if _, ok := table.Object.(parser.TailTable); ok {
+			return table, true
+		}
+	}
+	return parser.Table{}, false
+}
+
+func singleTable(query parser.SelectQuery) (parser.Table, bool) {
+	entity, ok := query.SelectEntity.(parser.SelectEntity)
+	if !ok || entity.FromClause == nil {
+		return parser.Table{}, false
+	}
+
+	tables := entity.FromClause.(parser.FromClause).Tables
+	if len(tables) != 1 {
+		return parser.Table{}, false
+	}
+	table, ok := tables[0].(parser.Table)
+	return table, ok
+}
+
+func tailBatchKey(expr parser.QueryExpression) parser.Identifier {
+	return parser.Identifier{Literal: strings.ToUpper(expr.String())}
+}
+
+// SetTailBatch makes the records read by a TailReader available as the table
+// to the query evaluated with the filter.
+func (f *Filter) SetTailBatch(table parser.Table, batch *View) {
+	f.InlineTables[0][tailBatchKey(table.Object).Literal] = batch
+}
+
+func loadTailBatch(table parser.Table, filter *Filter) (*View, bool) {
+	view, err := filter.InlineTables.Get(tailBatchKey(table.Object))
+	if err != nil {
+		return nil, false
+	}
+	view.Header.Update(table.Name().Literal, nil)
+	return view, true
+}
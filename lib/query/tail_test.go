@@ -0,0 +1,199 @@
+package query
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func tailTestTable(path string) parser.Table {
+	return parser.Table{
+		Object: parser.TailTable{
+			BaseExpr: parser.NewBaseExpr(parser.Token{}),
+			Tail:     "TAIL",
+			Path:     parser.Identifier{BaseExpr: parser.NewBaseExpr(parser.Token{}), Literal: path},
+		},
+	}
+}
+
+func appendToFile(t *testing.T, path string, s string) {
+	fp, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fp.Close()
+	if _, err = fp.WriteString(s); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func tailReadValues(t *testing.T, r *TailReader) [][]value.Primary {
+	view, err := r.Read()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	result := make([][]value.Primary, view.RecordLen())
+	for i, record := range view.RecordSet {
+		result[i] = make([]value.Primary, len(record))
+		for j, cell := range record {
+			result[i][j] = cell.Value()
+		}
+	}
+	return result
+}
+
+func TestTailReader_Read(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	fpath := GetTestFilePath("tail.csv")
+	if err := ioutil.WriteFile(fpath, []byte("c1,c2\n1,a\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(fpath)
+
+	r, err := NewTailReader(tailTestTable("tail.csv"))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	if result := tailReadValues(t, r); len(result) != 0 {
+		t.Errorf("result = %s, want no records that exist before following", result)
+	}
+
+	appendToFile(t, fpath, "2,b\n3,")
+	expect := [][]value.Primary{
+		{value.NewString("2"), value.NewString("b")},
+	}
+	if result := tailReadValues(t, r); !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %s, want %s", result, expect)
+	}
+
+	appendToFile(t, fpath, "c\n")
+	expect = [][]value.Primary{
+		{value.NewString("3"), value.NewString("c")},
+	}
+	if result := tailReadValues(t, r); !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %s, want %s for an incomplete line", result, expect)
+	}
+
+	if err := ioutil.WriteFile(fpath, []byte("c1,c2\n4,d\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	expect = [][]value.Primary{
+		{value.NewString("4"), value.NewString("d")},
+	}
+	if result := tailReadValues(t, r); !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %s, want %s for a truncated file", result, expect)
+	}
+
+	view, _ := r.Read()
+	expectHeader := NewHeader("tail", []string{"c1", "c2"})
+	if !reflect.DeepEqual(view.Header, expectHeader) {
+		t.Errorf("header = %v, want %v", view.Header, expectHeader)
+	}
+
+	appendToFile(t, fpath, "5\n")
+	if _, err = r.Read(); err == nil {
+		t.Error("no error, want error for a wrong number of fields")
+	}
+}
+
+func TestTailReader_ReadHeaderLater(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	fpath := GetTestFilePath("tail_empty.csv")
+	if err := ioutil.WriteFile(fpath, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(fpath)
+
+	r, err := NewTailReader(tailTestTable("tail_empty.csv"))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	appendToFile(t, fpath, "c1\n1\n")
+	expect := [][]value.Primary{
+		{value.NewString("1")},
+	}
+	if result := tailReadValues(t, r); !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %s, want %s", result, expect)
+	}
+}
+
+func TestNewTailReader(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	fpath := GetTestFilePath("tail.json")
+	if err := ioutil.WriteFile(fpath, []byte("[]"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(fpath)
+
+	_, err := NewTailReader(tailTestTable("tail.json"))
+	expect := "[L:- C:-] TAIL tail.json: JSON format is not supported, only CSV and TSV files can be followed"
+	if err == nil {
+		t.Errorf("no error, want error %q", expect)
+	} else if err.Error() != expect {
+		t.Errorf("error = %q, want error %q", err.Error(), expect)
+	}
+}
+
+var tailStreamingTableTests = []struct {
+	Query  string
+	Expect bool
+}{
+	{
+		Query:  "SELECT * FROM TAIL `events.csv` WHERE c1 = 1",
+		Expect: true,
+	},
+	{
+		Query:  "SELECT * FROM TAIL `events.csv`, table1",
+		Expect: false,
+	},
+	{
+		Query:  "SELECT * FROM table1",
+		Expect: false,
+	},
+	{
+		Query:  "SELECT 1",
+		Expect: false,
+	},
+}
+
+func TestTailStreamingTable(t *testing.T) {
+	for _, v := range tailStreamingTableTests {
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Query, err)
+		}
+
+		_, ok := TailStreamingTable(program[0].(parser.SelectQuery))
+		if ok != v.Expect {
+			t.Errorf("%s: result = %t, want %t", v.Query, ok, v.Expect)
+		}
+	}
+}
+
+func TestView_LoadTailNotStreaming(t *testing.T) {
+	program, err := parser.Parse("SELECT * FROM table1 CROSS JOIN TAIL `events.csv`", "")
+	if err != nil {
+		t.Fatalf("unexpected parse error %q", err)
+	}
+
+	_, err = Select(program[0].(parser.SelectQuery), NewEmptyFilter())
+	expect := "[L:1 C:33] TAIL can only be used as the only table in the from clause of a select query"
+	if err == nil {
+		t.Errorf("no error, want error %q", expect)
+	} else if err.Error() != expect {
+		t.Errorf("error = %q, want error %q", err.Error(), expect)
+	}
+}
@@ -165,13 +165,13 @@ func (p *Prompt) Render(sequence []PromptElement) (string, error) {
 				case 1:
 					expr, ok := statements[0].(parser.QueryExpression)
 					if !ok {
-						return "", NewPromptEvaluationError(fmt.Sprintf(ErrorInvalidValue, command))
+						return "", NewPromptEvaluationError(errorMessage(ErrorInvalidValue, command))
 					}
 					if err = p.evaluate(expr); err != nil {
 						return "", err
 					}
 				default:
-					return "", NewPromptEvaluationError(fmt.Sprintf(ErrorInvalidValue, command))
+					return "", NewPromptEvaluationError(errorMessage(ErrorInvalidValue, command))
 				}
 			}
 		}
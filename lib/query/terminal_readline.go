@@ -30,7 +30,7 @@ func NewTerminal(filter *Filter) (VirtualTerminal, error) {
 	limit := *env.InteractiveShell.HistoryLimit
 	historyFile, err := HistoryFilePath(env.InteractiveShell.HistoryFile)
 	if err != nil {
-		LogError(fmt.Sprintf("cannot detect filepath: %q", env.InteractiveShell.HistoryFile))
+		LogError(fmt.Sprintf(cmd.Message("cannot detect filepath: %q"), env.InteractiveShell.HistoryFile))
 		limit = -1
 	}
 
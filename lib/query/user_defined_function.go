@@ -2,6 +2,8 @@ package query
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 
 	"sort"
@@ -63,8 +65,13 @@ func (list UserDefinedFunctionScopes) All() (UserDefinedFunctionMap, UserDefined
 type UserDefinedFunctionMap map[string]*UserDefinedFunction
 
 func (m UserDefinedFunctionMap) Declare(expr parser.FunctionDeclaration) error {
-	if err := m.CheckDuplicate(expr.Name); err != nil {
-		return err
+	uname := strings.ToUpper(expr.Name.Literal)
+
+	existing, ok := m[uname]
+	if !ok || existing.IsAggregate {
+		if err := m.CheckDuplicate(expr.Name); err != nil {
+			return err
+		}
 	}
 
 	parameters, defaults, required, err := m.parseParameters(expr.Parameters)
@@ -72,15 +79,30 @@ func (m UserDefinedFunctionMap) Declare(expr parser.FunctionDeclaration) error {
 		return err
 	}
 
-	uname := strings.ToUpper(expr.Name.Literal)
+	if expr.Variadic {
+		required = len(parameters) - 1
+	}
 
-	m[uname] = &UserDefinedFunction{
+	fn := &UserDefinedFunction{
 		Name:         expr.Name,
 		Statements:   expr.Statements,
 		Parameters:   parameters,
 		Defaults:     defaults,
 		RequiredArgs: required,
+		Variadic:     expr.Variadic,
 	}
+
+	if ok {
+		for _, v := range existing.variants() {
+			if v.RequiredArgs <= fn.maxArgsLen() && fn.RequiredArgs <= v.maxArgsLen() {
+				return NewFunctionRedeclaredError(expr.Name)
+			}
+		}
+		existing.Overloads = append(existing.Overloads, fn)
+		return nil
+	}
+
+	m[uname] = fn
 	return nil
 }
 
@@ -140,10 +162,13 @@ func (m UserDefinedFunctionMap) parseParameters(parameters []parser.VariableAssi
 func (m UserDefinedFunctionMap) CheckDuplicate(name parser.Identifier) error {
 	uname := strings.ToUpper(name.Literal)
 
-	if _, ok := Functions[uname]; ok || uname == "NOW" || uname == "JSON_OBJECT" {
+	if _, ok := Functions[uname]; ok || uname == "NOW" || uname == "UUID" || uname == "JSON_OBJECT" {
 		return NewBuiltInFunctionDeclaredError(name)
 	}
-	if _, ok := AggregateFunctions[uname]; ok {
+	if _, ok := ExceptionFunctions[uname]; ok {
+		return NewBuiltInFunctionDeclaredError(name)
+	}
+	if _, ok := AggregateFunctions[uname]; ok || OrderedSetAggregateFunctions[uname] {
 		return NewBuiltInFunctionDeclaredError(name)
 	}
 	if _, ok := AnalyticFunctions[uname]; ok {
@@ -192,9 +217,41 @@ type UserDefinedFunction struct {
 	Parameters   []parser.Variable
 	Defaults     map[string]parser.QueryExpression
 	RequiredArgs int
+	Variadic     bool // The last parameter receives the rest of the arguments as an array
 
 	IsAggregate bool
 	Cursor      parser.Identifier // For Aggregate Functions
+
+	Overloads []*UserDefinedFunction // Functions declared with the same name and different numbers of parameters
+}
+
+func (fn *UserDefinedFunction) variants() []*UserDefinedFunction {
+	return append([]*UserDefinedFunction{fn}, fn.Overloads...)
+}
+
+// Dispatch returns the function that accepts the number of arguments from the overloads.
+// If no function accepts the arguments, the function itself is returned.
+func (fn *UserDefinedFunction) Dispatch(argsLen int) *UserDefinedFunction {
+	for _, v := range fn.variants() {
+		if v.acceptsArgsLen(argsLen) {
+			return v
+		}
+	}
+	return fn
+}
+
+func (fn *UserDefinedFunction) acceptsArgsLen(argsLen int) bool {
+	if len(fn.Defaults) < 1 && !fn.Variadic {
+		return argsLen == len(fn.Parameters)
+	}
+	return fn.RequiredArgs <= argsLen && argsLen <= fn.maxArgsLen()
+}
+
+func (fn *UserDefinedFunction) maxArgsLen() int {
+	if fn.Variadic {
+		return math.MaxInt32
+	}
+	return len(fn.Parameters)
 }
 
 func (fn *UserDefinedFunction) Execute(args []value.Primary, filter *Filter) (value.Primary, error) {
@@ -202,6 +259,19 @@ func (fn *UserDefinedFunction) Execute(args []value.Primary, filter *Filter) (va
 	return fn.execute(args, childScope)
 }
 
+// ExecuteTable executes the function and returns the view returned by a RETURN TABLE statement.
+func (fn *UserDefinedFunction) ExecuteTable(expr parser.TableFunction, args []value.Primary, filter *Filter) (*View, error) {
+	childScope := filter.CreateChildScope()
+	proc, err := fn.run(args, childScope)
+	if err != nil {
+		return nil, err
+	}
+	if proc.ReturnView == nil {
+		return nil, NewNotTableFunctionError(expr)
+	}
+	return proc.ReturnView, nil
+}
+
 func (fn *UserDefinedFunction) ExecuteAggregate(values []value.Primary, args []value.Primary, filter *Filter) (value.Primary, error) {
 	childScope := filter.CreateChildScope()
 	childScope.Cursors.AddPseudoCursor(fn.Cursor, values)
@@ -209,6 +279,10 @@ func (fn *UserDefinedFunction) ExecuteAggregate(values []value.Primary, args []v
 }
 
 func (fn *UserDefinedFunction) CheckArgsLen(expr parser.QueryExpression, name string, argsLen int) error {
+	if 0 < len(fn.Overloads) {
+		return fn.checkOverloadsArgsLen(expr, name, argsLen)
+	}
+
 	parametersLen := len(fn.Parameters)
 	requiredLen := fn.RequiredArgs
 	if fn.IsAggregate {
@@ -216,7 +290,11 @@ func (fn *UserDefinedFunction) CheckArgsLen(expr parser.QueryExpression, name st
 		requiredLen++
 	}
 
-	if len(fn.Defaults) < 1 {
+	if fn.Variadic {
+		if argsLen < fn.RequiredArgs {
+			return NewFunctionArgumentLengthErrorWithCustomArgs(expr, name, fmt.Sprintf("at least %s", FormatCount(requiredLen, "argument")))
+		}
+	} else if len(fn.Defaults) < 1 {
 		if argsLen != len(fn.Parameters) {
 			return NewFunctionArgumentLengthError(expr, name, []int{parametersLen})
 		}
@@ -229,13 +307,76 @@ func (fn *UserDefinedFunction) CheckArgsLen(expr parser.QueryExpression, name st
 	return nil
 }
 
+func (fn *UserDefinedFunction) checkOverloadsArgsLen(expr parser.QueryExpression, name string, argsLen int) error {
+	lengths := make([]int, 0, len(fn.Overloads)+1)
+	variadicLen := -1
+	for _, v := range fn.variants() {
+		if v.acceptsArgsLen(argsLen) {
+			return nil
+		}
+		if v.Variadic {
+			variadicLen = v.RequiredArgs
+			continue
+		}
+		for i := v.RequiredArgs; i <= len(v.Parameters); i++ {
+			lengths = append(lengths, i)
+		}
+	}
+	sort.Ints(lengths)
+
+	isContinuous := len(lengths) < 1 || lengths[len(lengths)-1]-lengths[0] == len(lengths)-1
+
+	if -1 < variadicLen {
+		if len(lengths) < 1 {
+			return NewFunctionArgumentLengthErrorWithCustomArgs(expr, name, fmt.Sprintf("at least %s", FormatCount(variadicLen, "argument")))
+		}
+		if isContinuous && lengths[len(lengths)-1]+1 == variadicLen {
+			return NewFunctionArgumentLengthErrorWithCustomArgs(expr, name, fmt.Sprintf("at least %s", FormatCount(lengths[0], "argument")))
+		}
+	} else if isContinuous {
+		return NewFunctionArgumentLengthError(expr, name, lengths)
+	}
+
+	strs := make([]string, 0, len(lengths))
+	for _, i := range lengths {
+		strs = append(strs, strconv.Itoa(i))
+	}
+
+	var argstr string
+	if -1 < variadicLen {
+		argstr = strings.Join(strs, ", ") + " or at least " + FormatCount(variadicLen, "argument")
+	} else {
+		argstr = strings.Join(strs[:len(strs)-1], ", ") + " or " + FormatCount(lengths[len(lengths)-1], "argument")
+	}
+	return NewFunctionArgumentLengthErrorWithCustomArgs(expr, name, argstr)
+}
+
 func (fn *UserDefinedFunction) execute(args []value.Primary, filter *Filter) (value.Primary, error) {
+	proc, err := fn.run(args, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := proc.ReturnVal
+	if ret == nil {
+		ret = value.NewNull()
+	}
+
+	return ret, nil
+}
+
+func (fn *UserDefinedFunction) run(args []value.Primary, filter *Filter) (*Procedure, error) {
 	if err := fn.CheckArgsLen(fn.Name, fn.Name.Literal, len(args)); err != nil {
 		return nil, err
 	}
+	fn = fn.Dispatch(len(args))
 
 	for i, v := range fn.Parameters {
-		if i < len(args) {
+		if fn.Variadic && i == len(fn.Parameters)-1 {
+			rest := make([]value.Primary, len(args)-i)
+			copy(rest, args[i:])
+			filter.Variables[0].Add(v, value.NewArray(rest))
+		} else if i < len(args) {
 			filter.Variables[0].Add(v, args[i])
 		} else {
 			defaultValue, _ := fn.Defaults[v.Name]
@@ -253,11 +394,5 @@ func (fn *UserDefinedFunction) execute(args []value.Primary, filter *Filter) (va
 	if _, err := proc.Execute(fn.Statements); err != nil {
 		return nil, err
 	}
-
-	ret := proc.ReturnVal
-	if ret == nil {
-		ret = value.NewNull()
-	}
-
-	return ret, nil
+	return proc, nil
 }
@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 )
@@ -520,6 +521,148 @@ var userDefinedFunctionMapDeclareTests = []struct {
 		},
 		Error: "[L:- C:-] function userfunc is redeclared",
 	},
+	{
+		Name: "UserDefinedFunctionMap Declare Overload",
+		Expr: parser.FunctionDeclaration{
+			Name: parser.Identifier{Literal: "userfunc"},
+			Parameters: []parser.VariableAssignment{
+				{
+					Variable: parser.Variable{Name: "arg1"},
+				},
+			},
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.Variable{Name: "var1"}},
+			},
+		},
+		Result: UserDefinedFunctionMap{
+			"USERFUNC": &UserDefinedFunction{
+				Name: parser.Identifier{Literal: "userfunc"},
+				Parameters: []parser.Variable{
+					{Name: "arg1"},
+					{Name: "arg2"},
+				},
+				Defaults:     map[string]parser.QueryExpression{},
+				RequiredArgs: 2,
+				Statements: []parser.Statement{
+					parser.Print{Value: parser.Variable{Name: "var1"}},
+				},
+				Overloads: []*UserDefinedFunction{
+					{
+						Name: parser.Identifier{Literal: "userfunc"},
+						Parameters: []parser.Variable{
+							{Name: "arg1"},
+						},
+						Defaults:     map[string]parser.QueryExpression{},
+						RequiredArgs: 1,
+						Statements: []parser.Statement{
+							parser.Print{Value: parser.Variable{Name: "var1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "UserDefinedFunctionMap Declare Overload Redeclaration Error",
+		Expr: parser.FunctionDeclaration{
+			Name: parser.Identifier{Literal: "userfunc"},
+			Parameters: []parser.VariableAssignment{
+				{
+					Variable: parser.Variable{Name: "arg1"},
+				},
+				{
+					Variable: parser.Variable{Name: "arg2"},
+				},
+				{
+					Variable: parser.Variable{Name: "arg3"},
+					Value:    parser.NewIntegerValue(1),
+				},
+			},
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.Variable{Name: "var1"}},
+			},
+		},
+		Error: "[L:- C:-] function userfunc is redeclared",
+	},
+	{
+		Name: "UserDefinedFunctionMap Declare Variadic",
+		Expr: parser.FunctionDeclaration{
+			Name: parser.Identifier{Literal: "userfunc3"},
+			Parameters: []parser.VariableAssignment{
+				{
+					Variable: parser.Variable{Name: "arg1"},
+				},
+				{
+					Variable: parser.Variable{Name: "args"},
+				},
+			},
+			Variadic: true,
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.Variable{Name: "var1"}},
+			},
+		},
+		Result: UserDefinedFunctionMap{
+			"USERFUNC": &UserDefinedFunction{
+				Name: parser.Identifier{Literal: "userfunc"},
+				Parameters: []parser.Variable{
+					{Name: "arg1"},
+					{Name: "arg2"},
+				},
+				Defaults:     map[string]parser.QueryExpression{},
+				RequiredArgs: 2,
+				Statements: []parser.Statement{
+					parser.Print{Value: parser.Variable{Name: "var1"}},
+				},
+				Overloads: []*UserDefinedFunction{
+					{
+						Name: parser.Identifier{Literal: "userfunc"},
+						Parameters: []parser.Variable{
+							{Name: "arg1"},
+						},
+						Defaults:     map[string]parser.QueryExpression{},
+						RequiredArgs: 1,
+						Statements: []parser.Statement{
+							parser.Print{Value: parser.Variable{Name: "var1"}},
+						},
+					},
+				},
+			},
+			"USERFUNC3": &UserDefinedFunction{
+				Name: parser.Identifier{Literal: "userfunc3"},
+				Parameters: []parser.Variable{
+					{Name: "arg1"},
+					{Name: "args"},
+				},
+				Defaults:     map[string]parser.QueryExpression{},
+				RequiredArgs: 1,
+				Variadic:     true,
+				Statements: []parser.Statement{
+					parser.Print{Value: parser.Variable{Name: "var1"}},
+				},
+			},
+		},
+	},
+	{
+		Name: "UserDefinedFunctionMap Declare Variadic Overload Redeclaration Error",
+		Expr: parser.FunctionDeclaration{
+			Name: parser.Identifier{Literal: "userfunc3"},
+			Parameters: []parser.VariableAssignment{
+				{
+					Variable: parser.Variable{Name: "arg1"},
+				},
+				{
+					Variable: parser.Variable{Name: "arg2"},
+				},
+				{
+					Variable: parser.Variable{Name: "arg3"},
+				},
+			},
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.Variable{Name: "var1"}},
+			},
+		},
+		Error: "[L:- C:-] function userfunc3 is redeclared",
+	},
 	{
 		Name: "UserDefinedFunctionMap Declare Duplicate Prameters Error",
 		Expr: parser.FunctionDeclaration{
@@ -1014,6 +1157,116 @@ func TestUserDefinedFunction_Execute(t *testing.T) {
 	}
 }
 
+func TestUserDefinedFunction_ExecuteOverloads(t *testing.T) {
+	funcs := UserDefinedFunctionMap{}
+	_ = funcs.Declare(parser.FunctionDeclaration{
+		Name: parser.Identifier{Literal: "userfunc"},
+		Parameters: []parser.VariableAssignment{
+			{Variable: parser.Variable{Name: "arg1"}},
+		},
+		Statements: []parser.Statement{
+			parser.Return{Value: parser.Variable{Name: "arg1"}},
+		},
+	})
+	_ = funcs.Declare(parser.FunctionDeclaration{
+		Name: parser.Identifier{Literal: "userfunc"},
+		Parameters: []parser.VariableAssignment{
+			{Variable: parser.Variable{Name: "arg1"}},
+			{Variable: parser.Variable{Name: "arg2"}},
+		},
+		Statements: []parser.Statement{
+			parser.Return{Value: parser.Arithmetic{
+				LHS:      parser.Variable{Name: "arg1"},
+				RHS:      parser.Variable{Name: "arg2"},
+				Operator: '*',
+			}},
+		},
+	})
+
+	filter := NewEmptyFilter()
+	fn, _ := funcs.Get(parser.Identifier{Literal: "userfunc"}, "userfunc")
+
+	result, err := fn.Execute([]value.Primary{value.NewInteger(3)}, filter)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(result, value.NewInteger(3)) {
+		t.Errorf("result = %s, want %s", result, value.NewInteger(3))
+	}
+
+	result, err = fn.Execute([]value.Primary{value.NewInteger(3), value.NewInteger(2)}, filter)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(result, value.NewInteger(6)) {
+		t.Errorf("result = %s, want %s", result, value.NewInteger(6))
+	}
+}
+
+func TestUserDefinedFunction_ExecuteVariadic(t *testing.T) {
+	fn := &UserDefinedFunction{
+		Name: parser.Identifier{Literal: "userfunc"},
+		Parameters: []parser.Variable{
+			{Name: "arg1"},
+			{Name: "args"},
+		},
+		RequiredArgs: 1,
+		Variadic:     true,
+		Statements: []parser.Statement{
+			parser.Return{Value: parser.Variable{Name: "args"}},
+		},
+	}
+
+	filter := NewEmptyFilter()
+
+	result, err := fn.Execute([]value.Primary{value.NewInteger(1), value.NewInteger(2), value.NewString("a")}, filter)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := value.NewArray([]value.Primary{value.NewInteger(2), value.NewString("a")})
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %s, want %s", result, expect)
+	}
+
+	result, err = fn.Execute([]value.Primary{value.NewInteger(1)}, filter)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect = value.NewArray([]value.Primary{})
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %s, want %s", result, expect)
+	}
+}
+
+func TestUserDefinedFunction_ExecuteTableReturnCursor(t *testing.T) {
+	initCmdFlag()
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	fn := &UserDefinedFunction{
+		Name: parser.Identifier{Literal: "userfunc"},
+		Statements: []parser.Statement{
+			parser.CursorDeclaration{
+				Cursor: parser.Identifier{Literal: "cur"},
+				Query:  selectQueryForCursorTest,
+			},
+			parser.ReturnCursor{
+				Cursor: parser.Identifier{Literal: "cur"},
+			},
+		},
+	}
+	expr := parser.TableFunction{Table: "TABLE", Function: parser.Function{Name: "userfunc"}}
+
+	ViewCache.Clean()
+	view, err := fn.ExecuteTable(expr, []value.Primary{}, NewEmptyFilter())
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if view.RecordLen() != 3 {
+		t.Errorf("record length = %d, want %d", view.RecordLen(), 3)
+	}
+}
+
 var userDefinedFunctionExecuteAggregateTests = []struct {
 	Name   string
 	Func   *UserDefinedFunction
@@ -1360,6 +1613,129 @@ var userDefinedFunctionCheckArgsLenTests = []struct {
 		ArgsLen: 1,
 		Error:   "[L:- C:-] function userfunc takes exactly 3 arguments",
 	},
+	{
+		Name: "UserDefinedFunction CheckArgsLen Overloads",
+		Func: &UserDefinedFunction{
+			Name: parser.Identifier{Literal: "userfunc"},
+			Parameters: []parser.Variable{
+				{Name: "arg1"},
+				{Name: "arg2"},
+			},
+			RequiredArgs: 2,
+			Statements:   []parser.Statement{},
+			Overloads: []*UserDefinedFunction{
+				{
+					Name:         parser.Identifier{Literal: "userfunc"},
+					RequiredArgs: 0,
+					Statements:   []parser.Statement{},
+				},
+			},
+		},
+		ArgsLen: 0,
+		Error:   "",
+	},
+	{
+		Name: "UserDefinedFunction CheckArgsLen Overloads Argument Length Error",
+		Func: &UserDefinedFunction{
+			Name: parser.Identifier{Literal: "userfunc"},
+			Parameters: []parser.Variable{
+				{Name: "arg1"},
+				{Name: "arg2"},
+			},
+			RequiredArgs: 2,
+			Statements:   []parser.Statement{},
+			Overloads: []*UserDefinedFunction{
+				{
+					Name: parser.Identifier{Literal: "userfunc"},
+					Parameters: []parser.Variable{
+						{Name: "arg1"},
+					},
+					RequiredArgs: 1,
+					Statements:   []parser.Statement{},
+				},
+			},
+		},
+		ArgsLen: 3,
+		Error:   "[L:- C:-] function userfunc takes 1 or 2 arguments",
+	},
+	{
+		Name: "UserDefinedFunction CheckArgsLen Overloads Discontinuous Argument Length Error",
+		Func: &UserDefinedFunction{
+			Name: parser.Identifier{Literal: "userfunc"},
+			Parameters: []parser.Variable{
+				{Name: "arg1"},
+				{Name: "arg2"},
+				{Name: "arg3"},
+			},
+			Defaults: map[string]parser.QueryExpression{
+				"arg3": parser.NewIntegerValue(3),
+			},
+			RequiredArgs: 2,
+			Statements:   []parser.Statement{},
+			Overloads: []*UserDefinedFunction{
+				{
+					Name:         parser.Identifier{Literal: "userfunc"},
+					RequiredArgs: 0,
+					Statements:   []parser.Statement{},
+				},
+			},
+		},
+		ArgsLen: 1,
+		Error:   "[L:- C:-] function userfunc takes 0, 2 or 3 arguments",
+	},
+	{
+		Name: "UserDefinedFunction CheckArgsLen Variadic",
+		Func: &UserDefinedFunction{
+			Name: parser.Identifier{Literal: "userfunc"},
+			Parameters: []parser.Variable{
+				{Name: "arg1"},
+				{Name: "args"},
+			},
+			RequiredArgs: 1,
+			Variadic:     true,
+			Statements:   []parser.Statement{},
+		},
+		ArgsLen: 4,
+		Error:   "",
+	},
+	{
+		Name: "UserDefinedFunction CheckArgsLen Variadic Argument Length Error",
+		Func: &UserDefinedFunction{
+			Name: parser.Identifier{Literal: "userfunc"},
+			Parameters: []parser.Variable{
+				{Name: "arg1"},
+				{Name: "args"},
+			},
+			RequiredArgs: 1,
+			Variadic:     true,
+			Statements:   []parser.Statement{},
+		},
+		ArgsLen: 0,
+		Error:   "[L:- C:-] function userfunc takes at least 1 argument",
+	},
+	{
+		Name: "UserDefinedFunction CheckArgsLen Variadic Overloads Argument Length Error",
+		Func: &UserDefinedFunction{
+			Name: parser.Identifier{Literal: "userfunc"},
+			Parameters: []parser.Variable{
+				{Name: "arg1"},
+				{Name: "arg2"},
+				{Name: "args"},
+			},
+			RequiredArgs: 2,
+			Variadic:     true,
+			Statements:   []parser.Statement{},
+			Overloads: []*UserDefinedFunction{
+				{
+					Name:         parser.Identifier{Literal: "userfunc"},
+					RequiredArgs: 0,
+					Statements:   []parser.Statement{},
+				},
+			},
+		},
+		ArgsLen: 1,
+		Error:   "[L:- C:-] function userfunc takes 0 or at least 2 arguments",
+	},
 }
 
 func TestUserDefinedFunction_CheckArgsLen(t *testing.T) {
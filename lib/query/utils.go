@@ -3,9 +3,12 @@ package query
 import (
 	"bytes"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
@@ -27,6 +30,43 @@ func InStrSliceWithCaseInsensitive(s string, list []string) bool {
 	return false
 }
 
+// EqualFieldIdentifiers reports whether two formatted expressions are the same field.
+// Letter cases are ignored except in quoted strings.
+func EqualFieldIdentifiers(s1 string, s2 string) bool {
+	r1 := []rune(s1)
+	r2 := []rune(s2)
+	if len(r1) != len(r2) {
+		return false
+	}
+
+	var quote rune
+	escaped := false
+	for i := range r1 {
+		if quote != 0 {
+			if r1[i] != r2[i] {
+				return false
+			}
+			switch {
+			case escaped:
+				escaped = false
+			case r1[i] == '\\':
+				escaped = true
+			case r1[i] == quote:
+				quote = 0
+			}
+			continue
+		}
+
+		if r1[i] != r2[i] && !strings.EqualFold(string(r1[i]), string(r2[i])) {
+			return false
+		}
+		if r1[i] == '\'' || r1[i] == '"' {
+			quote = r1[i]
+		}
+	}
+	return true
+}
+
 func Distinguish(list []value.Primary) []value.Primary {
 	values := make(map[string]int)
 	valueKeys := make([]string, 0, len(list))
@@ -54,11 +94,31 @@ func Distinguish(list []value.Primary) []value.Primary {
 func FormatCount(i int, obj string) string {
 	var s string
 	if i == 0 {
-		s = fmt.Sprintf("no %s", obj)
+		s = fmt.Sprintf(cmd.Message("no %s"), cmd.Message(obj))
 	} else if i == 1 {
-		s = fmt.Sprintf("%d %s", i, obj)
+		s = fmt.Sprintf("%d %s", i, cmd.Message(obj))
 	} else {
-		s = fmt.Sprintf("%d %ss", i, obj)
+		s = fmt.Sprintf(cmd.Message("%d %ss"), i, cmd.Message(obj))
+	}
+	return s
+}
+
+// formatNumbers formats the line or row numbers to be shown in messages.
+// Only the first several numbers are listed.
+func formatNumbers(unit string, numbers []int) string {
+	const listLimit = 10
+
+	list := make([]string, 0, listLimit)
+	for i := 0; i < len(numbers) && i < listLimit; i++ {
+		list = append(list, strconv.Itoa(numbers[i]))
+	}
+
+	s := unit + " " + strings.Join(list, ", ")
+	if 1 < len(numbers) {
+		s = unit + "s " + strings.Join(list, ", ")
+	}
+	if listLimit < len(numbers) {
+		s = s + fmt.Sprintf(" and %d more", len(numbers)-listLimit)
 	}
 	return s
 }
@@ -96,6 +156,10 @@ func SerializeKey(buf *bytes.Buffer, val value.Primary) {
 		serializeBoolean(buf, b.(value.Boolean).Raw())
 	} else if s, ok := val.(value.String); ok {
 		serializeString(buf, s.Raw())
+	} else if a, ok := val.(value.Array); ok {
+		serializeArray(buf, a)
+	} else if m, ok := val.(value.Map); ok {
+		serializeMap(buf, m)
 	} else {
 		serializeNull(buf)
 	}
@@ -149,6 +213,34 @@ func serializeBoolean(buf *bytes.Buffer, b bool) {
 }
 
 func serializeString(buf *bytes.Buffer, s string) {
+	serializeStringKey(buf, value.DefaultCollation().Key(s))
+}
+
+func serializeStringKey(buf *bytes.Buffer, key string) {
 	buf.WriteString("[S]")
-	buf.WriteString(strings.ToUpper(strings.TrimSpace(s)))
+	buf.WriteString(key)
+}
+
+func serializeArray(buf *bytes.Buffer, a value.Array) {
+	buf.WriteString("[A]")
+	buf.WriteString(value.Int64ToStr(int64(a.Len())))
+	buf.WriteString("(")
+	SerializeComparisonKeys(buf, a.Raw())
+	buf.WriteString(")")
+}
+
+func serializeMap(buf *bytes.Buffer, m value.Map) {
+	keys := make([]string, m.Len())
+	copy(keys, m.Keys())
+	sort.Strings(keys)
+
+	buf.WriteString("[M]")
+	buf.WriteString(value.Int64ToStr(int64(m.Len())))
+	buf.WriteString("(")
+	for _, k := range keys {
+		v, _ := m.Value(k)
+		buf.WriteString(fmt.Sprintf("%q:", k))
+		SerializeKey(buf, v)
+	}
+	buf.WriteString(")")
 }
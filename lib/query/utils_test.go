@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/ternary"
@@ -34,6 +36,71 @@ func TestSerializeComparisonKeys(t *testing.T) {
 	}
 }
 
+func TestSerializeComparisonKeysWithCollation(t *testing.T) {
+	defer initFlag(cmd.GetFlags())
+
+	values := []value.Primary{
+		value.NewString(" Str "),
+		value.NewString("str"),
+	}
+
+	_ = cmd.GetFlags().SetCollation("binary")
+	expect := "[S] Str :[S]str"
+
+	buf := new(bytes.Buffer)
+	SerializeComparisonKeys(buf, values)
+	result := buf.String()
+	if result != expect {
+		t.Errorf("result = %q, want %q", result, expect)
+	}
+}
+
+var equalFieldIdentifiersTests = []struct {
+	S1     string
+	S2     string
+	Result bool
+}{
+	{
+		S1:     "count(*)",
+		S2:     "COUNT(*)",
+		Result: true,
+	},
+	{
+		S1:     "'a' = 'A'",
+		S2:     "'a' = 'a'",
+		Result: false,
+	},
+	{
+		S1:     "upper(\"abc\") || col",
+		S2:     "UPPER(\"abc\") || COL",
+		Result: true,
+	},
+	{
+		S1:     "'a\\'b' || c",
+		S2:     "'a\\'b' || C",
+		Result: true,
+	},
+	{
+		S1:     "'a\\'b' || c",
+		S2:     "'a\\'B' || c",
+		Result: false,
+	},
+	{
+		S1:     "col1",
+		S2:     "col10",
+		Result: false,
+	},
+}
+
+func TestEqualFieldIdentifiers(t *testing.T) {
+	for _, v := range equalFieldIdentifiersTests {
+		result := EqualFieldIdentifiers(v.S1, v.S2)
+		if result != v.Result {
+			t.Errorf("result = %t, want %t for %q and %q", result, v.Result, v.S1, v.S2)
+		}
+	}
+}
+
 func BenchmarkDistinguish(b *testing.B) {
 	values := make([]value.Primary, 10000)
 	for i := 0; i < 100; i++ {
@@ -63,3 +130,70 @@ func BenchmarkSerializeKey(b *testing.B) {
 		SerializeComparisonKeys(buf, plist)
 	}
 }
+
+var formatCountTests = []struct {
+	Count    int
+	Obj      string
+	Language string
+	Expect   string
+}{
+	{
+		Count:    0,
+		Obj:      "record",
+		Language: cmd.EnglishLanguage,
+		Expect:   "no record",
+	},
+	{
+		Count:    1,
+		Obj:      "record",
+		Language: cmd.EnglishLanguage,
+		Expect:   "1 record",
+	},
+	{
+		Count:    3,
+		Obj:      "record",
+		Language: cmd.EnglishLanguage,
+		Expect:   "3 records",
+	},
+	{
+		Count:    0,
+		Obj:      "record",
+		Language: cmd.JapaneseLanguage,
+		Expect:   "0 件のレコード",
+	},
+	{
+		Count:    3,
+		Obj:      "record",
+		Language: cmd.JapaneseLanguage,
+		Expect:   "3 件のレコード",
+	},
+}
+
+func TestFormatCount(t *testing.T) {
+	defer initCmdFlag()
+
+	for _, v := range formatCountTests {
+		cmd.GetFlags().Language = v.Language
+		result := FormatCount(v.Count, v.Obj)
+		if result != v.Expect {
+			t.Errorf("result = %q, want %q for %d, %q in %s", result, v.Expect, v.Count, v.Obj, v.Language)
+		}
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	defer initCmdFlag()
+
+	cmd.GetFlags().Language = cmd.JapaneseLanguage
+	err := NewFileNotExistError(parser.Identifier{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 1}), Literal: "notexist"})
+	expect := "[L:1 C:1] ファイル notexist は存在しません"
+	if err.Error() != expect {
+		t.Errorf("error = %q, want %q", err.Error(), expect)
+	}
+
+	err = NewUpdateValueAmbiguousError(parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}, parser.NewIntegerValue(1))
+	expect = "[L:- C:-] フィールド column1 に設定する値 1 は曖昧です"
+	if err.Error() != expect {
+		t.Errorf("error = %q, want %q", err.Error(), expect)
+	}
+}
@@ -3,6 +3,7 @@ package query
 import (
 	"reflect"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/mithrandie/csvq/lib/parser"
@@ -21,10 +22,31 @@ func (list VariableScopes) Get(expr parser.Variable) (value value.Primary, err e
 			return
 		}
 	}
+	if value, ok := list.getMember(expr); ok {
+		return value, nil
+	}
 	err = NewUndeclaredVariableError(expr)
 	return
 }
 
+// getMember treats a qualified name that is not declared as a reference to a member of the map stored in the variable.
+func (list VariableScopes) getMember(expr parser.Variable) (value.Primary, bool) {
+	i := strings.LastIndexByte(expr.Name, parser.NamespaceSeparator)
+	if i < 1 {
+		return nil, false
+	}
+
+	p, err := list.Get(parser.Variable{BaseExpr: expr.BaseExpr, Name: expr.Name[:i]})
+	if err != nil {
+		return nil, false
+	}
+	if m, ok := p.(value.Map); ok {
+		member, _ := m.Value(expr.Name[i+1:])
+		return member, true
+	}
+	return value.NewNull(), true
+}
+
 func (list VariableScopes) Substitute(expr parser.VariableSubstitution, filter *Filter) (value value.Primary, err error) {
 	for _, v := range list {
 		if value, err = v.Substitute(expr, filter); err == nil {
@@ -34,8 +56,35 @@ func (list VariableScopes) Substitute(expr parser.VariableSubstitution, filter *
 			return
 		}
 	}
-	err = NewUndeclaredVariableError(expr.Variable)
-	return
+	return list.substituteMember(expr, filter)
+}
+
+// substituteMember treats a qualified name that is not declared as a reference to a member of the map stored in the variable,
+// and replaces the map with a copy that has the member set to the value.
+func (list VariableScopes) substituteMember(expr parser.VariableSubstitution, filter *Filter) (value.Primary, error) {
+	i := strings.LastIndexByte(expr.Variable.Name, parser.NamespaceSeparator)
+	if i < 1 {
+		return nil, NewUndeclaredVariableError(expr.Variable)
+	}
+
+	mapVar := parser.Variable{BaseExpr: expr.Variable.BaseExpr, Name: expr.Variable.Name[:i]}
+	p, err := list.Get(mapVar)
+	if err != nil {
+		return nil, NewUndeclaredVariableError(expr.Variable)
+	}
+	m, ok := p.(value.Map)
+	if !ok {
+		return nil, NewUndeclaredVariableError(expr.Variable)
+	}
+
+	val, err := filter.Evaluate(expr.Value)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = list.SubstituteDirectly(mapVar, m.Set(expr.Variable.Name[i+1:], val)); err != nil {
+		return nil, err
+	}
+	return val, nil
 }
 
 func (list VariableScopes) SubstituteDirectly(variable parser.Variable, value value.Primary) (value.Primary, error) {
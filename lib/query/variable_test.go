@@ -19,6 +19,26 @@ var variableScopesGet = []struct {
 		Expr:   parser.Variable{Name: "var1"},
 		Result: value.NewInteger(1),
 	},
+	{
+		Name:   "VariableScopes Get Qualified Name",
+		Expr:   parser.Variable{Name: "util.var1"},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name:   "VariableScopes Get Member of Map",
+		Expr:   parser.Variable{Name: "map1.key1"},
+		Result: value.NewString("a"),
+	},
+	{
+		Name:   "VariableScopes Get Member of Non-Map",
+		Expr:   parser.Variable{Name: "var1.key1"},
+		Result: value.NewNull(),
+	},
+	{
+		Name:  "VariableScopes Get Qualified Name Undeclared Error",
+		Expr:  parser.Variable{Name: "undef.var1"},
+		Error: "[L:- C:-] variable @undef.var1 is undeclared",
+	},
 	{
 		Name:  "VariableScopes Get Undeclared Error",
 		Expr:  parser.Variable{Name: "undef"},
@@ -29,10 +49,12 @@ var variableScopesGet = []struct {
 func TestVariableScopes_Get(t *testing.T) {
 	list := VariableScopes{
 		GenerateVariableMap(map[string]value.Primary{
-			"var1": value.NewInteger(1),
+			"var1":      value.NewInteger(1),
+			"util.var1": value.NewInteger(3),
 		}),
 		GenerateVariableMap(map[string]value.Primary{
 			"var1": value.NewInteger(2),
+			"map1": value.NewMap([]string{"key1"}, []value.Primary{value.NewString("a")}),
 		}),
 	}
 
@@ -76,10 +98,36 @@ var variableScopesSubstituteTests = []struct {
 			}),
 			GenerateVariableMap(map[string]value.Primary{
 				"var1": value.NewInteger(2),
+				"map1": value.NewMap([]string{"key1"}, []value.Primary{value.NewString("a")}),
 			}),
 		},
 		Result: value.NewInteger(3),
 	},
+	{
+		Name: "VariableScopes Substitute Member of Map",
+		Expr: parser.VariableSubstitution{
+			Variable: parser.Variable{Name: "map1.key2"},
+			Value:    parser.NewIntegerValue(4),
+		},
+		List: VariableScopes{
+			GenerateVariableMap(map[string]value.Primary{
+				"var1": value.NewInteger(3),
+			}),
+			GenerateVariableMap(map[string]value.Primary{
+				"var1": value.NewInteger(2),
+				"map1": value.NewMap([]string{"key1", "key2"}, []value.Primary{value.NewString("a"), value.NewInteger(4)}),
+			}),
+		},
+		Result: value.NewInteger(4),
+	},
+	{
+		Name: "VariableScopes Substitute Member of Non-Map Error",
+		Expr: parser.VariableSubstitution{
+			Variable: parser.Variable{Name: "var1.key1"},
+			Value:    parser.NewIntegerValue(4),
+		},
+		Error: "[L:- C:-] variable @var1.key1 is undeclared",
+	},
 	{
 		Name: "VariableScopes Substitute Variable Undeclared Error",
 		Expr: parser.VariableSubstitution{
@@ -97,6 +145,7 @@ func TestVariableScopes_Substitute(t *testing.T) {
 		}),
 		GenerateVariableMap(map[string]value.Primary{
 			"var1": value.NewInteger(2),
+			"map1": value.NewMap([]string{"key1"}, []value.Primary{value.NewString("a")}),
 		}),
 	}
 
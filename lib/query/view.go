@@ -72,20 +72,41 @@ func (view *View) Load(clause parser.FromClause, filter *Filter) error {
 	}
 
 	views := make([]*View, len(clause.Tables))
+	nodes := make([][]*ExplainNode, len(clause.Tables))
 	for i, v := range clause.Tables {
+		if _, ok := lateralTable(v); ok && 0 < i {
+			continue
+		}
 		loaded, err := loadView(v, filter, view.UseInternalId, view.ForUpdate)
 		if err != nil {
 			return err
 		}
 		views[i] = loaded
+		nodes[i] = filter.profiler.take(1)
 	}
 
 	view.Header = views[0].Header
 	view.RecordSet = views[0].RecordSet
 	view.FileInfo = views[0].FileInfo
+	filter.profiler.push(nodes[0]...)
 
 	for i := 1; i < len(views); i++ {
-		CrossJoin(view, views[i])
+		if table, ok := lateralTable(clause.Tables[i]); ok {
+			if err := measureStep(filter, view, func() *ExplainNode {
+				return (&ExplainNode{Operation: "Lateral Join"}).add(explainTableNode(table, filter))
+			}, func() error {
+				return loadLateralJoin(view, table, nil, false, filter)
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		filter.profiler.push(nodes[i]...)
+		_ = filter.profiler.measure(func() *ExplainNode { return &ExplainNode{Operation: "Cross Join"} }, 2, func() (int, error) {
+			CrossJoin(view, views[i], filter)
+			return view.RecordLen(), nil
+		})
 	}
 
 	view.Filter = filter
@@ -108,7 +129,22 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 	}
 
 	table := tableExpr.(parser.Table)
+	if join, ok := table.Object.(parser.Join); ok {
+		if seq, ok := newJoinSequence(join, filter); ok {
+			return loadJoinSequence(seq, filter, useInternalId, forUpdate)
+		}
+	}
+	if _, ok := table.Object.(parser.Join); ok {
+		return measureLoad(filter, func() *ExplainNode { return explainTableNode(table, filter) }, func() (*View, error) {
+			return loadTable(table, filter, useInternalId, forUpdate)
+		})
+	}
+	return measureScan(filter, func() *ExplainNode { return explainTableNode(table, filter) }, func() (*View, error) {
+		return loadTable(table, filter, useInternalId, forUpdate)
+	})
+}
 
+func loadTable(table parser.Table, filter *Filter, useInternalId bool, forUpdate bool) (*View, error) {
 	var view *View
 	var err error
 
@@ -191,6 +227,13 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 		}
 	case parser.TableObject:
 		tableObject := table.Object.(parser.TableObject)
+		if strings.EqualFold(tableObject.Type.Literal, IncrementalObject) {
+			view, err = loadIncrementalBatch(table, filter)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
 
 		flags := cmd.GetFlags()
 		importFormat := flags.SelectImportFormat()
@@ -200,6 +243,13 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 		encoding := flags.Encoding
 		noHeader := flags.NoHeader
 		withoutNull := flags.WithoutNull
+		decimalSeparator := ""
+		thousandsSeparator := ""
+
+		tableObject, options, err := splitTableObjectOptions(tableObject, filter)
+		if err != nil {
+			return nil, err
+		}
 
 		var felem value.Primary
 		if tableObject.FormatElement != nil {
@@ -330,9 +380,12 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 		if args[withoutNullIdx] != nil {
 			withoutNull = args[withoutNullIdx].(value.Boolean).Raw()
 		}
+		if err = applyTableObjectOptions(tableObject, options, filter, &encoding, &noHeader, &withoutNull, &decimalSeparator, &thousandsSeparator); err != nil {
+			return nil, err
+		}
 
 		view, err = loadObject(
-			table.Object.(parser.TableObject).Path,
+			tableObject.Path,
 			table.Name(),
 			filter,
 			useInternalId,
@@ -347,6 +400,8 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			flags.EncloseAll,
 			flags.JsonEscape,
 			withoutNull,
+			decimalSeparator,
+			thousandsSeparator,
 		)
 		if err != nil {
 			return nil, err
@@ -371,6 +426,8 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			flags.EncloseAll,
 			flags.JsonEscape,
 			flags.WithoutNull,
+			"",
+			"",
 		)
 		if err != nil {
 			return nil, err
@@ -381,9 +438,18 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 		if err != nil {
 			return nil, err
 		}
-		view2, err := loadView(join.JoinTable, filter, useInternalId, forUpdate)
-		if err != nil {
-			return nil, err
+
+		lateral, isLateral := lateralTable(join.JoinTable)
+
+		var view2 *View
+		if isLateral {
+			view2 = NewView()
+			view2.Header = lateralHeader(lateral)
+		} else {
+			view2, err = loadView(join.JoinTable, filter, useInternalId, forUpdate)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		condition, includeFields, excludeFields, err := ParseJoinCondition(join, view, view2)
@@ -400,17 +466,28 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			}
 		}
 
-		switch joinType {
-		case parser.CROSS:
-			CrossJoin(view, view2)
-		case parser.INNER:
-			if err = InnerJoin(view, view2, condition, filter); err != nil {
-				return nil, err
+		if isLateral {
+			if joinType == parser.OUTER && (join.Direction.Token == parser.RIGHT || join.Direction.Token == parser.FULL) {
+				return nil, NewLateralJoinDirectionError(lateral.Object, lateralFunctionName(lateral.Object), join.Direction)
 			}
-		case parser.OUTER:
-			if err = OuterJoin(view, view2, condition, join.Direction.Token, filter); err != nil {
+			if err = loadLateralJoin(view, lateral, condition, joinType == parser.OUTER, filter); err != nil {
 				return nil, err
 			}
+		} else {
+			method, _ := filter.hints.joinMethod(join)
+
+			switch joinType {
+			case parser.CROSS:
+				CrossJoin(view, view2, filter)
+			case parser.INNER:
+				if err = innerJoin(view, view2, condition, method, filter); err != nil {
+					return nil, err
+				}
+			case parser.OUTER:
+				if err = outerJoin(view, view2, condition, join.Direction.Token, method, filter); err != nil {
+					return nil, err
+				}
+			}
 		}
 
 		includeIndices := make([]int, 0, len(includeFields))
@@ -442,7 +519,7 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			}
 			view.Header = header
 
-			NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+			NewGoroutineTaskManager(view.RecordLen(), -1, filter.cpu()).Run(func(index int) {
 				record := make(Record, len(fieldIndices))
 				for i, idx := range fieldIndices {
 					record[i] = view.RecordSet[index][idx]
@@ -514,6 +591,91 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			return nil, err
 		}
 
+	case parser.Unnest:
+		values, err := EvaluateUnnest(table.Object.(parser.Unnest), filter)
+		if err != nil {
+			return nil, err
+		}
+
+		view = NewView()
+		view.Header = lateralHeader(table)
+		view.RecordSet = make(RecordSet, len(values))
+		for i, v := range values {
+			view.RecordSet[i] = NewRecord([]value.Primary{v})
+		}
+
+		if err = filter.Aliases.Add(table.Name(), ""); err != nil {
+			return nil, err
+		}
+
+	case parser.GenerateSeries:
+		values, err := EvaluateGenerateSeries(table.Object.(parser.GenerateSeries), filter)
+		if err != nil {
+			return nil, err
+		}
+
+		view = NewView()
+		view.Header = lateralHeader(table)
+		view.RecordSet = make(RecordSet, len(values))
+		for i, v := range values {
+			view.RecordSet[i] = NewRecord([]value.Primary{v})
+		}
+
+		if err = filter.Aliases.Add(table.Name(), ""); err != nil {
+			return nil, err
+		}
+
+	case parser.TailTable:
+		var ok bool
+		if view, ok = loadTailBatch(table, filter); !ok {
+			return nil, NewTailNotStreamingError(table.Object.(parser.TailTable))
+		}
+
+		if err = filter.Aliases.Add(table.Name(), ""); err != nil {
+			return nil, err
+		}
+
+	case parser.JsonTable:
+		rows, err := EvaluateJsonTable(table.Object.(parser.JsonTable), filter)
+		if err != nil {
+			return nil, err
+		}
+
+		view = NewView()
+		view.Header = lateralHeader(table)
+		view.RecordSet = make(RecordSet, len(rows))
+		for i, row := range rows {
+			view.RecordSet[i] = NewRecord(row)
+		}
+
+		if err = filter.Aliases.Add(table.Name(), ""); err != nil {
+			return nil, err
+		}
+
+	case parser.RevisionTable:
+		view, err = loadObjectAtRevision(table.Object.(parser.RevisionTable), filter)
+		if err != nil {
+			return nil, err
+		}
+
+		view.Header.Update(table.Name().Literal, nil)
+
+		if err = filter.Aliases.Add(table.Name(), ""); err != nil {
+			return nil, err
+		}
+
+	case parser.TableFunction:
+		view, err = loadTableFunction(table.Object.(parser.TableFunction), filter)
+		if err != nil {
+			return nil, err
+		}
+
+		view.Header.Update(table.Name().Literal, nil)
+
+		if err = filter.Aliases.Add(table.Name(), ""); err != nil {
+			return nil, err
+		}
+
 	case parser.Subquery:
 		subquery := table.Object.(parser.Subquery)
 		view, err = Select(subquery.Query, filter)
@@ -531,6 +693,104 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 	return view, err
 }
 
+func loadTableFunction(expr parser.TableFunction, filter *Filter) (*View, error) {
+	udfn, err := filter.Functions.Get(expr.Function, expr.Function.Name)
+	if err != nil || udfn.IsAggregate {
+		return nil, NewFunctionNotExistError(expr.Function, expr.Function.Name)
+	}
+
+	args := make([]value.Primary, len(expr.Function.Args))
+	for i, v := range expr.Function.Args {
+		if args[i], err = filter.Evaluate(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return udfn.ExecuteTable(expr, args, filter)
+}
+
+// lateralTable returns the table if the table is expanded for each record of
+// the left-hand side table, such as UNNEST, GENERATE_SERIES and JSON_TABLE with columns.
+func lateralTable(expr parser.QueryExpression) (parser.Table, bool) {
+	if table, ok := expr.(parser.Table); ok {
+		switch table.Object.(type) {
+		case parser.Unnest, parser.GenerateSeries, parser.JsonTable:
+			return table, true
+		}
+	}
+	return parser.Table{}, false
+}
+
+func lateralFunctionName(expr parser.QueryExpression) string {
+	switch expr.(type) {
+	case parser.Unnest:
+		return expr.(parser.Unnest).Unnest
+	case parser.GenerateSeries:
+		return expr.(parser.GenerateSeries).GenerateSeries
+	case parser.JsonTable:
+		return expr.(parser.JsonTable).JsonTable
+	}
+	return expr.String()
+}
+
+func lateralHeader(table parser.Table) Header {
+	if jsonTable, ok := table.Object.(parser.JsonTable); ok {
+		columns := make([]string, 0, len(jsonTable.Columns))
+		for _, c := range jsonTable.Columns {
+			columns = append(columns, c.(parser.JsonTableColumn).Name.Literal)
+		}
+		return NewHeader(table.Name().Literal, columns)
+	}
+	return NewHeader(table.Name().Literal, []string{table.Name().Literal})
+}
+
+func loadLateralJoin(view *View, table parser.Table, condition parser.QueryExpression, outer bool, filter *Filter) error {
+	if err := filter.Aliases.Add(table.Name(), ""); err != nil {
+		return err
+	}
+
+	joinView := NewView()
+	joinView.Header = lateralHeader(table)
+	return LateralJoin(view, joinView, table.Object, condition, outer, filter)
+}
+
+// loadObjectAtRevision loads the contents of a file at a revision in the git repository.
+// The loaded view is treated as an inline table, so it cannot be updated.
+func loadObjectAtRevision(expr parser.RevisionTable, filter *Filter) (*View, error) {
+	p, err := filter.Evaluate(expr.Revision)
+	if err != nil {
+		return nil, err
+	}
+	revision := value.ToString(p)
+	if value.IsNull(revision) {
+		return nil, NewRevisionEmptyError(expr)
+	}
+
+	flags := cmd.GetFlags()
+	fileInfo, err := NewFileInfo(expr.Table, flags.Repository, cmd.AutoSelect, flags.Delimiter, flags.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	fileInfo.DelimiterPositions = flags.DelimiterPositions
+	fileInfo.JsonQuery = strings.TrimSpace(flags.JsonQuery)
+	fileInfo.LineBreak = flags.LineBreak
+	fileInfo.NoHeader = flags.NoHeader
+	fileInfo.EncloseAll = flags.EncloseAll
+	fileInfo.JsonEscape = flags.JsonEscape
+	fileInfo.IsTemporary = true
+
+	buf, err := file.ReadRevision(fileInfo.Path, revision.(value.String).Raw())
+	if err != nil {
+		return nil, NewReadRevisionError(expr, revision.(value.String).Raw(), err.Error())
+	}
+
+	view, err := loadViewFromFile(bytes.NewReader(buf), fileInfo, flags.WithoutNull)
+	if err != nil {
+		return nil, NewDataParsingError(expr.Table, fileInfo.Path, err.Error())
+	}
+	return view, nil
+}
+
 func loadObject(
 	tableIdentifier parser.Identifier,
 	tableName parser.Identifier,
@@ -547,6 +807,8 @@ func loadObject(
 	encloseAll bool,
 	jsonEscape txjson.EscapeType,
 	withoutNull bool,
+	decimalSeparator string,
+	thousandsSeparator string,
 ) (*View, error) {
 	var view *View
 
@@ -578,6 +840,36 @@ func loadObject(
 				view, _ = filter.TempViews.Get(pathIdent)
 			}
 		} else {
+			var columns []string
+			if t, ok := cmd.GetFlags().TableCatalog().Get(tableIdentifier.Literal); ok {
+				tableIdentifier = parser.Identifier{BaseExpr: tableIdentifier.BaseExpr, Literal: t.Path, Quoted: tableIdentifier.Quoted}
+				if importFormat == cmd.AutoSelect {
+					importFormat = t.ImportFormat()
+					if 0 < len(t.Delimiter) {
+						delimiter, delimiterPositions = t.ParsedDelimiter()
+					}
+					if 0 < len(t.JsonQuery) {
+						jsonQuery = t.JsonQuery
+					}
+					if 0 < len(t.Encoding) {
+						encoding = t.ParsedEncoding()
+					}
+					if t.NoHeader != nil {
+						noHeader = *t.NoHeader
+					}
+					if t.WithoutNull != nil {
+						withoutNull = *t.WithoutNull
+					}
+					if t.DecimalSeparator != nil {
+						decimalSeparator = *t.DecimalSeparator
+					}
+					if t.ThousandsSeparator != nil {
+						thousandsSeparator = *t.ThousandsSeparator
+					}
+					columns = t.Columns
+				}
+			}
+
 			filePath, err = CreateFilePath(tableIdentifier, cmd.GetFlags().Repository)
 			if err != nil {
 				return nil, err
@@ -610,6 +902,12 @@ func loadObject(
 							return nil, NewReadFileError(tableIdentifier, err.Error())
 						}
 						fileInfo.Handler = h
+						if 0 < len(cmd.GetFlags().MergeTool) || 0 < len(cmd.GetFlags().ConflictDir) {
+							if err = h.KeepBase(); err != nil {
+								fileInfo.Close()
+								return nil, NewReadFileError(tableIdentifier, err.Error())
+							}
+						}
 						fp = h.FileForRead()
 					} else {
 						h, err := file.NewHandlerForRead(fileInfo.Path)
@@ -623,22 +921,53 @@ func loadObject(
 						fp = h.FileForRead()
 					}
 
-					loadView, err := loadViewFromFile(fp, fileInfo, withoutNull)
+					lf := filter.loadFilterFor(tableName, fileInfo, useInternalId, forUpdate)
+					if lf != nil {
+						lf.columns = columns
+						lf.separators = numberSeparators(decimalSeparator, thousandsSeparator)
+					}
+
+					loadView, err := loadFilteredViewFromFile(fp, fileInfo, withoutNull, forUpdate, lf)
+					if lf != nil && lf.err != nil {
+						fileInfo.Close()
+						return nil, lf.err
+					}
 					if err != nil {
 						fileInfo.Close()
 						return nil, NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
 					}
+					if columns != nil {
+						if len(columns) != loadView.FieldLen() {
+							fileInfo.Close()
+							return nil, NewCatalogColumnsLengthError(tableIdentifier, len(columns), loadView.FieldLen())
+						}
+						for i := range columns {
+							loadView.Header[i].Column = columns[i]
+						}
+					}
+					if separators := numberSeparators(decimalSeparator, thousandsSeparator); separators != nil {
+						convertLocalizedNumbers(loadView, separators)
+					}
 					loadView.ForUpdate = forUpdate
-					ViewCache.Set(loadView)
+
+					if lf != nil {
+						// The filtered records are not cached because other queries require the other records.
+						lf.isApplied = true
+						view = loadView
+					} else {
+						ViewCache.Set(loadView)
+					}
 				}
 			}
 			commonTableName = parser.FormatTableName(filePath)
 
-			pathIdent := parser.Identifier{Literal: filePath}
-			if useInternalId {
-				view, _ = ViewCache.GetWithInternalId(pathIdent)
-			} else {
-				view, _ = ViewCache.Get(pathIdent)
+			if view == nil {
+				pathIdent := parser.Identifier{Literal: filePath}
+				if useInternalId {
+					view, _ = ViewCache.GetWithInternalId(pathIdent)
+				} else {
+					view, _ = ViewCache.Get(pathIdent)
+				}
 			}
 		}
 
@@ -653,19 +982,102 @@ func loadObject(
 	return view, nil
 }
 
-func loadViewFromFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*View, error) {
+// convertLocalizedNumbers sets the separators to the string values written as numbers with the separators,
+// so that they are converted into numbers when they are calculated or compared.
+// The strings are kept as they are to be written to the file.
+func convertLocalizedNumbers(view *View, separators *value.NumberSeparators) {
+	for _, record := range view.RecordSet {
+		convertLocalizedNumbersInRecord(record, separators)
+	}
+}
+
+func convertLocalizedNumbersInRecord(record Record, separators *value.NumberSeparators) {
+	for i := range record {
+		s, ok := record[i].Value().(value.String)
+		if !ok {
+			continue
+		}
+		if n := value.StrToNumber(s.Raw(), separators.Decimal, separators.Thousands); !value.IsNull(n) {
+			record[i] = NewCell(value.NewLocalizedString(s.Raw(), separators))
+		}
+	}
+}
+
+// numberSeparators returns nil if no separator is specified for the table.
+func numberSeparators(decimalSeparator string, thousandsSeparator string) *value.NumberSeparators {
+	if len(decimalSeparator) < 1 && len(thousandsSeparator) < 1 {
+		return nil
+	}
+	if len(decimalSeparator) < 1 {
+		decimalSeparator = "."
+	}
+	return &value.NumberSeparators{Decimal: decimalSeparator, Thousands: thousandsSeparator}
+}
+
+func loadViewFromFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool) (*View, error) {
+	return loadFilteredViewFromFile(fp, fileInfo, withoutNull, false, nil)
+}
+
+// loadFilteredViewFromFile loads the records that satisfy the load filter.
+// If the load filter is nil, then all the records are loaded.
+// The layouts of the lines are detected only for the views loaded for update, that are written to the files.
+func loadFilteredViewFromFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool, forUpdate bool, lf *loadFilter) (*View, error) {
+	if fileInfo.Format == cmd.JSON {
+		return loadViewFromJsonFile(fp, fileInfo)
+	}
+
+	flags := cmd.GetFlags()
+	decoder := NewDecodingReader(fp, fileInfo.Encoding, flags.EncodingErrors)
+
+	var r io.Reader = decoder
+	var lr *LayoutDetector
+	if forUpdate || requiresLayoutDetector(flags) {
+		lr = NewLayoutDetector(decoder, fileInfo.Format == cmd.CSV || fileInfo.Format == cmd.TSV, fileInfo.Delimiter)
+		lr.MaxFieldSize = flags.MaxFieldSize
+		lr.MaxRowSize = flags.MaxRowSize
+		lr.RecoverQuotes = flags.RecoverQuotes
+		r = lr
+	}
+
+	var view *View
+	var err error
 	switch fileInfo.Format {
 	case cmd.FIXED:
-		return loadViewFromFixedLengthTextFile(fp, fileInfo, withoutNull)
+		view, err = loadViewFromFixedLengthTextFile(r, fileInfo, withoutNull)
 	case cmd.LTSV:
-		return loadViewFromLTSVFile(fp, fileInfo, withoutNull)
-	case cmd.JSON:
-		return loadViewFromJsonFile(fp, fileInfo)
+		view, err = loadViewFromLTSVFile(r, fileInfo, withoutNull)
+	default:
+		view, err = loadViewFromCSVFile(r, fileInfo, withoutNull, lf)
+	}
+	if err != nil {
+		return nil, err
 	}
-	return loadViewFromCSVFile(fp, fileInfo, withoutNull)
+
+	if lr != nil {
+		if forUpdate {
+			if lineBreaks, lineBreak, ok := lr.Mixed(headerLines(fileInfo) + view.RecordLen()); ok {
+				fileInfo.LineBreaks = lineBreaks
+				fileInfo.LineBreak = lineBreak
+			}
+			if fieldQuotes, ok := lr.Quotes(headerLines(fileInfo) + view.RecordLen()); ok {
+				fileInfo.FieldQuotes = fieldQuotes
+			}
+		}
+
+		if 0 < len(lr.RecoveredLines) {
+			LogWarn(fmt.Sprintf("%s: opening double quotes of the fields at %s are read as parts of the values", fileInfo.Path, formatNumbers("line", lr.RecoveredLines)), flags.Quiet)
+		}
+	}
+
+	if 0 < decoder.Errors {
+		LogWarn(encodingErrorsWarning(fmt.Sprintf("%s: %s", fileInfo.Path, FormatCount(decoder.Errors, "invalid byte sequence"))), flags.Quiet)
+	}
+	return view, nil
 }
 
-func loadViewFromFixedLengthTextFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*View, error) {
+// The functions to load views from files read texts already decoded to UTF-8 by a DecodingReader.
+
+func loadViewFromFixedLengthTextFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool) (*View, error) {
 	var err error
 
 	data, err := ioutil.ReadAll(fp)
@@ -675,7 +1087,7 @@ func loadViewFromFixedLengthTextFile(fp *os.File, fileInfo *FileInfo, withoutNul
 	r := bytes.NewReader(data)
 
 	if fileInfo.DelimiterPositions == nil {
-		d := fixedlen.NewDelimiter(r, fileInfo.Encoding)
+		d := fixedlen.NewDelimiter(r, text.UTF8)
 		d.NoHeader = fileInfo.NoHeader
 		d.Encoding = fileInfo.Encoding
 		fileInfo.DelimiterPositions, err = d.Delimit()
@@ -685,7 +1097,7 @@ func loadViewFromFixedLengthTextFile(fp *os.File, fileInfo *FileInfo, withoutNul
 	}
 
 	r.Seek(0, io.SeekStart)
-	reader := fixedlen.NewReader(r, fileInfo.DelimiterPositions, fileInfo.Encoding)
+	reader := fixedlen.NewReader(r, fileInfo.DelimiterPositions, text.UTF8)
 	reader.WithoutNull = withoutNull
 	reader.Encoding = fileInfo.Encoding
 
@@ -720,8 +1132,8 @@ func loadViewFromFixedLengthTextFile(fp *os.File, fileInfo *FileInfo, withoutNul
 	return view, nil
 }
 
-func loadViewFromCSVFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*View, error) {
-	reader := csv.NewReader(fp, fileInfo.Encoding)
+func loadViewFromCSVFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool, lf *loadFilter) (*View, error) {
+	reader := csv.NewReader(fp, text.UTF8)
 	reader.Delimiter = fileInfo.Delimiter
 	reader.WithoutNull = withoutNull
 
@@ -734,7 +1146,14 @@ func loadViewFromCSVFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*Vi
 		}
 	}
 
-	records, err := readRecordSet(reader)
+	var accept func([]value.Primary) bool
+	if lf != nil {
+		accept = func(fields []value.Primary) bool {
+			return lf.accept(header, fields)
+		}
+	}
+
+	records, err := readFilteredRecordSet(reader, accept)
 	if err != nil {
 		return nil, err
 	}
@@ -758,8 +1177,8 @@ func loadViewFromCSVFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*Vi
 	return view, nil
 }
 
-func loadViewFromLTSVFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*View, error) {
-	reader := ltsv.NewReader(fp, fileInfo.Encoding)
+func loadViewFromLTSVFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool) (*View, error) {
+	reader := ltsv.NewReader(fp, text.UTF8)
 	reader.WithoutNull = withoutNull
 
 	records, err := readRecordSet(reader)
@@ -768,7 +1187,7 @@ func loadViewFromLTSVFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*V
 	}
 
 	header := reader.Header.Fields()
-	NewGoroutineTaskManager(len(records), -1).Run(func(index int) {
+	NewGoroutineTaskManager(len(records), -1, cmd.GetFlags().CPU).Run(func(index int) {
 		for j := len(records[index]); j < len(header); j++ {
 			if withoutNull {
 				records[index] = append(records[index], NewCell(value.NewString("")))
@@ -790,6 +1209,12 @@ func loadViewFromLTSVFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*V
 }
 
 func readRecordSet(reader RecordReader) (RecordSet, error) {
+	return readFilteredRecordSet(reader, nil)
+}
+
+// readFilteredRecordSet reads the records for which accept returns true.
+// If accept is nil, then all the records are read.
+func readFilteredRecordSet(reader RecordReader, accept func([]value.Primary) bool) (RecordSet, error) {
 	var err error
 	records := make(RecordSet, 0, 1000)
 	rowch := make(chan []text.RawText, 1000)
@@ -824,6 +1249,9 @@ func readRecordSet(reader RecordReader) (RecordSet, error) {
 					fields[i] = value.NewString(string(v))
 				}
 			}
+			if accept != nil && !accept(fields) {
+				continue
+			}
 			fieldch <- fields
 		}
 		close(fieldch)
@@ -858,7 +1286,12 @@ func loadViewFromJsonFile(fp io.Reader, fileInfo *FileInfo) (*View, error) {
 		return nil, err
 	}
 
-	headerLabels, rows, escapeType, err := json.LoadTable(fileInfo.JsonQuery, string(jsonText))
+	var cachePath string
+	if cacheDir := cmd.GetFlags().CacheDir; 0 < len(cacheDir) {
+		cachePath = ConversionCachePath(cacheDir, fileInfo.JsonQuery, jsonText)
+	}
+
+	headerLabels, rows, escapeType, err := loadJsonTable(fileInfo.JsonQuery, jsonText, cachePath)
 	if err != nil {
 		return nil, err
 	}
@@ -877,6 +1310,25 @@ func loadViewFromJsonFile(fp io.Reader, fileInfo *FileInfo) (*View, error) {
 	return view, nil
 }
 
+func loadJsonTable(jsonQuery string, jsonText []byte, cachePath string) ([]string, [][]value.Primary, txjson.EscapeType, error) {
+	if 0 < len(cachePath) {
+		if headerLabels, rows, escapeType, err := LoadConversionCache(cachePath); err == nil {
+			return headerLabels, rows, escapeType, nil
+		}
+	}
+
+	headerLabels, rows, escapeType, err := json.LoadTable(jsonQuery, string(jsonText))
+	if err != nil {
+		return nil, nil, escapeType, err
+	}
+
+	if 0 < len(cachePath) {
+		// Failures to write caches do not affect the results of queries.
+		SaveConversionCache(cachePath, headerLabels, rows, escapeType)
+	}
+	return headerLabels, rows, escapeType, nil
+}
+
 func loadDualView() *View {
 	view := View{
 		Header:    NewDualHeader(),
@@ -909,14 +1361,19 @@ func NewViewFromGroupedRecord(filterRecord FilterRecord) *View {
 }
 
 func (view *View) Where(clause parser.WhereClause) error {
-	return view.filter(clause.Filter)
+	condition, alwaysTrue := simplifyCondition(clause.Filter, view.Filter)
+	if alwaysTrue {
+		return nil
+	}
+	return view.filter(reorderPredicates(condition))
 }
 
 func (view *View) filter(condition parser.QueryExpression) error {
 	results := make([]bool, view.RecordLen())
+	evaluate := compileExpression(condition, view)
 
 	err := NewFilterForSequentialEvaluation(view, view.Filter).EvaluateSequentially(func(f *Filter, rIdx int) error {
-		primary, e := f.Evaluate(condition)
+		primary, e := evaluate(f)
 		if e != nil {
 			return e
 		}
@@ -972,21 +1429,12 @@ func (view *View) group(items []parser.QueryExpression) error {
 		return err
 	}
 
-	groups := make(map[string][]int)
-	groupKeys := make([]string, 0)
-	for i, key := range keys {
-		if _, ok := groups[key]; ok {
-			groups[key] = append(groups[key], i)
-		} else {
-			groups[key] = []int{i}
-			groupKeys = append(groupKeys, key)
-		}
-	}
+	groups := groupRecordIndices(keys, view.Filter.cpu())
 
-	records := make(RecordSet, len(groupKeys))
-	for i, groupKey := range groupKeys {
+	records := make(RecordSet, len(groups))
+	NewGoroutineTaskManager(len(groups), -1, view.Filter.cpu()).Run(func(i int) {
 		record := make(Record, view.FieldLen())
-		indices := groups[groupKey]
+		indices := groups[i]
 
 		for j := 0; j < view.FieldLen(); j++ {
 			primaries := make([]value.Primary, len(indices))
@@ -997,20 +1445,104 @@ func (view *View) group(items []parser.QueryExpression) error {
 		}
 
 		records[i] = record
-	}
+	})
 
 	view.RecordSet = records
 	view.isGrouped = true
 	for _, item := range items {
 		switch item.(type) {
 		case parser.FieldReference, parser.ColumnNumber:
-			idx, _ := view.FieldIndex(item)
-			view.Header[idx].IsGroupKey = true
+			if idx, err := view.FieldIndex(item); err == nil {
+				view.Header[idx].IsGroupKey = true
+			}
 		}
 	}
 	return nil
 }
 
+// groupRecordIndices returns the indices of the records in each group in order of the first appearances of the groups.
+//
+// The records are partitioned by the hashes of their keys, and the records in each partition are
+// grouped in parallel. Then the groups in all the partitions are merged.
+func groupRecordIndices(keys []string, cpu int) [][]int {
+	gm := NewGoroutineTaskManager(len(keys), -1, cpu)
+	if gm.Number < 2 {
+		indices := make([]int, len(keys))
+		for i := range indices {
+			indices[i] = i
+		}
+		return groupIndices(keys, indices)
+	}
+
+	partitionLen := gm.Number
+	buckets := make([][][]int, gm.Number)
+	for i := 0; i < gm.Number; i++ {
+		gm.Add()
+		go func(thIdx int) {
+			start, end := gm.RecordRange(thIdx)
+			bucket := make([][]int, partitionLen)
+			for j := start; j < end; j++ {
+				p := partitionIndex(keys[j], partitionLen)
+				bucket[p] = append(bucket[p], j)
+			}
+			buckets[thIdx] = bucket
+			gm.Done()
+		}(i)
+	}
+	gm.Wait()
+
+	partitions := make([][][]int, partitionLen)
+	NewGoroutineTaskManager(partitionLen, 1, cpu).Run(func(p int) {
+		size := 0
+		for _, bucket := range buckets {
+			size = size + len(bucket[p])
+		}
+		indices := make([]int, 0, size)
+		for _, bucket := range buckets {
+			indices = append(indices, bucket[p]...)
+		}
+		partitions[p] = groupIndices(keys, indices)
+	})
+
+	size := 0
+	for _, groups := range partitions {
+		size = size + len(groups)
+	}
+	groups := make([][]int, 0, size)
+	for _, v := range partitions {
+		groups = append(groups, v...)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i][0] < groups[j][0]
+	})
+	return groups
+}
+
+// groupIndices groups the indices in ascending order by the keys of the records.
+func groupIndices(keys []string, indices []int) [][]int {
+	positions := make(map[string]int)
+	groups := make([][]int, 0)
+	for _, idx := range indices {
+		if pos, ok := positions[keys[idx]]; ok {
+			groups[pos] = append(groups[pos], idx)
+		} else {
+			positions[keys[idx]] = len(groups)
+			groups = append(groups, []int{idx})
+		}
+	}
+	return groups
+}
+
+// partitionIndex returns the index of the partition for the key by using the FNV-1a hash.
+func partitionIndex(key string, partitionLen int) int {
+	h := uint32(2166136261)
+	for i := 0; i < len(key); i++ {
+		h = h ^ uint32(key[i])
+		h = h * 16777619
+	}
+	return int(h % uint32(partitionLen))
+}
+
 func (view *View) groupAll() error {
 	if 0 < view.RecordLen() {
 		records := make(RecordSet, 1)
@@ -1031,11 +1563,13 @@ func (view *View) groupAll() error {
 }
 
 func (view *View) Having(clause parser.HavingClause) error {
-	err := view.filter(clause.Filter)
+	condition, _ := simplifyCondition(clause.Filter, view.Filter)
+	condition = reorderPredicates(condition)
+	err := view.filter(condition)
 	if err != nil {
 		if _, ok := err.(*NotGroupingRecordsError); ok {
 			view.group(nil)
-			err = view.filter(clause.Filter)
+			err = view.filter(condition)
 			if err != nil {
 				return err
 			}
@@ -1172,7 +1706,7 @@ func (view *View) Select(clause parser.SelectClause) error {
 func (view *View) GenerateComparisonKeys() {
 	view.comparisonKeysInEachRecord = make([]string, view.RecordLen())
 
-	NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+	NewGoroutineTaskManager(view.RecordLen(), -1, view.Filter.cpu()).Run(func(index int) {
 		buf := new(bytes.Buffer)
 		if view.selectFields != nil {
 			primaries := make([]value.Primary, len(view.selectFields))
@@ -1197,25 +1731,62 @@ func (view *View) SelectAllColumns() error {
 }
 
 func (view *View) OrderBy(clause parser.OrderByClause) error {
+	sortIndices, collations, err := view.prepareOrderBy(clause)
+	if err != nil {
+		return err
+	}
+
+	view.sortValuesInEachRecord = make([]SortValues, view.RecordLen())
+
+	NewGoroutineTaskManager(view.RecordLen(), -1, view.Filter.cpu()).Run(func(index int) {
+		if view.sortValuesInEachCell != nil && view.sortValuesInEachCell[index] == nil {
+			view.sortValuesInEachCell[index] = make([]*SortValue, cap(view.RecordSet[index]))
+		}
+
+		sortValues := make(SortValues, len(sortIndices))
+		for j, idx := range sortIndices {
+			if view.sortValuesInEachCell != nil && idx < len(view.sortValuesInEachCell[index]) && view.sortValuesInEachCell[index][idx] != nil {
+				sortValues[j] = view.sortValuesInEachCell[index][idx]
+			} else {
+				sortValues[j] = NewSortValueWithCollation(view.RecordSet[index][idx].Value(), collations[j])
+				if view.sortValuesInEachCell != nil && idx < len(view.sortValuesInEachCell[index]) {
+					view.sortValuesInEachCell[index][idx] = sortValues[j]
+				}
+			}
+		}
+		view.sortValuesInEachRecord[index] = sortValues
+	})
+
+	sort.Sort(view)
+	return nil
+}
+
+// prepareOrderBy evaluates the values of the order by clause, and sets the directions and the positions of nulls.
+// The indices of the fields having the values and the collations of the values are returned.
+func (view *View) prepareOrderBy(clause parser.OrderByClause) ([]int, []value.Collation, error) {
 	orderValues := make([]parser.QueryExpression, len(clause.Items))
 	for i, item := range clause.Items {
 		orderValues[i] = item.(parser.OrderItem).Value
 	}
 	if err := view.ExtendRecordCapacity(orderValues); err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	sortIndices := make([]int, len(clause.Items))
+	collations := make([]value.Collation, len(clause.Items))
 	for i, v := range clause.Items {
 		oi := v.(parser.OrderItem)
 		idx, err := view.evalColumn(oi.Value, "")
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 		sortIndices[i] = idx
+
+		if collations[i], _, err = collationOf(oi.Value); err != nil {
+			return nil, nil, err
+		}
 	}
 
-	view.sortValuesInEachRecord = make([]SortValues, view.RecordLen())
 	view.sortDirections = make([]int, len(clause.Items))
 	view.sortNullPositions = make([]int, len(clause.Items))
 
@@ -1239,27 +1810,7 @@ func (view *View) OrderBy(clause parser.OrderByClause) error {
 		}
 	}
 
-	NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
-		if view.sortValuesInEachCell != nil && view.sortValuesInEachCell[index] == nil {
-			view.sortValuesInEachCell[index] = make([]*SortValue, cap(view.RecordSet[index]))
-		}
-
-		sortValues := make(SortValues, len(sortIndices))
-		for j, idx := range sortIndices {
-			if view.sortValuesInEachCell != nil && idx < len(view.sortValuesInEachCell[index]) && view.sortValuesInEachCell[index][idx] != nil {
-				sortValues[j] = view.sortValuesInEachCell[index][idx]
-			} else {
-				sortValues[j] = NewSortValue(view.RecordSet[index][idx].Value())
-				if view.sortValuesInEachCell != nil && idx < len(view.sortValuesInEachCell[index]) {
-					view.sortValuesInEachCell[index][idx] = sortValues[j]
-				}
-			}
-		}
-		view.sortValuesInEachRecord[index] = sortValues
-	})
-
-	sort.Sort(view)
-	return nil
+	return sortIndices, collations, nil
 }
 
 func (view *View) additionalColumns(expr parser.QueryExpression) ([]string, error) {
@@ -1350,7 +1901,7 @@ func (view *View) ExtendRecordCapacity(exprs []parser.QueryExpression) error {
 		return nil
 	}
 
-	NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+	NewGoroutineTaskManager(view.RecordLen(), -1, view.Filter.cpu()).Run(func(index int) {
 		record := make(Record, currentLen, fieldCap)
 		copy(record, view.RecordSet[index])
 		view.RecordSet[index] = record
@@ -1359,8 +1910,13 @@ func (view *View) ExtendRecordCapacity(exprs []parser.QueryExpression) error {
 }
 
 func (view *View) evalColumn(obj parser.QueryExpression, alias string) (idx int, err error) {
+	isFieldReference := false
 	switch obj.(type) {
 	case parser.FieldReference, parser.ColumnNumber:
+		isFieldReference = !view.isMapMemberReference(obj)
+	}
+
+	if isFieldReference {
 		if idx, err = view.FieldIndex(obj); err != nil {
 			return
 		}
@@ -1368,7 +1924,7 @@ func (view *View) evalColumn(obj parser.QueryExpression, alias string) (idx int,
 			err = NewFieldNotGroupKeyError(obj)
 			return
 		}
-	default:
+	} else {
 		idx, err = view.Header.ContainsObject(obj)
 		if err != nil {
 			err = nil
@@ -1379,8 +1935,9 @@ func (view *View) evalColumn(obj parser.QueryExpression, alias string) (idx int,
 					return
 				}
 			} else {
+				evaluate := compileExpression(obj, view)
 				err = NewFilterForSequentialEvaluation(view, view.Filter).EvaluateSequentially(func(f *Filter, rIdx int) error {
-					primary, e := f.Evaluate(obj)
+					primary, e := evaluate(f)
 					if e != nil {
 						return e
 					}
@@ -1405,6 +1962,20 @@ func (view *View) evalColumn(obj parser.QueryExpression, alias string) (idx int,
 	return
 }
 
+// isMapMemberReference reports whether the expression in the form of "column.key"
+// refers to a member of the map in the column rather than a field of a view.
+func (view *View) isMapMemberReference(obj parser.QueryExpression) bool {
+	fieldRef, ok := obj.(parser.FieldReference)
+	if !ok || len(fieldRef.View.Literal) < 1 {
+		return false
+	}
+	if _, err := view.FieldIndex(obj); err == nil {
+		return false
+	}
+	_, err := view.FieldIndex(parser.FieldReference{BaseExpr: fieldRef.BaseExpr, Column: fieldRef.View})
+	return err == nil
+}
+
 func (view *View) evalAnalyticFunction(expr parser.AnalyticFunction) error {
 	name := strings.ToUpper(expr.Name)
 	if _, ok := AggregateFunctions[name]; !ok {
@@ -1598,6 +2169,10 @@ func (view *View) insert(fields []parser.QueryExpression, valuesList [][]value.P
 		records[i] = record
 	}
 
+	if err = view.assignAutoIncrements(records, fieldIndices); err != nil {
+		return insertRecords, err
+	}
+
 	view.RecordSet = append(view.RecordSet, records...)
 	return len(valuesList), nil
 }
@@ -1616,7 +2191,7 @@ func (view *View) Fix() {
 	}
 
 	if resize {
-		NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+		NewGoroutineTaskManager(view.RecordLen(), -1, view.Filter.cpu()).Run(func(index int) {
 			record := make(Record, len(view.selectFields))
 			for j, idx := range view.selectFields {
 				if 1 < view.RecordSet[index].GroupLen() {
@@ -1765,6 +2340,37 @@ func (view *View) ListValuesForAggregateFunctions(expr parser.QueryExpression, a
 	return list, nil
 }
 
+// FilterForAggregateFunctions removes the records that do not satisfy the condition of the filter clause
+// of an aggregate function.
+func (view *View) FilterForAggregateFunctions(expr parser.QueryExpression, clause parser.FilterClause, filter *Filter) error {
+	condition := clause.Where.(parser.WhereClause).Filter
+	results := make([]bool, view.RecordLen())
+
+	err := NewFilterForSequentialEvaluation(view, filter).EvaluateSequentially(func(f *Filter, rIdx int) error {
+		p, e := f.Evaluate(condition)
+		if e != nil {
+			if _, ok := e.(*NotGroupingRecordsError); ok {
+				e = NewNestedAggregateFunctionsError(expr)
+			}
+			return e
+		}
+		results[rIdx] = p.Ternary() == ternary.TRUE
+		return nil
+	}, condition)
+	if err != nil {
+		return err
+	}
+
+	records := make(RecordSet, 0, len(results))
+	for i, ok := range results {
+		if ok {
+			records = append(records, view.RecordSet[i])
+		}
+	}
+	view.RecordSet = records
+	return nil
+}
+
 func (view *View) RestoreHeaderReferences() {
 	view.Header.Update(parser.FormatTableName(view.FileInfo.Path), nil)
 }
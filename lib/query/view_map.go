@@ -68,7 +68,7 @@ func (list TemporaryViewScopes) Store(uncomittedViews map[string]*FileInfo) {
 			if _, ok := uncomittedViews[view.FileInfo.Path]; ok {
 				view.FileInfo.InitialRecordSet = view.RecordSet.Copy()
 				view.FileInfo.InitialHeader = view.Header.Copy()
-				LogNotice(fmt.Sprintf("Commit: restore point of view %q is created.", view.FileInfo.Path), cmd.GetFlags().Quiet)
+				LogNotice(fmt.Sprintf(cmd.Message("Commit: restore point of view %q is created."), view.FileInfo.Path), cmd.GetFlags().Quiet)
 			}
 		}
 	}
@@ -80,7 +80,7 @@ func (list TemporaryViewScopes) Restore(uncomittedViews map[string]*FileInfo) {
 			if _, ok := uncomittedViews[view.FileInfo.Path]; ok {
 				view.RecordSet = view.FileInfo.InitialRecordSet.Copy()
 				view.Header = view.FileInfo.InitialHeader.Copy()
-				LogNotice(fmt.Sprintf("Rollback: view %q is restored.", view.FileInfo.Path), cmd.GetFlags().Quiet)
+				LogNotice(fmt.Sprintf(cmd.Message("Rollback: view %q is restored."), view.FileInfo.Path), cmd.GetFlags().Quiet)
 			}
 		}
 	}
@@ -127,7 +127,7 @@ func (m ViewMap) GetWithInternalId(fpath parser.Identifier) (*View, error) {
 
 		ret.Header = MergeHeader(NewHeaderWithId(ret.Header[0].View, []string{}), ret.Header)
 
-		NewGoroutineTaskManager(ret.RecordLen(), -1).Run(func(index int) {
+		NewGoroutineTaskManager(ret.RecordLen(), -1, cmd.GetFlags().CPU).Run(func(index int) {
 			ret.RecordSet[index] = append(Record{NewCell(value.NewInteger(int64(index)))}, ret.RecordSet[index]...)
 		})
 
@@ -5,11 +5,15 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/file/filetest"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 
@@ -842,6 +846,98 @@ var viewLoadTests = []struct {
 		},
 		Error: "[L:- C:-] invalid argument for csv: encoding must be one of UTF8|SJIS",
 	},
+	{
+		Name: "Load TableObject From CSV File With Options",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Identifier{Literal: "csv"},
+						FormatElement: parser.NewStringValue("table5"),
+						Options: []parser.QueryExpression{
+							parser.TableObjectOption{Name: parser.Identifier{Literal: "delimiter"}, Value: parser.NewStringValue(",")},
+							parser.TableObjectOption{Name: parser.Identifier{Literal: "encoding"}, Value: parser.FieldReference{Column: parser.Identifier{Literal: "SJIS"}}},
+							parser.TableObjectOption{Name: parser.Identifier{Literal: "noheader"}},
+							parser.TableObjectOption{Name: parser.Identifier{Literal: "without_null"}, Value: parser.NewTernaryValueFromString("true")},
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString(""),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table5.csv",
+				Delimiter: ',',
+				Format:    cmd.CSV,
+				Encoding:  text.SJIS,
+				LineBreak: text.LF,
+				NoHeader:  true,
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{{
+					"T": strings.ToUpper(GetTestFilePath("table5.csv")),
+				}},
+			},
+		},
+	},
+	{
+		Name: "Load TableObject From CSV File Unknown Option Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Identifier{Literal: "csv"},
+						Path: parser.Identifier{Literal: "table5"},
+						Options: []parser.QueryExpression{
+							parser.TableObjectOption{Name: parser.Identifier{Literal: "delimiter_positions"}, Value: parser.NewStringValue("[1]")},
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "[L:- C:-] invalid argument for csv: unknown option: delimiter_positions",
+	},
+	{
+		Name: "Load TableObject From CSV File Option Without Value Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Identifier{Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table5"},
+						Options: []parser.QueryExpression{
+							parser.TableObjectOption{Name: parser.Identifier{Literal: "no_header"}},
+							parser.TableObjectOption{Name: parser.Identifier{Literal: "encoding"}},
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "[L:- C:-] invalid argument for csv: option encoding requires a value",
+	},
 	{
 		Name: "Load TableObject From Fixed-Length File",
 		From: parser.FromClause{
@@ -2920,6 +3016,32 @@ func TestView_GroupBy(t *testing.T) {
 	}
 }
 
+func TestGroupRecordIndices(t *testing.T) {
+	keys := make([]string, 1000)
+	for i := range keys {
+		keys[i] = strconv.Itoa((i * 7) % 13)
+	}
+
+	expect := make([][]int, 13)
+	for i, key := range keys {
+		n, _ := strconv.Atoi(key)
+		expect[n] = append(expect[n], i)
+	}
+	sort.Slice(expect, func(i, j int) bool {
+		return expect[i][0] < expect[j][0]
+	})
+
+	for _, cpu := range []int{1, 4} {
+		if result := groupRecordIndices(keys, cpu); !reflect.DeepEqual(result, expect) {
+			t.Errorf("result = %v, want %v with %d cpu", result, expect, cpu)
+		}
+	}
+
+	if result := groupRecordIndices([]string{}, 4); len(result) != 0 {
+		t.Errorf("result = %v, want empty groups", result)
+	}
+}
+
 var viewHavingTests = []struct {
 	Name   string
 	View   *View
@@ -4226,6 +4348,107 @@ var viewOrderByTests = []struct {
 		},
 		Error: "[L:- C:-] function sum cannot aggregate not grouping records",
 	},
+	{
+		Name: "Order By with Collation",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{value.NewString("g")}),
+				NewRecordWithId(2, []value.Primary{value.NewString("é")}),
+				NewRecordWithId(3, []value.Primary{value.NewString("E")}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		OrderBy: parser.OrderByClause{
+			Items: []parser.QueryExpression{
+				parser.OrderItem{
+					Value: parser.Collate{
+						Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						Collate:   "collate",
+						Collation: parser.Identifier{Literal: "unicode"},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", IsFromTable: true},
+				{Column: "column1 collate unicode"},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(3, []value.Primary{value.NewString("E"), value.NewString("E")}),
+				NewRecordWithId(2, []value.Primary{value.NewString("é"), value.NewString("é")}),
+				NewRecordWithId(1, []value.Primary{value.NewString("g"), value.NewString("g")}),
+			},
+		},
+	},
+	{
+		Name: "Order By with Natural Collation",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{value.NewString("file10")}),
+				NewRecordWithId(2, []value.Primary{value.NewString("file2")}),
+				NewRecordWithId(3, []value.Primary{value.NewString("File1")}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		OrderBy: parser.OrderByClause{
+			Items: []parser.QueryExpression{
+				parser.OrderItem{
+					Value: parser.Collate{
+						Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						Collate:   "collate",
+						Collation: parser.Identifier{Literal: "natural"},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", IsFromTable: true},
+				{Column: "column1 collate natural"},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(3, []value.Primary{value.NewString("File1"), value.NewString("File1")}),
+				NewRecordWithId(2, []value.Primary{value.NewString("file2"), value.NewString("file2")}),
+				NewRecordWithId(1, []value.Primary{value.NewString("file10"), value.NewString("file10")}),
+			},
+		},
+	},
+	{
+		Name: "Order By Invalid Collation Error",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{value.NewString("g")}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		OrderBy: parser.OrderByClause{
+			Items: []parser.QueryExpression{
+				parser.OrderItem{
+					Value: parser.Collate{
+						Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						Collate:   "collate",
+						Collation: parser.Identifier{Literal: "notexist"},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] notexist: collation must be one of BINARY|NOCASE|UNICODE|UNICODE_CI|NATURAL",
+	},
 }
 
 func TestView_OrderBy(t *testing.T) {
@@ -6025,3 +6248,799 @@ func TestView_InternalRecordId(t *testing.T) {
 		t.Errorf("error = %q, want error %q", err, expectError)
 	}
 }
+
+var catalogSeparators = &value.NumberSeparators{Decimal: ",", Thousands: "."}
+var tableObjectSeparators = &value.NumberSeparators{Decimal: ",", Thousands: ""}
+
+var viewLoadWithCatalogTests = []struct {
+	Name   string
+	Query  string
+	Result *View
+	Error  string
+}{
+	{
+		Name:  "Load View with Catalog",
+		Query: "SELECT * FROM sales",
+		Result: &View{
+			Header: NewHeader("sales", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("1"), value.NewString("str1")}),
+				NewRecord([]value.Primary{value.NewString("2"), value.NewString("str2")}),
+				NewRecord([]value.Primary{value.NewString("3"), value.NewString("str3")}),
+			},
+		},
+	},
+	{
+		Name:  "Load View with Catalog Case Insensitive",
+		Query: "SELECT * FROM USERS",
+		Result: &View{
+			Header: NewHeader("USERS", []string{"column5", "column6"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("1"), value.NewString("str1")}),
+				NewRecord([]value.Primary{value.NewString("2"), value.NewString("str2")}),
+			},
+		},
+	},
+	{
+		Name:  "Load View with Catalog Import Format",
+		Query: "SELECT * FROM items",
+		Result: &View{
+			Header: NewHeader("items", []string{"column1,column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("1,str1")}),
+				NewRecord([]value.Primary{value.NewString("2,str2")}),
+				NewRecord([]value.Primary{value.NewString("3,str3")}),
+			},
+		},
+	},
+	{
+		Name:  "Load View with Catalog Delimiter",
+		Query: "SELECT * FROM tabbed",
+		Result: &View{
+			Header: NewHeader("tabbed", []string{"column1,column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("1,str1")}),
+				NewRecord([]value.Primary{value.NewString("2,str2")}),
+				NewRecord([]value.Primary{value.NewString("3,str3")}),
+			},
+		},
+	},
+	{
+		Name:  "Load View with Catalog Encoding",
+		Query: "SELECT * FROM sjis",
+		Result: &View{
+			Header: NewHeader("sjis", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("1"), value.NewString("日本語")}),
+				NewRecord([]value.Primary{value.NewString("2"), value.NewString("str")}),
+			},
+		},
+	},
+	{
+		Name:  "Load View with Catalog Columns",
+		Query: "SELECT * FROM numbers",
+		Result: &View{
+			Header: NewHeader("numbers", []string{"id", "name"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("1"), value.NewString("str1")}),
+				NewRecord([]value.Primary{value.NewString("2"), value.NewString("")}),
+				NewRecord([]value.Primary{value.NewString("3"), value.NewString("str3")}),
+			},
+		},
+	},
+	{
+		Name:  "Load View with Catalog Options Overridden by Table Object",
+		Query: "SELECT * FROM CSV(',', tabbed) AS t",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("1"), value.NewString("str1")}),
+				NewRecord([]value.Primary{value.NewString("2"), value.NewString("str2")}),
+				NewRecord([]value.Primary{value.NewString("3"), value.NewString("str3")}),
+			},
+		},
+	},
+	{
+		Name:  "Load View with Catalog Separators",
+		Query: "SELECT * FROM european",
+		Result: &View{
+			Header: NewHeader("european", []string{"id", "amount", "note"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewLocalizedString("1", catalogSeparators), value.NewLocalizedString("1.234,56", catalogSeparators), value.NewString("a")}),
+				NewRecord([]value.Primary{value.NewLocalizedString("2", catalogSeparators), value.NewLocalizedString("7,5", catalogSeparators), value.NewString("1.5")}),
+				NewRecord([]value.Primary{value.NewLocalizedString("3", catalogSeparators), value.NewLocalizedString("1.000", catalogSeparators), value.NewString("12.34.5")}),
+			},
+		},
+	},
+	{
+		Name:  "Load View with Separators Specified by Table Object",
+		Query: "SELECT * FROM CSV('table_eu.csv' WITH (delimiter ';', decimal_separator ',')) AS t",
+		Result: &View{
+			Header: NewHeader("t", []string{"id", "amount", "note"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewLocalizedString("1", tableObjectSeparators), value.NewString("1.234,56"), value.NewString("a")}),
+				NewRecord([]value.Primary{value.NewLocalizedString("2", tableObjectSeparators), value.NewLocalizedString("7,5", tableObjectSeparators), value.NewString("1.5")}),
+				NewRecord([]value.Primary{value.NewLocalizedString("3", tableObjectSeparators), value.NewString("1.000"), value.NewString("12.34.5")}),
+			},
+		},
+	},
+	{
+		Name:  "Load View with Invalid Separator Specified by Table Object Error",
+		Query: "SELECT * FROM CSV('table_eu.csv' WITH (delimiter ';', decimal_separator ';')) AS t",
+		Error: "[L:1 C:15] invalid argument for CSV: decimal separator must be one of .|,",
+	},
+	{
+		Name:  "Load View with Catalog Columns Length Error",
+		Query: "SELECT * FROM mismatch",
+		Error: "[L:1 C:15] " + filepath.Join(TestDir, "table1.csv") + ": catalog defines 1 column, but the table has 2 fields",
+	},
+	{
+		Name:  "Load View Not in Catalog",
+		Query: "SELECT * FROM table2",
+		Result: &View{
+			Header: NewHeader("table2", []string{"column3", "column4"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("2"), value.NewString("str22")}),
+				NewRecord([]value.Primary{value.NewString("3"), value.NewString("str33")}),
+				NewRecord([]value.Primary{value.NewString("4"), value.NewString("str44")}),
+			},
+		},
+	},
+	{
+		Name:  "Load View Not in Catalog File Not Exist Error",
+		Query: "SELECT * FROM notexist",
+		Error: "[L:1 C:15] file notexist does not exist",
+	},
+	{
+		Name:  "Load View with Catalog Inline Table Precedence",
+		Query: "WITH sales AS (SELECT 1 AS c1) SELECT * FROM sales",
+		Result: &View{
+			Header: NewHeader("sales", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1)}),
+			},
+		},
+	},
+}
+
+func TestView_LoadWithCatalog(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	if err := tf.SetCatalog(filepath.Join(TestDir, "catalog.json")); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer tf.SetCatalog("")
+
+	for _, v := range viewLoadWithCatalogTests {
+		ViewCache.Clean()
+
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		view, err := Select(program[0].(parser.SelectQuery), NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if !reflect.DeepEqual(view.Header, v.Result.Header) {
+			t.Errorf("%s: header = %v, want %v", v.Name, view.Header, v.Result.Header)
+		}
+		if !reflect.DeepEqual(view.RecordSet, v.Result.RecordSet) {
+			t.Errorf("%s: records = %v, want %v", v.Name, view.RecordSet, v.Result.RecordSet)
+		}
+	}
+}
+
+var viewLoadUnnestTests = []struct {
+	Name   string
+	Query  string
+	Result [][]value.Primary
+	Error  string
+}{
+	{
+		Name:  "Load Unnest",
+		Query: "SELECT u FROM UNNEST([1, 2]) AS u",
+		Result: [][]value.Primary{
+			{value.NewInteger(1)},
+			{value.NewInteger(2)},
+		},
+	},
+	{
+		Name:   "Load Unnest Null",
+		Query:  "SELECT u FROM UNNEST(NULL) AS u",
+		Result: [][]value.Primary{},
+	},
+	{
+		Name:  "Load Unnest Cross Join",
+		Query: "SELECT column1, tag FROM table1 CROSS JOIN UNNEST(SPLIT(column2, 'r')) AS tag WHERE column1 = 1",
+		Result: [][]value.Primary{
+			{value.NewString("1"), value.NewString("st")},
+			{value.NewString("1"), value.NewString("1")},
+		},
+	},
+	{
+		Name:  "Load Unnest Left Outer Join",
+		Query: "SELECT column1, n FROM table1 LEFT JOIN UNNEST(IF(column1 = 2, [1, 2], NULL)) AS n ON n = 2",
+		Result: [][]value.Primary{
+			{value.NewString("1"), value.NewNull()},
+			{value.NewString("2"), value.NewInteger(2)},
+			{value.NewString("3"), value.NewNull()},
+		},
+	},
+	{
+		Name:  "Load Unnest Comma Separated",
+		Query: "SELECT column1, n FROM table1, UNNEST([column1, 0]) AS n WHERE column1 = 3",
+		Result: [][]value.Primary{
+			{value.NewString("3"), value.NewString("3")},
+			{value.NewString("3"), value.NewInteger(0)},
+		},
+	},
+	{
+		Name:  "Load Unnest Aggregated Array",
+		Query: "SELECT ARRAY_LENGTH(ARRAY_AGG(column1)) FROM table1",
+		Result: [][]value.Primary{
+			{value.NewInteger(3)},
+		},
+	},
+	{
+		Name:  "Load Unnest Not Array Error",
+		Query: "SELECT * FROM UNNEST('a')",
+		Error: "[L:1 C:15] 'a': value is not an array",
+	},
+	{
+		Name:  "Load Unnest Right Outer Join Error",
+		Query: "SELECT * FROM table1 RIGHT JOIN UNNEST([1]) AS n ON TRUE",
+		Error: "[L:1 C:33] UNNEST cannot be joined with RIGHT OUTER JOIN",
+	},
+}
+
+func TestView_LoadUnnest(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	for _, v := range viewLoadUnnestTests {
+		ViewCache.Clean()
+
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		view, err := Select(program[0].(parser.SelectQuery), NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		result := make([][]value.Primary, view.RecordLen())
+		for i, record := range view.RecordSet {
+			result[i] = make([]value.Primary, len(record))
+			for j, cell := range record {
+				result[i][j] = cell.Value()
+			}
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
+var viewLoadGenerateSeriesTests = []struct {
+	Name   string
+	Query  string
+	Result [][]value.Primary
+	Error  string
+}{
+	{
+		Name:  "Load GenerateSeries",
+		Query: "SELECT n FROM GENERATE_SERIES(1, 3) AS n",
+		Result: [][]value.Primary{
+			{value.NewInteger(1)},
+			{value.NewInteger(2)},
+			{value.NewInteger(3)},
+		},
+	},
+	{
+		Name:  "Load GenerateSeries Descending",
+		Query: "SELECT n FROM GENERATE_SERIES(5, 1, -2) AS n",
+		Result: [][]value.Primary{
+			{value.NewInteger(5)},
+			{value.NewInteger(3)},
+			{value.NewInteger(1)},
+		},
+	},
+	{
+		Name:  "Load GenerateSeries Float",
+		Query: "SELECT n FROM GENERATE_SERIES(1, 2, 0.5) AS n",
+		Result: [][]value.Primary{
+			{value.NewFloat(1)},
+			{value.NewFloat(1.5)},
+			{value.NewFloat(2)},
+		},
+	},
+	{
+		Name:  "Load GenerateSeries Datetime",
+		Query: "SELECT d FROM GENERATE_SERIES('2024-01-30', '2024-02-01') AS d",
+		Result: [][]value.Primary{
+			{value.NewDatetime(time.Date(2024, 1, 30, 0, 0, 0, 0, GetTestLocation()))},
+			{value.NewDatetime(time.Date(2024, 1, 31, 0, 0, 0, 0, GetTestLocation()))},
+			{value.NewDatetime(time.Date(2024, 2, 1, 0, 0, 0, 0, GetTestLocation()))},
+		},
+	},
+	{
+		Name:  "Load GenerateSeries Datetime Interval",
+		Query: "SELECT d FROM GENERATE_SERIES('2024-01-01 00:00:00', '2024-01-01 05:00:00', '2 hours') AS d",
+		Result: [][]value.Primary{
+			{value.NewDatetime(time.Date(2024, 1, 1, 0, 0, 0, 0, GetTestLocation()))},
+			{value.NewDatetime(time.Date(2024, 1, 1, 2, 0, 0, 0, GetTestLocation()))},
+			{value.NewDatetime(time.Date(2024, 1, 1, 4, 0, 0, 0, GetTestLocation()))},
+		},
+	},
+	{
+		Name:  "Load GenerateSeries Datetime End of Month",
+		Query: "SELECT d FROM GENERATE_SERIES('2024-01-31', '2024-04-30', '1 month') AS d",
+		Result: [][]value.Primary{
+			{value.NewDatetime(time.Date(2024, 1, 31, 0, 0, 0, 0, GetTestLocation()))},
+			{value.NewDatetime(time.Date(2024, 2, 29, 0, 0, 0, 0, GetTestLocation()))},
+			{value.NewDatetime(time.Date(2024, 3, 31, 0, 0, 0, 0, GetTestLocation()))},
+			{value.NewDatetime(time.Date(2024, 4, 30, 0, 0, 0, 0, GetTestLocation()))},
+		},
+	},
+	{
+		Name:   "Load GenerateSeries Null",
+		Query:  "SELECT n FROM GENERATE_SERIES(1, NULL) AS n",
+		Result: [][]value.Primary{},
+	},
+	{
+		Name:  "Load GenerateSeries Cross Join",
+		Query: "SELECT column1, n FROM table1 CROSS JOIN GENERATE_SERIES(2, column1) AS n",
+		Result: [][]value.Primary{
+			{value.NewString("2"), value.NewInteger(2)},
+			{value.NewString("3"), value.NewInteger(2)},
+			{value.NewString("3"), value.NewInteger(3)},
+		},
+	},
+	{
+		Name:  "Load GenerateSeries Zero Step Error",
+		Query: "SELECT * FROM GENERATE_SERIES(1, 3, 0)",
+		Error: "[L:1 C:15] GENERATE_SERIES: 0 is not a valid step",
+	},
+	{
+		Name:  "Load GenerateSeries Invalid Interval Error",
+		Query: "SELECT * FROM GENERATE_SERIES('2024-01-01', '2024-01-02', '1 fortnight')",
+		Error: "[L:1 C:15] GENERATE_SERIES: '1 fortnight' is not a valid step",
+	},
+	{
+		Name:  "Load GenerateSeries Too Long Error",
+		Query: "SELECT * FROM GENERATE_SERIES(1, 1000000000000)",
+		Error: "[L:1 C:15] GENERATE_SERIES: series exceeds the limit of 10000000 values",
+	},
+	{
+		Name:  "Load GenerateSeries Datetime Too Long Error",
+		Query: "SELECT * FROM GENERATE_SERIES('2024-01-01', '2024-12-31', '1 second')",
+		Error: "[L:1 C:15] GENERATE_SERIES: series exceeds the limit of 10000000 values",
+	},
+	{
+		Name:  "Load GenerateSeries Invalid Argument Error",
+		Query: "SELECT * FROM GENERATE_SERIES('a', 3)",
+		Error: "[L:1 C:15] GENERATE_SERIES: 'a' is not a number or a datetime",
+	},
+}
+
+func TestView_LoadGenerateSeries(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	for _, v := range viewLoadGenerateSeriesTests {
+		ViewCache.Clean()
+
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		view, err := Select(program[0].(parser.SelectQuery), NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		result := make([][]value.Primary, view.RecordLen())
+		for i, record := range view.RecordSet {
+			result[i] = make([]value.Primary, len(record))
+			for j, cell := range record {
+				result[i][j] = cell.Value()
+			}
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
+var viewLoadNestedJsonTests = []struct {
+	Name   string
+	Query  string
+	Result [][]value.Primary
+	Error  string
+}{
+	{
+		Name:  "Load Nested Json Member Reference",
+		Query: "SELECT t.o.k, o.k, o['k'], o.notexist FROM JSON_TABLE('', '[{\"o\":{\"k\":1}},{\"o\":{\"k\":2}}]') AS t WHERE t.o.k > 1",
+		Result: [][]value.Primary{
+			{value.NewInteger(2), value.NewInteger(2), value.NewInteger(2), value.NewNull()},
+		},
+	},
+	{
+		Name:  "Load Nested Json Map Value",
+		Query: "SELECT o FROM JSON_TABLE('', '[{\"o\":{\"k\":[1]}}]') AS t",
+		Result: [][]value.Primary{
+			{value.NewMap([]string{"k"}, []value.Primary{value.NewArray([]value.Primary{value.NewInteger(1)})})},
+		},
+	},
+	{
+		Name:  "Load Nested Json Group By Map",
+		Query: "SELECT o.k, COUNT(*) FROM JSON_TABLE('', '[{\"o\":{\"k\":1}},{\"o\":{\"k\":1}},{\"o\":{\"k\":2}}]') AS t GROUP BY o",
+		Result: [][]value.Primary{
+			{value.NewInteger(1), value.NewInteger(2)},
+			{value.NewInteger(2), value.NewInteger(1)},
+		},
+	},
+	{
+		Name:  "Load Nested Json Not Group Key Error",
+		Query: "SELECT o.k FROM JSON_TABLE('', '[{\"o\":{\"k\":1}}]') AS t GROUP BY o.k",
+		Error: "[L:1 C:8] field o is not a group key",
+	},
+}
+
+func TestView_LoadNestedJson(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	for _, v := range viewLoadNestedJsonTests {
+		ViewCache.Clean()
+
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		view, err := Select(program[0].(parser.SelectQuery), NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		result := make([][]value.Primary, view.RecordLen())
+		for i, record := range view.RecordSet {
+			result[i] = make([]value.Primary, len(record))
+			for j, cell := range record {
+				result[i][j] = cell.Value()
+			}
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
+var viewLoadJsonTableColumnsTests = []struct {
+	Name   string
+	Query  string
+	Result [][]value.Primary
+	Error  string
+}{
+	{
+		Name:  "Load Json Table Columns",
+		Query: "SELECT * FROM JSON_TABLE('{\"items\":[{\"id\":1,\"o\":{\"k\":\"a\"}},{\"id\":2}]}', 'items' COLUMNS (id, k PATH 'o.k', o))",
+		Result: [][]value.Primary{
+			{value.NewInteger(1), value.NewString("a"), value.NewMap([]string{"k"}, []value.Primary{value.NewString("a")})},
+			{value.NewInteger(2), value.NewNull(), value.NewNull()},
+		},
+	},
+	{
+		Name:   "Load Json Table Columns Null",
+		Query:  "SELECT * FROM JSON_TABLE(NULL, 'items' COLUMNS (id))",
+		Result: [][]value.Primary{},
+	},
+	{
+		Name:  "Load Json Table Columns Cross Join",
+		Query: "SELECT column1, j.v FROM table1 CROSS JOIN JSON_TABLE('[' || column1 || ', 10]', '' COLUMNS (v PATH '')) AS j WHERE column1 < 3",
+		Result: [][]value.Primary{
+			{value.NewString("1"), value.NewInteger(1)},
+			{value.NewString("1"), value.NewInteger(10)},
+			{value.NewString("2"), value.NewInteger(2)},
+			{value.NewString("2"), value.NewInteger(10)},
+		},
+	},
+	{
+		Name:  "Load Json Table Columns Left Outer Join",
+		Query: "SELECT column1, id, v FROM table1 LEFT JOIN JSON_TABLE(IF(column1 = 2, '{\"id\":2,\"v\":\"x\"}', NULL), '' COLUMNS (id, v)) AS j ON TRUE",
+		Result: [][]value.Primary{
+			{value.NewString("1"), value.NewNull(), value.NewNull()},
+			{value.NewString("2"), value.NewInteger(2), value.NewString("x")},
+			{value.NewString("3"), value.NewNull(), value.NewNull()},
+		},
+	},
+	{
+		Name:  "Load Json Table Columns Comma Separated",
+		Query: "SELECT column1, v FROM table1, JSON_TABLE('[{\"v\":\"' || column2 || '\"}]', '' COLUMNS (v)) AS j WHERE column1 = 3",
+		Result: [][]value.Primary{
+			{value.NewString("3"), value.NewString("str3")},
+		},
+	},
+	{
+		Name:  "Load Json Table Columns Query Empty Error",
+		Query: "SELECT * FROM JSON_TABLE('[]', NULL COLUMNS (id))",
+		Error: "[L:1 C:15] json query is empty",
+	},
+	{
+		Name:  "Load Json Table Columns Json Error",
+		Query: "SELECT * FROM JSON_TABLE('[1', '' COLUMNS (id))",
+		Error: "[L:1 C:15] json query error: line 1, column 2: unexpected termination",
+	},
+	{
+		Name:  "Load Json Table Columns Right Outer Join Error",
+		Query: "SELECT * FROM table1 RIGHT JOIN JSON_TABLE('[]', '' COLUMNS (id)) AS j ON TRUE",
+		Error: "[L:1 C:33] JSON_TABLE cannot be joined with RIGHT OUTER JOIN",
+	},
+}
+
+func TestView_LoadJsonTableColumns(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	for _, v := range viewLoadJsonTableColumnsTests {
+		ViewCache.Clean()
+
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		view, err := Select(program[0].(parser.SelectQuery), NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		result := make([][]value.Primary, view.RecordLen())
+		for i, record := range view.RecordSet {
+			result[i] = make([]value.Primary, len(record))
+			for j, cell := range record {
+				result[i][j] = cell.Value()
+			}
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
+var viewLoadTableFunctionTests = []struct {
+	Name   string
+	Query  string
+	Result [][]value.Primary
+	Error  string
+}{
+	{
+		Name:  "Load Table Function",
+		Query: "SELECT * FROM TABLE(tablefunc(2))",
+		Result: [][]value.Primary{
+			{value.NewString("1"), value.NewString("str1")},
+			{value.NewString("2"), value.NewString("str2")},
+		},
+	},
+	{
+		Name:  "Load Table Function Returned In Control Flow",
+		Query: "SELECT column1 FROM TABLE(tablefunc(NULL))",
+		Result: [][]value.Primary{
+			{value.NewString("1")},
+			{value.NewString("2")},
+			{value.NewString("3")},
+		},
+	},
+	{
+		Name:  "Load Table Function With Alias",
+		Query: "SELECT t.column2 FROM TABLE(tablefunc(1)) AS t",
+		Result: [][]value.Primary{
+			{value.NewString("str1")},
+		},
+	},
+	{
+		Name:  "Load Table Function Not Table Function Error",
+		Query: "SELECT * FROM TABLE(scalarfunc())",
+		Error: "[L:1 C:21] function scalarfunc does not return a table",
+	},
+	{
+		Name:  "Load Table Function Not Exist Error",
+		Query: "SELECT * FROM TABLE(notexist())",
+		Error: "[L:1 C:21] function notexist does not exist",
+	},
+	{
+		Name:  "Load Table Function Argument Length Error",
+		Query: "SELECT * FROM TABLE(tablefunc())",
+		Error: "[L:1 C:9] function tablefunc takes exactly 1 argument",
+	},
+}
+
+func TestView_LoadTableFunction(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	declarations, err := parser.Parse(""+
+		"DECLARE tablefunc FUNCTION (@n) AS BEGIN "+
+		"  IF @n IS NULL THEN RETURN TABLE SELECT * FROM table1; END IF; "+
+		"  RETURN TABLE SELECT * FROM table1 WHERE column1 <= @n; "+
+		"END; "+
+		"DECLARE scalarfunc FUNCTION () AS BEGIN RETURN 1; END;", "")
+	if err != nil {
+		t.Fatalf("unexpected parse error %q", err)
+	}
+
+	for _, v := range viewLoadTableFunctionTests {
+		ViewCache.Clean()
+
+		proc := NewProcedure()
+		if _, err = proc.Execute(declarations); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		view, err := Select(program[0].(parser.SelectQuery), proc.Filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		result := make([][]value.Primary, view.RecordLen())
+		for i, record := range view.RecordSet {
+			result[i] = make([]value.Primary, len(record))
+			for j, cell := range record {
+				result[i][j] = cell.Value()
+			}
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
+func setupGitRepository(t *testing.T) string {
+	return filetest.GitRepository(t, file.GitCommand, filepath.Join(TestDir, "git_repository"), "id,name\n1,a\n", "id,name\n1,a\n2,b\n")
+}
+
+var viewLoadRevisionTests = []struct {
+	Name   string
+	Query  string
+	Result [][]value.Primary
+	Error  string
+}{
+	{
+		Name:  "Load Revision",
+		Query: "SELECT * FROM data AT 'HEAD~1'",
+		Result: [][]value.Primary{
+			{value.NewString("1"), value.NewString("a")},
+		},
+	},
+	{
+		Name:  "Load Revision Join",
+		Query: "SELECT d.id, o.name FROM data AS d LEFT JOIN data AT 'HEAD~1' AS o ON d.id = o.id",
+		Result: [][]value.Primary{
+			{value.NewString("1"), value.NewString("a")},
+			{value.NewString("2"), value.NewNull()},
+		},
+	},
+	{
+		Name:  "Load Revision Empty Error",
+		Query: "SELECT * FROM data AT NULL",
+		Error: "[L:1 C:15] revision of table data is empty",
+	},
+	{
+		Name:  "Load Revision Invalid Revision Error",
+		Query: "SELECT * FROM data AT '--output=x'",
+		Error: "[L:1 C:15] failed to read data at revision --output=x: invalid revision: --output=x",
+	},
+	{
+		Name:  "Load Revision File Not Exist Error",
+		Query: "SELECT * FROM notexist AT 'HEAD'",
+		Error: "[L:1 C:15] file notexist does not exist",
+	},
+}
+
+func TestView_LoadRevision(t *testing.T) {
+	defer initFlag(cmd.GetFlags())
+
+	tf := cmd.GetFlags()
+	tf.Repository = setupGitRepository(t)
+
+	for _, v := range viewLoadRevisionTests {
+		ViewCache.Clean()
+
+		program, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parse error %q", v.Name, err)
+		}
+
+		view, err := Select(program[0].(parser.SelectQuery), NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		result := make([][]value.Primary, view.RecordLen())
+		for i, record := range view.RecordSet {
+			result[i] = make([]value.Primary, len(record))
+			for j, cell := range record {
+				result[i][j] = cell.Value()
+			}
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+	ViewCache.Clean()
+}
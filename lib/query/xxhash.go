@@ -0,0 +1,111 @@
+package query
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+)
+
+const (
+	xxh64Prime1 uint64 = 11400714785074694791
+	xxh64Prime2 uint64 = 14029467366897019727
+	xxh64Prime3 uint64 = 1609587929392839161
+	xxh64Prime4 uint64 = 9650029242287828579
+	xxh64Prime5 uint64 = 2870177450012600261
+)
+
+// xxHash64 is a hash.Hash64 of the 64-bit xxHash algorithm with seed 0.
+// Written data is buffered and the digest is calculated at once by Sum64.
+type xxHash64 struct {
+	buf []byte
+}
+
+func newXxHash64() hash.Hash {
+	return &xxHash64{}
+}
+
+func (h *xxHash64) Write(p []byte) (int, error) {
+	h.buf = append(h.buf, p...)
+	return len(p), nil
+}
+
+func (h *xxHash64) Sum(b []byte) []byte {
+	var s [8]byte
+	binary.BigEndian.PutUint64(s[:], h.Sum64())
+	return append(b, s[:]...)
+}
+
+func (h *xxHash64) Reset() {
+	h.buf = h.buf[:0]
+}
+
+func (h *xxHash64) Size() int {
+	return 8
+}
+
+func (h *xxHash64) BlockSize() int {
+	return 32
+}
+
+func (h *xxHash64) Sum64() uint64 {
+	return xxh64(h.buf, 0)
+}
+
+func xxh64(b []byte, seed uint64) uint64 {
+	n := len(b)
+	var h uint64
+
+	if 32 <= n {
+		v1 := seed + xxh64Prime1 + xxh64Prime2
+		v2 := seed + xxh64Prime2
+		v3 := seed
+		v4 := seed - xxh64Prime1
+		for ; 32 <= len(b); b = b[32:] {
+			v1 = xxh64Round(v1, binary.LittleEndian.Uint64(b[0:8]))
+			v2 = xxh64Round(v2, binary.LittleEndian.Uint64(b[8:16]))
+			v3 = xxh64Round(v3, binary.LittleEndian.Uint64(b[16:24]))
+			v4 = xxh64Round(v4, binary.LittleEndian.Uint64(b[24:32]))
+		}
+		h = bits.RotateLeft64(v1, 1) + bits.RotateLeft64(v2, 7) + bits.RotateLeft64(v3, 12) + bits.RotateLeft64(v4, 18)
+		h = xxh64MergeRound(h, v1)
+		h = xxh64MergeRound(h, v2)
+		h = xxh64MergeRound(h, v3)
+		h = xxh64MergeRound(h, v4)
+	} else {
+		h = seed + xxh64Prime5
+	}
+
+	h += uint64(n)
+
+	for ; 8 <= len(b); b = b[8:] {
+		h ^= xxh64Round(0, binary.LittleEndian.Uint64(b[:8]))
+		h = bits.RotateLeft64(h, 27)*xxh64Prime1 + xxh64Prime4
+	}
+	if 4 <= len(b) {
+		h ^= uint64(binary.LittleEndian.Uint32(b[:4])) * xxh64Prime1
+		h = bits.RotateLeft64(h, 23)*xxh64Prime2 + xxh64Prime3
+		b = b[4:]
+	}
+	for _, c := range b {
+		h ^= uint64(c) * xxh64Prime5
+		h = bits.RotateLeft64(h, 11) * xxh64Prime1
+	}
+
+	h ^= h >> 33
+	h *= xxh64Prime2
+	h ^= h >> 29
+	h *= xxh64Prime3
+	h ^= h >> 32
+	return h
+}
+
+func xxh64Round(acc uint64, input uint64) uint64 {
+	acc += input * xxh64Prime2
+	acc = bits.RotateLeft64(acc, 31)
+	return acc * xxh64Prime1
+}
+
+func xxh64MergeRound(acc uint64, val uint64) uint64 {
+	acc ^= xxh64Round(0, val)
+	return acc*xxh64Prime1 + xxh64Prime4
+}
@@ -0,0 +1,26 @@
+package query
+
+import (
+	"testing"
+)
+
+var xxh64Tests = []struct {
+	Input  string
+	Expect uint64
+}{
+	{Input: "", Expect: 0xef46db3751d8e999},
+	{Input: "abc", Expect: 0x44bc2cf5ad770999},
+	{Input: "Hello, World!", Expect: 0xc49aacf8080fe47f},
+	{Input: "The quick brown fox jumps over the lazy dog", Expect: 0x0b242d361fda71bc},
+	{Input: "日本語のテキスト", Expect: 0xfcef3a62b7d1663b},
+}
+
+func TestXxh64(t *testing.T) {
+	for _, v := range xxh64Tests {
+		h := newXxHash64().(*xxHash64)
+		_, _ = h.Write([]byte(v.Input))
+		if result := h.Sum64(); result != v.Expect {
+			t.Errorf("result = %016x, want %016x for %q", result, v.Expect, v.Input)
+		}
+	}
+}
@@ -64,7 +64,26 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "select_clause",
 						Group: []Grammar{
-							{Keyword("SELECT"), Option{Keyword("DISTINCT")}, ContinuousOption{Link("field")}},
+							{Keyword("SELECT"), Option{Link("hints")}, Option{Keyword("DISTINCT")}, ContinuousOption{Link("field")}},
+						},
+					},
+					{
+						Name: "hints",
+						Group: []Grammar{
+							{Token("/*+"), Link("hint"), Option{Link("hint"), Token("...")}, Token("*/")},
+						},
+					},
+					{
+						Name: "hint",
+						Group: []Grammar{
+							{Keyword("HASH_JOIN"), Option{Parentheses{ContinuousOption{Identifier("table_name")}}}},
+							{Keyword("NESTED_LOOP_JOIN"), Option{Parentheses{ContinuousOption{Identifier("table_name")}}}},
+							{Keyword("PARALLEL"), Option{Parentheses{Integer("number")}}},
+							{Keyword("NO_PARALLEL")},
+						},
+						Description: Description{
+							Template: "Change how the query and its subqueries are executed without changing the result. %s and %s choose the method to join the tables. %s and %s set the number of goroutines used to process records. Unknown hints are ignored.",
+							Values:   []Element{Keyword("HASH_JOIN"), Keyword("NESTED_LOOP_JOIN"), Keyword("PARALLEL"), Keyword("NO_PARALLEL")},
 						},
 					},
 					{
@@ -100,8 +119,13 @@ var CsvqSyntax = []Expression{
 						Name: "table_entity",
 						Group: []Grammar{
 							{Identifier("table_name")},
+							{Identifier("table_name"), Keyword("AT"), String("revision")},
+							{Keyword("TAIL"), Identifier("table_name")},
+							{Link("table_function_call")},
 							{Link("table_object")},
 							{Link("json_inline_table")},
+							{Link("unnest")},
+							{Link("generate_series")},
 							{Parentheses{Link("select_query")}},
 							{Keyword("STDIN")},
 						},
@@ -131,6 +155,41 @@ var CsvqSyntax = []Expression{
 							{Function{Name: "FIXED", Args: []Element{String("delimiter_positions"), Identifier("table_name"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null")}}}},
 							{Function{Name: "JSON", Args: []Element{String("json_query"), Identifier("table_name")}}},
 							{Function{Name: "LTSV", Args: []Element{Identifier("table_name"), Option{String("encoding"), Boolean("without_null")}}}},
+							{Function{Name: "CSV", Args: []Element{PlainGroup{String("file_path"), Link("table_object_options")}}}},
+							{Function{Name: "FIXED", Args: []Element{PlainGroup{String("file_path"), Link("table_object_options")}}}},
+							{Function{Name: "JSON", Args: []Element{PlainGroup{String("file_path"), Link("table_object_options")}}}},
+							{Function{Name: "LTSV", Args: []Element{PlainGroup{String("file_path"), Link("table_object_options")}}}},
+							{Function{Name: "INCREMENTAL", Args: []Element{Identifier("table_name"), String("state_file")}}},
+						},
+						Description: Description{
+							Template: "%s can follow the positional arguments. " +
+								"INCREMENTAL reads only the records appended since the last run recorded in %s. " +
+								"Aggregated results of COUNT, SUM, MIN and MAX are merged into the result saved in %s.",
+							Values: []Element{Link("table_object_options"), String("state_file"), String("state_file")},
+						},
+					},
+					{
+						Name: "table_object_options",
+						Group: []Grammar{
+							{Keyword("WITH"), Parentheses{ContinuousOption{Link("table_object_option")}}},
+						},
+					},
+					{
+						Name: "table_object_option",
+						Group: []Grammar{
+							{Keyword("DELIMITER"), String("delimiter")},
+							{Keyword("DELIMITER_POSITIONS"), String("delimiter_positions")},
+							{Keyword("JSON_QUERY"), String("json_query")},
+							{Keyword("ENCODING"), String("encoding")},
+							{Keyword("NO_HEADER"), Option{Boolean("no_header")}},
+							{Keyword("WITHOUT_NULL"), Option{Boolean("without_null")}},
+							{Keyword("DECIMAL_SEPARATOR"), String("decimal_separator")},
+							{Keyword("THOUSANDS_SEPARATOR"), String("thousands_separator")},
+						},
+						Description: Description{
+							Template: "DELIMITER, DELIMITER_POSITIONS and JSON_QUERY can be used with CSV, FIXED and JSON respectively. " +
+								"If the value of NO_HEADER or WITHOUT_NULL is omitted, then it is true. " +
+								"If DECIMAL_SEPARATOR or THOUSANDS_SEPARATOR is specified, then the values written as numbers with the separators are converted into numbers when they are calculated or compared.",
 						},
 					},
 					{
@@ -138,6 +197,41 @@ var CsvqSyntax = []Expression{
 						Group: []Grammar{
 							{Function{Name: "JSON_TABLE", Args: []Element{String("json_query"), Identifier("table_name")}}},
 							{Function{Name: "JSON_TABLE", Args: []Element{String("json_query"), String("json_data")}}},
+							{Function{Name: "JSON_TABLE", Args: []Element{String("json_data"), String("json_query"), Keyword("COLUMNS"), Parentheses{ContinuousOption{Link("json_table_column")}}}}},
+						},
+						Description: Description{
+							Template: "With COLUMNS, each element selected by %s becomes a row, and the value of each column is extracted by its path. " +
+								"When joined with CROSS JOIN, INNER JOIN, LEFT OUTER JOIN or a comma, %s is evaluated for each record of the left table.",
+							Values: []Element{String("json_query"), String("json_data")},
+						},
+					},
+					{
+						Name: "json_table_column",
+						Group: []Grammar{
+							{Identifier("column_name"), Option{Keyword("PATH"), String("json_query")}},
+						},
+					},
+					{
+						Name: "unnest",
+						Group: []Grammar{
+							{Function{Name: "UNNEST", Args: []Element{Link("array")}}},
+						},
+						Description: Description{
+							Template: "Expands %s into rows of a single column named after the alias. " +
+								"When joined with CROSS JOIN, INNER JOIN, LEFT OUTER JOIN or a comma, %s is evaluated for each record of the left table.",
+							Values: []Element{Link("array"), Link("array")},
+						},
+					},
+					{
+						Name: "generate_series",
+						Group: []Grammar{
+							{Function{Name: "GENERATE_SERIES", Args: []Element{Link("start"), Link("stop"), Option{Link("step")}}}},
+						},
+						Description: Description{
+							Template: "Generates a sequence of values from %s to %s incremented by %s into rows of a single column named after the alias. " +
+								"If %s and %s are numbers, then the default %s is 1. " +
+								"If %s and %s are datetimes, then %s is an interval such as '1 day', '2 hours' or '3 months', and its default is '1 day'.",
+							Values: []Element{Link("start"), Link("stop"), Link("step"), Link("start"), Link("stop"), Link("step"), Link("start"), Link("stop"), Link("step")},
 						},
 					},
 				},
@@ -313,8 +407,49 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "create_table_statement",
 				Group: []Grammar{
-					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Parentheses{ContinuousOption{Identifier("column_name")}}},
-					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Option{Keyword("AS")}, Link("select_query")},
+					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Option{Parentheses{ContinuousOption{Link("create_table_attribute")}}}, Parentheses{ContinuousOption{Link("table_element")}}},
+					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Option{Parentheses{ContinuousOption{Link("create_table_attribute")}}}, Option{Parentheses{ContinuousOption{Link("table_element")}}}, Option{Keyword("AS")}, Link("select_query")},
+				},
+			},
+			{
+				Name: "create_table_attribute",
+				Group: []Grammar{
+					{Link("table_attribute"), Link("value")},
+				},
+				Description: Description{
+					Template: "Attributes of the created file. Attributes not specified are set by the flags and the file extension in the same way as when the attributes are omitted.",
+				},
+			},
+			{
+				Name: "table_element",
+				Group: []Grammar{
+					{Identifier("column_name"), Option{Link("column_constraint")}},
+					{Link("table_constraint")},
+				},
+			},
+			{
+				Name: "column_constraint",
+				Group: []Grammar{
+					{Option{Keyword("CONSTRAINT"), Identifier("constraint_name")}, Keyword("CHECK"), Parentheses{Link("condition")}},
+					{Option{Keyword("CONSTRAINT"), Identifier("constraint_name")}, Keyword("NOT"), Keyword("NULL")},
+					{Option{Keyword("CONSTRAINT"), Identifier("constraint_name")}, Keyword("UNIQUE")},
+					{Keyword("AUTO_INCREMENT")},
+				},
+			},
+			{
+				Name: "table_constraint",
+				Group: []Grammar{
+					{Option{Keyword("CONSTRAINT"), Identifier("constraint_name")}, Keyword("CHECK"), Parentheses{Link("condition")}},
+					{Option{Keyword("CONSTRAINT"), Identifier("constraint_name")}, Keyword("UNIQUE"), Parentheses{ContinuousOption{Identifier("column_name")}}},
+				},
+				Description: Description{
+					Template: "" +
+						"A record violates a CHECK constraint if %s is FALSE, and violates a NOT NULL constraint if the column value is NULL. " +
+						"Records violate a UNIQUE constraint if they have equal values in the columns, but records having NULL in any of the columns do not. " +
+						"When a constraint is violated, the current transaction is rolled back. " +
+						"AUTO_INCREMENT sets the next integer after the largest integer in the column to the column omitted in INSERT queries. " +
+						"Constraints of tables created by CREATE TABLE are effective until the current transaction is committed or rolled back.",
+					Values: []Element{Link("condition")},
 				},
 			},
 		},
@@ -332,11 +467,17 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "column_definition",
 				Group: []Grammar{
-					{Identifier("column_name"), Option{Keyword("DEFAULT"), Link("value")}},
+					{Identifier("column_name"), Option{Link("column_type")}, Option{Keyword("DEFAULT"), Link("value")}},
 				},
 				Description: Description{
-					Template: "%s is the default value.",
-					Values:   []Element{Null("NULL")},
+					Template: "%s is the default value. If %s is specified, the values are converted to the type, and an error is returned if any value cannot be converted.",
+					Values:   []Element{Null("NULL"), Link("column_type")},
+				},
+			},
+			{
+				Name: "column_type",
+				Group: []Grammar{
+					{AnyOne{Keyword("STRING"), Keyword("INTEGER"), Keyword("FLOAT"), Keyword("BOOLEAN"), Keyword("DATETIME")}},
 				},
 			},
 			{
@@ -362,6 +503,15 @@ var CsvqSyntax = []Expression{
 					{Keyword("ALTER"), Keyword("TABLE"), Identifier("table_name"), Keyword("RENAME"), Identifier("old_column_name"), Keyword("TO"), Identifier("new_column_name")},
 				},
 			},
+			{
+				Name: "alter_table_set_column_type_statement",
+				Group: []Grammar{
+					{Keyword("ALTER"), Keyword("TABLE"), Identifier("table_name"), Keyword("ALTER"), Identifier("column_name"), Keyword("SET"), Keyword("TYPE"), Link("column_type")},
+				},
+				Description: Description{
+					Template: "Values in the column are converted to the type. If any value cannot be converted, an error listing the rows is returned and the table remains unchanged.",
+				},
+			},
 			{
 				Name: "alter_table_set_attribute_statement",
 				Group: []Grammar{
@@ -376,6 +526,29 @@ var CsvqSyntax = []Expression{
 			},
 		},
 	},
+	{
+		Label: "COPY Statement",
+		Grammar: []Definition{
+			{
+				Name: "copy_statement",
+				Group: []Grammar{
+					{Keyword("COPY"), Parentheses{Link("select_query")}, Keyword("TO"), Identifier("file_path"), Option{Keyword("WITH"), Parentheses{ContinuousOption{Link("copy_attribute")}}}},
+				},
+				Description: Description{
+					Template: "Writes the result-set of the select query to the new file immediately, regardless of the transaction.",
+				},
+			},
+			{
+				Name: "copy_attribute",
+				Group: []Grammar{
+					{Link("table_attribute"), Link("value")},
+				},
+				Description: Description{
+					Template: "Attributes of the exported file. Attributes not specified are determined by the file extension, and the flags are not referred to.",
+				},
+			},
+		},
+	},
 	{
 		Label: "Variables",
 		Grammar: []Definition{
@@ -418,6 +591,7 @@ var CsvqSyntax = []Expression{
 				Name: "declare_cursor_statement",
 				Group: []Grammar{
 					{Keyword("DECLARE"), Identifier("cursor_name"), Keyword("CURSOR"), Keyword("FOR"), Link("select_query")},
+					{Keyword("DECLARE"), Identifier("cursor_name"), Keyword("CURSOR"), Keyword("FOR"), Link("table_function_call")},
 				},
 			},
 			{
@@ -436,6 +610,11 @@ var CsvqSyntax = []Expression{
 				Name: "fetch_cursor_statement",
 				Group: []Grammar{
 					{Keyword("FETCH"), Option{Link("fetch_position")}, Identifier("cursor_name"), Keyword("INTO"), ContinuousOption{Variable("@variable")}},
+					{Keyword("FETCH"), Option{Link("fetch_position")}, Identifier("cursor_name"), Keyword("BULK"), Integer("size"), Keyword("INTO"), Variable("@variable")},
+				},
+				Description: Description{
+					Template: "With %s, at most %s records are fetched at once, and set to the %s as an array of records. Each record is an array of field values.",
+					Values:   []Element{Keyword("BULK"), Integer("size"), Variable("@variable")},
 				},
 			},
 			{
@@ -462,8 +641,8 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "declare_view_statement",
 				Group: []Grammar{
-					{Keyword("DECLARE"), Identifier("view_name"), Keyword("VIEW"), Parentheses{ContinuousOption{Identifier("column_name")}}},
-					{Keyword("DECLARE"), Identifier("view_name"), Keyword("VIEW"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("AS"), Link("select_query")},
+					{Keyword("DECLARE"), Identifier("view_name"), Keyword("VIEW"), Parentheses{ContinuousOption{Link("table_element")}}},
+					{Keyword("DECLARE"), Identifier("view_name"), Keyword("VIEW"), Option{Parentheses{ContinuousOption{Link("table_element")}}}, Keyword("AS"), Link("select_query")},
 				},
 			},
 			{
@@ -496,6 +675,11 @@ var CsvqSyntax = []Expression{
 				Name: "function_parameters",
 				Group: []Grammar{
 					{Option{ContinuousOption{Variable("@parameter")}}, Option{ContinuousOption{Link("optional_parameter")}}},
+					{Option{ContinuousOption{Variable("@parameter")}}, Keyword("VARIADIC"), Variable("@parameter")},
+				},
+				Description: Description{
+					Template: "A parameter declared with %s receives the rest of the arguments as an array.",
+					Values:   []Element{Keyword("VARIADIC")},
 				},
 			},
 			{
@@ -514,10 +698,13 @@ var CsvqSyntax = []Expression{
 				Name: "return_statement",
 				Group: []Grammar{
 					{Keyword("RETURN"), Option{Link("value")}},
+					{Keyword("RETURN"), Keyword("TABLE"), Link("select_query")},
+					{Keyword("RETURN"), Keyword("CURSOR"), Identifier("cursor_name")},
 				},
 				Description: Description{
-					Template: "%s is the default value.",
-					Values:   []Element{Null("NULL")},
+					Template: "%s is the default value. " +
+						"A function that returns the result set of a %s or a cursor by using %s or %s can be used as a table.",
+					Values: []Element{Null("NULL"), Link("select_query"), Keyword("RETURN TABLE"), Keyword("RETURN CURSOR")},
 				},
 			},
 			{
@@ -526,6 +713,15 @@ var CsvqSyntax = []Expression{
 					{Identifier("function_name"), Parentheses{ContinuousOption{Link("argument")}}},
 				},
 			},
+			{
+				Name: "table_function_call",
+				Group: []Grammar{
+					{Keyword("TABLE"), Parentheses{Identifier("function_name"), Parentheses{ContinuousOption{Link("argument")}}}},
+				},
+				Description: Description{
+					Template: "Can be used as a table in a from clause, or as the query of a cursor.",
+				},
+			},
 			{
 				Name: "aggregate_function_call",
 				Group: []Grammar{
@@ -540,6 +736,54 @@ var CsvqSyntax = []Expression{
 			},
 		},
 	},
+	{
+		Label: "Table Triggers",
+		Grammar: []Definition{
+			{
+				Name: "declare_trigger_statement",
+				Group: []Grammar{
+					{Keyword("DECLARE"), Identifier("trigger_name"), Keyword("TRIGGER"), AnyOne{Keyword("BEFORE"), Keyword("AFTER")}, AnyOne{Keyword("INSERT"), Keyword("UPDATE"), Keyword("DELETE")}, Keyword("ON"), Identifier("table_name"), Keyword("AS"), Keyword("BEGIN"), Token("statements"), Keyword("END")},
+				},
+				Description: Description{
+					Template: "" +
+						"The statements are executed for each record inserted, updated or deleted in the table. " +
+						"The values of the record before and after the modification are referred as the maps %s and %s. " +
+						"Values substituted for the members of %s in a %s trigger are set to the record.",
+					Values: []Element{Variable("@OLD"), Variable("@NEW"), Variable("@NEW"), Keyword("BEFORE")},
+				},
+			},
+			{
+				Name: "dispose_trigger_statement",
+				Group: []Grammar{
+					{Keyword("DISPOSE"), Keyword("TRIGGER"), Identifier("trigger_name")},
+				},
+			},
+		},
+	},
+	{
+		Label: "Table Indexes",
+		Grammar: []Definition{
+			{
+				Name: "create_index_statement",
+				Group: []Grammar{
+					{Keyword("CREATE"), Keyword("INDEX"), Identifier("index_name"), Keyword("ON"), Identifier("table_name"), Parentheses{ContinuousOption{Identifier("column_name")}}, Option{Keyword("USING"), AnyOne{Keyword("HASH"), Keyword("BTREE")}}},
+				},
+				Description: Description{
+					Template: "" +
+						"The index is used to look up records by equality conditions and join conditions on the columns. " +
+						"A %s index is also used for ranges of numbers on the first column. " +
+						"The index is rebuilt when the table is modified.",
+					Values: []Element{Keyword("BTREE")},
+				},
+			},
+			{
+				Name: "drop_index_statement",
+				Group: []Grammar{
+					{Keyword("DROP"), Keyword("INDEX"), Identifier("index_name")},
+				},
+			},
+		},
+	},
 	{
 		Label: "Control Flow",
 		Grammar: []Definition{
@@ -559,25 +803,37 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "while_statement",
 				Group: []Grammar{
-					{Keyword("WHILE"), Link("condition"), Keyword("DO"), Token("statements"), Keyword("END"), Keyword("WHILE")},
+					{Option{Identifier("label:")}, Keyword("WHILE"), Link("condition"), Keyword("DO"), Token("statements"), Keyword("END"), Keyword("WHILE")},
+				},
+				Description: Description{
+					Template: "A loop labeled with %s can be referred by %s and %s in nested loops.",
+					Values:   []Element{Identifier("label"), Link("continue_statement"), Link("break_statement")},
 				},
 			},
 			{
 				Name: "while_in_cursor_statement",
 				Group: []Grammar{
-					{Keyword("WHILE"), Option{AnyOne{Keyword("DECLARE"), Keyword("VAR")}}, ContinuousOption{Variable("@variable")}, Keyword("IN"), Identifier("cursor_name"), Keyword("DO"), Token("statements"), Keyword("END"), Keyword("WHILE")},
+					{Option{Identifier("label:")}, Keyword("WHILE"), Option{AnyOne{Keyword("DECLARE"), Keyword("VAR")}}, ContinuousOption{Variable("@variable")}, Keyword("IN"), Identifier("cursor_name"), Keyword("DO"), Token("statements"), Keyword("END"), Keyword("WHILE")},
 				},
 			},
 			{
 				Name: "continue_statement",
 				Group: []Grammar{
-					{Keyword("CONTINUE")},
+					{Keyword("CONTINUE"), Option{Identifier("label")}},
+				},
+				Description: Description{
+					Template: "If %s is specified, jumps to the next iteration of the loop labeled with %s.",
+					Values:   []Element{Identifier("label"), Identifier("label")},
 				},
 			},
 			{
 				Name: "break_statement",
 				Group: []Grammar{
-					{Keyword("BREAK")},
+					{Keyword("BREAK"), Option{Identifier("label")}},
+				},
+				Description: Description{
+					Template: "If %s is specified, exits from the loop labeled with %s.",
+					Values:   []Element{Identifier("label"), Identifier("label")},
 				},
 			},
 			{
@@ -593,11 +849,64 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "trigger_error_statement",
 				Group: []Grammar{
-					{Keyword("TRIGGER"), Keyword("ERROR"), Option{Integer("exit_code")}, Option{String("error_message")}},
+					{Keyword("TRIGGER"), Keyword("ERROR"), Option{Integer("exit_code")}, Option{String("error_message")}, Option{Keyword("WITH"), Link("value")}},
 				},
 				Description: Description{
-					Template: "%s is the default %s.",
-					Values:   []Element{Token("1"), Integer("exit_code")},
+					Template: "%s is the default %s. " +
+						"The %s specified with %s keyword can be referred in a %s.",
+					Values: []Element{Token("1"), Integer("exit_code"), Link("value"), Keyword("WITH"), Link("try_statement")},
+				},
+			},
+			{
+				Name: "assert_statement",
+				Group: []Grammar{
+					{Keyword("ASSERT"), Link("condition"), Option{Keyword("MESSAGE"), String("message")}},
+				},
+				Description: Description{
+					Template: "If %s is not TRUE, the current transaction is rolled back, then the executing procedure is terminated with an error. " +
+						"The exit code of the error is %s.",
+					Values: []Element{Link("condition"), Token("2")},
+				},
+			},
+			{
+				Name: "expect_statement",
+				Group: []Grammar{
+					{Keyword("EXPECT"), Link("select_query"), Keyword("TO"), Keyword("EQUAL"), Token("expected_select_query")},
+				},
+				Description: Description{
+					Template: "If the result of %s does not equal the result of %s, the current transaction is rolled back, then the executing procedure is terminated with an error. " +
+						"The exit code of the error is %s.",
+					Values: []Element{Link("select_query"), Token("expected_select_query"), Token("2")},
+				},
+			},
+			{
+				Name: "try_statement",
+				Group: []Grammar{
+					{Keyword("TRY"), Token("statements"), Keyword("CATCH"), Token("catch_statements"), Keyword("END"), Keyword("TRY")},
+				},
+				Description: Description{
+					Template: "If an error occurs in %s, %s are executed. " +
+						"Errors raised by %s are not caught.\n" +
+						"\n" +
+						"In %s, the following functions return the information of the caught error.\n" +
+						"\n" +
+						"  %s: error code\n" +
+						"  %s: error message without position\n" +
+						"  %s: data specified by %s\n" +
+						"  %s: source file in which the error occurred\n" +
+						"  %s: line number at which the error occurred\n" +
+						"  %s: column number at which the error occurred",
+					Values: []Element{
+						Token("statements"), Token("catch_statements"), Link("exit_statement"),
+						Token("catch_statements"),
+						Function{Name: "ERROR_CODE"},
+						Function{Name: "ERROR_MESSAGE"},
+						Function{Name: "ERROR_DATA"},
+						Link("trigger_error_statement"),
+						Function{Name: "ERROR_SOURCE"},
+						Function{Name: "ERROR_LINE"},
+						Function{Name: "ERROR_CHAR"},
+					},
 				},
 			},
 		},
@@ -647,6 +956,49 @@ var CsvqSyntax = []Expression{
 					{Keyword("SOURCE"), Identifier("file_path")},
 				},
 			},
+			{
+				Name: "import",
+				Group: []Grammar{
+					{Keyword("IMPORT"), Identifier("module_name")},
+				},
+			},
+			{
+				Name: "execute",
+				Group: []Grammar{
+					{Keyword("EXECUTE"), String("statements")},
+					{Keyword("EXECUTE"), String("statements"), Keyword("USING"), ContinuousOption{Link("replace_value")}},
+					{Keyword("EXECUTE"), Identifier("statement_name")},
+					{Keyword("EXECUTE"), Identifier("statement_name"), Keyword("USING"), ContinuousOption{Link("bind_value")}},
+					{Keyword("EXECUTE"), Keyword("IMMEDIATE"), String("statements")},
+					{Keyword("EXECUTE"), Keyword("IMMEDIATE"), String("statements"), Keyword("USING"), ContinuousOption{Link("bind_value")}},
+				},
+				Description: Description{
+					Template: "%s is a prepared statement declared by %s. " +
+						"Positional placeholders \"?\" are replaced with %s without names in order, " +
+						"and named placeholders such as \":name\" are replaced with %s specified by the same names. " +
+						"With %s, identifier placeholders \"??\" and \"::name\" are also replaced with the quoted identifiers.",
+					Values: []Element{Identifier("statement_name"), Keyword("PREPARE"), Link("bind_value"), Link("bind_value"), Keyword("IMMEDIATE")},
+				},
+			},
+			{
+				Name: "bind_value",
+				Group: []Grammar{
+					{Link("value")},
+					{Link("value"), Keyword("AS"), Identifier("name")},
+				},
+			},
+			{
+				Name: "prepare",
+				Group: []Grammar{
+					{Keyword("PREPARE"), Identifier("statement_name"), Keyword("FROM"), String("statements")},
+				},
+			},
+			{
+				Name: "dispose_prepare",
+				Group: []Grammar{
+					{Keyword("DISPOSE"), Keyword("PREPARE"), Identifier("statement_name")},
+				},
+			},
 			{
 				Name: "show",
 				Group: []Grammar{
@@ -659,6 +1011,25 @@ var CsvqSyntax = []Expression{
 					{Keyword("SHOW"), Keyword("FIELDS"), Keyword("FROM"), Identifier("table_name")},
 				},
 			},
+			{
+				Name: "explain",
+				Group: []Grammar{
+					{Keyword("EXPLAIN"), Option{Keyword("ANALYZE")}, Link("select_query")},
+				},
+				Description: Description{
+					Template: "Print the steps to execute the query. If %s is specified, the query is executed and the time, the number of records and the memory allocated by each step are printed.",
+					Values:   []Element{Keyword("ANALYZE")},
+				},
+			},
+			{
+				Name: "estimate",
+				Group: []Grammar{
+					{Keyword("ESTIMATE"), Link("select_query")},
+				},
+				Description: Description{
+					Template: "Print the approximate number of records and bytes of the result set of the query without executing it.",
+				},
+			},
 			{
 				Name: "chdir",
 				Group: []Grammar{
@@ -729,6 +1100,29 @@ var CsvqSyntax = []Expression{
 					},
 				},
 			},
+			{
+				Name: "array",
+				Group: []Grammar{
+					{ConnectedGroup{Token("["), Option{ContinuousOption{Link("value")}}, Token("]")}},
+				},
+				Description: Description{
+					Template: "Arrays are ordered lists of values. " +
+						"%s returns the element at the zero-based %s, or null if the element does not exist.",
+					Values: []Element{ConnectedGroup{Link("array"), Token("["), Integer("index"), Token("]")}, Integer("index")},
+				},
+			},
+			{
+				Name: "map",
+				Description: Description{
+					Template: "Maps are sets of values associated with keys, such as nested objects in JSON data. " +
+						"%s and %s return the member of the %s, or null if the member does not exist.",
+					Values: []Element{
+						ConnectedGroup{Link("map"), Token("."), Identifier("key")},
+						ConnectedGroup{Link("map"), Token("["), String("key"), Token("]")},
+						String("key"),
+					},
+				},
+			},
 			{
 				Name: "field_reference",
 				Group: []Grammar{
@@ -871,22 +1265,40 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Deirectory path where files are located.\n" +
 				"%s  <type::%s>\n" +
+				"  > Catalog file path that maps table names to files.\n" +
+				"%s  <type::%s>\n" +
+				"  > Directory path where converted data of JSON files are cached.\n" +
+				"%s  <type::%s>\n" +
 				"  > Default %s.\n" +
 				"%s  <type::%s>\n" +
 				"  > Datetime Format to parse strings.\n" +
 				"%s  <type::%s>\n" +
 				"  > Limit of the waiting time in seconds to wait for locked files to be released.\n" +
 				"%s  <type::%s>\n" +
+				"  > Command to resolve conflicts with files modified by other applications.\n" +
+				"%s  <type::%s>\n" +
+				"  > Directory path where files to resolve conflicts are saved.\n" +
+				"%s  <type::%s>\n" +
+				"  > Check for uncommitted git modifications in files to be updated. One of NONE, WARN or REFUSE.\n" +
+				"%s  <type::%s>\n" +
 				"  > Field delimiter for CSV, or delimiter positions for Fixed-Length Format.\n" +
 				"%s  <type::%s>\n" +
 				"  > Query for JSON data.\n" +
 				"%s  <type::%s>\n" +
 				"  > Character %s.\n" +
 				"%s  <type::%s>\n" +
+				"  > Handling of byte sequences that cannot be decoded or characters that cannot be encoded. One of STRICT, REPLACE, IGNORE or an empty string.\n" +
+				"%s  <type::%s>\n" +
 				"  > Import first line as a record.\n" +
 				"%s  <type::%s>\n" +
 				"  > Parse empty fields as empty strings.\n" +
 				"%s  <type::%s>\n" +
+				"  > Maximum size in bytes of a field in CSV and TSV. 0 means unlimited.\n" +
+				"%s  <type::%s>\n" +
+				"  > Maximum size in bytes of a row. 0 means unlimited.\n" +
+				"%s  <type::%s>\n" +
+				"  > Recover quoted fields that start with stray double quotes and run over lines.\n" +
+				"%s  <type::%s>\n" +
 				"  > %s of query results.\n" +
 				"%s  <type::%s>\n" +
 				"  > Character %s of query results.\n" +
@@ -897,8 +1309,12 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > %s in query results.\n" +
 				"%s  <type::%s>\n" +
+				"  > Write updated files with a single line break instead of preserving mixed line breaks.\n" +
+				"%s  <type::%s>\n" +
 				"  > Enclose all string values in CSV.\n" +
 				"%s  <type::%s>\n" +
+				"  > Quoting of new or updated fields in CSV. One of PRESERVE, MINIMAL or ALL.\n" +
+				"%s  <type::%s>\n" +
 				"  > %s of query results.\n" +
 				"%s  <type::%s>\n" +
 				"  > Make JSON output easier to read in query results.\n" +
@@ -919,29 +1335,50 @@ var CsvqSyntax = []Expression{
 				"",
 			Values: []Element{
 				Flag("@@REPOSITORY"), String("string"),
+				Flag("@@CATALOG"), String("string"),
+				Flag("@@CACHE_DIR"), String("string"),
+				Flag("@@SOURCE_PATH"), String("string"),
+				Flag("@@SOURCE_RELATIVE"), Boolean("boolean"),
 				Flag("@@TIMEZONE"), String("string"), Link("Timezone"),
 				Flag("@@DATETIME_FORMAT"), String("string"),
+				Flag("@@DECIMAL_SEPARATOR"), String("string"),
+				Flag("@@THOUSANDS_SEPARATOR"), String("string"),
+				Flag("@@COLLATION"), String("string"),
+				Flag("@@LANGUAGE"), String("string"),
 				Flag("@@WAIT_TIMEOUT"), Float("float"),
+				Flag("@@MERGE_TOOL"), String("string"),
+				Flag("@@CONFLICT_DIR"), String("string"),
+				Flag("@@GIT_CHECK"), String("string"),
 				Flag("@@DELIMITER"), String("string"),
 				Flag("@@JSON_QUERY"), String("string"),
 				Flag("@@ENCODING"), String("string"), Link("Encoding"),
+				Flag("@@ENCODING_ERRORS"), String("string"),
 				Flag("@@NO_HEADER"), Boolean("boolean"),
 				Flag("@@WITHOUT_NULL"), Boolean("boolean"),
+				Flag("@@MAX_FIELD_SIZE"), Integer("integer"),
+				Flag("@@MAX_ROW_SIZE"), Integer("integer"),
+				Flag("@@RECOVER_QUOTES"), Boolean("boolean"),
 				Flag("@@FORMAT"), String("string"), Link("Format"),
 				Flag("@@WRITE_ENCODING"), String("string"), Link("Encoding"),
 				Flag("@@WRITE_DELIMITER"), String("string"),
 				Flag("@@WITHOUT_HEADER"), Boolean("boolean"),
 				Flag("@@LINE_BREAK"), String("string"), Link("Line Break"),
+				Flag("@@NORMALIZE_LINE_BREAK"), Boolean("boolean"),
 				Flag("@@ENCLOSE_ALL"), Boolean("boolean"),
+				Flag("@@QUOTE_STYLE"), String("string"),
 				Flag("@@JSON_ESCAPE"), String("string"), Link("Json Escape Type"),
 				Flag("@@PRETTY_PRINT"), Boolean("boolean"),
 				Flag("@@EAST_ASIAN_ENCODING"), Boolean("boolean"),
 				Flag("@@COUNT_DIACRITICAL_SIGN"), Boolean("boolean"),
 				Flag("@@COUNT_FORMAT_CODE"), Boolean("boolean"),
 				Flag("@@COLOR"), Boolean("boolean"),
+				Flag("@@PLAIN"), Boolean("boolean"),
 				Flag("@@QUIET"), Boolean("boolean"),
 				Flag("@@CPU"), Integer("integer"),
+				Flag("@@SORT_MEMORY"), Integer("integer"),
 				Flag("@@STATS"), Boolean("boolean"),
+				Flag("@@STREAM"), Boolean("boolean"),
+				Flag("@@FILTER_ON_LOAD"), Boolean("boolean"),
 			},
 		},
 		Grammar: []Definition{
@@ -989,6 +1426,17 @@ var CsvqSyntax = []Expression{
 					{Keyword("UNSET"), Variable("@%ENV_NAME")},
 				},
 			},
+			{
+				Name: "export_statement",
+				Group: []Grammar{
+					{Keyword("EXPORT"), ContinuousOption{Variable("@%ENV_NAME")}},
+					{Keyword("EXPORT"), ContinuousOption{Variable("@%ENV_NAME")}, Keyword("TO"), String("file_path")},
+				},
+				Description: Description{
+					Template: "Print the commands that set the environment variables in a POSIX shell, or append them to %s.",
+					Values:   []Element{String("file_path")},
+				},
+			},
 		},
 	},
 	{
@@ -1018,6 +1466,7 @@ var CsvqSyntax = []Expression{
 				Variable("@#LOADED_TABLES"), Integer("integer"),
 				Variable("@#WORKING_DIRECTORY"), String("string"),
 				Variable("@#VERSION"), String("string"),
+				Variable("@#LATEST_VERSION"), String("string"),
 			},
 		},
 	},
@@ -1203,6 +1652,42 @@ var CsvqSyntax = []Expression{
 								"```",
 						},
 					},
+					{
+						Name: "collate",
+						Group: []Grammar{
+							{Link("value"), Keyword("COLLATE"), Identifier("collation_name")},
+						},
+						Description: Description{
+							Template: "" +
+								"Specify the rule to compare strings by relational operators and to sort strings by %s. " +
+								"If different collations are specified to both operands, then an error is returned.\n" +
+								"\n" +
+								"```\n" +
+								"  +------------+-----------------------------------------------------------+\n" +
+								"  | Collation  |                        Description                        |\n" +
+								"  +------------+-----------------------------------------------------------+\n" +
+								"  | NOCASE     | Ignore case and leading and trailing spaces (default)     |\n" +
+								"  | BINARY     | Compare byte by byte                                      |\n" +
+								"  | UNICODE    | Unicode Collation Algorithm, ignoring surrounding spaces  |\n" +
+								"  | UNICODE_CI | The same as UNICODE except that case is ignored           |\n" +
+								"  | NATURAL    | The same as NOCASE except that digits are compared as     |\n" +
+								"  |            | numbers                                                   |\n" +
+								"  +------------+-----------------------------------------------------------+\n" +
+								"```",
+							Values: []Element{Keyword("ORDER BY")},
+						},
+					},
+					{
+						Name: "at_time_zone",
+						Group: []Grammar{
+							{Datetime("datetime"), Keyword("AT TIME ZONE"), String("timezone")},
+						},
+						Description: Description{
+							Template: "Returns the datetime value that represents the same instant as %s in %s. " +
+								"The format of %s is the same as %s.",
+							Values: []Element{Datetime("datetime"), String("timezone"), String("timezone"), Link("Timezone")},
+						},
+					},
 					{
 						Name: "is",
 						Group: []Grammar{
@@ -1516,6 +2001,27 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the value of %s raised to the power of %s.", Values: []Element{Float("base"), Float("exponent")}},
 					},
+					{
+						Name: "haversine_distance",
+						Group: []Grammar{
+							{Function{Name: "HAVERSINE_DISTANCE", Args: []Element{Float("lat1"), Float("lon1"), Float("lat2"), Float("lon2")}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the great-circle distance in meters between the point (%s, %s) and the point (%s, %s) in degrees.",
+							Values:   []Element{Float("lat1"), Float("lon1"), Float("lat2"), Float("lon2")},
+						},
+					},
+					{
+						Name: "in_bounding_box",
+						Group: []Grammar{
+							{Function{Name: "IN_BOUNDING_BOX", Args: []Element{Float("lat"), Float("lon"), Float("south"), Float("west"), Float("north"), Float("east")}, Return: Return("ternary")}},
+						},
+						Description: Description{
+							Template: "Returns whether the point (%s, %s) is in the box from the southwest corner (%s, %s) to the northeast corner (%s, %s). " +
+								"If %s is greater than %s, then the box is regarded as crossing the 180th meridian.",
+							Values: []Element{Float("lat"), Float("lon"), Float("south"), Float("west"), Float("north"), Float("east"), Float("west"), Float("east")},
+						},
+					},
 					{
 						Name: "bin_to_dec",
 						Group: []Grammar{
@@ -1684,16 +2190,58 @@ var CsvqSyntax = []Expression{
 						Description: Description{Template: "Returns the number of seconds elapsed since January 1, 1970 UTC of %s as an integer.", Values: []Element{Datetime("datetime")}},
 					},
 					{
-						Name: "unix_nano_time",
+						Name: "unix_milli_time",
 						Group: []Grammar{
-							{Function{Name: "UNIX_NANO_TIME", Args: []Element{Datetime("datetime")}, Return: Return("integer")}},
+							{Function{Name: "UNIX_MILLI_TIME", Args: []Element{Datetime("datetime")}, Return: Return("integer")}},
 						},
-						Description: Description{Template: "Returns the number of nanoseconds elapsed since January 1, 1970 UTC of %s as an integer.", Values: []Element{Datetime("datetime")}},
+						Description: Description{Template: "Returns the number of milliseconds elapsed since January 1, 1970 UTC of %s as an integer.", Values: []Element{Datetime("datetime")}},
 					},
 					{
-						Name: "day_of_year",
+						Name: "unix_micro_time",
 						Group: []Grammar{
-							{Function{Name: "DAY_OF_YEAR", Args: []Element{Datetime("datetime")}, Return: Return("integer")}},
+							{Function{Name: "UNIX_MICRO_TIME", Args: []Element{Datetime("datetime")}, Return: Return("integer")}},
+						},
+						Description: Description{Template: "Returns the number of microseconds elapsed since January 1, 1970 UTC of %s as an integer.", Values: []Element{Datetime("datetime")}},
+					},
+					{
+						Name: "unix_nano_time",
+						Group: []Grammar{
+							{Function{Name: "UNIX_NANO_TIME", Args: []Element{Datetime("datetime")}, Return: Return("integer")}},
+						},
+						Description: Description{Template: "Returns the number of nanoseconds elapsed since January 1, 1970 UTC of %s as an integer.", Values: []Element{Datetime("datetime")}},
+					},
+					{
+						Name: "from_unix_time",
+						Group: []Grammar{
+							{Function{Name: "FROM_UNIX_TIME", Args: []Element{Integer("seconds")}, Return: Return("datetime")}},
+						},
+						Description: Description{Template: "Returns the datetime value of %s elapsed since January 1, 1970 UTC.", Values: []Element{Integer("seconds")}},
+					},
+					{
+						Name: "from_unix_milli_time",
+						Group: []Grammar{
+							{Function{Name: "FROM_UNIX_MILLI_TIME", Args: []Element{Integer("milliseconds")}, Return: Return("datetime")}},
+						},
+						Description: Description{Template: "Returns the datetime value of %s elapsed since January 1, 1970 UTC.", Values: []Element{Integer("milliseconds")}},
+					},
+					{
+						Name: "from_unix_micro_time",
+						Group: []Grammar{
+							{Function{Name: "FROM_UNIX_MICRO_TIME", Args: []Element{Integer("microseconds")}, Return: Return("datetime")}},
+						},
+						Description: Description{Template: "Returns the datetime value of %s elapsed since January 1, 1970 UTC.", Values: []Element{Integer("microseconds")}},
+					},
+					{
+						Name: "from_unix_nano_time",
+						Group: []Grammar{
+							{Function{Name: "FROM_UNIX_NANO_TIME", Args: []Element{Integer("nanoseconds")}, Return: Return("datetime")}},
+						},
+						Description: Description{Template: "Returns the datetime value of %s elapsed since January 1, 1970 UTC.", Values: []Element{Integer("nanoseconds")}},
+					},
+					{
+						Name: "day_of_year",
+						Group: []Grammar{
+							{Function{Name: "DAY_OF_YEAR", Args: []Element{Datetime("datetime")}, Return: Return("integer")}},
 						},
 						Description: Description{Template: "Returns the day of the year of %s as an integer.", Values: []Element{Datetime("datetime")}},
 					},
@@ -1851,6 +2399,13 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the datetime value of %s in UTC.", Values: []Element{Datetime("datetime")}},
 					},
+					{
+						Name: "convert_tz",
+						Group: []Grammar{
+							{Function{Name: "CONVERT_TZ", Args: []Element{Datetime("datetime"), String("from_tz"), String("to_tz")}, Return: Return("datetime")}},
+						},
+						Description: Description{Template: "Regards the date and time of %s as a time in %s, and returns the datetime value converted to %s.", Values: []Element{Datetime("datetime"), String("from_tz"), String("to_tz")}},
+					},
 				},
 			},
 			{
@@ -1910,6 +2465,7 @@ var CsvqSyntax = []Expression{
 						Name: "base64_encode",
 						Group: []Grammar{
 							{Function{Name: "BASE64_ENCODE", Args: []Element{String("str")}, Return: Return("string")}},
+							{Function{Name: "TO_BASE64", Args: []Element{String("str")}, Return: Return("string")}},
 						},
 						Description: Description{Template: "Returns the Base64 encoding of %s.", Values: []Element{String("str")}},
 					},
@@ -1917,13 +2473,15 @@ var CsvqSyntax = []Expression{
 						Name: "base64_decode",
 						Group: []Grammar{
 							{Function{Name: "BASE64_DECODE", Args: []Element{String("str")}, Return: Return("string")}},
+							{Function{Name: "FROM_BASE64", Args: []Element{String("str")}, Return: Return("string")}},
 						},
-						Description: Description{Template: "Returns the string value represented by %s that is encoded with Base64.", Values: []Element{String("str")}},
+						Description: Description{Template: "Returns the string value represented by %s that is encoded with Base64. If %s is not a valid encoding, then returns null.", Values: []Element{String("str"), String("str")}},
 					},
 					{
 						Name: "hex_encode",
 						Group: []Grammar{
 							{Function{Name: "HEX_ENCODE", Args: []Element{String("str")}, Return: Return("string")}},
+							{Function{Name: "TO_HEX", Args: []Element{String("str")}, Return: Return("string")}},
 						},
 						Description: Description{Template: "Returns the hexadecimal encoding of %s.", Values: []Element{String("str")}},
 					},
@@ -1931,8 +2489,36 @@ var CsvqSyntax = []Expression{
 						Name: "hex_decode",
 						Group: []Grammar{
 							{Function{Name: "HEX_DECODE", Args: []Element{String("str")}, Return: Return("string")}},
+							{Function{Name: "FROM_HEX", Args: []Element{String("str")}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the string value represented by %s that is encoded with hexadecimal. If %s is not a valid encoding, then returns null.", Values: []Element{String("str"), String("str")}},
+					},
+					{
+						Name: "url_encode",
+						Group: []Grammar{
+							{Function{Name: "URL_ENCODE", Args: []Element{String("str")}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the string value of %s escaped so that it can be placed in a URL query.", Values: []Element{String("str")}},
+					},
+					{
+						Name: "url_decode",
+						Group: []Grammar{
+							{Function{Name: "URL_DECODE", Args: []Element{String("str")}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the string value represented by %s that is escaped for a URL query.", Values: []Element{String("str")}},
+					},
+					{
+						Name: "url_extract",
+						Group: []Grammar{
+							{Function{Name: "URL_EXTRACT", Args: []Element{String("url"), String("part")}, Return: Return("string")}},
+							{Function{Name: "URL_EXTRACT", Args: []Element{String("url"), Keyword("'QUERY'"), String("key")}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Returns the %s of %s. %s is one of SCHEME, USER, HOST, PORT, PATH, QUERY or FRAGMENT. " +
+								"If %s is specified, then returns the first value of the query parameter. " +
+								"If the part does not exist, then returns null.",
+							Values: []Element{String("part"), String("url"), String("part"), String("key")},
 						},
-						Description: Description{Template: "Returns the string value represented by %s that is encoded with hexadecimal.", Values: []Element{String("str")}},
 					},
 					{
 						Name: "len",
@@ -2019,6 +2605,13 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the string at %s in the list generated by splitting with %s from %s.", Values: []Element{Integer("index"), String("sep"), String("str")}},
 					},
+					{
+						Name: "split",
+						Group: []Grammar{
+							{Function{Name: "SPLIT", Args: []Element{String("str"), String("sep")}, Return: Return("array")}},
+						},
+						Description: Description{Template: "Returns the array of strings generated by splitting %s with %s.", Values: []Element{String("str"), String("sep")}},
+					},
 					{
 						Name: "replace",
 						Group: []Grammar{
@@ -2033,6 +2626,34 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the formatted string replaced %s with %s in %s.", Values: []Element{Link("placeholders"), Link("replace_value"), String("format")}},
 					},
+					{
+						Name: "edit_distance",
+						Group: []Grammar{
+							{Function{Name: "EDIT_DISTANCE", Args: []Element{String("str1"), String("str2")}, Return: Return("integer")}},
+						},
+						Description: Description{Template: "Returns the Levenshtein distance between %s and %s, that is the number of characters to be inserted, deleted or substituted.", Values: []Element{String("str1"), String("str2")}},
+					},
+					{
+						Name: "jaro_winkler",
+						Group: []Grammar{
+							{Function{Name: "JARO_WINKLER", Args: []Element{String("str1"), String("str2")}, Return: Return("float")}},
+						},
+						Description: Description{Template: "Returns the Jaro-Winkler similarity between %s and %s from 0 to 1. 1 means that both strings are the same.", Values: []Element{String("str1"), String("str2")}},
+					},
+					{
+						Name: "soundex",
+						Group: []Grammar{
+							{Function{Name: "SOUNDEX", Args: []Element{String("str")}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the American Soundex code of %s.", Values: []Element{String("str")}},
+					},
+					{
+						Name: "metaphone",
+						Group: []Grammar{
+							{Function{Name: "METAPHONE", Args: []Element{String("str")}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the Metaphone code of %s.", Values: []Element{String("str")}},
+					},
 					{
 						Name: "json_value",
 						Group: []Grammar{
@@ -2040,6 +2661,20 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns a %s in %s.", Values: []Element{Link("value"), String("json_data")}},
 					},
+					{
+						Name: "array_length",
+						Group: []Grammar{
+							{Function{Name: "ARRAY_LENGTH", Args: []Element{Link("array")}, Return: Return("integer")}},
+						},
+						Description: Description{Template: "Returns the number of elements in %s.", Values: []Element{Link("array")}},
+					},
+					{
+						Name: "contains",
+						Group: []Grammar{
+							{Function{Name: "CONTAINS", Args: []Element{Link("array"), Link("value")}, Return: Return("ternary")}},
+						},
+						Description: Description{Template: "Returns whether %s contains %s.", Values: []Element{Link("array"), Link("value")}},
+					},
 					{
 						Name: "json_object",
 						Group: []Grammar{
@@ -2047,6 +2682,42 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns a string formatted in JSON."},
 					},
+					{
+						Name: "uuid",
+						Group: []Grammar{
+							{Function{Name: "UUID", Return: Return("string")}},
+							{Function{Name: "UUID", Args: []Element{Integer("version")}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns a random UUID string. %s is 4 or 7, and the default is 4.", Values: []Element{Integer("version")}},
+					},
+					{
+						Name: "is_uuid",
+						Group: []Grammar{
+							{Function{Name: "IS_UUID", Args: []Element{String("str")}, Return: Return("ternary")}},
+						},
+						Description: Description{Template: "Returns whether %s is a UUID string in the form of \"xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx\".", Values: []Element{String("str")}},
+					},
+					{
+						Name: "aes_encrypt",
+						Group: []Grammar{
+							{Function{Name: "AES_ENCRYPT", Args: []Element{String("str"), String("key"), Option{String("format")}}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Encrypts %s with AES-256-GCM using a key derived from %s with PBKDF2-HMAC-SHA256 and a random salt. " +
+								"The result is encoded in %s, and %s is one of %s or %s. The default is %s.",
+							Values: []Element{String("str"), String("key"), String("format"), String("format"), Keyword("BASE64"), Keyword("HEX"), Keyword("BASE64")},
+						},
+					},
+					{
+						Name: "aes_decrypt",
+						Group: []Grammar{
+							{Function{Name: "AES_DECRYPT", Args: []Element{String("str"), String("key"), Option{String("format")}}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Decrypts %s encrypted by the function AES_ENCRYPT. If %s cannot be decrypted with %s, then returns null.",
+							Values:   []Element{String("str"), String("str"), String("key")},
+						},
+					},
 				},
 			},
 			{
@@ -2108,6 +2779,27 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Generates a SHA-512 keyed-hash value using the HMAC method."},
 					},
+					{
+						Name: "fnv32",
+						Group: []Grammar{
+							{Function{Name: "FNV32", Args: []Element{String("str")}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Generates a 32-bit FNV-1a hash value. This is not a cryptographic hash function."},
+					},
+					{
+						Name: "fnv64",
+						Group: []Grammar{
+							{Function{Name: "FNV64", Args: []Element{String("str")}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Generates a 64-bit FNV-1a hash value. This is not a cryptographic hash function."},
+					},
+					{
+						Name: "xxhash64",
+						Group: []Grammar{
+							{Function{Name: "XXHASH64", Args: []Element{String("str")}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Generates a 64-bit xxHash value. This is not a cryptographic hash function."},
+					},
 				},
 			},
 			{
@@ -2179,10 +2871,12 @@ var CsvqSyntax = []Expression{
 					Template: "" +
 						"Aggregate functions calculate groupd records retrieved by a select query. " +
 						"If records are not grouped, all records are dealt with as one group. " +
-						"If %s keyword is specified, aggregate functions calculate only unique values.\n" +
+						"If %s keyword is specified, aggregate functions calculate only unique values. " +
+						"If %s %s clause is specified, values are sorted before they are aggregated. " +
+						"If %s (%s %s) clause is specified, only the records that satisfy %s are aggregated.\n" +
 						"\n" +
 						"Analytic Functions can be used only in %s, %s and %s",
-					Values: []Element{Keyword("DISTINCT"), Link("Select Clause"), Link("Having Clause"), Link("Order By Clause")},
+					Values: []Element{Keyword("DISTINCT"), Keyword("WITHIN"), Keyword("GROUP"), Keyword("FILTER"), Keyword("WHERE"), Link("condition"), Link("condition"), Link("Select Clause"), Link("Having Clause"), Link("Order By Clause")},
 				},
 				Grammar: []Definition{
 					{
@@ -2255,18 +2949,195 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), Link("value"), Keyword("DATETIME")},
 						},
 					},
+					{
+						Name: "mode",
+						Group: []Grammar{
+							{Function{Name: "MODE", AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value"), Option{AnyOne{Keyword("ASC"), Keyword("DESC")}}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the most frequent non-null value of %s. " +
+								"If there are multiple most frequent values, then returns the first one in the sorted order. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "percentile_disc",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_DISC", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value"), Option{AnyOne{Keyword("ASC"), Keyword("DESC")}}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the first non-null value of %s in the sorted order whose cumulative distribution is greater than or equal to %s. " +
+								"%s must be a number between 0 and 1.",
+							Values: []Element{Link("value"), Float("fraction"), Float("fraction")},
+						},
+					},
+					{
+						Name: "percentile_cont",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_CONT", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value"), Option{AnyOne{Keyword("ASC"), Keyword("DESC")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the value at %s of float values of %s in the sorted order. " +
+								"If the position falls between two values, then the value is linearly interpolated between them. " +
+								"%s must be a number between 0 and 1.",
+							Values: []Element{Float("fraction"), Link("value"), Float("fraction")},
+						},
+					},
+					{
+						Name: "stddev",
+						Group: []Grammar{
+							{Function{Name: "STDDEV", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+							{Function{Name: "STDDEV_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample standard deviation of float values of %s. " +
+								"If there are less than two non-null values, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "stddev_pop",
+						Group: []Grammar{
+							{Function{Name: "STDDEV_POP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population standard deviation of float values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "variance",
+						Group: []Grammar{
+							{Function{Name: "VARIANCE", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+							{Function{Name: "VAR_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample variance of float values of %s. " +
+								"If there are less than two non-null values, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "var_pop",
+						Group: []Grammar{
+							{Function{Name: "VAR_POP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population variance of float values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "bit_and",
+						Group: []Grammar{
+							{Function{Name: "BIT_AND", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the bitwise AND of integer values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "bit_or",
+						Group: []Grammar{
+							{Function{Name: "BIT_OR", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the bitwise OR of integer values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "bit_xor",
+						Group: []Grammar{
+							{Function{Name: "BIT_XOR", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the bitwise XOR of integer values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "corr",
+						Group: []Grammar{
+							{Function{Name: "CORR", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the correlation coefficient of pairs of float values of %s and %s. Pairs that have a null are ignored.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "covar_pop",
+						Group: []Grammar{
+							{Function{Name: "COVAR_POP", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the population covariance of pairs of float values of %s and %s. Pairs that have a null are ignored.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "covar_samp",
+						Group: []Grammar{
+							{Function{Name: "COVAR_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the sample covariance of pairs of float values of %s and %s. Pairs that have a null are ignored.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_slope",
+						Group: []Grammar{
+							{Function{Name: "REGR_SLOPE", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the slope of the least-squares regression line fitted to pairs of float values of %s and %s. Pairs that have a null are ignored.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_intercept",
+						Group: []Grammar{
+							{Function{Name: "REGR_INTERCEPT", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the y-intercept of the least-squares regression line fitted to pairs of float values of %s and %s. Pairs that have a null are ignored.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_r2",
+						Group: []Grammar{
+							{Function{Name: "REGR_R2", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the coefficient of determination of the least-squares regression line fitted to pairs of float values of %s and %s. Pairs that have a null are ignored.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
 					{
 						Name: "listagg",
 						Group: []Grammar{
 							{Function{Name: "LISTAGG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value"), Option{String("sep")}}, AfterArgs: []Element{Option{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}}}, Return: Return("string")}},
+							{Function{Name: "GROUP_CONCAT", Args: []Element{Option{Keyword("DISTINCT")}, Link("value"), Option{String("sep")}}, AfterArgs: []Element{Option{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}}}, Return: Return("string")}},
 						},
 						Description: Description{
 							Template: "Returns the string result with the concatenated non-null values of %s. " +
 								"If all values are null, then returns %s.\n" +
 								"\n" +
 								"%s is placed between values. Empty string is the default. " +
-								"By using %s, you can sort values.",
-							Values: []Element{Link("value"), Null("NULL"), String("sep"), Link("order_by_clause")},
+								"By using %s, you can sort values.\n" +
+								"\n" +
+								"%s is a synonym for %s.",
+							Values: []Element{Link("value"), Null("NULL"), String("sep"), Link("order_by_clause"), Keyword("GROUP_CONCAT"), Keyword("LISTAGG")},
 						},
 					},
 					{
@@ -2280,6 +3151,17 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Link("order_by_clause")},
 						},
 					},
+					{
+						Name: "array_agg",
+						Group: []Grammar{
+							{Function{Name: "ARRAY_AGG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Option{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}}}, Return: Return("array")}},
+						},
+						Description: Description{
+							Template: "Returns the array of %s. " +
+								"By using %s, you can sort values.",
+							Values: []Element{Link("value"), Link("order_by_clause")},
+						},
+					},
 				},
 			},
 			{
@@ -2322,7 +3204,9 @@ var CsvqSyntax = []Expression{
 							{Function{Name: "CUME_DIST", AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause")}}}, Return: Return("float")}},
 						},
 						Description: Description{
-							Template: "Returns the cumulative distributions in a group. The return value is greater than 0 and less than or equal to 1.",
+							Template: "Returns the cumulative distributions in a group. The return value is greater than 0 and less than or equal to 1. " +
+								"If %s is not specified, then all records in a group are peers and return 1.",
+							Values: []Element{Link("order_by_clause")},
 						},
 					},
 					{
@@ -2331,17 +3215,20 @@ var CsvqSyntax = []Expression{
 							{Function{Name: "PERCENT_RANK", AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause")}}}, Return: Return("float")}},
 						},
 						Description: Description{
-							Template: "Returns the relative ranks in a group. The return value is greater than or equal to 0 and less than or equal to 1.",
+							Template: "Returns the relative ranks in a group. The return value is greater than or equal to 0 and less than or equal to 1. " +
+								"If %s is not specified or a group has only one record, then returns 0.",
+							Values: []Element{Link("order_by_clause")},
 						},
 					},
 					{
 						Name: "ntile",
 						Group: []Grammar{
-							{Function{Name: "NTILE", Args: []Element{Integer("number_of_groups")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause")}}}, Return: Return("float")}},
+							{Function{Name: "NTILE", Args: []Element{Integer("number_of_groups")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause")}}}, Return: Return("integer")}},
 						},
 						Description: Description{
-							Template: "Splits the records into %s groups, then returns the sequential numbers of the groups.",
-							Values:   []Element{Integer("number_of_groups")},
+							Template: "Splits the records into %s groups, then returns the sequential numbers of the groups. " +
+								"If the number of records is not divisible by %s, then the first groups have one more record than the others.",
+							Values: []Element{Integer("number_of_groups"), Integer("number_of_groups")},
 						},
 					},
 					{
@@ -2463,16 +3350,151 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), Link("value"), Keyword("DATETIME")},
 						},
 					},
+					{
+						Name: "stddev",
+						Group: []Grammar{
+							{Function{Name: "STDDEV", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+							{Function{Name: "STDDEV_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample standard deviation of float values of %s. If there are less than two non-null values, then returns %s.",
+							Values:   []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "stddev_pop",
+						Group: []Grammar{
+							{Function{Name: "STDDEV_POP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population standard deviation of float values of %s. If all values are null, then returns %s.",
+							Values:   []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "variance",
+						Group: []Grammar{
+							{Function{Name: "VARIANCE", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+							{Function{Name: "VAR_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample variance of float values of %s. If there are less than two non-null values, then returns %s.",
+							Values:   []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "var_pop",
+						Group: []Grammar{
+							{Function{Name: "VAR_POP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population variance of float values of %s. If all values are null, then returns %s.",
+							Values:   []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "bit_and",
+						Group: []Grammar{
+							{Function{Name: "BIT_AND", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the bitwise AND of integer values of %s. If all values are null, then returns %s.",
+							Values:   []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "bit_or",
+						Group: []Grammar{
+							{Function{Name: "BIT_OR", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the bitwise OR of integer values of %s. If all values are null, then returns %s.",
+							Values:   []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "bit_xor",
+						Group: []Grammar{
+							{Function{Name: "BIT_XOR", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the bitwise XOR of integer values of %s. If all values are null, then returns %s.",
+							Values:   []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "corr",
+						Group: []Grammar{
+							{Function{Name: "CORR", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the correlation coefficient of pairs of float values of %s and %s. Pairs that have a null are ignored.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "covar_pop",
+						Group: []Grammar{
+							{Function{Name: "COVAR_POP", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the population covariance of pairs of float values of %s and %s. Pairs that have a null are ignored.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "covar_samp",
+						Group: []Grammar{
+							{Function{Name: "COVAR_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the sample covariance of pairs of float values of %s and %s. Pairs that have a null are ignored.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_slope",
+						Group: []Grammar{
+							{Function{Name: "REGR_SLOPE", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the slope of the least-squares regression line fitted to pairs of float values of %s and %s. Pairs that have a null are ignored.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_intercept",
+						Group: []Grammar{
+							{Function{Name: "REGR_INTERCEPT", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the y-intercept of the least-squares regression line fitted to pairs of float values of %s and %s. Pairs that have a null are ignored.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_r2",
+						Group: []Grammar{
+							{Function{Name: "REGR_R2", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the coefficient of determination of the least-squares regression line fitted to pairs of float values of %s and %s. Pairs that have a null are ignored.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
 					{
 						Name: "listagg",
 						Group: []Grammar{
 							{Function{Name: "LISTAGG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value"), Option{String("sep")}}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("string")}},
+							{Function{Name: "GROUP_CONCAT", Args: []Element{Option{Keyword("DISTINCT")}, Link("value"), Option{String("sep")}}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("string")}},
 						},
 						Description: Description{
 							Template: "Returns the string result with the concatenated non-null values of %s. If all values are null, then returns %s.\n" +
 								"\n" +
-								"%s is placed between values. Empty string is the default.",
-							Values: []Element{Link("value"), Null("NULL"), String("sep")},
+								"%s is placed between values. Empty string is the default.\n" +
+								"\n" +
+								"%s is a synonym for %s.",
+							Values: []Element{Link("value"), Null("NULL"), String("sep"), Keyword("GROUP_CONCAT"), Keyword("LISTAGG")},
 						},
 					},
 					{
@@ -2485,6 +3507,16 @@ var CsvqSyntax = []Expression{
 							Values:   []Element{Link("value")},
 						},
 					},
+					{
+						Name: "array_agg",
+						Group: []Grammar{
+							{Function{Name: "ARRAY_AGG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("array")}},
+						},
+						Description: Description{
+							Template: "Returns the array of %s.",
+							Values:   []Element{Link("value")},
+						},
+					},
 				},
 				Children: []Expression{
 					{
@@ -2659,19 +3691,19 @@ var CsvqSyntax = []Expression{
 				Name: "Reserved Words",
 				Description: Description{
 					Template: "" +
-						"ABSOLUTE ADD AFTER AGGREGATE ALTER ALL AND ANY AS ASC AVG BEFORE BEGIN " +
-						"BETWEEN BREAK BY CASE CHDIR CLOSE COMMIT CONTINUE COUNT CREATE CROSS " +
+						"ABSOLUTE ADD AFTER AGGREGATE ALTER ALL AND ANY ARRAY_AGG AS ASC ASSERT AUTO_INCREMENT AVG BEFORE BEGIN " +
+						"BETWEEN BIT_AND BIT_OR BIT_XOR BREAK BULK BY CASE CATCH CHDIR CHECK CLOSE COMMIT CONSTRAINT CONTINUE COPY CORR COUNT COVAR_POP COVAR_SAMP CREATE CROSS " +
 						"CUME_DIST CURRENT CURSOR DECLARE DEFAULT DELETE DENSE_RANK DESC DISPOSE " +
 						"DISTINCT DO DROP DUAL ECHO ELSE ELSEIF END EXCEPT EXECUTE EXISTS " +
-						"EXIT FALSE FETCH FIRST FIRST_VALUE FOLLOWING FOR FROM FULL FUNCTION " +
-						"GROUP HAVING IF IGNORE IN INNER INSERT INTERSECT INTO IS JOIN " +
+						"EXIT EXPECT EXPLAIN EXPORT FALSE FETCH FIRST FIRST_VALUE FOLLOWING FOR FROM FULL FUNCTION " +
+						"GENERATE_SERIES GROUP GROUP_CONCAT HAVING IF IGNORE IMMEDIATE IMPORT IN INNER INSERT INTERSECT INTO IS JOIN " +
 						"JSON_AGG JSON_OBJECT JSON_ROW JSON_TABLE LAG LAST LAST_VALUE LEAD " +
-						"LEFT LIKE LIMIT LISTAGG MAX MEDIAN MIN NATURAL NEXT NOT NTH_VALUE " +
+						"LEFT LIKE LIMIT LISTAGG MAX MEDIAN MIN MODE NATURAL NEXT NOT NTH_VALUE " +
 						"NTILE NULL OFFSET ON OPEN OR ORDER OUTER OVER PARTITION PERCENT " +
-						"PERCENT_RANK PRECEDING PRINT PRINTF PRIOR PWD RANGE RANK RECURSIVE " +
-						"RELATIVE RELOAD REMOVE RENAME RETURN RIGHT ROLLBACK ROW ROW_NUMBER " +
-						"SELECT SEPARATOR SET SHOW SOURCE STDIN SUM SYNTAX TABLE THEN TO TRIGGER TRUE " +
-						"UNBOUNDED UNION UNKNOWN UNSET UPDATE USING VALUES VAR VIEW WHEN WHERE " +
+						"PERCENT_RANK PERCENTILE_CONT PERCENTILE_DISC PRECEDING PREPARE PRINT PRINTF PRIOR PWD RANGE RANK RECURSIVE " +
+						"REGR_INTERCEPT REGR_R2 REGR_SLOPE RELATIVE RELOAD REMOVE RENAME RETURN RIGHT ROLLBACK ROW ROW_NUMBER " +
+						"SELECT SEPARATOR SET SHOW SOURCE STDDEV STDDEV_POP STDDEV_SAMP STDIN SUM SYNTAX TABLE TAIL THEN TO TRIGGER TRUE TRY " +
+						"UNBOUNDED UNION UNIQUE UNKNOWN UNNEST UNSET UPDATE USING VALUES VAR VARIADIC VARIANCE VAR_POP VAR_SAMP VIEW WHEN WHERE " +
 						"WHILE WITH WITHIN",
 				},
 			},
@@ -2899,7 +3931,8 @@ var CsvqSyntax = []Expression{
 				Name: "Timezone",
 				Description: Description{
 					Template: "" +
-						"%s, %s or a timezone name in the IANA TimeZone database(in the form of \"Area/Location\". e.g. \"America/Los_Angeles\").\n" +
+						"%s, %s, an offset from UTC(in the form of \"+hh:mm\" or \"-hhmm\". e.g. \"+09:00\") " +
+						"or a timezone name in the IANA TimeZone database(in the form of \"Area/Location\". e.g. \"America/Los_Angeles\").\n" +
 						"\n" +
 						"The timezone database is required in order to use the timezone names. " +
 						"Most Unix-like systems provide the database. " +
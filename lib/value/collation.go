@@ -0,0 +1,121 @@
+package value
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+type Collation int
+
+const (
+	NoCaseCollation Collation = iota
+	BinaryCollation
+	UnicodeCollation
+	UnicodeCICollation
+	NaturalCollation
+)
+
+var collationNames = map[Collation]string{
+	NoCaseCollation:    "NOCASE",
+	BinaryCollation:    "BINARY",
+	UnicodeCollation:   "UNICODE",
+	UnicodeCICollation: "UNICODE_CI",
+	NaturalCollation:   "NATURAL",
+}
+
+func (c Collation) String() string {
+	return collationNames[c]
+}
+
+func ParseCollation(s string) (Collation, error) {
+	name := strings.ToUpper(s)
+	for c, v := range collationNames {
+		if v == name {
+			return c, nil
+		}
+	}
+	return NoCaseCollation, errors.New("collation must be one of BINARY|NOCASE|UNICODE|UNICODE_CI|NATURAL")
+}
+
+func (c Collation) IgnoresCase() bool {
+	return c != BinaryCollation && c != UnicodeCollation
+}
+
+func DefaultCollation() Collation {
+	c, _ := ParseCollation(cmd.GetFlags().Collation)
+	return c
+}
+
+func (c Collation) Key(s string) string {
+	switch c {
+	case BinaryCollation:
+		return s
+	case UnicodeCollation:
+		return unicodeCollationKey(unicodeCollators, strings.TrimSpace(s))
+	case UnicodeCICollation:
+		return unicodeCollationKey(unicodeCICollators, strings.TrimSpace(s))
+	case NaturalCollation:
+		return naturalKey(strings.ToUpper(strings.TrimSpace(s)))
+	default:
+		return strings.ToUpper(strings.TrimSpace(s))
+	}
+}
+
+type unicodeCollator struct {
+	collator *collate.Collator
+	buf      *collate.Buffer
+}
+
+// Collators are not safe for concurrent use.
+var unicodeCollators = &sync.Pool{
+	New: func() interface{} {
+		return &unicodeCollator{collator: collate.New(language.Und), buf: &collate.Buffer{}}
+	},
+}
+
+var unicodeCICollators = &sync.Pool{
+	New: func() interface{} {
+		return &unicodeCollator{collator: collate.New(language.Und, collate.IgnoreCase), buf: &collate.Buffer{}}
+	},
+}
+
+func unicodeCollationKey(pool *sync.Pool, s string) string {
+	c := pool.Get().(*unicodeCollator)
+	key := string(c.collator.KeyFromString(c.buf, s))
+	c.buf.Reset()
+	pool.Put(c)
+	return key
+}
+
+// Digit runs are prefixed with their length so that longer numbers sort after shorter ones.
+func naturalKey(s string) string {
+	buf := make([]byte, 0, len(s)+8)
+
+	for i := 0; i < len(s); {
+		if s[i] < '0' || '9' < s[i] {
+			buf = append(buf, s[i])
+			i++
+			continue
+		}
+
+		start := i
+		for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+			i++
+		}
+		digits := strings.TrimLeft(s[start:i], "0")
+		if len(digits) < 1 {
+			digits = "0"
+		}
+
+		n := len(digits)
+		buf = append(buf, '0', byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+		buf = append(buf, digits...)
+	}
+	return string(buf)
+}
@@ -0,0 +1,129 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+)
+
+var parseCollationTests = []struct {
+	Name   string
+	Result Collation
+	Error  string
+}{
+	{
+		Name:   "binary",
+		Result: BinaryCollation,
+	},
+	{
+		Name:   "NoCase",
+		Result: NoCaseCollation,
+	},
+	{
+		Name:   "unicode",
+		Result: UnicodeCollation,
+	},
+	{
+		Name:   "UNICODE_CI",
+		Result: UnicodeCICollation,
+	},
+	{
+		Name:   "natural",
+		Result: NaturalCollation,
+	},
+	{
+		Name:  "notexist",
+		Error: "collation must be one of BINARY|NOCASE|UNICODE|UNICODE_CI|NATURAL",
+	},
+}
+
+func TestParseCollation(t *testing.T) {
+	for _, v := range parseCollationTests {
+		result, err := ParseCollation(v.Name)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.Name)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.Name)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Name)
+			continue
+		}
+		if result != v.Result {
+			t.Errorf("result = %s, want %s for %q", result, v.Result, v.Name)
+		}
+	}
+}
+
+var collationKeyTests = []struct {
+	Collation Collation
+	Strings   []string
+}{
+	{
+		Collation: NoCaseCollation,
+		Strings:   []string{"a", " b", "Z", "é"},
+	},
+	{
+		Collation: BinaryCollation,
+		Strings:   []string{" b", "A", "Z", "a", "é"},
+	},
+	{
+		Collation: UnicodeCollation,
+		Strings:   []string{"a", "A", "e", "E", "é", "É", "f", "z"},
+	},
+	{
+		Collation: UnicodeCICollation,
+		Strings:   []string{"a", "e", "é", "f", "z"},
+	},
+	{
+		Collation: NaturalCollation,
+		Strings:   []string{"file", "file-1", "file1", "file2", "file002b", "file10", "FILE10a", "file100", "file:"},
+	},
+}
+
+func TestCollation_Key(t *testing.T) {
+	for _, v := range collationKeyTests {
+		for i := 1; i < len(v.Strings); i++ {
+			k1 := v.Collation.Key(v.Strings[i-1])
+			k2 := v.Collation.Key(v.Strings[i])
+			if k2 <= k1 {
+				t.Errorf("key of %q is not less than key of %q in %s", v.Strings[i-1], v.Strings[i], v.Collation)
+			}
+		}
+	}
+
+	if NoCaseCollation.Key(" abc ") != NoCaseCollation.Key("ABC") {
+		t.Errorf("keys of %q and %q are not equal in %s", " abc ", "ABC", NoCaseCollation)
+	}
+	if NaturalCollation.Key(" File007 ") != NaturalCollation.Key("file7") {
+		t.Errorf("keys of %q and %q are not equal in %s", " File007 ", "file7", NaturalCollation)
+	}
+	if UnicodeCICollation.Key("Émile") != UnicodeCICollation.Key("émile") {
+		t.Errorf("keys of %q and %q are not equal in %s", "Émile", "émile", UnicodeCICollation)
+	}
+}
+
+func TestDefaultCollation(t *testing.T) {
+	flags := cmd.GetFlags()
+	defer func() {
+		flags.Collation = "NOCASE"
+	}()
+
+	if DefaultCollation() != NoCaseCollation {
+		t.Errorf("default collation = %s, want %s", DefaultCollation(), NoCaseCollation)
+	}
+	if CompareCombinedly(NewString("abc"), NewString("ABC")) != IsEqual {
+		t.Errorf("%q and %q are not equal in %s", "abc", "ABC", NoCaseCollation)
+	}
+
+	_ = flags.SetCollation("binary")
+	if DefaultCollation() != BinaryCollation {
+		t.Errorf("default collation = %s, want %s", DefaultCollation(), BinaryCollation)
+	}
+	if CompareCombinedly(NewString("abc"), NewString("ABC")) != IsGreater {
+		t.Errorf("%q is not greater than %q in %s", "abc", "ABC", BinaryCollation)
+	}
+}
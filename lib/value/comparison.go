@@ -2,7 +2,6 @@ package value
 
 import (
 	"errors"
-	"strings"
 
 	"github.com/mithrandie/ternary"
 )
@@ -32,6 +31,12 @@ func (cr ComparisonResult) String() string {
 }
 
 func CompareCombinedly(p1 Primary, p2 Primary) ComparisonResult {
+	return CompareCombinedlyWithCollation(p1, p2, DefaultCollation())
+}
+
+// CompareCombinedlyWithCollation compares two values in the same way as CompareCombinedly
+// except that strings are compared by the collation.
+func CompareCombinedlyWithCollation(p1 Primary, p2 Primary, collation Collation) ComparisonResult {
 	if IsNull(p1) || IsNull(p2) {
 		return IsIncommensurable
 	}
@@ -92,8 +97,8 @@ func CompareCombinedly(p1 Primary, p2 Primary) ComparisonResult {
 
 	if s1, ok := p1.(String); ok {
 		if s2, ok := p2.(String); ok {
-			v1 := strings.ToUpper(strings.TrimSpace(s1.Raw()))
-			v2 := strings.ToUpper(strings.TrimSpace(s2.Raw()))
+			v1 := collation.Key(s1.Raw())
+			v2 := collation.Key(s2.Raw())
 
 			if v1 == v2 {
 				return IsEqual
@@ -105,9 +110,73 @@ func CompareCombinedly(p1 Primary, p2 Primary) ComparisonResult {
 		}
 	}
 
+	if a1, ok := p1.(Array); ok {
+		if a2, ok := p2.(Array); ok {
+			return compareArrays(a1, a2)
+		}
+	}
+
+	if m1, ok := p1.(Map); ok {
+		if m2, ok := p2.(Map); ok {
+			return compareMaps(m1, m2)
+		}
+	}
+
 	return IsIncommensurable
 }
 
+func compareArrays(a1 Array, a2 Array) ComparisonResult {
+	result := IsEqual
+
+	for i := 0; i < a1.Len() && i < a2.Len(); i++ {
+		switch r := CompareCombinedly(a1.values[i], a2.values[i]); r {
+		case IsEqual:
+		case IsBoolEqual:
+			result = IsBoolEqual
+		default:
+			return r
+		}
+	}
+
+	if a1.Len() == a2.Len() {
+		return result
+	}
+	if result == IsBoolEqual {
+		return IsNotEqual
+	}
+	if a1.Len() < a2.Len() {
+		return IsLess
+	}
+	return IsGreater
+}
+
+// compareMaps compares two maps only for equality, since maps have no order.
+func compareMaps(m1 Map, m2 Map) ComparisonResult {
+	result := IsEqual
+
+	for i, k := range m1.keys {
+		v2, ok := m2.Value(k)
+		if !ok {
+			return IsNotEqual
+		}
+
+		switch r := CompareCombinedly(m1.values[i], v2); r {
+		case IsEqual:
+		case IsBoolEqual:
+			result = IsBoolEqual
+		case IsIncommensurable:
+			return r
+		default:
+			result = IsNotEqual
+		}
+	}
+
+	if m1.Len() != m2.Len() {
+		return IsNotEqual
+	}
+	return result
+}
+
 func Identical(p1 Primary, p2 Primary) ternary.Value {
 	if t, ok := p1.(Ternary); (ok && t.value == ternary.UNKNOWN) || IsNull(p1) {
 		return ternary.UNKNOWN
@@ -152,6 +221,42 @@ func Identical(p1 Primary, p2 Primary) ternary.Value {
 		}
 	}
 
+	if v1, ok := p1.(Array); ok {
+		if v2, ok := p2.(Array); ok {
+			if v1.Len() != v2.Len() {
+				return ternary.FALSE
+			}
+			result := ternary.TRUE
+			for i := range v1.values {
+				result = ternary.And(result, Identical(v1.values[i], v2.values[i]))
+				if result == ternary.FALSE {
+					break
+				}
+			}
+			return result
+		}
+	}
+
+	if v1, ok := p1.(Map); ok {
+		if v2, ok := p2.(Map); ok {
+			if v1.Len() != v2.Len() {
+				return ternary.FALSE
+			}
+			result := ternary.TRUE
+			for i, k := range v1.keys {
+				v, ok := v2.Value(k)
+				if !ok {
+					return ternary.FALSE
+				}
+				result = ternary.And(result, Identical(v1.values[i], v))
+				if result == ternary.FALSE {
+					break
+				}
+			}
+			return result
+		}
+	}
+
 	return ternary.FALSE
 }
 
@@ -216,7 +321,47 @@ func Compare(p1 Primary, p2 Primary, operator string) ternary.Value {
 	}
 }
 
+// CompareWithCollation compares two values with the operator.
+// Strings are compared by the collation unless the operator is "==".
+func CompareWithCollation(p1 Primary, p2 Primary, operator string, collation Collation) ternary.Value {
+	if operator == "==" {
+		return Identical(p1, p2)
+	}
+
+	r := CompareCombinedlyWithCollation(p1, p2, collation)
+	if r == IsIncommensurable {
+		return ternary.UNKNOWN
+	}
+
+	switch operator {
+	case "=":
+		return ternary.ConvertFromBool(r == IsEqual || r == IsBoolEqual)
+	case "<>", "!=":
+		return ternary.ConvertFromBool(r != IsEqual && r != IsBoolEqual)
+	}
+
+	if r == IsNotEqual || r == IsBoolEqual {
+		return ternary.UNKNOWN
+	}
+	switch operator {
+	case ">":
+		return ternary.ConvertFromBool(r == IsGreater)
+	case "<":
+		return ternary.ConvertFromBool(r == IsLess)
+	case ">=":
+		return ternary.ConvertFromBool(r != IsLess)
+	default: //case "<=":
+		return ternary.ConvertFromBool(r != IsGreater)
+	}
+}
+
 func CompareRowValues(rowValue1 RowValue, rowValue2 RowValue, operator string) (ternary.Value, error) {
+	return CompareRowValuesWithCollation(rowValue1, rowValue2, operator, DefaultCollation())
+}
+
+// CompareRowValuesWithCollation compares two row values in the same way as CompareRowValues
+// except that strings are compared by the collation.
+func CompareRowValuesWithCollation(rowValue1 RowValue, rowValue2 RowValue, operator string, collation Collation) (ternary.Value, error) {
 	if rowValue1 == nil || rowValue2 == nil {
 		return ternary.UNKNOWN, nil
 	}
@@ -238,7 +383,7 @@ func CompareRowValues(rowValue1 RowValue, rowValue2 RowValue, operator string) (
 			continue
 		}
 
-		r := CompareCombinedly(rowValue1[i], rowValue2[i])
+		r := CompareCombinedlyWithCollation(rowValue1[i], rowValue2[i], collation)
 
 		if r == IsIncommensurable {
 			switch operator {
@@ -97,6 +97,56 @@ var compareCombinedlyTests = []struct {
 		RHS:    NewTernaryFromString("true"),
 		Result: IsIncommensurable,
 	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		RHS:    NewArray([]Primary{NewString("1"), NewString("A")}),
+		Result: IsEqual,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewString("b")}),
+		Result: IsLess,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		RHS:    NewArray([]Primary{NewInteger(1)}),
+		Result: IsGreater,
+	},
+	{
+		LHS:    NewArray([]Primary{NewBoolean(true)}),
+		RHS:    NewArray([]Primary{NewBoolean(true), NewInteger(1)}),
+		Result: IsNotEqual,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewNull()}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewInteger(2)}),
+		Result: IsIncommensurable,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1)}),
+		RHS:    NewInteger(1),
+		Result: IsIncommensurable,
+	},
+	{
+		LHS:    NewMap([]string{"a", "b"}, []Primary{NewInteger(1), NewString("x")}),
+		RHS:    NewMap([]string{"b", "a"}, []Primary{NewString("X"), NewString("1")}),
+		Result: IsEqual,
+	},
+	{
+		LHS:    NewMap([]string{"a"}, []Primary{NewInteger(1)}),
+		RHS:    NewMap([]string{"a"}, []Primary{NewInteger(2)}),
+		Result: IsNotEqual,
+	},
+	{
+		LHS:    NewMap([]string{"a"}, []Primary{NewInteger(1)}),
+		RHS:    NewMap([]string{"a", "b"}, []Primary{NewInteger(1), NewInteger(2)}),
+		Result: IsNotEqual,
+	},
+	{
+		LHS:    NewMap([]string{"a"}, []Primary{NewNull()}),
+		RHS:    NewMap([]string{"a"}, []Primary{NewInteger(1)}),
+		Result: IsIncommensurable,
+	},
 }
 
 func TestCompareCombinedly(t *testing.T) {
@@ -173,6 +223,36 @@ var identicalTests = []struct {
 		RHS:    NewFloat(1),
 		Result: ternary.FALSE,
 	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		Result: ternary.TRUE,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewString("A")}),
+		Result: ternary.FALSE,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1)}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		Result: ternary.FALSE,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewNull()}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewNull()}),
+		Result: ternary.UNKNOWN,
+	},
+	{
+		LHS:    NewMap([]string{"a", "b"}, []Primary{NewInteger(1), NewString("x")}),
+		RHS:    NewMap([]string{"b", "a"}, []Primary{NewString("x"), NewInteger(1)}),
+		Result: ternary.TRUE,
+	},
+	{
+		LHS:    NewMap([]string{"a"}, []Primary{NewInteger(1)}),
+		RHS:    NewMap([]string{"b"}, []Primary{NewInteger(1)}),
+		Result: ternary.FALSE,
+	},
 }
 
 func TestIdentical(t *testing.T) {
@@ -321,6 +401,108 @@ func TestCompare(t *testing.T) {
 	}
 }
 
+var compareWithCollationTests = []struct {
+	LHS       Primary
+	RHS       Primary
+	Op        string
+	Collation Collation
+	Result    ternary.Value
+}{
+	{
+		LHS:       NewString("abc"),
+		RHS:       NewString(" ABC "),
+		Op:        "=",
+		Collation: NoCaseCollation,
+		Result:    ternary.TRUE,
+	},
+	{
+		LHS:       NewString("abc"),
+		RHS:       NewString("ABC"),
+		Op:        "=",
+		Collation: BinaryCollation,
+		Result:    ternary.FALSE,
+	},
+	{
+		LHS:       NewString("b"),
+		RHS:       NewString("C"),
+		Op:        "<",
+		Collation: BinaryCollation,
+		Result:    ternary.FALSE,
+	},
+	{
+		LHS:       NewString("é"),
+		RHS:       NewString("g"),
+		Op:        "<=",
+		Collation: UnicodeCollation,
+		Result:    ternary.TRUE,
+	},
+	{
+		LHS:       NewString("é"),
+		RHS:       NewString("g"),
+		Op:        "<",
+		Collation: NoCaseCollation,
+		Result:    ternary.FALSE,
+	},
+	{
+		LHS:       NewString("Straße"),
+		RHS:       NewString("STRASSE"),
+		Op:        "<>",
+		Collation: UnicodeCICollation,
+		Result:    ternary.TRUE,
+	},
+	{
+		LHS:       NewString("Émile"),
+		RHS:       NewString("émile"),
+		Op:        "=",
+		Collation: UnicodeCICollation,
+		Result:    ternary.TRUE,
+	},
+	{
+		LHS:       NewString("abc"),
+		RHS:       NewString("ABC"),
+		Op:        "==",
+		Collation: UnicodeCICollation,
+		Result:    ternary.FALSE,
+	},
+	{
+		LHS:       NewInteger(10),
+		RHS:       NewString("9"),
+		Op:        ">",
+		Collation: BinaryCollation,
+		Result:    ternary.TRUE,
+	},
+	{
+		LHS:       NewBoolean(true),
+		RHS:       NewBoolean(false),
+		Op:        ">=",
+		Collation: BinaryCollation,
+		Result:    ternary.UNKNOWN,
+	},
+	{
+		LHS:       NewString("abc"),
+		RHS:       NewNull(),
+		Op:        "!=",
+		Collation: BinaryCollation,
+		Result:    ternary.UNKNOWN,
+	},
+}
+
+func TestCompareWithCollation(t *testing.T) {
+	for _, v := range compareTests {
+		r := CompareWithCollation(v.LHS, v.RHS, v.Op, NoCaseCollation)
+		if r != v.Result {
+			t.Errorf("result = %s, want %s for (%s %s %s)", r, v.Result, v.LHS, v.Op, v.RHS)
+		}
+	}
+
+	for _, v := range compareWithCollationTests {
+		r := CompareWithCollation(v.LHS, v.RHS, v.Op, v.Collation)
+		if r != v.Result {
+			t.Errorf("result = %s, want %s for (%s %s %s collate %s)", r, v.Result, v.LHS, v.Op, v.RHS, v.Collation)
+		}
+	}
+}
+
 var compareRowValuesTests = []struct {
 	LHS    RowValue
 	RHS    RowValue
@@ -668,6 +850,45 @@ func TestCompareRowValues(t *testing.T) {
 	}
 }
 
+var compareRowValuesWithCollationTests = []struct {
+	LHS       RowValue
+	RHS       RowValue
+	Op        string
+	Collation Collation
+	Result    ternary.Value
+}{
+	{
+		LHS:       RowValue{NewInteger(1), NewString("abc")},
+		RHS:       RowValue{NewInteger(1), NewString("ABC")},
+		Op:        "=",
+		Collation: NoCaseCollation,
+		Result:    ternary.TRUE,
+	},
+	{
+		LHS:       RowValue{NewInteger(1), NewString("abc")},
+		RHS:       RowValue{NewInteger(1), NewString("ABC")},
+		Op:        "=",
+		Collation: BinaryCollation,
+		Result:    ternary.FALSE,
+	},
+	{
+		LHS:       RowValue{NewString("file10"), NewInteger(1)},
+		RHS:       RowValue{NewString("file2"), NewInteger(1)},
+		Op:        ">",
+		Collation: NaturalCollation,
+		Result:    ternary.TRUE,
+	},
+}
+
+func TestCompareRowValuesWithCollation(t *testing.T) {
+	for _, v := range compareRowValuesWithCollationTests {
+		r, _ := CompareRowValuesWithCollation(v.LHS, v.RHS, v.Op, v.Collation)
+		if r != v.Result {
+			t.Errorf("result = %s, want %s for (%s %s %s collate %s)", r, v.Result, v.LHS, v.Op, v.RHS, v.Collation)
+		}
+	}
+}
+
 var equivalentToTests = []struct {
 	LHS    Primary
 	RHS    Primary
@@ -6,25 +6,44 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 
+	"github.com/mithrandie/go-text/json"
 	"github.com/mithrandie/ternary"
 )
 
-type DatetimeFormatMap map[string]string
+// DatetimeFormatMap is a cache of converted datetime formats that can be used by multiple goroutines.
+type DatetimeFormatMap struct {
+	mtx   *sync.RWMutex
+	items map[string]string
+}
+
+func NewDatetimeFormatMap() *DatetimeFormatMap {
+	return &DatetimeFormatMap{
+		mtx:   &sync.RWMutex{},
+		items: make(map[string]string),
+	}
+}
 
-func (m DatetimeFormatMap) Get(s string) string {
-	if f, ok := m[s]; ok {
+func (m *DatetimeFormatMap) Get(s string) string {
+	m.mtx.RLock()
+	f, ok := m.items[s]
+	m.mtx.RUnlock()
+	if ok {
 		return f
 	}
-	f := ConvertDatetimeFormat(s)
-	m[s] = f
+
+	f = ConvertDatetimeFormat(s)
+	m.mtx.Lock()
+	m.items[s] = f
+	m.mtx.Unlock()
 	return f
 }
 
-var DatetimeFormats = DatetimeFormatMap{}
+var DatetimeFormats = NewDatetimeFormatMap()
 
 func StrToTime(s string) (time.Time, error) {
 	s = strings.TrimSpace(s)
@@ -206,7 +225,7 @@ func Float64ToTime(f float64) time.Time {
 		}
 		nsec, _ = strconv.ParseInt(ns[1]+strings.Repeat("0", 9-len(ns[1])), 10, 64)
 	}
-	return time.Unix(sec, nsec)
+	return time.Unix(sec, nsec).In(cmd.GetLocation())
 }
 
 func Int64ToStr(i int64) string {
@@ -234,7 +253,7 @@ func ToInteger(p Primary) Primary {
 			return NewInteger(int64(f))
 		}
 	case String:
-		s := strings.TrimSpace(p.(String).Raw())
+		s := strings.TrimSpace(LocalizedNumber(p.(String)))
 		if maybeNumber(s) {
 			if i, e := strconv.ParseInt(s, 10, 64); e == nil {
 				return NewInteger(i)
@@ -257,9 +276,10 @@ func ToFloat(p Primary) Primary {
 	case Float:
 		return p
 	case String:
-		s := strings.TrimSpace(p.(String).Raw())
+		raw := LocalizedNumber(p.(String))
+		s := strings.TrimSpace(raw)
 		if maybeNumber(s) {
-			if f, e := strconv.ParseFloat(p.(String).Raw(), 64); e == nil {
+			if f, e := strconv.ParseFloat(raw, 64); e == nil {
 				return NewFloat(f)
 			}
 		}
@@ -268,6 +288,105 @@ func ToFloat(p Primary) Primary {
 	return NewNull()
 }
 
+// LocalizedNumber returns the string in which the separators of the string, or the separators specified
+// by the flags, are replaced so that the string can be parsed as a number.
+// If the string is not a number written with the separators, then returns the string as it is.
+func LocalizedNumber(p String) string {
+	s := p.literal
+	if p.separators != nil {
+		if n, ok := NormalizeNumber(s, p.separators.Decimal, p.separators.Thousands); ok {
+			return n
+		}
+		return s
+	}
+
+	flags := cmd.GetFlags()
+	if flags.DecimalSeparator == "." && len(flags.ThousandsSeparator) < 1 {
+		return s
+	}
+	if n, ok := NormalizeNumber(s, flags.DecimalSeparator, flags.ThousandsSeparator); ok {
+		return n
+	}
+	return s
+}
+
+// NormalizeNumber replaces the decimal separator with "." and removes the thousands separators
+// from the string written as a number.
+// Thousands separators are accepted only in the integer part and only between groups of three digits.
+func NormalizeNumber(s string, decimalSeparator string, thousandsSeparator string) (string, bool) {
+	if decimalSeparator == thousandsSeparator {
+		return s, false
+	}
+
+	s = strings.TrimSpace(s)
+	buf := make([]byte, 0, len(s))
+
+	i := 0
+	if 0 < len(s) && (s[0] == '-' || s[0] == '+') {
+		buf = append(buf, s[0])
+		i++
+	}
+
+	digits := 0
+	groupDigits := -1
+	for i < len(s) {
+		switch {
+		case '0' <= s[i] && s[i] <= '9':
+			buf = append(buf, s[i])
+			digits++
+			if -1 < groupDigits {
+				groupDigits++
+			}
+			i++
+			continue
+		case 0 < len(thousandsSeparator) && strings.HasPrefix(s[i:], thousandsSeparator):
+			if digits < 1 || (groupDigits < 0 && 3 < digits) || (-1 < groupDigits && groupDigits != 3) {
+				return s, false
+			}
+			groupDigits = 0
+			i = i + len(thousandsSeparator)
+			continue
+		}
+		break
+	}
+	if digits < 1 || (-1 < groupDigits && groupDigits != 3) {
+		return s, false
+	}
+
+	if strings.HasPrefix(s[i:], decimalSeparator) {
+		buf = append(buf, '.')
+		i = i + len(decimalSeparator)
+	}
+	for ; i < len(s); i++ {
+		switch {
+		case '0' <= s[i] && s[i] <= '9', s[i] == 'e', s[i] == 'E', s[i] == '+', s[i] == '-':
+			buf = append(buf, s[i])
+		default:
+			return s, false
+		}
+	}
+
+	n := string(buf)
+	if _, e := strconv.ParseFloat(n, 64); e != nil {
+		return s, false
+	}
+	return n, true
+}
+
+// StrToNumber converts the string written with the separators into an integer or a float.
+// If the string cannot be converted, then returns a null.
+func StrToNumber(s string, decimalSeparator string, thousandsSeparator string) Primary {
+	n, ok := NormalizeNumber(s, decimalSeparator, thousandsSeparator)
+	if !ok || !maybeNumber(n) {
+		return NewNull()
+	}
+	if i, e := strconv.ParseInt(n, 10, 64); e == nil {
+		return NewInteger(i)
+	}
+	f, _ := strconv.ParseFloat(n, 64)
+	return NewFloat(f)
+}
+
 func maybeNumber(s string) bool {
 	slen := len(s)
 	if 1 < slen && (s[0] == '-' || s[0] == '+') && '0' <= s[1] && s[1] <= '9' {
@@ -293,7 +412,7 @@ func maybeNumber(s string) bool {
 func ToDatetime(p Primary) Primary {
 	switch p.(type) {
 	case Integer:
-		dt := time.Unix(p.(Integer).Raw(), 0)
+		dt := time.Unix(p.(Integer).Raw(), 0).In(cmd.GetLocation())
 		return NewDatetime(dt)
 	case Float:
 		dt := Float64ToTime(p.(Float).Raw())
@@ -307,7 +426,7 @@ func ToDatetime(p Primary) Primary {
 		}
 		if maybeNumber(s) {
 			if i, e := strconv.ParseInt(s, 10, 64); e == nil {
-				dt := time.Unix(i, 0)
+				dt := time.Unix(i, 0).In(cmd.GetLocation())
 				return NewDatetime(dt)
 			}
 			if f, e := strconv.ParseFloat(s, 64); e == nil {
@@ -340,6 +459,50 @@ func ToString(p Primary) Primary {
 		return NewString(Int64ToStr(p.(Integer).Raw()))
 	case Float:
 		return NewString(Float64ToStr(p.(Float).Raw()))
+	case Map, Array:
+		return NewString(ToJSONStructure(p).Encode())
 	}
 	return NewNull()
 }
+
+func ToJSONStructure(p Primary) json.Structure {
+	var s json.Structure
+
+	switch p.(type) {
+	case String:
+		s = json.String(p.(String).Raw())
+	case Integer:
+		s = json.Number(p.(Integer).Raw())
+	case Float:
+		s = json.Number(p.(Float).Raw())
+	case Boolean:
+		s = json.Boolean(p.(Boolean).Raw())
+	case Ternary:
+		t := p.(Ternary)
+		if t.Ternary() == ternary.UNKNOWN {
+			s = json.Null{}
+		} else {
+			s = json.Boolean(t.Ternary().ParseBool())
+		}
+	case Datetime:
+		s = json.String(p.(Datetime).Format(time.RFC3339Nano))
+	case Array:
+		list := p.(Array).Raw()
+		array := make(json.Array, len(list))
+		for i, v := range list {
+			array[i] = ToJSONStructure(v)
+		}
+		s = array
+	case Map:
+		m := p.(Map)
+		obj := json.NewObject(m.Len())
+		for i, k := range m.Keys() {
+			obj.Add(k, ToJSONStructure(m.Values()[i]))
+		}
+		s = obj
+	case Null:
+		s = json.Null{}
+	}
+
+	return s
+}
@@ -11,13 +11,27 @@ import (
 
 func TestStrToTime(t *testing.T) {
 	flags := cmd.GetFlags()
-	flags.DatetimeFormat = []string{"01/02/2006"}
+	flags.DatetimeFormat = []string{"01/02/2006", "02/01/2006 15:04", "%d.%m.%Y %H:%i"}
 
 	s := "01/02/2006"
 	if _, err := StrToTime(s); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
+	s = "13/02/2006 15:04"
+	if dt, err := StrToTime(s); err != nil {
+		t.Errorf("unexpected error %q for %q", err, s)
+	} else if expect := time.Date(2006, 2, 13, 15, 4, 0, 0, cmd.GetLocation()); !dt.Equal(expect) {
+		t.Errorf("datetime = %s, want %s for %q", dt, expect, s)
+	}
+
+	s = "13.02.2006 15:04"
+	if dt, err := StrToTime(s); err != nil {
+		t.Errorf("unexpected error %q for %q", err, s)
+	} else if expect := time.Date(2006, 2, 13, 15, 4, 0, 0, cmd.GetLocation()); !dt.Equal(expect) {
+		t.Errorf("datetime = %s, want %s for %q", dt, expect, s)
+	}
+
 	s = "2006-01-02 15:04:05"
 	if _, err := StrToTime(s); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
@@ -378,6 +392,133 @@ func TestToFloat(t *testing.T) {
 	}
 }
 
+func TestToFloatWithSeparators(t *testing.T) {
+	flags := cmd.GetFlags()
+	defer func() {
+		flags.DecimalSeparator = "."
+		flags.ThousandsSeparator = ""
+	}()
+
+	flags.DecimalSeparator = ","
+	flags.ThousandsSeparator = "."
+
+	p := NewString("1.234,5")
+	f := ToFloat(p)
+	if f != NewFloat(1234.5) {
+		t.Errorf("result = %#v, want %#v for %#v", f, NewFloat(1234.5), p)
+	}
+
+	p = NewString("1.5")
+	f = ToFloat(p)
+	if f != NewFloat(1.5) {
+		t.Errorf("result = %#v, want %#v for %#v", f, NewFloat(1.5), p)
+	}
+
+	p = NewString("1.234.000")
+	i := ToInteger(p)
+	if i != NewInteger(1234000) {
+		t.Errorf("result = %#v, want %#v for %#v", i, NewInteger(1234000), p)
+	}
+}
+
+var normalizeNumberTests = []struct {
+	String             string
+	DecimalSeparator   string
+	ThousandsSeparator string
+	Result             string
+	OK                 bool
+}{
+	{
+		String:             "1.234,56",
+		DecimalSeparator:   ",",
+		ThousandsSeparator: ".",
+		Result:             "1234.56",
+		OK:                 true,
+	},
+	{
+		String:             " -1,234,567.8e2 ",
+		DecimalSeparator:   ".",
+		ThousandsSeparator: ",",
+		Result:             "-1234567.8e2",
+		OK:                 true,
+	},
+	{
+		String:             "1 234",
+		DecimalSeparator:   ",",
+		ThousandsSeparator: " ",
+		Result:             "1234",
+		OK:                 true,
+	},
+	{
+		String:             "0,5",
+		DecimalSeparator:   ",",
+		ThousandsSeparator: "",
+		Result:             "0.5",
+		OK:                 true,
+	},
+	{
+		String:             "12,34",
+		DecimalSeparator:   ".",
+		ThousandsSeparator: ",",
+		OK:                 false,
+	},
+	{
+		String:             "1234,567",
+		DecimalSeparator:   ".",
+		ThousandsSeparator: ",",
+		OK:                 false,
+	},
+	{
+		String:             "1,234.5,6",
+		DecimalSeparator:   ".",
+		ThousandsSeparator: ",",
+		OK:                 false,
+	},
+	{
+		String:             "1.5",
+		DecimalSeparator:   ",",
+		ThousandsSeparator: "",
+		OK:                 false,
+	},
+	{
+		String:             "abc",
+		DecimalSeparator:   ",",
+		ThousandsSeparator: ".",
+		OK:                 false,
+	},
+	{
+		String:             "1.234",
+		DecimalSeparator:   ".",
+		ThousandsSeparator: ".",
+		OK:                 false,
+	},
+}
+
+func TestNormalizeNumber(t *testing.T) {
+	for _, v := range normalizeNumberTests {
+		result, ok := NormalizeNumber(v.String, v.DecimalSeparator, v.ThousandsSeparator)
+		if ok != v.OK {
+			t.Errorf("ok = %t, want %t for %q, %q, %q", ok, v.OK, v.String, v.DecimalSeparator, v.ThousandsSeparator)
+			continue
+		}
+		if ok && result != v.Result {
+			t.Errorf("result = %q, want %q for %q, %q, %q", result, v.Result, v.String, v.DecimalSeparator, v.ThousandsSeparator)
+		}
+	}
+}
+
+func TestStrToNumber(t *testing.T) {
+	if p := StrToNumber("1.234", ",", "."); p != NewInteger(1234) {
+		t.Errorf("result = %#v, want %#v", p, NewInteger(1234))
+	}
+	if p := StrToNumber("1.234,5", ",", "."); p != NewFloat(1234.5) {
+		t.Errorf("result = %#v, want %#v", p, NewFloat(1234.5))
+	}
+	if p := StrToNumber("1.5", ",", "."); !IsNull(p) {
+		t.Errorf("result = %#v, want %#v", p, NewNull())
+	}
+}
+
 func TestToDatetime(t *testing.T) {
 	flags := cmd.GetFlags()
 
@@ -402,6 +543,16 @@ func TestToDatetime(t *testing.T) {
 		t.Errorf("primary type = %T, want Datetime for %#v", dt, p)
 	}
 
+	_ = flags.SetLocation("+09:00")
+	p = NewInteger(1136181845)
+	dt = ToDatetime(p)
+	if _, ok := dt.(Datetime); !ok {
+		t.Errorf("primary type = %T, want Datetime for %#v", dt, p)
+	} else if s := dt.(Datetime).Format(time.RFC3339); s != "2006-01-02T15:04:05+09:00" {
+		t.Errorf("datetime = %s, want %s for %#v in the timezone %s", s, "2006-01-02T15:04:05+09:00", p, flags.Location)
+	}
+	_ = flags.SetLocation("Local")
+
 	p = NewFloat(1136181845)
 	dt = ToDatetime(p)
 	if _, ok := dt.(Datetime); !ok {
@@ -519,6 +670,12 @@ func TestToString(t *testing.T) {
 	if _, ok := s.(Null); !ok {
 		t.Errorf("primary type = %T, want Null for %#v", s, p)
 	}
+
+	p = NewMap([]string{"a", "b"}, []Primary{NewInteger(1), NewArray([]Primary{NewString("x"), NewNull()})})
+	s = ToString(p)
+	if str, ok := s.(String); !ok || str.Raw() != `{"a":1,"b":["x",null]}` {
+		t.Errorf("result = %#v, want %q for %#v", s, `{"a":1,"b":["x",null]}`, p)
+	}
 }
 
 func BenchmarkStrToTime1(b *testing.B) {
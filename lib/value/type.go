@@ -22,7 +22,14 @@ type Primary interface {
 }
 
 type String struct {
-	literal string
+	literal    string
+	separators *NumberSeparators
+}
+
+// NumberSeparators are the separators used to convert a string read from a file into a number.
+type NumberSeparators struct {
+	Decimal   string
+	Thousands string
 }
 
 func (s String) String() string {
@@ -35,6 +42,13 @@ func NewString(s string) String {
 	}
 }
 
+func NewLocalizedString(s string, separators *NumberSeparators) String {
+	return String{
+		literal:    s,
+		separators: separators,
+	}
+}
+
 func (s String) Raw() string {
 	return s.literal
 }
@@ -212,3 +226,102 @@ func (n Null) String() string {
 func (n Null) Ternary() ternary.Value {
 	return ternary.UNKNOWN
 }
+
+type Array struct {
+	values []Primary
+}
+
+func NewArray(values []Primary) Array {
+	return Array{
+		values: values,
+	}
+}
+
+func (a Array) String() string {
+	list := make([]string, len(a.values))
+	for i, v := range a.values {
+		list[i] = v.String()
+	}
+	return "[" + strings.Join(list, ", ") + "]"
+}
+
+func (a Array) Raw() []Primary {
+	return a.values
+}
+
+func (a Array) Len() int {
+	return len(a.values)
+}
+
+func (a Array) Elem(i int) Primary {
+	if i < 0 || len(a.values) <= i {
+		return NewNull()
+	}
+	return a.values[i]
+}
+
+func (a Array) Ternary() ternary.Value {
+	return ternary.UNKNOWN
+}
+
+type Map struct {
+	keys   []string
+	values []Primary
+}
+
+func NewMap(keys []string, values []Primary) Map {
+	return Map{
+		keys:   keys,
+		values: values,
+	}
+}
+
+func (m Map) String() string {
+	list := make([]string, len(m.keys))
+	for i, k := range m.keys {
+		list[i] = strconv.Quote(k) + ": " + m.values[i].String()
+	}
+	return "{" + strings.Join(list, ", ") + "}"
+}
+
+func (m Map) Keys() []string {
+	return m.keys
+}
+
+func (m Map) Values() []Primary {
+	return m.values
+}
+
+func (m Map) Len() int {
+	return len(m.keys)
+}
+
+func (m Map) Value(key string) (Primary, bool) {
+	for i, k := range m.keys {
+		if k == key {
+			return m.values[i], true
+		}
+	}
+	return NewNull(), false
+}
+
+// Set returns a copy of the map with the value of the key replaced.
+// The key is appended if it does not exist in the map.
+func (m Map) Set(key string, val Primary) Map {
+	keys := make([]string, len(m.keys), len(m.keys)+1)
+	values := make([]Primary, len(m.values), len(m.values)+1)
+	copy(keys, m.keys)
+	copy(values, m.values)
+
+	for i, k := range keys {
+		if k == key {
+			values[i] = val
+			return NewMap(keys, values)
+		}
+	}
+	return NewMap(append(keys, key), append(values, val))
+}
+
+func (m Map) Ternary() ternary.Value {
+	return ternary.UNKNOWN
+}
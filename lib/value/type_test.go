@@ -214,3 +214,68 @@ func TestNull_Ternary(t *testing.T) {
 		t.Errorf("ternary = %s, want %s for %#v", p.Ternary(), ternary.UNKNOWN, p)
 	}
 }
+
+func TestArray_String(t *testing.T) {
+	p := NewArray([]Primary{NewInteger(1), NewString("a"), NewNull()})
+	expect := "[1, \"a\", NULL]"
+	if p.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", p.String(), expect, p)
+	}
+}
+
+func TestArray_Elem(t *testing.T) {
+	p := NewArray([]Primary{NewInteger(1), NewString("a")})
+	if p.Len() != 2 {
+		t.Errorf("length = %d, want %d for %#v", p.Len(), 2, p)
+	}
+	if e := p.Elem(1); e != NewString("a") {
+		t.Errorf("element = %s, want %s for %#v", e, NewString("a"), p)
+	}
+	if e := p.Elem(2); !IsNull(e) {
+		t.Errorf("element = %s, want %s for %#v", e, NewNull(), p)
+	}
+	if e := p.Elem(-1); !IsNull(e) {
+		t.Errorf("element = %s, want %s for %#v", e, NewNull(), p)
+	}
+}
+
+func TestArray_Ternary(t *testing.T) {
+	p := NewArray([]Primary{NewBoolean(true)})
+	if p.Ternary() != ternary.UNKNOWN {
+		t.Errorf("ternary = %s, want %s for %#v", p.Ternary(), ternary.UNKNOWN, p)
+	}
+}
+
+func TestMap_String(t *testing.T) {
+	p := NewMap([]string{"a", "b"}, []Primary{NewInteger(1), NewArray([]Primary{NewString("c")})})
+	expect := "{\"a\": 1, \"b\": [\"c\"]}"
+	if p.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", p.String(), expect, p)
+	}
+}
+
+func TestMap_Value(t *testing.T) {
+	p := NewMap([]string{"a", "b"}, []Primary{NewInteger(1), NewString("x")})
+	if p.Len() != 2 {
+		t.Errorf("length = %d, want %d for %#v", p.Len(), 2, p)
+	}
+	if v, ok := p.Value("b"); !ok || v != NewString("x") {
+		t.Errorf("value = %s, want %s for %#v", v, NewString("x"), p)
+	}
+	if v, ok := p.Value("c"); ok || !IsNull(v) {
+		t.Errorf("value = %s, want %s for %#v", v, NewNull(), p)
+	}
+}
+
+func TestMap_Set(t *testing.T) {
+	p := NewMap([]string{"a", "b"}, []Primary{NewInteger(1), NewString("x")})
+
+	result := p.Set("b", NewString("y")).Set("c", NewInteger(2))
+	expect := "{\"a\": 1, \"b\": \"y\", \"c\": 2}"
+	if result.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", result.String(), expect, p)
+	}
+	if v, _ := p.Value("b"); v != NewString("x") {
+		t.Errorf("value = %s, want %s for %#v", v, NewString("x"), p)
+	}
+}
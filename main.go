@@ -46,6 +46,23 @@ func main() {
 			Name:  "repository, r",
 			Usage: "directory `PATH` where files are located",
 		},
+		cli.StringFlag{
+			Name:  "catalog",
+			Usage: "catalog `FILE` that maps table names to files",
+		},
+		cli.StringFlag{
+			Name:  "cache-dir",
+			Usage: "directory `PATH` where converted data of JSON files are cached",
+		},
+		cli.StringFlag{
+			Name:   "source-path",
+			Usage:  "directory `PATH` list where modules loaded by IMPORT statements are searched",
+			EnvVar: "CSVQ_SOURCE_PATH",
+		},
+		cli.BoolFlag{
+			Name:  "source-relative",
+			Usage: "resolve relative paths in SOURCE statements from the directory of the including file",
+		},
 		cli.StringFlag{
 			Name:  "timezone, z",
 			Value: "Local",
@@ -55,15 +72,66 @@ func main() {
 			Name:  "datetime-format, t",
 			Usage: "datetime format to parse strings",
 		},
+		cli.StringFlag{
+			Name:  "decimal-separator",
+			Value: ".",
+			Usage: "decimal separator to parse strings as numbers. one of: .|,",
+		},
+		cli.StringFlag{
+			Name:  "thousands-separator",
+			Usage: "thousands separator to parse strings as numbers. one of: ,|.|'|_|space",
+		},
+		cli.StringFlag{
+			Name:  "collation",
+			Value: "NOCASE",
+			Usage: "default collation to compare and sort strings. one of: BINARY|NOCASE|UNICODE|UNICODE_CI|NATURAL",
+		},
+		cli.StringFlag{
+			Name:  "language",
+			Usage: "language of messages. one of: en|ja. if not specified, detected from the environment variables LC_ALL, LC_MESSAGES and LANG",
+		},
 		cli.Float64Flag{
 			Name:  "wait-timeout, w",
 			Value: 10,
 			Usage: "limit of the waiting time in seconds to wait for locked files to be released",
 		},
+		cli.StringFlag{
+			Name:  "merge-tool",
+			Usage: "`COMMAND` to resolve conflicts with files modified by other applications",
+		},
+		cli.StringFlag{
+			Name:  "conflict-dir",
+			Usage: "directory `PATH` where files to resolve conflicts are saved",
+		},
+		cli.StringFlag{
+			Name:  "git-check",
+			Value: "NONE",
+			Usage: "check for uncommitted git modifications in files to be updated. one of: NONE|WARN|REFUSE",
+		},
 		cli.StringFlag{
 			Name:  "source, s",
 			Usage: "load query or statements from `FILE`",
 		},
+		cli.StringSliceFlag{
+			Name:  "param",
+			Usage: "parameter as `NAME=VALUE` available as a variable @NAME and a placeholder :NAME. can be specified multiple times",
+		},
+		cli.BoolFlag{
+			Name:  "debug",
+			Usage: "execute statements with the debugger, stopping before the first statement",
+		},
+		cli.StringSliceFlag{
+			Name:  "break",
+			Usage: "set a breakpoint of the debugger at `[FILE:]LINE`. can be specified multiple times",
+		},
+		cli.StringFlag{
+			Name:  "watch",
+			Usage: "load query or statements from `FILE`, and execute them again each time the file or the tables loaded by them are modified",
+		},
+		cli.BoolFlag{
+			Name:  "check",
+			Usage: "check the syntax and semantics of the query or statements without executing them",
+		},
 		cli.StringFlag{
 			Name:  "delimiter, d",
 			Value: ",",
@@ -78,6 +146,10 @@ func main() {
 			Value: "UTF8",
 			Usage: "file encoding. one of: UTF8|SJIS",
 		},
+		cli.StringFlag{
+			Name:  "encoding-errors",
+			Usage: "handling of bytes that cannot be decoded or characters that cannot be encoded. one of: STRICT|REPLACE|IGNORE",
+		},
 		cli.BoolFlag{
 			Name:  "no-header, n",
 			Usage: "import the first line as a record",
@@ -86,9 +158,23 @@ func main() {
 			Name:  "without-null, a",
 			Usage: "parse empty fields as empty strings",
 		},
+		cli.IntFlag{
+			Name:  "max-field-size",
+			Value: 0,
+			Usage: "maximum size in bytes of a field in CSV and TSV. 0 means unlimited",
+		},
+		cli.IntFlag{
+			Name:  "max-row-size",
+			Value: 0,
+			Usage: "maximum size in bytes of a row. 0 means unlimited",
+		},
+		cli.BoolFlag{
+			Name:  "recover-quotes",
+			Usage: "recover quoted fields that start with stray double quotes and run over lines",
+		},
 		cli.StringFlag{
 			Name:  "out, o",
-			Usage: "export result sets of select queries to `FILE`",
+			Usage: "export result sets of select queries to `FILE`, or send them to an http(s) URL",
 		},
 		cli.StringFlag{
 			Name:  "format, f",
@@ -114,10 +200,19 @@ func main() {
 			Value: "LF",
 			Usage: "line break in query results. one of: CRLF|LF|CR",
 		},
+		cli.BoolFlag{
+			Name:  "normalize-line-break",
+			Usage: "write updated files with a single line break instead of preserving mixed line breaks",
+		},
 		cli.BoolFlag{
 			Name:  "enclose-all, Q",
 			Usage: "enclose all string values in CSV",
 		},
+		cli.StringFlag{
+			Name:  "quote-style",
+			Value: "PRESERVE",
+			Usage: "quoting of new or updated fields in CSV. one of: PRESERVE|MINIMAL|ALL",
+		},
 		cli.StringFlag{
 			Name:  "json-escape, J",
 			Value: "BACKSLASH",
@@ -143,6 +238,10 @@ func main() {
 			Name:  "color, c",
 			Usage: "use ANSI color escape sequences",
 		},
+		cli.BoolFlag{
+			Name:  "plain",
+			Usage: "write plain output without colors, box drawing and padding",
+		},
 		cli.BoolFlag{
 			Name:  "quiet, q",
 			Usage: "suppress operation log output",
@@ -152,10 +251,23 @@ func main() {
 			Value: cmd.GetDefaultNumberOfCPU(),
 			Usage: "hint for the number of cpu cores to be used",
 		},
+		cli.IntFlag{
+			Name:  "sort-memory",
+			Value: 0,
+			Usage: "size in bytes of memory to hold sort keys, exceeding keys are written to temporary files. 0 means unlimited",
+		},
 		cli.BoolFlag{
 			Name:  "stats, x",
 			Usage: "show execution time and memory statistics",
 		},
+		cli.BoolFlag{
+			Name:  "stream",
+			Usage: "execute simple select queries reading records without loading whole tables",
+		},
+		cli.BoolFlag{
+			Name:  "filter-on-load",
+			Usage: "evaluate conditions in where clauses while reading records from files",
+		},
 	}
 
 	app.Commands = []cli.Command{
@@ -219,6 +331,73 @@ func main() {
 				return NewExitError(fmt.Sprintf("Incorrect Usage: %s", err.Error()), 1)
 			},
 		},
+		{
+			Name:      "bench",
+			Usage:     "Measure the performance of a select query",
+			ArgsUsage: "\"query\"",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "iterations, i",
+					Value: 10,
+					Usage: "number of times to execute the query",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				if c.NArg() != 1 {
+					return NewExitError("query is empty", 1)
+				}
+
+				err := action.Bench(proc, c.Args().First(), c.Int("iterations"))
+				if err != nil {
+					return NewExitError(err.Error(), 1)
+				}
+
+				return nil
+			},
+			OnUsageError: func(c *cli.Context, err error, isSubcommand bool) error {
+				return NewExitError(fmt.Sprintf("Incorrect Usage: %s", err.Error()), 1)
+			},
+		},
+		{
+			Name:      "test",
+			Usage:     "Run test files",
+			ArgsUsage: "[PATH ...]",
+			Action: func(c *cli.Context) error {
+				paths := c.Args()
+				err := action.Test(proc, paths)
+				if err != nil {
+					return NewExitError(err.Error(), 1)
+				}
+
+				return nil
+			},
+			OnUsageError: func(c *cli.Context, err error, isSubcommand bool) error {
+				return NewExitError(fmt.Sprintf("Incorrect Usage: %s", err.Error()), 1)
+			},
+		},
+		{
+			Name:      "self-update",
+			Usage:     "Update csvq to the latest release",
+			ArgsUsage: " ",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "channel",
+					Value: query.StableChannel,
+					Usage: "release channel. one of: stable|prerelease",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				err := action.SelfUpdate(c.String("channel"))
+				if err != nil {
+					return NewExitError(err.Error(), 1)
+				}
+
+				return nil
+			},
+			OnUsageError: func(c *cli.Context, err error, isSubcommand bool) error {
+				return NewExitError(fmt.Sprintf("Incorrect Usage: %s", err.Error()), 1)
+			},
+		},
 	}
 
 	app.Before = func(c *cli.Context) error {
@@ -245,6 +424,11 @@ func main() {
 		if err := overwriteFlags(c); err != nil {
 			return NewExitError(err.Error(), 1)
 		}
+
+		// Declare Parameters
+		if err := proc.SetParams(c.GlobalStringSlice("param")); err != nil {
+			return NewExitError(err.Error(), 1)
+		}
 		return nil
 	}
 
@@ -254,9 +438,22 @@ func main() {
 			return NewExitError(err.Error(), 1)
 		}
 
-		if len(queryString) < 1 {
+		if c.GlobalBool("check") {
+			if len(queryString) < 1 {
+				return NewExitError("query is empty", 1)
+			}
+			err = action.Check(proc, queryString, path)
+		} else if c.IsSet("watch") {
+			err = action.Watch(proc, queryString, path, c.GlobalString("out"))
+		} else if len(queryString) < 1 {
+			if c.GlobalBool("debug") || 0 < len(c.GlobalStringSlice("break")) {
+				return NewExitError("debugger cannot be used in interactive shell", 1)
+			}
 			err = action.LaunchInteractiveShell(proc)
 		} else {
+			if err = setDebugger(c); err != nil {
+				return NewExitError(err.Error(), 1)
+			}
 			err = action.Run(proc, queryString, path, c.GlobalString("out"))
 		}
 
@@ -276,12 +473,48 @@ func main() {
 	app.Run(os.Args)
 }
 
+func setDebugger(c *cli.Context) error {
+	breakpoints := c.GlobalStringSlice("break")
+	if !c.GlobalBool("debug") && len(breakpoints) < 1 {
+		return nil
+	}
+
+	debugger := query.NewDebugger(query.Stdin, c.GlobalBool("debug"))
+	for _, bp := range breakpoints {
+		if err := debugger.AddBreakpoint(bp); err != nil {
+			return err
+		}
+	}
+	query.Debug = debugger
+	return nil
+}
+
+// sourceFilePath returns the path of the file specified by --source or --watch.
+func sourceFilePath(c *cli.Context) string {
+	if c.IsSet("watch") {
+		return c.GlobalString("watch")
+	}
+	if c.IsSet("source") {
+		return c.GlobalString("source")
+	}
+	return ""
+}
+
 func readQuery(c *cli.Context) (string, string, error) {
 	var queryString string
 	var path string
 
-	if c.IsSet("source") && 0 < len(c.GlobalString("source")) {
-		path = c.GlobalString("source")
+	if c.IsSet("watch") {
+		if c.IsSet("source") || 0 < c.NArg() {
+			return queryString, path, errors.New("query or statements cannot be passed with --watch")
+		}
+		if len(c.GlobalString("watch")) < 1 {
+			return queryString, path, errors.New("file to watch is not specified")
+		}
+	}
+
+	if sourcePath := sourceFilePath(c); 0 < len(sourcePath) {
+		path = sourcePath
 		if abs, err := filepath.Abs(path); err == nil {
 			path = abs
 		}
@@ -315,12 +548,31 @@ func overwriteFlags(c *cli.Context) error {
 	if c.IsSet("color") {
 		flags.SetColor(c.GlobalBool("color"))
 	}
+	if c.IsSet("plain") {
+		flags.SetPlain(c.GlobalBool("plain"))
+	}
 
 	if c.IsSet("repository") {
 		if err := flags.SetRepository(c.GlobalString("repository")); err != nil {
 			return err
 		}
 	}
+	if c.IsSet("catalog") {
+		if err := flags.SetCatalog(c.GlobalString("catalog")); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("cache-dir") {
+		if err := flags.SetCacheDir(c.GlobalString("cache-dir")); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("source-path") {
+		flags.SetSourcePath(c.GlobalString("source-path"))
+	}
+	if c.IsSet("source-relative") {
+		flags.SetSourceRelative(c.GlobalBool("source-relative"))
+	}
 	if c.IsSet("timezone") {
 		if err := flags.SetLocation(c.String("timezone")); err != nil {
 			return err
@@ -329,9 +581,44 @@ func overwriteFlags(c *cli.Context) error {
 	if c.IsSet("datetime-format") {
 		flags.SetDatetimeFormat(c.GlobalString("datetime-format"))
 	}
+	if c.IsSet("decimal-separator") {
+		if err := flags.SetDecimalSeparator(c.GlobalString("decimal-separator")); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("thousands-separator") {
+		if err := flags.SetThousandsSeparator(c.GlobalString("thousands-separator")); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("collation") {
+		if err := flags.SetCollation(c.GlobalString("collation")); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("language") {
+		if err := flags.SetLanguage(c.GlobalString("language")); err != nil {
+			return err
+		}
+	} else {
+		flags.Language = cmd.DetectLanguage()
+	}
 	if c.IsSet("wait-timeout") {
 		flags.SetWaitTimeout(c.GlobalFloat64("wait-timeout"))
 	}
+	if c.IsSet("merge-tool") {
+		flags.SetMergeTool(c.GlobalString("merge-tool"))
+	}
+	if c.IsSet("conflict-dir") {
+		if err := flags.SetConflictDir(c.GlobalString("conflict-dir")); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("git-check") {
+		if err := flags.SetGitCheck(c.GlobalString("git-check")); err != nil {
+			return err
+		}
+	}
 
 	if c.IsSet("delimiter") {
 		if err := flags.SetDelimiter(c.GlobalString("delimiter")); err != nil {
@@ -346,17 +633,35 @@ func overwriteFlags(c *cli.Context) error {
 			return err
 		}
 	}
+	if c.IsSet("encoding-errors") {
+		if err := flags.SetEncodingErrors(c.GlobalString("encoding-errors")); err != nil {
+			return err
+		}
+	}
 	if c.IsSet("no-header") {
 		flags.SetNoHeader(c.GlobalBool("no-header"))
 	}
 	if c.IsSet("without-null") {
 		flags.SetWithoutNull(c.GlobalBool("without-null"))
 	}
+	if c.IsSet("max-field-size") {
+		flags.SetMaxFieldSize(c.GlobalInt("max-field-size"))
+	}
+	if c.IsSet("max-row-size") {
+		flags.SetMaxRowSize(c.GlobalInt("max-row-size"))
+	}
+	if c.IsSet("recover-quotes") {
+		flags.SetRecoverQuotes(c.GlobalBool("recover-quotes"))
+	}
 
 	if c.IsSet("format") {
 		if err := flags.SetFormat(c.GlobalString("format"), c.GlobalString("out")); err != nil {
 			return err
 		}
+	} else if cmd.IsHttpUrl(c.GlobalString("out")) {
+		if err := flags.SetFormat("", c.GlobalString("out")); err != nil {
+			return err
+		}
 	}
 	if c.IsSet("write-encoding") {
 		if err := flags.SetWriteEncoding(c.GlobalString("write-encoding")); err != nil {
@@ -376,9 +681,17 @@ func overwriteFlags(c *cli.Context) error {
 			return err
 		}
 	}
+	if c.IsSet("normalize-line-break") {
+		flags.SetNormalizeLineBreak(c.GlobalBool("normalize-line-break"))
+	}
 	if c.IsSet("enclose-all") {
 		flags.SetEncloseAll(c.GlobalBool("enclose-all"))
 	}
+	if c.IsSet("quote-style") {
+		if err := flags.SetQuoteStyle(c.GlobalString("quote-style")); err != nil {
+			return err
+		}
+	}
 	if c.IsSet("json-escape") {
 		if err := flags.SetJsonEscape(c.GlobalString("json-escape")); err != nil {
 			return err
@@ -404,9 +717,18 @@ func overwriteFlags(c *cli.Context) error {
 	if c.IsSet("cpu") {
 		flags.SetCPU(c.GlobalInt("cpu"))
 	}
+	if c.IsSet("sort-memory") {
+		flags.SetSortMemory(c.GlobalInt("sort-memory"))
+	}
 	if c.IsSet("stats") {
 		flags.SetStats(c.GlobalBool("stats"))
 	}
+	if c.IsSet("stream") {
+		flags.SetStream(c.GlobalBool("stream"))
+	}
+	if c.IsSet("filter-on-load") {
+		flags.SetFilterOnLoad(c.GlobalBool("filter-on-load"))
+	}
 
 	return nil
 }